@@ -0,0 +1,75 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/authext"
+	"github.com/openimsdk/tools/a2r"
+)
+
+// APIKeyHeader carries the API key of server-side integrations, in place of the token header.
+const APIKeyHeader = "apiKey"
+
+// apiKeyScopeKey is the gin context key of the scope a request was authorized with by an API key.
+const apiKeyScopeKey = "apiKeyScope"
+
+// apiKeyScopes maps the APIs that can be called with an API key to the scope they require.
+// APIs not listed here only accept user tokens.
+var apiKeyScopes = map[string]string{
+	"/msg/send_msg":                   authverify.ScopeMsgSend,
+	"/msg/batch_send_msg":             authverify.ScopeMsgSend,
+	"/msg/send_business_notification": authverify.ScopeMsgSend,
+	"/msg/submit_broadcast":           authverify.ScopeMsgSend,
+	"/msg/get_broadcast":              authverify.ScopeMsgSend,
+	"/msg/get_broadcasts":             authverify.ScopeMsgSend,
+	"/msg/get_broadcast_failed_ids":   authverify.ScopeMsgSend,
+	"/msg/cancel_broadcast":           authverify.ScopeMsgSend,
+	"/msg/check_msg_is_send_success":  authverify.ScopeMsgSend,
+	"/msg/search_msg":                 authverify.ScopeMsgRead,
+	"/user/user_register":             authverify.ScopeUserRegister,
+	"/user/get_users_info":            authverify.ScopeUserRead,
+	"/user/get_users":                 authverify.ScopeUserRead,
+	"/user/get_all_users_uid":         authverify.ScopeUserRead,
+	"/user/update_user_info":          authverify.ScopeUserWrite,
+	"/auth/get_user_token":            authverify.ScopeUserToken,
+	"/auth/force_logout":              authverify.ScopeUserToken,
+	"/group/get_groups_info":          authverify.ScopeGroupRead,
+	"/group/get_groups":               authverify.ScopeGroupRead,
+	"/group/get_group_members_info":   authverify.ScopeGroupRead,
+	"/group/get_group_member_list":    authverify.ScopeGroupRead,
+	"/group/create_group":             authverify.ScopeGroupWrite,
+	"/group/invite_user_to_group":     authverify.ScopeGroupWrite,
+	"/group/kick_group":               authverify.ScopeGroupWrite,
+	"/group/dismiss_group":            authverify.ScopeGroupWrite,
+	"/friend/import_friend":           authverify.ScopeFriendWrite,
+}
+
+func (o *AuthApi) CreateAPIKey(c *gin.Context) {
+	a2r.Call(authext.AuthExtClient.CreateAPIKey, o.ExtClient, c)
+}
+
+func (o *AuthApi) RevokeAPIKey(c *gin.Context) {
+	a2r.Call(authext.AuthExtClient.RevokeAPIKey, o.ExtClient, c)
+}
+
+func (o *AuthApi) GetAPIKeys(c *gin.Context) {
+	a2r.Call(authext.AuthExtClient.GetAPIKeys, o.ExtClient, c)
+}
+
+func (o *AuthApi) GetAPIKeyAudits(c *gin.Context) {
+	a2r.Call(authext.AuthExtClient.GetAPIKeyAudits, o.ExtClient, c)
+}
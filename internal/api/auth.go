@@ -15,10 +15,17 @@
 package api
 
 import (
+	"math/rand"
+	"net/http"
+	"strconv"
+
 	"github.com/gin-gonic/gin"
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/authext"
 	"github.com/openimsdk/open-im-server/v3/pkg/rpcclient"
 	"github.com/openimsdk/protocol/auth"
 	"github.com/openimsdk/tools/a2r"
+	"github.com/openimsdk/tools/mcontext"
 )
 
 type AuthApi rpcclient.Auth
@@ -28,7 +35,23 @@ func NewAuthApi(client rpcclient.Auth) AuthApi {
 }
 
 func (o *AuthApi) UserToken(c *gin.Context) {
-	a2r.Call(auth.AuthClient.UserToken, o.Client, c)
+	a2r.Call(authext.AuthExtClient.IssueToken, o.ExtClient, c)
+}
+
+func (o *AuthApi) RefreshToken(c *gin.Context) {
+	a2r.Call(authext.AuthExtClient.RefreshToken, o.ExtClient, c)
+}
+
+func (o *AuthApi) OidcLogin(c *gin.Context) {
+	a2r.Call(authext.AuthExtClient.OidcLogin, o.ExtClient, c)
+}
+
+func (o *AuthApi) GetSessions(c *gin.Context) {
+	a2r.Call(authext.AuthExtClient.GetSessions, o.ExtClient, c)
+}
+
+func (o *AuthApi) RevokeSession(c *gin.Context) {
+	a2r.Call(authext.AuthExtClient.RevokeSession, o.ExtClient, c)
 }
 
 func (o *AuthApi) GetUserToken(c *gin.Context) {
@@ -42,3 +65,19 @@ func (o *AuthApi) ParseToken(c *gin.Context) {
 func (o *AuthApi) ForceLogout(c *gin.Context) {
 	a2r.Call(auth.AuthClient.ForceLogout, o.Client, c)
 }
+
+// GetJWKS serves the token verification keys as a plain JWKS document, so it can be consumed by standard JWT libraries.
+func (o *AuthApi) GetJWKS(c *gin.Context) {
+	operationID := c.Query("operationID")
+	if operationID == "" {
+		operationID = strconv.Itoa(rand.Int())
+	}
+	ctx := mcontext.SetOperationID(c, operationID)
+	resp, err := o.ExtClient.GetJWKS(ctx, &authext.GetJWKSReq{})
+	if err != nil {
+		c.String(http.StatusInternalServerError, err.Error())
+		return
+	}
+	c.Header("Cache-Control", "public, max-age=300")
+	c.JSON(http.StatusOK, gin.H{"keys": authverify.JSONWebKeysFromPb(resp.Keys)})
+}
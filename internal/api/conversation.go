@@ -16,6 +16,7 @@ package api
 
 import (
 	"github.com/gin-gonic/gin"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/conversationext"
 	"github.com/openimsdk/open-im-server/v3/pkg/rpcclient"
 	"github.com/openimsdk/protocol/conversation"
 	"github.com/openimsdk/tools/a2r"
@@ -50,3 +51,59 @@ func (o *ConversationApi) SetConversations(c *gin.Context) {
 func (o *ConversationApi) GetConversationOfflinePushUserIDs(c *gin.Context) {
 	a2r.Call(conversation.ConversationClient.GetConversationOfflinePushUserIDs, o.Client, c)
 }
+
+func (o *ConversationApi) GetIncrementalConversation(c *gin.Context) {
+	a2r.Call(conversationext.ConversationExtClient.GetIncrementalConversation, o.ExtClient, c)
+}
+
+func (o *ConversationApi) CreateConversationFolder(c *gin.Context) {
+	a2r.Call(conversationext.ConversationExtClient.CreateConversationFolder, o.ExtClient, c)
+}
+
+func (o *ConversationApi) SetConversationFolder(c *gin.Context) {
+	a2r.Call(conversationext.ConversationExtClient.SetConversationFolder, o.ExtClient, c)
+}
+
+func (o *ConversationApi) DeleteConversationFolder(c *gin.Context) {
+	a2r.Call(conversationext.ConversationExtClient.DeleteConversationFolder, o.ExtClient, c)
+}
+
+func (o *ConversationApi) SortConversationFolders(c *gin.Context) {
+	a2r.Call(conversationext.ConversationExtClient.SortConversationFolders, o.ExtClient, c)
+}
+
+func (o *ConversationApi) GetConversationFolders(c *gin.Context) {
+	a2r.Call(conversationext.ConversationExtClient.GetConversationFolders, o.ExtClient, c)
+}
+
+func (o *ConversationApi) AddFolderConversations(c *gin.Context) {
+	a2r.Call(conversationext.ConversationExtClient.AddFolderConversations, o.ExtClient, c)
+}
+
+func (o *ConversationApi) RemoveFolderConversations(c *gin.Context) {
+	a2r.Call(conversationext.ConversationExtClient.RemoveFolderConversations, o.ExtClient, c)
+}
+
+func (o *ConversationApi) GetFolderSortedConversationList(c *gin.Context) {
+	a2r.Call(conversationext.ConversationExtClient.GetFolderSortedConversationList, o.ExtClient, c)
+}
+
+func (o *ConversationApi) SetConversationDraft(c *gin.Context) {
+	a2r.Call(conversationext.ConversationExtClient.SetConversationDraft, o.ExtClient, c)
+}
+
+func (o *ConversationApi) GetConversationDrafts(c *gin.Context) {
+	a2r.Call(conversationext.ConversationExtClient.GetConversationDrafts, o.ExtClient, c)
+}
+
+func (o *ConversationApi) SetDoNotDisturb(c *gin.Context) {
+	a2r.Call(conversationext.ConversationExtClient.SetDoNotDisturb, o.ExtClient, c)
+}
+
+func (o *ConversationApi) GetDoNotDisturb(c *gin.Context) {
+	a2r.Call(conversationext.ConversationExtClient.GetDoNotDisturb, o.ExtClient, c)
+}
+
+func (o *ConversationApi) MuteConversationUntil(c *gin.Context) {
+	a2r.Call(conversationext.ConversationExtClient.MuteConversationUntil, o.ExtClient, c)
+}
@@ -16,6 +16,7 @@ package api
 
 import (
 	"github.com/gin-gonic/gin"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/friendext"
 	"github.com/openimsdk/open-im-server/v3/pkg/rpcclient"
 	"github.com/openimsdk/protocol/friend"
 	"github.com/openimsdk/tools/a2r"
@@ -93,3 +94,91 @@ func (o *FriendApi) GetSpecifiedFriendsInfo(c *gin.Context) {
 func (o *FriendApi) UpdateFriends(c *gin.Context) {
 	a2r.Call(friend.FriendClient.UpdateFriends, o.Client, c)
 }
+
+func (o *FriendApi) CreateFriendGroup(c *gin.Context) {
+	a2r.Call(friendext.FriendExtClient.CreateFriendGroup, o.ExtClient, c)
+}
+
+func (o *FriendApi) SetFriendGroup(c *gin.Context) {
+	a2r.Call(friendext.FriendExtClient.SetFriendGroup, o.ExtClient, c)
+}
+
+func (o *FriendApi) DeleteFriendGroup(c *gin.Context) {
+	a2r.Call(friendext.FriendExtClient.DeleteFriendGroup, o.ExtClient, c)
+}
+
+func (o *FriendApi) SortFriendGroups(c *gin.Context) {
+	a2r.Call(friendext.FriendExtClient.SortFriendGroups, o.ExtClient, c)
+}
+
+func (o *FriendApi) GetFriendGroups(c *gin.Context) {
+	a2r.Call(friendext.FriendExtClient.GetFriendGroups, o.ExtClient, c)
+}
+
+func (o *FriendApi) AddFriendsToGroup(c *gin.Context) {
+	a2r.Call(friendext.FriendExtClient.AddFriendsToGroup, o.ExtClient, c)
+}
+
+func (o *FriendApi) RemoveFriendsFromGroup(c *gin.Context) {
+	a2r.Call(friendext.FriendExtClient.RemoveFriendsFromGroup, o.ExtClient, c)
+}
+
+func (o *FriendApi) MoveFriendsToGroup(c *gin.Context) {
+	a2r.Call(friendext.FriendExtClient.MoveFriendsToGroup, o.ExtClient, c)
+}
+
+func (o *FriendApi) GetFriendsGroupIDs(c *gin.Context) {
+	a2r.Call(friendext.FriendExtClient.GetFriendsGroupIDs, o.ExtClient, c)
+}
+
+func (o *FriendApi) GetPaginationGroupFriends(c *gin.Context) {
+	a2r.Call(friendext.FriendExtClient.GetPaginationGroupFriends, o.ExtClient, c)
+}
+
+func (o *FriendApi) SetFriendAddSetting(c *gin.Context) {
+	a2r.Call(friendext.FriendExtClient.SetFriendAddSetting, o.ExtClient, c)
+}
+
+func (o *FriendApi) GetFriendAddSetting(c *gin.Context) {
+	a2r.Call(friendext.FriendExtClient.GetFriendAddSetting, o.ExtClient, c)
+}
+
+func (o *FriendApi) GetFriendAddPolicy(c *gin.Context) {
+	a2r.Call(friendext.FriendExtClient.GetFriendAddPolicy, o.ExtClient, c)
+}
+
+func (o *FriendApi) ApplyToAddFriendWithAnswer(c *gin.Context) {
+	a2r.Call(friendext.FriendExtClient.ApplyToAddFriendWithAnswer, o.ExtClient, c)
+}
+
+func (o *FriendApi) GetFriendRequestSenders(c *gin.Context) {
+	a2r.Call(friendext.FriendExtClient.GetFriendRequestSenders, o.ExtClient, c)
+}
+
+func (o *FriendApi) GetContactDiscoverySalt(c *gin.Context) {
+	a2r.Call(friendext.FriendExtClient.GetContactDiscoverySalt, o.ExtClient, c)
+}
+
+func (o *FriendApi) SetContactHashes(c *gin.Context) {
+	a2r.Call(friendext.FriendExtClient.SetContactHashes, o.ExtClient, c)
+}
+
+func (o *FriendApi) SetContactDiscoverySetting(c *gin.Context) {
+	a2r.Call(friendext.FriendExtClient.SetContactDiscoverySetting, o.ExtClient, c)
+}
+
+func (o *FriendApi) GetContactDiscoverySetting(c *gin.Context) {
+	a2r.Call(friendext.FriendExtClient.GetContactDiscoverySetting, o.ExtClient, c)
+}
+
+func (o *FriendApi) DiscoverContacts(c *gin.Context) {
+	a2r.Call(friendext.FriendExtClient.DiscoverContacts, o.ExtClient, c)
+}
+
+func (o *FriendApi) GetFriendSuggestions(c *gin.Context) {
+	a2r.Call(friendext.FriendExtClient.GetFriendSuggestions, o.ExtClient, c)
+}
+
+func (o *FriendApi) DismissFriendSuggestion(c *gin.Context) {
+	a2r.Call(friendext.FriendExtClient.DismissFriendSuggestion, o.ExtClient, c)
+}
@@ -16,6 +16,7 @@ package api
 
 import (
 	"github.com/gin-gonic/gin"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/groupext"
 	"github.com/openimsdk/open-im-server/v3/pkg/rpcclient"
 	"github.com/openimsdk/protocol/group"
 	"github.com/openimsdk/tools/a2r"
@@ -134,3 +135,139 @@ func (o *GroupApi) GetGroups(c *gin.Context) {
 func (o *GroupApi) GetGroupMemberUserIDs(c *gin.Context) {
 	a2r.Call(group.GroupClient.GetGroupMemberUserIDs, o.Client, c)
 }
+
+func (o *GroupApi) CreateGroupRole(c *gin.Context) {
+	a2r.Call(groupext.GroupExtClient.CreateGroupRole, o.ExtClient, c)
+}
+
+func (o *GroupApi) UpdateGroupRole(c *gin.Context) {
+	a2r.Call(groupext.GroupExtClient.UpdateGroupRole, o.ExtClient, c)
+}
+
+func (o *GroupApi) DeleteGroupRole(c *gin.Context) {
+	a2r.Call(groupext.GroupExtClient.DeleteGroupRole, o.ExtClient, c)
+}
+
+func (o *GroupApi) GetGroupRoles(c *gin.Context) {
+	a2r.Call(groupext.GroupExtClient.GetGroupRoles, o.ExtClient, c)
+}
+
+func (o *GroupApi) CreateGroupInviteLink(c *gin.Context) {
+	a2r.Call(groupext.GroupExtClient.CreateGroupInviteLink, o.ExtClient, c)
+}
+
+func (o *GroupApi) RevokeGroupInviteLink(c *gin.Context) {
+	a2r.Call(groupext.GroupExtClient.RevokeGroupInviteLink, o.ExtClient, c)
+}
+
+func (o *GroupApi) GetGroupInviteLinks(c *gin.Context) {
+	a2r.Call(groupext.GroupExtClient.GetGroupInviteLinks, o.ExtClient, c)
+}
+
+func (o *GroupApi) SetGroupMsgPolicy(c *gin.Context) {
+	a2r.Call(groupext.GroupExtClient.SetGroupMsgPolicy, o.ExtClient, c)
+}
+
+func (o *GroupApi) GetGroupMsgPolicy(c *gin.Context) {
+	a2r.Call(groupext.GroupExtClient.GetGroupMsgPolicy, o.ExtClient, c)
+}
+
+func (o *GroupApi) SetGroupJoinQuestionnaire(c *gin.Context) {
+	a2r.Call(groupext.GroupExtClient.SetGroupJoinQuestionnaire, o.ExtClient, c)
+}
+
+func (o *GroupApi) GetGroupJoinQuestionnaire(c *gin.Context) {
+	a2r.Call(groupext.GroupExtClient.GetGroupJoinQuestionnaire, o.ExtClient, c)
+}
+
+func (o *GroupApi) ApplyJoinGroup(c *gin.Context) {
+	a2r.Call(groupext.GroupExtClient.ApplyJoinGroup, o.ExtClient, c)
+}
+
+func (o *GroupApi) GetGroupRequestAnswers(c *gin.Context) {
+	a2r.Call(groupext.GroupExtClient.GetGroupRequestAnswers, o.ExtClient, c)
+}
+
+func (o *GroupApi) GetGroupRequestAudits(c *gin.Context) {
+	a2r.Call(groupext.GroupExtClient.GetGroupRequestAudits, o.ExtClient, c)
+}
+
+func (o *GroupApi) SetGroupDirectorySetting(c *gin.Context) {
+	a2r.Call(groupext.GroupExtClient.SetGroupDirectorySetting, o.ExtClient, c)
+}
+
+func (o *GroupApi) GetGroupDirectorySetting(c *gin.Context) {
+	a2r.Call(groupext.GroupExtClient.GetGroupDirectorySetting, o.ExtClient, c)
+}
+
+func (o *GroupApi) GetGroupDirectoryCategories(c *gin.Context) {
+	a2r.Call(groupext.GroupExtClient.GetGroupDirectoryCategories, o.ExtClient, c)
+}
+
+func (o *GroupApi) SearchGroupDirectory(c *gin.Context) {
+	a2r.Call(groupext.GroupExtClient.SearchGroupDirectory, o.ExtClient, c)
+}
+
+func (o *GroupApi) CreateCommunity(c *gin.Context) {
+	a2r.Call(groupext.GroupExtClient.CreateCommunity, o.ExtClient, c)
+}
+
+func (o *GroupApi) SetCommunityInfo(c *gin.Context) {
+	a2r.Call(groupext.GroupExtClient.SetCommunityInfo, o.ExtClient, c)
+}
+
+func (o *GroupApi) GetCommunitiesInfo(c *gin.Context) {
+	a2r.Call(groupext.GroupExtClient.GetCommunitiesInfo, o.ExtClient, c)
+}
+
+func (o *GroupApi) DismissCommunity(c *gin.Context) {
+	a2r.Call(groupext.GroupExtClient.DismissCommunity, o.ExtClient, c)
+}
+
+func (o *GroupApi) SetCommunityChannel(c *gin.Context) {
+	a2r.Call(groupext.GroupExtClient.SetCommunityChannel, o.ExtClient, c)
+}
+
+func (o *GroupApi) RemoveCommunityChannel(c *gin.Context) {
+	a2r.Call(groupext.GroupExtClient.RemoveCommunityChannel, o.ExtClient, c)
+}
+
+func (o *GroupApi) GetCommunityChannels(c *gin.Context) {
+	a2r.Call(groupext.GroupExtClient.GetCommunityChannels, o.ExtClient, c)
+}
+
+func (o *GroupApi) JoinCommunity(c *gin.Context) {
+	a2r.Call(groupext.GroupExtClient.JoinCommunity, o.ExtClient, c)
+}
+
+func (o *GroupApi) InviteToCommunity(c *gin.Context) {
+	a2r.Call(groupext.GroupExtClient.InviteToCommunity, o.ExtClient, c)
+}
+
+func (o *GroupApi) JoinCommunityChannel(c *gin.Context) {
+	a2r.Call(groupext.GroupExtClient.JoinCommunityChannel, o.ExtClient, c)
+}
+
+func (o *GroupApi) QuitCommunity(c *gin.Context) {
+	a2r.Call(groupext.GroupExtClient.QuitCommunity, o.ExtClient, c)
+}
+
+func (o *GroupApi) KickCommunityMember(c *gin.Context) {
+	a2r.Call(groupext.GroupExtClient.KickCommunityMember, o.ExtClient, c)
+}
+
+func (o *GroupApi) SetCommunityMemberRole(c *gin.Context) {
+	a2r.Call(groupext.GroupExtClient.SetCommunityMemberRole, o.ExtClient, c)
+}
+
+func (o *GroupApi) GetCommunityMembers(c *gin.Context) {
+	a2r.Call(groupext.GroupExtClient.GetCommunityMembers, o.ExtClient, c)
+}
+
+func (o *GroupApi) GetJoinedCommunities(c *gin.Context) {
+	a2r.Call(groupext.GroupExtClient.GetJoinedCommunities, o.ExtClient, c)
+}
+
+func (o *GroupApi) SendCommunityAnnouncement(c *gin.Context) {
+	a2r.Call(groupext.GroupExtClient.SendCommunityAnnouncement, o.ExtClient, c)
+}
@@ -66,7 +66,10 @@ func Start(ctx context.Context, index int, config *Config) error {
 		netErr  error
 	)
 
-	router := newGinRouter(client, config)
+	router, err := newGinRouter(client, config)
+	if err != nil {
+		return err
+	}
 	if config.API.Prometheus.Enable {
 		go func() {
 			p := ginprom.NewPrometheus("app", prommetrics.GetGinCusMetrics("Api"))
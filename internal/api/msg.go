@@ -21,6 +21,7 @@ import (
 	"github.com/openimsdk/open-im-server/v3/pkg/apistruct"
 	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/msgext"
 	"github.com/openimsdk/open-im-server/v3/pkg/rpcclient"
 	"github.com/openimsdk/protocol/constant"
 	"github.com/openimsdk/protocol/msg"
@@ -349,6 +350,51 @@ func (m *MessageApi) BatchSendMsg(c *gin.Context) {
 	apiresp.GinSuccess(c, resp)
 }
 
+func (m *MessageApi) SubmitBroadcast(c *gin.Context) {
+	var req apistruct.SubmitBroadcastReq
+	if err := c.BindJSON(&req); err != nil {
+		apiresp.GinError(c, errs.ErrArgs.WithDetail(err.Error()).Wrap())
+		return
+	}
+	if err := authverify.CheckAdmin(c, m.imAdminUserID); err != nil {
+		apiresp.GinError(c, errs.ErrNoPermission.WrapMsg("only app manager can send message"))
+		return
+	}
+	sendMsgReq, err := m.getSendMsgReq(c, req.SendMsg)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	resp, err := m.ExtClient.SubmitBroadcast(c, &msgext.SubmitBroadcastReq{
+		MsgData:        sendMsgReq.MsgData,
+		Mode:           req.Mode,
+		RecvIDs:        req.RecvIDs,
+		FilterUserID:   req.FilterUserID,
+		FilterNickname: req.FilterNickname,
+	})
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	apiresp.GinSuccess(c, resp)
+}
+
+func (m *MessageApi) GetBroadcast(c *gin.Context) {
+	a2r.Call(msgext.MsgExtClient.GetBroadcast, m.ExtClient, c)
+}
+
+func (m *MessageApi) GetBroadcasts(c *gin.Context) {
+	a2r.Call(msgext.MsgExtClient.GetBroadcasts, m.ExtClient, c)
+}
+
+func (m *MessageApi) GetBroadcastFailedIDs(c *gin.Context) {
+	a2r.Call(msgext.MsgExtClient.GetBroadcastFailedIDs, m.ExtClient, c)
+}
+
+func (m *MessageApi) CancelBroadcast(c *gin.Context) {
+	a2r.Call(msgext.MsgExtClient.CancelBroadcast, m.ExtClient, c)
+}
+
 func (m *MessageApi) CheckMsgIsSendSuccess(c *gin.Context) {
 	a2r.Call(msg.MsgClient.GetSendMsgStatus, m.Client, c)
 }
@@ -372,3 +418,39 @@ func (m *MessageApi) SearchMsg(c *gin.Context) {
 func (m *MessageApi) GetServerTime(c *gin.Context) {
 	a2r.Call(msg.MsgClient.GetServerTime, m.Client, c)
 }
+
+func (m *MessageApi) PinMsg(c *gin.Context) {
+	a2r.Call(msgext.MsgExtClient.PinMsg, m.ExtClient, c)
+}
+
+func (m *MessageApi) UnpinMsg(c *gin.Context) {
+	a2r.Call(msgext.MsgExtClient.UnpinMsg, m.ExtClient, c)
+}
+
+func (m *MessageApi) GetPinnedMsgs(c *gin.Context) {
+	a2r.Call(msgext.MsgExtClient.GetPinnedMsgs, m.ExtClient, c)
+}
+
+func (m *MessageApi) CreatePoll(c *gin.Context) {
+	a2r.Call(msgext.MsgExtClient.CreatePoll, m.ExtClient, c)
+}
+
+func (m *MessageApi) VotePoll(c *gin.Context) {
+	a2r.Call(msgext.MsgExtClient.VotePoll, m.ExtClient, c)
+}
+
+func (m *MessageApi) RetractPollVote(c *gin.Context) {
+	a2r.Call(msgext.MsgExtClient.RetractPollVote, m.ExtClient, c)
+}
+
+func (m *MessageApi) GetPoll(c *gin.Context) {
+	a2r.Call(msgext.MsgExtClient.GetPoll, m.ExtClient, c)
+}
+
+func (m *MessageApi) GetPollVoters(c *gin.Context) {
+	a2r.Call(msgext.MsgExtClient.GetPollVoters, m.ExtClient, c)
+}
+
+func (m *MessageApi) GetConversationsUnreadCount(c *gin.Context) {
+	a2r.Call(msgext.MsgExtClient.GetConversationsUnreadCount, m.ExtClient, c)
+}
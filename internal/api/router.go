@@ -6,10 +6,12 @@ import (
 	"github.com/gin-gonic/gin/binding"
 	"github.com/go-playground/validator/v10"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/servererrs"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/authext"
 	"github.com/openimsdk/open-im-server/v3/pkg/rpcclient"
 	"github.com/openimsdk/protocol/constant"
 	"github.com/openimsdk/tools/apiresp"
 	"github.com/openimsdk/tools/discovery"
+	"github.com/openimsdk/tools/errs"
 	"github.com/openimsdk/tools/log"
 	"github.com/openimsdk/tools/mw"
 	"google.golang.org/grpc"
@@ -18,11 +20,15 @@ import (
 	"strings"
 )
 
-func newGinRouter(disCov discovery.SvcDiscoveryRegistry, config *Config) *gin.Engine {
+func newGinRouter(disCov discovery.SvcDiscoveryRegistry, config *Config) (*gin.Engine, error) {
 	disCov.AddOption(mw.GrpcClient(), grpc.WithTransportCredentials(insecure.NewCredentials()),
 		grpc.WithDefaultServiceConfig(fmt.Sprintf(`{"LoadBalancingPolicy": "%s"}`, "round_robin")))
 	gin.SetMode(gin.ReleaseMode)
 	r := gin.New()
+	// without trusted proxies ClientIP is the peer address, so X-Forwarded-For cannot bypass api key IP allowlists
+	if err := r.SetTrustedProxies(config.API.Api.TrustedProxies); err != nil {
+		return nil, errs.WrapMsg(err, "invalid api trustedProxies")
+	}
 	if v, ok := binding.Validator.Engine().(*validator.Validate); ok {
 		_ = v.RegisterValidation("required_if", RequiredIf)
 	}
@@ -37,7 +43,7 @@ func newGinRouter(disCov discovery.SvcDiscoveryRegistry, config *Config) *gin.En
 	thirdRpc := rpcclient.NewThird(disCov, config.Share.RpcRegisterName.Third, config.API.Prometheus.GrafanaURL)
 
 	r.Use(gin.Recovery(), mw.CorsHandler(), mw.GinParseOperationID(), GinParseToken(authRpc))
-	u := NewUserApi(*userRpc)
+	u := NewUserApi(*userRpc, config.Share.Secret)
 	m := NewMessageApi(messageRpc, userRpc, config.Share.IMAdminUserID)
 	userRouterGroup := r.Group("/user")
 	{
@@ -64,6 +70,12 @@ func newGinRouter(disCov discovery.SvcDiscoveryRegistry, config *Config) *gin.En
 		userRouterGroup.POST("/add_notification_account", u.AddNotificationAccount)
 		userRouterGroup.POST("/update_notification_account", u.UpdateNotificationAccountInfo)
 		userRouterGroup.POST("/search_notification_account", u.SearchNotificationAccount)
+
+		userRouterGroup.POST("/request_account_deletion", u.RequestAccountDeletion)
+		userRouterGroup.POST("/cancel_account_deletion", u.CancelAccountDeletion)
+		userRouterGroup.POST("/get_account_deletion", u.GetAccountDeletion)
+		userRouterGroup.POST("/request_data_export", u.RequestDataExport)
+		userRouterGroup.POST("/get_data_exports", u.GetDataExports)
 	}
 	// friend routing group
 	friendRouterGroup := r.Group("/friend")
@@ -86,6 +98,28 @@ func newGinRouter(disCov discovery.SvcDiscoveryRegistry, config *Config) *gin.En
 		friendRouterGroup.POST("/get_friend_id", f.GetFriendIDs)
 		friendRouterGroup.POST("/get_specified_friends_info", f.GetSpecifiedFriendsInfo)
 		friendRouterGroup.POST("/update_friends", f.UpdateFriends)
+		friendRouterGroup.POST("/create_friend_group", f.CreateFriendGroup)
+		friendRouterGroup.POST("/set_friend_group", f.SetFriendGroup)
+		friendRouterGroup.POST("/delete_friend_group", f.DeleteFriendGroup)
+		friendRouterGroup.POST("/sort_friend_groups", f.SortFriendGroups)
+		friendRouterGroup.POST("/get_friend_groups", f.GetFriendGroups)
+		friendRouterGroup.POST("/add_friends_to_group", f.AddFriendsToGroup)
+		friendRouterGroup.POST("/remove_friends_from_group", f.RemoveFriendsFromGroup)
+		friendRouterGroup.POST("/move_friends_to_group", f.MoveFriendsToGroup)
+		friendRouterGroup.POST("/get_friends_group_ids", f.GetFriendsGroupIDs)
+		friendRouterGroup.POST("/get_pagination_group_friends", f.GetPaginationGroupFriends)
+		friendRouterGroup.POST("/set_friend_add_setting", f.SetFriendAddSetting)
+		friendRouterGroup.POST("/get_friend_add_setting", f.GetFriendAddSetting)
+		friendRouterGroup.POST("/get_friend_add_policy", f.GetFriendAddPolicy)
+		friendRouterGroup.POST("/add_friend_with_answer", f.ApplyToAddFriendWithAnswer)
+		friendRouterGroup.POST("/get_friend_request_senders", f.GetFriendRequestSenders)
+		friendRouterGroup.POST("/get_contact_discovery_salt", f.GetContactDiscoverySalt)
+		friendRouterGroup.POST("/set_contact_hashes", f.SetContactHashes)
+		friendRouterGroup.POST("/set_contact_discovery_setting", f.SetContactDiscoverySetting)
+		friendRouterGroup.POST("/get_contact_discovery_setting", f.GetContactDiscoverySetting)
+		friendRouterGroup.POST("/discover_contacts", f.DiscoverContacts)
+		friendRouterGroup.POST("/get_friend_suggestions", f.GetFriendSuggestions)
+		friendRouterGroup.POST("/dismiss_friend_suggestion", f.DismissFriendSuggestion)
 	}
 	g := NewGroupApi(*groupRpc)
 	groupRouterGroup := r.Group("/group")
@@ -114,15 +148,58 @@ func newGinRouter(disCov discovery.SvcDiscoveryRegistry, config *Config) *gin.En
 		groupRouterGroup.POST("/get_group_abstract_info", g.GetGroupAbstractInfo)
 		groupRouterGroup.POST("/get_groups", g.GetGroups)
 		groupRouterGroup.POST("/get_group_member_user_id", g.GetGroupMemberUserIDs)
+		groupRouterGroup.POST("/create_group_role", g.CreateGroupRole)
+		groupRouterGroup.POST("/update_group_role", g.UpdateGroupRole)
+		groupRouterGroup.POST("/delete_group_role", g.DeleteGroupRole)
+		groupRouterGroup.POST("/get_group_roles", g.GetGroupRoles)
+		groupRouterGroup.POST("/create_group_invite_link", g.CreateGroupInviteLink)
+		groupRouterGroup.POST("/revoke_group_invite_link", g.RevokeGroupInviteLink)
+		groupRouterGroup.POST("/get_group_invite_links", g.GetGroupInviteLinks)
+		groupRouterGroup.POST("/set_group_msg_policy", g.SetGroupMsgPolicy)
+		groupRouterGroup.POST("/get_group_msg_policy", g.GetGroupMsgPolicy)
+		groupRouterGroup.POST("/set_group_join_questionnaire", g.SetGroupJoinQuestionnaire)
+		groupRouterGroup.POST("/get_group_join_questionnaire", g.GetGroupJoinQuestionnaire)
+		groupRouterGroup.POST("/apply_join_group", g.ApplyJoinGroup)
+		groupRouterGroup.POST("/get_group_request_answers", g.GetGroupRequestAnswers)
+		groupRouterGroup.POST("/get_group_request_audits", g.GetGroupRequestAudits)
+		groupRouterGroup.POST("/set_group_directory_setting", g.SetGroupDirectorySetting)
+		groupRouterGroup.POST("/get_group_directory_setting", g.GetGroupDirectorySetting)
+		groupRouterGroup.POST("/get_group_directory_categories", g.GetGroupDirectoryCategories)
+		groupRouterGroup.POST("/search_group_directory", g.SearchGroupDirectory)
+		groupRouterGroup.POST("/create_community", g.CreateCommunity)
+		groupRouterGroup.POST("/set_community_info", g.SetCommunityInfo)
+		groupRouterGroup.POST("/get_communities_info", g.GetCommunitiesInfo)
+		groupRouterGroup.POST("/dismiss_community", g.DismissCommunity)
+		groupRouterGroup.POST("/set_community_channel", g.SetCommunityChannel)
+		groupRouterGroup.POST("/remove_community_channel", g.RemoveCommunityChannel)
+		groupRouterGroup.POST("/get_community_channels", g.GetCommunityChannels)
+		groupRouterGroup.POST("/join_community", g.JoinCommunity)
+		groupRouterGroup.POST("/invite_to_community", g.InviteToCommunity)
+		groupRouterGroup.POST("/join_community_channel", g.JoinCommunityChannel)
+		groupRouterGroup.POST("/quit_community", g.QuitCommunity)
+		groupRouterGroup.POST("/kick_community_member", g.KickCommunityMember)
+		groupRouterGroup.POST("/set_community_member_role", g.SetCommunityMemberRole)
+		groupRouterGroup.POST("/get_community_members", g.GetCommunityMembers)
+		groupRouterGroup.POST("/get_joined_communities", g.GetJoinedCommunities)
+		groupRouterGroup.POST("/send_community_announcement", g.SendCommunityAnnouncement)
 	}
 	// certificate
 	authRouterGroup := r.Group("/auth")
 	{
 		a := NewAuthApi(*authRpc)
 		authRouterGroup.POST("/user_token", a.UserToken)
+		authRouterGroup.POST("/refresh_token", a.RefreshToken)
+		authRouterGroup.POST("/oidc_login", a.OidcLogin)
 		authRouterGroup.POST("/get_user_token", a.GetUserToken)
 		authRouterGroup.POST("/parse_token", a.ParseToken)
 		authRouterGroup.POST("/force_logout", a.ForceLogout)
+		authRouterGroup.POST("/get_sessions", a.GetSessions)
+		authRouterGroup.POST("/revoke_session", a.RevokeSession)
+		authRouterGroup.POST("/create_api_key", a.CreateAPIKey)
+		authRouterGroup.POST("/revoke_api_key", a.RevokeAPIKey)
+		authRouterGroup.POST("/get_api_keys", a.GetAPIKeys)
+		authRouterGroup.POST("/get_api_key_audits", a.GetAPIKeyAudits)
+		authRouterGroup.GET("/jwks", a.GetJWKS)
 	}
 	// Third service
 	thirdGroup := r.Group("/third")
@@ -161,6 +238,7 @@ func newGinRouter(disCov discovery.SvcDiscoveryRegistry, config *Config) *gin.En
 		msgGroup.POST("/mark_msgs_as_read", m.MarkMsgsAsRead)
 		msgGroup.POST("/mark_conversation_as_read", m.MarkConversationAsRead)
 		msgGroup.POST("/get_conversations_has_read_and_max_seq", m.GetConversationsHasReadAndMaxSeq)
+		msgGroup.POST("/get_conversations_unread_count", m.GetConversationsUnreadCount)
 		msgGroup.POST("/set_conversation_has_read_seq", m.SetConversationHasReadSeq)
 
 		msgGroup.POST("/clear_conversation_msg", m.ClearConversationsMsg)
@@ -171,6 +249,19 @@ func newGinRouter(disCov discovery.SvcDiscoveryRegistry, config *Config) *gin.En
 
 		msgGroup.POST("/batch_send_msg", m.BatchSendMsg)
 		msgGroup.POST("/check_msg_is_send_success", m.CheckMsgIsSendSuccess)
+		msgGroup.POST("/submit_broadcast", m.SubmitBroadcast)
+		msgGroup.POST("/get_broadcast", m.GetBroadcast)
+		msgGroup.POST("/get_broadcasts", m.GetBroadcasts)
+		msgGroup.POST("/get_broadcast_failed_ids", m.GetBroadcastFailedIDs)
+		msgGroup.POST("/cancel_broadcast", m.CancelBroadcast)
+		msgGroup.POST("/pin_msg", m.PinMsg)
+		msgGroup.POST("/unpin_msg", m.UnpinMsg)
+		msgGroup.POST("/get_pinned_msgs", m.GetPinnedMsgs)
+		msgGroup.POST("/create_poll", m.CreatePoll)
+		msgGroup.POST("/vote_poll", m.VotePoll)
+		msgGroup.POST("/retract_poll_vote", m.RetractPollVote)
+		msgGroup.POST("/get_poll", m.GetPoll)
+		msgGroup.POST("/get_poll_voters", m.GetPollVoters)
 		msgGroup.POST("/get_server_time", m.GetServerTime)
 	}
 	// Conversation
@@ -179,10 +270,24 @@ func newGinRouter(disCov discovery.SvcDiscoveryRegistry, config *Config) *gin.En
 		c := NewConversationApi(*conversationRpc)
 		conversationGroup.POST("/get_sorted_conversation_list", c.GetSortedConversationList)
 		conversationGroup.POST("/get_all_conversations", c.GetAllConversations)
+		conversationGroup.POST("/get_incremental_conversation", c.GetIncrementalConversation)
 		conversationGroup.POST("/get_conversation", c.GetConversation)
 		conversationGroup.POST("/get_conversations", c.GetConversations)
 		conversationGroup.POST("/set_conversations", c.SetConversations)
 		conversationGroup.POST("/get_conversation_offline_push_user_ids", c.GetConversationOfflinePushUserIDs)
+		conversationGroup.POST("/create_folder", c.CreateConversationFolder)
+		conversationGroup.POST("/set_folder", c.SetConversationFolder)
+		conversationGroup.POST("/delete_folder", c.DeleteConversationFolder)
+		conversationGroup.POST("/sort_folders", c.SortConversationFolders)
+		conversationGroup.POST("/get_folders", c.GetConversationFolders)
+		conversationGroup.POST("/add_folder_conversations", c.AddFolderConversations)
+		conversationGroup.POST("/remove_folder_conversations", c.RemoveFolderConversations)
+		conversationGroup.POST("/get_folder_sorted_conversation_list", c.GetFolderSortedConversationList)
+		conversationGroup.POST("/set_draft", c.SetConversationDraft)
+		conversationGroup.POST("/get_drafts", c.GetConversationDrafts)
+		conversationGroup.POST("/set_do_not_disturb", c.SetDoNotDisturb)
+		conversationGroup.POST("/get_do_not_disturb", c.GetDoNotDisturb)
+		conversationGroup.POST("/mute_conversation_until", c.MuteConversationUntil)
 	}
 
 	statisticsGroup := r.Group("/statistics")
@@ -192,7 +297,7 @@ func newGinRouter(disCov discovery.SvcDiscoveryRegistry, config *Config) *gin.En
 		statisticsGroup.POST("/group/create", g.GroupCreateCount)
 		statisticsGroup.POST("/group/active", m.GetActiveGroup)
 	}
-	return r
+	return r, nil
 }
 
 func GinParseToken(authRPC *rpcclient.Auth) gin.HandlerFunc {
@@ -201,6 +306,10 @@ func GinParseToken(authRPC *rpcclient.Auth) gin.HandlerFunc {
 		case http.MethodPost:
 			for _, wApi := range Whitelist {
 				if strings.HasPrefix(c.Request.URL.Path, wApi) {
+					if apiKey := c.Request.Header.Get(APIKeyHeader); apiKey != "" {
+						parseAPIKey(c, authRPC, apiKey)
+						return
+					}
 					c.Next()
 					return
 				}
@@ -208,6 +317,10 @@ func GinParseToken(authRPC *rpcclient.Auth) gin.HandlerFunc {
 
 			token := c.Request.Header.Get(constant.Token)
 			if token == "" {
+				if apiKey := c.Request.Header.Get(APIKeyHeader); apiKey != "" {
+					parseAPIKey(c, authRPC, apiKey)
+					return
+				}
 				log.ZWarn(c, "header get token error", servererrs.ErrArgs.WrapMsg("header must have token"))
 				apiresp.GinError(c, servererrs.ErrArgs.WrapMsg("header must have token"))
 				c.Abort()
@@ -226,9 +339,33 @@ func GinParseToken(authRPC *rpcclient.Auth) gin.HandlerFunc {
 	}
 }
 
+// parseAPIKey authorizes a request made with an API key, which is executed as the admin user
+// when the key holds the scope of the API.
+func parseAPIKey(c *gin.Context, authRPC *rpcclient.Auth, apiKey string) {
+	scope, ok := apiKeyScopes[c.Request.URL.Path]
+	if !ok {
+		apiresp.GinError(c, servererrs.ErrNoPermission.WrapMsg("api not allowed for api key", "path", c.Request.URL.Path))
+		c.Abort()
+		return
+	}
+	resp, err := authRPC.ExtClient.VerifyAPIKey(c, &authext.VerifyAPIKeyReq{ApiKey: apiKey, Ip: c.ClientIP(), Scope: scope})
+	if err != nil {
+		apiresp.GinError(c, err)
+		c.Abort()
+		return
+	}
+	log.ZDebug(c, "api key request", "keyID", resp.KeyID, "scope", scope)
+	c.Set(constant.OpUserPlatform, constant.AdminPlatformStr)
+	c.Set(constant.OpUserID, resp.OpUserID)
+	c.Set(apiKeyScopeKey, scope)
+	c.Next()
+}
+
 // Whitelist api not parse token
 var Whitelist = []string{
 	"/user/user_register",
 	"/auth/user_token",
+	"/auth/refresh_token",
+	"/auth/oidc_login",
 	"/auth/parse_token",
 }
@@ -16,6 +16,8 @@ package api
 
 import (
 	"github.com/gin-gonic/gin"
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/userext"
 	"github.com/openimsdk/open-im-server/v3/pkg/rpcclient"
 	"github.com/openimsdk/protocol/constant"
 	"github.com/openimsdk/protocol/msggateway"
@@ -26,14 +28,26 @@ import (
 	"github.com/openimsdk/tools/log"
 )
 
-type UserApi rpcclient.User
+type UserApi struct {
+	rpcclient.User
+	// secret is filled into registrations made with an API key of the user:register scope.
+	secret string
+}
 
-func NewUserApi(client rpcclient.User) UserApi {
-	return UserApi(client)
+func NewUserApi(client rpcclient.User, secret string) UserApi {
+	return UserApi{User: client, secret: secret}
 }
 
 func (u *UserApi) UserRegister(c *gin.Context) {
-	a2r.Call(user.UserClient.UserRegister, u.Client, c)
+	a2r.Call(user.UserClient.UserRegister, u.Client, c, &a2r.Option[user.UserRegisterReq, user.UserRegisterResp]{
+		BindAfter: func(req *user.UserRegisterReq) error {
+			// only the API key bypasses the secret, admin user tokens still have to present it
+			if c.GetString(apiKeyScopeKey) == authverify.ScopeUserRegister {
+				req.Secret = u.secret
+			}
+			return nil
+		},
+	})
 }
 
 func (u *UserApi) UpdateUserInfo(c *gin.Context) {
@@ -235,3 +249,26 @@ func (u *UserApi) UpdateNotificationAccountInfo(c *gin.Context) {
 func (u *UserApi) SearchNotificationAccount(c *gin.Context) {
 	a2r.Call(user.UserClient.SearchNotificationAccount, u.Client, c)
 }
+
+func (u *UserApi) RequestAccountDeletion(c *gin.Context) {
+	a2r.Call(userext.UserExtClient.RequestAccountDeletion, u.ExtClient, c)
+}
+
+func (u *UserApi) CancelAccountDeletion(c *gin.Context) {
+	a2r.Call(userext.UserExtClient.CancelAccountDeletion, u.ExtClient, c)
+}
+
+func (u *UserApi) GetAccountDeletion(c *gin.Context) {
+	a2r.Call(userext.UserExtClient.GetAccountDeletion, u.ExtClient, c)
+}
+
+func (u *UserApi) RequestDataExport(c *gin.Context) {
+	a2r.Call(userext.UserExtClient.RequestDataExport, u.ExtClient, c)
+}
+
+func (u *UserApi) GetDataExports(c *gin.Context) {
+	opt := setURLPrefixOption(userext.UserExtClient.GetDataExports, func(req *userext.GetDataExportsReq) error {
+		return setURLPrefix(c, &req.UrlPrefix)
+	})
+	a2r.Call(userext.UserExtClient.GetDataExports, u.ExtClient, c, opt)
+}
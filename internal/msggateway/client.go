@@ -20,6 +20,7 @@ import (
 	"runtime/debug"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/openimsdk/open-im-server/v3/pkg/msgprocessor"
 	"github.com/openimsdk/protocol/constant"
@@ -72,6 +73,8 @@ type Client struct {
 	closed         atomic.Bool
 	closedErr      error
 	token          string
+	// sessionTouched is when the session of the token was last refreshed.
+	sessionTouched time.Time
 }
 
 // ResetClient updates the client's state with new connection and context information.
@@ -88,12 +91,14 @@ func (c *Client) ResetClient(ctx *UserConnContext, conn LongConn, longConnServer
 	c.closed.Store(false)
 	c.closedErr = nil
 	c.token = ctx.GetToken()
+	c.sessionTouched = time.Now()
 }
 
 func (c *Client) pingHandler(_ string) error {
 	if err := c.conn.SetReadDeadline(pongWait); err != nil {
 		return err
 	}
+	c.longConnServer.heartbeat(c)
 
 	return c.writePongMsg()
 }
@@ -127,6 +132,7 @@ func (c *Client) readMessage() {
 			c.closedErr = ErrConnClosed
 			return
 		}
+		c.longConnServer.heartbeat(c)
 
 		switch messageType {
 		case MessageBinary:
@@ -19,18 +19,21 @@ import (
 	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/servererrs"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/startrpc"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/msggatewayext"
 	"github.com/openimsdk/protocol/constant"
 	"github.com/openimsdk/protocol/msggateway"
 	"github.com/openimsdk/tools/discovery"
 	"github.com/openimsdk/tools/errs"
 	"github.com/openimsdk/tools/log"
 	"github.com/openimsdk/tools/mcontext"
+	"github.com/openimsdk/tools/utils/datautil"
 	"google.golang.org/grpc"
 )
 
 func (s *Server) InitServer(ctx context.Context, config *Config, disCov discovery.SvcDiscoveryRegistry, server *grpc.Server) error {
 	s.LongConnServer.SetDiscoveryRegistry(disCov, config)
 	msggateway.RegisterMsgGatewayServer(server, s)
+	msggatewayext.RegisterMsgGatewayExtServer(server, s)
 	return nil
 }
 
@@ -175,22 +178,35 @@ func (s *Server) KickUserOffline(
 	req *msggateway.KickUserOfflineReq,
 ) (*msggateway.KickUserOfflineResp, error) {
 	for _, v := range req.KickUserIDList {
-		clients, _, ok := s.LongConnServer.GetUserPlatformCons(v, int(req.PlatformID))
-		if !ok {
-			log.ZDebug(ctx, "conn not exist", "userID", v, "platformID", req.PlatformID)
-			continue
-		}
+		s.kickUserOffline(ctx, v, req.PlatformID, nil)
+	}
 
-		for _, client := range clients {
-			log.ZDebug(ctx, "kick user offline", "userID", v, "platformID", req.PlatformID, "client", client)
-			if err := client.longConnServer.KickUserConn(client); err != nil {
-				log.ZWarn(ctx, "kick user offline failed", err, "userID", v, "platformID", req.PlatformID)
+	return &msggateway.KickUserOfflineResp{}, nil
+}
+
+func (s *Server) KickConnOffline(ctx context.Context, req *msggatewayext.KickConnOfflineReq) (*msggatewayext.KickConnOfflineResp, error) {
+	s.kickUserOffline(ctx, req.UserID, req.PlatformID, datautil.SliceSet(req.ConnIDs))
+	return &msggatewayext.KickConnOfflineResp{}, nil
+}
+
+// kickUserOffline kicks the connections of the user on the platform, only those in connIDs when it is not nil.
+func (s *Server) kickUserOffline(ctx context.Context, userID string, platformID int32, connIDs map[string]struct{}) {
+	clients, _, ok := s.LongConnServer.GetUserPlatformCons(userID, int(platformID))
+	if !ok {
+		log.ZDebug(ctx, "conn not exist", "userID", userID, "platformID", platformID)
+		return
+	}
+	for _, client := range clients {
+		if connIDs != nil {
+			if _, ok := connIDs[client.ctx.GetConnID()]; !ok {
+				continue
 			}
 		}
-		continue
+		log.ZDebug(ctx, "kick user offline", "userID", userID, "platformID", platformID, "client", client)
+		if err := client.longConnServer.KickUserConn(client); err != nil {
+			log.ZWarn(ctx, "kick user offline failed", err, "userID", userID, "platformID", platformID)
+		}
 	}
-
-	return &msggateway.KickUserOfflineResp{}, nil
 }
 
 func (s *Server) MultiTerminalLoginCheck(ctx context.Context, req *msggateway.MultiTerminalLoginCheckReq) (*msggateway.MultiTerminalLoginCheckResp, error) {
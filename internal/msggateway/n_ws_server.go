@@ -47,6 +47,7 @@ type LongConnServer interface {
 	SetDiscoveryRegistry(client discovery.SvcDiscoveryRegistry, config *Config)
 	KickUserConn(client *Client) error
 	UnRegister(c *Client)
+	heartbeat(client *Client)
 	SetKickHandlerInfo(i *kickHandler)
 	Compressor
 	Encoder
@@ -69,6 +70,7 @@ type WsServer struct {
 	validate          *validator.Validate
 	userClient        *rpcclient.UserRpcClient
 	authClient        *rpcclient.Auth
+	trustedProxies    trustedProxies
 	disCov            discovery.SvcDiscoveryRegistry
 	Compressor
 	Encoder
@@ -125,8 +127,13 @@ func NewWsServer(msgGatewayConfig *Config, opts ...Option) (*WsServer, error) {
 		o(&config)
 	}
 	v := validator.New()
+	proxies, err := parseTrustedProxies(msgGatewayConfig.MsgGateway.LongConnSvr.TrustedProxies)
+	if err != nil {
+		return nil, err
+	}
 	return &WsServer{
 		msgGatewayConfig: msgGatewayConfig,
+		trustedProxies:   proxies,
 		port:             config.port,
 		wsMaxConnNum:     config.maxConnNum,
 		writeBufferSize:  config.writeBufferSize,
@@ -284,6 +291,12 @@ func (ws *WsServer) registerClient(client *Client) {
 		ws.SetUserOnlineStatus(client.ctx, client, constant.Online)
 	}()
 
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		ws.touchSession(client.ctx, ws.sessionTouchReq(client))
+	}()
+
 	wg.Wait()
 
 	log.ZInfo(
@@ -352,6 +365,7 @@ func (ws *WsServer) unregisterClient(client *Client) {
 	}
 	ws.onlineUserConnNum.Add(-1)
 	ws.SetUserOnlineStatus(client.ctx, client, constant.Offline)
+	go ws.touchSession(client.ctx, ws.sessionTouchReq(client))
 	log.ZInfo(client.ctx, "user offline", "close reason", client.closedErr, "online user Num",
 		ws.onlineUserNum.Load(), "online user conn Num",
 		ws.onlineUserConnNum.Load(),
@@ -0,0 +1,124 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msggateway
+
+import (
+	"context"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/authext"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+)
+
+// sessionTouchInterval limits how often traffic on a connection refreshes the last seen time of its session.
+const sessionTouchInterval = time.Minute
+
+// trustedProxies are the peers whose X-Forwarded-For header is used to find the client address.
+type trustedProxies []*net.IPNet
+
+// parseTrustedProxies parses IP addresses and CIDR ranges.
+func parseTrustedProxies(proxies []string) (trustedProxies, error) {
+	res := make(trustedProxies, 0, len(proxies))
+	for _, proxy := range proxies {
+		if !strings.Contains(proxy, "/") {
+			ip := net.ParseIP(proxy)
+			if ip == nil {
+				return nil, errs.New("invalid trusted proxy", "proxy", proxy).Wrap()
+			}
+			bits := 8 * net.IPv6len
+			if ip4 := ip.To4(); ip4 != nil {
+				ip, bits = ip4, 8*net.IPv4len
+			}
+			res = append(res, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+		_, ipNet, err := net.ParseCIDR(proxy)
+		if err != nil {
+			return nil, errs.WrapMsg(err, "invalid trusted proxy", "proxy", proxy)
+		}
+		res = append(res, ipNet)
+	}
+	return res, nil
+}
+
+func (p trustedProxies) contains(addr string) bool {
+	ip := net.ParseIP(addr)
+	if ip == nil {
+		return false
+	}
+	for _, ipNet := range p {
+		if ipNet.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// clientIP returns the address of the peer, or the client address forwarded by trusted proxies.
+// X-Forwarded-For is read from the right, so entries added by the client itself are never trusted.
+func (p trustedProxies) clientIP(remoteAddr string, forwarded string) string {
+	ip, _, err := net.SplitHostPort(remoteAddr)
+	if err != nil {
+		ip = remoteAddr
+	}
+	if forwarded == "" || !p.contains(ip) {
+		return ip
+	}
+	addrs := strings.Split(forwarded, ",")
+	for i := len(addrs) - 1; i >= 0; i-- {
+		addr := strings.TrimSpace(addrs[i])
+		if net.ParseIP(addr) == nil {
+			break
+		}
+		ip = addr
+		if !p.contains(addr) {
+			break
+		}
+	}
+	return ip
+}
+
+// sessionTouchReq describes the connection of client for the session of its token.
+func (ws *WsServer) sessionTouchReq(client *Client) *authext.TouchSessionReq {
+	forwarded, _ := client.ctx.GetHeader("X-Forwarded-For")
+	userAgent, _ := client.ctx.GetHeader("User-Agent")
+	return &authext.TouchSessionReq{
+		Token:     client.ctx.GetToken(),
+		ConnID:    client.ctx.GetConnID(),
+		Ip:        ws.trustedProxies.clientIP(client.ctx.GetRemoteAddr(), forwarded),
+		UserAgent: userAgent,
+	}
+}
+
+// touchSession records the connection on the session of the client token, so the session can be kicked on its own.
+func (ws *WsServer) touchSession(ctx context.Context, req *authext.TouchSessionReq) {
+	if _, err := ws.authClient.ExtClient.TouchSession(ctx, req); err != nil {
+		log.ZWarn(ctx, "TouchSession err", err, "connID", req.ConnID)
+	}
+}
+
+// heartbeat refreshes the last seen time of the client session, at most once per sessionTouchInterval.
+// It is called from the read loop of the client, the request is built there so the client may be reused afterwards.
+func (ws *WsServer) heartbeat(client *Client) {
+	now := time.Now()
+	if now.Sub(client.sessionTouched) < sessionTouchInterval {
+		return
+	}
+	client.sessionTouched = now
+	go ws.touchSession(client.ctx, ws.sessionTouchReq(client))
+}
@@ -0,0 +1,43 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msggateway
+
+import "testing"
+
+func TestTrustedProxiesClientIP(t *testing.T) {
+	proxies, err := parseTrustedProxies([]string{"10.0.0.0/8", "192.168.1.1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, c := range []struct {
+		remoteAddr, forwarded, want string
+	}{
+		{"203.0.113.5:4000", "", "203.0.113.5"},
+		// an untrusted peer cannot choose its address
+		{"203.0.113.5:4000", "1.2.3.4", "203.0.113.5"},
+		{"10.1.2.3:4000", "198.51.100.7", "198.51.100.7"},
+		// entries left of the first untrusted hop are written by the client
+		{"10.1.2.3:4000", "1.2.3.4, 198.51.100.7, 192.168.1.1", "198.51.100.7"},
+		{"192.168.1.1:4000", "10.9.9.9", "10.9.9.9"},
+		{"10.1.2.3:4000", "garbage", "10.1.2.3"},
+	} {
+		if got := proxies.clientIP(c.remoteAddr, c.forwarded); got != c.want {
+			t.Errorf("clientIP(%q, %q) = %q, want %q", c.remoteAddr, c.forwarded, got, c.want)
+		}
+	}
+	if _, err := parseTrustedProxies([]string{"not-an-ip"}); err == nil {
+		t.Fatal("invalid trusted proxy accepted")
+	}
+}
@@ -27,6 +27,7 @@ import (
 	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/controller"
 	"github.com/openimsdk/open-im-server/v3/pkg/msgprocessor"
+	"github.com/openimsdk/open-im-server/v3/pkg/rpccache"
 	"github.com/openimsdk/open-im-server/v3/pkg/rpcclient"
 	"github.com/openimsdk/protocol/constant"
 	"github.com/openimsdk/protocol/sdkws"
@@ -34,6 +35,7 @@ import (
 	"github.com/openimsdk/tools/log"
 	"github.com/openimsdk/tools/mcontext"
 	"github.com/openimsdk/tools/mq/kafka"
+	"github.com/openimsdk/tools/utils/datautil"
 	"github.com/openimsdk/tools/utils/idutil"
 	"github.com/openimsdk/tools/utils/stringutil"
 	"google.golang.org/protobuf/proto"
@@ -77,18 +79,21 @@ type OnlineHistoryRedisConsumerHandler struct {
 	// singleMsgFailedCountMutex  sync.Mutex
 
 	msgDatabase           controller.CommonMsgDatabase
+	unreadDatabase        controller.UnreadDatabase
 	conversationRpcClient *rpcclient.ConversationRpcClient
 	groupRpcClient        *rpcclient.GroupRpcClient
+	groupLocalCache       *rpccache.GroupLocalCache
 }
 
-func NewOnlineHistoryRedisConsumerHandler(kafkaConf *config.Kafka, database controller.CommonMsgDatabase,
-	conversationRpcClient *rpcclient.ConversationRpcClient, groupRpcClient *rpcclient.GroupRpcClient) (*OnlineHistoryRedisConsumerHandler, error) {
+func NewOnlineHistoryRedisConsumerHandler(kafkaConf *config.Kafka, database controller.CommonMsgDatabase, unreadDatabase controller.UnreadDatabase,
+	conversationRpcClient *rpcclient.ConversationRpcClient, groupRpcClient *rpcclient.GroupRpcClient, groupLocalCache *rpccache.GroupLocalCache) (*OnlineHistoryRedisConsumerHandler, error) {
 	historyConsumerGroup, err := kafka.NewMConsumerGroup(kafkaConf.Build(), kafkaConf.ToRedisGroupID, []string{kafkaConf.ToRedisTopic}, true)
 	if err != nil {
 		return nil, err
 	}
 	var och OnlineHistoryRedisConsumerHandler
 	och.msgDatabase = database
+	och.unreadDatabase = unreadDatabase
 	och.msgDistributionCh = make(chan Cmd2Value) // no buffer channel
 	go och.MessagesDistributionHandle()
 	for i := 0; i < ChannelNum; i++ {
@@ -97,6 +102,7 @@ func NewOnlineHistoryRedisConsumerHandler(kafkaConf *config.Kafka, database cont
 	}
 	och.conversationRpcClient = conversationRpcClient
 	och.groupRpcClient = groupRpcClient
+	och.groupLocalCache = groupLocalCache
 	och.historyConsumerGroup = historyConsumerGroup
 	return &och, err
 }
@@ -228,6 +234,7 @@ func (och *OnlineHistoryRedisConsumerHandler) handleNotification(
 			return
 		}
 		log.ZDebug(ctx, "success to next topic", "conversationID", conversationID)
+		och.countUnread(ctx, conversationID, storageList)
 		err = och.msgDatabase.MsgToMongoMQ(ctx, key, conversationID, storageList, lastSeq)
 		if err != nil {
 			log.ZError(ctx, "MsgToMongoMQ error", err)
@@ -279,6 +286,7 @@ func (och *OnlineHistoryRedisConsumerHandler) handleMsg(ctx context.Context, key
 		}
 
 		log.ZDebug(ctx, "success incr to next topic")
+		och.countUnread(ctx, conversationID, storageList)
 		err = och.msgDatabase.MsgToMongoMQ(ctx, key, conversationID, storageList, lastSeq)
 		if err != nil {
 			log.ZError(ctx, "MsgToMongoMQ error", err)
@@ -287,6 +295,30 @@ func (och *OnlineHistoryRedisConsumerHandler) handleMsg(ctx context.Context, key
 	}
 }
 
+// countUnread updates the unread counts of the conversation members before the messages are pushed,
+// so that offline pushes carry the badge including them.
+func (och *OnlineHistoryRedisConsumerHandler) countUnread(ctx context.Context, conversationID string, msgs []*sdkws.MsgData) {
+	var userIDs []string
+	switch msgs[0].SessionType {
+	case constant.ReadGroupChatType:
+		memberIDs, err := och.groupLocalCache.GetGroupMemberIDs(ctx, msgs[0].GroupID)
+		if err != nil {
+			log.ZWarn(ctx, "get group member ids error", err, "conversationID", conversationID)
+			return
+		}
+		userIDs = memberIDs
+	case constant.SingleChatType:
+		userIDs = datautil.Distinct([]string{msgs[0].SendID, msgs[0].RecvID})
+	case constant.NotificationChatType:
+		userIDs = []string{msgs[0].RecvID}
+	default:
+		return
+	}
+	if err := och.unreadDatabase.IncrUnreadCounts(ctx, conversationID, msgs, userIDs); err != nil {
+		log.ZError(ctx, "incr unread counts error", err, "conversationID", conversationID)
+	}
+}
+
 func (och *OnlineHistoryRedisConsumerHandler) MessagesDistributionHandle() {
 	for {
 		aggregationMsgs := make(map[string][]*ContextMsg, ChannelNum)
@@ -93,14 +93,8 @@ func (f *Fcm) Push(ctx context.Context, userIDs []string, title, content string,
 			messages = messages[0:0]
 		}
 		if opts.IOSBadgeCount {
-			unreadCountSum, err := f.cache.IncrUserBadgeUnreadCountSum(ctx, userID)
-			if err == nil {
-				apns.Payload.Aps.Badge = &unreadCountSum
-			} else {
-				// log.Error(operationID, "IncrUserBadgeUnreadCountSum redis err", err.Error(), uid)
-				Fail++
-				continue
-			}
+			badge := int(opts.Badges[userID])
+			apns.Payload.Aps.Badge = &badge
 		} else {
 			unreadCountSum, err := f.cache.GetUserBadgeUnreadCountSum(ctx, userID)
 			if err == nil && unreadCountSum != 0 {
@@ -16,6 +16,7 @@ package getui
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
 )
@@ -149,6 +150,12 @@ func newBatchPushReq(userIDs []string, taskID string) PushReq {
 	return PushReq{Audience: &Audience{Alias: userIDs}, IsAsync: &IsAsync, TaskID: &taskID}
 }
 
+// setIOSBadge sets the badge to the unread count instead of leaving it unchanged.
+func (pushReq *PushReq) setIOSBadge(badge int64) {
+	autoBadge := strconv.FormatInt(badge, 10)
+	pushReq.PushChannel.Ios.AutoBadge = &autoBadge
+}
+
 func (pushReq *PushReq) setPushChannel(title string, body string) {
 	pushReq.PushChannel = &PushChannel{}
 	// autoBadge := "+1"
@@ -80,8 +80,27 @@ func (g *Client) Push(ctx context.Context, userIDs []string, title, content stri
 			return err
 		}
 	}
-	pushReq := newPushReq(g.pushConf, title, content)
-	pushReq.setPushChannel(title, content)
+	if len(userIDs) == 0 {
+		return ErrUserIDEmpty
+	}
+	for badge, badgeUserIDs := range opts.GroupByBadge(userIDs) {
+		pushReq := newPushReq(g.pushConf, title, content)
+		pushReq.setPushChannel(title, content)
+		if opts.IOSBadgeCount {
+			pushReq.setIOSBadge(badge)
+		}
+		if err = g.push(ctx, token, badgeUserIDs, pushReq); err != nil {
+			break
+		}
+	}
+	switch err {
+	case ErrTokenExpire:
+		token, err = g.getTokenAndSave2Redis(ctx)
+	}
+	return err
+}
+
+func (g *Client) push(ctx context.Context, token string, userIDs []string, pushReq PushReq) (err error) {
 	if len(userIDs) > 1 {
 		maxNum := 999
 		if len(userIDs) > maxNum {
@@ -100,14 +119,8 @@ func (g *Client) Push(ctx context.Context, userIDs []string, title, content stri
 		} else {
 			err = g.batchPush(ctx, token, userIDs, pushReq)
 		}
-	} else if len(userIDs) == 1 {
-		err = g.singlePush(ctx, token, userIDs[0], pushReq)
 	} else {
-		return ErrUserIDEmpty
-	}
-	switch err {
-	case ErrTokenExpire:
-		token, err = g.getTokenAndSave2Redis(ctx)
+		err = g.singlePush(ctx, token, userIDs[0], pushReq)
 	}
 	return err
 }
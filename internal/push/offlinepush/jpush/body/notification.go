@@ -15,6 +15,8 @@
 package body
 
 import (
+	"strconv"
+
 	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
 )
 
@@ -51,6 +53,11 @@ func (n *Notification) SetAlert(alert string) {
 	n.IOS.Badge = "+1"
 }
 
+// SetIOSBadge sets the badge to the unread count instead of incrementing it.
+func (n *Notification) SetIOSBadge(badge int64) {
+	n.IOS.Badge = strconv.FormatInt(badge, 10)
+}
+
 func (n *Notification) SetExtras(extras Extras) {
 	n.IOS.Extras = extras
 	n.Android.Extras = extras
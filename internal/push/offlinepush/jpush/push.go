@@ -52,6 +52,15 @@ func (j *JPush) getAuthorization(appKey string, masterSecret string) string {
 }
 
 func (j *JPush) Push(ctx context.Context, userIDs []string, title, content string, opts *options.Opts) error {
+	for badge, badgeUserIDs := range opts.GroupByBadge(userIDs) {
+		if err := j.push(ctx, badgeUserIDs, title, content, opts, badge); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (j *JPush) push(ctx context.Context, userIDs []string, title, content string, opts *options.Opts, badge int64) error {
 	var pf body.Platform
 	pf.SetAll()
 	var au body.Audience
@@ -64,6 +73,9 @@ func (j *JPush) Push(ctx context.Context, userIDs []string, title, content strin
 	no.IOSEnableMutableContent()
 	no.SetExtras(extras)
 	no.SetAlert(title)
+	if opts.IOSBadgeCount {
+		no.SetIOSBadge(badge)
+	}
 	no.SetAndroidIntent(j.pushConf)
 
 	var msg body.Message
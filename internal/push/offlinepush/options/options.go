@@ -5,7 +5,23 @@ type Opts struct {
 	Signal        *Signal
 	IOSPushSound  string
 	IOSBadgeCount bool
-	Ex            string
+	// k: user, v: unread count used as the iOS badge, set when IOSBadgeCount
+	Badges map[string]int64
+	Ex     string
+}
+
+// GroupByBadge groups the users by their badge, all users are in one group with the zero badge
+// when IOSBadgeCount is not set.
+func (o *Opts) GroupByBadge(userIDs []string) map[int64][]string {
+	if !o.IOSBadgeCount {
+		return map[int64][]string{0: userIDs}
+	}
+	groups := make(map[int64][]string)
+	for _, userID := range userIDs {
+		badge := o.Badges[userID]
+		groups[badge] = append(groups[badge], userID)
+	}
+	return groups
 }
 
 // Signal message id.
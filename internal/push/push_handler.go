@@ -22,6 +22,7 @@ import (
 	"github.com/openimsdk/open-im-server/v3/pkg/common/prommetrics"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/webhook"
 	"github.com/openimsdk/open-im-server/v3/pkg/msgprocessor"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/msgext"
 	"github.com/openimsdk/open-im-server/v3/pkg/rpccache"
 	"github.com/openimsdk/open-im-server/v3/pkg/rpcclient"
 	"github.com/openimsdk/open-im-server/v3/pkg/util/conversationutil"
@@ -93,6 +94,9 @@ func (c *ConsumerHandler) handleMs2PsChat(ctx context.Context, msg []byte) {
 	if nowSec-sec > 10 {
 		return
 	}
+	if pbData.MsgData.ContentType == msgext.Poll {
+		c.refreshPollMsg(ctx, pbData.MsgData)
+	}
 	var err error
 	switch msgFromMQ.MsgData.SessionType {
 	case constant.ReadGroupChatType:
@@ -112,6 +116,26 @@ func (c *ConsumerHandler) handleMs2PsChat(ctx context.Context, msg []byte) {
 	}
 }
 
+// refreshPollMsg replaces the tallies of a poll message with the current ones, votes may have been cast by the users
+// the message reached first. The choices of the sender are dropped as the message goes to other users.
+func (c *ConsumerHandler) refreshPollMsg(ctx context.Context, msg *sdkws.MsgData) {
+	var content msgext.PollInfo
+	if err := json.Unmarshal(msg.Content, &content); err != nil || content.PollID == "" {
+		return
+	}
+	info, err := c.msgRpcClient.GetPoll(ctx, msg.SendID, content.PollID)
+	if err != nil {
+		log.ZWarn(ctx, "get poll failed", err, "pollID", content.PollID)
+		return
+	}
+	info.VotedOptionIDs = nil
+	data, err := json.Marshal(info)
+	if err != nil {
+		return
+	}
+	msg.Content = data
+}
+
 func (*ConsumerHandler) Setup(sarama.ConsumerGroupSession) error { return nil }
 
 func (*ConsumerHandler) Cleanup(sarama.ConsumerGroupSession) error { return nil }
@@ -152,7 +176,15 @@ func (c *ConsumerHandler) Push2User(ctx context.Context, userIDs []string, msg *
 			return nil
 		}
 	}
-	offlinePUshUserID := []string{msg.RecvID}
+	offlinePUshUserID, err := c.conversationRpcClient.GetDoNotDisturbPushUserIDs(ctx, msgprocessor.GetConversationIDByMsg(msg),
+		[]string{msg.RecvID}, msg.AtUserIDList)
+	if err != nil {
+		return err
+	}
+	if len(offlinePUshUserID) == 0 {
+		// the receiver does not want to be disturbed now
+		return nil
+	}
 
 	//receiver offline push
 	if err = c.webhookBeforeOfflinePush(ctx, &c.config.WebhooksConfig.BeforeOfflinePush,
@@ -231,12 +263,30 @@ func (c *ConsumerHandler) Push2Group(ctx context.Context, groupID string, msg *s
 
 	return nil
 }
+
+// filterCommunityMembers leaves out the members of a community channel who are no longer in the community.
+func (c *ConsumerHandler) filterCommunityMembers(ctx context.Context, groupID string, userIDs []string) ([]string, error) {
+	community, err := c.groupLocalCache.GetGroupCommunity(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+	if community.CommunityID == "" || len(userIDs) == 0 || len(c.config.Share.IMAdminUserID) == 0 {
+		return userIDs, nil
+	}
+	ctx = mcontext.WithOpUserIDContext(ctx, c.config.Share.IMAdminUserID[0])
+	return c.groupRpcClient.FindCommunityMemberIDs(ctx, community.CommunityID, userIDs)
+}
+
 func (c *ConsumerHandler) groupMessagesHandler(ctx context.Context, groupID string, pushToUserIDs *[]string, msg *sdkws.MsgData) (err error) {
 	if len(*pushToUserIDs) == 0 {
 		*pushToUserIDs, err = c.groupLocalCache.GetGroupMemberIDs(ctx, groupID)
 		if err != nil {
 			return err
 		}
+		*pushToUserIDs, err = c.filterCommunityMembers(ctx, groupID, *pushToUserIDs)
+		if err != nil {
+			return err
+		}
 		switch msg.ContentType {
 		case constant.MemberQuitNotification:
 			var tips sdkws.MemberQuitTips
@@ -284,6 +334,14 @@ func (c *ConsumerHandler) offlinePushMsg(ctx context.Context, msg *sdkws.MsgData
 	if err != nil {
 		return err
 	}
+	if opts.IOSBadgeCount && len(c.config.Share.IMAdminUserID) > 0 {
+		// The unread counts already include the pushed message, they are updated before it is pushed.
+		adminCtx := mcontext.WithOpUserIDContext(ctx, c.config.Share.IMAdminUserID[0])
+		opts.Badges, err = c.msgRpcClient.GetUsersUnreadCount(adminCtx, offlinePushUserIDs)
+		if err != nil {
+			return err
+		}
+	}
 	err = c.offlinePusher.Push(ctx, offlinePushUserIDs, title, content, opts)
 	if err != nil {
 		prommetrics.MsgOfflinePushFailedCounter.Inc()
@@ -296,8 +354,8 @@ func (c *ConsumerHandler) filterGroupMessageOfflinePush(ctx context.Context, gro
 	offlinePushUserIDs []string) (userIDs []string, err error) {
 
 	//todo local cache Obtain the difference set through local comparison.
-	needOfflinePushUserIDs, err := c.conversationRpcClient.GetConversationOfflinePushUserIDs(
-		ctx, conversationutil.GenGroupConversationID(groupID), offlinePushUserIDs)
+	needOfflinePushUserIDs, err := c.conversationRpcClient.GetOfflinePushUserIDs(
+		ctx, conversationutil.GenGroupConversationID(groupID), offlinePushUserIDs, msg.AtUserIDList)
 	if err != nil {
 		return nil, err
 	}
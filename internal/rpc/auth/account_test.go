@@ -0,0 +1,83 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"testing"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/servererrs"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/controller"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/authext"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/userext"
+	"github.com/openimsdk/open-im-server/v3/pkg/rpcclient"
+	pbauth "github.com/openimsdk/protocol/auth"
+	"github.com/openimsdk/tools/mcontext"
+	"google.golang.org/grpc"
+)
+
+type purgedUserClient struct {
+	userext.UserExtClient
+	deleted map[string]bool
+}
+
+func (c *purgedUserClient) CheckAccountActive(ctx context.Context, in *userext.CheckAccountActiveReq, opts ...grpc.CallOption) (*userext.CheckAccountActiveResp, error) {
+	if c.deleted[in.UserID] {
+		return nil, servererrs.ErrAccountDeleted.WrapMsg("account deleted", "userID", in.UserID)
+	}
+	return &userext.CheckAccountActiveResp{}, nil
+}
+
+// tokenDatabaseStub fails the test when a token is issued.
+type tokenDatabaseStub struct {
+	controller.AuthDatabase
+	t *testing.T
+}
+
+func (d *tokenDatabaseStub) CreateToken(ctx context.Context, userID string, platformID int) (string, error) {
+	d.t.Fatalf("token issued for %s", userID)
+	return "", nil
+}
+
+func (d *tokenDatabaseStub) RefreshToken(ctx context.Context, userID string, platformID int, refreshToken string) (string, string, error) {
+	d.t.Fatalf("token refreshed for %s", userID)
+	return "", "", nil
+}
+
+func TestLoginAfterPurge(t *testing.T) {
+	s := &authServer{
+		authDatabase:  &tokenDatabaseStub{t: t},
+		userRpcClient: &rpcclient.UserRpcClient{ExtClient: &purgedUserClient{deleted: map[string]bool{"alice": true}}},
+		config: &Config{
+			Share: config.Share{Secret: "secret", IMAdminUserID: []string{"admin"}},
+		},
+	}
+	s.config.RpcConfig.RefreshTokenPolicy.Enable = true
+	ctx := context.Background()
+
+	if _, err := s.UserToken(ctx, &pbauth.UserTokenReq{Secret: "secret", UserID: "alice", PlatformID: 1}); !servererrs.ErrAccountDeleted.Is(err) {
+		t.Fatalf("user token: got %v, want account deleted", err)
+	}
+	if _, err := s.GetUserToken(mcontext.SetOpUserID(ctx, "admin"), &pbauth.GetUserTokenReq{UserID: "alice", PlatformID: 1}); !servererrs.ErrAccountDeleted.Is(err) {
+		t.Fatalf("get user token: got %v, want account deleted", err)
+	}
+	if _, err := s.IssueToken(ctx, &authext.IssueTokenReq{Secret: "secret", UserID: "alice", PlatformID: 1}); !servererrs.ErrAccountDeleted.Is(err) {
+		t.Fatalf("issue token: got %v, want account deleted", err)
+	}
+	if _, err := s.RefreshToken(ctx, &authext.RefreshTokenReq{UserID: "alice", PlatformID: 1, RefreshToken: "refresh"}); !servererrs.ErrAccountDeleted.Is(err) {
+		t.Fatalf("refresh token: got %v, want account deleted", err)
+	}
+}
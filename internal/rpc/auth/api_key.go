@@ -0,0 +1,197 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/servererrs"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/authext"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/mcontext"
+	"github.com/openimsdk/tools/mw/specialerror"
+	"github.com/openimsdk/tools/utils/datautil"
+)
+
+func (s *authServer) CreateAPIKey(ctx context.Context, req *authext.CreateAPIKeyReq) (*authext.CreateAPIKeyResp, error) {
+	if err := authverify.CheckAdmin(ctx, s.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	if datautil.Duplicate(req.Scopes) {
+		return nil, errs.ErrArgs.WrapMsg("scopes repeated")
+	}
+	for _, scope := range req.Scopes {
+		if !datautil.Contain(scope, authverify.APIKeyScopes...) {
+			return nil, errs.ErrArgs.WrapMsg("unknown scope", "scope", scope)
+		}
+	}
+	if err := authverify.CheckIPAllowlist(req.IpAllowlist); err != nil {
+		return nil, err
+	}
+	if req.ExpireTime != 0 && req.ExpireTime <= time.Now().UnixMilli() {
+		return nil, errs.ErrArgs.WrapMsg("expireTime has passed")
+	}
+	apiKey, keyID, secretHash, err := authverify.GenAPIKey()
+	if err != nil {
+		return nil, err
+	}
+	key := &model.APIKey{
+		KeyID:         keyID,
+		Name:          req.Name,
+		SecretHash:    secretHash,
+		Scopes:        req.Scopes,
+		IPAllowlist:   req.IpAllowlist,
+		Status:        model.APIKeyStatusNormal,
+		CreatorUserID: mcontext.GetOpUserID(ctx),
+		CreateTime:    time.Now(),
+	}
+	if req.ExpireTime != 0 {
+		key.ExpireTime = time.UnixMilli(req.ExpireTime)
+	}
+	if err := s.apiKeyDatabase.CreateAPIKey(ctx, key); err != nil {
+		return nil, err
+	}
+	return &authext.CreateAPIKeyResp{ApiKey: apiKey, Info: convertAPIKey(key)}, nil
+}
+
+func (s *authServer) RevokeAPIKey(ctx context.Context, req *authext.RevokeAPIKeyReq) (*authext.RevokeAPIKeyResp, error) {
+	if err := authverify.CheckAdmin(ctx, s.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	if _, err := s.apiKeyDatabase.TakeAPIKey(ctx, req.KeyID); err != nil {
+		return nil, err
+	}
+	if err := s.apiKeyDatabase.RevokeAPIKey(ctx, req.KeyID, mcontext.GetOpUserID(ctx)); err != nil {
+		return nil, err
+	}
+	return &authext.RevokeAPIKeyResp{}, nil
+}
+
+func (s *authServer) GetAPIKeys(ctx context.Context, req *authext.GetAPIKeysReq) (*authext.GetAPIKeysResp, error) {
+	if err := authverify.CheckAdmin(ctx, s.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	total, keys, err := s.apiKeyDatabase.PageAPIKeys(ctx, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	resp := &authext.GetAPIKeysResp{Total: total, Keys: make([]*authext.ApiKeyInfo, 0, len(keys))}
+	for _, key := range keys {
+		info := convertAPIKey(key)
+		usage, err := s.apiKeyDatabase.GetAPIKeyUsage(ctx, key.KeyID)
+		if err != nil {
+			return nil, err
+		}
+		info.UsageTotal = usage.Total
+		info.ScopeUsage = usage.Scopes
+		info.LastUsedTime = usage.LastUsedTime
+		info.LastIP = usage.LastIP
+		resp.Keys = append(resp.Keys, info)
+	}
+	return resp, nil
+}
+
+func (s *authServer) GetAPIKeyAudits(ctx context.Context, req *authext.GetAPIKeyAuditsReq) (*authext.GetAPIKeyAuditsResp, error) {
+	if err := authverify.CheckAdmin(ctx, s.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	total, audits, err := s.apiKeyDatabase.PageAPIKeyAudits(ctx, req.KeyID, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &authext.GetAPIKeyAuditsResp{
+		Total: total,
+		Audits: datautil.Slice(audits, func(e *model.APIKeyAudit) *authext.ApiKeyAudit {
+			return &authext.ApiKeyAudit{
+				KeyID:          e.KeyID,
+				Action:         e.Action,
+				OperatorUserID: e.OperatorUserID,
+				Ip:             e.IP,
+				Scope:          e.Scope,
+				Reason:         e.Reason,
+				CreateTime:     e.CreateTime.UnixMilli(),
+			}
+		}),
+	}, nil
+}
+
+func (s *authServer) VerifyAPIKey(ctx context.Context, req *authext.VerifyAPIKeyReq) (*authext.VerifyAPIKeyResp, error) {
+	keyID, secret, ok := authverify.ParseAPIKey(req.ApiKey)
+	if !ok {
+		return nil, servererrs.ErrTokenMalformed.WrapMsg("api key malformed")
+	}
+	key, err := s.apiKeyDatabase.TakeAPIKey(ctx, keyID)
+	if err != nil {
+		if errs.ErrRecordNotFound.Is(specialerror.ErrCode(errs.Unwrap(err))) {
+			return nil, servererrs.ErrTokenInvalid.WrapMsg("api key not exist")
+		}
+		return nil, err
+	}
+	if !authverify.CheckAPIKeySecret(secret, key.SecretHash) {
+		return nil, s.denyAPIKey(ctx, req, "invalid secret", servererrs.ErrTokenInvalid.WrapMsg("api key invalid"))
+	}
+	if key.Status != model.APIKeyStatusNormal {
+		return nil, s.denyAPIKey(ctx, req, "revoked", servererrs.ErrTokenKicked.WrapMsg("api key revoked"))
+	}
+	if !key.ExpireTime.IsZero() && time.Now().After(key.ExpireTime) {
+		return nil, s.denyAPIKey(ctx, req, "expired", servererrs.ErrTokenExpired.WrapMsg("api key expired"))
+	}
+	if !authverify.IPAllowed(key.IPAllowlist, req.Ip) {
+		return nil, s.denyAPIKey(ctx, req, "ip not allowed", errs.ErrNoPermission.WrapMsg("ip not allowed", "ip", req.Ip))
+	}
+	if !datautil.Contain(req.Scope, key.Scopes...) {
+		return nil, s.denyAPIKey(ctx, req, "scope not granted", errs.ErrNoPermission.WrapMsg("scope not granted", "scope", req.Scope))
+	}
+	if err := s.apiKeyDatabase.IncrAPIKeyUsage(ctx, keyID, req.Scope, req.Ip); err != nil {
+		log.ZWarn(ctx, "IncrAPIKeyUsage failed", err, "keyID", keyID)
+	}
+	return &authext.VerifyAPIKeyResp{KeyID: keyID, OpUserID: s.config.Share.IMAdminUserID[0]}, nil
+}
+
+// denyAPIKey audits a refused request of an existing API key and returns err.
+func (s *authServer) denyAPIKey(ctx context.Context, req *authext.VerifyAPIKeyReq, reason string, err error) error {
+	keyID, _, _ := authverify.ParseAPIKey(req.ApiKey)
+	audit := &model.APIKeyAudit{
+		KeyID:  keyID,
+		Action: model.APIKeyActionDeny,
+		IP:     req.Ip,
+		Scope:  req.Scope,
+		Reason: reason,
+	}
+	if auditErr := s.apiKeyDatabase.AddAPIKeyAudit(ctx, audit); auditErr != nil {
+		log.ZWarn(ctx, "AddAPIKeyAudit failed", auditErr, "keyID", keyID)
+	}
+	return err
+}
+
+func convertAPIKey(key *model.APIKey) *authext.ApiKeyInfo {
+	info := &authext.ApiKeyInfo{
+		KeyID:         key.KeyID,
+		Name:          key.Name,
+		Scopes:        key.Scopes,
+		IpAllowlist:   key.IPAllowlist,
+		Status:        key.Status,
+		CreatorUserID: key.CreatorUserID,
+		CreateTime:    key.CreateTime.UnixMilli(),
+	}
+	if !key.ExpireTime.IsZero() {
+		info.ExpireTime = key.ExpireTime.UnixMilli()
+	}
+	return info
+}
@@ -16,8 +16,13 @@ package auth
 
 import (
 	"context"
+	"time"
+
 	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
 	redis2 "github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache/redis"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database/mgo"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/authext"
+	"github.com/openimsdk/tools/db/mongoutil"
 	"github.com/openimsdk/tools/db/redisutil"
 	"github.com/redis/go-redis/v9"
 
@@ -39,43 +44,82 @@ import (
 
 type authServer struct {
 	authDatabase   controller.AuthDatabase
+	apiKeyDatabase controller.APIKeyDatabase
+	tokenKeys      *authverify.TokenKeys
+	oidcVerifier   *authverify.OIDCVerifier
 	userRpcClient  *rpcclient.UserRpcClient
 	RegisterCenter discovery.SvcDiscoveryRegistry
 	config         *Config
 }
 
 type Config struct {
-	RpcConfig   config.Auth
-	RedisConfig config.Redis
-	Share       config.Share
-	Discovery   config.Discovery
+	RpcConfig     config.Auth
+	RedisConfig   config.Redis
+	MongodbConfig config.Mongo
+	Share         config.Share
+	Discovery     config.Discovery
 }
 
 func Start(ctx context.Context, config *Config, client discovery.SvcDiscoveryRegistry, server *grpc.Server) error {
+	mgocli, err := mongoutil.NewMongoDB(ctx, config.MongodbConfig.Build())
+	if err != nil {
+		return err
+	}
 	rdb, err := redisutil.NewRedisClient(ctx, config.RedisConfig.Build())
 	if err != nil {
 		return err
 	}
+	apiKeyDB, err := mgo.NewAPIKeyMongo(mgocli.GetDB())
+	if err != nil {
+		return err
+	}
+	apiKeyAuditDB, err := mgo.NewAPIKeyAuditMongo(mgocli.GetDB())
+	if err != nil {
+		return err
+	}
+	tokenKeys, err := authverify.NewTokenKeys(&config.RpcConfig.TokenSigning, config.Share.Secret)
+	if err != nil {
+		return err
+	}
 	userRpcClient := rpcclient.NewUserRpcClient(client, config.Share.RpcRegisterName.User, config.Share.IMAdminUserID)
-	pbauth.RegisterAuthServer(server, &authServer{
+	srv := &authServer{
 		userRpcClient:  &userRpcClient,
 		RegisterCenter: client,
+		tokenKeys:      tokenKeys,
 		authDatabase: controller.NewAuthDatabase(
 			redis2.NewTokenCacheModel(rdb),
-			config.Share.Secret,
-			config.RpcConfig.TokenPolicy.Expire,
+			tokenKeys,
+			accessTokenExpire(config),
+			time.Duration(config.RpcConfig.RefreshTokenPolicy.Expire)*24*time.Hour,
 		),
-		config: config,
-	})
+		apiKeyDatabase: controller.NewAPIKeyDatabase(apiKeyDB, apiKeyAuditDB, redis2.NewAPIKeyCacheRedis(rdb, apiKeyDB)),
+		config:         config,
+	}
+	if config.RpcConfig.OIDC.Enable {
+		srv.oidcVerifier, err = authverify.NewOIDCVerifier(&config.RpcConfig.OIDC)
+		if err != nil {
+			return err
+		}
+	}
+	pbauth.RegisterAuthServer(server, srv)
+	authext.RegisterAuthExtServer(server, srv)
 	return nil
 }
 
+// accessTokenExpire returns the access token validity period, which is shortened when refresh tokens are enabled.
+func accessTokenExpire(config *Config) time.Duration {
+	if config.RpcConfig.RefreshTokenPolicy.Enable {
+		return time.Duration(config.RpcConfig.RefreshTokenPolicy.AccessExpire) * time.Minute
+	}
+	return time.Duration(config.RpcConfig.TokenPolicy.Expire) * 24 * time.Hour
+}
+
 func (s *authServer) UserToken(ctx context.Context, req *pbauth.UserTokenReq) (*pbauth.UserTokenResp, error) {
 	resp := pbauth.UserTokenResp{}
 	if req.Secret != s.config.Share.Secret {
 		return nil, errs.ErrNoPermission.WrapMsg("secret invalid")
 	}
-	if _, err := s.userRpcClient.GetUserInfo(ctx, req.UserID); err != nil {
+	if err := s.userRpcClient.CheckAccountActive(ctx, req.UserID); err != nil {
 		return nil, err
 	}
 	token, err := s.authDatabase.CreateToken(ctx, req.UserID, int(req.PlatformID))
@@ -84,7 +128,7 @@ func (s *authServer) UserToken(ctx context.Context, req *pbauth.UserTokenReq) (*
 	}
 	prommetrics.UserLoginCounter.Inc()
 	resp.Token = token
-	resp.ExpireTimeSeconds = s.config.RpcConfig.TokenPolicy.Expire * 24 * 60 * 60
+	resp.ExpireTimeSeconds = int64(accessTokenExpire(s.config) / time.Second)
 	return &resp, nil
 }
 
@@ -97,7 +141,7 @@ func (s *authServer) GetUserToken(ctx context.Context, req *pbauth.GetUserTokenR
 	if authverify.IsManagerUserID(req.UserID, s.config.Share.IMAdminUserID) {
 		return nil, errs.ErrNoPermission.WrapMsg("don't get Admin token")
 	}
-	if _, err := s.userRpcClient.GetUserInfo(ctx, req.UserID); err != nil {
+	if err := s.userRpcClient.CheckAccountActive(ctx, req.UserID); err != nil {
 		return nil, err
 	}
 	token, err := s.authDatabase.CreateToken(ctx, req.UserID, int(req.PlatformID))
@@ -105,12 +149,12 @@ func (s *authServer) GetUserToken(ctx context.Context, req *pbauth.GetUserTokenR
 		return nil, err
 	}
 	resp.Token = token
-	resp.ExpireTimeSeconds = s.config.RpcConfig.TokenPolicy.Expire * 24 * 60 * 60
+	resp.ExpireTimeSeconds = int64(accessTokenExpire(s.config) / time.Second)
 	return &resp, nil
 }
 
 func (s *authServer) parseToken(ctx context.Context, tokensString string) (claims *tokenverify.Claims, err error) {
-	claims, err = tokenverify.GetClaimFromToken(tokensString, authverify.Secret(s.config.Share.Secret))
+	claims, err = tokenverify.GetClaimFromToken(tokensString, s.tokenKeys.Keyfunc)
 	if err != nil {
 		return nil, errs.Wrap(err)
 	}
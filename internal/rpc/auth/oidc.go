@@ -0,0 +1,83 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"strings"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/servererrs"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/authext"
+	"github.com/openimsdk/protocol/sdkws"
+	pbuser "github.com/openimsdk/protocol/user"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+)
+
+func (s *authServer) OidcLogin(ctx context.Context, req *authext.OidcLoginReq) (*authext.OidcLoginResp, error) {
+	if s.oidcVerifier == nil {
+		return nil, servererrs.ErrNoPermission.WrapMsg("oidc login is disabled")
+	}
+	issuer, claims, err := s.oidcVerifier.Verify(ctx, req.IdToken)
+	if err != nil {
+		return nil, err
+	}
+	userIDClaim := issuer.UserIDClaim
+	if userIDClaim == "" {
+		userIDClaim = "sub"
+	}
+	subject, _ := claims[userIDClaim].(string)
+	if subject == "" {
+		return nil, servererrs.ErrTokenInvalid.WrapMsg("id token user claim is empty", "claim", userIDClaim)
+	}
+	userID := issuer.UserIDPrefix + subject
+	if strings.Contains(userID, ":") {
+		return nil, errs.ErrArgs.WrapMsg("userID contains ':' is invalid userID", "userID", userID)
+	}
+	if authverify.IsManagerUserID(userID, s.config.Share.IMAdminUserID) {
+		return nil, servererrs.ErrNoPermission.WrapMsg("oidc login cannot act as an admin user", "userID", userID)
+	}
+	if err := s.userRpcClient.CheckAccountActive(ctx, userID); err != nil {
+		if !(issuer.AutoRegister && servererrs.ErrUserIDNotFound.Is(err)) {
+			return nil, err
+		}
+		nickname, _ := claims[issuer.NicknameClaim].(string)
+		faceURL, _ := claims[issuer.FaceURLClaim].(string)
+		if nickname == "" {
+			nickname = subject
+		}
+		_, err := s.userRpcClient.Client.UserRegister(ctx, &pbuser.UserRegisterReq{
+			Secret: s.config.Share.Secret,
+			Users:  []*sdkws.UserInfo{{UserID: userID, Nickname: nickname, FaceURL: faceURL}},
+		})
+		if err != nil && !servererrs.ErrRegisteredAlready.Is(err) {
+			return nil, err
+		}
+		log.ZInfo(ctx, "oidc user registered", "userID", userID, "issuer", issuer.Issuer)
+	}
+	resp, err := s.issueToken(ctx, userID, int(req.PlatformID), &cache.TokenSession{DeviceName: req.DeviceName})
+	if err != nil {
+		return nil, err
+	}
+	return &authext.OidcLoginResp{
+		UserID:                   userID,
+		Token:                    resp.Token,
+		ExpireTimeSeconds:        resp.ExpireTimeSeconds,
+		RefreshToken:             resp.RefreshToken,
+		RefreshExpireTimeSeconds: resp.RefreshExpireTimeSeconds,
+	}, nil
+}
@@ -0,0 +1,88 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/prommetrics"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/servererrs"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/authext"
+	"github.com/openimsdk/tools/errs"
+)
+
+func (s *authServer) refreshTokenExpire() time.Duration {
+	return time.Duration(s.config.RpcConfig.RefreshTokenPolicy.Expire) * 24 * time.Hour
+}
+
+func (s *authServer) IssueToken(ctx context.Context, req *authext.IssueTokenReq) (*authext.IssueTokenResp, error) {
+	if req.Secret != s.config.Share.Secret {
+		return nil, errs.ErrNoPermission.WrapMsg("secret invalid")
+	}
+	if err := s.userRpcClient.CheckAccountActive(ctx, req.UserID); err != nil {
+		return nil, err
+	}
+	return s.issueToken(ctx, req.UserID, int(req.PlatformID), &cache.TokenSession{
+		DeviceName: req.DeviceName,
+		IP:         req.Ip,
+		UserAgent:  req.UserAgent,
+	})
+}
+
+// issueToken creates an access token for a verified user, together with a refresh token when the policy is enabled.
+func (s *authServer) issueToken(ctx context.Context, userID string, platformID int, session *cache.TokenSession) (*authext.IssueTokenResp, error) {
+	token, err := s.authDatabase.CreateToken(ctx, userID, platformID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authDatabase.AddTokenSession(ctx, userID, platformID, token, session); err != nil {
+		return nil, err
+	}
+	prommetrics.UserLoginCounter.Inc()
+	resp := &authext.IssueTokenResp{
+		Token:             token,
+		ExpireTimeSeconds: int64(accessTokenExpire(s.config) / time.Second),
+	}
+	if !s.config.RpcConfig.RefreshTokenPolicy.Enable {
+		return resp, nil
+	}
+	resp.RefreshToken, err = s.authDatabase.CreateRefreshToken(ctx, userID, platformID, token)
+	if err != nil {
+		return nil, err
+	}
+	resp.RefreshExpireTimeSeconds = int64(s.refreshTokenExpire() / time.Second)
+	return resp, nil
+}
+
+func (s *authServer) RefreshToken(ctx context.Context, req *authext.RefreshTokenReq) (*authext.RefreshTokenResp, error) {
+	if !s.config.RpcConfig.RefreshTokenPolicy.Enable {
+		return nil, servererrs.ErrNoPermission.WrapMsg("refresh token is disabled")
+	}
+	if err := s.userRpcClient.CheckAccountActive(ctx, req.UserID); err != nil {
+		return nil, err
+	}
+	token, refreshToken, err := s.authDatabase.RefreshToken(ctx, req.UserID, int(req.PlatformID), req.RefreshToken)
+	if err != nil {
+		return nil, err
+	}
+	return &authext.RefreshTokenResp{
+		Token:                    token,
+		ExpireTimeSeconds:        int64(accessTokenExpire(s.config) / time.Second),
+		RefreshToken:             refreshToken,
+		RefreshExpireTimeSeconds: int64(s.refreshTokenExpire() / time.Second),
+	}, nil
+}
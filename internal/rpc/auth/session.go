@@ -0,0 +1,125 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/authext"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/msggatewayext"
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/mcontext"
+	"github.com/openimsdk/tools/utils/encrypt"
+)
+
+// sessionID identifies a token session without exposing the token itself.
+func sessionID(token string) string {
+	return encrypt.Md5(token)
+}
+
+func (s *authServer) GetSessions(ctx context.Context, req *authext.GetSessionsReq) (*authext.GetSessionsResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, s.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	sessions, err := s.authDatabase.GetTokenSessions(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	resp := &authext.GetSessionsResp{Sessions: make([]*authext.TokenSession, 0, len(sessions))}
+	for _, session := range sessions {
+		resp.Sessions = append(resp.Sessions, &authext.TokenSession{
+			SessionID:    sessionID(session.Token),
+			PlatformID:   int32(session.PlatformID),
+			DeviceName:   session.DeviceName,
+			Ip:           session.IP,
+			UserAgent:    session.UserAgent,
+			ConnID:       session.ConnID,
+			CreateTime:   session.CreateTime,
+			LastSeenTime: session.LastSeenTime,
+		})
+	}
+	return resp, nil
+}
+
+func (s *authServer) RevokeSession(ctx context.Context, req *authext.RevokeSessionReq) (*authext.RevokeSessionResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, s.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	sessions, err := s.authDatabase.GetTokenSessions(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	for _, session := range sessions {
+		if sessionID(session.Token) != req.SessionID {
+			continue
+		}
+		if err := s.authDatabase.RevokeTokenSession(ctx, req.UserID, session.PlatformID, session.Token); err != nil {
+			return nil, err
+		}
+		if session.ConnID != "" {
+			if err := s.kickConnOffline(ctx, req.UserID, int32(session.PlatformID), session.ConnID); err != nil {
+				return nil, err
+			}
+		}
+		return &authext.RevokeSessionResp{}, nil
+	}
+	return nil, errs.ErrRecordNotFound.WrapMsg("session not found", "sessionID", req.SessionID)
+}
+
+func (s *authServer) RevokeUserTokens(ctx context.Context, req *authext.RevokeUserTokensReq) (*authext.RevokeUserTokensResp, error) {
+	if err := authverify.CheckAdmin(ctx, s.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	count, err := s.authDatabase.RevokeUserTokens(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	for platformID := range constant.PlatformID2Name {
+		if err := s.forceKickOff(ctx, req.UserID, int32(platformID), mcontext.GetOperationID(ctx)); err != nil {
+			return nil, err
+		}
+	}
+	return &authext.RevokeUserTokensResp{Count: int32(count)}, nil
+}
+
+func (s *authServer) TouchSession(ctx context.Context, req *authext.TouchSessionReq) (*authext.TouchSessionResp, error) {
+	claims, err := s.parseToken(ctx, req.Token)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authDatabase.TouchTokenSession(ctx, claims.UserID, claims.PlatformID, req.Token, req.ConnID, req.Ip, req.UserAgent); err != nil {
+		return nil, err
+	}
+	return &authext.TouchSessionResp{}, nil
+}
+
+// kickConnOffline kicks a single long connection, whichever gateway instance holds it.
+func (s *authServer) kickConnOffline(ctx context.Context, userID string, platformID int32, connID string) error {
+	conns, err := s.RegisterCenter.GetConns(ctx, s.config.Share.RpcRegisterName.MessageGateway)
+	if err != nil {
+		return err
+	}
+	for _, v := range conns {
+		client := msggatewayext.NewMsgGatewayExtClient(v)
+		kickReq := &msggatewayext.KickConnOfflineReq{UserID: userID, PlatformID: platformID, ConnIDs: []string{connID}}
+		if _, err := client.KickConnOffline(ctx, kickReq); err != nil {
+			log.ZError(ctx, "kickConnOffline", err, "kickReq", kickReq)
+		}
+	}
+	return nil
+}
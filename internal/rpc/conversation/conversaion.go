@@ -16,11 +16,13 @@ package conversation
 
 import (
 	"context"
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache/redis"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database/mgo"
 	tablerelation "github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
 	"github.com/openimsdk/open-im-server/v3/pkg/localcache"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/conversationext"
 	"github.com/openimsdk/tools/db/redisutil"
 	"sort"
 
@@ -44,6 +46,9 @@ type conversationServer struct {
 	user                           *rpcclient.UserRpcClient
 	groupRpcClient                 *rpcclient.GroupRpcClient
 	conversationDatabase           controller.ConversationDatabase
+	folderDatabase                 controller.ConversationFolderDatabase
+	draftDatabase                  controller.ConversationDraftDatabase
+	dndDatabase                    controller.DoNotDisturbDatabase
 	conversationNotificationSender *ConversationNotificationSender
 	config                         *Config
 }
@@ -71,18 +76,41 @@ func Start(ctx context.Context, config *Config, client discovery.SvcDiscoveryReg
 	if err != nil {
 		return err
 	}
+	conversationVersionDB, err := mgo.NewVersionLogMgo(mgocli.GetDB(), "conversation")
+	if err != nil {
+		return err
+	}
+	folderDB, err := mgo.NewConversationFolderMgo(mgocli.GetDB())
+	if err != nil {
+		return err
+	}
+	draftDB, err := mgo.NewConversationDraftMgo(mgocli.GetDB())
+	if err != nil {
+		return err
+	}
+	dndDB, err := mgo.NewDoNotDisturbMgo(mgocli.GetDB())
+	if err != nil {
+		return err
+	}
 	groupRpcClient := rpcclient.NewGroupRpcClient(client, config.Share.RpcRegisterName.Group)
 	msgRpcClient := rpcclient.NewMessageRpcClient(client, config.Share.RpcRegisterName.Msg)
 	userRpcClient := rpcclient.NewUserRpcClient(client, config.Share.RpcRegisterName.User, config.Share.IMAdminUserID)
 	localcache.InitLocalCache(&config.LocalCacheConfig)
-	pbconversation.RegisterConversationServer(server, &conversationServer{
+	cs := &conversationServer{
 		msgRpcClient:                   &msgRpcClient,
 		user:                           &userRpcClient,
 		conversationNotificationSender: NewConversationNotificationSender(&config.NotificationConfig, &msgRpcClient),
 		groupRpcClient:                 &groupRpcClient,
-		conversationDatabase: controller.NewConversationDatabase(conversationDB,
+		conversationDatabase: controller.NewConversationDatabase(conversationDB, conversationVersionDB,
 			redis.NewConversationRedis(rdb, &config.LocalCacheConfig, redis.GetRocksCacheOptions(), conversationDB), mgocli.GetTx()),
-	})
+		folderDatabase: controller.NewConversationFolderDatabase(folderDB),
+		draftDatabase:  controller.NewConversationDraftDatabase(draftDB, redis.NewDraftCache(rdb)),
+		dndDatabase:    controller.NewDoNotDisturbDatabase(dndDB, redis.NewDoNotDisturbCacheRedis(rdb, dndDB, redis.GetRocksCacheOptions())),
+		config:         config,
+	}
+	pbconversation.RegisterConversationServer(server, cs)
+	conversationext.RegisterConversationExtServer(server, cs)
+	go cs.draftWriter(ctx)
 	return nil
 }
 
@@ -134,15 +162,15 @@ func (c *conversationServer) GetSortedConversationList(ctx context.Context, req
 		return nil, err
 	}
 
-	hasReadSeqs, err := c.msgRpcClient.GetHasReadSeqs(ctx, req.UserID, conversationIDs)
+	unreadCounts, err := c.msgRpcClient.GetConversationsUnreadCount(ctx, req.UserID, conversationIDs)
 	if err != nil {
 		return nil, err
 	}
 
 	var unreadTotal int64
 	conversation_unreadCount := make(map[string]int64)
-	for conversationID, maxSeq := range maxSeqs {
-		unreadCount := maxSeq - hasReadSeqs[conversationID]
+	for conversationID := range maxSeqs {
+		unreadCount := unreadCounts[conversationID]
 		conversation_unreadCount[conversationID] = unreadCount
 		unreadTotal += unreadCount
 	}
@@ -409,24 +437,11 @@ func (c *conversationServer) GetConversationOfflinePushUserIDs(ctx context.Conte
 	if req.ConversationID == "" {
 		return nil, errs.ErrArgs.WrapMsg("conversationID is empty")
 	}
-	if len(req.UserIDs) == 0 {
-		return &pbconversation.GetConversationOfflinePushUserIDsResp{}, nil
-	}
-	userIDs, err := c.conversationDatabase.GetConversationNotReceiveMessageUserIDs(ctx, req.ConversationID)
+	userIDs, err := c.getOfflinePushUserIDs(ctx, req.ConversationID, req.UserIDs, nil)
 	if err != nil {
 		return nil, err
 	}
-	if len(userIDs) == 0 {
-		return &pbconversation.GetConversationOfflinePushUserIDsResp{UserIDs: req.UserIDs}, nil
-	}
-	userIDSet := make(map[string]struct{})
-	for _, userID := range req.UserIDs {
-		userIDSet[userID] = struct{}{}
-	}
-	for _, userID := range userIDs {
-		delete(userIDSet, userID)
-	}
-	return &pbconversation.GetConversationOfflinePushUserIDsResp{UserIDs: datautil.Keys(userIDSet)}, nil
+	return &pbconversation.GetConversationOfflinePushUserIDsResp{UserIDs: userIDs}, nil
 }
 
 func (c *conversationServer) conversationSort(conversations map[int64]string, resp *pbconversation.GetSortedConversationListResp, conversation_unreadCount map[string]int64, conversationMsg map[string]*pbconversation.ConversationElem) {
@@ -581,3 +596,22 @@ func (c *conversationServer) UpdateConversation(ctx context.Context, req *pbconv
 	}
 	return &pbconversation.UpdateConversationResp{}, nil
 }
+
+func (c *conversationServer) PurgeUserConversations(ctx context.Context, req *conversationext.PurgeUserConversationsReq) (*conversationext.PurgeUserConversationsResp, error) {
+	if err := authverify.CheckAdmin(ctx, c.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	if err := c.conversationDatabase.DeleteUserConversations(ctx, req.UserID); err != nil {
+		return nil, err
+	}
+	if err := c.folderDatabase.DeleteUserFolders(ctx, req.UserID); err != nil {
+		return nil, err
+	}
+	if err := c.draftDatabase.DeleteUserDrafts(ctx, req.UserID); err != nil {
+		return nil, err
+	}
+	if err := c.dndDatabase.DeleteDoNotDisturb(ctx, req.UserID); err != nil {
+		return nil, err
+	}
+	return &conversationext.PurgeUserConversationsResp{}, nil
+}
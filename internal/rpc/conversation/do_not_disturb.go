@@ -0,0 +1,231 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conversation
+
+import (
+	"context"
+	"sync"
+	"time"
+	// The time zones of users are resolved even if the host has no zoneinfo.
+	_ "time/tzdata"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database/mgo"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/conversationext"
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/utils/datautil"
+)
+
+// locations caches the loaded time zones, loading one reads the zoneinfo.
+var locations sync.Map
+
+func loadLocation(name string) (*time.Location, error) {
+	if v, ok := locations.Load(name); ok {
+		return v.(*time.Location), nil
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, err
+	}
+	locations.Store(name, loc)
+	return loc, nil
+}
+
+// inDoNotDisturbSchedule reports whether the local time is within the quiet period.
+func inDoNotDisturbSchedule(schedule *model.DoNotDisturbSchedule, local time.Time) bool {
+	minute := int32(local.Hour()*60 + local.Minute())
+	weekday := int32(local.Weekday())
+	onDay := func(day int32) bool {
+		return len(schedule.Weekdays) == 0 || datautil.Contain(day, schedule.Weekdays...)
+	}
+	switch {
+	case schedule.StartMinute == schedule.EndMinute:
+		return onDay(weekday)
+	case schedule.StartMinute < schedule.EndMinute:
+		return onDay(weekday) && minute >= schedule.StartMinute && minute < schedule.EndMinute
+	default:
+		// The period started today or continues from yesterday.
+		return (onDay(weekday) && minute >= schedule.StartMinute) || (onDay((weekday+6)%7) && minute < schedule.EndMinute)
+	}
+}
+
+// doNotDisturbHolds reports whether the offline push of a message of the conversation is held back for the user.
+func doNotDisturbHolds(dnd *model.DoNotDisturb, conversationID string, mentioned bool, now time.Time) bool {
+	for _, muted := range dnd.MutedConversations {
+		if muted.ConversationID == conversationID && now.Before(muted.MuteUntil) {
+			return true
+		}
+	}
+	if len(dnd.Schedules) == 0 || datautil.Contain(conversationID, dnd.ExceptConversationIDs...) || (mentioned && dnd.AllowMentions) {
+		return false
+	}
+	loc := time.UTC
+	if dnd.TimeZone != "" {
+		var err error
+		if loc, err = loadLocation(dnd.TimeZone); err != nil {
+			return false
+		}
+	}
+	local := now.In(loc)
+	for i := range dnd.Schedules {
+		if inDoNotDisturbSchedule(&dnd.Schedules[i], local) {
+			return true
+		}
+	}
+	return false
+}
+
+func convertDoNotDisturb(dnd *model.DoNotDisturb) *conversationext.DoNotDisturb {
+	now := time.Now()
+	res := &conversationext.DoNotDisturb{
+		TimeZone:              dnd.TimeZone,
+		AllowMentions:         dnd.AllowMentions,
+		ExceptConversationIDs: dnd.ExceptConversationIDs,
+	}
+	for _, schedule := range dnd.Schedules {
+		res.Schedules = append(res.Schedules, &conversationext.DoNotDisturbSchedule{
+			Weekdays:    schedule.Weekdays,
+			StartMinute: schedule.StartMinute,
+			EndMinute:   schedule.EndMinute,
+		})
+	}
+	for _, muted := range dnd.MutedConversations {
+		if now.Before(muted.MuteUntil) {
+			res.MutedConversations = append(res.MutedConversations, &conversationext.MutedConversation{
+				ConversationID: muted.ConversationID,
+				MuteUntil:      muted.MuteUntil.UnixMilli(),
+			})
+		}
+	}
+	return res
+}
+
+func (c *conversationServer) SetDoNotDisturb(ctx context.Context, req *conversationext.SetDoNotDisturbReq) (*conversationext.SetDoNotDisturbResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, c.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	if req.TimeZone != "" {
+		if _, err := loadLocation(req.TimeZone); err != nil {
+			return nil, errs.ErrArgs.WrapMsg("invalid time zone", "timeZone", req.TimeZone)
+		}
+	}
+	dnd := &model.DoNotDisturb{
+		UserID:                req.UserID,
+		TimeZone:              req.TimeZone,
+		Schedules:             make([]model.DoNotDisturbSchedule, 0, len(req.Schedules)),
+		AllowMentions:         req.AllowMentions,
+		ExceptConversationIDs: datautil.Distinct(req.ExceptConversationIDs),
+		UpdateTime:            time.Now(),
+	}
+	for _, schedule := range req.Schedules {
+		dnd.Schedules = append(dnd.Schedules, model.DoNotDisturbSchedule{
+			Weekdays:    datautil.Distinct(schedule.Weekdays),
+			StartMinute: schedule.StartMinute,
+			EndMinute:   schedule.EndMinute,
+		})
+	}
+	if err := c.dndDatabase.SetDoNotDisturb(ctx, dnd); err != nil {
+		return nil, err
+	}
+	c.conversationNotificationSender.DoNotDisturbNotification(ctx, req.UserID)
+	return &conversationext.SetDoNotDisturbResp{}, nil
+}
+
+func (c *conversationServer) GetDoNotDisturb(ctx context.Context, req *conversationext.GetDoNotDisturbReq) (*conversationext.GetDoNotDisturbResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, c.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	dnd, err := c.dndDatabase.TakeDoNotDisturb(ctx, req.UserID)
+	if err != nil {
+		if mgo.IsNotFound(err) {
+			return &conversationext.GetDoNotDisturbResp{DoNotDisturb: &conversationext.DoNotDisturb{}}, nil
+		}
+		return nil, err
+	}
+	return &conversationext.GetDoNotDisturbResp{DoNotDisturb: convertDoNotDisturb(dnd)}, nil
+}
+
+func (c *conversationServer) MuteConversationUntil(ctx context.Context, req *conversationext.MuteConversationUntilReq) (*conversationext.MuteConversationUntilResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, c.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	if err := c.dndDatabase.MuteConversation(ctx, req.UserID, req.ConversationID, time.UnixMilli(req.MuteUntil)); err != nil {
+		return nil, err
+	}
+	if err := c.conversationDatabase.IncrConversationsVersion(ctx, req.UserID, []string{req.ConversationID}); err != nil {
+		return nil, err
+	}
+	c.conversationNotificationSender.ConversationChangeNotification(ctx, req.UserID, []string{req.ConversationID})
+	return &conversationext.MuteConversationUntilResp{}, nil
+}
+
+func (c *conversationServer) GetOfflinePushUserIDs(ctx context.Context, req *conversationext.GetOfflinePushUserIDsReq) (*conversationext.GetOfflinePushUserIDsResp, error) {
+	var (
+		userIDs []string
+		err     error
+	)
+	if req.DoNotDisturbOnly {
+		userIDs, err = c.filterDoNotDisturb(ctx, req.ConversationID, req.UserIDs, req.MentionedUserIDs)
+	} else {
+		userIDs, err = c.getOfflinePushUserIDs(ctx, req.ConversationID, req.UserIDs, req.MentionedUserIDs)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &conversationext.GetOfflinePushUserIDsResp{UserIDs: userIDs}, nil
+}
+
+// getOfflinePushUserIDs leaves out the users not receiving notifications of the conversation and the users whose
+// do not disturb settings hold back the push now.
+func (c *conversationServer) getOfflinePushUserIDs(ctx context.Context, conversationID string, userIDs []string, mentionedUserIDs []string) ([]string, error) {
+	if len(userIDs) == 0 {
+		return []string{}, nil
+	}
+	notReceiveUserIDs, err := c.conversationDatabase.GetConversationNotReceiveMessageUserIDs(ctx, conversationID)
+	if err != nil {
+		return nil, err
+	}
+	userIDSet := datautil.SliceSet(userIDs)
+	for _, userID := range notReceiveUserIDs {
+		delete(userIDSet, userID)
+	}
+	return c.filterDoNotDisturb(ctx, conversationID, datautil.Keys(userIDSet), mentionedUserIDs)
+}
+
+// filterDoNotDisturb leaves out the users whose do not disturb settings hold back the push now.
+func (c *conversationServer) filterDoNotDisturb(ctx context.Context, conversationID string, userIDs []string, mentionedUserIDs []string) ([]string, error) {
+	if len(userIDs) == 0 {
+		return []string{}, nil
+	}
+	userIDSet := datautil.SliceSet(userIDs)
+	dnds, err := c.dndDatabase.FindDoNotDisturbs(ctx, datautil.Keys(userIDSet))
+	if err != nil {
+		return nil, err
+	}
+	// @all mentions every user.
+	mentionAll := datautil.Contain(constant.AtAllString, mentionedUserIDs...)
+	now := time.Now()
+	for _, dnd := range dnds {
+		mentioned := mentionAll || datautil.Contain(dnd.UserID, mentionedUserIDs...)
+		if doNotDisturbHolds(dnd, conversationID, mentioned, now) {
+			log.ZDebug(ctx, "offline push held back by do not disturb", "userID", dnd.UserID, "conversationID", conversationID)
+			delete(userIDSet, dnd.UserID)
+		}
+	}
+	return datautil.Keys(userIDSet), nil
+}
@@ -0,0 +1,163 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conversation
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/controller"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/conversationext"
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/tools/mcontext"
+)
+
+func TestDoNotDisturbHolds(t *testing.T) {
+	// 2024-06-03 is a Monday
+	at := func(day, hour, minute int) time.Time {
+		return time.Date(2024, 6, day, hour, minute, 0, 0, time.UTC)
+	}
+	weeknights := model.DoNotDisturbSchedule{Weekdays: []int32{1, 2, 3, 4, 5}, StartMinute: 22 * 60, EndMinute: 7 * 60}
+	dnd := &model.DoNotDisturb{
+		UserID:                "u1",
+		Schedules:             []model.DoNotDisturbSchedule{weeknights},
+		AllowMentions:         true,
+		ExceptConversationIDs: []string{"vip"},
+		MutedConversations:    []model.MutedConversation{{ConversationID: "muted", MuteUntil: at(3, 12, 0)}},
+	}
+	tests := []struct {
+		name           string
+		conversationID string
+		mentioned      bool
+		now            time.Time
+		holds          bool
+	}{
+		{"monday night", "c", false, at(3, 23, 0), true},
+		{"tuesday morning", "c", false, at(4, 6, 59), true},
+		{"tuesday after end", "c", false, at(4, 7, 0), false},
+		{"monday early morning", "c", false, at(3, 6, 0), false},
+		{"saturday morning", "c", false, at(8, 6, 0), true},
+		{"saturday night", "c", false, at(8, 23, 0), false},
+		{"except conversation", "vip", false, at(3, 23, 0), false},
+		{"mentioned", "c", true, at(3, 23, 0), false},
+		{"muted", "muted", false, at(3, 11, 0), true},
+		{"mute expired", "muted", false, at(3, 12, 0), false},
+		{"muted and mentioned", "muted", true, at(3, 11, 0), true},
+	}
+	for _, tt := range tests {
+		if holds := doNotDisturbHolds(dnd, tt.conversationID, tt.mentioned, tt.now); holds != tt.holds {
+			t.Errorf("%s: holds %v, want %v", tt.name, holds, tt.holds)
+		}
+	}
+}
+
+func TestDoNotDisturbTimeZone(t *testing.T) {
+	dnd := &model.DoNotDisturb{
+		TimeZone:  "Asia/Shanghai",
+		Schedules: []model.DoNotDisturbSchedule{{StartMinute: 22 * 60, EndMinute: 7 * 60}},
+	}
+	// 15:00 UTC is 23:00 in Shanghai
+	if !doNotDisturbHolds(dnd, "c", false, time.Date(2024, 6, 3, 15, 0, 0, 0, time.UTC)) {
+		t.Error("quiet hours not applied in the time zone of the user")
+	}
+	if doNotDisturbHolds(dnd, "c", false, time.Date(2024, 6, 3, 23, 0, 0, 0, time.UTC)) {
+		t.Error("quiet hours applied in UTC")
+	}
+	allDay := &model.DoNotDisturb{Schedules: []model.DoNotDisturbSchedule{{Weekdays: []int32{0}}}}
+	if !doNotDisturbHolds(allDay, "c", false, time.Date(2024, 6, 2, 12, 0, 0, 0, time.UTC)) {
+		t.Error("whole day schedule not applied")
+	}
+}
+
+type stubNotReceiveConversationDB struct {
+	controller.ConversationDatabase
+	notReceiveUserIDs []string
+}
+
+func (s *stubNotReceiveConversationDB) GetConversationNotReceiveMessageUserIDs(ctx context.Context, conversationID string) ([]string, error) {
+	return s.notReceiveUserIDs, nil
+}
+
+type stubDoNotDisturbDB struct {
+	controller.DoNotDisturbDatabase
+	dnds []*model.DoNotDisturb
+}
+
+func (s *stubDoNotDisturbDB) FindDoNotDisturbs(ctx context.Context, userIDs []string) ([]*model.DoNotDisturb, error) {
+	var res []*model.DoNotDisturb
+	for _, dnd := range s.dnds {
+		for _, userID := range userIDs {
+			if dnd.UserID == userID {
+				res = append(res, dnd)
+			}
+		}
+	}
+	return res, nil
+}
+
+func (s *stubDoNotDisturbDB) SetDoNotDisturb(ctx context.Context, dnd *model.DoNotDisturb) error {
+	s.dnds = append(s.dnds, dnd)
+	return nil
+}
+
+func TestGetOfflinePushUserIDs(t *testing.T) {
+	s, _ := newConversationTestServer(&stubFolderDB{})
+	s.conversationDatabase = &stubNotReceiveConversationDB{notReceiveUserIDs: []string{"off"}}
+	s.dndDatabase = &stubDoNotDisturbDB{dnds: []*model.DoNotDisturb{
+		{UserID: "quiet", Schedules: []model.DoNotDisturbSchedule{{}}},
+		{UserID: "mentions", Schedules: []model.DoNotDisturbSchedule{{}}, AllowMentions: true},
+		{UserID: "none"},
+	}}
+	ctx := context.Background()
+	get := func(doNotDisturbOnly bool, mentionedUserIDs ...string) []string {
+		resp, err := s.GetOfflinePushUserIDs(ctx, &conversationext.GetOfflinePushUserIDsReq{
+			ConversationID:   "c",
+			UserIDs:          []string{"off", "quiet", "mentions", "none", "other"},
+			MentionedUserIDs: mentionedUserIDs,
+			DoNotDisturbOnly: doNotDisturbOnly,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		sort.Strings(resp.UserIDs)
+		return resp.UserIDs
+	}
+	if userIDs := get(false); len(userIDs) != 2 || userIDs[0] != "none" || userIDs[1] != "other" {
+		t.Errorf("offline push users %v, expected [none other]", userIDs)
+	}
+	// The receive option is left to the sender of single chats.
+	if userIDs := get(true); len(userIDs) != 3 || userIDs[0] != "none" || userIDs[1] != "off" || userIDs[2] != "other" {
+		t.Errorf("do not disturb push users %v, expected [none off other]", userIDs)
+	}
+	if userIDs := get(false, "mentions"); len(userIDs) != 3 || userIDs[0] != "mentions" {
+		t.Errorf("mentioned push users %v, expected [mentions none other]", userIDs)
+	}
+	if userIDs := get(false, constant.AtAllString); len(userIDs) != 3 || userIDs[0] != "mentions" {
+		t.Errorf("@all push users %v, expected [mentions none other]", userIDs)
+	}
+}
+
+func TestSetDoNotDisturbNotification(t *testing.T) {
+	s, recorder := newConversationTestServer(&stubFolderDB{})
+	s.dndDatabase = &stubDoNotDisturbDB{}
+	ctx := mcontext.SetOpUserID(context.Background(), "u1")
+	if _, err := s.SetDoNotDisturb(ctx, &conversationext.SetDoNotDisturbReq{UserID: "u1", TimeZone: "Asia/Shanghai"}); err != nil {
+		t.Fatal(err)
+	}
+	recorder.Receive(t, conversationext.DoNotDisturbNotification, nil)
+}
@@ -0,0 +1,108 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conversation
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/conversationext"
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/mcontext"
+	"github.com/openimsdk/tools/utils/datautil"
+)
+
+const (
+	// draftWriteInterval is how long drafts stay in the cache before they are written to the database.
+	draftWriteInterval = 5 * time.Second
+	// draftWriteBatchSize is the number of users whose drafts are written at a time.
+	draftWriteBatchSize = 200
+)
+
+func convertConversationDraft(draft *model.ConversationDraft) *conversationext.ConversationDraft {
+	return &conversationext.ConversationDraft{
+		ConversationID: draft.ConversationID,
+		Draft:          draft.Draft,
+		UpdateTime:     draft.UpdateTime.UnixMilli(),
+	}
+}
+
+func (c *conversationServer) getConversationDrafts(ctx context.Context, userID string, conversationIDs []string) ([]*conversationext.ConversationDraft, error) {
+	drafts, err := c.draftDatabase.GetDrafts(ctx, userID, conversationIDs)
+	if err != nil {
+		return nil, err
+	}
+	return datautil.Slice(drafts, convertConversationDraft), nil
+}
+
+func (c *conversationServer) SetConversationDraft(ctx context.Context, req *conversationext.SetConversationDraftReq) (*conversationext.SetConversationDraftResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, c.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	conversations, err := c.conversationDatabase.FindConversations(ctx, req.UserID, []string{req.ConversationID})
+	if err != nil {
+		return nil, err
+	}
+	if len(conversations) == 0 {
+		return nil, errs.ErrRecordNotFound.WrapMsg("conversation not found", "conversationID", req.ConversationID)
+	}
+	draft, err := c.draftDatabase.SetDraft(ctx, req.UserID, req.ConversationID, req.Draft)
+	if err != nil {
+		return nil, err
+	}
+	// Drafts are left out of the version log, which would cost a database write and a resync of the other devices on
+	// every pause in typing. They learn of the draft from the notification, and GetIncrementalConversation returns all drafts.
+	c.conversationNotificationSender.ConversationDraftNotification(ctx, req.UserID, convertConversationDraft(draft),
+		int32(constant.PlatformNameToID(mcontext.GetOpUserPlatform(ctx))))
+	return &conversationext.SetConversationDraftResp{UpdateTime: draft.UpdateTime.UnixMilli()}, nil
+}
+
+func (c *conversationServer) GetConversationDrafts(ctx context.Context, req *conversationext.GetConversationDraftsReq) (*conversationext.GetConversationDraftsResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, c.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	drafts, err := c.getConversationDrafts(ctx, req.UserID, req.ConversationIDs)
+	if err != nil {
+		return nil, err
+	}
+	return &conversationext.GetConversationDraftsResp{Drafts: drafts}, nil
+}
+
+// draftWriter writes the cached drafts to the database, so that setting a draft on every pause in typing only costs a cache write.
+func (c *conversationServer) draftWriter(ctx context.Context) {
+	ticker := time.NewTicker(draftWriteInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		for ctx.Err() == nil {
+			n, err := c.draftDatabase.WriteDrafts(ctx, draftWriteBatchSize)
+			if err != nil {
+				log.ZError(ctx, "write drafts failed", err)
+				break
+			}
+			if n < draftWriteBatchSize {
+				break
+			}
+		}
+	}
+}
@@ -0,0 +1,258 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conversation
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database/mgo"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/conversationext"
+	"github.com/openimsdk/open-im-server/v3/pkg/util/orderutil"
+	pbconversation "github.com/openimsdk/protocol/conversation"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/utils/datautil"
+)
+
+func convertConversationFolder(folder *model.ConversationFolder) *conversationext.ConversationFolder {
+	return &conversationext.ConversationFolder{
+		FolderID:        folder.FolderID,
+		Name:            folder.Name,
+		Order:           folder.Order,
+		ConversationIDs: folder.ConversationIDs,
+		Ex:              folder.Ex,
+		CreateTime:      folder.CreateTime.UnixMilli(),
+	}
+}
+
+func (c *conversationServer) takeConversationFolder(ctx context.Context, userID string, folderID string) (*model.ConversationFolder, error) {
+	folder, err := c.folderDatabase.TakeFolder(ctx, userID, folderID)
+	if err != nil {
+		if mgo.IsNotFound(err) {
+			return nil, errs.ErrRecordNotFound.WrapMsg("conversation folder not found", "folderID", folderID)
+		}
+		return nil, err
+	}
+	return folder, nil
+}
+
+func (c *conversationServer) CreateConversationFolder(ctx context.Context, req *conversationext.CreateConversationFolderReq) (*conversationext.CreateConversationFolderResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, c.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	folders, err := c.folderDatabase.FindFolders(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if limit := c.config.RpcConfig.Folder.MaxPerUser; limit > 0 && len(folders) >= limit {
+		return nil, errs.ErrArgs.WrapMsg("too many conversation folders", "max", limit)
+	}
+	folder := &model.ConversationFolder{
+		OwnerUserID:     req.UserID,
+		FolderID:        uuid.NewString(),
+		Name:            req.Name,
+		ConversationIDs: []string{},
+		Ex:              req.Ex,
+		CreateTime:      time.Now(),
+	}
+	// New folders are listed last.
+	folder.Order = orderutil.Next(folders, func(e *model.ConversationFolder) int32 { return e.Order })
+	if err := c.folderDatabase.CreateFolder(ctx, folder); err != nil {
+		return nil, err
+	}
+	c.conversationNotificationSender.ConversationFolderNotification(ctx, req.UserID, []string{folder.FolderID}, nil)
+	return &conversationext.CreateConversationFolderResp{Folder: convertConversationFolder(folder)}, nil
+}
+
+func (c *conversationServer) SetConversationFolder(ctx context.Context, req *conversationext.SetConversationFolderReq) (*conversationext.SetConversationFolderResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, c.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	folder, err := c.takeConversationFolder(ctx, req.UserID, req.FolderID)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.folderDatabase.UpdateFolder(ctx, req.UserID, req.FolderID, map[string]any{"name": req.Name, "ex": req.Ex}); err != nil {
+		return nil, err
+	}
+	if err := c.conversationDatabase.IncrConversationsVersion(ctx, req.UserID, folder.ConversationIDs); err != nil {
+		return nil, err
+	}
+	c.conversationNotificationSender.ConversationFolderNotification(ctx, req.UserID, []string{req.FolderID}, nil)
+	return &conversationext.SetConversationFolderResp{}, nil
+}
+
+func (c *conversationServer) DeleteConversationFolder(ctx context.Context, req *conversationext.DeleteConversationFolderReq) (*conversationext.DeleteConversationFolderResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, c.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	folder, err := c.takeConversationFolder(ctx, req.UserID, req.FolderID)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.folderDatabase.DeleteFolder(ctx, req.UserID, req.FolderID); err != nil {
+		return nil, err
+	}
+	if err := c.conversationDatabase.IncrConversationsVersion(ctx, req.UserID, folder.ConversationIDs); err != nil {
+		return nil, err
+	}
+	c.conversationNotificationSender.ConversationFolderNotification(ctx, req.UserID, []string{req.FolderID}, folder.ConversationIDs)
+	return &conversationext.DeleteConversationFolderResp{}, nil
+}
+
+func (c *conversationServer) SortConversationFolders(ctx context.Context, req *conversationext.SortConversationFoldersReq) (*conversationext.SortConversationFoldersResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, c.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	folders, err := c.folderDatabase.FindFolders(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	orders, missing := orderutil.Sort(folders, func(e *model.ConversationFolder) string { return e.FolderID },
+		func(e *model.ConversationFolder) int32 { return e.Order }, req.FolderIDs)
+	if missing != "" {
+		return nil, errs.ErrRecordNotFound.WrapMsg("conversation folder not found", "folderID", missing)
+	}
+	if len(orders) == 0 {
+		return &conversationext.SortConversationFoldersResp{}, nil
+	}
+	if err := c.folderDatabase.SortFolders(ctx, req.UserID, orders); err != nil {
+		return nil, err
+	}
+	c.conversationNotificationSender.ConversationFolderNotification(ctx, req.UserID, datautil.Keys(orders), nil)
+	return &conversationext.SortConversationFoldersResp{}, nil
+}
+
+func (c *conversationServer) GetConversationFolders(ctx context.Context, req *conversationext.GetConversationFoldersReq) (*conversationext.GetConversationFoldersResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, c.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	folders, err := c.folderDatabase.FindFolders(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	resp := &conversationext.GetConversationFoldersResp{Folders: make([]*conversationext.ConversationFolder, 0, len(folders))}
+	if len(folders) == 0 {
+		return resp, nil
+	}
+	var conversationIDs []string
+	for _, folder := range folders {
+		conversationIDs = append(conversationIDs, folder.ConversationIDs...)
+	}
+	conversationIDs = datautil.Distinct(conversationIDs)
+	var unreadCounts map[string]int64
+	if len(conversationIDs) > 0 {
+		unreadCounts, err = c.msgRpcClient.GetConversationsUnreadCount(ctx, req.UserID, conversationIDs)
+		if err != nil {
+			return nil, err
+		}
+	}
+	for _, folder := range folders {
+		pbFolder := convertConversationFolder(folder)
+		for _, conversationID := range folder.ConversationIDs {
+			pbFolder.UnreadTotal += unreadCounts[conversationID]
+		}
+		resp.Folders = append(resp.Folders, pbFolder)
+	}
+	return resp, nil
+}
+
+func (c *conversationServer) AddFolderConversations(ctx context.Context, req *conversationext.AddFolderConversationsReq) (*conversationext.AddFolderConversationsResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, c.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	if _, err := c.takeConversationFolder(ctx, req.UserID, req.FolderID); err != nil {
+		return nil, err
+	}
+	conversationIDs := datautil.Distinct(req.ConversationIDs)
+	conversations, err := c.conversationDatabase.FindConversations(ctx, req.UserID, conversationIDs)
+	if err != nil {
+		return nil, err
+	}
+	if len(conversations) != len(conversationIDs) {
+		found := datautil.SliceSet(datautil.Slice(conversations, func(e *model.Conversation) string { return e.ConversationID }))
+		for _, conversationID := range conversationIDs {
+			if _, ok := found[conversationID]; !ok {
+				return nil, errs.ErrRecordNotFound.WrapMsg("conversation not found", "conversationID", conversationID)
+			}
+		}
+	}
+	if err := c.folderDatabase.AddFolderConversations(ctx, req.UserID, req.FolderID, conversationIDs); err != nil {
+		return nil, err
+	}
+	if err := c.conversationDatabase.IncrConversationsVersion(ctx, req.UserID, conversationIDs); err != nil {
+		return nil, err
+	}
+	c.conversationNotificationSender.ConversationFolderNotification(ctx, req.UserID, []string{req.FolderID}, conversationIDs)
+	return &conversationext.AddFolderConversationsResp{}, nil
+}
+
+func (c *conversationServer) RemoveFolderConversations(ctx context.Context, req *conversationext.RemoveFolderConversationsReq) (*conversationext.RemoveFolderConversationsResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, c.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	if _, err := c.takeConversationFolder(ctx, req.UserID, req.FolderID); err != nil {
+		return nil, err
+	}
+	conversationIDs := datautil.Distinct(req.ConversationIDs)
+	if err := c.folderDatabase.RemoveFolderConversations(ctx, req.UserID, req.FolderID, conversationIDs); err != nil {
+		return nil, err
+	}
+	if err := c.conversationDatabase.IncrConversationsVersion(ctx, req.UserID, conversationIDs); err != nil {
+		return nil, err
+	}
+	c.conversationNotificationSender.ConversationFolderNotification(ctx, req.UserID, []string{req.FolderID}, conversationIDs)
+	return &conversationext.RemoveFolderConversationsResp{}, nil
+}
+
+func (c *conversationServer) GetFolderSortedConversationList(ctx context.Context, req *conversationext.GetFolderSortedConversationListReq) (*conversationext.GetFolderSortedConversationListResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, c.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	folder, err := c.takeConversationFolder(ctx, req.UserID, req.FolderID)
+	if err != nil {
+		return nil, err
+	}
+	resp := &conversationext.GetFolderSortedConversationListResp{ConversationElems: []*pbconversation.ConversationElem{}}
+	// An empty list of conversation IDs means all conversations of the user.
+	if len(folder.ConversationIDs) == 0 {
+		return resp, nil
+	}
+	sorted, err := c.GetSortedConversationList(ctx, &pbconversation.GetSortedConversationListReq{
+		UserID:          req.UserID,
+		ConversationIDs: folder.ConversationIDs,
+		Pagination:      req.Pagination,
+	})
+	if err != nil {
+		if errs.ErrRecordNotFound.Is(err) {
+			return resp, nil
+		}
+		return nil, err
+	}
+	resp.ConversationTotal = sorted.ConversationTotal
+	resp.UnreadTotal = sorted.UnreadTotal
+	resp.ConversationElems = sorted.ConversationElems
+	if len(resp.ConversationElems) > 0 {
+		conversationIDs := datautil.Slice(resp.ConversationElems, func(e *pbconversation.ConversationElem) string { return e.ConversationID })
+		resp.Drafts, err = c.getConversationDrafts(ctx, req.UserID, conversationIDs)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return resp, nil
+}
@@ -0,0 +1,117 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conversation
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/controller"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/conversationext"
+	"github.com/openimsdk/open-im-server/v3/pkg/rpcclient/notificationtest"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/mcontext"
+)
+
+type stubFolderDB struct {
+	controller.ConversationFolderDatabase
+	folders []*model.ConversationFolder
+}
+
+func (s *stubFolderDB) FindFolders(ctx context.Context, ownerUserID string) ([]*model.ConversationFolder, error) {
+	return s.folders, nil
+}
+
+func (s *stubFolderDB) CreateFolder(ctx context.Context, folder *model.ConversationFolder) error {
+	s.folders = append(s.folders, folder)
+	return nil
+}
+
+func (s *stubFolderDB) SortFolders(ctx context.Context, ownerUserID string, orders map[string]int32) error {
+	for _, folder := range s.folders {
+		if order, ok := orders[folder.FolderID]; ok {
+			folder.Order = order
+		}
+	}
+	return nil
+}
+
+// newConversationTestServer returns a server whose notifications are received by the returned recorder.
+func newConversationTestServer(folderDB *stubFolderDB) (*conversationServer, *notificationtest.Recorder) {
+	sender, recorder := notificationtest.NewRecorder()
+	return &conversationServer{
+		folderDatabase:                 folderDB,
+		conversationNotificationSender: &ConversationNotificationSender{sender},
+		config:                         &Config{Share: config.Share{IMAdminUserID: []string{"admin"}}},
+	}, recorder
+}
+
+func TestCreateConversationFolder(t *testing.T) {
+	folderDB := &stubFolderDB{folders: []*model.ConversationFolder{{FolderID: "f1", Order: 0}, {FolderID: "f2", Order: 4}}}
+	s, recorder := newConversationTestServer(folderDB)
+	ctx := mcontext.SetOpUserID(context.Background(), "u1")
+
+	resp, err := s.CreateConversationFolder(ctx, &conversationext.CreateConversationFolderReq{UserID: "u1", Name: "work"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Folder.Order != 5 {
+		t.Errorf("new folder order %d, expected 5", resp.Folder.Order)
+	}
+	var tips conversationext.ConversationFolderTips
+	recorder.Receive(t, conversationext.ConversationFolderNotification, &tips)
+	if !reflect.DeepEqual(tips.FolderIDs, []string{resp.Folder.FolderID}) {
+		t.Errorf("notified folders %v, expected the new folder %s", tips.FolderIDs, resp.Folder.FolderID)
+	}
+
+	s.config.RpcConfig.Folder.MaxPerUser = 3
+	if _, err := s.CreateConversationFolder(ctx, &conversationext.CreateConversationFolderReq{UserID: "u1", Name: "family"}); !errs.ErrArgs.Is(err) {
+		t.Fatalf("folder over the limit: %v", err)
+	}
+}
+
+func TestSortConversationFolders(t *testing.T) {
+	folderDB := &stubFolderDB{folders: []*model.ConversationFolder{
+		{FolderID: "f1", Order: 0},
+		{FolderID: "f2", Order: 1},
+		{FolderID: "f3", Order: 2},
+	}}
+	s, recorder := newConversationTestServer(folderDB)
+	ctx := mcontext.SetOpUserID(context.Background(), "u1")
+
+	if _, err := s.SortConversationFolders(ctx, &conversationext.SortConversationFoldersReq{UserID: "u1", FolderIDs: []string{"f4"}}); !errs.ErrRecordNotFound.Is(err) {
+		t.Fatalf("unknown folder: %v", err)
+	}
+	if _, err := s.SortConversationFolders(ctx, &conversationext.SortConversationFoldersReq{UserID: "u1", FolderIDs: []string{"f3"}}); err != nil {
+		t.Fatal(err)
+	}
+	orders := make(map[string]int32)
+	for _, folder := range folderDB.folders {
+		orders[folder.FolderID] = folder.Order
+	}
+	if expected := map[string]int32{"f3": 0, "f1": 1, "f2": 2}; !reflect.DeepEqual(orders, expected) {
+		t.Errorf("orders %v, expected %v", orders, expected)
+	}
+	var tips conversationext.ConversationFolderTips
+	recorder.Receive(t, conversationext.ConversationFolderNotification, &tips)
+	sort.Strings(tips.FolderIDs)
+	if !reflect.DeepEqual(tips.FolderIDs, []string{"f1", "f2", "f3"}) {
+		t.Errorf("notified folders %v, expected the reordered folders", tips.FolderIDs)
+	}
+}
@@ -0,0 +1,88 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conversation
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/convert"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/conversationext"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/utils/datautil"
+)
+
+// maxIncrementalConversationChanges is the number of changed conversations above which a full resync is cheaper.
+const maxIncrementalConversationChanges = 500
+
+func (c *conversationServer) GetIncrementalConversation(ctx context.Context, req *conversationext.GetIncrementalConversationReq) (*conversationext.GetIncrementalConversationResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, c.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	drafts, err := c.getConversationDrafts(ctx, req.UserID, nil)
+	if err != nil {
+		return nil, err
+	}
+	head, logs, err := c.conversationDatabase.FindConversationChangeLog(ctx, req.UserID, req.Version, maxIncrementalConversationChanges+1)
+	if err != nil {
+		if errs.ErrRecordNotFound.Is(err) {
+			// The list has not changed since the log was introduced, only a full sync is possible.
+			return &conversationext.GetIncrementalConversationResp{Full: true, Drafts: drafts}, nil
+		}
+		return nil, err
+	}
+	resp := &conversationext.GetIncrementalConversationResp{
+		VersionID: head.ID.Hex(),
+		Version:   head.Version,
+		Drafts:    drafts,
+	}
+	if req.VersionID != resp.VersionID || req.Version > head.Version || len(logs) > maxIncrementalConversationChanges {
+		resp.Full = true
+		return resp, nil
+	}
+	if len(logs) == 0 {
+		return resp, nil
+	}
+	var changedIDs []string
+	states := make(map[string]int32, len(logs))
+	for _, elem := range logs {
+		states[elem.EID] = elem.State
+		if elem.State == model.VersionStateDelete {
+			resp.Delete = append(resp.Delete, elem.EID)
+		} else {
+			changedIDs = append(changedIDs, elem.EID)
+		}
+	}
+	conversations, err := c.conversationDatabase.FindConversations(ctx, req.UserID, changedIDs)
+	if err != nil {
+		return nil, err
+	}
+	for _, conversation := range conversations {
+		if states[conversation.ConversationID] == model.VersionStateInsert {
+			resp.Insert = append(resp.Insert, convert.ConversationDB2Pb(conversation))
+		} else {
+			resp.Update = append(resp.Update, convert.ConversationDB2Pb(conversation))
+		}
+	}
+	// Conversations removed after the log was read are reported as deleted until the next sync.
+	found := datautil.SliceSet(datautil.Slice(conversations, func(e *model.Conversation) string { return e.ConversationID }))
+	for _, conversationID := range changedIDs {
+		if _, ok := found[conversationID]; !ok {
+			resp.Delete = append(resp.Delete, conversationID)
+		}
+	}
+	return resp, nil
+}
@@ -18,6 +18,7 @@ import (
 	"context"
 
 	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/conversationext"
 	"github.com/openimsdk/open-im-server/v3/pkg/rpcclient"
 	"github.com/openimsdk/protocol/constant"
 	"github.com/openimsdk/protocol/sdkws"
@@ -68,3 +69,31 @@ func (c *ConversationNotificationSender) ConversationUnreadChangeNotification(
 
 	c.Notification(ctx, userID, userID, constant.ConversationUnreadNotification, tips)
 }
+
+func (c *ConversationNotificationSender) ConversationDraftNotification(ctx context.Context, userID string, draft *conversationext.ConversationDraft, platformID int32) {
+	tips := &conversationext.ConversationDraftTips{
+		UserID:     userID,
+		Draft:      draft,
+		PlatformID: platformID,
+	}
+
+	c.Notification(ctx, userID, userID, conversationext.ConversationDraftNotification, tips)
+}
+
+func (c *ConversationNotificationSender) ConversationFolderNotification(ctx context.Context, userID string, folderIDs []string, conversationIDs []string) {
+	tips := &conversationext.ConversationFolderTips{
+		UserID:          userID,
+		FolderIDs:       folderIDs,
+		ConversationIDs: conversationIDs,
+	}
+
+	c.Notification(ctx, userID, userID, conversationext.ConversationFolderNotification, tips)
+}
+
+func (c *ConversationNotificationSender) DoNotDisturbNotification(ctx context.Context, userID string) {
+	tips := &conversationext.DoNotDisturbTips{
+		UserID: userID,
+	}
+
+	c.Notification(ctx, userID, userID, conversationext.DoNotDisturbNotification, tips)
+}
@@ -86,6 +86,7 @@ func (s *friendServer) AddBlack(ctx context.Context, req *pbfriend.AddBlackReq)
 	if err := s.blackDatabase.Create(ctx, []*model.Black{&black}); err != nil {
 		return nil, err
 	}
+	s.friendSuggestionsChanged(ctx, req.OwnerUserID, req.BlackUserID, false)
 	s.notificationSender.BlackAddedNotification(ctx, req)
 	return &pbfriend.AddBlackResp{}, nil
 }
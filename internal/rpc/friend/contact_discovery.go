@@ -0,0 +1,169 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package friend
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/servererrs"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database/mgo"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/friendext"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/utils/datautil"
+)
+
+// storedContactHash hashes a client hash with the secret, so that the stored hashes can not be matched
+// with hashes computed from the public salt without it.
+func storedContactHash(secret string, hash string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(hash))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// contactDiscoverySecret returns the configured secret. The salt is public and phone numbers are few enough to hash
+// them all, so contact discovery is refused rather than storing hashes anyone could match without the secret.
+func (s *friendServer) contactDiscoverySecret() (string, error) {
+	secret := s.config.RpcConfig.ContactDiscovery.Secret
+	if secret == "" {
+		return "", errs.New("contactDiscovery.secret is not set in openim-rpc-friend.yml").Wrap()
+	}
+	return secret, nil
+}
+
+// contactDiscoverable reports whether the setting allows discovering the user by the type of identifier.
+func contactDiscoverable(setting *model.ContactDiscoverySetting, typ int32) bool {
+	switch typ {
+	case friendext.ContactPhone:
+		return setting.ByPhone
+	case friendext.ContactEmail:
+		return setting.ByEmail
+	default:
+		return false
+	}
+}
+
+func (s *friendServer) GetContactDiscoverySalt(ctx context.Context, req *friendext.GetContactDiscoverySaltReq) (*friendext.GetContactDiscoverySaltResp, error) {
+	return &friendext.GetContactDiscoverySaltResp{Salt: s.config.RpcConfig.ContactDiscovery.Salt}, nil
+}
+
+func (s *friendServer) SetContactHashes(ctx context.Context, req *friendext.SetContactHashesReq) (*friendext.SetContactHashesResp, error) {
+	if err := authverify.CheckAdmin(ctx, s.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	if _, err := s.userRpcClient.GetUsersInfo(ctx, []string{req.UserID}); err != nil {
+		return nil, err
+	}
+	secret, err := s.contactDiscoverySecret()
+	if err != nil {
+		return nil, err
+	}
+	hashes := datautil.Slice(req.Hashes, func(hash string) string { return storedContactHash(secret, hash) })
+	if err := s.contactDatabase.SetContactHashes(ctx, req.UserID, req.Type, hashes); err != nil {
+		return nil, err
+	}
+	return &friendext.SetContactHashesResp{}, nil
+}
+
+func (s *friendServer) SetContactDiscoverySetting(ctx context.Context, req *friendext.SetContactDiscoverySettingReq) (*friendext.SetContactDiscoverySettingResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, s.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	setting := &model.ContactDiscoverySetting{
+		UserID:     req.UserID,
+		ByPhone:    req.Setting.ByPhone,
+		ByEmail:    req.Setting.ByEmail,
+		UpdateTime: time.Now(),
+	}
+	if err := s.contactDatabase.SetContactDiscoverySetting(ctx, setting); err != nil {
+		return nil, err
+	}
+	return &friendext.SetContactDiscoverySettingResp{}, nil
+}
+
+func (s *friendServer) GetContactDiscoverySetting(ctx context.Context, req *friendext.GetContactDiscoverySettingReq) (*friendext.GetContactDiscoverySettingResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, s.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	setting, err := s.contactDatabase.TakeContactDiscoverySetting(ctx, req.UserID)
+	if err != nil {
+		if mgo.IsNotFound(err) {
+			return &friendext.GetContactDiscoverySettingResp{Setting: &friendext.ContactDiscoverySetting{}}, nil
+		}
+		return nil, err
+	}
+	return &friendext.GetContactDiscoverySettingResp{Setting: &friendext.ContactDiscoverySetting{
+		ByPhone: setting.ByPhone,
+		ByEmail: setting.ByEmail,
+	}}, nil
+}
+
+func (s *friendServer) DiscoverContacts(ctx context.Context, req *friendext.DiscoverContactsReq) (*friendext.DiscoverContactsResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, s.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	secret, err := s.contactDiscoverySecret()
+	if err != nil {
+		return nil, err
+	}
+	conf := s.config.RpcConfig.ContactDiscovery
+	if conf.MaxHashesPerRequest > 0 && len(req.Hashes) > conf.MaxHashesPerRequest {
+		return nil, errs.ErrArgs.WrapMsg("too many hashes", "max", conf.MaxHashesPerRequest)
+	}
+	if conf.DailyQuota > 0 && !authverify.IsAppManagerUid(ctx, s.config.Share.IMAdminUserID) {
+		ok, err := s.contactDatabase.AcquireContactDiscoveryQuota(ctx, req.UserID, int64(len(req.Hashes)), conf.DailyQuota)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, servererrs.ErrContactDiscoveryQuota.WrapMsg("daily contact discovery quota used up", "limit", conf.DailyQuota)
+		}
+	}
+	// The submitted hashes are only kept in memory for matching.
+	clientHashes := make(map[string]string, len(req.Hashes))
+	for _, hash := range req.Hashes {
+		clientHashes[storedContactHash(secret, hash)] = hash
+	}
+	contacts, err := s.contactDatabase.FindContactHashes(ctx, datautil.Keys(clientHashes))
+	if err != nil {
+		return nil, err
+	}
+	contacts = datautil.Filter(contacts, func(e *model.ContactHash) (*model.ContactHash, bool) {
+		return e, e.UserID != req.UserID
+	})
+	if len(contacts) == 0 {
+		return &friendext.DiscoverContactsResp{}, nil
+	}
+	userIDs := datautil.Distinct(datautil.Slice(contacts, func(e *model.ContactHash) string { return e.UserID }))
+	settings, err := s.contactDatabase.FindContactDiscoverySettings(ctx, userIDs)
+	if err != nil {
+		return nil, err
+	}
+	settingMap := datautil.SliceToMap(settings, func(e *model.ContactDiscoverySetting) string { return e.UserID })
+	resp := &friendext.DiscoverContactsResp{Matches: make([]*friendext.ContactMatch, 0, len(contacts))}
+	for _, contact := range contacts {
+		setting, ok := settingMap[contact.UserID]
+		if !ok || !contactDiscoverable(setting, contact.Type) {
+			continue
+		}
+		resp.Matches = append(resp.Matches, &friendext.ContactMatch{Hash: clientHashes[contact.Hash], UserID: contact.UserID})
+	}
+	return resp, nil
+}
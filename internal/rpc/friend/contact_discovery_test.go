@@ -0,0 +1,128 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package friend
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/servererrs"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/controller"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/friendext"
+	"github.com/openimsdk/tools/mcontext"
+	"github.com/openimsdk/tools/utils/datautil"
+)
+
+type stubContactDatabase struct {
+	controller.ContactDiscoveryDatabase
+	hashes   map[string]*model.ContactHash
+	settings []*model.ContactDiscoverySetting
+	used     int64
+}
+
+func (c *stubContactDatabase) FindContactHashes(ctx context.Context, hashes []string) ([]*model.ContactHash, error) {
+	var res []*model.ContactHash
+	for _, hash := range hashes {
+		if contact, ok := c.hashes[hash]; ok {
+			res = append(res, contact)
+		}
+	}
+	return res, nil
+}
+
+func (c *stubContactDatabase) FindContactDiscoverySettings(ctx context.Context, userIDs []string) ([]*model.ContactDiscoverySetting, error) {
+	return datautil.Filter(c.settings, func(e *model.ContactDiscoverySetting) (*model.ContactDiscoverySetting, bool) {
+		return e, datautil.Contain(e.UserID, userIDs...)
+	}), nil
+}
+
+func (c *stubContactDatabase) AcquireContactDiscoveryQuota(ctx context.Context, userID string, count int64, limit int64) (bool, error) {
+	if c.used+count > limit {
+		return false, nil
+	}
+	c.used += count
+	return true, nil
+}
+
+func TestDiscoverContacts(t *testing.T) {
+	const salt, secret = "salt", "secret"
+	hash := func(identifier string) string {
+		sum := sha256.Sum256([]byte(salt + identifier))
+		return hex.EncodeToString(sum[:])
+	}
+	db := &stubContactDatabase{hashes: make(map[string]*model.ContactHash)}
+	register := func(userID string, typ int32, identifier string) {
+		stored := storedContactHash(secret, hash(identifier))
+		db.hashes[stored] = &model.ContactHash{Hash: stored, Type: typ, UserID: userID}
+	}
+	register("a", friendext.ContactPhone, "+8613800000000")
+	register("b", friendext.ContactPhone, "+8613800000001")
+	register("b", friendext.ContactEmail, "b@example.com")
+	register("c", friendext.ContactEmail, "c@example.com")
+	register("self", friendext.ContactPhone, "+8613800000002")
+	db.settings = []*model.ContactDiscoverySetting{
+		{UserID: "a", ByPhone: true},
+		{UserID: "b", ByEmail: true},
+		{UserID: "self", ByPhone: true},
+	}
+	s := &friendServer{
+		contactDatabase: db,
+		config: &Config{RpcConfig: config.Friend{ContactDiscovery: config.ContactDiscovery{
+			Salt:                salt,
+			Secret:              secret,
+			MaxHashesPerRequest: 10,
+			DailyQuota:          8,
+		}}},
+	}
+	ctx := mcontext.SetOpUserID(context.Background(), "self")
+	req := &friendext.DiscoverContactsReq{UserID: "self", Hashes: []string{
+		hash("+8613800000000"), hash("+8613800000001"), hash("b@example.com"), hash("c@example.com"), hash("+8613800000002"), hash("unknown"),
+	}}
+	if _, ok := db.hashes[hash("+8613800000000")]; ok {
+		t.Fatal("client hash stored without the secret")
+	}
+	resp, err := s.DiscoverContacts(ctx, req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	matches := make(map[string]string)
+	for _, match := range resp.Matches {
+		matches[match.Hash] = match.UserID
+	}
+	expected := map[string]string{hash("+8613800000000"): "a", hash("b@example.com"): "b"}
+	if len(matches) != len(expected) {
+		t.Fatalf("matches %v, expected %v", matches, expected)
+	}
+	for h, userID := range expected {
+		if matches[h] != userID {
+			t.Errorf("hash %s matched %q, expected %q", h, matches[h], userID)
+		}
+	}
+	if _, err := s.DiscoverContacts(ctx, req); !servererrs.ErrContactDiscoveryQuota.Is(err) {
+		t.Errorf("quota not applied: %v", err)
+	}
+	if _, err := s.DiscoverContacts(mcontext.SetOpUserID(context.Background(), "a"), req); err == nil {
+		t.Error("discovered contacts of another user")
+	}
+
+	s.config.RpcConfig.ContactDiscovery.Secret = ""
+	if _, err := s.DiscoverContacts(mcontext.SetOpUserID(context.Background(), "b"), &friendext.DiscoverContactsReq{UserID: "b", Hashes: req.Hashes}); err == nil {
+		t.Error("discovered contacts without a secret")
+	}
+}
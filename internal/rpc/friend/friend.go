@@ -22,10 +22,12 @@ import (
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/webhook"
 	"github.com/openimsdk/open-im-server/v3/pkg/localcache"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/friendext"
 	"github.com/openimsdk/tools/db/redisutil"
 
 	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/convert"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/prommetrics"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/servererrs"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/controller"
 	"github.com/openimsdk/open-im-server/v3/pkg/rpcclient"
@@ -35,6 +37,7 @@ import (
 	"github.com/openimsdk/tools/db/mongoutil"
 	"github.com/openimsdk/tools/discovery"
 	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
 	"github.com/openimsdk/tools/utils/datautil"
 	"google.golang.org/grpc"
 )
@@ -42,7 +45,11 @@ import (
 type friendServer struct {
 	friendDatabase        controller.FriendDatabase
 	blackDatabase         controller.BlackDatabase
+	requestLimitDatabase  controller.FriendRequestLimitDatabase
+	contactDatabase       controller.ContactDiscoveryDatabase
+	suggestionDatabase    controller.FriendSuggestionDatabase
 	userRpcClient         *rpcclient.UserRpcClient
+	groupRpcClient        rpcclient.GroupRpcClient
 	notificationSender    *FriendNotificationSender
 	conversationRpcClient rpcclient.ConversationRpcClient
 	RegisterCenter        discovery.SvcDiscoveryRegistry
@@ -87,6 +94,31 @@ func Start(ctx context.Context, config *Config, client discovery.SvcDiscoveryReg
 		return err
 	}
 
+	friendGroupMongoDB, err := mgo.NewFriendGroupMgo(mgocli.GetDB())
+	if err != nil {
+		return err
+	}
+
+	friendAddSettingMongoDB, err := mgo.NewFriendAddSettingMgo(mgocli.GetDB())
+	if err != nil {
+		return err
+	}
+
+	contactHashMongoDB, err := mgo.NewContactHashMgo(mgocli.GetDB())
+	if err != nil {
+		return err
+	}
+
+	contactDiscoverySettingMongoDB, err := mgo.NewContactDiscoverySettingMgo(mgocli.GetDB())
+	if err != nil {
+		return err
+	}
+
+	friendSuggestionDismissMongoDB, err := mgo.NewFriendSuggestionDismissMgo(mgocli.GetDB())
+	if err != nil {
+		return err
+	}
+
 	// Initialize RPC clients
 	userRpcClient := rpcclient.NewUserRpcClient(client, config.Share.RpcRegisterName.User, config.Share.IMAdminUserID)
 	msgRpcClient := rpcclient.NewMessageRpcClient(client, config.Share.RpcRegisterName.Msg)
@@ -100,10 +132,11 @@ func Start(ctx context.Context, config *Config, client discovery.SvcDiscoveryReg
 	localcache.InitLocalCache(&config.LocalCacheConfig)
 
 	// Register Friend server with refactored MongoDB and Redis integrations
-	pbfriend.RegisterFriendServer(server, &friendServer{
+	s := &friendServer{
 		friendDatabase: controller.NewFriendDatabase(
 			friendMongoDB,
 			friendRequestMongoDB,
+			friendGroupMongoDB,
 			redis.NewFriendCacheRedis(rdb, &config.LocalCacheConfig, friendMongoDB, redis.GetRocksCacheOptions()),
 			mgocli.GetTx(),
 		),
@@ -111,46 +144,76 @@ func Start(ctx context.Context, config *Config, client discovery.SvcDiscoveryReg
 			blackMongoDB,
 			redis.NewBlackCacheRedis(rdb, &config.LocalCacheConfig, blackMongoDB, redis.GetRocksCacheOptions()),
 		),
+		requestLimitDatabase: controller.NewFriendRequestLimitDatabase(friendAddSettingMongoDB, redis.NewFriendRequestQuotaCache(rdb)),
+		contactDatabase: controller.NewContactDiscoveryDatabase(
+			contactHashMongoDB,
+			contactDiscoverySettingMongoDB,
+			redis.NewContactDiscoveryQuotaCache(rdb),
+			mgocli.GetTx(),
+		),
+		suggestionDatabase:    controller.NewFriendSuggestionDatabase(friendSuggestionDismissMongoDB, redis.NewFriendSuggestionCache(rdb)),
 		userRpcClient:         &userRpcClient,
+		groupRpcClient:        rpcclient.NewGroupRpcClient(client, config.Share.RpcRegisterName.Group),
 		notificationSender:    notificationSender,
 		RegisterCenter:        client,
 		conversationRpcClient: rpcclient.NewConversationRpcClient(client, config.Share.RpcRegisterName.Conversation),
 		config:                config,
 		webhookClient:         webhook.NewWebhookClient(config.WebhooksConfig.URL),
-	})
+	}
+	pbfriend.RegisterFriendServer(server, s)
+	friendext.RegisterFriendExtServer(server, s)
 
 	return nil
 }
 
 // ok.
 func (s *friendServer) ApplyToAddFriend(ctx context.Context, req *pbfriend.ApplyToAddFriendReq) (resp *pbfriend.ApplyToAddFriendResp, err error) {
-	resp = &pbfriend.ApplyToAddFriendResp{}
-	if err := authverify.CheckAccessV3(ctx, req.FromUserID, s.config.Share.IMAdminUserID); err != nil {
+	if err := s.applyToAddFriend(ctx, req, ""); err != nil {
 		return nil, err
 	}
+	return &pbfriend.ApplyToAddFriendResp{}, nil
+}
+
+// applyToAddFriend sends a friend request, answer is checked when the receiver only accepts requests answering their question.
+func (s *friendServer) applyToAddFriend(ctx context.Context, req *pbfriend.ApplyToAddFriendReq, answer string) error {
+	if err := authverify.CheckAccessV3(ctx, req.FromUserID, s.config.Share.IMAdminUserID); err != nil {
+		return err
+	}
 	if req.ToUserID == req.FromUserID {
-		return nil, servererrs.ErrCanNotAddYourself.WrapMsg("req.ToUserID", req.ToUserID)
+		return servererrs.ErrCanNotAddYourself.WrapMsg("req.ToUserID", req.ToUserID)
 	}
-	if err = s.webhookBeforeAddFriend(ctx, &s.config.WebhooksConfig.BeforeAddFriend, req); err != nil && err != servererrs.ErrCallbackContinue {
-		return nil, err
+	if err := s.webhookBeforeAddFriend(ctx, &s.config.WebhooksConfig.BeforeAddFriend, req); err != nil && err != servererrs.ErrCallbackContinue {
+		return err
 	}
 	if _, err := s.userRpcClient.GetUsersInfoMap(ctx, []string{req.ToUserID, req.FromUserID}); err != nil {
-		return nil, err
+		return err
 	}
 
 	in1, in2, err := s.friendDatabase.CheckIn(ctx, req.FromUserID, req.ToUserID)
 	if err != nil {
-		return nil, err
+		return err
 	}
 	if in1 && in2 {
-		return nil, servererrs.ErrRelationshipAlready.WrapMsg("already friends has f")
+		return servererrs.ErrRelationshipAlready.WrapMsg("already friends has f")
+	}
+	// Admins and users the receiver already counts as friends are not limited.
+	limited := !in2 && !authverify.IsAppManagerUid(ctx, s.config.Share.IMAdminUserID)
+	if limited {
+		if err := s.checkFriendRequestLimits(ctx, req.FromUserID, req.ToUserID, answer); err != nil {
+			return err
+		}
 	}
 	if err = s.friendDatabase.AddFriendRequest(ctx, req.FromUserID, req.ToUserID, req.ReqMsg, req.Ex); err != nil {
-		return nil, err
+		if limited {
+			s.releaseFriendRequestQuota(ctx, req.FromUserID)
+		}
+		return err
 	}
+	prommetrics.FriendRequestSentCounter.Inc()
+	s.friendSuggestionsChanged(ctx, req.FromUserID, req.ToUserID, false)
 	s.notificationSender.FriendApplicationAddNotification(ctx, req)
 	s.webhookAfterAddFriend(ctx, &s.config.WebhooksConfig.AfterAddFriend, req)
-	return resp, nil
+	return nil
 }
 
 // ok.
@@ -176,6 +239,7 @@ func (s *friendServer) ImportFriends(ctx context.Context, req *pbfriend.ImportFr
 		return nil, err
 	}
 	for _, userID := range req.FriendUserIDs {
+		s.friendSuggestionsChanged(ctx, req.OwnerUserID, userID, true)
 		s.notificationSender.FriendApplicationAgreedNotification(ctx, &pbfriend.RespondFriendApplyReq{
 			FromUserID:   req.OwnerUserID,
 			ToUserID:     userID,
@@ -208,6 +272,7 @@ func (s *friendServer) RespondFriendApply(ctx context.Context, req *pbfriend.Res
 		if err != nil {
 			return nil, err
 		}
+		s.friendSuggestionsChanged(ctx, req.FromUserID, req.ToUserID, true)
 		s.notificationSender.FriendApplicationAgreedNotification(ctx, req)
 		return resp, nil
 	}
@@ -235,6 +300,7 @@ func (s *friendServer) DeleteFriend(ctx context.Context, req *pbfriend.DeleteFri
 	if err := s.friendDatabase.Delete(ctx, req.OwnerUserID, []string{req.FriendUserID}); err != nil {
 		return nil, err
 	}
+	s.friendSuggestionsChanged(ctx, req.OwnerUserID, req.FriendUserID, true)
 	s.notificationSender.FriendDeletedNotification(ctx, req)
 	s.webhookAfterDeleteFriend(ctx, &s.config.WebhooksConfig.AfterDeleteFriend, req)
 	return resp, nil
@@ -466,3 +532,32 @@ func (s *friendServer) UpdateFriends(
 	s.notificationSender.FriendsInfoUpdateNotification(ctx, req.OwnerUserID, req.FriendUserIDs)
 	return resp, nil
 }
+
+func (s *friendServer) PurgeUserRelations(ctx context.Context, req *friendext.PurgeUserRelationsReq) (*friendext.PurgeUserRelationsResp, error) {
+	if err := authverify.CheckAdmin(ctx, s.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	changedUserIDs, err := s.friendDatabase.DeleteUserRelations(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.blackDatabase.DeleteUserBlacks(ctx, req.UserID); err != nil {
+		return nil, err
+	}
+	if err := s.requestLimitDatabase.DeleteFriendAddSetting(ctx, req.UserID); err != nil {
+		return nil, err
+	}
+	if err := s.contactDatabase.DeleteUserContactDiscovery(ctx, req.UserID); err != nil {
+		return nil, err
+	}
+	if err := s.suggestionDatabase.DeleteUserFriendSuggestions(ctx, req.UserID); err != nil {
+		return nil, err
+	}
+	if err := s.suggestionDatabase.MarkFriendSuggestionsStale(ctx, changedUserIDs); err != nil {
+		log.ZError(ctx, "mark friend suggestions stale failed", err, "userIDs", changedUserIDs)
+	}
+	for _, userID := range changedUserIDs {
+		s.notificationSender.FriendDeletedNotification(ctx, &pbfriend.DeleteFriendReq{OwnerUserID: req.UserID, FriendUserID: userID})
+	}
+	return &friendext.PurgeUserRelationsResp{}, nil
+}
@@ -0,0 +1,225 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package friend
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/convert"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database/mgo"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/friendext"
+	"github.com/openimsdk/open-im-server/v3/pkg/util/orderutil"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/utils/datautil"
+)
+
+func convertFriendGroup(group *model.FriendGroup) *friendext.FriendGroup {
+	return &friendext.FriendGroup{
+		GroupID:    group.GroupID,
+		Name:       group.Name,
+		Order:      group.Order,
+		Ex:         group.Ex,
+		CreateTime: group.CreateTime.UnixMilli(),
+	}
+}
+
+func (s *friendServer) takeFriendGroup(ctx context.Context, ownerUserID string, groupID string) (*model.FriendGroup, error) {
+	group, err := s.friendDatabase.TakeFriendGroup(ctx, ownerUserID, groupID)
+	if err != nil {
+		if mgo.IsNotFound(err) {
+			return nil, errs.ErrRecordNotFound.WrapMsg("friend group not found", "groupID", groupID)
+		}
+		return nil, err
+	}
+	return group, nil
+}
+
+func (s *friendServer) CreateFriendGroup(ctx context.Context, req *friendext.CreateFriendGroupReq) (*friendext.CreateFriendGroupResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.OwnerUserID, s.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	groups, err := s.friendDatabase.FindFriendGroups(ctx, req.OwnerUserID)
+	if err != nil {
+		return nil, err
+	}
+	if limit := s.config.RpcConfig.FriendGroup.MaxPerUser; limit > 0 && len(groups) >= limit {
+		return nil, errs.ErrArgs.WrapMsg("too many friend groups", "max", limit)
+	}
+	group := &model.FriendGroup{
+		OwnerUserID: req.OwnerUserID,
+		GroupID:     uuid.NewString(),
+		Name:        req.Name,
+		Ex:          req.Ex,
+		CreateTime:  time.Now(),
+	}
+	// New groups are listed last.
+	group.Order = orderutil.Next(groups, func(e *model.FriendGroup) int32 { return e.Order })
+	if err := s.friendDatabase.CreateFriendGroup(ctx, group); err != nil {
+		return nil, err
+	}
+	s.notificationSender.FriendGroupChangedNotification(ctx, req.OwnerUserID, []string{group.GroupID}, nil)
+	return &friendext.CreateFriendGroupResp{Group: convertFriendGroup(group)}, nil
+}
+
+func (s *friendServer) SetFriendGroup(ctx context.Context, req *friendext.SetFriendGroupReq) (*friendext.SetFriendGroupResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.OwnerUserID, s.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	if _, err := s.takeFriendGroup(ctx, req.OwnerUserID, req.GroupID); err != nil {
+		return nil, err
+	}
+	if err := s.friendDatabase.UpdateFriendGroup(ctx, req.OwnerUserID, req.GroupID, map[string]any{"name": req.Name, "ex": req.Ex}); err != nil {
+		return nil, err
+	}
+	s.notificationSender.FriendGroupChangedNotification(ctx, req.OwnerUserID, []string{req.GroupID}, nil)
+	return &friendext.SetFriendGroupResp{}, nil
+}
+
+func (s *friendServer) DeleteFriendGroup(ctx context.Context, req *friendext.DeleteFriendGroupReq) (*friendext.DeleteFriendGroupResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.OwnerUserID, s.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	if _, err := s.takeFriendGroup(ctx, req.OwnerUserID, req.GroupID); err != nil {
+		return nil, err
+	}
+	friendUserIDs, err := s.friendDatabase.DeleteFriendGroup(ctx, req.OwnerUserID, req.GroupID)
+	if err != nil {
+		return nil, err
+	}
+	s.notificationSender.FriendGroupChangedNotification(ctx, req.OwnerUserID, []string{req.GroupID}, friendUserIDs)
+	return &friendext.DeleteFriendGroupResp{}, nil
+}
+
+func (s *friendServer) SortFriendGroups(ctx context.Context, req *friendext.SortFriendGroupsReq) (*friendext.SortFriendGroupsResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.OwnerUserID, s.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	groups, err := s.friendDatabase.FindFriendGroups(ctx, req.OwnerUserID)
+	if err != nil {
+		return nil, err
+	}
+	orders, missing := orderutil.Sort(groups, func(e *model.FriendGroup) string { return e.GroupID },
+		func(e *model.FriendGroup) int32 { return e.Order }, req.GroupIDs)
+	if missing != "" {
+		return nil, errs.ErrRecordNotFound.WrapMsg("friend group not found", "groupID", missing)
+	}
+	if len(orders) == 0 {
+		return &friendext.SortFriendGroupsResp{}, nil
+	}
+	if err := s.friendDatabase.SortFriendGroups(ctx, req.OwnerUserID, orders); err != nil {
+		return nil, err
+	}
+	s.notificationSender.FriendGroupChangedNotification(ctx, req.OwnerUserID, datautil.Keys(orders), nil)
+	return &friendext.SortFriendGroupsResp{}, nil
+}
+
+func (s *friendServer) GetFriendGroups(ctx context.Context, req *friendext.GetFriendGroupsReq) (*friendext.GetFriendGroupsResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.OwnerUserID, s.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	groups, err := s.friendDatabase.FindFriendGroups(ctx, req.OwnerUserID)
+	if err != nil {
+		return nil, err
+	}
+	return &friendext.GetFriendGroupsResp{Groups: datautil.Slice(groups, convertFriendGroup)}, nil
+}
+
+// moveFriendsGroup checks the groups and the friends before moving the friends.
+func (s *friendServer) moveFriendsGroup(ctx context.Context, ownerUserID string, friendUserIDs []string, fromGroupID string, toGroupID string) error {
+	if err := authverify.CheckAccessV3(ctx, ownerUserID, s.config.Share.IMAdminUserID); err != nil {
+		return err
+	}
+	groupIDs := make([]string, 0, 2)
+	for _, groupID := range []string{fromGroupID, toGroupID} {
+		if groupID == "" {
+			continue
+		}
+		if _, err := s.takeFriendGroup(ctx, ownerUserID, groupID); err != nil {
+			return err
+		}
+		groupIDs = append(groupIDs, groupID)
+	}
+	if _, err := s.friendDatabase.FindFriendsWithError(ctx, ownerUserID, friendUserIDs); err != nil {
+		return err
+	}
+	if err := s.friendDatabase.MoveFriendsGroup(ctx, ownerUserID, friendUserIDs, fromGroupID, toGroupID); err != nil {
+		return err
+	}
+	s.notificationSender.FriendGroupChangedNotification(ctx, ownerUserID, groupIDs, friendUserIDs)
+	return nil
+}
+
+func (s *friendServer) AddFriendsToGroup(ctx context.Context, req *friendext.AddFriendsToGroupReq) (*friendext.AddFriendsToGroupResp, error) {
+	if err := s.moveFriendsGroup(ctx, req.OwnerUserID, req.FriendUserIDs, "", req.GroupID); err != nil {
+		return nil, err
+	}
+	return &friendext.AddFriendsToGroupResp{}, nil
+}
+
+func (s *friendServer) RemoveFriendsFromGroup(ctx context.Context, req *friendext.RemoveFriendsFromGroupReq) (*friendext.RemoveFriendsFromGroupResp, error) {
+	if err := s.moveFriendsGroup(ctx, req.OwnerUserID, req.FriendUserIDs, req.GroupID, ""); err != nil {
+		return nil, err
+	}
+	return &friendext.RemoveFriendsFromGroupResp{}, nil
+}
+
+func (s *friendServer) MoveFriendsToGroup(ctx context.Context, req *friendext.MoveFriendsToGroupReq) (*friendext.MoveFriendsToGroupResp, error) {
+	if err := s.moveFriendsGroup(ctx, req.OwnerUserID, req.FriendUserIDs, req.FromGroupID, req.ToGroupID); err != nil {
+		return nil, err
+	}
+	return &friendext.MoveFriendsToGroupResp{}, nil
+}
+
+func (s *friendServer) GetFriendsGroupIDs(ctx context.Context, req *friendext.GetFriendsGroupIDsReq) (*friendext.GetFriendsGroupIDsResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.OwnerUserID, s.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	friends, err := s.friendDatabase.FindFriendsWithError(ctx, req.OwnerUserID, datautil.Distinct(req.FriendUserIDs))
+	if err != nil {
+		return nil, err
+	}
+	resp := &friendext.GetFriendsGroupIDsResp{Friends: make([]*friendext.FriendGroupIDs, 0, len(friends))}
+	for _, friend := range friends {
+		resp.Friends = append(resp.Friends, &friendext.FriendGroupIDs{
+			FriendUserID: friend.FriendUserID,
+			GroupIDs:     friend.GroupIDs,
+		})
+	}
+	return resp, nil
+}
+
+func (s *friendServer) GetPaginationGroupFriends(ctx context.Context, req *friendext.GetPaginationGroupFriendsReq) (*friendext.GetPaginationGroupFriendsResp, error) {
+	if err := s.userRpcClient.Access(ctx, req.UserID); err != nil {
+		return nil, err
+	}
+	if _, err := s.takeFriendGroup(ctx, req.UserID, req.GroupID); err != nil {
+		return nil, err
+	}
+	total, friends, err := s.friendDatabase.PageGroupFriends(ctx, req.UserID, req.GroupID, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	resp := &friendext.GetPaginationGroupFriendsResp{}
+	resp.FriendsInfo, err = convert.FriendsDB2Pb(ctx, friends, s.userRpcClient.GetUsersInfoMap)
+	if err != nil {
+		return nil, err
+	}
+	resp.Total = int32(total)
+	return resp, nil
+}
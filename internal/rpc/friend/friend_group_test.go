@@ -0,0 +1,102 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package friend
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/controller"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/friendext"
+	"github.com/openimsdk/open-im-server/v3/pkg/rpcclient/notificationtest"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/mcontext"
+)
+
+type stubFriendGroupDatabase struct {
+	controller.FriendDatabase
+	groups []*model.FriendGroup
+}
+
+func (s *stubFriendGroupDatabase) FindFriendGroups(ctx context.Context, ownerUserID string) ([]*model.FriendGroup, error) {
+	return s.groups, nil
+}
+
+func (s *stubFriendGroupDatabase) CreateFriendGroup(ctx context.Context, group *model.FriendGroup) error {
+	s.groups = append(s.groups, group)
+	return nil
+}
+
+func (s *stubFriendGroupDatabase) SortFriendGroups(ctx context.Context, ownerUserID string, orders map[string]int32) error {
+	for _, group := range s.groups {
+		if order, ok := orders[group.GroupID]; ok {
+			group.Order = order
+		}
+	}
+	return nil
+}
+
+// newFriendGroupTestServer returns a server whose notifications are received by the returned recorder.
+func newFriendGroupTestServer(db *stubFriendGroupDatabase) (*friendServer, *notificationtest.Recorder) {
+	sender, recorder := notificationtest.NewRecorder()
+	return &friendServer{
+		friendDatabase:     db,
+		notificationSender: &FriendNotificationSender{NotificationSender: sender},
+		config:             &Config{Share: config.Share{IMAdminUserID: []string{"admin"}}},
+	}, recorder
+}
+
+func TestFriendGroupOrder(t *testing.T) {
+	db := &stubFriendGroupDatabase{groups: []*model.FriendGroup{
+		{GroupID: "g1", Order: 0},
+		{GroupID: "g2", Order: 1},
+		{GroupID: "g3", Order: 2},
+	}}
+	s, recorder := newFriendGroupTestServer(db)
+	ctx := mcontext.SetOpUserID(context.Background(), "u1")
+
+	resp, err := s.CreateFriendGroup(ctx, &friendext.CreateFriendGroupReq{OwnerUserID: "u1", Name: "family"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Group.Order != 3 {
+		t.Errorf("new group order %d, expected 3", resp.Group.Order)
+	}
+	recorder.Receive(t, friendext.FriendGroupChangedNotification, nil)
+
+	if _, err := s.SortFriendGroups(ctx, &friendext.SortFriendGroupsReq{OwnerUserID: "u1", GroupIDs: []string{"g5"}}); !errs.ErrRecordNotFound.Is(err) {
+		t.Fatalf("unknown group: %v", err)
+	}
+	if _, err := s.SortFriendGroups(ctx, &friendext.SortFriendGroupsReq{OwnerUserID: "u1", GroupIDs: []string{"g2", "g1"}}); err != nil {
+		t.Fatal(err)
+	}
+	orders := make(map[string]int32)
+	for _, group := range db.groups {
+		orders[group.GroupID] = group.Order
+	}
+	if expected := map[string]int32{"g2": 0, "g1": 1, "g3": 2, resp.Group.GroupID: 3}; !reflect.DeepEqual(orders, expected) {
+		t.Errorf("orders %v, expected %v", orders, expected)
+	}
+	var tips friendext.FriendGroupChangedTips
+	recorder.Receive(t, friendext.FriendGroupChangedNotification, &tips)
+	sort.Strings(tips.GroupIDs)
+	if !reflect.DeepEqual(tips.GroupIDs, []string{"g1", "g2"}) {
+		t.Errorf("notified groups %v, expected the reordered groups", tips.GroupIDs)
+	}
+}
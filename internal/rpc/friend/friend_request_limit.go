@@ -0,0 +1,243 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package friend
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/prommetrics"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/servererrs"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database/mgo"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/friendext"
+	"github.com/openimsdk/protocol/constant"
+	pbfriend "github.com/openimsdk/protocol/friend"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/utils/datautil"
+)
+
+const (
+	// expireFriendRequestsMax is the number of friend requests expired per query.
+	expireFriendRequestsMax = 500
+	// friendRequestExpiredMsg is the handle message of the friend requests nobody handled in time.
+	friendRequestExpiredMsg = "request expired"
+)
+
+// Reasons friend requests are blocked for, as labels of prommetrics.FriendRequestBlockedCounter.
+const (
+	blockedByQuota    = "quota"
+	blockedByCooldown = "cooldown"
+	blockedByPolicy   = "policy"
+	blockedByAnswer   = "answer"
+)
+
+// takeFriendAddSetting returns the setting of the user, users who never set it accept requests from everyone.
+func (s *friendServer) takeFriendAddSetting(ctx context.Context, userID string) (*model.FriendAddSetting, error) {
+	setting, err := s.requestLimitDatabase.TakeFriendAddSetting(ctx, userID)
+	if err != nil {
+		if mgo.IsNotFound(err) {
+			return &model.FriendAddSetting{UserID: userID, AddPolicy: friendext.AddPolicyEveryone}, nil
+		}
+		return nil, err
+	}
+	return setting, nil
+}
+
+// friendAnswerMatches compares an answer ignoring case and surrounding spaces.
+func friendAnswerMatches(answer string, expected string) bool {
+	return strings.EqualFold(strings.TrimSpace(answer), strings.TrimSpace(expected))
+}
+
+// checkFriendRequestLimits checks the request against the add setting of the receiver, the refused cooldown and
+// the daily quota of the sender. The quota is checked last so that only the requests about to be sent use it.
+func (s *friendServer) checkFriendRequestLimits(ctx context.Context, fromUserID string, toUserID string, answer string) error {
+	setting, err := s.takeFriendAddSetting(ctx, toUserID)
+	if err != nil {
+		return err
+	}
+	switch setting.AddPolicy {
+	case friendext.AddPolicyNobody:
+		prommetrics.FriendRequestBlockedCounter.WithLabelValues(blockedByPolicy).Inc()
+		return servererrs.ErrFriendRequestNotAllowed.WrapMsg("user does not accept friend requests", "toUserID", toUserID)
+	case friendext.AddPolicyFriendsOfFriends:
+		common, err := s.friendDatabase.HasCommonFriend(ctx, fromUserID, toUserID)
+		if err != nil {
+			return err
+		}
+		if !common {
+			prommetrics.FriendRequestBlockedCounter.WithLabelValues(blockedByPolicy).Inc()
+			return servererrs.ErrFriendRequestNotAllowed.WrapMsg("user only accepts friend requests from friends of friends", "toUserID", toUserID)
+		}
+	case friendext.AddPolicyQuestion:
+		if !friendAnswerMatches(answer, setting.Answer) {
+			prommetrics.FriendRequestBlockedCounter.WithLabelValues(blockedByAnswer).Inc()
+			return servererrs.ErrFriendRequestAnswerWrong.WrapMsg("wrong answer to the question", "toUserID", toUserID)
+		}
+	}
+	if cooldown := time.Duration(s.config.RpcConfig.FriendRequest.RefusedCooldownHours) * time.Hour; cooldown > 0 {
+		requests, err := s.friendDatabase.FindBothFriendRequests(ctx, fromUserID, toUserID)
+		if err != nil {
+			return err
+		}
+		for _, request := range requests {
+			// Expired requests have no handler and are not followed by a cooldown.
+			if request.FromUserID != fromUserID || request.HandleResult != constant.FriendResponseRefuse || request.HandlerUserID == "" {
+				continue
+			}
+			if wait := time.Until(request.HandleTime.Add(cooldown)); wait > 0 {
+				prommetrics.FriendRequestBlockedCounter.WithLabelValues(blockedByCooldown).Inc()
+				return servererrs.ErrFriendRequestCooldown.WrapMsg("friend request refused recently", "toUserID", toUserID, "retryAfter", wait.Round(time.Second).String())
+			}
+		}
+	}
+	if limit := s.config.RpcConfig.FriendRequest.DailyQuota; limit > 0 {
+		ok, err := s.requestLimitDatabase.AcquireFriendRequestQuota(ctx, fromUserID, limit)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			prommetrics.FriendRequestBlockedCounter.WithLabelValues(blockedByQuota).Inc()
+			return servererrs.ErrFriendRequestQuota.WrapMsg("daily friend request quota used up", "limit", limit)
+		}
+	}
+	return nil
+}
+
+// releaseFriendRequestQuota gives back the quota used by a request checkFriendRequestLimits let through but that failed to be sent.
+func (s *friendServer) releaseFriendRequestQuota(ctx context.Context, fromUserID string) {
+	if s.config.RpcConfig.FriendRequest.DailyQuota <= 0 {
+		return
+	}
+	if err := s.requestLimitDatabase.ReleaseFriendRequestQuota(ctx, fromUserID); err != nil {
+		log.ZError(ctx, "release friend request quota failed", err, "fromUserID", fromUserID)
+	}
+}
+
+func (s *friendServer) SetFriendAddSetting(ctx context.Context, req *friendext.SetFriendAddSettingReq) (*friendext.SetFriendAddSettingResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, s.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	setting := &model.FriendAddSetting{
+		UserID:     req.UserID,
+		AddPolicy:  req.Setting.AddPolicy,
+		UpdateTime: time.Now(),
+	}
+	if req.Setting.AddPolicy == friendext.AddPolicyQuestion {
+		setting.Question = req.Setting.Question
+		setting.Answer = req.Setting.Answer
+	}
+	if err := s.requestLimitDatabase.SetFriendAddSetting(ctx, setting); err != nil {
+		return nil, err
+	}
+	return &friendext.SetFriendAddSettingResp{}, nil
+}
+
+func (s *friendServer) GetFriendAddSetting(ctx context.Context, req *friendext.GetFriendAddSettingReq) (*friendext.GetFriendAddSettingResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, s.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	setting, err := s.takeFriendAddSetting(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	return &friendext.GetFriendAddSettingResp{Setting: &friendext.FriendAddSetting{
+		AddPolicy: setting.AddPolicy,
+		Question:  setting.Question,
+		Answer:    setting.Answer,
+	}}, nil
+}
+
+func (s *friendServer) GetFriendAddPolicy(ctx context.Context, req *friendext.GetFriendAddPolicyReq) (*friendext.GetFriendAddPolicyResp, error) {
+	setting, err := s.takeFriendAddSetting(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	return &friendext.GetFriendAddPolicyResp{AddPolicy: setting.AddPolicy, Question: setting.Question}, nil
+}
+
+func (s *friendServer) ApplyToAddFriendWithAnswer(ctx context.Context, req *friendext.ApplyToAddFriendWithAnswerReq) (*friendext.ApplyToAddFriendWithAnswerResp, error) {
+	applyReq := &pbfriend.ApplyToAddFriendReq{
+		FromUserID: req.FromUserID,
+		ToUserID:   req.ToUserID,
+		ReqMsg:     req.ReqMsg,
+		Ex:         req.Ex,
+	}
+	if err := s.applyToAddFriend(ctx, applyReq, req.Answer); err != nil {
+		return nil, err
+	}
+	return &friendext.ApplyToAddFriendWithAnswerResp{}, nil
+}
+
+// ExpireFriendRequests refuses the pending friend requests sent before req.Before in batches. A request failing to be
+// expired is logged and left pending, the next batches start after it.
+func (s *friendServer) ExpireFriendRequests(ctx context.Context, req *friendext.ExpireFriendRequestsReq) (*friendext.ExpireFriendRequestsResp, error) {
+	if err := authverify.CheckAdmin(ctx, s.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	before := time.UnixMilli(req.Before)
+	var (
+		count int32
+		after *model.FriendRequest
+	)
+	for {
+		requests, err := s.friendDatabase.FindExpiredFriendRequests(ctx, before, after, expireFriendRequestsMax)
+		if err != nil {
+			return nil, err
+		}
+		var handled int
+		for _, request := range requests {
+			if err := s.friendDatabase.ExpireFriendRequest(ctx, request.FromUserID, request.ToUserID, friendRequestExpiredMsg); err != nil {
+				log.ZError(ctx, "expire friend request failed", err, "fromUserID", request.FromUserID, "toUserID", request.ToUserID)
+				continue
+			}
+			prommetrics.FriendRequestExpiredCounter.Inc()
+			s.notificationSender.FriendApplicationRefusedNotification(ctx, &pbfriend.RespondFriendApplyReq{
+				FromUserID:   request.FromUserID,
+				ToUserID:     request.ToUserID,
+				HandleResult: constant.FriendResponseRefuse,
+				HandleMsg:    friendRequestExpiredMsg,
+			})
+			handled++
+		}
+		count += int32(handled)
+		if len(requests) < expireFriendRequestsMax {
+			break
+		}
+		after = requests[len(requests)-1]
+	}
+	return &friendext.ExpireFriendRequestsResp{Count: count}, nil
+}
+
+func (s *friendServer) GetFriendRequestSenders(ctx context.Context, req *friendext.GetFriendRequestSendersReq) (*friendext.GetFriendRequestSendersResp, error) {
+	if err := authverify.CheckAdmin(ctx, s.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	senders, err := s.friendDatabase.CountFriendRequestSenders(ctx, time.UnixMilli(req.Since), int64(req.Limit))
+	if err != nil {
+		return nil, err
+	}
+	return &friendext.GetFriendRequestSendersResp{Senders: datautil.Slice(senders, func(e *model.FriendRequestSender) *friendext.FriendRequestSender {
+		return &friendext.FriendRequestSender{
+			UserID:   e.UserID,
+			Sent:     e.Sent,
+			Accepted: e.Accepted,
+			Refused:  e.Refused,
+			Pending:  e.Pending,
+		}
+	})}, nil
+}
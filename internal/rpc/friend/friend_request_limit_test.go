@@ -0,0 +1,170 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package friend
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/servererrs"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/controller"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/friendext"
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/mcontext"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type limitFriendDatabase struct {
+	controller.FriendDatabase
+	friends  map[string][]string
+	requests []*model.FriendRequest
+}
+
+func (f *limitFriendDatabase) HasCommonFriend(ctx context.Context, userID1 string, userID2 string) (bool, error) {
+	for _, friendUserID := range f.friends[userID1] {
+		if slices.Contains(f.friends[userID2], friendUserID) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (f *limitFriendDatabase) FindBothFriendRequests(ctx context.Context, fromUserID, toUserID string) ([]*model.FriendRequest, error) {
+	return f.requests, nil
+}
+
+type limitRequestDatabase struct {
+	controller.FriendRequestLimitDatabase
+	settings map[string]*model.FriendAddSetting
+	sent     map[string]int64
+}
+
+func (f *limitRequestDatabase) TakeFriendAddSetting(ctx context.Context, userID string) (*model.FriendAddSetting, error) {
+	setting, ok := f.settings[userID]
+	if !ok {
+		return nil, errs.Wrap(mongo.ErrNoDocuments)
+	}
+	return setting, nil
+}
+
+func (f *limitRequestDatabase) AcquireFriendRequestQuota(ctx context.Context, userID string, limit int64) (bool, error) {
+	if f.sent[userID] >= limit {
+		return false, nil
+	}
+	f.sent[userID]++
+	return true, nil
+}
+
+func (f *limitRequestDatabase) ReleaseFriendRequestQuota(ctx context.Context, userID string) error {
+	if f.sent[userID] > 0 {
+		f.sent[userID]--
+	}
+	return nil
+}
+
+func TestCheckFriendRequestLimits(t *testing.T) {
+	ctx := context.Background()
+	friendDB := &limitFriendDatabase{friends: map[string][]string{"a": {"c"}, "b": {"c"}, "d": {"e"}}}
+	limitDB := &limitRequestDatabase{
+		settings: map[string]*model.FriendAddSetting{
+			"nobody":  {AddPolicy: friendext.AddPolicyNobody},
+			"b":       {AddPolicy: friendext.AddPolicyFriendsOfFriends},
+			"riddler": {AddPolicy: friendext.AddPolicyQuestion, Question: "language?", Answer: "Go"},
+		},
+		sent: make(map[string]int64),
+	}
+	s := &friendServer{
+		friendDatabase:       friendDB,
+		requestLimitDatabase: limitDB,
+		config: &Config{RpcConfig: config.Friend{FriendRequest: config.FriendRequest{
+			DailyQuota:           3,
+			RefusedCooldownHours: 24,
+		}}},
+	}
+	check := func(fromUserID string, toUserID string, answer string, expected errs.CodeError) {
+		t.Helper()
+		err := s.checkFriendRequestLimits(ctx, fromUserID, toUserID, answer)
+		if expected == nil {
+			if err != nil {
+				t.Errorf("%s -> %s: %v", fromUserID, toUserID, err)
+			}
+		} else if !expected.Is(err) {
+			t.Errorf("%s -> %s: %v, expected %v", fromUserID, toUserID, err, expected)
+		}
+	}
+	check("a", "nobody", "", servererrs.ErrFriendRequestNotAllowed)
+	check("d", "b", "", servererrs.ErrFriendRequestNotAllowed)
+	check("a", "riddler", "rust", servererrs.ErrFriendRequestAnswerWrong)
+	check("a", "b", "", nil)
+	check("a", "riddler", " go ", nil)
+
+	friendDB.requests = []*model.FriendRequest{{FromUserID: "a", ToUserID: "x", HandleResult: constant.FriendResponseRefuse, HandlerUserID: "x", HandleTime: time.Now().Add(-time.Hour)}}
+	check("a", "x", "", servererrs.ErrFriendRequestCooldown)
+	friendDB.requests[0].HandlerUserID = ""
+	check("a", "x", "", nil)
+	friendDB.requests = nil
+
+	check("a", "y", "", servererrs.ErrFriendRequestQuota)
+	s.releaseFriendRequestQuota(ctx, "a")
+	check("a", "y", "", nil)
+	check("a", "z", "", servererrs.ErrFriendRequestQuota)
+}
+
+type expireFriendDatabase struct {
+	controller.FriendDatabase
+	requests []*model.FriendRequest
+	afters   []*model.FriendRequest
+}
+
+func (f *expireFriendDatabase) FindExpiredFriendRequests(ctx context.Context, before time.Time, after *model.FriendRequest, limit int64) ([]*model.FriendRequest, error) {
+	f.afters = append(f.afters, after)
+	start := 0
+	if after != nil {
+		start = slices.Index(f.requests, after) + 1
+	}
+	end := min(start+int(limit), len(f.requests))
+	return f.requests[start:end], nil
+}
+
+func (f *expireFriendDatabase) ExpireFriendRequest(ctx context.Context, fromUserID string, toUserID string, handleMsg string) error {
+	return errs.ErrInternalServer.WrapMsg("expire failed")
+}
+
+func TestExpireFriendRequestsSkipsFailures(t *testing.T) {
+	friendDB := &expireFriendDatabase{}
+	for i := 0; i < expireFriendRequestsMax+1; i++ {
+		friendDB.requests = append(friendDB.requests, &model.FriendRequest{FromUserID: fmt.Sprintf("u%d", i), ToUserID: "bob"})
+	}
+	s := &friendServer{
+		friendDatabase: friendDB,
+		config:         &Config{Share: config.Share{IMAdminUserID: []string{"admin"}}},
+	}
+	resp, err := s.ExpireFriendRequests(mcontext.SetOpUserID(context.Background(), "admin"), &friendext.ExpireFriendRequestsReq{Before: time.Now().UnixMilli()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Count != 0 {
+		t.Errorf("count %d", resp.Count)
+	}
+	if len(friendDB.afters) != 2 || friendDB.afters[0] != nil || friendDB.afters[1] != friendDB.requests[expireFriendRequestsMax-1] {
+		t.Errorf("cursors %v", friendDB.afters)
+	}
+}
@@ -0,0 +1,246 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package friend
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/friendext"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/utils/datautil"
+)
+
+const (
+	// friendSuggestionScanLimit bounds the friends of the user whose friends are counted as candidates.
+	friendSuggestionScanLimit = 1000
+	// friendSuggestionCandidateLimit bounds the candidates read by each of the counting queries.
+	friendSuggestionCandidateLimit = 5000
+)
+
+// rankFriendSuggestions scores the candidates by their mutual friends and shared groups and returns the best
+// max of them, excluded users are never suggested.
+func rankFriendSuggestions(mutualFriends map[string]int64, sharedGroups map[string]int64, excluded map[string]struct{},
+	mutualFriendWeight float64, sharedGroupWeight float64, max int) []*model.FriendSuggestion {
+	suggestions := make(map[string]*model.FriendSuggestion)
+	take := func(userID string) *model.FriendSuggestion {
+		suggestion, ok := suggestions[userID]
+		if !ok {
+			suggestion = &model.FriendSuggestion{UserID: userID}
+			suggestions[userID] = suggestion
+		}
+		return suggestion
+	}
+	for userID, count := range mutualFriends {
+		if _, ok := excluded[userID]; !ok {
+			take(userID).MutualFriends = count
+		}
+	}
+	for userID, count := range sharedGroups {
+		if _, ok := excluded[userID]; !ok {
+			take(userID).SharedGroups = count
+		}
+	}
+	res := make([]*model.FriendSuggestion, 0, len(suggestions))
+	for _, suggestion := range suggestions {
+		suggestion.Score = float64(suggestion.MutualFriends)*mutualFriendWeight + float64(suggestion.SharedGroups)*sharedGroupWeight
+		if suggestion.Score > 0 {
+			res = append(res, suggestion)
+		}
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Score != res[j].Score {
+			return res[i].Score > res[j].Score
+		}
+		return res[i].UserID < res[j].UserID
+	})
+	if max > 0 && len(res) > max {
+		res = res[:max]
+	}
+	return res
+}
+
+// friendSuggestionExcluded returns the users never suggested to the user: themselves, their friends, the users
+// they blocked, the users with a pending request from or to them and the suggestions they dismissed.
+func (s *friendServer) friendSuggestionExcluded(ctx context.Context, userID string, friendUserIDs []string) (map[string]struct{}, error) {
+	excluded := map[string]struct{}{userID: {}}
+	add := func(userIDs []string) {
+		for _, userID := range userIDs {
+			excluded[userID] = struct{}{}
+		}
+	}
+	add(friendUserIDs)
+	blackIDs, err := s.blackDatabase.FindBlackIDs(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	add(blackIDs)
+	pendingUserIDs, err := s.friendDatabase.FindPendingFriendRequestUserIDs(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	add(pendingUserIDs)
+	dismissed, err := s.suggestionDatabase.FindDismissedUserIDs(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	add(dismissed)
+	return excluded, nil
+}
+
+// computeFriendSuggestions computes and stores the suggestions of the user, each kind of relation is counted
+// by a single query whatever the number of friends and groups of the user.
+// excludeFriendSuggestionBlockers adds the candidates who blocked the user to excluded, before the suggestions
+// are ranked so that the next candidates take their places.
+func (s *friendServer) excludeFriendSuggestionBlockers(ctx context.Context, userID string, excluded map[string]struct{}, candidates ...map[string]int64) error {
+	var candidateIDs []string
+	for _, counts := range candidates {
+		for candidateID := range counts {
+			if _, ok := excluded[candidateID]; !ok {
+				candidateIDs = append(candidateIDs, candidateID)
+			}
+		}
+	}
+	if len(candidateIDs) == 0 {
+		return nil
+	}
+	blockers, err := s.blackDatabase.FindReversalBlackOwnerIDs(ctx, userID, datautil.Distinct(candidateIDs))
+	if err != nil {
+		return err
+	}
+	for _, blocker := range blockers {
+		excluded[blocker] = struct{}{}
+	}
+	return nil
+}
+
+func (s *friendServer) computeFriendSuggestions(ctx context.Context, userID string) error {
+	conf := s.config.RpcConfig.FriendSuggestion
+	friendUserIDs, err := s.friendDatabase.FindFriendUserIDs(ctx, userID)
+	if err != nil {
+		return err
+	}
+	excluded, err := s.friendSuggestionExcluded(ctx, userID, friendUserIDs)
+	if err != nil {
+		return err
+	}
+	if len(friendUserIDs) > friendSuggestionScanLimit {
+		friendUserIDs = friendUserIDs[:friendSuggestionScanLimit]
+	}
+	mutualFriends, err := s.friendDatabase.CountFriendsOfFriends(ctx, friendUserIDs, friendSuggestionCandidateLimit)
+	if err != nil {
+		return err
+	}
+	sharedGroups, err := s.groupRpcClient.GetSharedGroupMembers(ctx, userID, int64(conf.MaxGroupMembers), friendSuggestionCandidateLimit)
+	if err != nil {
+		return err
+	}
+	if err := s.excludeFriendSuggestionBlockers(ctx, userID, excluded, mutualFriends, sharedGroups); err != nil {
+		return err
+	}
+	suggestions := rankFriendSuggestions(mutualFriends, sharedGroups, excluded, conf.MutualFriendWeight, conf.SharedGroupWeight, conf.MaxPerUser)
+	return s.suggestionDatabase.SetFriendSuggestions(ctx, userID, suggestions, time.Hour*time.Duration(conf.ExpireHours))
+}
+
+// friendSuggestionsChanged removes the two users from the suggestions of each other and queues them to be
+// computed again, errors are logged only because the suggestions are a hint.
+func (s *friendServer) friendSuggestionsChanged(ctx context.Context, userID1 string, userID2 string, stale bool) {
+	if err := s.suggestionDatabase.DelFriendSuggestions(ctx, userID1, userID2); err != nil {
+		log.ZError(ctx, "del friend suggestions failed", err, "userID1", userID1, "userID2", userID2)
+	}
+	if !stale {
+		return
+	}
+	if err := s.suggestionDatabase.MarkFriendSuggestionsStale(ctx, []string{userID1, userID2}); err != nil {
+		log.ZError(ctx, "mark friend suggestions stale failed", err, "userID1", userID1, "userID2", userID2)
+	}
+}
+
+func (s *friendServer) GetFriendSuggestions(ctx context.Context, req *friendext.GetFriendSuggestionsReq) (*friendext.GetFriendSuggestionsResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, s.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	computed, total, suggestions, err := s.suggestionDatabase.PageFriendSuggestions(ctx, req.UserID, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	if !computed {
+		if err := s.suggestionDatabase.MarkFriendSuggestionsStale(ctx, []string{req.UserID}); err != nil {
+			return nil, err
+		}
+		return &friendext.GetFriendSuggestionsResp{Computing: true}, nil
+	}
+	users, err := s.userRpcClient.GetPublicUserInfoMap(ctx, datautil.Slice(suggestions, func(e *model.FriendSuggestion) string {
+		return e.UserID
+	}), false)
+	if err != nil {
+		return nil, err
+	}
+	resp := &friendext.GetFriendSuggestionsResp{Total: total}
+	for _, suggestion := range suggestions {
+		user, ok := users[suggestion.UserID]
+		if !ok {
+			continue
+		}
+		resp.Suggestions = append(resp.Suggestions, &friendext.FriendSuggestion{
+			User:          user,
+			MutualFriends: suggestion.MutualFriends,
+			SharedGroups:  suggestion.SharedGroups,
+		})
+	}
+	return resp, nil
+}
+
+func (s *friendServer) DismissFriendSuggestion(ctx context.Context, req *friendext.DismissFriendSuggestionReq) (*friendext.DismissFriendSuggestionResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, s.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	if err := s.suggestionDatabase.DismissFriendSuggestion(ctx, req.UserID, req.SuggestedUserID); err != nil {
+		return nil, err
+	}
+	return &friendext.DismissFriendSuggestionResp{}, nil
+}
+
+func (s *friendServer) RefreshFriendSuggestions(ctx context.Context, req *friendext.RefreshFriendSuggestionsReq) (*friendext.RefreshFriendSuggestionsResp, error) {
+	if err := authverify.CheckAdmin(ctx, s.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	userIDs, err := s.suggestionDatabase.PopStaleFriendSuggestionUsers(ctx, int64(s.config.RpcConfig.FriendSuggestion.BatchSize))
+	if err != nil {
+		return nil, err
+	}
+	var (
+		count  int32
+		failed []string
+	)
+	for _, userID := range userIDs {
+		if err := s.computeFriendSuggestions(ctx, userID); err != nil {
+			log.ZError(ctx, "compute friend suggestions failed", err, "userID", userID)
+			failed = append(failed, userID)
+			continue
+		}
+		count++
+	}
+	// The failed users are queued again to be retried by the next run.
+	if len(failed) > 0 {
+		if err := s.suggestionDatabase.MarkFriendSuggestionsStale(ctx, failed); err != nil {
+			return nil, err
+		}
+	}
+	return &friendext.RefreshFriendSuggestionsResp{Count: count}, nil
+}
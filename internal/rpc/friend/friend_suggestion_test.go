@@ -0,0 +1,172 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package friend
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/controller"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/friendext"
+	"github.com/openimsdk/tools/mcontext"
+	"github.com/openimsdk/tools/utils/datautil"
+)
+
+type suggestionFriendDatabase struct {
+	controller.FriendDatabase
+	pending []string
+}
+
+func (f *suggestionFriendDatabase) FindFriendUserIDs(ctx context.Context, ownerUserID string) ([]string, error) {
+	return nil, errors.New("mongo unavailable")
+}
+
+func (f *suggestionFriendDatabase) FindPendingFriendRequestUserIDs(ctx context.Context, userID string) ([]string, error) {
+	return f.pending, nil
+}
+
+type suggestionBlackDatabase struct {
+	controller.BlackDatabase
+	blacks []string
+	// users who blocked the owner
+	blockers []string
+}
+
+func (b *suggestionBlackDatabase) FindBlackIDs(ctx context.Context, ownerUserID string) ([]string, error) {
+	return b.blacks, nil
+}
+
+func (b *suggestionBlackDatabase) FindReversalBlackOwnerIDs(ctx context.Context, userID string, ownerUserIDs []string) ([]string, error) {
+	var blockers []string
+	for _, ownerUserID := range ownerUserIDs {
+		if datautil.Contain(ownerUserID, b.blockers...) {
+			blockers = append(blockers, ownerUserID)
+		}
+	}
+	return blockers, nil
+}
+
+type stubSuggestionDatabase struct {
+	controller.FriendSuggestionDatabase
+	dismissed []string
+	stale     map[string]struct{}
+}
+
+func (f *stubSuggestionDatabase) FindDismissedUserIDs(ctx context.Context, ownerUserID string) ([]string, error) {
+	return f.dismissed, nil
+}
+
+func (f *stubSuggestionDatabase) MarkFriendSuggestionsStale(ctx context.Context, userIDs []string) error {
+	for _, userID := range userIDs {
+		f.stale[userID] = struct{}{}
+	}
+	return nil
+}
+
+func (f *stubSuggestionDatabase) PopStaleFriendSuggestionUsers(ctx context.Context, count int64) ([]string, error) {
+	var userIDs []string
+	for userID := range f.stale {
+		if int64(len(userIDs)) == count {
+			break
+		}
+		userIDs = append(userIDs, userID)
+		delete(f.stale, userID)
+	}
+	return userIDs, nil
+}
+
+func TestRankFriendSuggestions(t *testing.T) {
+	mutualFriends := map[string]int64{"a": 1, "b": 3, "friend": 5, "dismissed": 2}
+	sharedGroups := map[string]int64{"a": 4, "c": 2, "self": 9, "d": 1}
+	excluded := map[string]struct{}{"self": {}, "friend": {}, "dismissed": {}}
+	res := rankFriendSuggestions(mutualFriends, sharedGroups, excluded, 3, 1, 3)
+	expected := []model.FriendSuggestion{
+		{UserID: "b", MutualFriends: 3, Score: 9},
+		{UserID: "a", MutualFriends: 1, SharedGroups: 4, Score: 7},
+		{UserID: "c", SharedGroups: 2, Score: 2},
+	}
+	if len(res) != len(expected) {
+		t.Fatalf("expected %d suggestions, got %d", len(expected), len(res))
+	}
+	for i, suggestion := range res {
+		if *suggestion != expected[i] {
+			t.Errorf("suggestion %d: expected %+v, got %+v", i, expected[i], *suggestion)
+		}
+	}
+	if res := rankFriendSuggestions(mutualFriends, sharedGroups, excluded, 3, 0, 0); len(res) != 2 {
+		t.Errorf("expected only the candidates with mutual friends without a shared group weight, got %d", len(res))
+	}
+}
+
+func TestFriendSuggestionExcluded(t *testing.T) {
+	s := &friendServer{
+		friendDatabase:     &suggestionFriendDatabase{pending: []string{"from", "to"}},
+		blackDatabase:      &suggestionBlackDatabase{blacks: []string{"blocked"}},
+		suggestionDatabase: &stubSuggestionDatabase{dismissed: []string{"dismissed"}},
+	}
+	excluded, err := s.friendSuggestionExcluded(context.Background(), "self", []string{"friend"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, userID := range []string{"self", "friend", "blocked", "from", "to", "dismissed"} {
+		if _, ok := excluded[userID]; !ok {
+			t.Errorf("%s is not excluded", userID)
+		}
+	}
+	if len(excluded) != 6 {
+		t.Errorf("expected 6 excluded users, got %d", len(excluded))
+	}
+}
+
+func TestFriendSuggestionBlockersReplaced(t *testing.T) {
+	s := &friendServer{blackDatabase: &suggestionBlackDatabase{blockers: []string{"a", "c"}}}
+	mutualFriends := map[string]int64{"a": 5, "b": 4, "c": 3, "d": 2}
+	sharedGroups := map[string]int64{"e": 1}
+	excluded := map[string]struct{}{"self": {}}
+	if err := s.excludeFriendSuggestionBlockers(context.Background(), "self", excluded, mutualFriends, sharedGroups); err != nil {
+		t.Fatal(err)
+	}
+	suggestions := rankFriendSuggestions(mutualFriends, sharedGroups, excluded, 1, 1, 2)
+	userIDs := datautil.Slice(suggestions, func(e *model.FriendSuggestion) string { return e.UserID })
+	if !reflect.DeepEqual(userIDs, []string{"b", "d"}) {
+		t.Errorf("suggestions %v, expected the blockers replaced by [b d]", userIDs)
+	}
+}
+
+func TestRefreshFriendSuggestionsRequeuesFailed(t *testing.T) {
+	suggestionDB := &stubSuggestionDatabase{stale: map[string]struct{}{"a": {}, "b": {}}}
+	s := &friendServer{
+		friendDatabase:     &suggestionFriendDatabase{},
+		suggestionDatabase: suggestionDB,
+		config: &Config{
+			Share:     config.Share{IMAdminUserID: []string{"admin"}},
+			RpcConfig: config.Friend{FriendSuggestion: config.FriendSuggestion{BatchSize: 10}},
+		},
+	}
+	resp, err := s.RefreshFriendSuggestions(mcontext.SetOpUserID(context.Background(), "admin"), &friendext.RefreshFriendSuggestionsReq{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Count != 0 {
+		t.Errorf("expected no computed users, got %d", resp.Count)
+	}
+	if len(suggestionDB.stale) != 2 {
+		t.Errorf("expected the failed users to be queued again, got %v", suggestionDB.stale)
+	}
+}
@@ -21,6 +21,7 @@ import (
 	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/convert"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/controller"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/friendext"
 	"github.com/openimsdk/open-im-server/v3/pkg/rpcclient"
 	"github.com/openimsdk/open-im-server/v3/pkg/rpcclient/notification"
 	"github.com/openimsdk/protocol/constant"
@@ -204,6 +205,15 @@ func (f *FriendNotificationSender) FriendsInfoUpdateNotification(ctx context.Con
 	f.Notification(ctx, toUserID, toUserID, constant.FriendsInfoUpdateNotification, &tips)
 }
 
+func (f *FriendNotificationSender) FriendGroupChangedNotification(ctx context.Context, ownerUserID string, groupIDs []string, friendUserIDs []string) {
+	tips := friendext.FriendGroupChangedTips{
+		OwnerUserID:   ownerUserID,
+		GroupIDs:      groupIDs,
+		FriendUserIDs: friendUserIDs,
+	}
+	f.Notification(ctx, ownerUserID, ownerUserID, friendext.FriendGroupChangedNotification, &tips)
+}
+
 func (f *FriendNotificationSender) BlackAddedNotification(ctx context.Context, req *pbfriend.AddBlackReq) {
 	tips := sdkws.BlackAddedTips{FromToUserID: &sdkws.FromToUserID{}}
 	tips.FromToUserID.FromUserID = req.OwnerUserID
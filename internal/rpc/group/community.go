@@ -0,0 +1,754 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package group
+
+import (
+	"context"
+	"math/rand"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/servererrs"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/groupext"
+	"github.com/openimsdk/protocol/constant"
+	pbgroup "github.com/openimsdk/protocol/group"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/mcontext"
+	"github.com/openimsdk/tools/utils/datautil"
+	"github.com/openimsdk/tools/utils/encrypt"
+)
+
+func convertCommunity(community *model.Community, memberCount int64) *groupext.Community {
+	return &groupext.Community{
+		CommunityID:   community.CommunityID,
+		Name:          community.Name,
+		FaceURL:       community.FaceURL,
+		Introduction:  community.Introduction,
+		OwnerUserID:   community.OwnerUserID,
+		InviteOnly:    community.InviteOnly,
+		CreatorUserID: community.CreatorUserID,
+		CreateTime:    community.CreateTime.UnixMilli(),
+		Ex:            community.Ex,
+		MemberCount:   uint32(memberCount),
+	}
+}
+
+func convertCommunityMember(member *model.CommunityMember) *groupext.CommunityMember {
+	return &groupext.CommunityMember{
+		CommunityID:   member.CommunityID,
+		UserID:        member.UserID,
+		RoleLevel:     member.RoleLevel,
+		JoinTime:      member.JoinTime.UnixMilli(),
+		InviterUserID: member.InviterUserID,
+	}
+}
+
+// takeOpGroupMember returns the membership of the operator in the group, as raised by asCommunityAdmin.
+func (s *groupServer) takeOpGroupMember(ctx context.Context, groupID string) (*model.GroupMember, error) {
+	member, takeErr := s.db.TakeGroupMember(ctx, groupID, mcontext.GetOpUserID(ctx))
+	if takeErr != nil && !s.IsNotFound(takeErr) {
+		return nil, takeErr
+	}
+	opMember, err := s.asCommunityAdmin(ctx, groupID, member)
+	if err != nil {
+		return nil, err
+	}
+	if opMember == nil {
+		return nil, takeErr
+	}
+	return opMember, nil
+}
+
+// asCommunityAdmin raises the operator to an admin of the group when it is the owner or an admin of the community
+// the group is a channel of, even when it has not entered the channel. Community admins thus never act on the owner
+// or the other admins of a channel. member is the membership of the operator in the group and may be nil.
+func (s *groupServer) asCommunityAdmin(ctx context.Context, groupID string, member *model.GroupMember) (*model.GroupMember, error) {
+	if member != nil && member.RoleLevel >= constant.GroupAdmin {
+		return member, nil
+	}
+	group, err := s.db.TakeGroup(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+	if group.CommunityID == "" {
+		return member, nil
+	}
+	opUserID := mcontext.GetOpUserID(ctx)
+	ok, err := s.isCommunityAdmin(ctx, group.CommunityID, opUserID)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return member, nil
+	}
+	admin := &model.GroupMember{GroupID: groupID, UserID: opUserID}
+	if member != nil {
+		temp := *member
+		admin = &temp
+	}
+	admin.RoleLevel = constant.GroupAdmin
+	return admin, nil
+}
+
+func (s *groupServer) isCommunityAdmin(ctx context.Context, communityID string, userID string) (bool, error) {
+	member, err := s.communityDB.TakeCommunityMember(ctx, communityID, userID)
+	if err != nil {
+		if s.IsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return member.RoleLevel == constant.GroupOwner || member.RoleLevel == constant.GroupAdmin, nil
+}
+
+// checkCommunityAdmin requires the operator to be an app manager or an admin of the community.
+func (s *groupServer) checkCommunityAdmin(ctx context.Context, communityID string) error {
+	if authverify.IsAppManagerUid(ctx, s.config.Share.IMAdminUserID) {
+		return nil
+	}
+	ok, err := s.isCommunityAdmin(ctx, communityID, mcontext.GetOpUserID(ctx))
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errs.ErrNoPermission.WrapMsg("not a community admin")
+	}
+	return nil
+}
+
+// checkCommunityMembers requires users entering a channel to be members of its community.
+func (s *groupServer) checkCommunityMembers(ctx context.Context, group *model.Group, userIDs []string) error {
+	if group.CommunityID == "" {
+		return nil
+	}
+	userIDs = datautil.Distinct(userIDs)
+	members, err := s.communityDB.FindCommunityMembers(ctx, group.CommunityID, userIDs)
+	if err != nil {
+		return err
+	}
+	if len(members) != len(userIDs) {
+		return errs.ErrNoPermission.WrapMsg("only community members can enter the channel", "communityID", group.CommunityID)
+	}
+	return nil
+}
+
+// enterCommunityChannels adds the users to the channels they are not in yet.
+func (s *groupServer) enterCommunityChannels(ctx context.Context, channels []*model.Group, userIDs []string, inviterUserID string) error {
+	for _, channel := range channels {
+		existing, err := s.db.FindGroupMembers(ctx, channel.GroupID, userIDs)
+		if err != nil {
+			return err
+		}
+		existingIDs := datautil.SliceSet(datautil.Slice(existing, func(e *model.GroupMember) string { return e.UserID }))
+		var (
+			members    []*model.GroupMember
+			enterIDs   []string
+			joinedTime = time.Now()
+		)
+		for _, userID := range userIDs {
+			if _, ok := existingIDs[userID]; ok {
+				continue
+			}
+			enterIDs = append(enterIDs, userID)
+			members = append(members, &model.GroupMember{
+				GroupID:        channel.GroupID,
+				UserID:         userID,
+				RoleLevel:      constant.GroupOrdinaryUsers,
+				JoinSource:     groupext.JoinByCommunity,
+				InviterUserID:  inviterUserID,
+				OperatorUserID: mcontext.GetOpUserID(ctx),
+				JoinTime:       joinedTime,
+				MuteEndTime:    time.UnixMilli(0),
+			})
+		}
+		if len(members) == 0 {
+			continue
+		}
+		if err := s.db.CreateGroup(ctx, nil, members); err != nil {
+			return err
+		}
+		if err := s.conversationRpcClient.GroupChatFirstCreateConversation(ctx, channel.GroupID, enterIDs); err != nil {
+			return err
+		}
+		for _, userID := range enterIDs {
+			s.notification.MemberEnterNotification(ctx, channel.GroupID, userID)
+		}
+	}
+	return nil
+}
+
+// joinedCommunityChannels returns the channel memberships of the users, channel owners cannot leave a community.
+func (s *groupServer) joinedCommunityChannels(ctx context.Context, communityID string, userIDs []string) (map[string][]string, error) {
+	channels, err := s.db.FindCommunityGroups(ctx, communityID)
+	if err != nil {
+		return nil, err
+	}
+	if len(channels) == 0 {
+		return nil, nil
+	}
+	channelIDs := datautil.Slice(channels, func(e *model.Group) string { return e.GroupID })
+	joined := make(map[string][]string)
+	for _, userID := range userIDs {
+		members, err := s.db.FindGroupMemberUser(ctx, channelIDs, userID)
+		if err != nil {
+			return nil, err
+		}
+		for _, member := range members {
+			if member.RoleLevel == constant.GroupOwner {
+				return nil, errs.ErrNoPermission.WrapMsg("channel owner cannot leave the community", "userID", userID, "groupID", member.GroupID)
+			}
+			joined[member.GroupID] = append(joined[member.GroupID], userID)
+		}
+	}
+	return joined, nil
+}
+
+func (s *groupServer) takeCommunity(ctx context.Context, communityID string) (*model.Community, error) {
+	community, err := s.communityDB.TakeCommunity(ctx, communityID)
+	if err != nil {
+		if s.IsNotFound(err) {
+			return nil, errs.ErrRecordNotFound.WrapMsg("community not found", "communityID", communityID)
+		}
+		return nil, err
+	}
+	return community, nil
+}
+
+func (s *groupServer) CreateCommunity(ctx context.Context, req *groupext.CreateCommunityReq) (*groupext.CreateCommunityResp, error) {
+	opUserID := mcontext.GetOpUserID(ctx)
+	if _, err := s.user.GetPublicUserInfo(ctx, opUserID); err != nil {
+		return nil, err
+	}
+	communityID := req.Community.CommunityID
+	if communityID == "" {
+		communityID = encrypt.Md5(strings.Join([]string{mcontext.GetOperationID(ctx), strconv.FormatInt(time.Now().UnixNano(), 10), strconv.Itoa(rand.Int())}, ",;,"))
+	} else if _, err := s.communityDB.TakeCommunity(ctx, communityID); err == nil {
+		return nil, errs.ErrArgs.WrapMsg("community id existed", "communityID", communityID)
+	} else if !s.IsNotFound(err) {
+		return nil, err
+	}
+	now := time.Now()
+	community := &model.Community{
+		CommunityID:   communityID,
+		Name:          req.Community.Name,
+		FaceURL:       req.Community.FaceURL,
+		Introduction:  req.Community.Introduction,
+		OwnerUserID:   opUserID,
+		InviteOnly:    req.Community.InviteOnly,
+		CreatorUserID: opUserID,
+		CreateTime:    now,
+		Ex:            req.Community.Ex,
+	}
+	owner := &model.CommunityMember{
+		CommunityID: communityID,
+		UserID:      opUserID,
+		RoleLevel:   constant.GroupOwner,
+		JoinTime:    now,
+	}
+	if err := s.communityDB.CreateCommunity(ctx, community, owner); err != nil {
+		return nil, err
+	}
+	return &groupext.CreateCommunityResp{Community: convertCommunity(community, 1)}, nil
+}
+
+func (s *groupServer) SetCommunityInfo(ctx context.Context, req *groupext.SetCommunityInfoReq) (*groupext.SetCommunityInfoResp, error) {
+	if _, err := s.takeCommunity(ctx, req.CommunityID); err != nil {
+		return nil, err
+	}
+	if err := s.checkCommunityAdmin(ctx, req.CommunityID); err != nil {
+		return nil, err
+	}
+	update := map[string]any{
+		"name":         req.Name,
+		"face_url":     req.FaceURL,
+		"introduction": req.Introduction,
+		"invite_only":  req.InviteOnly,
+		"ex":           req.Ex,
+	}
+	if err := s.communityDB.UpdateCommunity(ctx, req.CommunityID, update); err != nil {
+		return nil, err
+	}
+	return &groupext.SetCommunityInfoResp{}, nil
+}
+
+func (s *groupServer) getCommunitiesInfo(ctx context.Context, communityIDs []string) ([]*groupext.Community, error) {
+	communities, err := s.communityDB.FindCommunities(ctx, communityIDs)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]*groupext.Community, 0, len(communities))
+	for _, community := range communities {
+		count, err := s.communityDB.CountCommunityMembers(ctx, community.CommunityID)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, convertCommunity(community, count))
+	}
+	return res, nil
+}
+
+// GetCommunitiesInfo shows communities anyone can join to everyone, invite only ones to their members.
+func (s *groupServer) GetCommunitiesInfo(ctx context.Context, req *groupext.GetCommunitiesInfoReq) (*groupext.GetCommunitiesInfoResp, error) {
+	communities, err := s.getCommunitiesInfo(ctx, req.CommunityIDs)
+	if err != nil {
+		return nil, err
+	}
+	if !authverify.IsAppManagerUid(ctx, s.config.Share.IMAdminUserID) {
+		joinedIDs, err := s.communityDB.FindJoinedCommunityIDs(ctx, mcontext.GetOpUserID(ctx))
+		if err != nil {
+			return nil, err
+		}
+		for _, community := range communities {
+			if community.InviteOnly && !datautil.Contain(community.CommunityID, joinedIDs...) {
+				return nil, errs.ErrNoPermission.WrapMsg("not in community", "communityID", community.CommunityID)
+			}
+		}
+	}
+	return &groupext.GetCommunitiesInfoResp{Communities: communities}, nil
+}
+
+func (s *groupServer) DismissCommunity(ctx context.Context, req *groupext.DismissCommunityReq) (*groupext.DismissCommunityResp, error) {
+	community, err := s.takeCommunity(ctx, req.CommunityID)
+	if err != nil {
+		return nil, err
+	}
+	if !authverify.IsAppManagerUid(ctx, s.config.Share.IMAdminUserID) && community.OwnerUserID != mcontext.GetOpUserID(ctx) {
+		return nil, errs.ErrNoPermission.WrapMsg("only the community owner can dismiss it")
+	}
+	channels, err := s.db.FindCommunityGroups(ctx, req.CommunityID)
+	if err != nil {
+		return nil, err
+	}
+	for _, channel := range channels {
+		if err := s.db.UpdateGroup(ctx, channel.GroupID, map[string]any{"community_id": "", "public_channel": false}); err != nil {
+			return nil, err
+		}
+	}
+	if err := s.communityDB.DeleteCommunity(ctx, req.CommunityID); err != nil {
+		return nil, err
+	}
+	return &groupext.DismissCommunityResp{}, nil
+}
+
+func (s *groupServer) SetCommunityChannel(ctx context.Context, req *groupext.SetCommunityChannelReq) (*groupext.SetCommunityChannelResp, error) {
+	if _, err := s.takeCommunity(ctx, req.CommunityID); err != nil {
+		return nil, err
+	}
+	if err := s.checkCommunityAdmin(ctx, req.CommunityID); err != nil {
+		return nil, err
+	}
+	group, err := s.db.TakeGroup(ctx, req.GroupID)
+	if err != nil {
+		return nil, err
+	}
+	if group.Status == constant.GroupStatusDismissed {
+		return nil, servererrs.ErrDismissedAlready.Wrap()
+	}
+	if group.CommunityID == req.CommunityID {
+		if group.PublicChannel != req.Public {
+			if err := s.db.UpdateGroup(ctx, req.GroupID, map[string]any{"public_channel": req.Public}); err != nil {
+				return nil, err
+			}
+		}
+		return &groupext.SetCommunityChannelResp{}, nil
+	}
+	if group.CommunityID != "" {
+		return nil, errs.ErrArgs.WrapMsg("group is a channel of another community", "communityID", group.CommunityID)
+	}
+	if !authverify.IsAppManagerUid(ctx, s.config.Share.IMAdminUserID) {
+		owner, err := s.db.TakeGroupOwner(ctx, req.GroupID)
+		if err != nil {
+			return nil, err
+		}
+		if owner.UserID != mcontext.GetOpUserID(ctx) {
+			return nil, errs.ErrNoPermission.WrapMsg("only the group owner can add it to a community")
+		}
+	}
+	// Members of the group join the community so they keep access to the channel.
+	userIDs, err := s.db.FindGroupMemberUserID(ctx, req.GroupID)
+	if err != nil {
+		return nil, err
+	}
+	existing, err := s.communityDB.FindCommunityMembers(ctx, req.CommunityID, userIDs)
+	if err != nil {
+		return nil, err
+	}
+	existingIDs := datautil.SliceSet(datautil.Slice(existing, func(e *model.CommunityMember) string { return e.UserID }))
+	now := time.Now()
+	var members []*model.CommunityMember
+	for _, userID := range userIDs {
+		if _, ok := existingIDs[userID]; ok {
+			continue
+		}
+		members = append(members, &model.CommunityMember{
+			CommunityID:   req.CommunityID,
+			UserID:        userID,
+			RoleLevel:     constant.GroupOrdinaryUsers,
+			JoinTime:      now,
+			InviterUserID: mcontext.GetOpUserID(ctx),
+		})
+	}
+	if err := s.communityDB.AddCommunityMembers(ctx, members); err != nil {
+		return nil, err
+	}
+	if err := s.db.UpdateGroup(ctx, req.GroupID, map[string]any{"community_id": req.CommunityID, "public_channel": req.Public}); err != nil {
+		return nil, err
+	}
+	return &groupext.SetCommunityChannelResp{}, nil
+}
+
+func (s *groupServer) RemoveCommunityChannel(ctx context.Context, req *groupext.RemoveCommunityChannelReq) (*groupext.RemoveCommunityChannelResp, error) {
+	if err := s.checkCommunityAdmin(ctx, req.CommunityID); err != nil {
+		return nil, err
+	}
+	group, err := s.db.TakeGroup(ctx, req.GroupID)
+	if err != nil {
+		return nil, err
+	}
+	if group.CommunityID != req.CommunityID {
+		return nil, errs.ErrArgs.WrapMsg("group is not a channel of the community")
+	}
+	if err := s.db.UpdateGroup(ctx, req.GroupID, map[string]any{"community_id": "", "public_channel": false}); err != nil {
+		return nil, err
+	}
+	return &groupext.RemoveCommunityChannelResp{}, nil
+}
+
+func (s *groupServer) GetCommunityChannels(ctx context.Context, req *groupext.GetCommunityChannelsReq) (*groupext.GetCommunityChannelsResp, error) {
+	opUserID := mcontext.GetOpUserID(ctx)
+	isAdmin := authverify.IsAppManagerUid(ctx, s.config.Share.IMAdminUserID)
+	if !isAdmin {
+		member, err := s.communityDB.TakeCommunityMember(ctx, req.CommunityID, opUserID)
+		if err != nil {
+			return nil, err
+		}
+		isAdmin = member.RoleLevel == constant.GroupOwner || member.RoleLevel == constant.GroupAdmin
+	}
+	channels, err := s.db.FindCommunityGroups(ctx, req.CommunityID)
+	if err != nil {
+		return nil, err
+	}
+	channelIDs := datautil.Slice(channels, func(e *model.Group) string { return e.GroupID })
+	joined, err := s.db.FindGroupMemberUser(ctx, channelIDs, opUserID)
+	if err != nil {
+		return nil, err
+	}
+	joinedIDs := datautil.SliceSet(datautil.Slice(joined, func(e *model.GroupMember) string { return e.GroupID }))
+	resp := &groupext.GetCommunityChannelsResp{Channels: make([]*groupext.CommunityChannel, 0, len(channels))}
+	for _, channel := range channels {
+		_, ok := joinedIDs[channel.GroupID]
+		if !(ok || channel.PublicChannel || isAdmin) {
+			continue
+		}
+		resp.Channels = append(resp.Channels, &groupext.CommunityChannel{
+			GroupID:   channel.GroupID,
+			GroupName: channel.GroupName,
+			FaceURL:   channel.FaceURL,
+			Public:    channel.PublicChannel,
+			Joined:    ok,
+		})
+	}
+	return resp, nil
+}
+
+// addCommunityMembers adds the users not in the community yet and lets every user enter the public channels.
+func (s *groupServer) addCommunityMembers(ctx context.Context, communityID string, userIDs []string, inviterUserID string) error {
+	existing, err := s.communityDB.FindCommunityMembers(ctx, communityID, userIDs)
+	if err != nil {
+		return err
+	}
+	existingIDs := datautil.SliceSet(datautil.Slice(existing, func(e *model.CommunityMember) string { return e.UserID }))
+	now := time.Now()
+	var members []*model.CommunityMember
+	for _, userID := range userIDs {
+		if _, ok := existingIDs[userID]; ok {
+			continue
+		}
+		members = append(members, &model.CommunityMember{
+			CommunityID:   communityID,
+			UserID:        userID,
+			RoleLevel:     constant.GroupOrdinaryUsers,
+			JoinTime:      now,
+			InviterUserID: inviterUserID,
+		})
+	}
+	if err := s.communityDB.AddCommunityMembers(ctx, members); err != nil {
+		return err
+	}
+	channels, err := s.db.FindCommunityGroups(ctx, communityID)
+	if err != nil {
+		return err
+	}
+	channels = datautil.Filter(channels, func(e *model.Group) (*model.Group, bool) { return e, e.PublicChannel })
+	return s.enterCommunityChannels(ctx, channels, userIDs, inviterUserID)
+}
+
+func (s *groupServer) JoinCommunity(ctx context.Context, req *groupext.JoinCommunityReq) (*groupext.JoinCommunityResp, error) {
+	community, err := s.takeCommunity(ctx, req.CommunityID)
+	if err != nil {
+		return nil, err
+	}
+	if community.InviteOnly {
+		return nil, errs.ErrNoPermission.WrapMsg("community is invite only")
+	}
+	if err := s.addCommunityMembers(ctx, req.CommunityID, []string{mcontext.GetOpUserID(ctx)}, ""); err != nil {
+		return nil, err
+	}
+	return &groupext.JoinCommunityResp{}, nil
+}
+
+func (s *groupServer) InviteToCommunity(ctx context.Context, req *groupext.InviteToCommunityReq) (*groupext.InviteToCommunityResp, error) {
+	if _, err := s.takeCommunity(ctx, req.CommunityID); err != nil {
+		return nil, err
+	}
+	if err := s.checkCommunityAdmin(ctx, req.CommunityID); err != nil {
+		return nil, err
+	}
+	users, err := s.user.GetPublicUserInfoMap(ctx, req.UserIDs, true)
+	if err != nil {
+		return nil, err
+	}
+	if len(users) != len(req.UserIDs) {
+		return nil, errs.ErrRecordNotFound.WrapMsg("user not found")
+	}
+	if err := s.addCommunityMembers(ctx, req.CommunityID, req.UserIDs, mcontext.GetOpUserID(ctx)); err != nil {
+		return nil, err
+	}
+	return &groupext.InviteToCommunityResp{}, nil
+}
+
+func (s *groupServer) JoinCommunityChannel(ctx context.Context, req *groupext.JoinCommunityChannelReq) (*groupext.JoinCommunityChannelResp, error) {
+	group, err := s.db.TakeGroup(ctx, req.GroupID)
+	if err != nil {
+		return nil, err
+	}
+	if group.CommunityID == "" || !group.PublicChannel {
+		return nil, errs.ErrNoPermission.WrapMsg("group is not a public channel")
+	}
+	if group.Status == constant.GroupStatusDismissed {
+		return nil, servererrs.ErrDismissedAlready.Wrap()
+	}
+	userID := mcontext.GetOpUserID(ctx)
+	if err := s.checkCommunityMembers(ctx, group, []string{userID}); err != nil {
+		return nil, err
+	}
+	if err := s.enterCommunityChannels(ctx, []*model.Group{group}, []string{userID}, ""); err != nil {
+		return nil, err
+	}
+	return &groupext.JoinCommunityChannelResp{}, nil
+}
+
+func (s *groupServer) QuitCommunity(ctx context.Context, req *groupext.QuitCommunityReq) (*groupext.QuitCommunityResp, error) {
+	community, err := s.takeCommunity(ctx, req.CommunityID)
+	if err != nil {
+		return nil, err
+	}
+	userID := mcontext.GetOpUserID(ctx)
+	if community.OwnerUserID == userID {
+		return nil, errs.ErrNoPermission.WrapMsg("community owner can't quit")
+	}
+	if _, err := s.communityDB.TakeCommunityMember(ctx, req.CommunityID, userID); err != nil {
+		return nil, err
+	}
+	joined, err := s.joinedCommunityChannels(ctx, req.CommunityID, []string{userID})
+	if err != nil {
+		return nil, err
+	}
+	// leave the channels first, a failure leaves the user in the community to quit again
+	for groupID := range joined {
+		if _, err := s.QuitGroup(ctx, &pbgroup.QuitGroupReq{GroupID: groupID}); err != nil {
+			return nil, err
+		}
+	}
+	if err := s.communityDB.DeleteCommunityMembers(ctx, req.CommunityID, []string{userID}); err != nil {
+		return nil, err
+	}
+	return &groupext.QuitCommunityResp{}, nil
+}
+
+func (s *groupServer) KickCommunityMember(ctx context.Context, req *groupext.KickCommunityMemberReq) (*groupext.KickCommunityMemberResp, error) {
+	community, err := s.takeCommunity(ctx, req.CommunityID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkCommunityAdmin(ctx, req.CommunityID); err != nil {
+		return nil, err
+	}
+	members, err := s.communityDB.FindCommunityMembers(ctx, req.CommunityID, req.UserIDs)
+	if err != nil {
+		return nil, err
+	}
+	if len(members) != len(req.UserIDs) {
+		return nil, errs.ErrArgs.WrapMsg("user not in community")
+	}
+	isOwner := authverify.IsAppManagerUid(ctx, s.config.Share.IMAdminUserID) || community.OwnerUserID == mcontext.GetOpUserID(ctx)
+	for _, member := range members {
+		if member.RoleLevel == constant.GroupOwner {
+			return nil, errs.ErrNoPermission.WrapMsg("community owner can't be kicked")
+		}
+		if member.RoleLevel == constant.GroupAdmin && !isOwner {
+			return nil, errs.ErrNoPermission.WrapMsg("only the owner can kick community admins")
+		}
+	}
+	joined, err := s.joinedCommunityChannels(ctx, req.CommunityID, req.UserIDs)
+	if err != nil {
+		return nil, err
+	}
+	// remove them from the channels first, a failure leaves them in the community to kick again
+	for groupID, userIDs := range joined {
+		if err := s.kickCommunityChannelMembers(ctx, groupID, userIDs); err != nil {
+			return nil, err
+		}
+	}
+	if err := s.communityDB.DeleteCommunityMembers(ctx, req.CommunityID, req.UserIDs); err != nil {
+		return nil, err
+	}
+	return &groupext.KickCommunityMemberResp{}, nil
+}
+
+// kickCommunityChannelMembers removes users kicked from the community from one of its channels. The community level
+// checks already passed, so the role of the users in the channel does not matter.
+func (s *groupServer) kickCommunityChannelMembers(ctx context.Context, groupID string, userIDs []string) error {
+	group, err := s.db.TakeGroup(ctx, groupID)
+	if err != nil {
+		return err
+	}
+	members, err := s.db.FindGroupMembers(ctx, groupID, append(userIDs, mcontext.GetOpUserID(ctx)))
+	if err != nil {
+		return err
+	}
+	if err := s.PopulateGroupMember(ctx, members...); err != nil {
+		return err
+	}
+	memberMap := make(map[string]*model.GroupMember, len(members))
+	for _, member := range members {
+		memberMap[member.UserID] = member
+	}
+	req := &pbgroup.KickGroupMemberReq{GroupID: groupID, KickedUserIDs: userIDs, Reason: "removed from community"}
+	return s.kickGroupMembers(ctx, group, req, memberMap)
+}
+
+func (s *groupServer) SetCommunityMemberRole(ctx context.Context, req *groupext.SetCommunityMemberRoleReq) (*groupext.SetCommunityMemberRoleResp, error) {
+	community, err := s.takeCommunity(ctx, req.CommunityID)
+	if err != nil {
+		return nil, err
+	}
+	if !authverify.IsAppManagerUid(ctx, s.config.Share.IMAdminUserID) && community.OwnerUserID != mcontext.GetOpUserID(ctx) {
+		return nil, errs.ErrNoPermission.WrapMsg("only the community owner can set roles")
+	}
+	member, err := s.communityDB.TakeCommunityMember(ctx, req.CommunityID, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if member.RoleLevel == constant.GroupOwner {
+		return nil, errs.ErrNoPermission.WrapMsg("can't change the role of the community owner")
+	}
+	if err := s.communityDB.SetCommunityMemberRoleLevel(ctx, req.CommunityID, req.UserID, req.RoleLevel); err != nil {
+		return nil, err
+	}
+	return &groupext.SetCommunityMemberRoleResp{}, nil
+}
+
+func (s *groupServer) GetCommunityMembers(ctx context.Context, req *groupext.GetCommunityMembersReq) (*groupext.GetCommunityMembersResp, error) {
+	if !authverify.IsAppManagerUid(ctx, s.config.Share.IMAdminUserID) {
+		if _, err := s.communityDB.TakeCommunityMember(ctx, req.CommunityID, mcontext.GetOpUserID(ctx)); err != nil {
+			return nil, err
+		}
+	}
+	total, members, err := s.communityDB.PageCommunityMembers(ctx, req.CommunityID, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &groupext.GetCommunityMembersResp{Total: total, Members: datautil.Slice(members, convertCommunityMember)}, nil
+}
+
+func (s *groupServer) GetJoinedCommunities(ctx context.Context, req *groupext.GetJoinedCommunitiesReq) (*groupext.GetJoinedCommunitiesResp, error) {
+	communityIDs, err := s.communityDB.FindJoinedCommunityIDs(ctx, mcontext.GetOpUserID(ctx))
+	if err != nil {
+		return nil, err
+	}
+	if len(communityIDs) == 0 {
+		return &groupext.GetJoinedCommunitiesResp{}, nil
+	}
+	communities, err := s.getCommunitiesInfo(ctx, communityIDs)
+	if err != nil {
+		return nil, err
+	}
+	return &groupext.GetJoinedCommunitiesResp{Communities: communities}, nil
+}
+
+func (s *groupServer) SendCommunityAnnouncement(ctx context.Context, req *groupext.SendCommunityAnnouncementReq) (*groupext.SendCommunityAnnouncementResp, error) {
+	community, err := s.takeCommunity(ctx, req.CommunityID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkCommunityAdmin(ctx, req.CommunityID); err != nil {
+		return nil, err
+	}
+	channels, err := s.db.FindCommunityGroups(ctx, req.CommunityID)
+	if err != nil {
+		return nil, err
+	}
+	opUserID := mcontext.GetOpUserID(ctx)
+	tips := &groupext.CommunityAnnouncementTips{
+		CommunityID:   community.CommunityID,
+		CommunityName: community.Name,
+		OpUserID:      opUserID,
+		Content:       req.Content,
+		SendTime:      time.Now().UnixMilli(),
+	}
+	for _, channel := range channels {
+		s.notification.Notification(ctx, opUserID, channel.GroupID, groupext.CommunityAnnouncementNotification, tips)
+	}
+	return &groupext.SendCommunityAnnouncementResp{Count: int32(len(channels))}, nil
+}
+
+func (s *groupServer) GetGroupCommunity(ctx context.Context, req *groupext.GetGroupCommunityReq) (*groupext.GetGroupCommunityResp, error) {
+	group, err := s.db.TakeGroup(ctx, req.GroupID)
+	if err != nil {
+		return nil, err
+	}
+	return &groupext.GetGroupCommunityResp{CommunityID: group.CommunityID, Public: group.PublicChannel}, nil
+}
+
+// GetCommunityMember shows a membership to the user itself and to the other members of the community.
+func (s *groupServer) GetCommunityMember(ctx context.Context, req *groupext.GetCommunityMemberReq) (*groupext.GetCommunityMemberResp, error) {
+	opUserID := mcontext.GetOpUserID(ctx)
+	if opUserID != req.UserID && !authverify.IsAppManagerUid(ctx, s.config.Share.IMAdminUserID) {
+		if _, err := s.communityDB.TakeCommunityMember(ctx, req.CommunityID, opUserID); err != nil {
+			return nil, err
+		}
+	}
+	member, err := s.communityDB.TakeCommunityMember(ctx, req.CommunityID, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	return &groupext.GetCommunityMemberResp{Member: convertCommunityMember(member)}, nil
+}
+
+// FindCommunityMemberIDs is used by the push service to leave out channel members who are no longer in the community.
+func (s *groupServer) FindCommunityMemberIDs(ctx context.Context, req *groupext.FindCommunityMemberIDsReq) (*groupext.FindCommunityMemberIDsResp, error) {
+	if err := authverify.CheckAdmin(ctx, s.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	members, err := s.communityDB.FindCommunityMembers(ctx, req.CommunityID, req.UserIDs)
+	if err != nil {
+		return nil, err
+	}
+	return &groupext.FindCommunityMemberIDsResp{UserIDs: datautil.Slice(members, func(e *model.CommunityMember) string { return e.UserID })}, nil
+}
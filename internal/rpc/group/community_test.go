@@ -0,0 +1,235 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package group
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/controller"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/groupext"
+	"github.com/openimsdk/protocol/constant"
+	pbgroup "github.com/openimsdk/protocol/group"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/mcontext"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// stubChannelGroupDB holds a single channel, its member writes fail like those of stubInviteGroupDB.
+type stubChannelGroupDB struct {
+	stubInviteGroupDB
+}
+
+func (d *stubChannelGroupDB) FindCommunityGroups(_ context.Context, communityID string) ([]*model.Group, error) {
+	if d.group.CommunityID != communityID {
+		return nil, nil
+	}
+	return []*model.Group{d.group}, nil
+}
+
+func (d *stubChannelGroupDB) FindGroupMemberUser(_ context.Context, _ []string, userID string) ([]*model.GroupMember, error) {
+	if member, ok := d.members[userID]; ok {
+		return []*model.GroupMember{member}, nil
+	}
+	return nil, nil
+}
+
+func (d *stubChannelGroupDB) FindGroupMemberNum(context.Context, string) (uint32, error) {
+	return uint32(len(d.members)), nil
+}
+
+func (d *stubChannelGroupDB) GetGroupRoleLevelMemberIDs(_ context.Context, _ string, roleLevel int32) ([]string, error) {
+	var userIDs []string
+	for _, member := range d.members {
+		if member.RoleLevel == roleLevel {
+			userIDs = append(userIDs, member.UserID)
+		}
+	}
+	return userIDs, nil
+}
+
+type stubCommunityDB struct {
+	controller.CommunityDatabase
+	community *model.Community
+	members   map[string]*model.CommunityMember
+	deleted   []string
+}
+
+func (d *stubCommunityDB) TakeCommunity(_ context.Context, communityID string) (*model.Community, error) {
+	if d.community.CommunityID != communityID {
+		return nil, errs.Wrap(mongo.ErrNoDocuments)
+	}
+	return d.community, nil
+}
+
+func (d *stubCommunityDB) FindCommunities(_ context.Context, communityIDs []string) ([]*model.Community, error) {
+	for _, communityID := range communityIDs {
+		if communityID == d.community.CommunityID {
+			return []*model.Community{d.community}, nil
+		}
+	}
+	return nil, nil
+}
+
+func (d *stubCommunityDB) CountCommunityMembers(context.Context, string) (int64, error) {
+	return int64(len(d.members)), nil
+}
+
+func (d *stubCommunityDB) TakeCommunityMember(_ context.Context, _ string, userID string) (*model.CommunityMember, error) {
+	if member, ok := d.members[userID]; ok {
+		return member, nil
+	}
+	return nil, errs.Wrap(mongo.ErrNoDocuments)
+}
+
+func (d *stubCommunityDB) FindCommunityMembers(_ context.Context, _ string, userIDs []string) ([]*model.CommunityMember, error) {
+	var members []*model.CommunityMember
+	for _, userID := range userIDs {
+		if member, ok := d.members[userID]; ok {
+			members = append(members, member)
+		}
+	}
+	return members, nil
+}
+
+func (d *stubCommunityDB) FindJoinedCommunityIDs(_ context.Context, userID string) ([]string, error) {
+	if _, ok := d.members[userID]; ok {
+		return []string{d.community.CommunityID}, nil
+	}
+	return nil, nil
+}
+
+func (d *stubCommunityDB) DeleteCommunityMembers(_ context.Context, _ string, userIDs []string) error {
+	d.deleted = append(d.deleted, userIDs...)
+	return nil
+}
+
+// newCommunityTestServer serves the channel g1 of the community c1, where mod is a community admin who is an
+// ordinary member of the channel and boss a community admin who has not entered it.
+func newCommunityTestServer() (*groupServer, *stubChannelGroupDB, *stubCommunityDB) {
+	groupMember := func(userID string, roleLevel int32) *model.GroupMember {
+		return &model.GroupMember{GroupID: "g1", UserID: userID, RoleLevel: roleLevel, Nickname: userID, FaceURL: userID}
+	}
+	communityMember := func(userID string, roleLevel int32) *model.CommunityMember {
+		return &model.CommunityMember{CommunityID: "c1", UserID: userID, RoleLevel: roleLevel}
+	}
+	groupDB := &stubChannelGroupDB{stubInviteGroupDB{
+		group: &model.Group{GroupID: "g1", CommunityID: "c1"},
+		members: map[string]*model.GroupMember{
+			"owner":  groupMember("owner", constant.GroupOwner),
+			"admin":  groupMember("admin", constant.GroupAdmin),
+			"mod":    groupMember("mod", constant.GroupOrdinaryUsers),
+			"member": groupMember("member", constant.GroupOrdinaryUsers),
+		},
+	}}
+	communityDB := &stubCommunityDB{
+		community: &model.Community{CommunityID: "c1", OwnerUserID: "founder", InviteOnly: true},
+		members: map[string]*model.CommunityMember{
+			"founder": communityMember("founder", constant.GroupOwner),
+			"mod":     communityMember("mod", constant.GroupAdmin),
+			"boss":    communityMember("boss", constant.GroupAdmin),
+			"admin":   communityMember("admin", constant.GroupOrdinaryUsers),
+			"member":  communityMember("member", constant.GroupOrdinaryUsers),
+		},
+	}
+	s := newInviteLinkTestServer(&groupDB.stubInviteGroupDB, &stubInviteLinkDB{})
+	s.db = groupDB
+	s.communityDB = communityDB
+	return s, groupDB, communityDB
+}
+
+func TestCommunityAdminChannelRights(t *testing.T) {
+	s, _, _ := newCommunityTestServer()
+	for _, opUserID := range []string{"mod", "boss"} {
+		ctx := mcontext.SetOpUserID(context.Background(), opUserID)
+		opMember, err := s.checkGroupPermission(ctx, "g1", authverify.GroupPermissionKick)
+		if err != nil {
+			t.Fatalf("%s: %v", opUserID, err)
+		}
+		if opMember.UserID != opUserID || opMember.RoleLevel != constant.GroupAdmin {
+			t.Errorf("%s acts as %+v", opUserID, opMember)
+		}
+		kick := func(userID string) error {
+			_, err := s.KickGroupMember(ctx, &pbgroup.KickGroupMemberReq{GroupID: "g1", KickedUserIDs: []string{userID}})
+			return err
+		}
+		for _, userID := range []string{"owner", "admin"} {
+			if err := kick(userID); !errs.ErrNoPermission.Is(err) {
+				t.Errorf("%s kicked %s: %v", opUserID, userID, err)
+			}
+		}
+		if err := kick("member"); !errors.Is(err, errStubWrite) {
+			t.Errorf("%s kicked member: %v", opUserID, err)
+		}
+		if err := s.checkMuteGroupMember(ctx, &model.GroupMember{GroupID: "g1", UserID: "owner", RoleLevel: constant.GroupOwner}); !errs.ErrNoPermission.Is(err) {
+			t.Errorf("%s muted the owner: %v", opUserID, err)
+		}
+	}
+	// the channel membership of mod is left as is
+	if s.db.(*stubChannelGroupDB).members["mod"].RoleLevel != constant.GroupOrdinaryUsers {
+		t.Error("membership of a community admin was changed")
+	}
+	ctx := mcontext.SetOpUserID(context.Background(), "member")
+	if _, err := s.checkGroupPermission(ctx, "g1", authverify.GroupPermissionKick); !errs.ErrNoPermission.Is(err) {
+		t.Errorf("member kicks: %v", err)
+	}
+}
+
+func TestKickCommunityMemberLeavesChannelsFirst(t *testing.T) {
+	s, _, communityDB := newCommunityTestServer()
+	ctx := mcontext.SetOpUserID(context.Background(), "founder")
+	// the channel admin is an ordinary community member, the community owner removes it from the channel too
+	_, err := s.KickCommunityMember(ctx, &groupext.KickCommunityMemberReq{CommunityID: "c1", UserIDs: []string{"admin"}})
+	if !errors.Is(err, errStubWrite) {
+		t.Fatalf("kick: %v", err)
+	}
+	if len(communityDB.deleted) != 0 {
+		t.Errorf("community members %v deleted although the channel kick failed", communityDB.deleted)
+	}
+}
+
+func TestCommunityReadAccess(t *testing.T) {
+	s, _, _ := newCommunityTestServer()
+	getMember := func(opUserID string, userID string) error {
+		_, err := s.GetCommunityMember(mcontext.SetOpUserID(context.Background(), opUserID), &groupext.GetCommunityMemberReq{CommunityID: "c1", UserID: userID})
+		return err
+	}
+	if err := getMember("member", "mod"); err != nil {
+		t.Errorf("member reads a member: %v", err)
+	}
+	if err := getMember("stranger", "stranger"); !s.IsNotFound(err) {
+		t.Errorf("stranger reads itself: %v", err)
+	}
+	if err := getMember("stranger", "mod"); err == nil {
+		t.Error("stranger reads a member")
+	}
+	getInfo := func(opUserID string) error {
+		_, err := s.GetCommunitiesInfo(mcontext.SetOpUserID(context.Background(), opUserID), &groupext.GetCommunitiesInfoReq{CommunityIDs: []string{"c1"}})
+		return err
+	}
+	if err := getInfo("member"); err != nil {
+		t.Errorf("member reads the community: %v", err)
+	}
+	if err := getInfo("stranger"); !errs.ErrNoPermission.Is(err) {
+		t.Errorf("stranger reads an invite only community: %v", err)
+	}
+	s.communityDB.(*stubCommunityDB).community.InviteOnly = false
+	if err := getInfo("stranger"); err != nil {
+		t.Errorf("stranger reads an open community: %v", err)
+	}
+}
@@ -0,0 +1,219 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package group
+
+import (
+	"context"
+	"time"
+	"unicode/utf8"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/servererrs"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/groupext"
+	"github.com/openimsdk/protocol/constant"
+	pbmsg "github.com/openimsdk/protocol/msg"
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/utils/datautil"
+)
+
+const (
+	maxDirectoryDescriptionLength = 500
+	// directoryActivityGroups is how many of the most active groups get an activity when refreshing the directory,
+	// the others count as inactive.
+	directoryActivityGroups = 10000
+	directoryRefreshBatch   = 500
+)
+
+func convertGroupDirectorySetting(group *model.Group) *groupext.GroupDirectorySetting {
+	return &groupext.GroupDirectorySetting{
+		GroupID:     group.GroupID,
+		Listed:      group.DirectoryListed,
+		Categories:  group.DirectoryCategories,
+		Description: group.DirectoryDescription,
+	}
+}
+
+func (s *groupServer) checkDirectoryCategories(categories []string) error {
+	conf := s.config.RpcConfig.Directory
+	if conf.MaxCategories > 0 && len(categories) > conf.MaxCategories {
+		return errs.ErrArgs.WrapMsg("too many directory categories")
+	}
+	if len(conf.Categories) == 0 {
+		return nil
+	}
+	for _, category := range categories {
+		if !datautil.Contain(category, conf.Categories...) {
+			return errs.ErrArgs.WrapMsg("unknown directory category", "category", category)
+		}
+	}
+	return nil
+}
+
+func (s *groupServer) SetGroupDirectorySetting(ctx context.Context, req *groupext.SetGroupDirectorySettingReq) (*groupext.SetGroupDirectorySettingResp, error) {
+	setting := req.Setting
+	if utf8.RuneCountInString(setting.Description) > maxDirectoryDescriptionLength {
+		return nil, errs.ErrArgs.WrapMsg("description too long")
+	}
+	if err := s.checkDirectoryCategories(setting.Categories); err != nil {
+		return nil, err
+	}
+	if _, err := s.checkGroupPermission(ctx, setting.GroupID, authverify.GroupPermissionEditInfo); err != nil {
+		return nil, err
+	}
+	group, err := s.db.TakeGroup(ctx, setting.GroupID)
+	if err != nil {
+		return nil, err
+	}
+	if group.Status == constant.GroupStatusDismissed {
+		return nil, servererrs.ErrDismissedAlready.Wrap()
+	}
+	update := map[string]any{
+		"directory_listed":      setting.Listed,
+		"directory_categories":  setting.Categories,
+		"directory_description": setting.Description,
+	}
+	if setting.Listed && !group.DirectoryListed {
+		// Sort a newly listed group by its size until the next refresh.
+		num, err := s.db.FindGroupMemberNum(ctx, setting.GroupID)
+		if err != nil {
+			return nil, err
+		}
+		update["member_count"] = int64(num)
+	}
+	if err := s.db.UpdateGroup(ctx, setting.GroupID, update); err != nil {
+		return nil, err
+	}
+	return &groupext.SetGroupDirectorySettingResp{}, nil
+}
+
+func (s *groupServer) GetGroupDirectorySetting(ctx context.Context, req *groupext.GetGroupDirectorySettingReq) (*groupext.GetGroupDirectorySettingResp, error) {
+	group, err := s.db.TakeGroup(ctx, req.GroupID)
+	if err != nil {
+		return nil, err
+	}
+	// the setting of a listed group is public anyway, the others are only shown to the members
+	if !group.DirectoryListed {
+		if err := s.checkGroupMemberOrManager(ctx, req.GroupID); err != nil {
+			return nil, err
+		}
+	}
+	return &groupext.GetGroupDirectorySettingResp{Setting: convertGroupDirectorySetting(group)}, nil
+}
+
+func (s *groupServer) checkGroupMemberOrManager(ctx context.Context, groupID string) error {
+	if authverify.IsAppManagerUid(ctx, s.config.Share.IMAdminUserID) {
+		return nil
+	}
+	if _, err := s.takeOpGroupMember(ctx, groupID); err != nil {
+		if s.IsNotFound(err) {
+			return errs.ErrNoPermission.WrapMsg("not in group")
+		}
+		return err
+	}
+	return nil
+}
+
+func (s *groupServer) GetGroupDirectoryCategories(ctx context.Context, req *groupext.GetGroupDirectoryCategoriesReq) (*groupext.GetGroupDirectoryCategoriesResp, error) {
+	return &groupext.GetGroupDirectoryCategoriesResp{Categories: s.config.RpcConfig.Directory.Categories}, nil
+}
+
+func (s *groupServer) SearchGroupDirectory(ctx context.Context, req *groupext.SearchGroupDirectoryReq) (*groupext.SearchGroupDirectoryResp, error) {
+	var sort string
+	switch req.SortBy {
+	case groupext.DirectorySortBySize:
+		sort = "member_count"
+	case groupext.DirectorySortByNewest:
+		sort = "create_time"
+	default:
+		sort = "activity"
+	}
+	total, groups, err := s.db.SearchGroupDirectory(ctx, req.Keyword, req.Category, sort, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	resp := &groupext.SearchGroupDirectoryResp{Total: total, Groups: make([]*groupext.DirectoryGroup, 0, len(groups))}
+	for _, group := range groups {
+		// the member count saved by the last refresh, recounting every page would cost a query per group
+		resp.Groups = append(resp.Groups, &groupext.DirectoryGroup{
+			GroupID:     group.GroupID,
+			GroupName:   group.GroupName,
+			FaceURL:     group.FaceURL,
+			Description: group.DirectoryDescription,
+			Categories:  group.DirectoryCategories,
+			MemberCount: uint32(group.MemberCount),
+			JoinPolicy:  group.NeedVerification,
+			Activity:    group.Activity,
+			CreateTime:  group.CreateTime.UnixMilli(),
+		})
+	}
+	return resp, nil
+}
+
+// RefreshGroupDirectory saves the member count of every listed group and the number of messages sent to it within
+// the configured activity window, which the directory is sorted by.
+func (s *groupServer) RefreshGroupDirectory(ctx context.Context, req *groupext.RefreshGroupDirectoryReq) (*groupext.RefreshGroupDirectoryResp, error) {
+	if err := authverify.CheckAdmin(ctx, s.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	days := s.config.RpcConfig.Directory.ActivityDays
+	if days <= 0 {
+		days = 7
+	}
+	now := time.Now()
+	active, err := s.msgRpcClient.Client.GetActiveGroup(ctx, &pbmsg.GetActiveGroupReq{
+		Start:      now.AddDate(0, 0, -days).UnixMilli(),
+		End:        now.UnixMilli(),
+		Pagination: &sdkws.RequestPagination{PageNumber: 1, ShowNumber: directoryActivityGroups},
+	})
+	if err != nil {
+		return nil, err
+	}
+	activity := make(map[string]int64, len(active.Groups))
+	for _, group := range active.Groups {
+		if group.Group != nil {
+			activity[group.Group.GroupID] = group.Count
+		}
+	}
+	var (
+		count        int32
+		afterGroupID string
+	)
+	for {
+		groupIDs, err := s.db.FindGroupDirectoryIDs(ctx, afterGroupID, directoryRefreshBatch)
+		if err != nil {
+			return nil, err
+		}
+		for _, groupID := range groupIDs {
+			num, err := s.db.FindGroupMemberNum(ctx, groupID)
+			if err != nil {
+				log.ZError(ctx, "refresh group directory member num failed", err, "groupID", groupID)
+				continue
+			}
+			if err := s.db.UpdateGroupDirectoryStats(ctx, groupID, int64(num), activity[groupID]); err != nil {
+				log.ZError(ctx, "refresh group directory stats failed", err, "groupID", groupID)
+				continue
+			}
+			count++
+		}
+		if len(groupIDs) < directoryRefreshBatch {
+			break
+		}
+		afterGroupID = groupIDs[len(groupIDs)-1]
+	}
+	return &groupext.RefreshGroupDirectoryResp{Count: count}, nil
+}
@@ -0,0 +1,84 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package group
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/groupext"
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/tools/db/pagination"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/mcontext"
+)
+
+// stubDirectoryGroupDB leaves FindGroupMemberNum unimplemented, calling it panics.
+type stubDirectoryGroupDB struct {
+	stubInviteGroupDB
+	sort string
+}
+
+func (d *stubDirectoryGroupDB) SearchGroupDirectory(_ context.Context, _ string, _ string, sort string, _ pagination.Pagination) (int64, []*model.Group, error) {
+	d.sort = sort
+	return 1, []*model.Group{d.group}, nil
+}
+
+func TestSearchGroupDirectory(t *testing.T) {
+	groupDB := &stubDirectoryGroupDB{stubInviteGroupDB: stubInviteGroupDB{
+		group: &model.Group{GroupID: "g1", GroupName: "gophers", DirectoryListed: true, MemberCount: 42, Activity: 7, CreateTime: time.UnixMilli(1000)},
+	}}
+	s := newInviteLinkTestServer(&groupDB.stubInviteGroupDB, &stubInviteLinkDB{})
+	s.db = groupDB
+	ctx := mcontext.SetOpUserID(context.Background(), "bob")
+
+	resp, err := s.SearchGroupDirectory(ctx, &groupext.SearchGroupDirectoryReq{SortBy: groupext.DirectorySortBySize})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if groupDB.sort != "member_count" {
+		t.Errorf("sort %s", groupDB.sort)
+	}
+	if len(resp.Groups) != 1 || resp.Groups[0].MemberCount != 42 || resp.Groups[0].Activity != 7 || resp.Groups[0].CreateTime != 1000 {
+		t.Errorf("groups %v", resp.Groups)
+	}
+}
+
+func TestGetGroupDirectorySetting(t *testing.T) {
+	groupDB := &stubInviteGroupDB{
+		group:   &model.Group{GroupID: "g1", DirectoryListed: true, DirectoryDescription: "gophers"},
+		members: map[string]*model.GroupMember{"alice": {GroupID: "g1", UserID: "alice", RoleLevel: constant.GroupOrdinaryUsers}},
+	}
+	s := newInviteLinkTestServer(groupDB, &stubInviteLinkDB{})
+	get := func(userID string) (*groupext.GetGroupDirectorySettingResp, error) {
+		return s.GetGroupDirectorySetting(mcontext.SetOpUserID(context.Background(), userID), &groupext.GetGroupDirectorySettingReq{GroupID: "g1"})
+	}
+
+	if resp, err := get("bob"); err != nil || resp.Setting.Description != "gophers" {
+		t.Fatalf("listed group: %v %v", resp, err)
+	}
+	groupDB.group.DirectoryListed = false
+	if _, err := get("bob"); !errs.ErrNoPermission.Is(err) {
+		t.Errorf("unlisted group read by a stranger: %v", err)
+	}
+	if _, err := get("alice"); err != nil {
+		t.Errorf("unlisted group read by a member: %v", err)
+	}
+	if _, err := get("admin"); err != nil {
+		t.Errorf("unlisted group read by an app manager: %v", err)
+	}
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/webhook"
 	"github.com/openimsdk/open-im-server/v3/pkg/localcache"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/groupext"
 	"math/big"
 	"math/rand"
 	"strconv"
@@ -33,6 +34,7 @@ import (
 	"github.com/openimsdk/open-im-server/v3/pkg/callbackstruct"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/convert"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/servererrs"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache/redis"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/controller"
 	"github.com/openimsdk/open-im-server/v3/pkg/msgprocessor"
 	"github.com/openimsdk/open-im-server/v3/pkg/rpcclient"
@@ -57,6 +59,9 @@ import (
 
 type groupServer struct {
 	db                    controller.GroupDatabase
+	inviteLinkDB          controller.GroupInviteLinkDatabase
+	joinDB                controller.GroupJoinDatabase
+	communityDB           controller.CommunityDatabase
 	user                  rpcclient.UserRpcClient
 	notification          *GroupNotificationSender
 	conversationRpcClient rpcclient.ConversationRpcClient
@@ -97,12 +102,39 @@ func Start(ctx context.Context, config *Config, client discovery.SvcDiscoveryReg
 	if err != nil {
 		return err
 	}
+	groupRoleDB, err := mgo.NewGroupRoleMgo(mgocli.GetDB())
+	if err != nil {
+		return err
+	}
+	groupInviteLinkDB, err := mgo.NewGroupInviteLinkMgo(mgocli.GetDB())
+	if err != nil {
+		return err
+	}
+	groupJoinQuestionnaireDB, err := mgo.NewGroupJoinQuestionnaireMgo(mgocli.GetDB())
+	if err != nil {
+		return err
+	}
+	groupRequestAuditDB, err := mgo.NewGroupRequestAuditMgo(mgocli.GetDB())
+	if err != nil {
+		return err
+	}
+	communityDB, err := mgo.NewCommunityMgo(mgocli.GetDB())
+	if err != nil {
+		return err
+	}
+	communityMemberDB, err := mgo.NewCommunityMemberMgo(mgocli.GetDB())
+	if err != nil {
+		return err
+	}
 	userRpcClient := rpcclient.NewUserRpcClient(client, config.Share.RpcRegisterName.User, config.Share.IMAdminUserID)
 	msgRpcClient := rpcclient.NewMessageRpcClient(client, config.Share.RpcRegisterName.Msg)
 	conversationRpcClient := rpcclient.NewConversationRpcClient(client, config.Share.RpcRegisterName.Conversation)
 	var gs groupServer
-	database := controller.NewGroupDatabase(rdb, &config.LocalCacheConfig, groupDB, groupMemberDB, groupRequestDB, mgocli.GetTx(), grouphash.NewGroupHashFromGroupServer(&gs))
+	database := controller.NewGroupDatabase(rdb, &config.LocalCacheConfig, groupDB, groupMemberDB, groupRequestDB, groupRoleDB, mgocli.GetTx(), grouphash.NewGroupHashFromGroupServer(&gs))
 	gs.db = database
+	gs.inviteLinkDB = controller.NewGroupInviteLinkDatabase(groupInviteLinkDB)
+	gs.joinDB = controller.NewGroupJoinDatabase(groupJoinQuestionnaireDB, groupRequestAuditDB)
+	gs.communityDB = controller.NewCommunityDatabase(communityDB, communityMemberDB, redis.NewCommunityCacheRedis(rdb, &config.LocalCacheConfig, communityMemberDB, redis.GetRocksCacheOptions()))
 	gs.user = userRpcClient
 	gs.notification = NewGroupNotificationSender(database, &msgRpcClient, &userRpcClient, config, func(ctx context.Context, userIDs []string) ([]notification.CommonUser, error) {
 		users, err := userRpcClient.GetUsersInfo(ctx, userIDs)
@@ -117,6 +149,7 @@ func Start(ctx context.Context, config *Config, client discovery.SvcDiscoveryReg
 	gs.config = config
 	gs.webhookClient = webhook.NewWebhookClient(config.WebhooksConfig.URL)
 	pbgroup.RegisterGroupServer(server, &gs)
+	groupext.RegisterGroupExtServer(server, &gs)
 	return nil
 }
 
@@ -142,19 +175,6 @@ func (s *groupServer) NotificationUserInfoUpdate(ctx context.Context, req *pbgro
 	return &pbgroup.NotificationUserInfoUpdateResp{}, nil
 }
 
-func (s *groupServer) CheckGroupAdmin(ctx context.Context, groupID string) error {
-	if !authverify.IsAppManagerUid(ctx, s.config.Share.IMAdminUserID) {
-		groupMember, err := s.db.TakeGroupMember(ctx, groupID, mcontext.GetOpUserID(ctx))
-		if err != nil {
-			return err
-		}
-		if !(groupMember.RoleLevel == constant.GroupOwner || groupMember.RoleLevel == constant.GroupAdmin) {
-			return errs.ErrNoPermission.WrapMsg("no group owner or admin")
-		}
-	}
-	return nil
-}
-
 func (s *groupServer) GetPublicUserInfoMap(ctx context.Context, userIDs []string, complete bool) (map[string]*sdkws.PublicUserInfo, error) {
 	if len(userIDs) == 0 {
 		return map[string]*sdkws.PublicUserInfo{}, nil
@@ -388,6 +408,9 @@ func (s *groupServer) InviteUserToGroup(ctx context.Context, req *pbgroup.Invite
 	if group.Status == constant.GroupStatusDismissed {
 		return nil, servererrs.ErrDismissedAlready.WrapMsg("group dismissed checking group status found it dismissed")
 	}
+	if err := s.checkCommunityMembers(ctx, group, req.InvitedUserIDs); err != nil {
+		return nil, err
+	}
 
 	userMap, err := s.user.GetUsersInfoMap(ctx, req.InvitedUserIDs)
 	if err != nil {
@@ -398,12 +421,13 @@ func (s *groupServer) InviteUserToGroup(ctx context.Context, req *pbgroup.Invite
 		return nil, errs.ErrRecordNotFound.WrapMsg("user not found")
 	}
 
+	isManager := authverify.IsAppManagerUid(ctx, s.config.Share.IMAdminUserID)
 	var groupMember *model.GroupMember
 	var opUserID string
-	if !authverify.IsAppManagerUid(ctx, s.config.Share.IMAdminUserID) {
+	if !isManager {
 		opUserID = mcontext.GetOpUserID(ctx)
 		var err error
-		groupMember, err = s.db.TakeGroupMember(ctx, req.GroupID, opUserID)
+		groupMember, err = s.takeOpGroupMember(ctx, req.GroupID)
 		if err != nil {
 			return nil, err
 		}
@@ -417,8 +441,12 @@ func (s *groupServer) InviteUserToGroup(ctx context.Context, req *pbgroup.Invite
 	}
 
 	if group.NeedVerification == constant.AllNeedVerification {
-		if !authverify.IsAppManagerUid(ctx, s.config.Share.IMAdminUserID) {
-			if !(groupMember.RoleLevel == constant.GroupOwner || groupMember.RoleLevel == constant.GroupAdmin) {
+		if !isManager {
+			canInvite, err := s.hasGroupPermission(ctx, groupMember, authverify.GroupPermissionInvite)
+			if err != nil {
+				return nil, err
+			}
+			if !canInvite {
 				var requests []*model.GroupRequest
 				for _, userID := range req.InvitedUserIDs {
 					requests = append(requests, &model.GroupRequest{
@@ -561,42 +589,57 @@ func (s *groupServer) KickGroupMember(ctx context.Context, req *pbgroup.KickGrou
 	}
 	isAppManagerUid := authverify.IsAppManagerUid(ctx, s.config.Share.IMAdminUserID)
 	opMember := memberMap[opUserID]
+	if !isAppManagerUid {
+		opMember, err = s.asCommunityAdmin(ctx, req.GroupID, opMember)
+		if err != nil {
+			return nil, err
+		}
+		if opMember == nil {
+			return nil, errs.ErrNoPermission.WrapMsg("opUserID no in group")
+		}
+		canKick, err := s.hasGroupPermission(ctx, opMember, authverify.GroupPermissionKick)
+		if err != nil {
+			return nil, err
+		}
+		if !canKick {
+			return nil, errs.ErrNoPermission.WrapMsg("opUserID no permission")
+		}
+	}
 	for _, userID := range req.KickedUserIDs {
 		member, ok := memberMap[userID]
 		if !ok {
 			return nil, servererrs.ErrUserIDNotFound.WrapMsg(userID)
 		}
-		if !isAppManagerUid {
-			if opMember == nil {
-				return nil, errs.ErrNoPermission.WrapMsg("opUserID no in group")
-			}
-			switch opMember.RoleLevel {
-			case constant.GroupOwner:
-			case constant.GroupAdmin:
-				if member.RoleLevel == constant.GroupOwner || member.RoleLevel == constant.GroupAdmin {
-					return nil, errs.ErrNoPermission.WrapMsg("group admins cannot remove the group owner and other admins")
-				}
-			case constant.GroupOrdinaryUsers:
-				return nil, errs.ErrNoPermission.WrapMsg("opUserID no permission")
-			default:
-				return nil, errs.ErrNoPermission.WrapMsg("opUserID roleLevel unknown")
-			}
+		if !isAppManagerUid && !authverify.CanOperateGroupMember(opMember.RoleLevel, member.RoleLevel) {
+			return nil, errs.ErrNoPermission.WrapMsg("cannot remove members of the same or a higher role level")
 		}
 	}
+	if err := s.kickGroupMembers(ctx, group, req, memberMap); err != nil {
+		return nil, err
+	}
+	return &pbgroup.KickGroupMemberResp{}, nil
+}
+
+// kickGroupMembers removes the members once the operator is allowed to, memberMap holds the kicked members and the
+// operator when it is in the group.
+func (s *groupServer) kickGroupMembers(ctx context.Context, group *model.Group, req *pbgroup.KickGroupMemberReq, memberMap map[string]*model.GroupMember) error {
 	num, err := s.db.FindGroupMemberNum(ctx, req.GroupID)
 	if err != nil {
-		return nil, err
+		return err
 	}
 	ownerUserIDs, err := s.db.GetGroupRoleLevelMemberIDs(ctx, req.GroupID, constant.GroupOwner)
 	if err != nil {
-		return nil, err
+		return err
 	}
 	var ownerUserID string
 	if len(ownerUserIDs) > 0 {
 		ownerUserID = ownerUserIDs[0]
 	}
+	if err := s.inviteLinkDB.RevokeUserGroupInviteLinks(ctx, group.GroupID, req.KickedUserIDs); err != nil {
+		return err
+	}
 	if err := s.db.DeleteGroupMember(ctx, group.GroupID, req.KickedUserIDs); err != nil {
-		return nil, err
+		return err
 	}
 	tips := &sdkws.MemberKickedTips{
 		Group: &sdkws.GroupInfo{
@@ -620,7 +663,7 @@ func (s *groupServer) KickGroupMember(ctx context.Context, req *pbgroup.KickGrou
 		},
 		KickedUserList: []*sdkws.GroupMemberFullInfo{},
 	}
-	if opMember, ok := memberMap[opUserID]; ok {
+	if opMember, ok := memberMap[mcontext.GetOpUserID(ctx)]; ok {
 		tips.OpUser = convert.Db2PbGroupMember(opMember)
 	}
 	for _, userID := range req.KickedUserIDs {
@@ -628,11 +671,10 @@ func (s *groupServer) KickGroupMember(ctx context.Context, req *pbgroup.KickGrou
 	}
 	s.notification.MemberKickedNotification(ctx, tips)
 	if err := s.deleteMemberAndSetConversationSeq(ctx, req.GroupID, req.KickedUserIDs); err != nil {
-		return nil, err
+		return err
 	}
 	s.webhookAfterKickGroupMember(ctx, &s.config.WebhooksConfig.AfterKickGroupMember, req)
-
-	return &pbgroup.KickGroupMemberResp{}, nil
+	return nil
 }
 
 func (s *groupServer) GetGroupMembersInfo(ctx context.Context, req *pbgroup.GetGroupMembersInfoReq) (*pbgroup.GetGroupMembersInfoResp, error) {
@@ -755,14 +797,8 @@ func (s *groupServer) GroupApplicationResponse(ctx context.Context, req *pbgroup
 	if !datautil.Contain(req.HandleResult, constant.GroupResponseAgree, constant.GroupResponseRefuse) {
 		return nil, errs.ErrArgs.WrapMsg("HandleResult unknown")
 	}
-	if !authverify.IsAppManagerUid(ctx, s.config.Share.IMAdminUserID) {
-		groupMember, err := s.db.TakeGroupMember(ctx, req.GroupID, mcontext.GetOpUserID(ctx))
-		if err != nil {
-			return nil, err
-		}
-		if !(groupMember.RoleLevel == constant.GroupOwner || groupMember.RoleLevel == constant.GroupAdmin) {
-			return nil, errs.ErrNoPermission.WrapMsg("no group owner or admin")
-		}
+	if _, err := s.checkGroupPermission(ctx, req.GroupID, authverify.GroupPermissionApproveJoin); err != nil {
+		return nil, err
 	}
 	group, err := s.db.TakeGroup(ctx, req.GroupID)
 	if err != nil {
@@ -775,14 +811,22 @@ func (s *groupServer) GroupApplicationResponse(ctx context.Context, req *pbgroup
 	if groupRequest.HandleResult != 0 {
 		return nil, servererrs.ErrGroupRequestHandled.WrapMsg("group request already processed")
 	}
+	if err := s.handleGroupRequest(ctx, group, groupRequest, req, groupext.RequestHandledByAdmin); err != nil {
+		return nil, err
+	}
+	return &pbgroup.GroupApplicationResponseResp{}, nil
+}
+
+// handleGroupRequest applies the result of a pending join request, records how it was handled and notifies the applicant and the admins.
+func (s *groupServer) handleGroupRequest(ctx context.Context, group *model.Group, groupRequest *model.GroupRequest, req *pbgroup.GroupApplicationResponseReq, handleType int32) error {
 	var inGroup bool
 	if _, err := s.db.TakeGroupMember(ctx, req.GroupID, req.FromUserID); err == nil {
 		inGroup = true // Already in group
 	} else if !s.IsNotFound(err) {
-		return nil, err
+		return err
 	}
 	if _, err := s.user.GetPublicUserInfo(ctx, req.FromUserID); err != nil {
-		return nil, err
+		return err
 	}
 	var member *model.GroupMember
 	if (!inGroup) && req.HandleResult == constant.GroupResponseAgree {
@@ -800,17 +844,31 @@ func (s *groupServer) GroupApplicationResponse(ctx context.Context, req *pbgroup
 			Ex:             groupRequest.Ex,
 		}
 		if err := s.webhookBeforeMemberJoinGroup(ctx, &s.config.WebhooksConfig.BeforeMemberJoinGroup, member, group.Ex); err != nil && err != servererrs.ErrCallbackContinue {
-			return nil, err
+			return err
 		}
 	}
 	log.ZDebug(ctx, "GroupApplicationResponse", "inGroup", inGroup, "HandleResult", req.HandleResult, "member", member)
 	if err := s.db.HandlerGroupRequest(ctx, req.GroupID, req.FromUserID, req.HandledMsg, req.HandleResult, member); err != nil {
-		return nil, err
+		return err
+	}
+	audit := &model.GroupRequestAudit{
+		GroupID:      req.GroupID,
+		UserID:       req.FromUserID,
+		HandleResult: req.HandleResult,
+		HandledMsg:   req.HandledMsg,
+		HandleType:   handleType,
+		HandledTime:  time.Now(),
+	}
+	if handleType == groupext.RequestHandledByAdmin {
+		audit.HandleUserID = mcontext.GetOpUserID(ctx)
+	}
+	if err := s.joinDB.CreateGroupRequestAudit(ctx, audit); err != nil {
+		log.ZError(ctx, "CreateGroupRequestAudit failed", err, "groupID", req.GroupID, "userID", req.FromUserID)
 	}
 	switch req.HandleResult {
 	case constant.GroupResponseAgree:
 		if err := s.conversationRpcClient.GroupChatFirstCreateConversation(ctx, req.GroupID, []string{req.FromUserID}); err != nil {
-			return nil, err
+			return err
 		}
 		s.notification.GroupApplicationAcceptedNotification(ctx, req)
 		if member == nil {
@@ -821,21 +879,34 @@ func (s *groupServer) GroupApplicationResponse(ctx context.Context, req *pbgroup
 	case constant.GroupResponseRefuse:
 		s.notification.GroupApplicationRejectedNotification(ctx, req)
 	}
-
-	return &pbgroup.GroupApplicationResponseResp{}, nil
+	return nil
 }
 
 func (s *groupServer) JoinGroup(ctx context.Context, req *pbgroup.JoinGroupReq) (*pbgroup.JoinGroupResp, error) {
+	if _, err := s.joinGroup(ctx, req, nil, nil); err != nil {
+		return nil, err
+	}
+	return &pbgroup.JoinGroupResp{}, nil
+}
+
+// joinGroup adds req.InviterUserID to the group or, when the group requires approval, creates a join request carrying
+// the answers to the join questionnaire. It reports whether the user joined, which includes auto approved requests.
+// A join with an invite link is an invitation from the link creator, it consumes a use of the link that is given
+// back when the join fails.
+func (s *groupServer) joinGroup(ctx context.Context, req *pbgroup.JoinGroupReq, answers []model.GroupJoinAnswer, link *model.GroupInviteLink) (joined bool, err error) {
 	user, err := s.user.GetUserInfo(ctx, req.InviterUserID)
 	if err != nil {
-		return nil, err
+		return false, err
 	}
 	group, err := s.db.TakeGroup(ctx, req.GroupID)
 	if err != nil {
-		return nil, err
+		return false, err
 	}
 	if group.Status == constant.GroupStatusDismissed {
-		return nil, servererrs.ErrDismissedAlready.Wrap()
+		return false, servererrs.ErrDismissedAlready.Wrap()
+	}
+	if err := s.checkCommunityMembers(ctx, group, []string{req.InviterUserID}); err != nil {
+		return false, err
 	}
 
 	reqCall := &callbackstruct.CallbackJoinGroupReq{
@@ -847,42 +918,66 @@ func (s *groupServer) JoinGroup(ctx context.Context, req *pbgroup.JoinGroupReq)
 	}
 
 	if err := s.webhookBeforeApplyJoinGroup(ctx, &s.config.WebhooksConfig.BeforeApplyJoinGroup, reqCall); err != nil && err != servererrs.ErrCallbackContinue {
-		return nil, err
+		return false, err
 	}
 
 	_, err = s.db.TakeGroupMember(ctx, req.GroupID, req.InviterUserID)
 	if err == nil {
-		return nil, errs.ErrArgs.Wrap()
+		return false, errs.ErrArgs.Wrap()
 	} else if !s.IsNotFound(err) && errs.Unwrap(err) != errs.ErrRecordNotFound {
-		return nil, err
+		return false, err
+	}
+	inviterUserID := req.InviterUserID
+	if link != nil {
+		if err := s.useGroupInviteLink(ctx, link); err != nil {
+			return false, err
+		}
+		defer func() {
+			if err != nil {
+				s.releaseGroupInviteLink(ctx, link)
+			}
+		}()
+		inviterUserID = link.CreatorUserID
 	}
 	log.ZDebug(ctx, "JoinGroup.groupInfo", "group", group, "eq", group.NeedVerification == constant.Directly)
-	if group.NeedVerification == constant.Directly {
+	if group.NeedVerification == constant.Directly || (link != nil && inviteLinkJoinsDirectly(group, link)) {
 		groupMember := &model.GroupMember{
 			GroupID:        group.GroupID,
 			UserID:         user.UserID,
 			RoleLevel:      constant.GroupOrdinaryUsers,
 			OperatorUserID: mcontext.GetOpUserID(ctx),
-			InviterUserID:  req.InviterUserID,
+			InviterUserID:  inviterUserID,
 			JoinTime:       time.Now(),
 			MuteEndTime:    time.UnixMilli(0),
 		}
+		if link != nil {
+			groupMember.JoinSource = req.JoinSource
+		}
 
 		if err := s.webhookBeforeMemberJoinGroup(ctx, &s.config.WebhooksConfig.BeforeMemberJoinGroup, groupMember, group.Ex); err != nil && err != servererrs.ErrCallbackContinue {
-			return nil, err
+			return false, err
 		}
 
 		if err := s.db.CreateGroup(ctx, nil, []*model.GroupMember{groupMember}); err != nil {
-			return nil, err
+			return false, err
 		}
 
 		if err := s.conversationRpcClient.GroupChatFirstCreateConversation(ctx, req.GroupID, []string{req.InviterUserID}); err != nil {
-			return nil, err
+			return false, err
 		}
 		s.notification.MemberEnterNotification(ctx, req.GroupID, req.InviterUserID)
 		s.webhookAfterJoinGroup(ctx, &s.config.WebhooksConfig.AfterJoinGroup, req)
 
-		return &pbgroup.JoinGroupResp{}, nil
+		return true, nil
+	}
+	questionnaire, err := s.takeGroupJoinQuestionnaire(ctx, req.GroupID)
+	if err != nil {
+		return false, err
+	}
+	if questionnaire == nil {
+		answers = nil
+	} else if err := checkJoinAnswers(questionnaire.Questions, answers); err != nil {
+		return false, err
 	}
 	groupRequest := model.GroupRequest{
 		UserID:      req.InviterUserID,
@@ -892,12 +987,27 @@ func (s *groupServer) JoinGroup(ctx context.Context, req *pbgroup.JoinGroupReq)
 		ReqTime:     time.Now(),
 		HandledTime: time.Unix(0, 0),
 		Ex:          req.Ex,
+		Answers:     answers,
+	}
+	if link != nil {
+		groupRequest.InviterUserID = link.CreatorUserID
 	}
 	if err = s.db.CreateGroupRequest(ctx, []*model.GroupRequest{&groupRequest}); err != nil {
-		return nil, err
+		return false, err
+	}
+	if questionnaire != nil && questionnaire.AutoApprove && joinAnswersAccepted(questionnaire.Questions, answers) {
+		handleReq := &pbgroup.GroupApplicationResponseReq{
+			GroupID:      req.GroupID,
+			FromUserID:   req.InviterUserID,
+			HandleResult: constant.GroupResponseAgree,
+		}
+		if err := s.handleGroupRequest(ctx, group, &groupRequest, handleReq, groupext.RequestAutoApproved); err != nil {
+			return false, err
+		}
+		return true, nil
 	}
 	s.notification.JoinGroupApplicationNotification(ctx, req)
-	return &pbgroup.JoinGroupResp{}, nil
+	return false, nil
 }
 
 func (s *groupServer) QuitGroup(ctx context.Context, req *pbgroup.QuitGroupReq) (*pbgroup.QuitGroupResp, error) {
@@ -918,6 +1028,9 @@ func (s *groupServer) QuitGroup(ctx context.Context, req *pbgroup.QuitGroupReq)
 	if err := s.PopulateGroupMember(ctx, member); err != nil {
 		return nil, err
 	}
+	if err := s.inviteLinkDB.RevokeUserGroupInviteLinks(ctx, req.GroupID, []string{req.UserID}); err != nil {
+		return nil, err
+	}
 	err = s.db.DeleteGroupMember(ctx, req.GroupID, []string{req.UserID})
 	if err != nil {
 		return nil, err
@@ -941,16 +1054,11 @@ func (s *groupServer) deleteMemberAndSetConversationSeq(ctx context.Context, gro
 }
 
 func (s *groupServer) SetGroupInfo(ctx context.Context, req *pbgroup.SetGroupInfoReq) (*pbgroup.SetGroupInfoResp, error) {
-	var opMember *model.GroupMember
-	if !authverify.IsAppManagerUid(ctx, s.config.Share.IMAdminUserID) {
-		var err error
-		opMember, err = s.db.TakeGroupMember(ctx, req.GroupInfoForSet.GroupID, mcontext.GetOpUserID(ctx))
-		if err != nil {
-			return nil, err
-		}
-		if !(opMember.RoleLevel == constant.GroupOwner || opMember.RoleLevel == constant.GroupAdmin) {
-			return nil, errs.ErrNoPermission.WrapMsg("no group owner or admin")
-		}
+	opMember, err := s.checkGroupPermission(ctx, req.GroupInfoForSet.GroupID, authverify.GroupPermissionEditInfo)
+	if err != nil {
+		return nil, err
+	}
+	if opMember != nil {
 		if err := s.PopulateGroupMember(ctx, opMember); err != nil {
 			return nil, err
 		}
@@ -1067,6 +1175,12 @@ func (s *groupServer) TransferGroupOwner(ctx context.Context, req *pbgroup.Trans
 			return nil, errs.ErrNoPermission.WrapMsg("no permission transfer group owner")
 		}
 	}
+	// the old owner takes the role of the new owner
+	if newOwner.RoleLevel < constant.GroupAdmin {
+		if err := s.inviteLinkDB.RevokeUserGroupInviteLinks(ctx, req.GroupID, []string{req.OldOwnerUserID}); err != nil {
+			return nil, err
+		}
+	}
 	if err := s.db.TransferGroupOwner(ctx, req.GroupID, req.OldOwnerUserID, req.NewOwnerUserID, newOwner.RoleLevel); err != nil {
 		return nil, err
 	}
@@ -1251,23 +1365,8 @@ func (s *groupServer) MuteGroupMember(ctx context.Context, req *pbgroup.MuteGrou
 	if err := s.PopulateGroupMember(ctx, member); err != nil {
 		return nil, err
 	}
-	if !authverify.IsAppManagerUid(ctx, s.config.Share.IMAdminUserID) {
-		opMember, err := s.db.TakeGroupMember(ctx, req.GroupID, mcontext.GetOpUserID(ctx))
-		if err != nil {
-			return nil, err
-		}
-		switch member.RoleLevel {
-		case constant.GroupOwner:
-			return nil, errs.ErrNoPermission.WrapMsg("set group owner mute")
-		case constant.GroupAdmin:
-			if opMember.RoleLevel != constant.GroupOwner {
-				return nil, errs.ErrNoPermission.WrapMsg("set group admin mute")
-			}
-		case constant.GroupOrdinaryUsers:
-			if !(opMember.RoleLevel == constant.GroupAdmin || opMember.RoleLevel == constant.GroupOwner) {
-				return nil, errs.ErrNoPermission.WrapMsg("set group ordinary users mute")
-			}
-		}
+	if err := s.checkMuteGroupMember(ctx, member); err != nil {
+		return nil, err
 	}
 	data := UpdateGroupMemberMutedTimeMap(time.Now().Add(time.Second * time.Duration(req.MutedSeconds)))
 	if err := s.db.UpdateGroupMember(ctx, member.GroupID, member.UserID, data); err != nil {
@@ -1285,23 +1384,8 @@ func (s *groupServer) CancelMuteGroupMember(ctx context.Context, req *pbgroup.Ca
 	if err := s.PopulateGroupMember(ctx, member); err != nil {
 		return nil, err
 	}
-	if !authverify.IsAppManagerUid(ctx, s.config.Share.IMAdminUserID) {
-		opMember, err := s.db.TakeGroupMember(ctx, req.GroupID, mcontext.GetOpUserID(ctx))
-		if err != nil {
-			return nil, err
-		}
-		switch member.RoleLevel {
-		case constant.GroupOwner:
-			return nil, errs.ErrNoPermission.WrapMsg("set group owner mute")
-		case constant.GroupAdmin:
-			if opMember.RoleLevel != constant.GroupOwner {
-				return nil, errs.ErrNoPermission.WrapMsg("set group admin mute")
-			}
-		case constant.GroupOrdinaryUsers:
-			if !(opMember.RoleLevel == constant.GroupAdmin || opMember.RoleLevel == constant.GroupOwner) {
-				return nil, errs.ErrNoPermission.WrapMsg("set group ordinary users mute")
-			}
-		}
+	if err := s.checkMuteGroupMember(ctx, member); err != nil {
+		return nil, err
 	}
 	data := UpdateGroupMemberMutedTimeMap(time.Unix(0, 0))
 	if err := s.db.UpdateGroupMember(ctx, member.GroupID, member.UserID, data); err != nil {
@@ -1311,8 +1395,26 @@ func (s *groupServer) CancelMuteGroupMember(ctx context.Context, req *pbgroup.Ca
 	return &pbgroup.CancelMuteGroupMemberResp{}, nil
 }
 
+// checkMuteGroupMember requires the mute permission and a higher role level than the member, the owner cannot be muted.
+func (s *groupServer) checkMuteGroupMember(ctx context.Context, member *model.GroupMember) error {
+	if authverify.IsAppManagerUid(ctx, s.config.Share.IMAdminUserID) {
+		return nil
+	}
+	if member.RoleLevel == constant.GroupOwner {
+		return errs.ErrNoPermission.WrapMsg("set group owner mute")
+	}
+	opMember, err := s.checkGroupPermission(ctx, member.GroupID, authverify.GroupPermissionMute)
+	if err != nil {
+		return err
+	}
+	if !authverify.CanOperateGroupMember(opMember.RoleLevel, member.RoleLevel) {
+		return errs.ErrNoPermission.WrapMsg("set mute of the same or a higher role level")
+	}
+	return nil
+}
+
 func (s *groupServer) MuteGroup(ctx context.Context, req *pbgroup.MuteGroupReq) (*pbgroup.MuteGroupResp, error) {
-	if err := s.CheckGroupAdmin(ctx, req.GroupID); err != nil {
+	if _, err := s.checkGroupPermission(ctx, req.GroupID, authverify.GroupPermissionMute); err != nil {
 		return nil, err
 	}
 	if err := s.db.UpdateGroup(ctx, req.GroupID, UpdateGroupStatusMap(constant.GroupStatusMuted)); err != nil {
@@ -1323,7 +1425,7 @@ func (s *groupServer) MuteGroup(ctx context.Context, req *pbgroup.MuteGroupReq)
 }
 
 func (s *groupServer) CancelMuteGroup(ctx context.Context, req *pbgroup.CancelMuteGroupReq) (*pbgroup.CancelMuteGroupResp, error) {
-	if err := s.CheckGroupAdmin(ctx, req.GroupID); err != nil {
+	if _, err := s.checkGroupPermission(ctx, req.GroupID, authverify.GroupPermissionMute); err != nil {
 		return nil, err
 	}
 	if err := s.db.UpdateGroup(ctx, req.GroupID, UpdateGroupStatusMap(constant.GroupOk)); err != nil {
@@ -1353,11 +1455,14 @@ func (s *groupServer) SetGroupMemberInfo(ctx context.Context, req *pbgroup.SetGr
 				return nil, errs.ErrNoPermission.WrapMsg("cannot set ungroup owner")
 			case constant.GroupAdmin, constant.GroupOrdinaryUsers:
 			default:
-				return nil, errs.ErrArgs.WrapMsg("invalid role level")
+				if !authverify.IsCustomGroupRoleLevel(member.RoleLevel.Value) {
+					return nil, errs.ErrArgs.WrapMsg("invalid role level")
+				}
 			}
 		}
 		groupMembers[member.GroupID] = append(groupMembers[member.GroupID], req.Members[i])
 	}
+	demotedUserIDs := make(map[string][]string)
 	for groupID, members := range groupMembers {
 		temp := make(map[string]struct{})
 		userIDs := make([]string, 0, len(members)+1)
@@ -1371,21 +1476,35 @@ func (s *groupServer) SetGroupMemberInfo(ctx context.Context, req *pbgroup.SetGr
 		if _, ok := temp[opUserID]; !ok {
 			userIDs = append(userIDs, opUserID)
 		}
+		if err := s.checkCustomGroupRoleLevels(ctx, groupID, members); err != nil {
+			return nil, err
+		}
 		dbMembers, err := s.db.FindGroupMembers(ctx, groupID, userIDs)
 		if err != nil {
 			return nil, err
 		}
-		opUserIndex := -1
-		for i, member := range dbMembers {
+		var opMember *model.GroupMember
+		for _, member := range dbMembers {
 			if member.UserID == opUserID {
-				opUserIndex = i
+				opMember = member
 				break
 			}
 		}
-		switch len(userIDs) - len(dbMembers) {
+		missing := len(userIDs) - len(dbMembers)
+		if !isAppManagerUid {
+			inGroup := opMember != nil
+			opMember, err = s.asCommunityAdmin(ctx, groupID, opMember)
+			if err != nil {
+				return nil, err
+			}
+			if !inGroup && opMember != nil {
+				missing--
+			}
+		}
+		switch missing {
 		case 0:
 			if !isAppManagerUid {
-				roleLevel := dbMembers[opUserIndex].RoleLevel
+				roleLevel := opMember.RoleLevel
 				if roleLevel != constant.GroupOwner {
 					switch roleLevel {
 					case constant.GroupAdmin:
@@ -1409,11 +1528,16 @@ func (s *groupServer) SetGroupMemberInfo(ctx context.Context, req *pbgroup.SetGr
 								return nil, errs.ErrNoPermission.WrapMsg("can not change higher role level")
 							}
 						}
+						for _, member := range members {
+							if member.RoleLevel != nil && member.RoleLevel.Value >= roleLevel {
+								return nil, errs.ErrNoPermission.WrapMsg("can not set a role level not lower than your own")
+							}
+						}
 					}
 				}
 			}
 		case 1:
-			if opUserIndex >= 0 {
+			if opMember != nil {
 				return nil, errs.ErrArgs.WrapMsg("user not in group")
 			}
 			if !isAppManagerUid {
@@ -1422,6 +1546,15 @@ func (s *groupServer) SetGroupMemberInfo(ctx context.Context, req *pbgroup.SetGr
 		default:
 			return nil, errs.ErrArgs.WrapMsg("user not in group")
 		}
+		roleLevels := make(map[string]int32, len(dbMembers))
+		for _, member := range dbMembers {
+			roleLevels[member.UserID] = member.RoleLevel
+		}
+		for _, member := range members {
+			if member.RoleLevel != nil && member.RoleLevel.Value < roleLevels[member.UserID] {
+				demotedUserIDs[groupID] = append(demotedUserIDs[groupID], member.UserID)
+			}
+		}
 	}
 
 	for i := 0; i < len(req.Members); i++ {
@@ -1431,6 +1564,12 @@ func (s *groupServer) SetGroupMemberInfo(ctx context.Context, req *pbgroup.SetGr
 		}
 
 	}
+	// demoted members may no longer hold the links they created with their former rights
+	for groupID, userIDs := range demotedUserIDs {
+		if err := s.inviteLinkDB.RevokeUserGroupInviteLinks(ctx, groupID, userIDs); err != nil {
+			return nil, err
+		}
+	}
 	if err := s.db.UpdateGroupMembers(ctx, datautil.Slice(req.Members, func(e *pbgroup.SetGroupMemberInfo) *common.BatchUpdateGroupMember {
 		return &common.BatchUpdateGroupMember{
 			GroupID: e.GroupID,
@@ -1447,6 +1586,9 @@ func (s *groupServer) SetGroupMemberInfo(ctx context.Context, req *pbgroup.SetGr
 				s.notification.GroupMemberSetToAdminNotification(ctx, member.GroupID, member.UserID)
 			case constant.GroupOrdinaryUsers:
 				s.notification.GroupMemberSetToOrdinaryUserNotification(ctx, member.GroupID, member.UserID)
+			default:
+				s.notification.GroupMemberInfoSetNotification(ctx, member.GroupID, member.UserID)
+				continue
 			}
 		}
 		if member.Nickname != nil || member.FaceURL != nil || member.Ex != nil {
@@ -1583,3 +1725,18 @@ func (s *groupServer) GetGroupUsersReqApplicationList(ctx context.Context, req *
 		}),
 	}, nil
 }
+
+func (s *groupServer) GetSharedGroupMembers(ctx context.Context, req *groupext.GetSharedGroupMembersReq) (*groupext.GetSharedGroupMembersResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, s.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	counts, err := s.db.CountSharedGroupMembers(ctx, req.UserID, req.MaxGroupMembers, req.Limit)
+	if err != nil {
+		return nil, err
+	}
+	resp := &groupext.GetSharedGroupMembersResp{Members: make([]*groupext.SharedGroupMember, 0, len(counts))}
+	for userID, count := range counts {
+		resp.Members = append(resp.Members, &groupext.SharedGroupMember{UserID: userID, SharedGroups: count})
+	}
+	return resp, nil
+}
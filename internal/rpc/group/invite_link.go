@@ -0,0 +1,193 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package group
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/servererrs"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/groupext"
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/mcontext"
+	"github.com/openimsdk/tools/utils/datautil"
+)
+
+func genInviteLinkToken() (string, error) {
+	b := make([]byte, 18)
+	if _, err := rand.Read(b); err != nil {
+		return "", errs.WrapMsg(err, "rand.Read")
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func convertGroupInviteLink(link *model.GroupInviteLink) *groupext.GroupInviteLink {
+	var expireTime int64
+	if !link.ExpireTime.IsZero() {
+		expireTime = link.ExpireTime.UnixMilli()
+	}
+	return &groupext.GroupInviteLink{
+		Token:          link.Token,
+		GroupID:        link.GroupID,
+		CreatorUserID:  link.CreatorUserID,
+		ExpireTime:     expireTime,
+		MaxUses:        link.MaxUses,
+		UseCount:       link.UseCount,
+		BypassApproval: link.BypassApproval,
+		Revoked:        link.Revoked,
+		CreateTime:     link.CreateTime.UnixMilli(),
+	}
+}
+
+func (s *groupServer) CreateGroupInviteLink(ctx context.Context, req *groupext.CreateGroupInviteLinkReq) (*groupext.CreateGroupInviteLinkResp, error) {
+	if req.ExpireTime > 0 && req.ExpireTime <= time.Now().UnixMilli() {
+		return nil, errs.ErrArgs.WrapMsg("expireTime is in the past")
+	}
+	group, err := s.db.TakeGroup(ctx, req.GroupID)
+	if err != nil {
+		return nil, err
+	}
+	if group.Status == constant.GroupStatusDismissed {
+		return nil, servererrs.ErrDismissedAlready.Wrap()
+	}
+	opUserID := mcontext.GetOpUserID(ctx)
+	if !authverify.IsAppManagerUid(ctx, s.config.Share.IMAdminUserID) {
+		opMember, err := s.db.TakeGroupMember(ctx, req.GroupID, opUserID)
+		if err != nil {
+			return nil, err
+		}
+		if req.BypassApproval {
+			canInvite, err := s.hasGroupPermission(ctx, opMember, authverify.GroupPermissionInvite)
+			if err != nil {
+				return nil, err
+			}
+			if !canInvite {
+				return nil, errs.ErrNoPermission.WrapMsg("no permission to create a link bypassing approval")
+			}
+		}
+	}
+	token, err := genInviteLinkToken()
+	if err != nil {
+		return nil, err
+	}
+	link := &model.GroupInviteLink{
+		Token:          token,
+		GroupID:        req.GroupID,
+		CreatorUserID:  opUserID,
+		MaxUses:        req.MaxUses,
+		BypassApproval: req.BypassApproval,
+		CreateTime:     time.Now(),
+	}
+	if req.ExpireTime > 0 {
+		link.ExpireTime = time.UnixMilli(req.ExpireTime)
+	}
+	if err := s.inviteLinkDB.CreateGroupInviteLink(ctx, link); err != nil {
+		return nil, err
+	}
+	return &groupext.CreateGroupInviteLinkResp{Link: convertGroupInviteLink(link)}, nil
+}
+
+// canManageInviteLinks reports whether the operator may see and revoke every link of the group.
+func (s *groupServer) canManageInviteLinks(ctx context.Context, groupID string) (bool, error) {
+	if authverify.IsAppManagerUid(ctx, s.config.Share.IMAdminUserID) {
+		return true, nil
+	}
+	opMember, err := s.takeOpGroupMember(ctx, groupID)
+	if err != nil {
+		return false, err
+	}
+	return s.hasGroupPermission(ctx, opMember, authverify.GroupPermissionInvite)
+}
+
+func (s *groupServer) RevokeGroupInviteLink(ctx context.Context, req *groupext.RevokeGroupInviteLinkReq) (*groupext.RevokeGroupInviteLinkResp, error) {
+	link, err := s.inviteLinkDB.TakeGroupInviteLink(ctx, req.Token)
+	if err != nil {
+		return nil, err
+	}
+	if link.CreatorUserID != mcontext.GetOpUserID(ctx) {
+		ok, err := s.canManageInviteLinks(ctx, link.GroupID)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, errs.ErrNoPermission.WrapMsg("no permission to revoke the invite link")
+		}
+	}
+	if err := s.inviteLinkDB.RevokeGroupInviteLink(ctx, req.Token); err != nil {
+		return nil, err
+	}
+	return &groupext.RevokeGroupInviteLinkResp{}, nil
+}
+
+func (s *groupServer) GetGroupInviteLinks(ctx context.Context, req *groupext.GetGroupInviteLinksReq) (*groupext.GetGroupInviteLinksResp, error) {
+	ok, err := s.canManageInviteLinks(ctx, req.GroupID)
+	if err != nil {
+		return nil, err
+	}
+	var creatorUserID string
+	if !ok {
+		creatorUserID = mcontext.GetOpUserID(ctx)
+	}
+	links, err := s.inviteLinkDB.FindGroupInviteLinks(ctx, req.GroupID, creatorUserID)
+	if err != nil {
+		return nil, err
+	}
+	return &groupext.GetGroupInviteLinksResp{Links: datautil.Slice(links, convertGroupInviteLink)}, nil
+}
+
+// takeUsableGroupInviteLink returns the link of token when it can still be joined with.
+func (s *groupServer) takeUsableGroupInviteLink(ctx context.Context, token string) (*model.GroupInviteLink, error) {
+	link, err := s.inviteLinkDB.TakeGroupInviteLink(ctx, token)
+	if err != nil {
+		if s.IsNotFound(err) {
+			return nil, errs.ErrArgs.WrapMsg("invite link not found")
+		}
+		return nil, err
+	}
+	if !link.Usable(time.Now()) {
+		return nil, errs.ErrArgs.WrapMsg("invite link is revoked, expired or used up")
+	}
+	return link, nil
+}
+
+// inviteLinkJoinsDirectly reports whether a link invitation joins without approval, which is held back only by
+// groups where every join needs approval and links that do not bypass it.
+func inviteLinkJoinsDirectly(group *model.Group, link *model.GroupInviteLink) bool {
+	return group.NeedVerification != constant.AllNeedVerification || link.BypassApproval
+}
+
+// useGroupInviteLink reserves a use of the link, atomically so concurrent joins cannot exceed its limit.
+func (s *groupServer) useGroupInviteLink(ctx context.Context, link *model.GroupInviteLink) error {
+	if err := s.inviteLinkDB.UseGroupInviteLink(ctx, link.Token); err != nil {
+		if s.IsNotFound(err) {
+			return errs.ErrArgs.WrapMsg("invite link is revoked, expired or used up")
+		}
+		return err
+	}
+	return nil
+}
+
+// releaseGroupInviteLink gives back the use reserved for a join that failed.
+func (s *groupServer) releaseGroupInviteLink(ctx context.Context, link *model.GroupInviteLink) {
+	if err := s.inviteLinkDB.ReleaseGroupInviteLink(ctx, link.Token); err != nil {
+		log.ZError(ctx, "release group invite link failed", err, "groupID", link.GroupID)
+	}
+}
@@ -0,0 +1,213 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package group
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/common"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/controller"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/groupext"
+	"github.com/openimsdk/open-im-server/v3/pkg/rpcclient"
+	"github.com/openimsdk/protocol/constant"
+	pbgroup "github.com/openimsdk/protocol/group"
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/protocol/user"
+	"github.com/openimsdk/protocol/wrapperspb"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/mcontext"
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/grpc"
+)
+
+var errStubWrite = errors.New("stub write failed")
+
+type stubUserClient struct {
+	user.UserClient
+}
+
+func (stubUserClient) GetDesignateUsers(_ context.Context, req *user.GetDesignateUsersReq, _ ...grpc.CallOption) (*user.GetDesignateUsersResp, error) {
+	resp := &user.GetDesignateUsersResp{}
+	for _, userID := range req.UserIDs {
+		resp.UsersInfo = append(resp.UsersInfo, &sdkws.UserInfo{UserID: userID, Nickname: userID})
+	}
+	return resp, nil
+}
+
+// stubInviteGroupDB fails every write so the tests stop right after the invite link bookkeeping.
+type stubInviteGroupDB struct {
+	controller.GroupDatabase
+	group   *model.Group
+	members map[string]*model.GroupMember
+	created []*model.GroupMember
+}
+
+func (d *stubInviteGroupDB) TakeGroup(_ context.Context, groupID string) (*model.Group, error) {
+	if d.group == nil || d.group.GroupID != groupID {
+		return nil, errs.Wrap(mongo.ErrNoDocuments)
+	}
+	return d.group, nil
+}
+
+func (d *stubInviteGroupDB) TakeGroupMember(_ context.Context, _ string, userID string) (*model.GroupMember, error) {
+	if member, ok := d.members[userID]; ok {
+		return member, nil
+	}
+	return nil, errs.Wrap(mongo.ErrNoDocuments)
+}
+
+func (d *stubInviteGroupDB) FindGroupMembers(_ context.Context, _ string, userIDs []string) ([]*model.GroupMember, error) {
+	var members []*model.GroupMember
+	for _, userID := range userIDs {
+		if member, ok := d.members[userID]; ok {
+			members = append(members, member)
+		}
+	}
+	return members, nil
+}
+
+func (d *stubInviteGroupDB) CreateGroup(_ context.Context, _ []*model.Group, members []*model.GroupMember) error {
+	d.created = append(d.created, members...)
+	return errStubWrite
+}
+
+func (d *stubInviteGroupDB) DeleteGroupMember(context.Context, string, []string) error {
+	return errStubWrite
+}
+
+func (d *stubInviteGroupDB) UpdateGroupMembers(context.Context, []*common.BatchUpdateGroupMember) error {
+	return errStubWrite
+}
+
+type stubInviteLinkDB struct {
+	controller.GroupInviteLinkDatabase
+	link     *model.GroupInviteLink
+	used     int
+	released int
+	revoked  map[string][]string
+}
+
+func (d *stubInviteLinkDB) TakeGroupInviteLink(_ context.Context, token string) (*model.GroupInviteLink, error) {
+	if d.link == nil || d.link.Token != token {
+		return nil, errs.Wrap(mongo.ErrNoDocuments)
+	}
+	return d.link, nil
+}
+
+func (d *stubInviteLinkDB) UseGroupInviteLink(context.Context, string) error {
+	if d.link.MaxUses > 0 && d.link.UseCount >= d.link.MaxUses {
+		return errs.Wrap(mongo.ErrNoDocuments)
+	}
+	d.link.UseCount++
+	d.used++
+	return nil
+}
+
+func (d *stubInviteLinkDB) ReleaseGroupInviteLink(context.Context, string) error {
+	d.link.UseCount--
+	d.released++
+	return nil
+}
+
+func (d *stubInviteLinkDB) RevokeUserGroupInviteLinks(_ context.Context, groupID string, userIDs []string) error {
+	if d.revoked == nil {
+		d.revoked = make(map[string][]string)
+	}
+	d.revoked[groupID] = append(d.revoked[groupID], userIDs...)
+	return nil
+}
+
+func newInviteLinkTestServer(groupDB *stubInviteGroupDB, linkDB *stubInviteLinkDB) *groupServer {
+	return &groupServer{
+		db:           groupDB,
+		inviteLinkDB: linkDB,
+		user:         rpcclient.UserRpcClient{Client: stubUserClient{}},
+		notification: &GroupNotificationSender{},
+		config:       &Config{Share: config.Share{IMAdminUserID: []string{"admin"}}},
+	}
+}
+
+func TestApplyJoinGroupWithInviteLink(t *testing.T) {
+	groupDB := &stubInviteGroupDB{group: &model.Group{GroupID: "g1", NeedVerification: constant.AllNeedVerification}}
+	linkDB := &stubInviteLinkDB{link: &model.GroupInviteLink{Token: "t1", GroupID: "g1", CreatorUserID: "alice", MaxUses: 1, BypassApproval: true}}
+	s := newInviteLinkTestServer(groupDB, linkDB)
+	ctx := mcontext.SetOpUserID(context.Background(), "bob")
+
+	if _, err := s.ApplyJoinGroup(ctx, &groupext.ApplyJoinGroupReq{GroupID: "g2", InviteToken: "t1"}); !errs.ErrArgs.Is(err) {
+		t.Fatalf("link of another group: %v", err)
+	}
+	if linkDB.used != 0 {
+		t.Fatal("link of another group was used")
+	}
+
+	if _, err := s.ApplyJoinGroup(ctx, &groupext.ApplyJoinGroupReq{InviteToken: "t1"}); !errors.Is(err, errStubWrite) {
+		t.Fatalf("join: %v", err)
+	}
+	if len(groupDB.created) != 1 {
+		t.Fatalf("created %d members", len(groupDB.created))
+	}
+	member := groupDB.created[0]
+	if member.UserID != "bob" || member.InviterUserID != "alice" || member.JoinSource != groupext.JoinByInviteLink {
+		t.Errorf("member %+v", member)
+	}
+	if linkDB.used != 1 || linkDB.released != 1 || linkDB.link.UseCount != 0 {
+		t.Errorf("used %d released %d useCount %d", linkDB.used, linkDB.released, linkDB.link.UseCount)
+	}
+
+	linkDB.link.UseCount = 1
+	if _, err := s.ApplyJoinGroup(ctx, &groupext.ApplyJoinGroupReq{InviteToken: "t1"}); !errs.ErrArgs.Is(err) {
+		t.Fatalf("used up link: %v", err)
+	}
+	if linkDB.released != 1 {
+		t.Error("use of a used up link was released")
+	}
+}
+
+func TestRevokeInviteLinksOfLeavingMembers(t *testing.T) {
+	groupDB := &stubInviteGroupDB{
+		group: &model.Group{GroupID: "g1"},
+		members: map[string]*model.GroupMember{
+			"alice": {GroupID: "g1", UserID: "alice", Nickname: "alice", FaceURL: "a", RoleLevel: constant.GroupAdmin, JoinTime: time.Now()},
+			"bob":   {GroupID: "g1", UserID: "bob", Nickname: "bob", FaceURL: "b", RoleLevel: constant.GroupOrdinaryUsers, JoinTime: time.Now()},
+		},
+	}
+	linkDB := &stubInviteLinkDB{}
+	s := newInviteLinkTestServer(groupDB, linkDB)
+
+	if _, err := s.QuitGroup(mcontext.SetOpUserID(context.Background(), "bob"), &pbgroup.QuitGroupReq{GroupID: "g1"}); !errors.Is(err, errStubWrite) {
+		t.Fatalf("quit: %v", err)
+	}
+	if !reflect.DeepEqual(linkDB.revoked["g1"], []string{"bob"}) {
+		t.Fatalf("revoked on quit %v", linkDB.revoked)
+	}
+
+	linkDB.revoked = nil
+	_, err := s.SetGroupMemberInfo(mcontext.SetOpUserID(context.Background(), "admin"), &pbgroup.SetGroupMemberInfoReq{Members: []*pbgroup.SetGroupMemberInfo{
+		{GroupID: "g1", UserID: "alice", RoleLevel: wrapperspb.Int32(constant.GroupOrdinaryUsers)},
+		{GroupID: "g1", UserID: "bob", RoleLevel: wrapperspb.Int32(constant.GroupAdmin)},
+	}})
+	if !errors.Is(err, errStubWrite) {
+		t.Fatalf("set member info: %v", err)
+	}
+	if !reflect.DeepEqual(linkDB.revoked["g1"], []string{"alice"}) {
+		t.Fatalf("revoked on demotion %v", linkDB.revoked)
+	}
+}
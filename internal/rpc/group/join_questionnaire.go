@@ -0,0 +1,370 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package group
+
+import (
+	"context"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/servererrs"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/groupext"
+	"github.com/openimsdk/protocol/constant"
+	pbgroup "github.com/openimsdk/protocol/group"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/mcontext"
+	"github.com/openimsdk/tools/utils/datautil"
+)
+
+const (
+	maxJoinQuestions       = 10
+	maxJoinAnswerLength    = 500
+	expireGroupRequestsMax = 500
+)
+
+func convertGroupJoinQuestionnaire(questionnaire *model.GroupJoinQuestionnaire) *groupext.GroupJoinQuestionnaire {
+	return &groupext.GroupJoinQuestionnaire{
+		GroupID: questionnaire.GroupID,
+		Questions: datautil.Slice(questionnaire.Questions, func(e model.GroupJoinQuestion) *groupext.GroupJoinQuestion {
+			return &groupext.GroupJoinQuestion{
+				QuestionID:      e.QuestionID,
+				Type:            e.Type,
+				Content:         e.Content,
+				Options:         e.Options,
+				Required:        e.Required,
+				AcceptedAnswers: e.AcceptedAnswers,
+			}
+		}),
+		AutoApprove: questionnaire.AutoApprove,
+	}
+}
+
+func convertGroupJoinAnswers(answers []model.GroupJoinAnswer) []*groupext.GroupJoinAnswer {
+	return datautil.Slice(answers, func(e model.GroupJoinAnswer) *groupext.GroupJoinAnswer {
+		return &groupext.GroupJoinAnswer{QuestionID: e.QuestionID, Values: e.Values}
+	})
+}
+
+// checkJoinAnswers verifies the answers fit the questions and every required question is answered.
+func checkJoinAnswers(questions []model.GroupJoinQuestion, answers []model.GroupJoinAnswer) error {
+	answerMap := make(map[string]model.GroupJoinAnswer, len(answers))
+	for _, answer := range answers {
+		if _, ok := answerMap[answer.QuestionID]; ok {
+			return errs.ErrArgs.WrapMsg("question answered twice", "questionID", answer.QuestionID)
+		}
+		answerMap[answer.QuestionID] = answer
+	}
+	for _, question := range questions {
+		answer, ok := answerMap[question.QuestionID]
+		delete(answerMap, question.QuestionID)
+		if !ok || len(answer.Values) == 0 {
+			if question.Required {
+				return errs.ErrArgs.WrapMsg("required join question not answered", "questionID", question.QuestionID)
+			}
+			continue
+		}
+		switch question.Type {
+		case groupext.JoinQuestionText:
+			if len(answer.Values) != 1 || strings.TrimSpace(answer.Values[0]) == "" {
+				return errs.ErrArgs.WrapMsg("text question takes one answer", "questionID", question.QuestionID)
+			}
+			if utf8.RuneCountInString(answer.Values[0]) > maxJoinAnswerLength {
+				return errs.ErrArgs.WrapMsg("answer too long", "questionID", question.QuestionID)
+			}
+		case groupext.JoinQuestionSingleChoice, groupext.JoinQuestionMultipleChoice:
+			if question.Type == groupext.JoinQuestionSingleChoice && len(answer.Values) != 1 {
+				return errs.ErrArgs.WrapMsg("single choice question takes one option", "questionID", question.QuestionID)
+			}
+			if datautil.Duplicate(answer.Values) {
+				return errs.ErrArgs.WrapMsg("option chosen twice", "questionID", question.QuestionID)
+			}
+			for _, value := range answer.Values {
+				if !datautil.Contain(value, question.Options...) {
+					return errs.ErrArgs.WrapMsg("answer is not an option", "questionID", question.QuestionID)
+				}
+			}
+		}
+	}
+	if len(answerMap) > 0 {
+		return errs.ErrArgs.WrapMsg("answer to unknown join question")
+	}
+	return nil
+}
+
+// hasAcceptedAnswers reports whether some question defines accepted answers, which auto approval relies on.
+func hasAcceptedAnswers(questions []model.GroupJoinQuestion) bool {
+	for _, question := range questions {
+		if len(question.AcceptedAnswers) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// joinAnswersAccepted reports whether the answers, already checked with checkJoinAnswers, match the accepted answers
+// of every question defining some. A multiple choice answer matches when all chosen options are accepted. Answers
+// to questionnaires without accepted answers are never accepted.
+func joinAnswersAccepted(questions []model.GroupJoinQuestion, answers []model.GroupJoinAnswer) bool {
+	if !hasAcceptedAnswers(questions) {
+		return false
+	}
+	answerMap := make(map[string][]string, len(answers))
+	for _, answer := range answers {
+		answerMap[answer.QuestionID] = answer.Values
+	}
+	for _, question := range questions {
+		if len(question.AcceptedAnswers) == 0 {
+			continue
+		}
+		values := answerMap[question.QuestionID]
+		if len(values) == 0 {
+			return false
+		}
+		for _, value := range values {
+			var accepted bool
+			for _, acceptedAnswer := range question.AcceptedAnswers {
+				if question.Type == groupext.JoinQuestionText {
+					accepted = strings.EqualFold(strings.TrimSpace(value), strings.TrimSpace(acceptedAnswer))
+				} else {
+					accepted = value == acceptedAnswer
+				}
+				if accepted {
+					break
+				}
+			}
+			if !accepted {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// takeGroupJoinQuestionnaire returns nil when the group has no questionnaire.
+func (s *groupServer) takeGroupJoinQuestionnaire(ctx context.Context, groupID string) (*model.GroupJoinQuestionnaire, error) {
+	questionnaire, err := s.joinDB.TakeGroupJoinQuestionnaire(ctx, groupID)
+	if err == nil {
+		return questionnaire, nil
+	}
+	if s.IsNotFound(err) {
+		return nil, nil
+	}
+	return nil, err
+}
+
+func (s *groupServer) SetGroupJoinQuestionnaire(ctx context.Context, req *groupext.SetGroupJoinQuestionnaireReq) (*groupext.SetGroupJoinQuestionnaireResp, error) {
+	questionnaire := req.Questionnaire
+	if len(questionnaire.Questions) > maxJoinQuestions {
+		return nil, errs.ErrArgs.WrapMsg("too many join questions")
+	}
+	if _, err := s.checkGroupPermission(ctx, questionnaire.GroupID, authverify.GroupPermissionApproveJoin); err != nil {
+		return nil, err
+	}
+	group, err := s.db.TakeGroup(ctx, questionnaire.GroupID)
+	if err != nil {
+		return nil, err
+	}
+	if group.Status == constant.GroupStatusDismissed {
+		return nil, servererrs.ErrDismissedAlready.Wrap()
+	}
+	if len(questionnaire.Questions) == 0 {
+		if err := s.joinDB.DeleteGroupJoinQuestionnaire(ctx, questionnaire.GroupID); err != nil && !s.IsNotFound(err) {
+			return nil, err
+		}
+		return &groupext.SetGroupJoinQuestionnaireResp{}, nil
+	}
+	dbQuestionnaire := &model.GroupJoinQuestionnaire{
+		GroupID: questionnaire.GroupID,
+		Questions: datautil.Slice(questionnaire.Questions, func(e *groupext.GroupJoinQuestion) model.GroupJoinQuestion {
+			return model.GroupJoinQuestion{
+				QuestionID:      e.QuestionID,
+				Type:            e.Type,
+				Content:         e.Content,
+				Options:         e.Options,
+				Required:        e.Required,
+				AcceptedAnswers: e.AcceptedAnswers,
+			}
+		}),
+		AutoApprove: questionnaire.AutoApprove,
+		UpdateTime:  time.Now(),
+	}
+	if dbQuestionnaire.AutoApprove && !hasAcceptedAnswers(dbQuestionnaire.Questions) {
+		return nil, errs.ErrArgs.WrapMsg("auto approval needs a question with accepted answers")
+	}
+	if err := s.joinDB.SetGroupJoinQuestionnaire(ctx, dbQuestionnaire); err != nil {
+		return nil, err
+	}
+	return &groupext.SetGroupJoinQuestionnaireResp{}, nil
+}
+
+func (s *groupServer) GetGroupJoinQuestionnaire(ctx context.Context, req *groupext.GetGroupJoinQuestionnaireReq) (*groupext.GetGroupJoinQuestionnaireResp, error) {
+	questionnaire, err := s.takeGroupJoinQuestionnaire(ctx, req.GroupID)
+	if err != nil {
+		return nil, err
+	}
+	if questionnaire == nil {
+		questionnaire = &model.GroupJoinQuestionnaire{GroupID: req.GroupID}
+	}
+	resp := &groupext.GetGroupJoinQuestionnaireResp{Questionnaire: convertGroupJoinQuestionnaire(questionnaire)}
+	// applicants see the questions, only those approving requests see the answers accepted automatically
+	canApprove, err := s.canApproveJoinRequests(ctx, req.GroupID)
+	if err != nil {
+		return nil, err
+	}
+	if !canApprove {
+		for _, question := range resp.Questionnaire.Questions {
+			question.AcceptedAnswers = nil
+		}
+	}
+	return resp, nil
+}
+
+// canApproveJoinRequests reports whether the operator holds the permission to approve join requests of the group.
+func (s *groupServer) canApproveJoinRequests(ctx context.Context, groupID string) (bool, error) {
+	if authverify.IsAppManagerUid(ctx, s.config.Share.IMAdminUserID) {
+		return true, nil
+	}
+	opMember, err := s.takeOpGroupMember(ctx, groupID)
+	if err != nil {
+		if s.IsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return s.hasGroupPermission(ctx, opMember, authverify.GroupPermissionApproveJoin)
+}
+
+func (s *groupServer) ApplyJoinGroup(ctx context.Context, req *groupext.ApplyJoinGroupReq) (*groupext.ApplyJoinGroupResp, error) {
+	joinReq := &pbgroup.JoinGroupReq{
+		GroupID:       req.GroupID,
+		ReqMessage:    req.ReqMessage,
+		JoinSource:    constant.JoinBySearch,
+		InviterUserID: mcontext.GetOpUserID(ctx),
+		Ex:            req.Ex,
+	}
+	answers := datautil.Slice(req.Answers, func(e *groupext.GroupJoinAnswer) model.GroupJoinAnswer {
+		return model.GroupJoinAnswer{QuestionID: e.QuestionID, Values: e.Values}
+	})
+	var link *model.GroupInviteLink
+	if req.InviteToken != "" {
+		var err error
+		link, err = s.takeUsableGroupInviteLink(ctx, req.InviteToken)
+		if err != nil {
+			return nil, err
+		}
+		if req.GroupID != "" && req.GroupID != link.GroupID {
+			return nil, errs.ErrArgs.WrapMsg("invite link belongs to another group")
+		}
+		joinReq.GroupID = link.GroupID
+		joinReq.JoinSource = groupext.JoinByInviteLink
+	}
+	joined, err := s.joinGroup(ctx, joinReq, answers, link)
+	if err != nil {
+		return nil, err
+	}
+	return &groupext.ApplyJoinGroupResp{Joined: joined, GroupID: joinReq.GroupID}, nil
+}
+
+func (s *groupServer) GetGroupRequestAnswers(ctx context.Context, req *groupext.GetGroupRequestAnswersReq) (*groupext.GetGroupRequestAnswersResp, error) {
+	if _, err := s.checkGroupPermission(ctx, req.GroupID, authverify.GroupPermissionApproveJoin); err != nil {
+		return nil, err
+	}
+	requests, err := s.db.FindGroupRequests(ctx, req.GroupID, req.UserIDs)
+	if err != nil {
+		return nil, err
+	}
+	return &groupext.GetGroupRequestAnswersResp{
+		Requests: datautil.Slice(requests, func(e *model.GroupRequest) *groupext.GroupRequestAnswers {
+			return &groupext.GroupRequestAnswers{UserID: e.UserID, Answers: convertGroupJoinAnswers(e.Answers)}
+		}),
+	}, nil
+}
+
+// ExpireGroupRequests refuses the pending join requests sent before req.Before in batches. A request failing to be
+// handled is logged and left pending, the next batches start after it.
+func (s *groupServer) ExpireGroupRequests(ctx context.Context, req *groupext.ExpireGroupRequestsReq) (*groupext.ExpireGroupRequestsResp, error) {
+	if err := authverify.CheckAdmin(ctx, s.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	before := time.UnixMilli(req.Before)
+	groups := make(map[string]*model.Group)
+	var (
+		count int32
+		after *model.GroupRequest
+	)
+	for {
+		requests, err := s.db.FindExpiredGroupRequests(ctx, before, after, expireGroupRequestsMax)
+		if err != nil {
+			return nil, err
+		}
+		var handled int
+		for _, request := range requests {
+			group, ok := groups[request.GroupID]
+			if !ok {
+				group, err = s.db.TakeGroup(ctx, request.GroupID)
+				if err != nil {
+					log.ZError(ctx, "expire group request take group failed", err, "groupID", request.GroupID)
+					continue
+				}
+				groups[request.GroupID] = group
+			}
+			handleReq := &pbgroup.GroupApplicationResponseReq{
+				GroupID:      request.GroupID,
+				FromUserID:   request.UserID,
+				HandledMsg:   "request expired",
+				HandleResult: constant.GroupResponseRefuse,
+			}
+			if err := s.handleGroupRequest(ctx, group, request, handleReq, groupext.RequestExpired); err != nil {
+				log.ZError(ctx, "expire group request failed", err, "groupID", request.GroupID, "userID", request.UserID)
+				continue
+			}
+			handled++
+		}
+		count += int32(handled)
+		if len(requests) < expireGroupRequestsMax {
+			break
+		}
+		after = requests[len(requests)-1]
+	}
+	return &groupext.ExpireGroupRequestsResp{Count: count}, nil
+}
+
+func (s *groupServer) GetGroupRequestAudits(ctx context.Context, req *groupext.GetGroupRequestAuditsReq) (*groupext.GetGroupRequestAuditsResp, error) {
+	if _, err := s.checkGroupPermission(ctx, req.GroupID, authverify.GroupPermissionApproveJoin); err != nil {
+		return nil, err
+	}
+	total, audits, err := s.joinDB.PageGroupRequestAudits(ctx, req.GroupID, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &groupext.GetGroupRequestAuditsResp{
+		Total: total,
+		Audits: datautil.Slice(audits, func(e *model.GroupRequestAudit) *groupext.GroupRequestAudit {
+			return &groupext.GroupRequestAudit{
+				GroupID:      e.GroupID,
+				UserID:       e.UserID,
+				HandleUserID: e.HandleUserID,
+				HandleResult: e.HandleResult,
+				HandledMsg:   e.HandledMsg,
+				HandleType:   e.HandleType,
+				HandledTime:  e.HandledTime.UnixMilli(),
+			}
+		}),
+	}, nil
+}
@@ -0,0 +1,172 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package group
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/controller"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/groupext"
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/mcontext"
+)
+
+func TestJoinAnswers(t *testing.T) {
+	questions := []model.GroupJoinQuestion{
+		{QuestionID: "why", Type: groupext.JoinQuestionText, Required: true, AcceptedAnswers: []string{"Go"}},
+		{QuestionID: "lang", Type: groupext.JoinQuestionMultipleChoice, Options: []string{"go", "rust", "php"}, AcceptedAnswers: []string{"go", "rust"}},
+		{QuestionID: "age", Type: groupext.JoinQuestionSingleChoice, Options: []string{"<18", ">=18"}},
+	}
+	answer := func(questionID string, values ...string) model.GroupJoinAnswer {
+		return model.GroupJoinAnswer{QuestionID: questionID, Values: values}
+	}
+	invalid := [][]model.GroupJoinAnswer{
+		nil,
+		{answer("why", " ")},
+		{answer("why", "go"), answer("why", "go")},
+		{answer("why", "go"), answer("age", "<18", ">=18")},
+		{answer("why", "go"), answer("lang", "java")},
+		{answer("why", "go"), answer("lang", "go", "go")},
+		{answer("why", "go"), answer("other", "x")},
+	}
+	for i, answers := range invalid {
+		if err := checkJoinAnswers(questions, answers); err == nil {
+			t.Errorf("invalid answers %d accepted", i)
+		}
+	}
+	cases := []struct {
+		answers  []model.GroupJoinAnswer
+		accepted bool
+	}{
+		{[]model.GroupJoinAnswer{answer("why", " go "), answer("lang", "go", "rust"), answer("age", "<18")}, true},
+		{[]model.GroupJoinAnswer{answer("why", "go")}, false},
+		{[]model.GroupJoinAnswer{answer("why", "go"), answer("lang", "go", "php")}, false},
+		{[]model.GroupJoinAnswer{answer("why", "java"), answer("lang", "go")}, false},
+	}
+	for i, c := range cases {
+		if err := checkJoinAnswers(questions, c.answers); err != nil {
+			t.Fatalf("answers %d: %v", i, err)
+		}
+		if joinAnswersAccepted(questions, c.answers) != c.accepted {
+			t.Errorf("answers %d accepted != %v", i, c.accepted)
+		}
+	}
+	open := []model.GroupJoinQuestion{{QuestionID: "why", Type: groupext.JoinQuestionText}}
+	if joinAnswersAccepted(open, []model.GroupJoinAnswer{answer("why", "go")}) {
+		t.Error("answers accepted without accepted answers")
+	}
+}
+
+type stubJoinDB struct {
+	controller.GroupJoinDatabase
+	questionnaire *model.GroupJoinQuestionnaire
+}
+
+func (d *stubJoinDB) TakeGroupJoinQuestionnaire(context.Context, string) (*model.GroupJoinQuestionnaire, error) {
+	return d.questionnaire, nil
+}
+
+func (d *stubJoinDB) SetGroupJoinQuestionnaire(_ context.Context, questionnaire *model.GroupJoinQuestionnaire) error {
+	d.questionnaire = questionnaire
+	return nil
+}
+
+func TestGroupJoinQuestionnaireAcceptedAnswers(t *testing.T) {
+	groupDB := &stubInviteGroupDB{
+		group: &model.Group{GroupID: "g1"},
+		members: map[string]*model.GroupMember{
+			"owner":  {GroupID: "g1", UserID: "owner", RoleLevel: constant.GroupOwner},
+			"member": {GroupID: "g1", UserID: "member", RoleLevel: constant.GroupOrdinaryUsers},
+		},
+	}
+	joinDB := &stubJoinDB{}
+	s := newInviteLinkTestServer(groupDB, &stubInviteLinkDB{})
+	s.joinDB = joinDB
+	ownerCtx := mcontext.SetOpUserID(context.Background(), "owner")
+
+	question := &groupext.GroupJoinQuestion{QuestionID: "why", Type: groupext.JoinQuestionText, Content: "why?"}
+	req := &groupext.SetGroupJoinQuestionnaireReq{Questionnaire: &groupext.GroupJoinQuestionnaire{
+		GroupID:     "g1",
+		Questions:   []*groupext.GroupJoinQuestion{question},
+		AutoApprove: true,
+	}}
+	if _, err := s.SetGroupJoinQuestionnaire(ownerCtx, req); !errs.ErrArgs.Is(err) {
+		t.Fatalf("auto approval without accepted answers: %v", err)
+	}
+	question.AcceptedAnswers = []string{"go"}
+	if _, err := s.SetGroupJoinQuestionnaire(ownerCtx, req); err != nil {
+		t.Fatal(err)
+	}
+
+	for userID, visible := range map[string]bool{"owner": true, "member": false, "applicant": false} {
+		resp, err := s.GetGroupJoinQuestionnaire(mcontext.SetOpUserID(context.Background(), userID), &groupext.GetGroupJoinQuestionnaireReq{GroupID: "g1"})
+		if err != nil {
+			t.Fatalf("%s: %v", userID, err)
+		}
+		if got := len(resp.Questionnaire.Questions[0].AcceptedAnswers) > 0; got != visible {
+			t.Errorf("%s sees accepted answers: %v", userID, got)
+		}
+	}
+	if len(joinDB.questionnaire.Questions[0].AcceptedAnswers) != 1 {
+		t.Error("stored accepted answers were stripped")
+	}
+}
+
+type stubExpireGroupDB struct {
+	stubInviteGroupDB
+	requests []*model.GroupRequest
+	afters   []*model.GroupRequest
+}
+
+func (d *stubExpireGroupDB) FindExpiredGroupRequests(_ context.Context, _ time.Time, after *model.GroupRequest, limit int64) ([]*model.GroupRequest, error) {
+	d.afters = append(d.afters, after)
+	start := 0
+	if after != nil {
+		for i, request := range d.requests {
+			if request == after {
+				start = i + 1
+			}
+		}
+	}
+	end := min(start+int(limit), len(d.requests))
+	return d.requests[start:end], nil
+}
+
+func TestExpireGroupRequestsSkipsFailures(t *testing.T) {
+	groupDB := &stubExpireGroupDB{}
+	for i := 0; i < expireGroupRequestsMax+1; i++ {
+		// the groups are missing so every request fails to be handled
+		groupDB.requests = append(groupDB.requests, &model.GroupRequest{GroupID: fmt.Sprintf("g%d", i), UserID: "bob"})
+	}
+	s := newInviteLinkTestServer(&groupDB.stubInviteGroupDB, &stubInviteLinkDB{})
+	s.db = groupDB
+	ctx := mcontext.SetOpUserID(context.Background(), "admin")
+
+	resp, err := s.ExpireGroupRequests(ctx, &groupext.ExpireGroupRequestsReq{Before: time.Now().UnixMilli()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Count != 0 {
+		t.Errorf("count %d", resp.Count)
+	}
+	if len(groupDB.afters) != 2 || groupDB.afters[0] != nil || groupDB.afters[1] != groupDB.requests[expireGroupRequestsMax-1] {
+		t.Errorf("cursors %v", groupDB.afters)
+	}
+}
@@ -0,0 +1,80 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package group
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/servererrs"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/groupext"
+	"github.com/openimsdk/protocol/constant"
+)
+
+func convertGroupMsgPolicy(group *model.Group) *groupext.GroupMsgPolicy {
+	return &groupext.GroupMsgPolicy{
+		GroupID:             group.GroupID,
+		SlowModeSeconds:     group.SlowModeSeconds,
+		MaxMsgLength:        group.MaxMsgLength,
+		DisallowLinks:       group.DisallowLinks,
+		DisallowAttachments: group.DisallowAttachments,
+	}
+}
+
+func (s *groupServer) SetGroupMsgPolicy(ctx context.Context, req *groupext.SetGroupMsgPolicyReq) (*groupext.SetGroupMsgPolicyResp, error) {
+	policy := req.Policy
+	opMember, err := s.checkGroupPermission(ctx, policy.GroupID, authverify.GroupPermissionEditInfo)
+	if err != nil {
+		return nil, err
+	}
+	group, err := s.db.TakeGroup(ctx, policy.GroupID)
+	if err != nil {
+		return nil, err
+	}
+	if group.Status == constant.GroupStatusDismissed {
+		return nil, servererrs.ErrDismissedAlready.Wrap()
+	}
+	update := map[string]any{
+		"slow_mode_seconds":    policy.SlowModeSeconds,
+		"max_msg_length":       policy.MaxMsgLength,
+		"disallow_links":       policy.DisallowLinks,
+		"disallow_attachments": policy.DisallowAttachments,
+	}
+	if err := s.db.UpdateGroup(ctx, policy.GroupID, update); err != nil {
+		return nil, err
+	}
+	group, err = s.db.TakeGroup(ctx, policy.GroupID)
+	if err != nil {
+		return nil, err
+	}
+	tips := &groupext.GroupMsgPolicySetTips{Policy: convertGroupMsgPolicy(group)}
+	if opMember != nil {
+		if err := s.PopulateGroupMember(ctx, opMember); err != nil {
+			return nil, err
+		}
+		tips.OpUser = s.groupMemberDB2PB(opMember, 0)
+	}
+	s.notification.GroupMsgPolicySetNotification(ctx, tips)
+	return &groupext.SetGroupMsgPolicyResp{}, nil
+}
+
+func (s *groupServer) GetGroupMsgPolicy(ctx context.Context, req *groupext.GetGroupMsgPolicyReq) (*groupext.GetGroupMsgPolicyResp, error) {
+	group, err := s.db.TakeGroup(ctx, req.GroupID)
+	if err != nil {
+		return nil, err
+	}
+	return &groupext.GetGroupMsgPolicyResp{Policy: convertGroupMsgPolicy(group)}, nil
+}
@@ -0,0 +1,123 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package group
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/groupext"
+	"github.com/openimsdk/protocol/constant"
+	pbgroup "github.com/openimsdk/protocol/group"
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/tools/log"
+)
+
+func (s *groupServer) PurgeUserGroups(ctx context.Context, req *groupext.PurgeUserGroupsReq) (*groupext.PurgeUserGroupsResp, error) {
+	if err := authverify.CheckAdmin(ctx, s.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	communityIDs, err := s.communityDB.FindJoinedCommunityIDs(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	for _, communityID := range communityIDs {
+		if err := s.purgeCommunityMember(ctx, communityID, req.UserID); err != nil {
+			return nil, err
+		}
+	}
+	groupIDs, err := s.db.FindJoinedGroupID(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	for _, groupID := range groupIDs {
+		if err := s.purgeGroupMember(ctx, groupID, req.UserID); err != nil {
+			return nil, err
+		}
+	}
+	if err := s.db.DeleteUserGroupRequests(ctx, req.UserID); err != nil {
+		return nil, err
+	}
+	return &groupext.PurgeUserGroupsResp{Count: int32(len(groupIDs))}, nil
+}
+
+// purgeCommunityMember removes the user from the community, handing it over first when they own it.
+// Its channels are left by purgeGroupMember like any other group.
+func (s *groupServer) purgeCommunityMember(ctx context.Context, communityID string, userID string) error {
+	community, err := s.takeCommunity(ctx, communityID)
+	if err != nil {
+		return err
+	}
+	if community.OwnerUserID == userID {
+		// members are sorted by role level, then join time, so the owner and at most one other member come first
+		_, members, err := s.communityDB.PageCommunityMembers(ctx, communityID, &sdkws.RequestPagination{PageNumber: 1, ShowNumber: 2})
+		if err != nil {
+			return err
+		}
+		var successorID string
+		for _, member := range members {
+			if member.UserID != userID {
+				successorID = member.UserID
+				break
+			}
+		}
+		if successorID == "" {
+			_, err := s.DismissCommunity(ctx, &groupext.DismissCommunityReq{CommunityID: communityID})
+			return err
+		}
+		if err := s.communityDB.SetCommunityMemberRoleLevel(ctx, communityID, successorID, constant.GroupOwner); err != nil {
+			return err
+		}
+		if err := s.communityDB.UpdateCommunity(ctx, communityID, map[string]any{"owner_user_id": successorID}); err != nil {
+			return err
+		}
+		log.ZInfo(ctx, "community handed over", "communityID", communityID, "oldOwner", userID, "newOwner", successorID)
+	}
+	return s.communityDB.DeleteCommunityMembers(ctx, communityID, []string{userID})
+}
+
+// purgeGroupMember removes the user from the group. A group they own is transferred to its admin or earliest member,
+// or dismissed when they are its last member.
+func (s *groupServer) purgeGroupMember(ctx context.Context, groupID string, userID string) error {
+	member, err := s.db.TakeGroupMember(ctx, groupID, userID)
+	if err != nil {
+		if s.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	group, err := s.db.TakeGroup(ctx, groupID)
+	if err != nil {
+		return err
+	}
+	if group.Status == constant.GroupStatusDismissed {
+		return s.db.DeleteGroupMember(ctx, groupID, []string{userID})
+	}
+	if member.RoleLevel == constant.GroupOwner {
+		successor, err := s.db.TakeGroupSuccessor(ctx, groupID, userID)
+		if err != nil {
+			if s.IsNotFound(err) {
+				_, err := s.DismissGroup(ctx, &pbgroup.DismissGroupReq{GroupID: groupID, DeleteMember: true})
+				return err
+			}
+			return err
+		}
+		if _, err := s.TransferGroupOwner(ctx, &pbgroup.TransferGroupOwnerReq{GroupID: groupID, OldOwnerUserID: userID, NewOwnerUserID: successor.UserID}); err != nil {
+			return err
+		}
+	}
+	_, err = s.QuitGroup(ctx, &pbgroup.QuitGroupReq{GroupID: groupID, UserID: userID})
+	return err
+}
@@ -0,0 +1,190 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package group
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/servererrs"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/groupext"
+	"github.com/openimsdk/protocol/constant"
+	pbgroup "github.com/openimsdk/protocol/group"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/mcontext"
+	"github.com/openimsdk/tools/utils/datautil"
+)
+
+// hasGroupPermission evaluates the permissions of a member, custom roles are only loaded for custom role levels.
+func (s *groupServer) hasGroupPermission(ctx context.Context, member *model.GroupMember, permission string) (bool, error) {
+	var roles []*groupext.GroupRole
+	if authverify.IsCustomGroupRoleLevel(member.RoleLevel) {
+		dbRoles, err := s.db.FindGroupRoles(ctx, member.GroupID)
+		if err != nil {
+			return false, err
+		}
+		roles = datautil.Slice(dbRoles, convertGroupRole)
+	}
+	return authverify.HasGroupPermission(member.RoleLevel, roles, permission), nil
+}
+
+// checkGroupPermission requires the operator to hold permission in the group and returns its membership,
+// which is nil for app managers.
+func (s *groupServer) checkGroupPermission(ctx context.Context, groupID string, permission string) (*model.GroupMember, error) {
+	if authverify.IsAppManagerUid(ctx, s.config.Share.IMAdminUserID) {
+		return nil, nil
+	}
+	opMember, err := s.takeOpGroupMember(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+	ok, err := s.hasGroupPermission(ctx, opMember, permission)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, errs.ErrNoPermission.WrapMsg("no group permission", "permission", permission)
+	}
+	return opMember, nil
+}
+
+func (s *groupServer) checkGroupOwner(ctx context.Context, groupID string) error {
+	if authverify.IsAppManagerUid(ctx, s.config.Share.IMAdminUserID) {
+		return nil
+	}
+	opMember, err := s.db.TakeGroupMember(ctx, groupID, mcontext.GetOpUserID(ctx))
+	if err != nil {
+		return err
+	}
+	if opMember.RoleLevel != constant.GroupOwner {
+		return errs.ErrNoPermission.WrapMsg("only the group owner can manage group roles")
+	}
+	return nil
+}
+
+// checkCustomGroupRoleLevels requires custom role levels assigned to members to be defined in the group.
+func (s *groupServer) checkCustomGroupRoleLevels(ctx context.Context, groupID string, members []*pbgroup.SetGroupMemberInfo) error {
+	var roles map[int32]*model.GroupRole
+	for _, member := range members {
+		if member.RoleLevel == nil || !authverify.IsCustomGroupRoleLevel(member.RoleLevel.Value) {
+			continue
+		}
+		if roles == nil {
+			dbRoles, err := s.db.FindGroupRoles(ctx, groupID)
+			if err != nil {
+				return err
+			}
+			roles = datautil.SliceToMap(dbRoles, func(e *model.GroupRole) int32 {
+				return e.RoleLevel
+			})
+		}
+		if _, ok := roles[member.RoleLevel.Value]; !ok {
+			return errs.ErrArgs.WrapMsg("group role not found", "groupID", groupID, "roleLevel", member.RoleLevel.Value)
+		}
+	}
+	return nil
+}
+
+func convertGroupRole(role *model.GroupRole) *groupext.GroupRole {
+	return &groupext.GroupRole{
+		GroupID:     role.GroupID,
+		RoleLevel:   role.RoleLevel,
+		Name:        role.Name,
+		Permissions: role.Permissions,
+		CreateTime:  role.CreateTime.UnixMilli(),
+	}
+}
+
+func (s *groupServer) CreateGroupRole(ctx context.Context, req *groupext.CreateGroupRoleReq) (*groupext.CreateGroupRoleResp, error) {
+	if err := authverify.CheckGroupRole(req.RoleLevel, req.Permissions); err != nil {
+		return nil, err
+	}
+	if err := s.checkGroupOwner(ctx, req.GroupID); err != nil {
+		return nil, err
+	}
+	group, err := s.db.TakeGroup(ctx, req.GroupID)
+	if err != nil {
+		return nil, err
+	}
+	if group.Status == constant.GroupStatusDismissed {
+		return nil, servererrs.ErrDismissedAlready.Wrap()
+	}
+	roles, err := s.db.FindGroupRoles(ctx, req.GroupID)
+	if err != nil {
+		return nil, err
+	}
+	for _, role := range roles {
+		if role.RoleLevel == req.RoleLevel {
+			return nil, errs.ErrArgs.WrapMsg("group role level already exists", "roleLevel", req.RoleLevel)
+		}
+	}
+	role := &model.GroupRole{
+		GroupID:     req.GroupID,
+		RoleLevel:   req.RoleLevel,
+		Name:        req.Name,
+		Permissions: datautil.If(req.Permissions == nil, []string{}, req.Permissions),
+		CreateTime:  time.Now(),
+	}
+	if err := s.db.CreateGroupRole(ctx, role); err != nil {
+		return nil, err
+	}
+	return &groupext.CreateGroupRoleResp{}, nil
+}
+
+func (s *groupServer) UpdateGroupRole(ctx context.Context, req *groupext.UpdateGroupRoleReq) (*groupext.UpdateGroupRoleResp, error) {
+	if err := authverify.CheckGroupRole(req.RoleLevel, req.Permissions); err != nil {
+		return nil, err
+	}
+	if err := s.checkGroupOwner(ctx, req.GroupID); err != nil {
+		return nil, err
+	}
+	permissions := datautil.If(req.Permissions == nil, []string{}, req.Permissions)
+	if err := s.db.UpdateGroupRole(ctx, req.GroupID, req.RoleLevel, req.Name, permissions); err != nil {
+		return nil, err
+	}
+	return &groupext.UpdateGroupRoleResp{}, nil
+}
+
+func (s *groupServer) DeleteGroupRole(ctx context.Context, req *groupext.DeleteGroupRoleReq) (*groupext.DeleteGroupRoleResp, error) {
+	if err := s.checkGroupOwner(ctx, req.GroupID); err != nil {
+		return nil, err
+	}
+	members, err := s.db.FindGroupMemberRoleLevels(ctx, req.GroupID, []int32{req.RoleLevel})
+	if err != nil {
+		return nil, err
+	}
+	if len(members) > 0 {
+		return nil, errs.ErrArgs.WrapMsg("group role is still held by members", "roleLevel", req.RoleLevel)
+	}
+	if err := s.db.DeleteGroupRole(ctx, req.GroupID, req.RoleLevel); err != nil {
+		return nil, err
+	}
+	return &groupext.DeleteGroupRoleResp{}, nil
+}
+
+func (s *groupServer) GetGroupRoles(ctx context.Context, req *groupext.GetGroupRolesReq) (*groupext.GetGroupRolesResp, error) {
+	if !authverify.IsAppManagerUid(ctx, s.config.Share.IMAdminUserID) {
+		if _, err := s.db.TakeGroupMember(ctx, req.GroupID, mcontext.GetOpUserID(ctx)); err != nil {
+			return nil, err
+		}
+	}
+	roles, err := s.db.FindGroupRoles(ctx, req.GroupID)
+	if err != nil {
+		return nil, err
+	}
+	return &groupext.GetGroupRolesResp{Roles: datautil.Slice(roles, convertGroupRole)}, nil
+}
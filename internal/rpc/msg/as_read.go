@@ -17,7 +17,9 @@ package msg
 import (
 	"context"
 
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
 	cbapi "github.com/openimsdk/open-im-server/v3/pkg/callbackstruct"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/msgext"
 	"github.com/openimsdk/protocol/constant"
 	"github.com/openimsdk/protocol/msg"
 	"github.com/openimsdk/protocol/sdkws"
@@ -83,6 +85,7 @@ func (m *msgServer) SetConversationHasReadSeq(ctx context.Context, req *msg.SetC
 	if err := m.MsgDatabase.SetHasReadSeq(ctx, req.UserID, req.ConversationID, req.HasReadSeq); err != nil {
 		return nil, err
 	}
+	m.resetUnreadCount(ctx, req.UserID, req.ConversationID, req.HasReadSeq, maxSeq)
 	m.sendMarkAsReadNotification(ctx, req.ConversationID, constant.SingleChatType, req.UserID, req.UserID, nil, req.HasReadSeq)
 	return &msg.SetConversationHasReadSeqResp{}, nil
 }
@@ -115,6 +118,7 @@ func (m *msgServer) MarkMsgsAsRead(ctx context.Context, req *msg.MarkMsgsAsReadR
 		if err != nil {
 			return nil, err
 		}
+		m.resetUnreadCount(ctx, req.UserID, req.ConversationID, hasReadSeq, maxSeq)
 	}
 
 	reqCallback := &cbapi.CallbackSingleMsgReadReq{
@@ -179,6 +183,12 @@ func (m *msgServer) MarkConversationAsRead(ctx context.Context, req *msg.MarkCon
 			req.UserID, seqs, hasReadSeq)
 	}
 
+	if maxSeq, err := m.MsgDatabase.GetMaxSeq(ctx, req.ConversationID); err == nil {
+		m.resetUnreadCount(ctx, req.UserID, req.ConversationID, hasReadSeq, maxSeq)
+	} else {
+		log.ZWarn(ctx, "get max seq error", err, "conversationID", req.ConversationID)
+	}
+
 	reqCall := &cbapi.CallbackGroupMsgReadReq{
 		SendID:       conversation.OwnerUserID,
 		ReceiveID:    req.UserID,
@@ -190,6 +200,78 @@ func (m *msgServer) MarkConversationAsRead(ctx context.Context, req *msg.MarkCon
 	return &msg.MarkConversationAsReadResp{}, nil
 }
 
+// resetUnreadCount recounts the unread messages after the user read the conversation up to hasReadSeq,
+// a failure only leaves the count stale until the next read.
+func (m *msgServer) resetUnreadCount(ctx context.Context, userID string, conversationID string, hasReadSeq int64, maxSeq int64) {
+	if err := m.unreadDatabase.ResetUnreadCount(ctx, userID, conversationID, hasReadSeq, maxSeq); err != nil {
+		log.ZWarn(ctx, "reset unread count error", err, "userID", userID, "conversationID", conversationID)
+	}
+}
+
+func (m *msgServer) GetConversationsUnreadCount(ctx context.Context, req *msgext.GetConversationsUnreadCountReq) (*msgext.GetConversationsUnreadCountResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, m.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	totals, err := m.getUnreadCountSums(ctx, []string{req.UserID})
+	if err != nil {
+		return nil, err
+	}
+	conversationIDs := req.ConversationIDs
+	if len(conversationIDs) == 0 {
+		conversationIDs, err = m.ConversationLocalCache.GetConversationIDs(ctx, req.UserID)
+		if err != nil {
+			return nil, err
+		}
+	}
+	counts, err := m.unreadDatabase.GetUnreadCounts(ctx, req.UserID, conversationIDs)
+	if err != nil {
+		return nil, err
+	}
+	return &msgext.GetConversationsUnreadCountResp{UnreadCounts: counts, Total: totals[req.UserID]}, nil
+}
+
+func (m *msgServer) GetUsersUnreadCount(ctx context.Context, req *msgext.GetUsersUnreadCountReq) (*msgext.GetUsersUnreadCountResp, error) {
+	if err := authverify.CheckAdmin(ctx, m.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	totals, err := m.getUnreadCountSums(ctx, datautil.Distinct(req.UserIDs))
+	if err != nil {
+		return nil, err
+	}
+	return &msgext.GetUsersUnreadCountResp{Totals: totals}, nil
+}
+
+// getUnreadCountSums returns the unread count sums of the users, the counts of users that have never been built,
+// such as users with messages from before the counts were kept, are rebuilt from their seqs first.
+func (m *msgServer) getUnreadCountSums(ctx context.Context, userIDs []string) (map[string]int64, error) {
+	sums, err := m.unreadDatabase.GetUnreadCountSums(ctx, userIDs)
+	if err != nil {
+		return nil, err
+	}
+	for _, userID := range userIDs {
+		if _, ok := sums[userID]; ok {
+			continue
+		}
+		conversationIDs, err := m.ConversationLocalCache.GetConversationIDs(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+		hasReadSeqs, err := m.MsgDatabase.GetHasReadSeqs(ctx, userID, conversationIDs)
+		if err != nil {
+			return nil, err
+		}
+		maxSeqs, err := m.MsgDatabase.GetMaxSeqs(ctx, conversationIDs)
+		if err != nil {
+			return nil, err
+		}
+		sums[userID], err = m.unreadDatabase.RebuildUnreadCounts(ctx, userID, hasReadSeqs, maxSeqs)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return sums, nil
+}
+
 func (m *msgServer) sendMarkAsReadNotification(ctx context.Context, conversationID string, sessionType int32, sendID, recvID string, seqs []int64, hasReadSeq int64) {
 	tips := &sdkws.MarkAsReadTips{
 		MarkAsReadUserID: sendID,
@@ -0,0 +1,385 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msg
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database/mgo"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/msgext"
+	"github.com/openimsdk/protocol/constant"
+	pbmsg "github.com/openimsdk/protocol/msg"
+	"github.com/openimsdk/protocol/sdkws"
+	pbuser "github.com/openimsdk/protocol/user"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/mcontext"
+	"github.com/openimsdk/tools/utils/datautil"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	defaultBroadcastBatchSize    = 500
+	defaultBroadcastConcurrency  = 20
+	defaultBroadcastLeaseSeconds = 60
+	// broadcastPollInterval is how often idle workers look for pending or abandoned jobs.
+	broadcastPollInterval = 5 * time.Second
+)
+
+func (m *msgServer) SubmitBroadcast(ctx context.Context, req *msgext.SubmitBroadcastReq) (*msgext.SubmitBroadcastResp, error) {
+	if err := authverify.CheckAdmin(ctx, m.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	switch req.MsgData.SessionType {
+	case constant.SingleChatType, constant.NotificationChatType:
+	default:
+		return nil, errs.ErrArgs.WrapMsg("broadcast only supports single chat and notification messages")
+	}
+	data, err := proto.Marshal(req.MsgData)
+	if err != nil {
+		return nil, errs.WrapMsg(err, "marshal msg data failed")
+	}
+	now := time.Now()
+	job := &model.BroadcastJob{
+		JobID:          uuid.NewString(),
+		Mode:           req.Mode,
+		FilterUserID:   req.FilterUserID,
+		FilterNickname: req.FilterNickname,
+		Msg:            data,
+		Status:         model.BroadcastStatusPending,
+		CreatorUserID:  mcontext.GetOpUserID(ctx),
+		OperationID:    mcontext.GetOperationID(ctx),
+		CreateTime:     now,
+		UpdateTime:     now,
+	}
+	var recvIDs []string
+	if req.Mode == msgext.BroadcastModeUsers {
+		recvIDs = datautil.Distinct(req.RecvIDs)
+		job.Total = int64(len(recvIDs))
+	}
+	if err := m.broadcastDatabase.CreateBroadcast(ctx, job, recvIDs); err != nil {
+		return nil, err
+	}
+	if err := m.MsgDatabase.SetSendMsgStatus(ctx, job.OperationID, constant.MsgIsSending); err != nil {
+		log.ZWarn(ctx, "set broadcast send status failed", err, "jobID", job.JobID)
+	}
+	m.wakeBroadcastWorker()
+	return &msgext.SubmitBroadcastResp{Job: convertBroadcastJob(job)}, nil
+}
+
+func (m *msgServer) GetBroadcast(ctx context.Context, req *msgext.GetBroadcastReq) (*msgext.GetBroadcastResp, error) {
+	if err := authverify.CheckAdmin(ctx, m.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	job, err := m.broadcastDatabase.TakeBroadcast(ctx, req.JobID)
+	if err != nil {
+		return nil, err
+	}
+	return &msgext.GetBroadcastResp{Job: convertBroadcastJob(job)}, nil
+}
+
+func (m *msgServer) GetBroadcasts(ctx context.Context, req *msgext.GetBroadcastsReq) (*msgext.GetBroadcastsResp, error) {
+	if err := authverify.CheckAdmin(ctx, m.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	total, jobs, err := m.broadcastDatabase.PageBroadcasts(ctx, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &msgext.GetBroadcastsResp{Total: total, Jobs: datautil.Slice(jobs, convertBroadcastJob)}, nil
+}
+
+func (m *msgServer) GetBroadcastFailedIDs(ctx context.Context, req *msgext.GetBroadcastFailedIDsReq) (*msgext.GetBroadcastFailedIDsResp, error) {
+	if err := authverify.CheckAdmin(ctx, m.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	total, failed, err := m.broadcastDatabase.PageBroadcastFailed(ctx, req.JobID, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &msgext.GetBroadcastFailedIDsResp{
+		Total: total,
+		Failed: datautil.Slice(failed, func(e *model.BroadcastFailed) *msgext.BroadcastFailed {
+			return &msgext.BroadcastFailed{UserID: e.UserID, Error: e.Error}
+		}),
+	}, nil
+}
+
+func (m *msgServer) CancelBroadcast(ctx context.Context, req *msgext.CancelBroadcastReq) (*msgext.CancelBroadcastResp, error) {
+	if err := authverify.CheckAdmin(ctx, m.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	job, err := m.broadcastDatabase.TakeBroadcast(ctx, req.JobID)
+	if err != nil {
+		return nil, err
+	}
+	switch job.Status {
+	case model.BroadcastStatusCompleted, model.BroadcastStatusCanceled, model.BroadcastStatusFailed:
+		return nil, errs.ErrArgs.WrapMsg("broadcast job is already finished", "jobID", req.JobID)
+	}
+	// the worker notices the cancellation when it renews its lease or saves the progress of its current batch
+	if err := m.broadcastDatabase.CancelBroadcast(ctx, req.JobID); err != nil {
+		return nil, err
+	}
+	if err := m.MsgDatabase.SetSendMsgStatus(ctx, job.OperationID, constant.MsgSendFailed); err != nil {
+		log.ZWarn(ctx, "set broadcast send status failed", err, "jobID", job.JobID)
+	}
+	return &msgext.CancelBroadcastResp{}, nil
+}
+
+func convertBroadcastJob(job *model.BroadcastJob) *msgext.BroadcastJob {
+	var finishTime int64
+	if !job.FinishTime.IsZero() {
+		finishTime = job.FinishTime.UnixMilli()
+	}
+	return &msgext.BroadcastJob{
+		JobID:          job.JobID,
+		Mode:           job.Mode,
+		Status:         job.Status,
+		Total:          job.Total,
+		Sent:           job.Sent,
+		Failed:         job.Failed,
+		CreatorUserID:  job.CreatorUserID,
+		OperationID:    job.OperationID,
+		CreateTime:     job.CreateTime.UnixMilli(),
+		UpdateTime:     job.UpdateTime.UnixMilli(),
+		FinishTime:     finishTime,
+		FilterUserID:   job.FilterUserID,
+		FilterNickname: job.FilterNickname,
+		Error:          job.Error,
+	}
+}
+
+func (m *msgServer) wakeBroadcastWorker() {
+	select {
+	case m.broadcastWake <- struct{}{}:
+	default:
+	}
+}
+
+// broadcastWorker runs pending broadcast jobs, and jobs whose worker stopped renewing its lease,
+// so that jobs interrupted by a restart resume from their saved cursor.
+func (m *msgServer) broadcastWorker(ctx context.Context) {
+	ticker := time.NewTicker(broadcastPollInterval)
+	defer ticker.Stop()
+	for {
+		m.runBroadcastJobs(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-m.broadcastWake:
+		}
+	}
+}
+
+func (m *msgServer) runBroadcastJobs(ctx context.Context) {
+	for ctx.Err() == nil {
+		job, err := m.broadcastDatabase.AcquireBroadcast(ctx, m.broadcastOwner, m.broadcastLease())
+		if err != nil {
+			if !mgo.IsNotFound(err) {
+				log.ZError(ctx, "acquire broadcast job failed", err)
+			}
+			return
+		}
+		m.runBroadcast(job)
+	}
+}
+
+func (m *msgServer) runBroadcast(job *model.BroadcastJob) {
+	ctx := mcontext.WithMustInfoCtx([]string{job.OperationID, job.CreatorUserID, constant.PlatformIDToName(constant.AdminPlatformID), ""})
+	log.ZInfo(ctx, "broadcast job started", "jobID", job.JobID, "cursor", job.Cursor)
+	var msgData sdkws.MsgData
+	if err := proto.Unmarshal(job.Msg, &msgData); err != nil {
+		log.ZError(ctx, "unmarshal broadcast msg failed", err, "jobID", job.JobID)
+		m.failBroadcast(ctx, job, err)
+		return
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go m.keepBroadcastLease(ctx, cancel, job.JobID)
+	conf := m.config.RpcConfig.Broadcast
+	batchSize := datautil.If(conf.BatchSize > 0, conf.BatchSize, defaultBroadcastBatchSize)
+	concurrency := datautil.If(conf.Concurrency > 0, conf.Concurrency, defaultBroadcastConcurrency)
+	for {
+		recvIDs, total, err := m.broadcastRecvIDs(ctx, job, batchSize)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			if errs.ErrArgs.Is(err) {
+				m.failBroadcast(ctx, job, err)
+				return
+			}
+			// the lease expires and the job is retried from its cursor
+			log.ZError(ctx, "get broadcast recipients failed", err, "jobID", job.JobID)
+			return
+		}
+		job.Total = total
+		if len(recvIDs) == 0 {
+			break
+		}
+		sent, failed := m.sendBroadcastBatch(ctx, job.JobID, &msgData, recvIDs, concurrency)
+		if ctx.Err() != nil {
+			// the job was canceled or taken over while the batch was sent
+			return
+		}
+		job.Cursor += int64(len(recvIDs))
+		job.Sent += sent
+		job.Failed += int64(len(failed))
+		if err := m.broadcastDatabase.SaveBroadcastProgress(ctx, job, m.broadcastOwner, sent, failed, m.broadcastLease()); err != nil {
+			if mgo.IsNotFound(err) {
+				log.ZInfo(ctx, "broadcast job stopped, canceled or taken over", "jobID", job.JobID)
+			} else {
+				log.ZError(ctx, "save broadcast progress failed", err, "jobID", job.JobID)
+			}
+			return
+		}
+	}
+	if err := m.broadcastDatabase.FinishBroadcast(ctx, job.JobID, m.broadcastOwner); err != nil {
+		log.ZError(ctx, "finish broadcast job failed", err, "jobID", job.JobID)
+		return
+	}
+	status := int32(constant.MsgSendSuccessed)
+	if job.Failed > 0 {
+		status = constant.MsgSendFailed
+	}
+	if err := m.MsgDatabase.SetSendMsgStatus(ctx, job.OperationID, status); err != nil {
+		log.ZWarn(ctx, "set broadcast send status failed", err, "jobID", job.JobID)
+	}
+	log.ZInfo(ctx, "broadcast job completed", "jobID", job.JobID, "total", job.Total, "sent", job.Sent, "failed", job.Failed)
+}
+
+// keepBroadcastLease renews the lease of jobID until ctx is done, independently of how long a batch takes,
+// and cancels the job once it was canceled or taken over by another worker.
+func (m *msgServer) keepBroadcastLease(ctx context.Context, cancel context.CancelFunc, jobID string) {
+	lease := m.broadcastLease()
+	ticker := time.NewTicker(lease / 3)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		if err := m.broadcastDatabase.RenewBroadcastLease(ctx, jobID, m.broadcastOwner, lease); err != nil {
+			if mgo.IsNotFound(err) {
+				log.ZInfo(ctx, "broadcast job stopped, canceled or taken over", "jobID", jobID)
+				cancel()
+				return
+			}
+			log.ZWarn(ctx, "renew broadcast lease failed", err, "jobID", jobID)
+		}
+	}
+}
+
+// failBroadcast stops a job that cannot run, retrying it after its lease expired would fail the same way.
+func (m *msgServer) failBroadcast(ctx context.Context, job *model.BroadcastJob, cause error) {
+	if err := m.broadcastDatabase.FailBroadcast(ctx, job.JobID, m.broadcastOwner, cause.Error()); err != nil {
+		log.ZError(ctx, "fail broadcast job failed", err, "jobID", job.JobID)
+		return
+	}
+	if err := m.MsgDatabase.SetSendMsgStatus(ctx, job.OperationID, constant.MsgSendFailed); err != nil {
+		log.ZWarn(ctx, "set broadcast send status failed", err, "jobID", job.JobID)
+	}
+}
+
+// broadcastRecvIDs returns the next batch of recipients after the cursor of job and the total number of recipients.
+func (m *msgServer) broadcastRecvIDs(ctx context.Context, job *model.BroadcastJob, batchSize int) ([]string, int64, error) {
+	// the batch size may have changed since the cursor was saved, so skip into the page containing it
+	pagination := &sdkws.RequestPagination{PageNumber: int32(job.Cursor/int64(batchSize)) + 1, ShowNumber: int32(batchSize)}
+	skip := int(job.Cursor % int64(batchSize))
+	var (
+		recvIDs []string
+		total   int64
+	)
+	switch job.Mode {
+	case msgext.BroadcastModeUsers:
+		recvIDs, err := m.broadcastDatabase.FindBroadcastRecipients(ctx, job.JobID, job.Cursor, batchSize)
+		if err != nil {
+			return nil, 0, err
+		}
+		return recvIDs, job.Total, nil
+	case msgext.BroadcastModeAll:
+		resp, err := m.User.Client.GetAllUserID(ctx, &pbuser.GetAllUserIDReq{Pagination: pagination})
+		if err != nil {
+			return nil, 0, err
+		}
+		recvIDs, total = resp.UserIDs, int64(resp.Total)
+	case msgext.BroadcastModeFilter:
+		resp, err := m.User.Client.GetPaginationUsers(ctx, &pbuser.GetPaginationUsersReq{
+			Pagination: pagination,
+			UserID:     job.FilterUserID,
+			NickName:   job.FilterNickname,
+		})
+		if err != nil {
+			return nil, 0, err
+		}
+		recvIDs = datautil.Slice(resp.Users, func(e *sdkws.UserInfo) string { return e.UserID })
+		total = int64(resp.Total)
+	default:
+		return nil, 0, errs.ErrArgs.WrapMsg("unknown broadcast mode", "mode", job.Mode)
+	}
+	if skip >= len(recvIDs) {
+		return nil, total, nil
+	}
+	return recvIDs[skip:], total, nil
+}
+
+func (m *msgServer) sendBroadcastBatch(ctx context.Context, jobID string, msgData *sdkws.MsgData, recvIDs []string, concurrency int) (int64, []*model.BroadcastFailed) {
+	var (
+		lock   sync.Mutex
+		sent   int64
+		failed []*model.BroadcastFailed
+	)
+	g := errgroup.Group{}
+	g.SetLimit(concurrency)
+	for _, recvID := range recvIDs {
+		recvID := recvID
+		g.Go(func() error {
+			if ctx.Err() != nil {
+				return nil
+			}
+			data := proto.Clone(msgData).(*sdkws.MsgData)
+			data.RecvID = recvID
+			_, err := m.SendMsg(ctx, &pbmsg.SendMsgReq{MsgData: data})
+			lock.Lock()
+			defer lock.Unlock()
+			if err != nil {
+				log.ZWarn(ctx, "broadcast send msg failed", err, "jobID", jobID, "recvID", recvID)
+				failed = append(failed, &model.BroadcastFailed{JobID: jobID, UserID: recvID, Error: err.Error(), CreateTime: time.Now()})
+			} else {
+				sent++
+			}
+			return nil
+		})
+	}
+	_ = g.Wait()
+	return sent, failed
+}
+
+func (m *msgServer) broadcastLease() time.Duration {
+	seconds := m.config.RpcConfig.Broadcast.LeaseSeconds
+	if seconds <= 0 {
+		seconds = defaultBroadcastLeaseSeconds
+	}
+	return time.Duration(seconds) * time.Second
+}
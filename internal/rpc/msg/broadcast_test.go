@@ -0,0 +1,136 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msg
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/controller"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/msgext"
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type broadcastDatabaseStub struct {
+	controller.BroadcastDatabase
+	mu         sync.Mutex
+	recipients []string
+	renewed    int
+	// renewLimit is how many renewals succeed before the job looks canceled.
+	renewLimit int
+	failed     map[string]string
+}
+
+func (b *broadcastDatabaseStub) RenewBroadcastLease(ctx context.Context, jobID string, owner string, lease time.Duration) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.renewed++
+	if b.renewed > b.renewLimit {
+		return errs.Wrap(mongo.ErrNoDocuments)
+	}
+	return nil
+}
+
+func (b *broadcastDatabaseStub) FailBroadcast(ctx context.Context, jobID string, owner string, errMsg string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.failed[jobID] = errMsg
+	return nil
+}
+
+func (b *broadcastDatabaseStub) FindBroadcastRecipients(ctx context.Context, jobID string, cursor int64, limit int) ([]string, error) {
+	start := min(int(cursor), len(b.recipients))
+	return b.recipients[start:min(start+limit, len(b.recipients))], nil
+}
+
+type sendStatusStub struct {
+	controller.CommonMsgDatabase
+	status map[string]int32
+}
+
+func (s *sendStatusStub) SetSendMsgStatus(ctx context.Context, id string, status int32) error {
+	s.status[id] = status
+	return nil
+}
+
+func newBroadcastTestServer(db *broadcastDatabaseStub) (*msgServer, *sendStatusStub) {
+	status := &sendStatusStub{status: make(map[string]int32)}
+	m := &msgServer{
+		MsgDatabase:       status,
+		broadcastDatabase: db,
+		broadcastOwner:    "worker-1",
+		config:            &Config{},
+	}
+	m.config.RpcConfig.Broadcast.LeaseSeconds = 1
+	return m, status
+}
+
+func TestRunBroadcastInvalidPayload(t *testing.T) {
+	db := &broadcastDatabaseStub{failed: make(map[string]string)}
+	m, status := newBroadcastTestServer(db)
+	// a length prefix pointing past the end of the data
+	m.runBroadcast(&model.BroadcastJob{JobID: "job-1", OperationID: "op-1", Msg: []byte{0x0a, 0xff}})
+	if db.failed["job-1"] == "" {
+		t.Fatal("job with an invalid payload not marked failed")
+	}
+	if status.status["op-1"] != constant.MsgSendFailed {
+		t.Fatalf("send status %d, want failed", status.status["op-1"])
+	}
+}
+
+func TestKeepBroadcastLease(t *testing.T) {
+	db := &broadcastDatabaseStub{renewLimit: 2}
+	m, _ := newBroadcastTestServer(db)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		m.keepBroadcastLease(ctx, cancel, "job-1")
+	}()
+	select {
+	case <-ctx.Done():
+	case <-time.After(5 * time.Second):
+		t.Fatal("job not stopped after its lease was lost")
+	}
+	<-done
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	if db.renewed != 3 {
+		t.Fatalf("lease renewed %d times, want 2 renewals and the failed one", db.renewed)
+	}
+}
+
+func TestBroadcastRecvIDsByCursor(t *testing.T) {
+	db := &broadcastDatabaseStub{recipients: []string{"u1", "u2", "u3", "u4", "u5"}}
+	m, _ := newBroadcastTestServer(db)
+	job := &model.BroadcastJob{JobID: "job-1", Mode: msgext.BroadcastModeUsers, Total: 5, Cursor: 3}
+	recvIDs, total, err := m.broadcastRecvIDs(context.Background(), job, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if total != 5 || len(recvIDs) != 2 || recvIDs[0] != "u4" || recvIDs[1] != "u5" {
+		t.Fatalf("unexpected recipients %v of %d", recvIDs, total)
+	}
+	job.Mode = 99
+	if _, _, err := m.broadcastRecvIDs(context.Background(), job, 2); !errs.ErrArgs.Is(err) {
+		t.Fatalf("unknown mode: got %v, want args error", err)
+	}
+}
@@ -35,6 +35,9 @@ func (m *msgServer) ClearMsg(ctx context.Context, req *msg.ClearMsgReq) (_ *msg.
 				if err := m.Conversation.UpdateConversations(ctx, req); err != nil {
 					log.ZError(ctx, "update conversation max seq failed", err, "conversationID", conversationID, "msgDestructTime", req.MsgDestructTime)
 				}
+				if err := m.unpinDeletedMsgs(ctx, conversationID); err != nil {
+					log.ZError(ctx, "unpin deleted msgs failed", err, "conversationID", conversationID)
+				}
 			}
 		}()
 		msgs, err := m.MsgDatabase.GetBeforeMsg(ctx, req.Timestamp, 100)
@@ -18,12 +18,15 @@ import (
 	"context"
 
 	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/msgext"
 	"github.com/openimsdk/protocol/constant"
 	"github.com/openimsdk/protocol/conversation"
 	"github.com/openimsdk/protocol/msg"
 	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/tools/errs"
 	"github.com/openimsdk/tools/log"
 	"github.com/openimsdk/tools/utils/timeutil"
+	"github.com/redis/go-redis/v9"
 )
 
 func (m *msgServer) getMinSeqs(maxSeqs map[string]int64) map[string]int64 {
@@ -91,6 +94,13 @@ func (m *msgServer) DeleteMsgs(ctx context.Context, req *msg.DeleteMsgsReq) (*ms
 		if err := m.MsgDatabase.DeleteUserMsgsBySeqs(ctx, req.UserID, req.ConversationID, req.Seqs); err != nil {
 			return nil, err
 		}
+		hasReadSeq, err := m.MsgDatabase.GetHasReadSeq(ctx, req.UserID, req.ConversationID)
+		if err != nil && errs.Unwrap(err) != redis.Nil {
+			return nil, err
+		}
+		if err := m.unreadDatabase.DeleteUnreadSeqs(ctx, req.UserID, req.ConversationID, hasReadSeq, req.Seqs); err != nil {
+			log.ZWarn(ctx, "delete unread seqs error", err, "userID", req.UserID, "conversationID", req.ConversationID)
+		}
 		if isSyncSelf {
 			tips := &sdkws.DeleteMsgsTips{UserID: req.UserID, ConversationID: req.ConversationID, Seqs: req.Seqs}
 			m.notificationSender.NotificationWithSessionType(ctx, req.UserID, req.UserID, constant.DeleteMsgsNotification, constant.SingleChatType, tips)
@@ -104,6 +114,9 @@ func (m *msgServer) DeleteMsgPhysicalBySeq(ctx context.Context, req *msg.DeleteM
 	if err != nil {
 		return nil, err
 	}
+	if err := m.msgPinDatabase.UnpinMsgs(ctx, req.ConversationID, req.Seqs); err != nil {
+		return nil, err
+	}
 	return &msg.DeleteMsgPhysicalBySeqResp{}, nil
 }
 
@@ -115,11 +128,24 @@ func (m *msgServer) DeleteMsgPhysical(ctx context.Context, req *msg.DeleteMsgPhy
 	for _, conversationID := range req.ConversationIDs {
 		if err := m.MsgDatabase.DeleteConversationMsgsAndSetMinSeq(ctx, conversationID, remainTime); err != nil {
 			log.ZWarn(ctx, "DeleteConversationMsgsAndSetMinSeq error", err, "conversationID", conversationID, "err", err)
+			continue
+		}
+		if err := m.unpinDeletedMsgs(ctx, conversationID); err != nil {
+			log.ZWarn(ctx, "unpinDeletedMsgs error", err, "conversationID", conversationID)
 		}
 	}
 	return &msg.DeleteMsgPhysicalResp{}, nil
 }
 
+// unpinDeletedMsgs removes the pins of the messages below the min seq of the conversation, the ones deleted physically.
+func (m *msgServer) unpinDeletedMsgs(ctx context.Context, conversationID string) error {
+	minSeq, err := m.MsgDatabase.GetMinSeq(ctx, conversationID)
+	if err != nil {
+		return err
+	}
+	return m.msgPinDatabase.UnpinMsgsBefore(ctx, conversationID, minSeq)
+}
+
 func (m *msgServer) clearConversation(ctx context.Context, conversationIDs []string, userID string, deleteSyncOpt *msg.DeleteSyncOpt) error {
 	conversations, err := m.Conversation.GetConversationsByConversationID(ctx, conversationIDs)
 	if err != nil {
@@ -158,5 +184,24 @@ func (m *msgServer) clearConversation(ctx context.Context, conversationIDs []str
 	if err := m.MsgDatabase.UserSetHasReadSeqs(ctx, userID, maxSeqs); err != nil {
 		return err
 	}
+	for conversationID, maxSeq := range maxSeqs {
+		m.resetUnreadCount(ctx, userID, conversationID, maxSeq, maxSeq)
+	}
 	return nil
 }
+
+func (m *msgServer) AnonymizeUserMsgs(ctx context.Context, req *msgext.AnonymizeUserMsgsReq) (*msgext.AnonymizeUserMsgsResp, error) {
+	if err := authverify.CheckAdmin(ctx, m.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	conversationIDs, err := m.Conversation.GetConversationIDs(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	count, err := m.MsgDatabase.AnonymizeUserMsgs(ctx, req.UserID, conversationIDs, req.Nickname)
+	if err != nil {
+		return nil, err
+	}
+	log.ZInfo(ctx, "anonymized user msgs", "userID", req.UserID, "conversations", len(conversationIDs), "docs", count)
+	return &msgext.AnonymizeUserMsgsResp{Count: count}, nil
+}
@@ -0,0 +1,163 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msg
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/controller"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/msgext"
+	"github.com/openimsdk/open-im-server/v3/pkg/rpcclient"
+	"github.com/openimsdk/protocol/constant"
+	pbconversation "github.com/openimsdk/protocol/conversation"
+	"github.com/openimsdk/protocol/msg"
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/tools/mcontext"
+	"google.golang.org/grpc"
+)
+
+type physicalDeleteStub struct {
+	controller.CommonMsgDatabase
+	minSeqs map[string]int64
+}
+
+func (p *physicalDeleteStub) DeleteConversationMsgsAndSetMinSeq(ctx context.Context, conversationID string, remainTime int64) error {
+	if _, ok := p.minSeqs[conversationID]; !ok {
+		return errors.New("delete failed")
+	}
+	return nil
+}
+
+func (p *physicalDeleteStub) GetMinSeq(ctx context.Context, conversationID string) (int64, error) {
+	return p.minSeqs[conversationID], nil
+}
+
+type msgPinStub struct {
+	controller.MsgPinDatabase
+	unpinnedBefore map[string]int64
+	pins           []*model.MsgPin
+}
+
+func (p *msgPinStub) FindMsgPins(ctx context.Context, conversationID string) ([]*model.MsgPin, error) {
+	return p.pins, nil
+}
+
+func (p *msgPinStub) PinMsg(ctx context.Context, pin *model.MsgPin) error {
+	p.pins = append(p.pins, pin)
+	return nil
+}
+
+func (p *msgPinStub) UnpinMsgsBefore(ctx context.Context, conversationID string, minSeq int64) error {
+	p.unpinnedBefore[conversationID] = minSeq
+	return nil
+}
+
+func TestDeleteMsgPhysicalUnpins(t *testing.T) {
+	pins := &msgPinStub{unpinnedBefore: make(map[string]int64)}
+	m := &msgServer{
+		MsgDatabase:    &physicalDeleteStub{minSeqs: map[string]int64{"si_a_b": 11, "sg_g1": 101}},
+		msgPinDatabase: pins,
+		config:         &Config{},
+	}
+	m.config.Share.IMAdminUserID = []string{"admin"}
+	req := &msg.DeleteMsgPhysicalReq{ConversationIDs: []string{"si_a_b", "sg_failed", "sg_g1"}, Timestamp: 1}
+	if _, err := m.DeleteMsgPhysical(mcontext.SetOpUserID(context.Background(), "user"), req); err == nil {
+		t.Fatal("non admin deleted messages")
+	}
+	if _, err := m.DeleteMsgPhysical(mcontext.SetOpUserID(context.Background(), "admin"), req); err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]int64{"si_a_b": 11, "sg_g1": 101}
+	if !reflect.DeepEqual(pins.unpinnedBefore, want) {
+		t.Fatalf("unpinned %v, want %v", pins.unpinnedBefore, want)
+	}
+}
+
+type clearMsgStub struct {
+	physicalDeleteStub
+	docs []*model.MsgDocModel
+}
+
+func (c *clearMsgStub) GetBeforeMsg(ctx context.Context, ts int64, limit int) ([]*model.MsgDocModel, error) {
+	docs := c.docs
+	c.docs = nil
+	return docs, nil
+}
+
+func (c *clearMsgStub) DeleteDocMsgBefore(ctx context.Context, ts int64, doc *model.MsgDocModel) ([]int, error) {
+	return []int{0}, nil
+}
+
+type updateConversationStub struct {
+	pbconversation.ConversationClient
+}
+
+func (updateConversationStub) UpdateConversation(ctx context.Context, in *pbconversation.UpdateConversationReq, opts ...grpc.CallOption) (*pbconversation.UpdateConversationResp, error) {
+	return &pbconversation.UpdateConversationResp{}, nil
+}
+
+func TestClearMsgUnpins(t *testing.T) {
+	pins := &msgPinStub{unpinnedBefore: make(map[string]int64)}
+	m := &msgServer{
+		MsgDatabase: &clearMsgStub{
+			physicalDeleteStub: physicalDeleteStub{minSeqs: map[string]int64{"si_a_b": 11, "sg_g1": 101}},
+			docs:               []*model.MsgDocModel{{DocID: "si_a_b:0"}, {DocID: "sg_g1:0"}},
+		},
+		Conversation:   &rpcclient.ConversationRpcClient{Client: updateConversationStub{}},
+		msgPinDatabase: pins,
+		config:         &Config{},
+	}
+	m.config.Share.IMAdminUserID = []string{"admin"}
+	if _, err := m.ClearMsg(mcontext.SetOpUserID(context.Background(), "admin"), &msg.ClearMsgReq{Timestamp: 1}); err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]int64{"si_a_b": 11, "sg_g1": 101}
+	if !reflect.DeepEqual(pins.unpinnedBefore, want) {
+		t.Fatalf("unpinned %v, want %v", pins.unpinnedBefore, want)
+	}
+}
+
+type pinMsgStub struct {
+	physicalDeleteStub
+}
+
+func (p *pinMsgStub) GetMsgBySeqs(ctx context.Context, userID string, conversationID string, seqs []int64) (int64, int64, []*sdkws.MsgData, error) {
+	return 0, 0, []*sdkws.MsgData{{SendID: "a", RecvID: "b", Seq: seqs[0], SessionType: constant.SingleChatType}}, nil
+}
+
+func TestPinMsgSkipsDeletedPins(t *testing.T) {
+	pins := &msgPinStub{pins: []*model.MsgPin{{ConversationID: "si_a_b", Seq: 5}, {ConversationID: "si_a_b", Seq: 12}}}
+	m := &msgServer{
+		MsgDatabase:    &pinMsgStub{physicalDeleteStub{minSeqs: map[string]int64{"si_a_b": 11}}},
+		msgPinDatabase: pins,
+		config:         &Config{},
+	}
+	m.config.RpcConfig.Pin.MaxPerConversation = 2
+	m.notificationSender = rpcclient.NewNotificationSender(&config.Notification{}, rpcclient.WithLocalSendMsg(func(ctx context.Context, req *msg.SendMsgReq) (*msg.SendMsgResp, error) {
+		return &msg.SendMsgResp{}, nil
+	}))
+	ctx := mcontext.SetOpUserID(context.Background(), "a")
+	if _, err := m.PinMsg(ctx, &msgext.PinMsgReq{UserID: "a", ConversationID: "si_a_b", Seq: 13}); err != nil {
+		t.Fatalf("pin of a deleted message counted: %v", err)
+	}
+	if _, err := m.PinMsg(ctx, &msgext.PinMsgReq{UserID: "a", ConversationID: "si_a_b", Seq: 14}); err == nil {
+		t.Fatal("pinned more than the limit")
+	}
+}
@@ -0,0 +1,91 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msg
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"regexp"
+	"time"
+	"unicode/utf8"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/servererrs"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/groupext"
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/tools/utils/datautil"
+)
+
+var linkRegexp = regexp.MustCompile(`(?i)(https?://|www\.)\S+`)
+
+// attachmentContentTypes are the content types rejected by groups disallowing attachments.
+var attachmentContentTypes = []int32{constant.Picture, constant.Voice, constant.Video, constant.File}
+
+// msgText returns the text typed by the sender of text bearing messages.
+func msgText(msgData *sdkws.MsgData) string {
+	var content struct {
+		Content string `json:"content"`
+		Text    string `json:"text"`
+	}
+	switch msgData.ContentType {
+	case constant.Text:
+		if err := json.Unmarshal(msgData.Content, &content); err != nil {
+			return string(msgData.Content)
+		}
+		return content.Content
+	case constant.AtText, constant.Quote, constant.AdvancedText:
+		if err := json.Unmarshal(msgData.Content, &content); err != nil {
+			return ""
+		}
+		return content.Text
+	default:
+		return ""
+	}
+}
+
+// checkGroupMsgPolicy enforces the content limits of the group, slow mode is left to acquireSlowMode.
+func checkGroupMsgPolicy(msgData *sdkws.MsgData, policy *groupext.GroupMsgPolicy) error {
+	if policy.DisallowAttachments && datautil.Contain(msgData.ContentType, attachmentContentTypes...) {
+		return servererrs.ErrAttachmentNotAllowed.Wrap()
+	}
+	if text := msgText(msgData); text != "" {
+		if length := utf8.RuneCountInString(text); policy.MaxMsgLength > 0 && length > int(policy.MaxMsgLength) {
+			return servererrs.ErrMsgTooLong.WrapMsg("message too long", "length", length, "maxMsgLength", policy.MaxMsgLength)
+		}
+		if policy.DisallowLinks && linkRegexp.MatchString(text) {
+			return servererrs.ErrLinkNotAllowed.Wrap()
+		}
+	}
+	return nil
+}
+
+// acquireSlowMode records the message of the sender in slow mode, or rejects it when the sender sent one less than
+// interval ago. It runs after every other check so rejected messages do not hold the sender back.
+func (m *msgServer) acquireSlowMode(ctx context.Context, msgData *sdkws.MsgData, interval time.Duration) error {
+	if interval <= 0 {
+		return nil
+	}
+	wait, err := m.slowModeCache.AcquireSlowMode(ctx, msgData.GroupID, msgData.SendID, interval)
+	if err != nil {
+		return err
+	}
+	if wait > 0 {
+		// Rounded up so the countdown shown by clients never reaches 0 early.
+		remainSeconds := int64(math.Ceil(wait.Seconds()))
+		return servererrs.ErrSlowMode.WrapMsg("slow mode", "remainSeconds", remainSeconds, "slowModeSeconds", int64(interval.Seconds()))
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msg
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/servererrs"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/groupext"
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/tools/errs"
+)
+
+type slowModeCacheStub struct {
+	last     map[string]time.Time
+	acquired int
+}
+
+func (s *slowModeCacheStub) AcquireSlowMode(ctx context.Context, groupID string, userID string, interval time.Duration) (time.Duration, error) {
+	s.acquired++
+	key := groupID + ":" + userID
+	if last, ok := s.last[key]; ok && time.Since(last) < interval {
+		return interval - time.Since(last), nil
+	}
+	s.last[key] = time.Now()
+	return 0, nil
+}
+
+func TestCheckGroupMsgPolicy(t *testing.T) {
+	policy := &groupext.GroupMsgPolicy{MaxMsgLength: 5, DisallowLinks: true, DisallowAttachments: true}
+	cases := []struct {
+		msg *sdkws.MsgData
+		err errs.CodeError
+	}{
+		{&sdkws.MsgData{ContentType: constant.Text, Content: []byte(`{"content":"hello"}`)}, nil},
+		{&sdkws.MsgData{ContentType: constant.Text, Content: []byte(`{"content":"hello!"}`)}, servererrs.ErrMsgTooLong},
+		{&sdkws.MsgData{ContentType: constant.AtText, Content: []byte(`{"text":"www.a"}`)}, servererrs.ErrLinkNotAllowed},
+		{&sdkws.MsgData{ContentType: constant.Picture}, servererrs.ErrAttachmentNotAllowed},
+		{&sdkws.MsgData{ContentType: constant.Card, Content: []byte(strings.Repeat("x", 10))}, nil},
+	}
+	for i, c := range cases {
+		err := checkGroupMsgPolicy(c.msg, policy)
+		if c.err == nil && err != nil {
+			t.Errorf("case %d: %v", i, err)
+		}
+		if c.err != nil && !c.err.Is(err) {
+			t.Errorf("case %d: %v, want %v", i, err, c.err)
+		}
+	}
+}
+
+func TestAcquireSlowMode(t *testing.T) {
+	cache := &slowModeCacheStub{last: make(map[string]time.Time)}
+	m := &msgServer{slowModeCache: cache}
+	msgData := &sdkws.MsgData{GroupID: "g1", SendID: "u1"}
+	if err := m.acquireSlowMode(context.Background(), msgData, 0); err != nil || cache.acquired != 0 {
+		t.Fatalf("slow mode disabled: %v, acquired %d", err, cache.acquired)
+	}
+	if err := m.acquireSlowMode(context.Background(), msgData, time.Minute); err != nil {
+		t.Fatal(err)
+	}
+	err := m.acquireSlowMode(context.Background(), msgData, time.Minute)
+	if !servererrs.ErrSlowMode.Is(err) {
+		t.Fatalf("second message within the interval: %v", err)
+	}
+}
@@ -0,0 +1,230 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msg
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/servererrs"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/msgext"
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/utils/datautil"
+)
+
+// getPinnableMsg returns the message of seq as seen by userID, revoked and deleted messages cannot be pinned.
+func (m *msgServer) getPinnableMsg(ctx context.Context, userID string, conversationID string, seq int64) (*sdkws.MsgData, error) {
+	_, _, msgs, err := m.MsgDatabase.GetMsgBySeqs(ctx, userID, conversationID, []int64{seq})
+	if err != nil {
+		return nil, err
+	}
+	if len(msgs) == 0 || msgs[0] == nil {
+		return nil, errs.ErrRecordNotFound.WrapMsg("msg not found")
+	}
+	if msgs[0].ContentType == constant.MsgRevokeNotification {
+		return nil, servererrs.ErrMsgAlreadyRevoke.WrapMsg("msg already revoke")
+	}
+	return msgs[0], nil
+}
+
+// checkPinPermission requires the pin permission in groups, in single chats either party can pin.
+func (m *msgServer) checkPinPermission(ctx context.Context, userID string, msgData *sdkws.MsgData) error {
+	if authverify.IsAppManagerUid(ctx, m.config.Share.IMAdminUserID) {
+		return nil
+	}
+	switch msgData.SessionType {
+	case constant.SingleChatType:
+		if userID != msgData.SendID && userID != msgData.RecvID {
+			return errs.ErrNoPermission.WrapMsg("not a party of the conversation")
+		}
+	case constant.ReadGroupChatType:
+		member, err := m.GroupLocalCache.GetGroupMember(ctx, msgData.GroupID, userID)
+		if err != nil {
+			return err
+		}
+		ok, err := m.GroupLocalCache.HasGroupPermission(ctx, msgData.GroupID, member.RoleLevel, authverify.GroupPermissionPin)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return errs.ErrNoPermission.WrapMsg("no permission to pin messages")
+		}
+	default:
+		return errs.ErrArgs.WrapMsg("msg sessionType not supported")
+	}
+	return nil
+}
+
+func (m *msgServer) pinChangedNotification(ctx context.Context, userID string, msgData *sdkws.MsgData, conversationID string, pinned bool) {
+	tips := &msgext.MsgPinChangedTips{
+		ConversationID: conversationID,
+		Seq:            msgData.Seq,
+		ClientMsgID:    msgData.ClientMsgID,
+		Pinned:         pinned,
+		OpUserID:       userID,
+		ChangeTime:     time.Now().UnixMilli(),
+	}
+	var recvID string
+	switch {
+	case msgData.SessionType == constant.ReadGroupChatType:
+		recvID = msgData.GroupID
+	case userID == msgData.SendID:
+		recvID = msgData.RecvID
+	default:
+		recvID = msgData.SendID
+	}
+	m.notificationSender.NotificationWithSessionType(ctx, userID, recvID, msgext.MsgPinChangedNotification, msgData.SessionType, tips)
+}
+
+func (m *msgServer) PinMsg(ctx context.Context, req *msgext.PinMsgReq) (*msgext.PinMsgResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, m.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	msgData, err := m.getPinnableMsg(ctx, req.UserID, req.ConversationID, req.Seq)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.checkPinPermission(ctx, req.UserID, msgData); err != nil {
+		return nil, err
+	}
+	pins, err := m.msgPinDatabase.FindMsgPins(ctx, req.ConversationID)
+	if err != nil {
+		return nil, err
+	}
+	minSeq, err := m.MsgDatabase.GetMinSeq(ctx, req.ConversationID)
+	if err != nil {
+		return nil, err
+	}
+	var count int
+	for _, pin := range pins {
+		if pin.Seq == req.Seq {
+			return &msgext.PinMsgResp{}, nil
+		}
+		// Pins of messages deleted physically are not counted, even before they are removed.
+		if pin.Seq >= minSeq {
+			count++
+		}
+	}
+	if limit := m.config.RpcConfig.Pin.MaxPerConversation; limit > 0 && count >= limit {
+		return nil, errs.ErrArgs.WrapMsg("too many pinned messages", "max", limit)
+	}
+	pin := &model.MsgPin{
+		ConversationID: req.ConversationID,
+		Seq:            req.Seq,
+		ClientMsgID:    msgData.ClientMsgID,
+		PinnerUserID:   req.UserID,
+		PinTime:        time.Now(),
+	}
+	if err := m.msgPinDatabase.PinMsg(ctx, pin); err != nil {
+		return nil, err
+	}
+	m.pinChangedNotification(ctx, req.UserID, msgData, req.ConversationID, true)
+	return &msgext.PinMsgResp{}, nil
+}
+
+func (m *msgServer) UnpinMsg(ctx context.Context, req *msgext.UnpinMsgReq) (*msgext.UnpinMsgResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, m.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	msgData, err := m.getPinnableMsg(ctx, req.UserID, req.ConversationID, req.Seq)
+	if err != nil && !servererrs.ErrMsgAlreadyRevoke.Is(err) {
+		return nil, err
+	}
+	if msgData != nil {
+		if err := m.checkPinPermission(ctx, req.UserID, msgData); err != nil {
+			return nil, err
+		}
+	} else if !authverify.IsAppManagerUid(ctx, m.config.Share.IMAdminUserID) {
+		return nil, errs.ErrNoPermission.WrapMsg("revoked messages are unpinned automatically")
+	}
+	if err := m.msgPinDatabase.UnpinMsgs(ctx, req.ConversationID, []int64{req.Seq}); err != nil {
+		return nil, err
+	}
+	if msgData != nil {
+		m.pinChangedNotification(ctx, req.UserID, msgData, req.ConversationID, false)
+	}
+	return &msgext.UnpinMsgResp{}, nil
+}
+
+func (m *msgServer) GetPinnedMsgs(ctx context.Context, req *msgext.GetPinnedMsgsReq) (*msgext.GetPinnedMsgsResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, m.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	if !authverify.IsAppManagerUid(ctx, m.config.Share.IMAdminUserID) {
+		conversation, err := m.ConversationLocalCache.GetConversation(ctx, req.UserID, req.ConversationID)
+		if err != nil {
+			return nil, err
+		}
+		if conversation.ConversationType == constant.ReadGroupChatType {
+			if _, err := m.GroupLocalCache.GetGroupMember(ctx, conversation.GroupID, req.UserID); err != nil {
+				return nil, err
+			}
+		}
+	}
+	pins, err := m.msgPinDatabase.FindMsgPins(ctx, req.ConversationID)
+	if err != nil {
+		return nil, err
+	}
+	if len(pins) == 0 {
+		return &msgext.GetPinnedMsgsResp{}, nil
+	}
+	_, _, msgs, err := m.MsgDatabase.GetMsgBySeqs(ctx, req.UserID, req.ConversationID, datautil.Slice(pins, func(e *model.MsgPin) int64 {
+		return e.Seq
+	}))
+	if err != nil {
+		return nil, err
+	}
+	msgMap := make(map[int64]*sdkws.MsgData, len(msgs))
+	for _, msgData := range msgs {
+		if msgData != nil && msgData.ContentType != constant.MsgRevokeNotification {
+			msgMap[msgData.Seq] = msgData
+		}
+	}
+	resp := &msgext.GetPinnedMsgsResp{Pins: make([]*msgext.PinnedMsg, 0, len(pins))}
+	for _, pin := range pins {
+		msgData, ok := msgMap[pin.Seq]
+		if !ok {
+			continue
+		}
+		resp.Pins = append(resp.Pins, &msgext.PinnedMsg{
+			ConversationID: pin.ConversationID,
+			Seq:            pin.Seq,
+			PinnerUserID:   pin.PinnerUserID,
+			PinTime:        pin.PinTime.UnixMilli(),
+			Msg:            msgData,
+		})
+	}
+	return resp, nil
+}
+
+// unpinRevokedMsg drops the pin of a revoked message and tells the conversation about it.
+func (m *msgServer) unpinRevokedMsg(ctx context.Context, userID string, conversationID string, msgData *sdkws.MsgData) error {
+	pins, err := m.msgPinDatabase.FindMsgPins(ctx, conversationID)
+	if err != nil {
+		return err
+	}
+	if !datautil.Contain(msgData.Seq, datautil.Slice(pins, func(e *model.MsgPin) int64 { return e.Seq })...) {
+		return nil
+	}
+	if err := m.msgPinDatabase.UnpinMsgs(ctx, conversationID, []int64{msgData.Seq}); err != nil {
+		return err
+	}
+	m.pinChangedNotification(ctx, userID, msgData, conversationID, false)
+	return nil
+}
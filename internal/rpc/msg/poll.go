@@ -0,0 +1,418 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msg
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/open-im-server/v3/pkg/msgprocessor"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/msgext"
+	"github.com/openimsdk/protocol/constant"
+	pbmsg "github.com/openimsdk/protocol/msg"
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/utils/datautil"
+	"github.com/openimsdk/tools/utils/idutil"
+	"google.golang.org/protobuf/proto"
+)
+
+// closeExpiredPollsBatch is the number of expired polls closed per round.
+const closeExpiredPollsBatch = 100
+
+// checkPollAccess requires userID to be a member of the conversation of the poll.
+func (m *msgServer) checkPollAccess(ctx context.Context, userID string, poll *model.Poll) error {
+	if authverify.IsAppManagerUid(ctx, m.config.Share.IMAdminUserID) {
+		return nil
+	}
+	switch poll.SessionType {
+	case constant.SingleChatType:
+		if userID != poll.SendID && userID != poll.RecvID {
+			return errs.ErrNoPermission.WrapMsg("not a party of the conversation")
+		}
+	case constant.ReadGroupChatType:
+		memberIDs, err := m.GroupLocalCache.GetGroupMemberIDMap(ctx, poll.GroupID)
+		if err != nil {
+			return err
+		}
+		if _, ok := memberIDs[userID]; !ok {
+			return errs.ErrNoPermission.WrapMsg("not in group")
+		}
+	default:
+		return errs.ErrArgs.WrapMsg("poll sessionType not supported")
+	}
+	return nil
+}
+
+// newPollInfo builds the state of a poll from its tallies, votedOptionIDs are those of the user the poll is returned to.
+func newPollInfo(poll *model.Poll, voterCount int64, tallies []*model.PollTally, votedOptionIDs []string) *msgext.PollInfo {
+	tallyMap := datautil.SliceToMap(tallies, func(e *model.PollTally) string {
+		return e.OptionID
+	})
+	info := &msgext.PollInfo{
+		PollID:         poll.PollID,
+		ConversationID: poll.ConversationID,
+		CreatorUserID:  poll.SendID,
+		Question:       poll.Question,
+		Options:        make([]*msgext.PollOption, 0, len(poll.Options)),
+		MultiChoice:    poll.MultiChoice,
+		Anonymous:      poll.Anonymous,
+		Closed:         poll.IsClosed(time.Now()),
+		VoterCount:     voterCount,
+		VotedOptionIDs: votedOptionIDs,
+		CreateTime:     poll.CreateTime.UnixMilli(),
+	}
+	if !poll.CloseTime.IsZero() {
+		info.CloseTime = poll.CloseTime.UnixMilli()
+	}
+	for _, option := range poll.Options {
+		pollOption := &msgext.PollOption{OptionID: option.OptionID, Text: option.Text}
+		if tally, ok := tallyMap[option.OptionID]; ok {
+			pollOption.VoteCount = tally.Count
+			if !poll.Anonymous {
+				pollOption.VoterIDs = tally.UserIDs
+			}
+		}
+		info.Options = append(info.Options, pollOption)
+	}
+	return info
+}
+
+// pollInfos builds the current state of the polls with one round of queries, votedOptionIDs maps pollIDs to the
+// options chosen by the user the polls are returned to.
+func (m *msgServer) pollInfos(ctx context.Context, polls []*model.Poll, votedOptionIDs map[string][]string) ([]*msgext.PollInfo, error) {
+	voterCounts, tallies, err := m.pollDatabase.TallyPolls(ctx, datautil.Slice(polls, func(e *model.Poll) string {
+		return e.PollID
+	}))
+	if err != nil {
+		return nil, err
+	}
+	return datautil.Slice(polls, func(e *model.Poll) *msgext.PollInfo {
+		return newPollInfo(e, voterCounts[e.PollID], tallies[e.PollID], votedOptionIDs[e.PollID])
+	}), nil
+}
+
+func (m *msgServer) pollInfo(ctx context.Context, poll *model.Poll, votedOptionIDs []string) (*msgext.PollInfo, error) {
+	infos, err := m.pollInfos(ctx, []*model.Poll{poll}, map[string][]string{poll.PollID: votedOptionIDs})
+	if err != nil {
+		return nil, err
+	}
+	return infos[0], nil
+}
+
+func (m *msgServer) userPollInfo(ctx context.Context, poll *model.Poll, userID string) (*msgext.PollInfo, error) {
+	votes, err := m.pollDatabase.FindUserPollVotes(ctx, userID, []string{poll.PollID})
+	if err != nil {
+		return nil, err
+	}
+	var votedOptionIDs []string
+	if len(votes) > 0 {
+		votedOptionIDs = votes[0].OptionIDs
+	}
+	return m.pollInfo(ctx, poll, votedOptionIDs)
+}
+
+func (m *msgServer) pollVotedNotification(ctx context.Context, opUserID string, poll *model.Poll, info *msgext.PollInfo) {
+	tips := &msgext.PollVotedTips{Poll: proto.Clone(info).(*msgext.PollInfo), OpUserID: opUserID}
+	tips.Poll.VotedOptionIDs = nil
+	if poll.Anonymous {
+		tips.OpUserID = ""
+	}
+	var sendID, recvID string
+	switch {
+	case poll.SessionType == constant.ReadGroupChatType:
+		sendID, recvID = datautil.If(poll.Anonymous, poll.SendID, opUserID), poll.GroupID
+	case opUserID == poll.RecvID:
+		sendID, recvID = poll.RecvID, poll.SendID
+	default:
+		sendID, recvID = poll.SendID, poll.RecvID
+	}
+	m.notificationSender.NotificationWithSessionType(ctx, sendID, recvID, msgext.PollVotedNotification, poll.SessionType, tips)
+}
+
+// checkPollMsg only lets poll messages created by CreatePoll through, the content of other ones could be forged.
+func (m *msgServer) checkPollMsg(ctx context.Context, msgData *sdkws.MsgData) error {
+	var content msgext.PollInfo
+	if err := json.Unmarshal(msgData.Content, &content); err != nil {
+		return errs.ErrArgs.WrapMsg("poll content is invalid")
+	}
+	poll, err := m.pollDatabase.TakePoll(ctx, content.PollID)
+	if err != nil {
+		return err
+	}
+	if poll.SendID != msgData.SendID || poll.ClientMsgID != msgData.ClientMsgID {
+		return errs.ErrArgs.WrapMsg("poll messages must be sent with createPoll")
+	}
+	return nil
+}
+
+// fillPollMsgs replaces the content of poll messages with the tallies computed by the server.
+func (m *msgServer) fillPollMsgs(ctx context.Context, userID string, msgs []*sdkws.MsgData) {
+	pollMsgs := make(map[string][]*sdkws.MsgData)
+	for _, msgData := range msgs {
+		if msgData == nil || msgData.ContentType != msgext.Poll {
+			continue
+		}
+		var content msgext.PollInfo
+		if err := json.Unmarshal(msgData.Content, &content); err != nil || content.PollID == "" {
+			continue
+		}
+		pollMsgs[content.PollID] = append(pollMsgs[content.PollID], msgData)
+	}
+	if len(pollMsgs) == 0 {
+		return
+	}
+	pollIDs := datautil.Keys(pollMsgs)
+	polls, err := m.pollDatabase.FindPolls(ctx, pollIDs)
+	if err != nil {
+		log.ZWarn(ctx, "find polls failed", err, "pollIDs", pollIDs)
+		return
+	}
+	votes, err := m.pollDatabase.FindUserPollVotes(ctx, userID, pollIDs)
+	if err != nil {
+		log.ZWarn(ctx, "find poll votes failed", err, "pollIDs", pollIDs)
+		return
+	}
+	votedOptionIDs := make(map[string][]string, len(votes))
+	for _, vote := range votes {
+		votedOptionIDs[vote.PollID] = vote.OptionIDs
+	}
+	infos, err := m.pollInfos(ctx, polls, votedOptionIDs)
+	if err != nil {
+		log.ZWarn(ctx, "poll infos failed", err, "pollIDs", pollIDs)
+		return
+	}
+	for _, info := range infos {
+		content, err := json.Marshal(info)
+		if err != nil {
+			continue
+		}
+		for _, msgData := range pollMsgs[info.PollID] {
+			msgData.Content = content
+		}
+	}
+}
+
+func (m *msgServer) CreatePoll(ctx context.Context, req *msgext.CreatePollReq) (*msgext.CreatePollResp, error) {
+	msgData := req.MsgData
+	if err := authverify.CheckAccessV3(ctx, msgData.SendID, m.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	if req.CloseTime > 0 && req.CloseTime <= time.Now().UnixMilli() {
+		return nil, errs.ErrArgs.WrapMsg("closeTime is in the past")
+	}
+	poll := &model.Poll{
+		PollID:      idutil.GetMsgIDByMD5(msgData.SendID),
+		SessionType: msgData.SessionType,
+		GroupID:     msgData.GroupID,
+		SendID:      msgData.SendID,
+		RecvID:      msgData.RecvID,
+		Question:    req.Question,
+		Options:     make([]model.PollOption, 0, len(req.Options)),
+		MultiChoice: req.MultiChoice,
+		Anonymous:   req.Anonymous,
+		CreateTime:  time.Now(),
+	}
+	switch msgData.SessionType {
+	case constant.SingleChatType:
+		poll.ConversationID = msgprocessor.GetConversationIDBySessionType(constant.SingleChatType, msgData.SendID, msgData.RecvID)
+	case constant.ReadGroupChatType:
+		poll.ConversationID = msgprocessor.GetConversationIDBySessionType(constant.ReadGroupChatType, msgData.GroupID)
+	default:
+		return nil, errs.ErrArgs.WrapMsg("polls are only supported in single and group chats")
+	}
+	if req.CloseTime > 0 {
+		poll.CloseTime = time.UnixMilli(req.CloseTime)
+	}
+	for i, option := range req.Options {
+		poll.Options = append(poll.Options, model.PollOption{OptionID: strconv.Itoa(i + 1), Text: option})
+	}
+	if msgData.ClientMsgID == "" {
+		msgData.ClientMsgID = idutil.GetMsgIDByMD5(msgData.SendID)
+	}
+	poll.ClientMsgID = msgData.ClientMsgID
+	info := newPollInfo(poll, 0, nil, nil)
+	content, err := json.Marshal(info)
+	if err != nil {
+		return nil, errs.WrapMsg(err, "json.Marshal")
+	}
+	msgData.ContentType = msgext.Poll
+	msgData.Content = content
+	if err := m.pollDatabase.CreatePoll(ctx, poll); err != nil {
+		return nil, err
+	}
+	resp, err := m.SendMsg(ctx, &pbmsg.SendMsgReq{MsgData: msgData})
+	if err != nil {
+		if err := m.pollDatabase.DeletePoll(ctx, poll.PollID); err != nil {
+			log.ZWarn(ctx, "delete unsent poll failed", err, "pollID", poll.PollID)
+		}
+		return nil, err
+	}
+	return &msgext.CreatePollResp{
+		Poll:        info,
+		ServerMsgID: resp.ServerMsgID,
+		ClientMsgID: resp.ClientMsgID,
+		SendTime:    resp.SendTime,
+	}, nil
+}
+
+func (m *msgServer) VotePoll(ctx context.Context, req *msgext.VotePollReq) (*msgext.VotePollResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, m.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	poll, err := m.pollDatabase.TakePoll(ctx, req.PollID)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.checkPollAccess(ctx, req.UserID, poll); err != nil {
+		return nil, err
+	}
+	if poll.IsClosed(time.Now()) {
+		return nil, errs.ErrArgs.WrapMsg("poll is closed")
+	}
+	if datautil.Duplicate(req.OptionIDs) {
+		return nil, errs.ErrArgs.WrapMsg("duplicate optionIDs")
+	}
+	if len(req.OptionIDs) > 1 && !poll.MultiChoice {
+		return nil, errs.ErrArgs.WrapMsg("poll is single choice")
+	}
+	optionIDs := datautil.Slice(poll.Options, func(e model.PollOption) string {
+		return e.OptionID
+	})
+	for _, optionID := range req.OptionIDs {
+		if !datautil.Contain(optionID, optionIDs...) {
+			return nil, errs.ErrArgs.WrapMsg("option not found", "optionID", optionID)
+		}
+	}
+	vote := &model.PollVote{
+		PollID:    req.PollID,
+		UserID:    req.UserID,
+		OptionIDs: req.OptionIDs,
+		VoteTime:  time.Now(),
+	}
+	if err := m.pollDatabase.SetPollVote(ctx, vote); err != nil {
+		return nil, err
+	}
+	info, err := m.pollInfo(ctx, poll, req.OptionIDs)
+	if err != nil {
+		return nil, err
+	}
+	m.pollVotedNotification(ctx, req.UserID, poll, info)
+	return &msgext.VotePollResp{Poll: info}, nil
+}
+
+func (m *msgServer) RetractPollVote(ctx context.Context, req *msgext.RetractPollVoteReq) (*msgext.RetractPollVoteResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, m.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	poll, err := m.pollDatabase.TakePoll(ctx, req.PollID)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.checkPollAccess(ctx, req.UserID, poll); err != nil {
+		return nil, err
+	}
+	if poll.IsClosed(time.Now()) {
+		return nil, errs.ErrArgs.WrapMsg("poll is closed")
+	}
+	if err := m.pollDatabase.DeletePollVote(ctx, req.PollID, req.UserID); err != nil {
+		return nil, err
+	}
+	info, err := m.pollInfo(ctx, poll, nil)
+	if err != nil {
+		return nil, err
+	}
+	m.pollVotedNotification(ctx, req.UserID, poll, info)
+	return &msgext.RetractPollVoteResp{Poll: info}, nil
+}
+
+func (m *msgServer) GetPoll(ctx context.Context, req *msgext.GetPollReq) (*msgext.GetPollResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, m.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	poll, err := m.pollDatabase.TakePoll(ctx, req.PollID)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.checkPollAccess(ctx, req.UserID, poll); err != nil {
+		return nil, err
+	}
+	info, err := m.userPollInfo(ctx, poll, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	return &msgext.GetPollResp{Poll: info}, nil
+}
+
+func (m *msgServer) GetPollVoters(ctx context.Context, req *msgext.GetPollVotersReq) (*msgext.GetPollVotersResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, m.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	poll, err := m.pollDatabase.TakePoll(ctx, req.PollID)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.checkPollAccess(ctx, req.UserID, poll); err != nil {
+		return nil, err
+	}
+	if poll.Anonymous {
+		return nil, errs.ErrNoPermission.WrapMsg("voters of anonymous polls are hidden")
+	}
+	total, userIDs, err := m.pollDatabase.PagePollVoters(ctx, req.PollID, req.OptionID, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &msgext.GetPollVotersResp{Total: total, UserIDs: userIDs}, nil
+}
+
+func (m *msgServer) CloseExpiredPolls(ctx context.Context, req *msgext.CloseExpiredPollsReq) (*msgext.CloseExpiredPollsResp, error) {
+	if err := authverify.CheckAdmin(ctx, m.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	var count int64
+	for {
+		polls, err := m.pollDatabase.FindExpiredPolls(ctx, closeExpiredPollsBatch)
+		if err != nil {
+			return nil, err
+		}
+		if len(polls) == 0 {
+			break
+		}
+		if err := m.pollDatabase.ClosePolls(ctx, datautil.Slice(polls, func(e *model.Poll) string { return e.PollID })); err != nil {
+			return nil, err
+		}
+		for _, poll := range polls {
+			poll.Closed = true
+		}
+		infos, err := m.pollInfos(ctx, polls, nil)
+		if err != nil {
+			log.ZWarn(ctx, "poll infos failed", err, "count", len(polls))
+		} else {
+			for i, poll := range polls {
+				m.pollVotedNotification(ctx, poll.SendID, poll, infos[i])
+			}
+		}
+		count += int64(len(polls))
+		if len(polls) < closeExpiredPollsBatch {
+			break
+		}
+	}
+	return &msgext.CloseExpiredPollsResp{Count: count}, nil
+}
@@ -0,0 +1,159 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msg
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/controller"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/msgext"
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/tools/db/pagination"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/mcontext"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type pollDatabaseStub struct {
+	controller.PollDatabase
+	polls       map[string]*model.Poll
+	votes       []*model.PollVote
+	tallied     [][]string
+	voterCounts map[string]int64
+	tallies     map[string][]*model.PollTally
+}
+
+func (p *pollDatabaseStub) TakePoll(ctx context.Context, pollID string) (*model.Poll, error) {
+	if poll, ok := p.polls[pollID]; ok {
+		return poll, nil
+	}
+	return nil, errs.Wrap(mongo.ErrNoDocuments)
+}
+
+func (p *pollDatabaseStub) FindPolls(ctx context.Context, pollIDs []string) ([]*model.Poll, error) {
+	var polls []*model.Poll
+	for _, pollID := range pollIDs {
+		if poll, ok := p.polls[pollID]; ok {
+			polls = append(polls, poll)
+		}
+	}
+	return polls, nil
+}
+
+func (p *pollDatabaseStub) FindUserPollVotes(ctx context.Context, userID string, pollIDs []string) ([]*model.PollVote, error) {
+	var votes []*model.PollVote
+	for _, vote := range p.votes {
+		if vote.UserID == userID {
+			votes = append(votes, vote)
+		}
+	}
+	return votes, nil
+}
+
+func (p *pollDatabaseStub) TallyPolls(ctx context.Context, pollIDs []string) (map[string]int64, map[string][]*model.PollTally, error) {
+	p.tallied = append(p.tallied, pollIDs)
+	return p.voterCounts, p.tallies, nil
+}
+
+func (p *pollDatabaseStub) PagePollVoters(ctx context.Context, pollID string, optionID string, pagination pagination.Pagination) (int64, []string, error) {
+	for _, tally := range p.tallies[pollID] {
+		if tally.OptionID == optionID {
+			return tally.Count, tally.UserIDs, nil
+		}
+	}
+	return 0, nil, nil
+}
+
+func newPollTestServer() (*msgServer, *pollDatabaseStub) {
+	options := []model.PollOption{{OptionID: "1", Text: "yes"}, {OptionID: "2", Text: "no"}}
+	db := &pollDatabaseStub{
+		polls: map[string]*model.Poll{
+			"p1": {PollID: "p1", SessionType: constant.SingleChatType, SendID: "a", RecvID: "b", Options: options},
+			"p2": {PollID: "p2", SessionType: constant.SingleChatType, SendID: "a", RecvID: "b", Options: options, Anonymous: true},
+		},
+		votes:       []*model.PollVote{{PollID: "p1", UserID: "b", OptionIDs: []string{"2"}}},
+		voterCounts: map[string]int64{"p1": 2, "p2": 1},
+		tallies: map[string][]*model.PollTally{
+			"p1": {{PollID: "p1", OptionID: "1", Count: 1, UserIDs: []string{"a"}}, {PollID: "p1", OptionID: "2", Count: 1, UserIDs: []string{"b"}}},
+			"p2": {{PollID: "p2", OptionID: "1", Count: 1, UserIDs: []string{"b"}}},
+		},
+	}
+	m := &msgServer{pollDatabase: db, config: &Config{}}
+	return m, db
+}
+
+func pollMsg(t *testing.T, pollID string) *sdkws.MsgData {
+	content, err := json.Marshal(&msgext.PollInfo{PollID: pollID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &sdkws.MsgData{ContentType: msgext.Poll, Content: content}
+}
+
+func TestFillPollMsgs(t *testing.T) {
+	m, db := newPollTestServer()
+	text := &sdkws.MsgData{ContentType: constant.Text, Content: []byte("hi")}
+	msgs := []*sdkws.MsgData{pollMsg(t, "p1"), text, pollMsg(t, "p2"), pollMsg(t, "p1"), nil}
+	m.fillPollMsgs(context.Background(), "b", msgs)
+	if len(db.tallied) != 1 || len(db.tallied[0]) != 2 {
+		t.Fatalf("polls tallied in %v, want one round for both polls", db.tallied)
+	}
+	if string(text.Content) != "hi" {
+		t.Fatal("content of a text message changed")
+	}
+	var infos []*msgext.PollInfo
+	for _, i := range []int{0, 2, 3} {
+		var info msgext.PollInfo
+		if err := json.Unmarshal(msgs[i].Content, &info); err != nil {
+			t.Fatal(err)
+		}
+		infos = append(infos, &info)
+	}
+	if infos[0].VoterCount != 2 || !reflect.DeepEqual(infos[0].VotedOptionIDs, []string{"2"}) ||
+		!reflect.DeepEqual(infos[0].Options[0].VoterIDs, []string{"a"}) {
+		t.Errorf("poll p1 %+v", infos[0])
+	}
+	if infos[1].VoterCount != 1 || infos[1].Options[0].VoteCount != 1 || len(infos[1].Options[0].VoterIDs) != 0 {
+		t.Errorf("anonymous poll p2 %+v", infos[1])
+	}
+	if infos[2].VoterCount != 2 {
+		t.Errorf("second message of poll p1 %+v", infos[2])
+	}
+}
+
+func TestGetPollVoters(t *testing.T) {
+	m, _ := newPollTestServer()
+	req := &msgext.GetPollVotersReq{PollID: "p1", UserID: "b", OptionID: "2", Pagination: &sdkws.RequestPagination{PageNumber: 1, ShowNumber: 10}}
+	resp, err := m.GetPollVoters(mcontext.SetOpUserID(context.Background(), "b"), req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Total != 1 || !reflect.DeepEqual(resp.UserIDs, []string{"b"}) {
+		t.Fatalf("voters %+v", resp)
+	}
+	req.PollID = "p2"
+	if _, err := m.GetPollVoters(mcontext.SetOpUserID(context.Background(), "b"), req); !errs.ErrNoPermission.Is(err) {
+		t.Fatalf("voters of an anonymous poll: %v", err)
+	}
+	req.PollID, req.UserID = "p1", "c"
+	if _, err := m.GetPollVoters(mcontext.SetOpUserID(context.Background(), "c"), req); !errs.ErrNoPermission.Is(err) {
+		t.Fatalf("voters for a user outside the conversation: %v", err)
+	}
+}
@@ -124,6 +124,9 @@ func (m *msgServer) RevokeMsg(ctx context.Context, req *msg.RevokeMsgReq) (*msg.
 		recvID = msgs[0].RecvID
 	}
 	m.notificationSender.NotificationWithSessionType(ctx, req.UserID, recvID, constant.MsgRevokeNotification, msgs[0].SessionType, &tips)
+	if err := m.unpinRevokedMsg(ctx, req.UserID, req.ConversationID, msgs[0]); err != nil {
+		log.ZWarn(ctx, "unpin revoked msg failed", err, "conversationID", req.ConversationID, "seq", req.Seq)
+	}
 	m.webhookAfterRevokeMsg(ctx, &m.config.WebhooksConfig.AfterRevokeMsg, req)
 	return &msg.RevokeMsgResp{}, nil
 }
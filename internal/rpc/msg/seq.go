@@ -18,6 +18,8 @@ import (
 	"context"
 
 	pbmsg "github.com/openimsdk/protocol/msg"
+	"github.com/openimsdk/tools/mcontext"
+	"github.com/openimsdk/tools/utils/datautil"
 )
 
 func (m *msgServer) GetConversationMaxSeq(ctx context.Context, req *pbmsg.GetConversationMaxSeqReq) (*pbmsg.GetConversationMaxSeqResp, error) {
@@ -49,5 +51,6 @@ func (m *msgServer) GetMsgByConversationIDs(ctx context.Context, req *pbmsg.GetM
 	if err != nil {
 		return nil, err
 	}
+	m.fillPollMsgs(ctx, mcontext.GetOpUserID(ctx), datautil.Values(Msgs))
 	return &pbmsg.GetMsgByConversationIDsResp{MsgDatas: Msgs}, nil
 }
@@ -16,10 +16,14 @@ package msg
 
 import (
 	"context"
+
+	"github.com/google/uuid"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache/redis"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database/mgo"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/webhook"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/msgext"
 	"github.com/openimsdk/protocol/sdkws"
 	"github.com/openimsdk/tools/db/mongoutil"
 	"github.com/openimsdk/tools/db/redisutil"
@@ -44,6 +48,7 @@ type (
 		RegisterCenter         discovery.SvcDiscoveryRegistry   // Service discovery registry for service registration.
 		MsgDatabase            controller.CommonMsgDatabase     // Interface for message database operations.
 		Conversation           *rpcclient.ConversationRpcClient // RPC client for conversation service.
+		User                   *rpcclient.UserRpcClient         // RPC client for user service.
 		UserLocalCache         *rpccache.UserLocalCache         // Local cache for user data.
 		FriendLocalCache       *rpccache.FriendLocalCache       // Local cache for friend data.
 		GroupLocalCache        *rpccache.GroupLocalCache        // Local cache for group data.
@@ -52,6 +57,13 @@ type (
 		notificationSender     *rpcclient.NotificationSender    // RPC client for sending notifications.
 		config                 *Config                          // Global configuration settings.
 		webhookClient          *webhook.Client
+		broadcastDatabase      controller.BroadcastDatabase
+		broadcastOwner         string        // Identifies this instance when leasing broadcast jobs.
+		broadcastWake          chan struct{} // Wakes the broadcast worker when a job is submitted.
+		msgPinDatabase         controller.MsgPinDatabase
+		pollDatabase           controller.PollDatabase
+		slowModeCache          cache.SlowModeCache // Last message time of members in groups with slow mode.
+		unreadDatabase         controller.UnreadDatabase
 	}
 
 	Config struct {
@@ -85,6 +97,18 @@ func Start(ctx context.Context, config *Config, client discovery.SvcDiscoveryReg
 	if err != nil {
 		return err
 	}
+	broadcastModel, err := mgo.NewBroadcastMongo(mgocli.GetDB())
+	if err != nil {
+		return err
+	}
+	msgPinModel, err := mgo.NewMsgPinMongo(mgocli.GetDB())
+	if err != nil {
+		return err
+	}
+	pollModel, err := mgo.NewPollMongo(mgocli.GetDB())
+	if err != nil {
+		return err
+	}
 	//todo MsgCacheTimeout
 	msgModel := redis.NewMsgCache(rdb, config.RedisConfig.EnablePipeline)
 	seqModel := redis.NewSeqCache(rdb)
@@ -98,6 +122,7 @@ func Start(ctx context.Context, config *Config, client discovery.SvcDiscoveryReg
 	}
 	s := &msgServer{
 		Conversation:           &conversationClient,
+		User:                   &userRpcClient,
 		MsgDatabase:            msgDatabase,
 		RegisterCenter:         client,
 		UserLocalCache:         rpccache.NewUserLocalCache(userRpcClient, &config.LocalCacheConfig, rdb),
@@ -106,10 +131,19 @@ func Start(ctx context.Context, config *Config, client discovery.SvcDiscoveryReg
 		FriendLocalCache:       rpccache.NewFriendLocalCache(friendRpcClient, &config.LocalCacheConfig, rdb),
 		config:                 config,
 		webhookClient:          webhook.NewWebhookClient(config.WebhooksConfig.URL),
+		broadcastDatabase:      controller.NewBroadcastDatabase(broadcastModel),
+		broadcastOwner:         uuid.NewString(),
+		broadcastWake:          make(chan struct{}, 1),
+		msgPinDatabase:         controller.NewMsgPinDatabase(msgPinModel),
+		pollDatabase:           controller.NewPollDatabase(pollModel),
+		slowModeCache:          redis.NewSlowModeCache(rdb),
+		unreadDatabase:         controller.NewUnreadDatabase(redis.NewUnreadCache(rdb)),
 	}
 
 	s.notificationSender = rpcclient.NewNotificationSender(&config.NotificationConfig, rpcclient.WithLocalSendMsg(s.SendMsg))
 	msg.RegisterMsgServer(server, s)
+	msgext.RegisterMsgExtServer(server, s)
+	go s.broadcastWorker(ctx)
 	return nil
 }
 
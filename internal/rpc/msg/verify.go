@@ -16,6 +16,7 @@ package msg
 
 import (
 	"context"
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/servererrs"
 	"github.com/openimsdk/tools/utils/datautil"
 	"github.com/openimsdk/tools/utils/encrypt"
@@ -77,55 +78,105 @@ func (m *msgServer) messageVerification(ctx context.Context, data *msg.SendMsgRe
 			return nil
 		}
 		return nil
-	case constant.ReadGroupChatType:
-		groupInfo, err := m.GroupLocalCache.GetGroupInfo(ctx, data.MsgData.GroupID)
-		if err != nil {
-			return err
-		}
-		if groupInfo.Status == constant.GroupStatusDismissed &&
-			data.MsgData.ContentType != constant.GroupDismissedNotification {
-			return servererrs.ErrDismissedAlready.Wrap()
-		}
-		if groupInfo.GroupType == constant.SuperGroup {
-			return nil
-		}
+	default:
+		return nil
+	}
+}
 
-		if datautil.Contain(data.MsgData.SendID, m.config.Share.IMAdminUserID...) {
-			return nil
-		}
-		if data.MsgData.ContentType <= constant.NotificationEnd &&
-			data.MsgData.ContentType >= constant.NotificationBegin {
-			return nil
+// groupMessageVerification checks the sender may send the message to the group. It returns the slow mode interval the
+// sender is subject to, which is acquired by the caller once every other check passed.
+func (m *msgServer) groupMessageVerification(ctx context.Context, data *msg.SendMsgReq) (time.Duration, error) {
+	groupInfo, err := m.GroupLocalCache.GetGroupInfo(ctx, data.MsgData.GroupID)
+	if err != nil {
+		return 0, err
+	}
+	if groupInfo.Status == constant.GroupStatusDismissed &&
+		data.MsgData.ContentType != constant.GroupDismissedNotification {
+		return 0, servererrs.ErrDismissedAlready.Wrap()
+	}
+	if groupInfo.GroupType == constant.SuperGroup {
+		return 0, nil
+	}
+
+	if datautil.Contain(data.MsgData.SendID, m.config.Share.IMAdminUserID...) {
+		return 0, nil
+	}
+	if data.MsgData.ContentType <= constant.NotificationEnd &&
+		data.MsgData.ContentType >= constant.NotificationBegin {
+		return 0, nil
+	}
+	memberIDs, err := m.GroupLocalCache.GetGroupMemberIDMap(ctx, data.MsgData.GroupID)
+	if err != nil {
+		return 0, err
+	}
+	if _, ok := memberIDs[data.MsgData.SendID]; !ok {
+		return 0, servererrs.ErrNotInGroupYet.Wrap()
+	}
+	if err := m.checkCommunityMember(ctx, data.MsgData.GroupID, data.MsgData.SendID); err != nil {
+		return 0, err
+	}
+
+	groupMemberInfo, err := m.GroupLocalCache.GetGroupMember(ctx, data.MsgData.GroupID, data.MsgData.SendID)
+	if err != nil {
+		if errs.ErrRecordNotFound.Is(err) {
+			return 0, servererrs.ErrNotInGroupYet.WrapMsg(err.Error())
 		}
-		memberIDs, err := m.GroupLocalCache.GetGroupMemberIDMap(ctx, data.MsgData.GroupID)
-		if err != nil {
-			return err
+		return 0, err
+	}
+	if groupMemberInfo.RoleLevel == constant.GroupOwner {
+		return 0, nil
+	} else {
+		if groupMemberInfo.MuteEndTime >= time.Now().UnixMilli() {
+			return 0, servererrs.ErrMutedInGroup.Wrap()
 		}
-		if _, ok := memberIDs[data.MsgData.SendID]; !ok {
-			return servererrs.ErrNotInGroupYet.Wrap()
+		if groupInfo.Status == constant.GroupStatusMuted {
+			canSend, err := m.GroupLocalCache.HasGroupPermission(ctx, data.MsgData.GroupID, groupMemberInfo.RoleLevel, authverify.GroupPermissionSendDuringMute)
+			if err != nil {
+				return 0, err
+			}
+			if !canSend {
+				return 0, servererrs.ErrMutedGroup.Wrap()
+			}
 		}
-
-		groupMemberInfo, err := m.GroupLocalCache.GetGroupMember(ctx, data.MsgData.GroupID, data.MsgData.SendID)
-		if err != nil {
-			if errs.ErrRecordNotFound.Is(err) {
-				return servererrs.ErrNotInGroupYet.WrapMsg(err.Error())
+		if datautil.Contain(constant.AtAllString, data.MsgData.AtUserIDList...) {
+			canAtAll, err := m.GroupLocalCache.HasGroupPermission(ctx, data.MsgData.GroupID, groupMemberInfo.RoleLevel, authverify.GroupPermissionAtAll)
+			if err != nil {
+				return 0, err
+			}
+			if !canAtAll {
+				return 0, errs.ErrNoPermission.WrapMsg("no permission to mention all members")
 			}
-			return err
 		}
-		if groupMemberInfo.RoleLevel == constant.GroupOwner {
-			return nil
-		} else {
-			if groupMemberInfo.MuteEndTime >= time.Now().UnixMilli() {
-				return servererrs.ErrMutedInGroup.Wrap()
+		if groupMemberInfo.RoleLevel != constant.GroupAdmin {
+			policy, err := m.GroupLocalCache.GetGroupMsgPolicy(ctx, data.MsgData.GroupID)
+			if err != nil {
+				return 0, err
 			}
-			if groupInfo.Status == constant.GroupStatusMuted && groupMemberInfo.RoleLevel != constant.GroupAdmin {
-				return servererrs.ErrMutedGroup.Wrap()
+			if err := checkGroupMsgPolicy(data.MsgData, policy); err != nil {
+				return 0, err
 			}
+			return time.Duration(policy.SlowModeSeconds) * time.Second, nil
 		}
+	}
+	return 0, nil
+}
+
+// checkCommunityMember requires senders in a community channel to still be members of the community.
+func (m *msgServer) checkCommunityMember(ctx context.Context, groupID string, userID string) error {
+	community, err := m.GroupLocalCache.GetGroupCommunity(ctx, groupID)
+	if err != nil {
+		return err
+	}
+	if community.CommunityID == "" {
 		return nil
-	default:
-		return nil
 	}
+	if _, err := m.GroupLocalCache.GetCommunityMember(ctx, community.CommunityID, userID); err != nil {
+		if errs.ErrRecordNotFound.Is(err) {
+			return servererrs.ErrNotInGroupYet.WrapMsg("not in community")
+		}
+		return err
+	}
+	return nil
 }
 
 func (m *msgServer) encapsulateMsgData(msg *sdkws.MsgData) {
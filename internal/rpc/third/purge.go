@@ -0,0 +1,46 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package third
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/thirdext"
+)
+
+// purgeObjectBatchSize is the number of objects deleted per query when purging a user.
+const purgeObjectBatchSize = 500
+
+func (t *thirdServer) PurgeUserObjects(ctx context.Context, req *thirdext.PurgeUserObjectsReq) (*thirdext.PurgeUserObjectsResp, error) {
+	if err := authverify.CheckAdmin(ctx, t.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	var count int64
+	for {
+		n, err := t.s3dataBase.DeleteUserObjects(ctx, req.UserID, purgeObjectBatchSize)
+		if err != nil {
+			return nil, err
+		}
+		count += int64(n)
+		if n < purgeObjectBatchSize {
+			break
+		}
+	}
+	if err := t.thirdDatabase.DeleteUserData(ctx, req.UserID); err != nil {
+		return nil, err
+	}
+	return &thirdext.PurgeUserObjectsResp{Count: count}, nil
+}
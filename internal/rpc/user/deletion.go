@@ -0,0 +1,209 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package user
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/servererrs"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database/mgo"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/authext"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/conversationext"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/friendext"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/groupext"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/msgext"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/thirdext"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/userext"
+	"github.com/openimsdk/tools/log"
+)
+
+const defaultPurgeBatchSize = 100
+
+func accountDeletionDB2Pb(deletion *model.UserDeletion) *userext.AccountDeletion {
+	return &userext.AccountDeletion{
+		UserID:      deletion.UserID,
+		RequestTime: deletion.RequestTime.UnixMilli(),
+		PurgeTime:   deletion.PurgeTime.UnixMilli(),
+		Attempts:    deletion.Attempts,
+	}
+}
+
+func (s *userServer) RequestAccountDeletion(ctx context.Context, req *userext.RequestAccountDeletionReq) (*userext.RequestAccountDeletionResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, s.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	if authverify.IsManagerUserID(req.UserID, s.config.Share.IMAdminUserID) {
+		return nil, servererrs.ErrNoPermission.WrapMsg("admin accounts can not be deleted")
+	}
+	if _, err := s.db.FindWithError(ctx, []string{req.UserID}); err != nil {
+		return nil, err
+	}
+	deletion, err := s.deletionDatabase.TakeUserDeletion(ctx, req.UserID)
+	if err == nil {
+		if deletion.Status == model.UserDeletionStatusPurged {
+			return nil, servererrs.ErrAccountDeleted.WrapMsg("account already deleted", "userID", req.UserID)
+		}
+		return &userext.RequestAccountDeletionResp{Deletion: accountDeletionDB2Pb(deletion)}, nil
+	} else if !mgo.IsNotFound(err) {
+		return nil, err
+	}
+	now := time.Now()
+	deletion = &model.UserDeletion{
+		UserID:      req.UserID,
+		Status:      model.UserDeletionStatusPending,
+		RequestTime: now,
+		PurgeTime:   now.Add(time.Hour * time.Duration(s.config.RpcConfig.AccountDeletion.GracePeriodHours)),
+	}
+	if err := s.deletionDatabase.CreateUserDeletion(ctx, deletion); err != nil {
+		return nil, err
+	}
+	return &userext.RequestAccountDeletionResp{Deletion: accountDeletionDB2Pb(deletion)}, nil
+}
+
+func (s *userServer) CancelAccountDeletion(ctx context.Context, req *userext.CancelAccountDeletionReq) (*userext.CancelAccountDeletionResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, s.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	deletion, err := s.deletionDatabase.TakeUserDeletion(ctx, req.UserID)
+	if err != nil {
+		if mgo.IsNotFound(err) {
+			return &userext.CancelAccountDeletionResp{}, nil
+		}
+		return nil, err
+	}
+	if deletion.Status == model.UserDeletionStatusPurged {
+		return nil, servererrs.ErrAccountDeleted.WrapMsg("account already deleted", "userID", req.UserID)
+	}
+	if deletion.Attempts > 0 || !deletion.PurgeTime.After(time.Now()) {
+		return nil, servererrs.ErrAccountDeleted.WrapMsg("account deletion already started", "userID", req.UserID)
+	}
+	// The purge may start between the check above and the cancel, the cancel only matches a deletion that has not started.
+	if err := s.deletionDatabase.CancelUserDeletion(ctx, req.UserID); err != nil {
+		if mgo.IsNotFound(err) {
+			return nil, servererrs.ErrAccountDeleted.WrapMsg("account deletion already started", "userID", req.UserID)
+		}
+		return nil, err
+	}
+	return &userext.CancelAccountDeletionResp{}, nil
+}
+
+func (s *userServer) GetAccountDeletion(ctx context.Context, req *userext.GetAccountDeletionReq) (*userext.GetAccountDeletionResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, s.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	deletion, err := s.deletionDatabase.TakeUserDeletion(ctx, req.UserID)
+	if err != nil {
+		if mgo.IsNotFound(err) {
+			return &userext.GetAccountDeletionResp{}, nil
+		}
+		return nil, err
+	}
+	if deletion.Status == model.UserDeletionStatusPurged {
+		return nil, servererrs.ErrAccountDeleted.WrapMsg("account already deleted", "userID", req.UserID)
+	}
+	return &userext.GetAccountDeletionResp{Deletion: accountDeletionDB2Pb(deletion)}, nil
+}
+
+func (s *userServer) CheckAccountActive(ctx context.Context, req *userext.CheckAccountActiveReq) (*userext.CheckAccountActiveResp, error) {
+	users, err := s.db.Find(ctx, []string{req.UserID})
+	if err != nil {
+		return nil, err
+	}
+	if len(users) == 0 {
+		return nil, servererrs.ErrUserIDNotFound.WrapMsg(req.UserID)
+	}
+	if users[0].Status == model.UserStatusDeleted {
+		return nil, servererrs.ErrAccountDeleted.WrapMsg("account deleted", "userID", req.UserID)
+	}
+	return &userext.CheckAccountActiveResp{}, nil
+}
+
+func (s *userServer) PurgeDeletedUsers(ctx context.Context, req *userext.PurgeDeletedUsersReq) (*userext.PurgeDeletedUsersResp, error) {
+	if err := authverify.CheckAdmin(ctx, s.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	conf := s.config.RpcConfig.AccountDeletion
+	batchSize := conf.BatchSize
+	if batchSize <= 0 {
+		batchSize = defaultPurgeBatchSize
+	}
+	retry := time.Minute * time.Duration(conf.RetryMinutes)
+	var count int32
+	for i := 0; i < batchSize; i++ {
+		// Acquiring a deletion postpones it, an account that fails to purge is retried by a later run.
+		deletion, err := s.deletionDatabase.AcquireUserDeletion(ctx, retry)
+		if err != nil {
+			if mgo.IsNotFound(err) {
+				break
+			}
+			return nil, err
+		}
+		// Acquiring marks the purge as started, so a deletion still pending now can no longer be cancelled.
+		deletion, err = s.deletionDatabase.TakeUserDeletion(ctx, deletion.UserID)
+		if err != nil {
+			if mgo.IsNotFound(err) {
+				continue
+			}
+			return nil, err
+		}
+		if deletion.Status != model.UserDeletionStatusPending {
+			continue
+		}
+		if err := s.purgeUser(ctx, deletion.UserID); err != nil {
+			log.ZError(ctx, "purge deleted user failed", err, "userID", deletion.UserID, "attempts", deletion.Attempts)
+			if err := s.deletionDatabase.SetUserDeletionStatus(ctx, deletion.UserID, model.UserDeletionStatusPending, err.Error()); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if err := s.deletionDatabase.SetUserDeletionStatus(ctx, deletion.UserID, model.UserDeletionStatusPurged, ""); err != nil {
+			return nil, err
+		}
+		log.ZInfo(ctx, "deleted user purged", "userID", deletion.UserID, "requestTime", deletion.RequestTime)
+		count++
+	}
+	return &userext.PurgeDeletedUsersResp{Count: count}, nil
+}
+
+// purgeUser removes the footprint of the user from every service, each step can be run again after a failure.
+func (s *userServer) purgeUser(ctx context.Context, userID string) error {
+	nickname := s.config.RpcConfig.AccountDeletion.DeletedNickname
+	if _, err := s.authRpcClient.ExtClient.RevokeUserTokens(ctx, &authext.RevokeUserTokensReq{UserID: userID}); err != nil {
+		return err
+	}
+	// The messages are found through the conversations of the user, so they are anonymized before the conversations are purged.
+	if _, err := s.msgRpcClient.ExtClient.AnonymizeUserMsgs(ctx, &msgext.AnonymizeUserMsgsReq{UserID: userID, Nickname: nickname}); err != nil {
+		return err
+	}
+	if _, err := s.groupRpcClient.ExtClient.PurgeUserGroups(ctx, &groupext.PurgeUserGroupsReq{UserID: userID}); err != nil {
+		return err
+	}
+	if _, err := s.friendRpcClient.ExtClient.PurgeUserRelations(ctx, &friendext.PurgeUserRelationsReq{UserID: userID}); err != nil {
+		return err
+	}
+	if _, err := s.conversationRpcClient.ExtClient.PurgeUserConversations(ctx, &conversationext.PurgeUserConversationsReq{UserID: userID}); err != nil {
+		return err
+	}
+	if _, err := s.thirdRpcClient.ExtClient.PurgeUserObjects(ctx, &thirdext.PurgeUserObjectsReq{UserID: userID}); err != nil {
+		return err
+	}
+	if err := s.deletionDatabase.DeleteUserDataExports(ctx, userID); err != nil {
+		return err
+	}
+	return s.db.AnonymizeUser(ctx, userID, nickname)
+}
@@ -0,0 +1,412 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package user
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/servererrs"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/controller"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/authext"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/conversationext"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/friendext"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/groupext"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/msgext"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/thirdext"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/userext"
+	"github.com/openimsdk/open-im-server/v3/pkg/rpcclient"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/mcontext"
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/grpc"
+)
+
+type deletionDatabaseStub struct {
+	controller.UserDeletionDatabase
+	deletions map[string]*model.UserDeletion
+	exports   map[string][]*model.UserDataExport
+}
+
+func (d *deletionDatabaseStub) CreateUserDeletion(ctx context.Context, deletion *model.UserDeletion) error {
+	d.deletions[deletion.UserID] = deletion
+	return nil
+}
+
+func (d *deletionDatabaseStub) TakeUserDeletion(ctx context.Context, userID string) (*model.UserDeletion, error) {
+	deletion, ok := d.deletions[userID]
+	if !ok {
+		return nil, errs.Wrap(mongo.ErrNoDocuments)
+	}
+	return deletion, nil
+}
+
+func (d *deletionDatabaseStub) CancelUserDeletion(ctx context.Context, userID string) error {
+	deletion, ok := d.deletions[userID]
+	if !ok || deletion.Status != model.UserDeletionStatusPending || deletion.Attempts > 0 || !deletion.PurgeTime.After(time.Now()) {
+		return errs.Wrap(mongo.ErrNoDocuments)
+	}
+	delete(d.deletions, userID)
+	return nil
+}
+
+func (d *deletionDatabaseStub) AcquireUserDeletion(ctx context.Context, retry time.Duration) (*model.UserDeletion, error) {
+	now := time.Now()
+	for _, deletion := range d.deletions {
+		if deletion.Status == model.UserDeletionStatusPending && !deletion.PurgeTime.After(now) {
+			deletion.PurgeTime = now.Add(retry)
+			deletion.Attempts++
+			return deletion, nil
+		}
+	}
+	return nil, errs.Wrap(mongo.ErrNoDocuments)
+}
+
+func (d *deletionDatabaseStub) SetUserDeletionStatus(ctx context.Context, userID string, status int32, errMsg string) error {
+	if _, ok := d.deletions[userID]; !ok {
+		return errs.Wrap(mongo.ErrNoDocuments)
+	}
+	d.deletions[userID].Status = status
+	d.deletions[userID].Error = errMsg
+	return nil
+}
+
+func (d *deletionDatabaseStub) DeleteUserDataExports(ctx context.Context, userID string) error {
+	delete(d.exports, userID)
+	return nil
+}
+
+func (d *deletionDatabaseStub) CreateUserDataExport(ctx context.Context, export *model.UserDataExport) error {
+	d.exports[export.UserID] = append([]*model.UserDataExport{export}, d.exports[export.UserID]...)
+	return nil
+}
+
+func (d *deletionDatabaseStub) FindUserDataExports(ctx context.Context, userID string, limit int64) ([]*model.UserDataExport, error) {
+	return d.exports[userID], nil
+}
+
+type purgeUserDatabase struct {
+	controller.UserDatabase
+	calls *[]string
+	users map[string]int32
+}
+
+func (u *purgeUserDatabase) FindWithError(ctx context.Context, userIDs []string) ([]*model.User, error) {
+	return []*model.User{{UserID: userIDs[0]}}, nil
+}
+
+func (u *purgeUserDatabase) Find(ctx context.Context, userIDs []string) ([]*model.User, error) {
+	var users []*model.User
+	for _, userID := range userIDs {
+		if status, ok := u.users[userID]; ok {
+			users = append(users, &model.User{UserID: userID, Status: status})
+		}
+	}
+	return users, nil
+}
+
+func (u *purgeUserDatabase) AnonymizeUser(ctx context.Context, userID string, nickname string) error {
+	*u.calls = append(*u.calls, "user:"+userID+":"+nickname)
+	u.users[userID] = model.UserStatusDeleted
+	return nil
+}
+
+type purgeAuthClient struct {
+	authext.AuthExtClient
+	calls *[]string
+}
+
+func (c *purgeAuthClient) RevokeUserTokens(ctx context.Context, in *authext.RevokeUserTokensReq, opts ...grpc.CallOption) (*authext.RevokeUserTokensResp, error) {
+	*c.calls = append(*c.calls, "auth:"+in.UserID)
+	return &authext.RevokeUserTokensResp{}, nil
+}
+
+type purgeMsgClient struct {
+	msgext.MsgExtClient
+	calls *[]string
+}
+
+func (c *purgeMsgClient) AnonymizeUserMsgs(ctx context.Context, in *msgext.AnonymizeUserMsgsReq, opts ...grpc.CallOption) (*msgext.AnonymizeUserMsgsResp, error) {
+	*c.calls = append(*c.calls, "msg:"+in.UserID+":"+in.Nickname)
+	return &msgext.AnonymizeUserMsgsResp{}, nil
+}
+
+type purgeGroupClient struct {
+	groupext.GroupExtClient
+	calls *[]string
+	fail  map[string]bool
+}
+
+func (c *purgeGroupClient) PurgeUserGroups(ctx context.Context, in *groupext.PurgeUserGroupsReq, opts ...grpc.CallOption) (*groupext.PurgeUserGroupsResp, error) {
+	*c.calls = append(*c.calls, "group:"+in.UserID)
+	if c.fail[in.UserID] {
+		return nil, errors.New("group service unavailable")
+	}
+	return &groupext.PurgeUserGroupsResp{}, nil
+}
+
+type purgeFriendClient struct {
+	friendext.FriendExtClient
+	calls *[]string
+}
+
+func (c *purgeFriendClient) PurgeUserRelations(ctx context.Context, in *friendext.PurgeUserRelationsReq, opts ...grpc.CallOption) (*friendext.PurgeUserRelationsResp, error) {
+	*c.calls = append(*c.calls, "friend:"+in.UserID)
+	return &friendext.PurgeUserRelationsResp{}, nil
+}
+
+type purgeConversationClient struct {
+	conversationext.ConversationExtClient
+	calls *[]string
+}
+
+func (c *purgeConversationClient) PurgeUserConversations(ctx context.Context, in *conversationext.PurgeUserConversationsReq, opts ...grpc.CallOption) (*conversationext.PurgeUserConversationsResp, error) {
+	*c.calls = append(*c.calls, "conversation:"+in.UserID)
+	return &conversationext.PurgeUserConversationsResp{}, nil
+}
+
+type purgeThirdClient struct {
+	thirdext.ThirdExtClient
+	calls *[]string
+}
+
+func (c *purgeThirdClient) PurgeUserObjects(ctx context.Context, in *thirdext.PurgeUserObjectsReq, opts ...grpc.CallOption) (*thirdext.PurgeUserObjectsResp, error) {
+	*c.calls = append(*c.calls, "third:"+in.UserID)
+	return &thirdext.PurgeUserObjectsResp{}, nil
+}
+
+func newPurgeTestServer(calls *[]string, failGroup map[string]bool) (*userServer, *deletionDatabaseStub) {
+	deletionDB := &deletionDatabaseStub{deletions: make(map[string]*model.UserDeletion), exports: make(map[string][]*model.UserDataExport)}
+	s := &userServer{
+		db:                    &purgeUserDatabase{calls: calls, users: map[string]int32{"alice": model.UserStatusNormal}},
+		deletionDatabase:      deletionDB,
+		authRpcClient:         &rpcclient.Auth{ExtClient: &purgeAuthClient{calls: calls}},
+		msgRpcClient:          &rpcclient.MessageRpcClient{ExtClient: &purgeMsgClient{calls: calls}},
+		groupRpcClient:        &rpcclient.GroupRpcClient{ExtClient: &purgeGroupClient{calls: calls, fail: failGroup}},
+		friendRpcClient:       &rpcclient.FriendRpcClient{ExtClient: &purgeFriendClient{calls: calls}},
+		conversationRpcClient: &rpcclient.Conversation{ExtClient: &purgeConversationClient{calls: calls}},
+		thirdRpcClient:        &rpcclient.Third{ExtClient: &purgeThirdClient{calls: calls}},
+		config: &Config{
+			RpcConfig: config.User{
+				AccountDeletion: config.AccountDeletion{GracePeriodHours: 24, RetryMinutes: 30, BatchSize: 10, DeletedNickname: "Deleted User"},
+				DataExport:      config.DataExport{IntervalHours: 24},
+			},
+			Share: config.Share{IMAdminUserID: []string{"admin"}},
+		},
+	}
+	return s, deletionDB
+}
+
+func TestAccountDeletionLifecycle(t *testing.T) {
+	var calls []string
+	s, deletionDB := newPurgeTestServer(&calls, nil)
+	ctx := mcontext.SetOpUserID(context.Background(), "alice")
+
+	if _, err := s.RequestAccountDeletion(ctx, &userext.RequestAccountDeletionReq{UserID: "bob"}); !errs.ErrNoPermission.Is(err) {
+		t.Fatalf("request for another user: got %v, want no permission", err)
+	}
+	if _, err := s.RequestAccountDeletion(mcontext.SetOpUserID(context.Background(), "admin"), &userext.RequestAccountDeletionReq{UserID: "admin"}); !errs.ErrNoPermission.Is(err) {
+		t.Fatalf("request for an admin: got %v, want no permission", err)
+	}
+	resp, err := s.RequestAccountDeletion(ctx, &userext.RequestAccountDeletionReq{UserID: "alice"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if grace := resp.Deletion.PurgeTime - resp.Deletion.RequestTime; grace != (24 * time.Hour).Milliseconds() {
+		t.Fatalf("grace period %dms", grace)
+	}
+	again, err := s.RequestAccountDeletion(ctx, &userext.RequestAccountDeletionReq{UserID: "alice"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if again.Deletion.PurgeTime != resp.Deletion.PurgeTime {
+		t.Fatal("a second request must not push back the purge")
+	}
+
+	admin := mcontext.SetOpUserID(context.Background(), "admin")
+	purged, err := s.PurgeDeletedUsers(admin, &userext.PurgeDeletedUsersReq{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if purged.Count != 0 || len(calls) != 0 {
+		t.Fatalf("purged during the grace period: %d %v", purged.Count, calls)
+	}
+
+	if _, err := s.CancelAccountDeletion(ctx, &userext.CancelAccountDeletionReq{UserID: "alice"}); err != nil {
+		t.Fatal(err)
+	}
+	got, err := s.GetAccountDeletion(ctx, &userext.GetAccountDeletionReq{UserID: "alice"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Deletion != nil {
+		t.Fatal("deletion still pending after cancel")
+	}
+
+	if _, err := s.RequestAccountDeletion(ctx, &userext.RequestAccountDeletionReq{UserID: "alice"}); err != nil {
+		t.Fatal(err)
+	}
+	deletionDB.deletions["alice"].PurgeTime = time.Now().Add(-time.Second)
+	if _, err := s.PurgeDeletedUsers(ctx, &userext.PurgeDeletedUsersReq{}); !errs.ErrNoPermission.Is(err) {
+		t.Fatalf("purge by a user: got %v, want no permission", err)
+	}
+	purged, err = s.PurgeDeletedUsers(admin, &userext.PurgeDeletedUsersReq{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if purged.Count != 1 {
+		t.Fatalf("purged %d accounts, want 1", purged.Count)
+	}
+	want := []string{
+		"auth:alice",
+		"msg:alice:Deleted User",
+		"group:alice",
+		"friend:alice",
+		"conversation:alice",
+		"third:alice",
+		"user:alice:Deleted User",
+	}
+	if !reflect.DeepEqual(calls, want) {
+		t.Fatalf("purge steps %v, want %v", calls, want)
+	}
+	if _, err := s.CancelAccountDeletion(ctx, &userext.CancelAccountDeletionReq{UserID: "alice"}); !servererrs.ErrAccountDeleted.Is(err) {
+		t.Fatalf("cancel after purge: got %v, want account deleted", err)
+	}
+	if _, err := s.CheckAccountActive(ctx, &userext.CheckAccountActiveReq{UserID: "alice"}); !servererrs.ErrAccountDeleted.Is(err) {
+		t.Fatalf("purged account active check: got %v, want account deleted", err)
+	}
+	if _, err := s.CheckAccountActive(ctx, &userext.CheckAccountActiveReq{UserID: "bob"}); !servererrs.ErrUserIDNotFound.Is(err) {
+		t.Fatalf("unknown account active check: got %v, want user not found", err)
+	}
+}
+
+func TestCancelAccountDeletionAfterPurgeStarted(t *testing.T) {
+	var calls []string
+	s, deletionDB := newPurgeTestServer(&calls, map[string]bool{"alice": true})
+	ctx := mcontext.SetOpUserID(context.Background(), "alice")
+	if _, err := s.RequestAccountDeletion(ctx, &userext.RequestAccountDeletionReq{UserID: "alice"}); err != nil {
+		t.Fatal(err)
+	}
+	deletionDB.deletions["alice"].PurgeTime = time.Now().Add(-time.Second)
+	if _, err := s.CancelAccountDeletion(ctx, &userext.CancelAccountDeletionReq{UserID: "alice"}); !servererrs.ErrAccountDeleted.Is(err) {
+		t.Fatalf("cancel after the purge time: got %v, want account deleted", err)
+	}
+
+	// A failed purge is pushed back past the retry interval but has already started.
+	if _, err := s.PurgeDeletedUsers(mcontext.SetOpUserID(context.Background(), "admin"), &userext.PurgeDeletedUsersReq{}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.CancelAccountDeletion(ctx, &userext.CancelAccountDeletionReq{UserID: "alice"}); !servererrs.ErrAccountDeleted.Is(err) {
+		t.Fatalf("cancel after a failed purge: got %v, want account deleted", err)
+	}
+	if _, ok := deletionDB.deletions["alice"]; !ok {
+		t.Fatal("deletion removed although the purge started")
+	}
+	if _, err := s.CheckAccountActive(ctx, &userext.CheckAccountActiveReq{UserID: "alice"}); err != nil {
+		t.Fatalf("account deleted before the purge finished: %v", err)
+	}
+}
+
+func TestPurgeDeletedUsersRetriesFailures(t *testing.T) {
+	var calls []string
+	s, deletionDB := newPurgeTestServer(&calls, map[string]bool{"alice": true})
+	deletionDB.deletions["alice"] = &model.UserDeletion{UserID: "alice", PurgeTime: time.Now().Add(-time.Minute)}
+
+	resp, err := s.PurgeDeletedUsers(mcontext.SetOpUserID(context.Background(), "admin"), &userext.PurgeDeletedUsersReq{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Count != 0 {
+		t.Fatalf("purged %d accounts, want 0", resp.Count)
+	}
+	deletion := deletionDB.deletions["alice"]
+	if deletion.Status != model.UserDeletionStatusPending || deletion.Error == "" || deletion.Attempts != 1 {
+		t.Fatalf("failed purge left %+v", deletion)
+	}
+	if !deletion.PurgeTime.After(time.Now().Add(29 * time.Minute)) {
+		t.Fatalf("failed purge retried at %v, want after the retry interval", deletion.PurgeTime)
+	}
+	for _, call := range calls {
+		if call == "user:alice:Deleted User" {
+			t.Fatal("profile anonymized although the purge failed")
+		}
+	}
+}
+
+func TestRequestDataExportInterval(t *testing.T) {
+	var calls []string
+	s, deletionDB := newPurgeTestServer(&calls, nil)
+	ctx := mcontext.SetOpUserID(context.Background(), "alice")
+	if _, err := s.RequestDataExport(ctx, &userext.RequestDataExportReq{UserID: "alice"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.RequestDataExport(ctx, &userext.RequestDataExportReq{UserID: "alice"}); !servererrs.ErrDataExportLimit.Is(err) {
+		t.Fatalf("second export: got %v, want data export limit", err)
+	}
+	deletionDB.exports["alice"][0].Status = model.UserDataExportStatusFailed
+	if _, err := s.RequestDataExport(ctx, &userext.RequestDataExportReq{UserID: "alice"}); err != nil {
+		t.Fatalf("export after a failed one: %v", err)
+	}
+	deletionDB.exports["alice"][0].Status = model.UserDataExportStatusSucceeded
+	deletionDB.exports["alice"][0].Object = "alice/export/1.zip"
+	resp, err := s.GetDataExports(ctx, &userext.GetDataExportsReq{UserID: "alice", UrlPrefix: "http://api/object/"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Exports[0].Url != "http://api/object/alice/export/1.zip" {
+		t.Fatalf("export url %q", resp.Exports[0].Url)
+	}
+	if _, err := s.GetDataExports(mcontext.SetOpUserID(context.Background(), "bob"), &userext.GetDataExportsReq{UserID: "alice"}); !errs.ErrNoPermission.Is(err) {
+		t.Fatalf("exports of another user: got %v, want no permission", err)
+	}
+}
+
+func TestBuildDataExportArchive(t *testing.T) {
+	data, err := buildDataExportArchive(map[string]any{
+		"profile.json":  map[string]string{"userID": "alice"},
+		"messages.json": []string{"hello"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, f := range r.File {
+		names = append(names, f.Name)
+	}
+	if !reflect.DeepEqual(names, []string{"messages.json", "profile.json"}) {
+		t.Fatalf("archive files %v", names)
+	}
+	again, err := buildDataExportArchive(map[string]any{
+		"messages.json": []string{"hello"},
+		"profile.json":  map[string]string{"userID": "alice"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(again) != len(data) {
+		t.Fatal("archive of the same data differs")
+	}
+}
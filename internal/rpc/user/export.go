@@ -0,0 +1,264 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package user
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/convert"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/servererrs"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database/mgo"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/userext"
+	"github.com/openimsdk/protocol/friend"
+	"github.com/openimsdk/protocol/group"
+	"github.com/openimsdk/protocol/msg"
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/utils/datautil"
+)
+
+const (
+	dataExportPageSize       = 500
+	dataExportListLimit      = 10
+	defaultExportBatchSize   = 10
+	defaultExportLeaseMinute = 30
+	dataExportContentType    = "application/zip"
+	dataExportCause          = "data-export"
+)
+
+func dataExportDB2Pb(export *model.UserDataExport, urlPrefix string) *userext.DataExport {
+	pb := &userext.DataExport{
+		ExportID:   export.ExportID,
+		UserID:     export.UserID,
+		Status:     export.Status,
+		CreateTime: export.CreateTime.UnixMilli(),
+	}
+	if export.Status != model.UserDataExportStatusPending {
+		pb.FinishTime = export.FinishTime.UnixMilli()
+	}
+	if export.Object != "" {
+		pb.Url = urlPrefix + export.Object
+	}
+	return pb
+}
+
+func (s *userServer) RequestDataExport(ctx context.Context, req *userext.RequestDataExportReq) (*userext.RequestDataExportResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, s.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	if _, err := s.db.FindWithError(ctx, []string{req.UserID}); err != nil {
+		return nil, err
+	}
+	if interval := time.Hour * time.Duration(s.config.RpcConfig.DataExport.IntervalHours); interval > 0 && !authverify.IsAppManagerUid(ctx, s.config.Share.IMAdminUserID) {
+		exports, err := s.deletionDatabase.FindUserDataExports(ctx, req.UserID, dataExportListLimit)
+		if err != nil {
+			return nil, err
+		}
+		for _, export := range exports {
+			// A failed export does not keep the user from asking again.
+			if export.Status == model.UserDataExportStatusFailed {
+				continue
+			}
+			if wait := interval - time.Since(export.CreateTime); wait > 0 {
+				return nil, servererrs.ErrDataExportLimit.WrapMsg("data export requested too soon", "retryAfter", wait.Round(time.Second).String())
+			}
+			break
+		}
+	}
+	export := &model.UserDataExport{
+		ExportID:   uuid.NewString(),
+		UserID:     req.UserID,
+		Status:     model.UserDataExportStatusPending,
+		CreateTime: time.Now(),
+	}
+	if err := s.deletionDatabase.CreateUserDataExport(ctx, export); err != nil {
+		return nil, err
+	}
+	return &userext.RequestDataExportResp{Export: dataExportDB2Pb(export, "")}, nil
+}
+
+func (s *userServer) GetDataExports(ctx context.Context, req *userext.GetDataExportsReq) (*userext.GetDataExportsResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, s.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	exports, err := s.deletionDatabase.FindUserDataExports(ctx, req.UserID, dataExportListLimit)
+	if err != nil {
+		return nil, err
+	}
+	resp := &userext.GetDataExportsResp{Exports: make([]*userext.DataExport, 0, len(exports))}
+	for _, export := range exports {
+		resp.Exports = append(resp.Exports, dataExportDB2Pb(export, req.UrlPrefix))
+	}
+	return resp, nil
+}
+
+func (s *userServer) ProcessDataExports(ctx context.Context, req *userext.ProcessDataExportsReq) (*userext.ProcessDataExportsResp, error) {
+	if err := authverify.CheckAdmin(ctx, s.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	conf := s.config.RpcConfig.DataExport
+	batchSize := conf.BatchSize
+	if batchSize <= 0 {
+		batchSize = defaultExportBatchSize
+	}
+	leaseMinutes := conf.LeaseMinutes
+	if leaseMinutes <= 0 {
+		leaseMinutes = defaultExportLeaseMinute
+	}
+	var count int32
+	for i := 0; i < batchSize; i++ {
+		export, err := s.deletionDatabase.AcquireUserDataExport(ctx, time.Minute*time.Duration(leaseMinutes))
+		if err != nil {
+			if mgo.IsNotFound(err) {
+				break
+			}
+			return nil, err
+		}
+		object := fmt.Sprintf("%s/export/%s.zip", export.UserID, export.ExportID)
+		status := int32(model.UserDataExportStatusSucceeded)
+		var errMsg string
+		if err := s.exportUserData(ctx, export.UserID, object); err != nil {
+			log.ZError(ctx, "data export failed", err, "exportID", export.ExportID, "userID", export.UserID)
+			status, object, errMsg = model.UserDataExportStatusFailed, "", err.Error()
+		}
+		if err := s.deletionDatabase.FinishUserDataExport(ctx, export.ExportID, status, object, errMsg); err != nil {
+			return nil, err
+		}
+		count++
+	}
+	return &userext.ProcessDataExportsResp{Count: count}, nil
+}
+
+// exportUserData builds the archive of the user and uploads it as object.
+func (s *userServer) exportUserData(ctx context.Context, userID string, object string) error {
+	files, err := s.collectUserData(ctx, userID)
+	if err != nil {
+		return err
+	}
+	data, err := buildDataExportArchive(files)
+	if err != nil {
+		return err
+	}
+	return s.thirdRpcClient.UploadObject(ctx, object, dataExportContentType, dataExportCause, data)
+}
+
+// collectUserData returns the content of each file of the archive by file name.
+func (s *userServer) collectUserData(ctx context.Context, userID string) (map[string]any, error) {
+	users, err := s.db.FindWithError(ctx, []string{userID})
+	if err != nil {
+		return nil, err
+	}
+	var (
+		friends  []*sdkws.FriendInfo
+		blacks   []*sdkws.BlackInfo
+		groups   []*sdkws.GroupInfo
+		messages []*msg.ChatLog
+	)
+	for page := int32(1); ; page++ {
+		resp, err := s.friendRpcClient.Client.GetPaginationFriends(ctx, &friend.GetPaginationFriendsReq{
+			UserID:     userID,
+			Pagination: &sdkws.RequestPagination{PageNumber: page, ShowNumber: dataExportPageSize},
+		})
+		if err != nil {
+			return nil, err
+		}
+		friends = append(friends, resp.FriendsInfo...)
+		if len(resp.FriendsInfo) < dataExportPageSize {
+			break
+		}
+	}
+	for page := int32(1); ; page++ {
+		resp, err := s.friendRpcClient.Client.GetPaginationBlacks(ctx, &friend.GetPaginationBlacksReq{
+			UserID:     userID,
+			Pagination: &sdkws.RequestPagination{PageNumber: page, ShowNumber: dataExportPageSize},
+		})
+		if err != nil {
+			return nil, err
+		}
+		blacks = append(blacks, resp.Blacks...)
+		if len(resp.Blacks) < dataExportPageSize {
+			break
+		}
+	}
+	for page := int32(1); ; page++ {
+		resp, err := s.groupRpcClient.Client.GetJoinedGroupList(ctx, &group.GetJoinedGroupListReq{
+			FromUserID: userID,
+			Pagination: &sdkws.RequestPagination{PageNumber: page, ShowNumber: dataExportPageSize},
+		})
+		if err != nil {
+			return nil, err
+		}
+		groups = append(groups, resp.Groups...)
+		if len(resp.Groups) < dataExportPageSize {
+			break
+		}
+	}
+	maxMessages := s.config.RpcConfig.DataExport.MaxMessages
+	for page := int32(1); maxMessages <= 0 || len(messages) < maxMessages; page++ {
+		resp, err := s.msgRpcClient.Client.SearchMessage(ctx, &msg.SearchMessageReq{
+			SendID:     userID,
+			Pagination: &sdkws.RequestPagination{PageNumber: page, ShowNumber: dataExportPageSize},
+		})
+		if err != nil {
+			return nil, err
+		}
+		messages = append(messages, resp.ChatLogs...)
+		if len(resp.ChatLogs) < dataExportPageSize {
+			break
+		}
+	}
+	if maxMessages > 0 && len(messages) > maxMessages {
+		messages = messages[:maxMessages]
+	}
+	return map[string]any{
+		"profile.json":  convert.UsersDB2Pb(users)[0],
+		"friends.json":  friends,
+		"blacks.json":   blacks,
+		"groups.json":   groups,
+		"messages.json": messages,
+	}, nil
+}
+
+func buildDataExportArchive(files map[string]any) ([]byte, error) {
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	names := datautil.Keys(files)
+	sort.Strings(names)
+	for _, name := range names {
+		f, err := w.Create(name)
+		if err != nil {
+			return nil, errs.Wrap(err)
+		}
+		enc := json.NewEncoder(f)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(files[name]); err != nil {
+			return nil, errs.WrapMsg(err, "encode data export file failed", "name", name)
+		}
+	}
+	if err := w.Close(); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return buf.Bytes(), nil
+}
@@ -23,6 +23,7 @@ import (
 	tablerelation "github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/webhook"
 	"github.com/openimsdk/open-im-server/v3/pkg/localcache"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/userext"
 	"github.com/openimsdk/tools/db/redisutil"
 	"math/rand"
 	"strings"
@@ -51,6 +52,11 @@ type userServer struct {
 	userNotificationSender   *UserNotificationSender
 	friendRpcClient          *rpcclient.FriendRpcClient
 	groupRpcClient           *rpcclient.GroupRpcClient
+	msgRpcClient             *rpcclient.MessageRpcClient
+	authRpcClient            *rpcclient.Auth
+	conversationRpcClient    *rpcclient.Conversation
+	thirdRpcClient           *rpcclient.Third
+	deletionDatabase         controller.UserDeletionDatabase
 	RegisterCenter           registry.SvcDiscoveryRegistry
 	config                   *Config
 	webhookClient            *webhook.Client
@@ -89,6 +95,14 @@ func Start(ctx context.Context, config *Config, client registry.SvcDiscoveryRegi
 	userCache := redis.NewUserCacheRedis(rdb, &config.LocalCacheConfig, userDB, redis.GetRocksCacheOptions())
 	userMongoDB := mgo.NewUserMongoDriver(mgocli.GetDB())
 	database := controller.NewUserDatabase(userDB, userCache, mgocli.GetTx(), userMongoDB)
+	userDeletionDB, err := mgo.NewUserDeletionMongo(mgocli.GetDB())
+	if err != nil {
+		return err
+	}
+	userDataExportDB, err := mgo.NewUserDataExportMongo(mgocli.GetDB())
+	if err != nil {
+		return err
+	}
 	friendRpcClient := rpcclient.NewFriendRpcClient(client, config.Share.RpcRegisterName.Friend)
 	groupRpcClient := rpcclient.NewGroupRpcClient(client, config.Share.RpcRegisterName.Group)
 	msgRpcClient := rpcclient.NewMessageRpcClient(client, config.Share.RpcRegisterName.Msg)
@@ -98,12 +112,18 @@ func Start(ctx context.Context, config *Config, client registry.SvcDiscoveryRegi
 		RegisterCenter:           client,
 		friendRpcClient:          &friendRpcClient,
 		groupRpcClient:           &groupRpcClient,
+		msgRpcClient:             &msgRpcClient,
+		authRpcClient:            rpcclient.NewAuth(client, config.Share.RpcRegisterName.Auth),
+		conversationRpcClient:    rpcclient.NewConversation(client, config.Share.RpcRegisterName.Conversation),
+		thirdRpcClient:           rpcclient.NewThird(client, config.Share.RpcRegisterName.Third, ""),
+		deletionDatabase:         controller.NewUserDeletionDatabase(userDeletionDB, userDataExportDB),
 		friendNotificationSender: friend.NewFriendNotificationSender(&config.NotificationConfig, &msgRpcClient, friend.WithDBFunc(database.FindWithError)),
 		userNotificationSender:   NewUserNotificationSender(config, &msgRpcClient, WithUserFunc(database.FindWithError)),
 		config:                   config,
 		webhookClient:            webhook.NewWebhookClient(config.WebhooksConfig.URL),
 	}
 	pbuser.RegisterUserServer(server, u)
+	userext.RegisterUserExtServer(server, u)
 	return u.db.InitOnce(context.Background(), users)
 }
 
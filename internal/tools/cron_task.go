@@ -19,6 +19,10 @@ import (
 	"fmt"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
 	kdisc "github.com/openimsdk/open-im-server/v3/pkg/common/discoveryregister"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/friendext"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/groupext"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/msgext"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/userext"
 	"github.com/openimsdk/protocol/msg"
 	"github.com/openimsdk/tools/mcontext"
 	"github.com/openimsdk/tools/mw"
@@ -54,6 +58,7 @@ func Start(ctx context.Context, config *CronTaskConfig) error {
 		return err
 	}
 	cli := msg.NewMsgClient(conn)
+	extCli := msgext.NewMsgExtClient(conn)
 	crontab := cron.New()
 	clearFunc := func() {
 		now := time.Now()
@@ -69,7 +74,129 @@ func Start(ctx context.Context, config *CronTaskConfig) error {
 	if _, err := crontab.AddFunc(config.CronTask.ChatRecordsClearTime, clearFunc); err != nil {
 		return errs.Wrap(err)
 	}
-	log.ZInfo(ctx, "start cron task", "chatRecordsClearTime", config.CronTask.ChatRecordsClearTime)
+	if config.CronTask.CloseExpiredPollsTime != "" {
+		closePollsFunc := func() {
+			now := time.Now()
+			ctx := mcontext.SetOperationID(ctx, fmt.Sprintf("cron_poll_%d_%d", os.Getpid(), now.UnixMilli()))
+			resp, err := extCli.CloseExpiredPolls(ctx, &msgext.CloseExpiredPollsReq{})
+			if err != nil {
+				log.ZError(ctx, "cron close expired polls failed", err, "cont", time.Since(now))
+				return
+			}
+			log.ZInfo(ctx, "cron close expired polls success", "count", resp.Count, "cont", time.Since(now))
+		}
+		if _, err := crontab.AddFunc(config.CronTask.CloseExpiredPollsTime, closePollsFunc); err != nil {
+			return errs.Wrap(err)
+		}
+	}
+	groupConn, err := client.GetConn(ctx, config.Share.RpcRegisterName.Group)
+	if err != nil {
+		return err
+	}
+	groupExtCli := groupext.NewGroupExtClient(groupConn)
+	if config.CronTask.ExpireGroupRequestsTime != "" && config.CronTask.GroupRequestExpireHours > 0 {
+		expireRequestsFunc := func() {
+			now := time.Now()
+			before := now.Add(-time.Hour * time.Duration(config.CronTask.GroupRequestExpireHours))
+			ctx := mcontext.SetOperationID(ctx, fmt.Sprintf("cron_group_request_%d_%d", os.Getpid(), now.UnixMilli()))
+			resp, err := groupExtCli.ExpireGroupRequests(ctx, &groupext.ExpireGroupRequestsReq{Before: before.UnixMilli()})
+			if err != nil {
+				log.ZError(ctx, "cron expire group requests failed", err, "before", before, "cont", time.Since(now))
+				return
+			}
+			log.ZInfo(ctx, "cron expire group requests success", "count", resp.Count, "before", before, "cont", time.Since(now))
+		}
+		if _, err := crontab.AddFunc(config.CronTask.ExpireGroupRequestsTime, expireRequestsFunc); err != nil {
+			return errs.Wrap(err)
+		}
+	}
+	if config.CronTask.RefreshGroupDirectoryTime != "" {
+		refreshDirectoryFunc := func() {
+			now := time.Now()
+			ctx := mcontext.SetOperationID(ctx, fmt.Sprintf("cron_group_directory_%d_%d", os.Getpid(), now.UnixMilli()))
+			resp, err := groupExtCli.RefreshGroupDirectory(ctx, &groupext.RefreshGroupDirectoryReq{})
+			if err != nil {
+				log.ZError(ctx, "cron refresh group directory failed", err, "cont", time.Since(now))
+				return
+			}
+			log.ZInfo(ctx, "cron refresh group directory success", "count", resp.Count, "cont", time.Since(now))
+		}
+		if _, err := crontab.AddFunc(config.CronTask.RefreshGroupDirectoryTime, refreshDirectoryFunc); err != nil {
+			return errs.Wrap(err)
+		}
+	}
+	friendConn, err := client.GetConn(ctx, config.Share.RpcRegisterName.Friend)
+	if err != nil {
+		return err
+	}
+	friendExtCli := friendext.NewFriendExtClient(friendConn)
+	if config.CronTask.ExpireFriendRequestsTime != "" && config.CronTask.FriendRequestExpireHours > 0 {
+		expireFriendRequestsFunc := func() {
+			now := time.Now()
+			before := now.Add(-time.Hour * time.Duration(config.CronTask.FriendRequestExpireHours))
+			ctx := mcontext.SetOperationID(ctx, fmt.Sprintf("cron_friend_request_%d_%d", os.Getpid(), now.UnixMilli()))
+			resp, err := friendExtCli.ExpireFriendRequests(ctx, &friendext.ExpireFriendRequestsReq{Before: before.UnixMilli()})
+			if err != nil {
+				log.ZError(ctx, "cron expire friend requests failed", err, "before", before, "cont", time.Since(now))
+				return
+			}
+			log.ZInfo(ctx, "cron expire friend requests success", "count", resp.Count, "before", before, "cont", time.Since(now))
+		}
+		if _, err := crontab.AddFunc(config.CronTask.ExpireFriendRequestsTime, expireFriendRequestsFunc); err != nil {
+			return errs.Wrap(err)
+		}
+	}
+	if config.CronTask.RefreshFriendSuggestTime != "" {
+		refreshFriendSuggestionsFunc := func() {
+			now := time.Now()
+			ctx := mcontext.SetOperationID(ctx, fmt.Sprintf("cron_friend_suggestion_%d_%d", os.Getpid(), now.UnixMilli()))
+			resp, err := friendExtCli.RefreshFriendSuggestions(ctx, &friendext.RefreshFriendSuggestionsReq{})
+			if err != nil {
+				log.ZError(ctx, "cron refresh friend suggestions failed", err, "cont", time.Since(now))
+				return
+			}
+			log.ZInfo(ctx, "cron refresh friend suggestions success", "count", resp.Count, "cont", time.Since(now))
+		}
+		if _, err := crontab.AddFunc(config.CronTask.RefreshFriendSuggestTime, refreshFriendSuggestionsFunc); err != nil {
+			return errs.Wrap(err)
+		}
+	}
+	userConn, err := client.GetConn(ctx, config.Share.RpcRegisterName.User)
+	if err != nil {
+		return err
+	}
+	userExtCli := userext.NewUserExtClient(userConn)
+	if config.CronTask.PurgeDeletedUsersTime != "" {
+		purgeDeletedUsersFunc := func() {
+			now := time.Now()
+			ctx := mcontext.SetOperationID(ctx, fmt.Sprintf("cron_user_deletion_%d_%d", os.Getpid(), now.UnixMilli()))
+			resp, err := userExtCli.PurgeDeletedUsers(ctx, &userext.PurgeDeletedUsersReq{})
+			if err != nil {
+				log.ZError(ctx, "cron purge deleted users failed", err, "cont", time.Since(now))
+				return
+			}
+			log.ZInfo(ctx, "cron purge deleted users success", "count", resp.Count, "cont", time.Since(now))
+		}
+		if _, err := crontab.AddFunc(config.CronTask.PurgeDeletedUsersTime, purgeDeletedUsersFunc); err != nil {
+			return errs.Wrap(err)
+		}
+	}
+	if config.CronTask.ProcessDataExportsTime != "" {
+		processDataExportsFunc := func() {
+			now := time.Now()
+			ctx := mcontext.SetOperationID(ctx, fmt.Sprintf("cron_data_export_%d_%d", os.Getpid(), now.UnixMilli()))
+			resp, err := userExtCli.ProcessDataExports(ctx, &userext.ProcessDataExportsReq{})
+			if err != nil {
+				log.ZError(ctx, "cron process data exports failed", err, "cont", time.Since(now))
+				return
+			}
+			log.ZInfo(ctx, "cron process data exports success", "count", resp.Count, "cont", time.Since(now))
+		}
+		if _, err := crontab.AddFunc(config.CronTask.ProcessDataExportsTime, processDataExportsFunc); err != nil {
+			return errs.Wrap(err)
+		}
+	}
+	log.ZInfo(ctx, "start cron task", "chatRecordsClearTime", config.CronTask.ChatRecordsClearTime, "closeExpiredPollsTime", config.CronTask.CloseExpiredPollsTime, "expireGroupRequestsTime", config.CronTask.ExpireGroupRequestsTime, "refreshGroupDirectoryTime", config.CronTask.RefreshGroupDirectoryTime, "expireFriendRequestsTime", config.CronTask.ExpireFriendRequestsTime, "refreshFriendSuggestTime", config.CronTask.RefreshFriendSuggestTime, "purgeDeletedUsersTime", config.CronTask.PurgeDeletedUsersTime, "processDataExportsTime", config.CronTask.ProcessDataExportsTime)
 	crontab.Start()
 	<-ctx.Done()
 	return nil
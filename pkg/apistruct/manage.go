@@ -108,6 +108,21 @@ type BatchSendMsgResp struct {
 	FailedIDs []string `json:"failedUserIDs"`
 }
 
+// SubmitBroadcastReq defines the structure for submitting a message to be sent to many users by a background job.
+type SubmitBroadcastReq struct {
+	SendMsg
+
+	// Mode selects the recipients: 1 for all users, 2 for RecvIDs, 3 for users matching the filter.
+	Mode int32 `json:"mode" binding:"required,oneof=1 2 3"`
+
+	// RecvIDs is a slice of receiver identifiers, required when Mode is 2.
+	RecvIDs []string `json:"recvIDs" binding:"required_if=Mode 2"`
+
+	// FilterUserID and FilterNickname match users by keyword when Mode is 3.
+	FilterUserID   string `json:"filterUserID"`
+	FilterNickname string `json:"filterNickname"`
+}
+
 // SingleReturnResult encapsulates the result of a single message send attempt.
 type SingleReturnResult struct {
 	// ServerMsgID is the message identifier on the server-side.
@@ -0,0 +1,123 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package authverify
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"net"
+	"strings"
+
+	"github.com/openimsdk/tools/errs"
+)
+
+// API key scopes, each granting a group of admin APIs.
+const (
+	ScopeMsgSend      = "msg:send"
+	ScopeMsgRead      = "msg:read"
+	ScopeUserRegister = "user:register"
+	ScopeUserRead     = "user:read"
+	ScopeUserWrite    = "user:write"
+	ScopeUserToken    = "user:token"
+	ScopeGroupRead    = "group:read"
+	ScopeGroupWrite   = "group:write"
+	ScopeFriendWrite  = "friend:write"
+)
+
+var APIKeyScopes = []string{
+	ScopeMsgSend, ScopeMsgRead,
+	ScopeUserRegister, ScopeUserRead, ScopeUserWrite, ScopeUserToken,
+	ScopeGroupRead, ScopeGroupWrite,
+	ScopeFriendWrite,
+}
+
+const apiKeyPrefix = "oimk"
+
+// GenAPIKey returns a new API key together with its id and the hash of its secret.
+// The key has the form oimk_<keyID>_<secret>.
+func GenAPIKey() (apiKey string, keyID string, secretHash string, err error) {
+	id := make([]byte, 8)
+	if _, err := rand.Read(id); err != nil {
+		return "", "", "", errs.WrapMsg(err, "rand.Read")
+	}
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", "", "", errs.WrapMsg(err, "rand.Read")
+	}
+	keyID = hex.EncodeToString(id)
+	secretStr := base64.RawURLEncoding.EncodeToString(secret)
+	return apiKeyPrefix + "_" + keyID + "_" + secretStr, keyID, HashAPIKeySecret(secretStr), nil
+}
+
+// ParseAPIKey splits an API key into its id and secret.
+func ParseAPIKey(apiKey string) (keyID string, secret string, ok bool) {
+	arr := strings.SplitN(apiKey, "_", 3)
+	if len(arr) != 3 || arr[0] != apiKeyPrefix || arr[1] == "" || arr[2] == "" {
+		return "", "", false
+	}
+	return arr[1], arr[2], true
+}
+
+func HashAPIKeySecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
+
+// CheckAPIKeySecret compares the secret with the stored hash in constant time.
+func CheckAPIKeySecret(secret string, secretHash string) bool {
+	return subtle.ConstantTimeCompare([]byte(HashAPIKeySecret(secret)), []byte(secretHash)) == 1
+}
+
+// IPAllowed reports whether ip matches one of the IPs or CIDRs of the allowlist; an empty allowlist allows any IP.
+func IPAllowed(allowlist []string, ip string) bool {
+	if len(allowlist) == 0 {
+		return true
+	}
+	addr := net.ParseIP(ip)
+	if addr == nil {
+		return false
+	}
+	for _, v := range allowlist {
+		if strings.Contains(v, "/") {
+			if _, ipNet, err := net.ParseCIDR(v); err == nil && ipNet.Contains(addr) {
+				return true
+			}
+			continue
+		}
+		if allowed := net.ParseIP(v); allowed != nil && allowed.Equal(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// CheckIPAllowlist validates the entries of an allowlist.
+func CheckIPAllowlist(allowlist []string) error {
+	for _, v := range allowlist {
+		if strings.Contains(v, "/") {
+			if _, _, err := net.ParseCIDR(v); err != nil {
+				return errs.ErrArgs.WrapMsg("invalid cidr", "cidr", v)
+			}
+			continue
+		}
+		if net.ParseIP(v) == nil {
+			return errs.ErrArgs.WrapMsg("invalid ip", "ip", v)
+		}
+	}
+	return nil
+}
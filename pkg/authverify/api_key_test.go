@@ -0,0 +1,65 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package authverify
+
+import "testing"
+
+func TestAPIKey(t *testing.T) {
+	apiKey, keyID, secretHash, err := GenAPIKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	id, secret, ok := ParseAPIKey(apiKey)
+	if !ok || id != keyID {
+		t.Fatalf("parse %s failed", apiKey)
+	}
+	if !CheckAPIKeySecret(secret, secretHash) {
+		t.Fatal("secret not accepted")
+	}
+	if CheckAPIKeySecret(secret+"x", secretHash) {
+		t.Fatal("wrong secret accepted")
+	}
+	for _, v := range []string{"", "oimk", "oimk__x", "other_" + keyID + "_" + secret} {
+		if _, _, ok := ParseAPIKey(v); ok {
+			t.Fatalf("malformed key %q accepted", v)
+		}
+	}
+}
+
+func TestIPAllowed(t *testing.T) {
+	allowlist := []string{"10.0.0.0/8", "192.168.1.10", "2001:db8::/32"}
+	if err := CheckIPAllowlist(allowlist); err != nil {
+		t.Fatal(err)
+	}
+	cases := map[string]bool{
+		"10.1.2.3":     true,
+		"192.168.1.10": true,
+		"192.168.1.11": false,
+		"2001:db8::1":  true,
+		"8.8.8.8":      false,
+		"bad":          false,
+	}
+	for ip, expected := range cases {
+		if IPAllowed(allowlist, ip) != expected {
+			t.Errorf("IPAllowed(%s) != %v", ip, expected)
+		}
+	}
+	if !IPAllowed(nil, "8.8.8.8") {
+		t.Error("empty allowlist must allow any ip")
+	}
+	if err := CheckIPAllowlist([]string{"10.0.0.0/33"}); err == nil {
+		t.Error("invalid cidr accepted")
+	}
+}
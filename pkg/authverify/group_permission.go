@@ -0,0 +1,101 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package authverify
+
+import (
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/groupext"
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/utils/datautil"
+)
+
+// Group permissions, granted to members by their role level.
+const (
+	GroupPermissionKick           = "kick"
+	GroupPermissionMute           = "mute"
+	GroupPermissionInvite         = "invite"
+	GroupPermissionEditInfo       = "editInfo"
+	GroupPermissionPin            = "pin"
+	GroupPermissionSendDuringMute = "sendDuringMute"
+	GroupPermissionApproveJoin    = "approveJoin"
+	GroupPermissionAtAll          = "atAll"
+)
+
+var GroupPermissions = []string{
+	GroupPermissionKick,
+	GroupPermissionMute,
+	GroupPermissionInvite,
+	GroupPermissionEditInfo,
+	GroupPermissionPin,
+	GroupPermissionSendDuringMute,
+	GroupPermissionApproveJoin,
+	GroupPermissionAtAll,
+}
+
+// ordinaryGroupPermissions keeps what ordinary members could always do.
+var ordinaryGroupPermissions = []string{GroupPermissionAtAll}
+
+// IsCustomGroupRoleLevel reports whether roleLevel belongs to a custom role, which ranks above ordinary members and below admins.
+func IsCustomGroupRoleLevel(roleLevel int32) bool {
+	return roleLevel > constant.GroupOrdinaryUsers && roleLevel < constant.GroupAdmin
+}
+
+// CheckGroupRole validates the definition of a custom role.
+func CheckGroupRole(roleLevel int32, permissions []string) error {
+	if !IsCustomGroupRoleLevel(roleLevel) {
+		return errs.ErrArgs.WrapMsg("custom role level must be between ordinary member and admin", "roleLevel", roleLevel)
+	}
+	for _, permission := range permissions {
+		if !datautil.Contain(permission, GroupPermissions...) {
+			return errs.ErrArgs.WrapMsg("unknown group permission", "permission", permission)
+		}
+	}
+	if datautil.Duplicate(permissions) {
+		return errs.ErrArgs.WrapMsg("duplicate group permission")
+	}
+	return nil
+}
+
+// HasGroupPermission is the single place deciding what a member may do in a group.
+// Owners and admins hold every permission, roles holds the custom roles of the group
+// and is only consulted for custom role levels.
+func HasGroupPermission(roleLevel int32, roles []*groupext.GroupRole, permission string) bool {
+	switch roleLevel {
+	case constant.GroupOwner, constant.GroupAdmin:
+		return true
+	case constant.GroupOrdinaryUsers:
+		return datautil.Contain(permission, ordinaryGroupPermissions...)
+	}
+	for _, role := range roles {
+		if role.RoleLevel == roleLevel {
+			return datautil.Contain(permission, role.Permissions...)
+		}
+	}
+	return false
+}
+
+// CanOperateGroupMember reports whether a member may act on another member, which requires a higher rank
+// unless the operator is the owner.
+func CanOperateGroupMember(opRoleLevel int32, roleLevel int32) bool {
+	return opRoleLevel == constant.GroupOwner || roleLevel < opRoleLevel
+}
+
+// CheckGroupPermission returns a no permission error when the member lacks permission.
+func CheckGroupPermission(roleLevel int32, roles []*groupext.GroupRole, permission string) error {
+	if !HasGroupPermission(roleLevel, roles, permission) {
+		return errs.ErrNoPermission.WrapMsg("no group permission", "permission", permission, "roleLevel", roleLevel)
+	}
+	return nil
+}
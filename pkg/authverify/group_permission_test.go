@@ -0,0 +1,78 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package authverify
+
+import (
+	"testing"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/groupext"
+	"github.com/openimsdk/protocol/constant"
+)
+
+func TestHasGroupPermission(t *testing.T) {
+	roles := []*groupext.GroupRole{
+		{RoleLevel: 30, Permissions: []string{GroupPermissionMute, GroupPermissionPin}},
+	}
+	cases := []struct {
+		roleLevel  int32
+		permission string
+		expected   bool
+	}{
+		{constant.GroupOwner, GroupPermissionKick, true},
+		{constant.GroupAdmin, GroupPermissionSendDuringMute, true},
+		{constant.GroupOrdinaryUsers, GroupPermissionAtAll, true},
+		{constant.GroupOrdinaryUsers, GroupPermissionMute, false},
+		{30, GroupPermissionMute, true},
+		{30, GroupPermissionKick, false},
+		{40, GroupPermissionMute, false},
+	}
+	for _, c := range cases {
+		if HasGroupPermission(c.roleLevel, roles, c.permission) != c.expected {
+			t.Errorf("HasGroupPermission(%d, %s) != %v", c.roleLevel, c.permission, c.expected)
+		}
+	}
+}
+
+func TestCanOperateGroupMember(t *testing.T) {
+	if !CanOperateGroupMember(constant.GroupOwner, constant.GroupAdmin) {
+		t.Error("owner must operate admins")
+	}
+	if CanOperateGroupMember(constant.GroupAdmin, constant.GroupAdmin) {
+		t.Error("admin must not operate other admins")
+	}
+	if !CanOperateGroupMember(30, constant.GroupOrdinaryUsers) {
+		t.Error("custom role must operate ordinary members")
+	}
+	if CanOperateGroupMember(30, 40) {
+		t.Error("custom role must not operate higher roles")
+	}
+}
+
+func TestCheckGroupRole(t *testing.T) {
+	if err := CheckGroupRole(30, []string{GroupPermissionKick}); err != nil {
+		t.Fatal(err)
+	}
+	for _, level := range []int32{constant.GroupOrdinaryUsers, constant.GroupAdmin, constant.GroupOwner} {
+		if CheckGroupRole(level, nil) == nil {
+			t.Errorf("role level %d accepted", level)
+		}
+	}
+	if CheckGroupRole(30, []string{"unknown"}) == nil {
+		t.Error("unknown permission accepted")
+	}
+	if CheckGroupRole(30, []string{GroupPermissionKick, GroupPermissionKick}) == nil {
+		t.Error("duplicate permission accepted")
+	}
+}
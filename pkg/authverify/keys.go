@@ -0,0 +1,331 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package authverify
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"math/big"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/authext"
+	"github.com/openimsdk/tools/errs"
+)
+
+const (
+	AlgorithmRS256 = "RS256"
+	AlgorithmEdDSA = "EdDSA"
+)
+
+type signingKey struct {
+	keyID      string
+	method     jwt.SigningMethod
+	privateKey crypto.Signer
+	notAfter   time.Time
+}
+
+func (k *signingKey) expired(now time.Time) bool {
+	return !k.notAfter.IsZero() && now.After(k.notAfter)
+}
+
+// TokenKeys signs tokens with the active key and resolves verification keys by the kid header.
+// Without an active key, tokens are signed with HS256 and the shared secret.
+type TokenKeys struct {
+	secret       []byte
+	acceptSecret bool
+	active       *signingKey
+	keys         map[string]*signingKey
+	order        []string
+}
+
+func NewTokenKeys(conf *config.TokenSigning, secret string) (*TokenKeys, error) {
+	t := &TokenKeys{
+		secret:       []byte(secret),
+		acceptSecret: conf.ActiveKeyID == "" || conf.AcceptSecret,
+		keys:         make(map[string]*signingKey),
+	}
+	for i := range conf.Keys {
+		key, err := loadSigningKey(&conf.Keys[i])
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := t.keys[key.keyID]; ok {
+			return nil, errs.New("duplicate signing key", "keyID", key.keyID).Wrap()
+		}
+		t.keys[key.keyID] = key
+		t.order = append(t.order, key.keyID)
+	}
+	if conf.ActiveKeyID != "" {
+		active, ok := t.keys[conf.ActiveKeyID]
+		if !ok {
+			return nil, errs.New("active signing key not found", "keyID", conf.ActiveKeyID).Wrap()
+		}
+		if active.expired(time.Now()) {
+			return nil, errs.New("active signing key has expired", "keyID", conf.ActiveKeyID).Wrap()
+		}
+		t.active = active
+	}
+	return t, nil
+}
+
+func loadSigningKey(conf *config.SigningKey) (*signingKey, error) {
+	if conf.KeyID == "" {
+		return nil, errs.New("signing key keyID is empty").Wrap()
+	}
+	data, err := readConfigFile(conf.PrivateKeyFile)
+	if err != nil {
+		return nil, err
+	}
+	key := &signingKey{keyID: conf.KeyID}
+	switch conf.Algorithm {
+	case AlgorithmRS256:
+		key.method = jwt.SigningMethodRS256
+		key.privateKey, err = jwt.ParseRSAPrivateKeyFromPEM(data)
+	case AlgorithmEdDSA:
+		key.method = jwt.SigningMethodEdDSA
+		var privateKey crypto.PrivateKey
+		privateKey, err = jwt.ParseEdPrivateKeyFromPEM(data)
+		if err == nil {
+			key.privateKey = privateKey.(ed25519.PrivateKey)
+		}
+	default:
+		return nil, errs.New("unsupported signing algorithm", "keyID", conf.KeyID, "algorithm", conf.Algorithm).Wrap()
+	}
+	if err != nil {
+		return nil, errs.WrapMsg(err, "parse signing key failed", "keyID", conf.KeyID)
+	}
+	if conf.NotAfter != "" {
+		key.notAfter, err = time.Parse(time.RFC3339, conf.NotAfter)
+		if err != nil {
+			return nil, errs.WrapMsg(err, "parse signing key notAfter failed", "keyID", conf.KeyID)
+		}
+	}
+	return key, nil
+}
+
+// readConfigFile reads a file given either as an absolute path or relative to the config directory.
+func readConfigFile(path string) ([]byte, error) {
+	if !filepath.IsAbs(path) {
+		projectRoot, err := config.GetProjectRoot()
+		if err != nil {
+			return nil, err
+		}
+		path = filepath.Join(projectRoot, "config", path)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errs.WrapMsg(err, "read file failed", "path", path)
+	}
+	return data, nil
+}
+
+// Sign signs claims with the active key, or with the shared secret when no active key is configured.
+func (t *TokenKeys) Sign(claims jwt.Claims) (string, error) {
+	if t.active == nil {
+		token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(t.secret)
+		if err != nil {
+			return "", errs.WrapMsg(err, "token.SignedString")
+		}
+		return token, nil
+	}
+	token := jwt.NewWithClaims(t.active.method, claims)
+	token.Header["kid"] = t.active.keyID
+	tokenString, err := token.SignedString(t.active.privateKey)
+	if err != nil {
+		return "", errs.WrapMsg(err, "token.SignedString", "keyID", t.active.keyID)
+	}
+	return tokenString, nil
+}
+
+// Keyfunc returns the verification key of a token. The algorithm must match the key the kid refers to,
+// and tokens without a kid are only accepted as HS256 tokens signed with the shared secret.
+func (t *TokenKeys) Keyfunc(token *jwt.Token) (any, error) {
+	kid, _ := token.Header["kid"].(string)
+	if kid == "" {
+		if !t.acceptSecret {
+			return nil, errs.New("token without kid is not accepted")
+		}
+		if token.Method.Alg() != jwt.SigningMethodHS256.Alg() {
+			return nil, errs.New("unexpected signing method", "alg", token.Method.Alg())
+		}
+		return t.secret, nil
+	}
+	key, ok := t.keys[kid]
+	if !ok {
+		return nil, errs.New("unknown signing key", "kid", kid)
+	}
+	if token.Method.Alg() != key.method.Alg() {
+		return nil, errs.New("unexpected signing method", "kid", kid, "alg", token.Method.Alg())
+	}
+	if key.expired(time.Now()) {
+		return nil, errs.New("signing key has expired", "kid", kid)
+	}
+	return key.privateKey.Public(), nil
+}
+
+// JWKS returns the public keys that can still verify tokens.
+func (t *TokenKeys) JWKS() []*JSONWebKey {
+	now := time.Now()
+	keys := make([]*JSONWebKey, 0, len(t.order))
+	for _, keyID := range t.order {
+		key := t.keys[keyID]
+		if key.expired(now) {
+			continue
+		}
+		jwk, err := NewJSONWebKey(key.keyID, key.method.Alg(), key.privateKey.Public())
+		if err != nil {
+			continue
+		}
+		keys = append(keys, jwk)
+	}
+	return keys
+}
+
+// JSONWebKey is a public key in the RFC 7517 JSON Web Key format.
+type JSONWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid,omitempty"`
+	Use string `json:"use,omitempty"`
+	Alg string `json:"alg,omitempty"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+	Y   string `json:"y,omitempty"`
+}
+
+func NewJSONWebKey(kid string, alg string, publicKey crypto.PublicKey) (*JSONWebKey, error) {
+	switch key := publicKey.(type) {
+	case *rsa.PublicKey:
+		return &JSONWebKey{
+			Kty: "RSA",
+			Kid: kid,
+			Use: "sig",
+			Alg: alg,
+			N:   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		}, nil
+	case ed25519.PublicKey:
+		return &JSONWebKey{
+			Kty: "OKP",
+			Kid: kid,
+			Use: "sig",
+			Alg: alg,
+			Crv: "Ed25519",
+			X:   base64.RawURLEncoding.EncodeToString(key),
+		}, nil
+	default:
+		return nil, errs.New("unsupported public key type").Wrap()
+	}
+}
+
+// JSONWebKeysToPb converts the keys to the form the auth service returns them in.
+func JSONWebKeysToPb(keys []*JSONWebKey) []*authext.JsonWebKey {
+	pbKeys := make([]*authext.JsonWebKey, 0, len(keys))
+	for _, key := range keys {
+		pbKeys = append(pbKeys, &authext.JsonWebKey{
+			Kty: key.Kty,
+			Kid: key.Kid,
+			Use: key.Use,
+			Alg: key.Alg,
+			N:   key.N,
+			E:   key.E,
+			Crv: key.Crv,
+			X:   key.X,
+		})
+	}
+	return pbKeys
+}
+
+// JSONWebKeysFromPb converts the keys returned by the auth service back to JSON Web Keys.
+func JSONWebKeysFromPb(pbKeys []*authext.JsonWebKey) []*JSONWebKey {
+	keys := make([]*JSONWebKey, 0, len(pbKeys))
+	for _, key := range pbKeys {
+		keys = append(keys, &JSONWebKey{
+			Kty: key.Kty,
+			Kid: key.Kid,
+			Use: key.Use,
+			Alg: key.Alg,
+			N:   key.N,
+			E:   key.E,
+			Crv: key.Crv,
+			X:   key.X,
+		})
+	}
+	return keys
+}
+
+// PublicKey decodes the public key described by the JSON Web Key.
+func (k *JSONWebKey) PublicKey() (crypto.PublicKey, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil {
+			return nil, errs.WrapMsg(err, "decode jwk n failed", "kid", k.Kid)
+		}
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil {
+			return nil, errs.WrapMsg(err, "decode jwk e failed", "kid", k.Kid)
+		}
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, errs.New("unsupported jwk curve", "kid", k.Kid, "crv", k.Crv).Wrap()
+		}
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil {
+			return nil, errs.WrapMsg(err, "decode jwk x failed", "kid", k.Kid)
+		}
+		y, err := base64.RawURLEncoding.DecodeString(k.Y)
+		if err != nil {
+			return nil, errs.WrapMsg(err, "decode jwk y failed", "kid", k.Kid)
+		}
+		key := &ecdsa.PublicKey{Curve: curve, X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}
+		if !curve.IsOnCurve(key.X, key.Y) {
+			return nil, errs.New("invalid ec jwk", "kid", k.Kid).Wrap()
+		}
+		return key, nil
+	case "OKP":
+		if k.Crv != "Ed25519" {
+			return nil, errs.New("unsupported jwk curve", "kid", k.Kid, "crv", k.Crv).Wrap()
+		}
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil {
+			return nil, errs.WrapMsg(err, "decode jwk x failed", "kid", k.Kid)
+		}
+		if len(x) != ed25519.PublicKeySize {
+			return nil, errs.New("invalid ed25519 jwk", "kid", k.Kid).Wrap()
+		}
+		return ed25519.PublicKey(x), nil
+	default:
+		return nil, errs.New("unsupported jwk type", "kid", k.Kid, "kty", k.Kty).Wrap()
+	}
+}
@@ -0,0 +1,103 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package authverify
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+)
+
+func writePrivateKey(t *testing.T, dir string, name string, key any) string {
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(dir, name)
+	if err := os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestJWKSRoundTrip(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir := t.TempDir()
+	keys, err := NewTokenKeys(&config.TokenSigning{
+		ActiveKeyID: "rsa-1",
+		Keys: []config.SigningKey{
+			{KeyID: "rsa-1", Algorithm: AlgorithmRS256, PrivateKeyFile: writePrivateKey(t, dir, "rsa.pem", rsaKey)},
+			{KeyID: "ed-1", Algorithm: AlgorithmEdDSA, PrivateKeyFile: writePrivateKey(t, dir, "ed.pem", edKey)},
+			{KeyID: "old", Algorithm: AlgorithmRS256, PrivateKeyFile: writePrivateKey(t, dir, "old.pem", rsaKey), NotAfter: time.Now().Add(-time.Hour).Format(time.RFC3339)},
+		},
+	}, "secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	jwks := JSONWebKeysFromPb(JSONWebKeysToPb(keys.JWKS()))
+	if len(jwks) != 2 {
+		t.Fatalf("got %d keys, want the 2 unexpired ones", len(jwks))
+	}
+	published := make(map[string]*JSONWebKey)
+	for _, jwk := range jwks {
+		published[jwk.Kid] = jwk
+	}
+	rsaJWK, ok := published["rsa-1"]
+	if !ok || rsaJWK.Kty != "RSA" || rsaJWK.Alg != AlgorithmRS256 {
+		t.Fatalf("rsa key published as %+v", rsaJWK)
+	}
+	publicKey, err := rsaJWK.PublicKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !rsaKey.PublicKey.Equal(publicKey) {
+		t.Fatal("rsa key changed through the protobuf round trip")
+	}
+	edPublicKey, err := published["ed-1"].PublicKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !edKey.Public().(ed25519.PublicKey).Equal(edPublicKey) {
+		t.Fatal("ed25519 key changed through the protobuf round trip")
+	}
+
+	// A token signed by the server verifies with the published key alone.
+	tokenString, err := keys.Sign(jwt.RegisteredClaims{Subject: "u1", ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour))})
+	if err != nil {
+		t.Fatal(err)
+	}
+	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (any, error) {
+		return published[token.Header["kid"].(string)].PublicKey()
+	})
+	if err != nil || !token.Valid {
+		t.Fatalf("token does not verify with the published key: %v", err)
+	}
+}
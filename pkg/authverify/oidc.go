@@ -0,0 +1,287 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package authverify
+
+import (
+	"context"
+	"crypto"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/servererrs"
+	"github.com/openimsdk/tools/errs"
+)
+
+const (
+	// oidcKeysExpire is how long keys fetched from an issuer are trusted before they are fetched again.
+	oidcKeysExpire = time.Hour
+	// oidcRefetchInterval limits refetching when a token refers to an unknown kid.
+	oidcRefetchInterval = time.Minute
+	oidcMaxResponseSize = 1 << 20
+)
+
+var oidcValidMethods = []string{"RS256", "RS384", "RS512", "ES256", "ES384", "ES512", "EdDSA"}
+
+// OIDCVerifier validates ID tokens issued by trusted OpenID Connect issuers.
+type OIDCVerifier struct {
+	issuers map[string]*oidcIssuer
+	client  *http.Client
+}
+
+type oidcIssuer struct {
+	conf     *config.OIDCIssuer
+	static   bool
+	lock     sync.Mutex
+	keys     map[string]crypto.PublicKey
+	fetched  time.Time
+	attempts time.Time
+	// fetching is closed when the running JWKS fetch finishes, nil when no fetch is running.
+	fetching chan struct{}
+}
+
+func NewOIDCVerifier(conf *config.OIDC) (*OIDCVerifier, error) {
+	v := &OIDCVerifier{
+		issuers: make(map[string]*oidcIssuer),
+		client:  &http.Client{Timeout: 10 * time.Second},
+	}
+	prefixes := make(map[string]string)
+	for i := range conf.Issuers {
+		issuerConf := &conf.Issuers[i]
+		if issuerConf.Issuer == "" {
+			return nil, errs.New("oidc issuer is empty").Wrap()
+		}
+		if len(issuerConf.Audiences) == 0 {
+			return nil, errs.New("oidc issuer audiences is empty", "issuer", issuerConf.Issuer).Wrap()
+		}
+		if _, ok := v.issuers[issuerConf.Issuer]; ok {
+			return nil, errs.New("duplicate oidc issuer", "issuer", issuerConf.Issuer).Wrap()
+		}
+		// the prefix keeps subjects of an issuer from mapping onto existing or other issuers' userIDs
+		if issuerConf.UserIDPrefix == "" {
+			return nil, errs.New("oidc issuer userIDPrefix is empty", "issuer", issuerConf.Issuer).Wrap()
+		}
+		for prefix, other := range prefixes {
+			if strings.HasPrefix(prefix, issuerConf.UserIDPrefix) || strings.HasPrefix(issuerConf.UserIDPrefix, prefix) {
+				return nil, errs.New("oidc issuer userIDPrefix overlaps", "issuer", issuerConf.Issuer, "other", other).Wrap()
+			}
+		}
+		prefixes[issuerConf.UserIDPrefix] = issuerConf.Issuer
+		issuer := &oidcIssuer{conf: issuerConf}
+		if issuerConf.JWKSFile != "" {
+			data, err := readConfigFile(issuerConf.JWKSFile)
+			if err != nil {
+				return nil, err
+			}
+			issuer.keys, err = parseJWKS(data)
+			if err != nil {
+				return nil, errs.WrapMsg(err, "parse oidc jwks file failed", "issuer", issuerConf.Issuer)
+			}
+			issuer.static = true
+		}
+		v.issuers[issuerConf.Issuer] = issuer
+	}
+	return v, nil
+}
+
+// Verify checks the signature, issuer, audience and lifetime of an ID token and returns its claims
+// together with the configuration of the issuer that signed it.
+func (v *OIDCVerifier) Verify(ctx context.Context, idToken string) (*config.OIDCIssuer, jwt.MapClaims, error) {
+	parser := jwt.NewParser(jwt.WithValidMethods(oidcValidMethods))
+	unverified, _, err := parser.ParseUnverified(idToken, jwt.MapClaims{})
+	if err != nil {
+		return nil, nil, servererrs.ErrTokenMalformed.WrapMsg(err.Error())
+	}
+	iss, _ := unverified.Claims.(jwt.MapClaims)["iss"].(string)
+	issuer, ok := v.issuers[iss]
+	if !ok {
+		return nil, nil, servererrs.ErrTokenInvalid.WrapMsg("untrusted id token issuer", "iss", iss)
+	}
+	token, err := parser.ParseWithClaims(idToken, jwt.MapClaims{}, func(token *jwt.Token) (any, error) {
+		kid, _ := token.Header["kid"].(string)
+		return v.publicKey(ctx, issuer, kid)
+	})
+	if err != nil {
+		if ve, ok := err.(*jwt.ValidationError); ok && ve.Errors&jwt.ValidationErrorExpired != 0 {
+			return nil, nil, servererrs.ErrTokenExpired.WrapMsg("id token expired")
+		}
+		return nil, nil, servererrs.ErrTokenInvalid.WrapMsg(err.Error())
+	}
+	claims := token.Claims.(jwt.MapClaims)
+	if !claims.VerifyExpiresAt(time.Now().Unix(), true) {
+		return nil, nil, servererrs.ErrTokenInvalid.WrapMsg("id token exp is missing")
+	}
+	var audienceOK bool
+	for _, audience := range issuer.conf.Audiences {
+		if claims.VerifyAudience(audience, true) {
+			audienceOK = true
+			break
+		}
+	}
+	if !audienceOK {
+		return nil, nil, servererrs.ErrTokenInvalid.WrapMsg("id token audience is not accepted", "iss", iss)
+	}
+	return issuer.conf, claims, nil
+}
+
+// publicKey returns the key of kid, fetching the issuer's JWKS when the key is unknown or stale.
+// The fetch runs without holding the issuer lock; concurrent callers wait for the running fetch.
+func (v *OIDCVerifier) publicKey(ctx context.Context, issuer *oidcIssuer, kid string) (crypto.PublicKey, error) {
+	issuer.lock.Lock()
+	key, ok := issuer.lookup(kid)
+	now := time.Now()
+	if issuer.static || (ok && now.Sub(issuer.fetched) < oidcKeysExpire) {
+		issuer.lock.Unlock()
+		return oidcKeyResult(kid, key, ok, nil)
+	}
+	if done := issuer.fetching; done != nil {
+		issuer.lock.Unlock()
+		select {
+		case <-done:
+		case <-ctx.Done():
+			return nil, errs.Wrap(ctx.Err())
+		}
+		issuer.lock.Lock()
+		key, ok = issuer.lookup(kid)
+		issuer.lock.Unlock()
+		return oidcKeyResult(kid, key, ok, nil)
+	}
+	if now.Sub(issuer.attempts) < oidcRefetchInterval && now.Sub(issuer.fetched) < oidcKeysExpire {
+		issuer.lock.Unlock()
+		return oidcKeyResult(kid, key, ok, nil)
+	}
+	issuer.attempts = now
+	done := make(chan struct{})
+	issuer.fetching = done
+	issuer.lock.Unlock()
+
+	keys, err := v.fetchKeys(ctx, issuer.conf)
+
+	issuer.lock.Lock()
+	defer issuer.lock.Unlock()
+	issuer.fetching = nil
+	close(done)
+	if err != nil {
+		// keep using the cached key when the issuer is temporarily unavailable
+		return oidcKeyResult(kid, key, ok, err)
+	}
+	issuer.keys = keys
+	issuer.fetched = time.Now()
+	key, ok = issuer.lookup(kid)
+	return oidcKeyResult(kid, key, ok, nil)
+}
+
+func oidcKeyResult(kid string, key crypto.PublicKey, ok bool, fetchErr error) (crypto.PublicKey, error) {
+	if ok {
+		return key, nil
+	}
+	if fetchErr != nil {
+		return nil, fetchErr
+	}
+	return nil, errs.New("unknown id token signing key", "kid", kid)
+}
+
+// lookup finds the key of kid; a token without kid is accepted only when the issuer publishes a single key.
+func (i *oidcIssuer) lookup(kid string) (crypto.PublicKey, bool) {
+	if kid == "" {
+		if len(i.keys) != 1 {
+			return nil, false
+		}
+		for _, key := range i.keys {
+			return key, true
+		}
+	}
+	key, ok := i.keys[kid]
+	return key, ok
+}
+
+func (v *OIDCVerifier) fetchKeys(ctx context.Context, conf *config.OIDCIssuer) (map[string]crypto.PublicKey, error) {
+	discoveryURL := conf.DiscoveryURL
+	if discoveryURL == "" {
+		discoveryURL = strings.TrimSuffix(conf.Issuer, "/") + "/.well-known/openid-configuration"
+	}
+	data, err := v.get(ctx, discoveryURL)
+	if err != nil {
+		return nil, err
+	}
+	var discovery struct {
+		Issuer  string `json:"issuer"`
+		JwksURI string `json:"jwks_uri"`
+	}
+	if err := json.Unmarshal(data, &discovery); err != nil {
+		return nil, errs.WrapMsg(err, "parse oidc discovery document failed", "url", discoveryURL)
+	}
+	if discovery.Issuer != conf.Issuer {
+		return nil, errs.New("oidc discovery issuer mismatch", "expected", conf.Issuer, "actual", discovery.Issuer).Wrap()
+	}
+	if discovery.JwksURI == "" {
+		return nil, errs.New("oidc discovery document has no jwks_uri", "url", discoveryURL).Wrap()
+	}
+	data, err = v.get(ctx, discovery.JwksURI)
+	if err != nil {
+		return nil, err
+	}
+	return parseJWKS(data)
+}
+
+func (v *OIDCVerifier) get(ctx context.Context, url string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, errs.WrapMsg(err, "create request failed", "url", url)
+	}
+	resp, err := v.client.Do(req)
+	if err != nil {
+		return nil, errs.WrapMsg(err, "request failed", "url", url)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, errs.New("unexpected http status", "url", url, "status", resp.StatusCode).Wrap()
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, oidcMaxResponseSize))
+	if err != nil {
+		return nil, errs.WrapMsg(err, "read response failed", "url", url)
+	}
+	return data, nil
+}
+
+// parseJWKS decodes the signature keys of a JWKS document, skipping keys of unsupported types.
+func parseJWKS(data []byte) (map[string]crypto.PublicKey, error) {
+	var jwks struct {
+		Keys []*JSONWebKey `json:"keys"`
+	}
+	if err := json.Unmarshal(data, &jwks); err != nil {
+		return nil, errs.WrapMsg(err, "parse jwks failed")
+	}
+	keys := make(map[string]crypto.PublicKey, len(jwks.Keys))
+	for _, jwk := range jwks.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		key, err := jwk.PublicKey()
+		if err != nil {
+			continue
+		}
+		keys[jwk.Kid] = key
+	}
+	if len(keys) == 0 {
+		return nil, errs.New("jwks has no usable keys").Wrap()
+	}
+	return keys, nil
+}
@@ -0,0 +1,196 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package authverify
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/servererrs"
+)
+
+func signIDToken(t *testing.T, method jwt.SigningMethod, kid string, key any, claims jwt.MapClaims) string {
+	token := jwt.NewWithClaims(method, claims)
+	token.Header["kid"] = kid
+	s, err := token.SignedString(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func TestOIDCVerifier(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/.well-known/openid-configuration":
+			_ = json.NewEncoder(w).Encode(map[string]string{"issuer": server.URL, "jwks_uri": server.URL + "/keys"})
+		case "/keys":
+			jwk, _ := NewJSONWebKey("rsa-1", "RS256", &rsaKey.PublicKey)
+			_ = json.NewEncoder(w).Encode(map[string]any{"keys": []*JSONWebKey{jwk}})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	edPublic, edPrivate, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	jwk, _ := NewJSONWebKey("ed-1", "EdDSA", edPublic)
+	data, _ := json.Marshal(map[string]any{"keys": []*JSONWebKey{jwk}})
+	jwksFile := filepath.Join(t.TempDir(), "jwks.json")
+	if err := os.WriteFile(jwksFile, data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	verifier, err := NewOIDCVerifier(&config.OIDC{
+		Enable: true,
+		Issuers: []config.OIDCIssuer{
+			{Issuer: server.URL, Audiences: []string{"client-a"}, UserIDPrefix: "a_"},
+			{Issuer: "https://static.example.com", JWKSFile: jwksFile, Audiences: []string{"client-b"}, UserIDPrefix: "b_"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	exp := time.Now().Add(time.Hour).Unix()
+
+	token := signIDToken(t, jwt.SigningMethodRS256, "rsa-1", rsaKey, jwt.MapClaims{"iss": server.URL, "aud": "client-a", "sub": "u1", "exp": exp})
+	_, claims, err := verifier.Verify(ctx, token)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if claims["sub"] != "u1" {
+		t.Fatalf("unexpected sub %v", claims["sub"])
+	}
+
+	token = signIDToken(t, jwt.SigningMethodEdDSA, "ed-1", edPrivate, jwt.MapClaims{"iss": "https://static.example.com", "aud": []string{"other", "client-b"}, "sub": "u2", "exp": exp})
+	if _, _, err := verifier.Verify(ctx, token); err != nil {
+		t.Fatal(err)
+	}
+
+	token = signIDToken(t, jwt.SigningMethodRS256, "rsa-1", rsaKey, jwt.MapClaims{"iss": server.URL, "aud": "client-b", "sub": "u1", "exp": exp})
+	if _, _, err := verifier.Verify(ctx, token); !servererrs.ErrTokenInvalid.Is(err) {
+		t.Fatalf("wrong audience accepted: %v", err)
+	}
+
+	token = signIDToken(t, jwt.SigningMethodRS256, "rsa-1", rsaKey, jwt.MapClaims{"iss": "https://evil.example.com", "aud": "client-a", "sub": "u1", "exp": exp})
+	if _, _, err := verifier.Verify(ctx, token); !servererrs.ErrTokenInvalid.Is(err) {
+		t.Fatalf("untrusted issuer accepted: %v", err)
+	}
+
+	token = signIDToken(t, jwt.SigningMethodRS256, "rsa-1", rsaKey, jwt.MapClaims{"iss": server.URL, "aud": "client-a", "sub": "u1", "exp": time.Now().Add(-time.Minute).Unix()})
+	if _, _, err := verifier.Verify(ctx, token); !servererrs.ErrTokenExpired.Is(err) {
+		t.Fatalf("expired token accepted: %v", err)
+	}
+
+	token = signIDToken(t, jwt.SigningMethodRS256, "rsa-1", rsaKey, jwt.MapClaims{"iss": server.URL, "aud": "client-a", "sub": "u1"})
+	if _, _, err := verifier.Verify(ctx, token); !servererrs.ErrTokenInvalid.Is(err) {
+		t.Fatalf("token without exp accepted: %v", err)
+	}
+
+	hsToken, _ := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{"iss": server.URL, "aud": "client-a", "sub": "u1", "exp": exp}).SignedString([]byte("secret"))
+	if _, _, err := verifier.Verify(ctx, hsToken); !servererrs.ErrTokenInvalid.Is(err) {
+		t.Fatalf("hs256 token accepted: %v", err)
+	}
+}
+
+func TestOIDCVerifierUserIDPrefix(t *testing.T) {
+	for name, issuers := range map[string][]config.OIDCIssuer{
+		"empty": {{Issuer: "https://a.example.com", Audiences: []string{"a"}}},
+		"overlap": {
+			{Issuer: "https://a.example.com", Audiences: []string{"a"}, UserIDPrefix: "ext_"},
+			{Issuer: "https://b.example.com", Audiences: []string{"b"}, UserIDPrefix: "ext_b_"},
+		},
+	} {
+		if _, err := NewOIDCVerifier(&config.OIDC{Enable: true, Issuers: issuers}); err == nil {
+			t.Fatalf("%s userIDPrefix accepted", name)
+		}
+	}
+}
+
+func TestOIDCVerifierFetchWithoutLock(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	entered := make(chan struct{}, 1)
+	release := make(chan struct{})
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/.well-known/openid-configuration":
+			_ = json.NewEncoder(w).Encode(map[string]string{"issuer": server.URL, "jwks_uri": server.URL + "/keys"})
+		case "/keys":
+			entered <- struct{}{}
+			<-release
+			jwk, _ := NewJSONWebKey("rsa-1", "RS256", &rsaKey.PublicKey)
+			_ = json.NewEncoder(w).Encode(map[string]any{"keys": []*JSONWebKey{jwk}})
+		}
+	}))
+	defer server.Close()
+	verifier, err := NewOIDCVerifier(&config.OIDC{
+		Enable:  true,
+		Issuers: []config.OIDCIssuer{{Issuer: server.URL, Audiences: []string{"client"}, UserIDPrefix: "a_"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	token := signIDToken(t, jwt.SigningMethodRS256, "rsa-1", rsaKey, jwt.MapClaims{"iss": server.URL, "aud": "client", "sub": "u1", "exp": time.Now().Add(time.Hour).Unix()})
+
+	first := make(chan error, 1)
+	go func() {
+		_, _, err := verifier.Verify(context.Background(), token)
+		first <- err
+	}()
+	<-entered
+
+	// a caller waiting for the running fetch gives up with its own context instead of blocking on the lock
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	if _, _, err := verifier.Verify(ctx, token); err == nil {
+		t.Fatal("verify succeeded before the keys were fetched")
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Fatalf("waiting caller blocked for %s", elapsed)
+	}
+
+	close(release)
+	if err := <-first; err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := verifier.Verify(context.Background(), token); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -36,6 +36,7 @@ func NewAuthRpcCmd() *AuthRpcCmd {
 	ret.configMap = map[string]any{
 		OpenIMRPCAuthCfgFileName: &authConfig.RpcConfig,
 		RedisConfigFileName:      &authConfig.RedisConfig,
+		MongodbConfigFileName:    &authConfig.MongodbConfig,
 		ShareFileName:            &authConfig.Share,
 		DiscoveryConfigFilename:  &authConfig.Discovery,
 	}
@@ -40,6 +40,7 @@ func NewMsgTransferCmd() *MsgTransferCmd {
 		ShareFileName:                &msgTransferConfig.Share,
 		WebhooksConfigFileName:       &msgTransferConfig.WebhooksConfig,
 		DiscoveryConfigFilename:      &msgTransferConfig.Discovery,
+		LocalCacheConfigFileName:     &msgTransferConfig.LocalCacheConfig,
 	}
 	ret.RootCmd = NewRootCmd(program.GetProcessName(), WithConfigMap(ret.configMap))
 	ret.ctx = context.WithValue(context.Background(), "version", config.Version)
@@ -94,8 +94,9 @@ type TLSConfig struct {
 
 type API struct {
 	Api struct {
-		ListenIP string `mapstructure:"listenIP"`
-		Ports    []int  `mapstructure:"ports"`
+		ListenIP       string   `mapstructure:"listenIP"`
+		Ports          []int    `mapstructure:"ports"`
+		TrustedProxies []string `mapstructure:"trustedProxies"`
 	} `mapstructure:"api"`
 	Prometheus struct {
 		Enable     bool   `mapstructure:"enable"`
@@ -105,8 +106,17 @@ type API struct {
 }
 
 type CronTask struct {
-	ChatRecordsClearTime string `mapstructure:"chatRecordsClearTime"`
-	RetainChatRecords    int    `mapstructure:"retainChatRecords"`
+	ChatRecordsClearTime      string `mapstructure:"chatRecordsClearTime"`
+	RetainChatRecords         int    `mapstructure:"retainChatRecords"`
+	CloseExpiredPollsTime     string `mapstructure:"closeExpiredPollsTime"`
+	ExpireGroupRequestsTime   string `mapstructure:"expireGroupRequestsTime"`
+	GroupRequestExpireHours   int    `mapstructure:"groupRequestExpireHours"`
+	RefreshGroupDirectoryTime string `mapstructure:"refreshGroupDirectoryTime"`
+	ExpireFriendRequestsTime  string `mapstructure:"expireFriendRequestsTime"`
+	FriendRequestExpireHours  int    `mapstructure:"friendRequestExpireHours"`
+	RefreshFriendSuggestTime  string `mapstructure:"refreshFriendSuggestTime"`
+	PurgeDeletedUsersTime     string `mapstructure:"purgeDeletedUsersTime"`
+	ProcessDataExportsTime    string `mapstructure:"processDataExportsTime"`
 }
 
 type OfflinePushConfig struct {
@@ -144,6 +154,8 @@ type Notification struct {
 	GroupMemberSetToOrdinary  NotificationConfig `yaml:"groupMemberSetToOrdinaryUser"`
 	GroupInfoSetAnnouncement  NotificationConfig `mapstructure:"groupInfoSetAnnouncement"`
 	GroupInfoSetName          NotificationConfig `mapstructure:"groupInfoSetName"`
+	CommunityAnnouncement     NotificationConfig `mapstructure:"communityAnnouncement"`
+	GroupMsgPolicySet         NotificationConfig `mapstructure:"groupMsgPolicySet"`
 	FriendApplicationAdded    NotificationConfig `mapstructure:"friendApplicationAdded"`
 	FriendApplicationApproved NotificationConfig `mapstructure:"friendApplicationApproved"`
 	FriendApplicationRejected NotificationConfig `mapstructure:"friendApplicationRejected"`
@@ -153,10 +165,14 @@ type Notification struct {
 	BlackAdded                NotificationConfig `mapstructure:"blackAdded"`
 	BlackDeleted              NotificationConfig `mapstructure:"blackDeleted"`
 	FriendInfoUpdated         NotificationConfig `mapstructure:"friendInfoUpdated"`
+	FriendGroupChanged        NotificationConfig `mapstructure:"friendGroupChanged"`
 	UserInfoUpdated           NotificationConfig `mapstructure:"userInfoUpdated"`
 	UserStatusChanged         NotificationConfig `mapstructure:"userStatusChanged"`
 	ConversationChanged       NotificationConfig `mapstructure:"conversationChanged"`
 	ConversationSetPrivate    NotificationConfig `mapstructure:"conversationSetPrivate"`
+	ConversationDraftChanged  NotificationConfig `mapstructure:"conversationDraftChanged"`
+	ConversationFolderChanged NotificationConfig `mapstructure:"conversationFolderChanged"`
+	DoNotDisturbChanged       NotificationConfig `mapstructure:"doNotDisturbChanged"`
 }
 
 type Prometheus struct {
@@ -172,10 +188,11 @@ type MsgGateway struct {
 	Prometheus  Prometheus `mapstructure:"prometheus"`
 	ListenIP    string     `mapstructure:"listenIP"`
 	LongConnSvr struct {
-		Ports               []int `mapstructure:"ports"`
-		WebsocketMaxConnNum int   `mapstructure:"websocketMaxConnNum"`
-		WebsocketMaxMsgLen  int   `mapstructure:"websocketMaxMsgLen"`
-		WebsocketTimeout    int   `mapstructure:"websocketTimeout"`
+		Ports               []int    `mapstructure:"ports"`
+		WebsocketMaxConnNum int      `mapstructure:"websocketMaxConnNum"`
+		WebsocketMaxMsgLen  int      `mapstructure:"websocketMaxMsgLen"`
+		WebsocketTimeout    int      `mapstructure:"websocketTimeout"`
+		TrustedProxies      []string `mapstructure:"trustedProxies"`
 	} `mapstructure:"longConnSvr"`
 	MultiLoginPolicy int `mapstructure:"multiLoginPolicy"`
 }
@@ -227,6 +244,43 @@ type Auth struct {
 	TokenPolicy struct {
 		Expire int64 `mapstructure:"expire"`
 	} `mapstructure:"tokenPolicy"`
+	RefreshTokenPolicy struct {
+		Enable       bool  `mapstructure:"enable"`
+		AccessExpire int64 `mapstructure:"accessExpire"`
+		Expire       int64 `mapstructure:"expire"`
+	} `mapstructure:"refreshTokenPolicy"`
+	TokenSigning TokenSigning `mapstructure:"tokenSigning"`
+	OIDC         OIDC         `mapstructure:"oidc"`
+}
+
+type TokenSigning struct {
+	ActiveKeyID  string       `mapstructure:"activeKeyID"`
+	AcceptSecret bool         `mapstructure:"acceptSecret"`
+	Keys         []SigningKey `mapstructure:"keys"`
+}
+
+type SigningKey struct {
+	KeyID          string `mapstructure:"keyID"`
+	Algorithm      string `mapstructure:"algorithm"`
+	PrivateKeyFile string `mapstructure:"privateKeyFile"`
+	NotAfter       string `mapstructure:"notAfter"`
+}
+
+type OIDC struct {
+	Enable  bool         `mapstructure:"enable"`
+	Issuers []OIDCIssuer `mapstructure:"issuers"`
+}
+
+type OIDCIssuer struct {
+	Issuer        string   `mapstructure:"issuer"`
+	DiscoveryURL  string   `mapstructure:"discoveryURL"`
+	JWKSFile      string   `mapstructure:"jwksFile"`
+	Audiences     []string `mapstructure:"audiences"`
+	UserIDClaim   string   `mapstructure:"userIDClaim"`
+	UserIDPrefix  string   `mapstructure:"userIDPrefix"`
+	AutoRegister  bool     `mapstructure:"autoRegister"`
+	NicknameClaim string   `mapstructure:"nicknameClaim"`
+	FaceURLClaim  string   `mapstructure:"faceURLClaim"`
 }
 
 type Conversation struct {
@@ -236,6 +290,11 @@ type Conversation struct {
 		Ports      []int  `mapstructure:"ports"`
 	} `mapstructure:"rpc"`
 	Prometheus Prometheus `mapstructure:"prometheus"`
+	Folder     Folder     `mapstructure:"folder"`
+}
+
+type Folder struct {
+	MaxPerUser int `mapstructure:"maxPerUser"`
 }
 
 type Friend struct {
@@ -244,7 +303,36 @@ type Friend struct {
 		ListenIP   string `mapstructure:"listenIP"`
 		Ports      []int  `mapstructure:"ports"`
 	} `mapstructure:"rpc"`
-	Prometheus Prometheus `mapstructure:"prometheus"`
+	Prometheus       Prometheus       `mapstructure:"prometheus"`
+	FriendGroup      FriendGroup      `mapstructure:"friendGroup"`
+	FriendRequest    FriendRequest    `mapstructure:"friendRequest"`
+	ContactDiscovery ContactDiscovery `mapstructure:"contactDiscovery"`
+	FriendSuggestion FriendSuggestion `mapstructure:"friendSuggestion"`
+}
+
+type FriendGroup struct {
+	MaxPerUser int `mapstructure:"maxPerUser"`
+}
+
+type FriendRequest struct {
+	DailyQuota           int64 `mapstructure:"dailyQuota"`
+	RefusedCooldownHours int   `mapstructure:"refusedCooldownHours"`
+}
+
+type ContactDiscovery struct {
+	Salt                string `mapstructure:"salt"`
+	Secret              string `mapstructure:"secret"`
+	MaxHashesPerRequest int    `mapstructure:"maxHashesPerRequest"`
+	DailyQuota          int64  `mapstructure:"dailyQuota"`
+}
+
+type FriendSuggestion struct {
+	MaxPerUser         int     `mapstructure:"maxPerUser"`
+	ExpireHours        int     `mapstructure:"expireHours"`
+	BatchSize          int     `mapstructure:"batchSize"`
+	MutualFriendWeight float64 `mapstructure:"mutualFriendWeight"`
+	SharedGroupWeight  float64 `mapstructure:"sharedGroupWeight"`
+	MaxGroupMembers    int     `mapstructure:"maxGroupMembers"`
 }
 
 type Group struct {
@@ -254,6 +342,13 @@ type Group struct {
 		Ports      []int  `mapstructure:"ports"`
 	} `mapstructure:"rpc"`
 	Prometheus Prometheus `mapstructure:"prometheus"`
+	Directory  Directory  `mapstructure:"directory"`
+}
+
+type Directory struct {
+	Categories    []string `mapstructure:"categories"`
+	MaxCategories int      `mapstructure:"maxCategories"`
+	ActivityDays  int      `mapstructure:"activityDays"`
 }
 
 type Msg struct {
@@ -264,6 +359,18 @@ type Msg struct {
 	} `mapstructure:"rpc"`
 	Prometheus   Prometheus `mapstructure:"prometheus"`
 	FriendVerify bool       `mapstructure:"friendVerify"`
+	Broadcast    Broadcast  `mapstructure:"broadcast"`
+	Pin          Pin        `mapstructure:"pin"`
+}
+
+type Broadcast struct {
+	BatchSize    int `mapstructure:"batchSize"`
+	Concurrency  int `mapstructure:"concurrency"`
+	LeaseSeconds int `mapstructure:"leaseSeconds"`
+}
+
+type Pin struct {
+	MaxPerConversation int `mapstructure:"maxPerConversation"`
 }
 
 type Third struct {
@@ -319,7 +426,23 @@ type User struct {
 		ListenIP   string `mapstructure:"listenIP"`
 		Ports      []int  `mapstructure:"ports"`
 	} `mapstructure:"rpc"`
-	Prometheus Prometheus `mapstructure:"prometheus"`
+	Prometheus      Prometheus      `mapstructure:"prometheus"`
+	AccountDeletion AccountDeletion `mapstructure:"accountDeletion"`
+	DataExport      DataExport      `mapstructure:"dataExport"`
+}
+
+type AccountDeletion struct {
+	GracePeriodHours int    `mapstructure:"gracePeriodHours"`
+	RetryMinutes     int    `mapstructure:"retryMinutes"`
+	BatchSize        int    `mapstructure:"batchSize"`
+	DeletedNickname  string `mapstructure:"deletedNickname"`
+}
+
+type DataExport struct {
+	IntervalHours int `mapstructure:"intervalHours"`
+	MaxMessages   int `mapstructure:"maxMessages"`
+	BatchSize     int `mapstructure:"batchSize"`
+	LeaseMinutes  int `mapstructure:"leaseMinutes"`
 }
 
 type Redis struct {
@@ -435,6 +558,35 @@ type Discovery struct {
 	Enable    string    `mapstructure:"enable"`
 	Etcd      Etcd      `mapstructure:"etcd"`
 	ZooKeeper ZooKeeper `mapstructure:"zooKeeper"`
+	Direct    Direct    `mapstructure:"direct"`
+}
+
+// Direct lists the static addresses of every service, keyed like RpcRegisterName.
+type Direct struct {
+	User           []string `mapstructure:"user"`
+	Friend         []string `mapstructure:"friend"`
+	Msg            []string `mapstructure:"msg"`
+	Push           []string `mapstructure:"push"`
+	MessageGateway []string `mapstructure:"messageGateway"`
+	Group          []string `mapstructure:"group"`
+	Auth           []string `mapstructure:"auth"`
+	Conversation   []string `mapstructure:"conversation"`
+	Third          []string `mapstructure:"third"`
+}
+
+// GetServiceAddresses maps the registered name of each service to its configured addresses.
+func (d *Direct) GetServiceAddresses(r *RpcRegisterName) map[string][]string {
+	return map[string][]string{
+		r.User:           d.User,
+		r.Friend:         d.Friend,
+		r.Msg:            d.Msg,
+		r.Push:           d.Push,
+		r.MessageGateway: d.MessageGateway,
+		r.Group:          d.Group,
+		r.Auth:           d.Auth,
+		r.Conversation:   d.Conversation,
+		r.Third:          d.Third,
+	}
 }
 
 type Etcd struct {
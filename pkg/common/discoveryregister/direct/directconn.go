@@ -14,161 +14,197 @@
 
 package direct
 
-//import (
-//	"context"
-//	"fmt"
-//
-//	config2 "github.com/openimsdk/open-im-server/v3/pkg/common/config"
-//	"github.com/openimsdk/tools/errs"
-//	"google.golang.org/grpc"
-//	"google.golang.org/grpc/credentials/insecure"
-//)
-//
-//type ServiceAddresses map[string][]int
-//
-//func getServiceAddresses(rpcRegisterName *config2.RpcRegisterName,
-//	rpcPort *config2.RpcPort, longConnSvrPort []int) ServiceAddresses {
-//	return ServiceAddresses{
-//		rpcRegisterName.OpenImUserName:           rpcPort.OpenImUserPort,
-//		rpcRegisterName.OpenImFriendName:         rpcPort.OpenImFriendPort,
-//		rpcRegisterName.OpenImMsgName:            rpcPort.OpenImMessagePort,
-//		rpcRegisterName.OpenImMessageGatewayName: longConnSvrPort,
-//		rpcRegisterName.OpenImGroupName:          rpcPort.OpenImGroupPort,
-//		rpcRegisterName.OpenImAuthName:           rpcPort.OpenImAuthPort,
-//		rpcRegisterName.OpenImPushName:           rpcPort.OpenImPushPort,
-//		rpcRegisterName.OpenImConversationName:   rpcPort.OpenImConversationPort,
-//		rpcRegisterName.OpenImThirdName:          rpcPort.OpenImThirdPort,
-//	}
-//}
-//
-//type ConnDirect struct {
-//	additionalOpts        []grpc.DialOption
-//	currentServiceAddress string
-//	conns                 map[string][]*grpc.ClientConn
-//	resolverDirect        *ResolverDirect
-//	config                *config2.GlobalConfig
-//}
-//
-//func (cd *ConnDirect) GetClientLocalConns() map[string][]*grpc.ClientConn {
-//	return nil
-//}
-//
-//func (cd *ConnDirect) GetUserIdHashGatewayHost(ctx context.Context, userId string) (string, error) {
-//	return "", nil
-//}
-//
-//func (cd *ConnDirect) Register(serviceName, host string, port int, opts ...grpc.DialOption) error {
-//	return nil
-//}
-//
-//func (cd *ConnDirect) UnRegister() error {
-//	return nil
-//}
-//
-//func (cd *ConnDirect) CreateRpcRootNodes(serviceNames []string) error {
-//	return nil
-//}
-//
-//func (cd *ConnDirect) RegisterConf2Registry(key string, conf []byte) error {
-//	return nil
-//}
-//
-//func (cd *ConnDirect) GetConfFromRegistry(key string) ([]byte, error) {
-//	return nil, nil
-//}
-//
-//func (cd *ConnDirect) Close() {
-//
-//}
-//
-//func NewConnDirect(config *config2.GlobalConfig) (*ConnDirect, error) {
-//	return &ConnDirect{
-//		conns:          make(map[string][]*grpc.ClientConn),
-//		resolverDirect: NewResolverDirect(),
-//		config:         config,
-//	}, nil
-//}
-//
-//func (cd *ConnDirect) GetConns(ctx context.Context,
-//	serviceName string, opts ...grpc.DialOption) ([]*grpc.ClientConn, error) {
-//
-//	if conns, exists := cd.conns[serviceName]; exists {
-//		return conns, nil
-//	}
-//	ports := getServiceAddresses(&cd.config.RpcRegisterName,
-//		&cd.config.RpcPort, cd.config.LongConnSvr.OpenImMessageGatewayPort)[serviceName]
-//	var connections []*grpc.ClientConn
-//	for _, port := range ports {
-//		conn, err := cd.dialServiceWithoutResolver(ctx, fmt.Sprintf(cd.config.Rpc.ListenIP+":%d", port), append(cd.additionalOpts, opts...)...)
-//		if err != nil {
-//			return nil, errs.Wrap(fmt.Errorf("connect to port %d failed,serviceName %s, IP %s", port, serviceName, cd.config.Rpc.ListenIP))
-//		}
-//		connections = append(connections, conn)
-//	}
-//
-//	if len(connections) == 0 {
-//		return nil, errs.New("no connections found for service", "serviceName", serviceName).Wrap()
-//	}
-//	return connections, nil
-//}
-//
-//func (cd *ConnDirect) GetConn(ctx context.Context, serviceName string, opts ...grpc.DialOption) (*grpc.ClientConn, error) {
-//	// Get service addresses
-//	addresses := getServiceAddresses(&cd.config.RpcRegisterName,
-//		&cd.config.RpcPort, cd.config.LongConnSvr.OpenImMessageGatewayPort)
-//	address, ok := addresses[serviceName]
-//	if !ok {
-//		return nil, errs.New("unknown service name", "serviceName", serviceName).Wrap()
-//	}
-//	var result string
-//	for _, addr := range address {
-//		if result != "" {
-//			result = result + "," + fmt.Sprintf(cd.config.Rpc.ListenIP+":%d", addr)
-//		} else {
-//			result = fmt.Sprintf(cd.config.Rpc.ListenIP+":%d", addr)
-//		}
-//	}
-//	// Try to dial a new connection
-//	conn, err := cd.dialService(ctx, result, append(cd.additionalOpts, opts...)...)
-//	if err != nil {
-//		return nil, errs.WrapMsg(err, "address", result)
-//	}
-//
-//	// Store the new connection
-//	cd.conns[serviceName] = append(cd.conns[serviceName], conn)
-//	return conn, nil
-//}
-//
-//func (cd *ConnDirect) GetSelfConnTarget() string {
-//	return cd.currentServiceAddress
-//}
-//
-//func (cd *ConnDirect) AddOption(opts ...grpc.DialOption) {
-//	cd.additionalOpts = append(cd.additionalOpts, opts...)
-//}
-//
-//func (cd *ConnDirect) CloseConn(conn *grpc.ClientConn) {
-//	if conn != nil {
-//		conn.Close()
-//	}
-//}
-//
-//func (cd *ConnDirect) dialService(ctx context.Context, address string, opts ...grpc.DialOption) (*grpc.ClientConn, error) {
-//	options := append(opts, grpc.WithTransportCredentials(insecure.NewCredentials()))
-//	conn, err := grpc.DialContext(ctx, cd.resolverDirect.Scheme()+":///"+address, options...)
-//
-//	if err != nil {
-//		return nil, errs.WrapMsg(err, "address", address)
-//	}
-//	return conn, nil
-//}
-//
-//func (cd *ConnDirect) dialServiceWithoutResolver(ctx context.Context, address string, opts ...grpc.DialOption) (*grpc.ClientConn, error) {
-//	options := append(opts, grpc.WithTransportCredentials(insecure.NewCredentials()))
-//	conn, err := grpc.DialContext(ctx, address, options...)
-//
-//	if err != nil {
-//		return nil, errs.Wrap(err)
-//	}
-//	return conn, nil
-//}
+import (
+	"context"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/openimsdk/tools/discovery"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/stathat/consistent"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// ConnDirect resolves services to the static addresses given in the configuration instead of a registry.
+type ConnDirect struct {
+	additionalOpts        []grpc.DialOption
+	currentServiceAddress string
+	services              map[string][]string
+	gatewayHostConsistent *consistent.Consistent
+	lock                  sync.Mutex
+	conns                 map[string]*grpc.ClientConn
+	addrConns             map[string]*grpc.ClientConn
+}
+
+func NewConnDirect(services map[string][]string, gatewayName string) (discovery.SvcDiscoveryRegistry, error) {
+	cd := &ConnDirect{
+		services:              make(map[string][]string, len(services)),
+		gatewayHostConsistent: consistent.New(),
+		conns:                 make(map[string]*grpc.ClientConn),
+		addrConns:             make(map[string]*grpc.ClientConn),
+	}
+	for serviceName, addresses := range services {
+		if serviceName == "" || len(addresses) == 0 {
+			continue
+		}
+		for _, addr := range addresses {
+			if _, _, err := net.SplitHostPort(addr); err != nil {
+				return nil, errs.WrapMsg(err, "invalid direct service address", "serviceName", serviceName, "address", addr)
+			}
+		}
+		cd.services[serviceName] = addresses
+	}
+	for _, addr := range cd.services[gatewayName] {
+		cd.gatewayHostConsistent.Add(addr)
+	}
+	return cd, nil
+}
+
+// GetConns returns one connection per configured instance of the service.
+func (cd *ConnDirect) GetConns(ctx context.Context, serviceName string, opts ...grpc.DialOption) ([]*grpc.ClientConn, error) {
+	addresses, ok := cd.services[serviceName]
+	if !ok {
+		return nil, errs.New("unknown service name", "serviceName", serviceName).Wrap()
+	}
+	conns := make([]*grpc.ClientConn, 0, len(addresses))
+	for _, addr := range addresses {
+		conn, err := cd.getAddrConn(ctx, addr, opts...)
+		if err != nil {
+			return nil, err
+		}
+		conns = append(conns, conn)
+	}
+	return conns, nil
+}
+
+// GetConn returns a connection balancing calls round-robin over all instances of the service.
+// A single instance address, as returned by GetUserIdHashGatewayHost, is dialed directly.
+func (cd *ConnDirect) GetConn(ctx context.Context, serviceName string, opts ...grpc.DialOption) (*grpc.ClientConn, error) {
+	addresses, ok := cd.services[serviceName]
+	if !ok {
+		if cd.isInstance(serviceName) {
+			return cd.getAddrConn(ctx, serviceName, opts...)
+		}
+		return nil, errs.New("unknown service name", "serviceName", serviceName).Wrap()
+	}
+	cd.lock.Lock()
+	defer cd.lock.Unlock()
+	if conn, ok := cd.conns[serviceName]; ok {
+		return conn, nil
+	}
+	target := scheme + ":///" + strings.Join(addresses, string(EndpointSepChar))
+	conn, err := cd.dial(ctx, target, opts...)
+	if err != nil {
+		return nil, err
+	}
+	cd.conns[serviceName] = conn
+	return conn, nil
+}
+
+func (cd *ConnDirect) getAddrConn(ctx context.Context, addr string, opts ...grpc.DialOption) (*grpc.ClientConn, error) {
+	cd.lock.Lock()
+	defer cd.lock.Unlock()
+	if conn, ok := cd.addrConns[addr]; ok {
+		return conn, nil
+	}
+	conn, err := cd.dial(ctx, addr, opts...)
+	if err != nil {
+		return nil, err
+	}
+	cd.addrConns[addr] = conn
+	return conn, nil
+}
+
+func (cd *ConnDirect) dial(ctx context.Context, target string, opts ...grpc.DialOption) (*grpc.ClientConn, error) {
+	options := []grpc.DialOption{
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultServiceConfig(`{"loadBalancingPolicy": "round_robin"}`),
+	}
+	options = append(options, cd.additionalOpts...)
+	options = append(options, opts...)
+	conn, err := grpc.DialContext(ctx, target, options...)
+	if err != nil {
+		return nil, errs.WrapMsg(err, "grpc dial error", "target", target)
+	}
+	return conn, nil
+}
+
+func (cd *ConnDirect) isInstance(addr string) bool {
+	for _, addresses := range cd.services {
+		for _, v := range addresses {
+			if v == addr {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (cd *ConnDirect) GetSelfConnTarget() string {
+	return cd.currentServiceAddress
+}
+
+func (cd *ConnDirect) AddOption(opts ...grpc.DialOption) {
+	cd.additionalOpts = append(cd.additionalOpts, opts...)
+}
+
+func (cd *ConnDirect) CloseConn(conn *grpc.ClientConn) {
+	if conn != nil {
+		conn.Close()
+	}
+}
+
+// Register only records the address of this instance, the configuration already lists every instance.
+// When the register IP differs from the configured host, the configured address with the same port is used,
+// so that GetSelfConnTarget matches the targets of GetConns.
+func (cd *ConnDirect) Register(serviceName, host string, port int, opts ...grpc.DialOption) error {
+	addresses, ok := cd.services[serviceName]
+	if !ok {
+		return errs.New("service is not configured for direct discovery", "serviceName", serviceName).Wrap()
+	}
+	addr := net.JoinHostPort(host, strconv.Itoa(port))
+	cd.currentServiceAddress = addr
+	for _, v := range addresses {
+		if v == addr {
+			return nil
+		}
+	}
+	for _, v := range addresses {
+		if _, p, _ := net.SplitHostPort(v); p == strconv.Itoa(port) {
+			cd.currentServiceAddress = v
+			return nil
+		}
+	}
+	log.ZWarn(context.Background(), "instance address is not configured for direct discovery", nil, "serviceName", serviceName, "addr", addr)
+	return nil
+}
+
+func (cd *ConnDirect) UnRegister() error {
+	cd.currentServiceAddress = ""
+	return nil
+}
+
+func (cd *ConnDirect) GetUserIdHashGatewayHost(ctx context.Context, userId string) (string, error) {
+	host, err := cd.gatewayHostConsistent.Get(userId)
+	if err != nil {
+		log.ZError(ctx, "GetUserIdHashGatewayHost error", err)
+	}
+	return host, err
+}
+
+func (cd *ConnDirect) Close() {
+	cd.lock.Lock()
+	defer cd.lock.Unlock()
+	for _, conn := range cd.conns {
+		conn.Close()
+	}
+	for _, conn := range cd.addrConns {
+		conn.Close()
+	}
+	cd.conns = make(map[string]*grpc.ClientConn)
+	cd.addrConns = make(map[string]*grpc.ClientConn)
+}
@@ -0,0 +1,134 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package direct
+
+import (
+	"context"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// startServer starts a health server counting the calls it receives.
+func startServer(t *testing.T) (string, *int64) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	var calls int64
+	srv := grpc.NewServer(grpc.UnaryInterceptor(func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		atomic.AddInt64(&calls, 1)
+		return handler(ctx, req)
+	}))
+	grpc_health_v1.RegisterHealthServer(srv, health.NewServer())
+	go srv.Serve(listener)
+	t.Cleanup(srv.Stop)
+	return listener.Addr().String(), &calls
+}
+
+func TestConnDirectRoundRobin(t *testing.T) {
+	addr1, calls1 := startServer(t)
+	addr2, calls2 := startServer(t)
+	cd, err := NewConnDirect(map[string][]string{"user": {addr1, addr2}}, "messageGateway")
+	require.NoError(t, err)
+	defer cd.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	conn, err := cd.GetConn(ctx, "user")
+	require.NoError(t, err)
+	again, err := cd.GetConn(ctx, "user")
+	require.NoError(t, err)
+	assert.Same(t, conn, again)
+
+	client := grpc_health_v1.NewHealthClient(conn)
+	for i := 0; i < 100 && (atomic.LoadInt64(calls1) == 0 || atomic.LoadInt64(calls2) == 0); i++ {
+		_, err := client.Check(ctx, &grpc_health_v1.HealthCheckRequest{}, grpc.WaitForReady(true))
+		require.NoError(t, err)
+	}
+	assert.NotZero(t, atomic.LoadInt64(calls1))
+	assert.NotZero(t, atomic.LoadInt64(calls2))
+}
+
+func TestConnDirectGetConns(t *testing.T) {
+	addr1, calls1 := startServer(t)
+	addr2, calls2 := startServer(t)
+	cd, err := NewConnDirect(map[string][]string{"messageGateway": {addr1, addr2}}, "messageGateway")
+	require.NoError(t, err)
+	defer cd.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	conns, err := cd.GetConns(ctx, "messageGateway")
+	require.NoError(t, err)
+	require.Len(t, conns, 2)
+	assert.Equal(t, addr1, conns[0].Target())
+	assert.Equal(t, addr2, conns[1].Target())
+	for _, conn := range conns {
+		_, err := grpc_health_v1.NewHealthClient(conn).Check(ctx, &grpc_health_v1.HealthCheckRequest{}, grpc.WaitForReady(true))
+		require.NoError(t, err)
+	}
+	assert.Equal(t, int64(1), atomic.LoadInt64(calls1))
+	assert.Equal(t, int64(1), atomic.LoadInt64(calls2))
+
+	_, err = cd.GetConns(ctx, "unknown")
+	assert.Error(t, err)
+	_, err = cd.GetConn(ctx, "unknown")
+	assert.Error(t, err)
+}
+
+func TestConnDirectGatewayHost(t *testing.T) {
+	gateways := []string{"127.0.0.1:10140", "127.0.0.1:10141", "127.0.0.1:10142"}
+	cd, err := NewConnDirect(map[string][]string{"messageGateway": gateways}, "messageGateway")
+	require.NoError(t, err)
+	defer cd.Close()
+
+	ctx := context.Background()
+	host, err := cd.GetUserIdHashGatewayHost(ctx, "user1")
+	require.NoError(t, err)
+	assert.Contains(t, gateways, host)
+	again, err := cd.GetUserIdHashGatewayHost(ctx, "user1")
+	require.NoError(t, err)
+	assert.Equal(t, host, again)
+
+	conn, err := cd.GetConn(ctx, host)
+	require.NoError(t, err)
+	assert.Equal(t, host, conn.Target())
+}
+
+func TestConnDirectRegister(t *testing.T) {
+	cd, err := NewConnDirect(map[string][]string{"msg": {"localhost:10130", "localhost:10131"}}, "messageGateway")
+	require.NoError(t, err)
+	defer cd.Close()
+
+	require.NoError(t, cd.Register("msg", "localhost", 10131))
+	assert.Equal(t, "localhost:10131", cd.GetSelfConnTarget())
+	// the register IP is matched to the configured address by port
+	require.NoError(t, cd.Register("msg", "192.168.1.10", 10130))
+	assert.Equal(t, "localhost:10130", cd.GetSelfConnTarget())
+	assert.Error(t, cd.Register("unknown", "localhost", 10130))
+	require.NoError(t, cd.UnRegister())
+	assert.Empty(t, cd.GetSelfConnTarget())
+}
+
+func TestNewConnDirectInvalidAddress(t *testing.T) {
+	_, err := NewConnDirect(map[string][]string{"user": {"localhost"}}, "messageGateway")
+	assert.Error(t, err)
+}
@@ -16,6 +16,7 @@ package discoveryregister
 
 import (
 	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/discoveryregister/direct"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/discoveryregister/kubernetes"
 	"github.com/openimsdk/tools/discovery"
 	"github.com/openimsdk/tools/discovery/etcd"
@@ -36,6 +37,8 @@ func NewDiscoveryRegister(discovery *config.Discovery, share *config.Share) (dis
 			zookeeper.WithRoundRobin(),
 			zookeeper.WithTimeout(10),
 		)
+	case "direct":
+		return direct.NewConnDirect(discovery.Direct.GetServiceAddresses(&share.RpcRegisterName), share.RpcRegisterName.MessageGateway)
 	case "k8s":
 		return kubernetes.NewK8sDiscoveryRegister(share.RpcRegisterName.MessageGateway)
 	case "etcd":
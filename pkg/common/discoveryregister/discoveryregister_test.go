@@ -15,7 +15,14 @@
 package discoveryregister
 
 import (
+	"context"
 	"os"
+	"testing"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/tools/discovery"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func setupTestEnvironment() {
@@ -58,3 +65,29 @@ func setupTestEnvironment() {
 //		}
 //	}
 //}
+
+func TestNewDiscoveryRegisterDirect(t *testing.T) {
+	share := &config.Share{RpcRegisterName: config.RpcRegisterName{User: "user", MessageGateway: "messageGateway"}}
+	conf := &config.Discovery{
+		Enable: "direct",
+		Direct: config.Direct{
+			User:           []string{"127.0.0.1:10110", "127.0.0.1:10111"},
+			MessageGateway: []string{"127.0.0.1:10140"},
+		},
+	}
+	client, err := NewDiscoveryRegister(conf, share)
+	require.NoError(t, err)
+	defer client.Close()
+	assert.Implements(t, (*discovery.SvcDiscoveryRegistry)(nil), client)
+
+	conns, err := client.GetConns(context.Background(), "user")
+	require.NoError(t, err)
+	assert.Len(t, conns, 2)
+	host, err := client.GetUserIdHashGatewayHost(context.Background(), "user1")
+	require.NoError(t, err)
+	assert.Equal(t, "127.0.0.1:10140", host)
+
+	conf.Enable = "invalid"
+	_, err = NewDiscoveryRegister(conf, share)
+	assert.Error(t, err)
+}
@@ -0,0 +1,35 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prommetrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	FriendRequestSentCounter = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "friend_request_sent_total",
+		Help: "The number of friend requests sent",
+	})
+	// FriendRequestBlockedCounter is labeled by the reason the request was blocked: quota, cooldown, policy or answer.
+	FriendRequestBlockedCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "friend_request_blocked_total",
+		Help: "The number of friend requests blocked by the anti-spam limits",
+	}, []string{"reason"})
+	FriendRequestExpiredCounter = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "friend_request_expired_total",
+		Help: "The number of friend requests refused for nobody handled them in time",
+	})
+)
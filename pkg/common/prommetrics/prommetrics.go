@@ -43,6 +43,8 @@ func GetGrpcCusMetrics(registerName string, share *config2.Share) []prometheus.C
 		return []prometheus.Collector{MsgOfflinePushFailedCounter}
 	case share.RpcRegisterName.Auth:
 		return []prometheus.Collector{UserLoginCounter}
+	case share.RpcRegisterName.Friend:
+		return []prometheus.Collector{FriendRequestSentCounter, FriendRequestBlockedCounter, FriendRequestExpiredCounter}
 	default:
 		return nil
 	}
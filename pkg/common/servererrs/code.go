@@ -54,6 +54,8 @@ const (
 	// Account error codes.
 	UserIDNotFoundError    = 1101 // UserID does not exist or is not registered
 	RegisteredAlreadyError = 1102 // user is already registered
+	AccountDeleted         = 1103 // Account of the user has been deleted
+	DataExportLimit        = 1104 // Data export requested again too soon
 
 	// Group error codes.
 	GroupIDNotFoundError  = 1201 // GroupID does not exist
@@ -68,12 +70,21 @@ const (
 	BlockedByPeer            = 1302 // Blocked by the peer
 	NotPeersFriend           = 1303 // Not the peer's friend
 	RelationshipAlreadyError = 1304 // Already in a friend relationship
+	FriendRequestQuota       = 1305 // Daily friend request quota of the user is used up
+	FriendRequestCooldown    = 1306 // Friend request sent again too soon after being refused
+	FriendRequestNotAllowed  = 1307 // Peer does not accept friend requests from the user
+	FriendRequestAnswerWrong = 1308 // Answer to the friend request question of the peer is wrong
+	ContactDiscoveryQuota    = 1309 // Daily contact discovery quota of the user is used up
 
 	// Message error codes.
 	MessageHasReadDisable = 1401
 	MutedInGroup          = 1402 // Member muted in the group
 	MutedGroup            = 1403 // Group is muted
 	MsgAlreadyRevoke      = 1404 // Message already revoked
+	SlowMode              = 1405 // Member sent again within the slow mode interval of the group
+	MsgTooLong            = 1406 // Message exceeds the length limit of the group
+	LinkNotAllowed        = 1407 // Group does not allow links
+	AttachmentNotAllowed  = 1408 // Group does not allow attachments
 
 	// Token error codes.
 	TokenExpiredError     = 1501
@@ -35,6 +35,8 @@ var (
 	ErrNotInGroupYet       = errs.NewCodeError(NotInGroupYetError, "NotInGroupYetError")
 	ErrDismissedAlready    = errs.NewCodeError(DismissedAlreadyError, "DismissedAlreadyError")
 	ErrRegisteredAlready   = errs.NewCodeError(RegisteredAlreadyError, "RegisteredAlreadyError")
+	ErrAccountDeleted      = errs.NewCodeError(AccountDeleted, "AccountDeleted")
+	ErrDataExportLimit     = errs.NewCodeError(DataExportLimit, "DataExportLimit")
 	ErrGroupTypeNotSupport = errs.NewCodeError(GroupTypeNotSupport, "")
 	ErrGroupRequestHandled = errs.NewCodeError(GroupRequestHandled, "GroupRequestHandled")
 
@@ -49,14 +51,23 @@ var (
 
 	ErrMessageHasReadDisable = errs.NewCodeError(MessageHasReadDisable, "MessageHasReadDisable")
 
-	ErrCanNotAddYourself   = errs.NewCodeError(CanNotAddYourselfError, "CanNotAddYourselfError")
-	ErrBlockedByPeer       = errs.NewCodeError(BlockedByPeer, "BlockedByPeer")
-	ErrNotPeersFriend      = errs.NewCodeError(NotPeersFriend, "NotPeersFriend")
-	ErrRelationshipAlready = errs.NewCodeError(RelationshipAlreadyError, "RelationshipAlreadyError")
+	ErrCanNotAddYourself        = errs.NewCodeError(CanNotAddYourselfError, "CanNotAddYourselfError")
+	ErrBlockedByPeer            = errs.NewCodeError(BlockedByPeer, "BlockedByPeer")
+	ErrNotPeersFriend           = errs.NewCodeError(NotPeersFriend, "NotPeersFriend")
+	ErrRelationshipAlready      = errs.NewCodeError(RelationshipAlreadyError, "RelationshipAlreadyError")
+	ErrFriendRequestQuota       = errs.NewCodeError(FriendRequestQuota, "FriendRequestQuota")
+	ErrFriendRequestCooldown    = errs.NewCodeError(FriendRequestCooldown, "FriendRequestCooldown")
+	ErrFriendRequestNotAllowed  = errs.NewCodeError(FriendRequestNotAllowed, "FriendRequestNotAllowed")
+	ErrFriendRequestAnswerWrong = errs.NewCodeError(FriendRequestAnswerWrong, "FriendRequestAnswerWrong")
+	ErrContactDiscoveryQuota    = errs.NewCodeError(ContactDiscoveryQuota, "ContactDiscoveryQuota")
 
-	ErrMutedInGroup     = errs.NewCodeError(MutedInGroup, "MutedInGroup")
-	ErrMutedGroup       = errs.NewCodeError(MutedGroup, "MutedGroup")
-	ErrMsgAlreadyRevoke = errs.NewCodeError(MsgAlreadyRevoke, "MsgAlreadyRevoke")
+	ErrMutedInGroup         = errs.NewCodeError(MutedInGroup, "MutedInGroup")
+	ErrMutedGroup           = errs.NewCodeError(MutedGroup, "MutedGroup")
+	ErrMsgAlreadyRevoke     = errs.NewCodeError(MsgAlreadyRevoke, "MsgAlreadyRevoke")
+	ErrSlowMode             = errs.NewCodeError(SlowMode, "SlowMode")
+	ErrMsgTooLong           = errs.NewCodeError(MsgTooLong, "MsgTooLong")
+	ErrLinkNotAllowed       = errs.NewCodeError(LinkNotAllowed, "LinkNotAllowed")
+	ErrAttachmentNotAllowed = errs.NewCodeError(AttachmentNotAllowed, "AttachmentNotAllowed")
 
 	ErrConnOverMaxNumLimit = errs.NewCodeError(ConnOverMaxNumLimit, "ConnOverMaxNumLimit")
 
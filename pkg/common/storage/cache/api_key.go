@@ -0,0 +1,39 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+)
+
+// APIKeyUsage counts the requests authorized by an API key.
+type APIKeyUsage struct {
+	Total int64
+	// Scopes holds the count of each scope the key was used for.
+	Scopes       map[string]int64
+	LastUsedTime int64
+	LastIP       string
+}
+
+type APIKeyCache interface {
+	BatchDeleter
+	CloneAPIKeyCache() APIKeyCache
+	GetAPIKey(ctx context.Context, keyID string) (*model.APIKey, error)
+	DelAPIKey(keyIDs ...string) APIKeyCache
+	IncrAPIKeyUsage(ctx context.Context, keyID string, scope string, ip string) error
+	GetAPIKeyUsage(ctx context.Context, keyID string) (*APIKeyUsage, error)
+}
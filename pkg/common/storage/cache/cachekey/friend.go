@@ -19,6 +19,12 @@ const (
 	TwoWayFriendsIDsKey = "COMMON_FRIENDS_IDS:"
 	FriendKey           = "FRIEND_INFO:"
 	IsFriendKey         = "IS_FRIEND:" // local cache key
+	FriendRequestQuota  = "FRIEND_REQUEST_QUOTA:"
+	ContactQuota        = "CONTACT_DISCOVERY_QUOTA:"
+	FriendSuggestion    = "FRIEND_SUGGESTION:"
+	FriendSuggestInfo   = "FRIEND_SUGGESTION_INFO:"
+	FriendSuggestTime   = "FRIEND_SUGGESTION_TIME:"
+	FriendSuggestStale  = "FRIEND_SUGGESTION_STALE"
 )
 
 func GetFriendIDsKey(ownerUserID string) string {
@@ -36,3 +42,31 @@ func GetFriendKey(ownerUserID, friendUserID string) string {
 func GetIsFriendKey(possibleFriendUserID, userID string) string {
 	return IsFriendKey + possibleFriendUserID + "-" + userID
 }
+
+func GetFriendRequestQuotaKey(userID string, day string) string {
+	return FriendRequestQuota + userID + "-" + day
+}
+
+func GetContactDiscoveryQuotaKey(userID string, day string) string {
+	return ContactQuota + userID + "-" + day
+}
+
+// GetFriendSuggestionKey is a sorted set of the suggested users by score.
+func GetFriendSuggestionKey(userID string) string {
+	return FriendSuggestion + userID
+}
+
+// GetFriendSuggestionInfoKey is a hash of the mutual friends and shared groups of the suggested users.
+func GetFriendSuggestionInfoKey(userID string) string {
+	return FriendSuggestInfo + userID
+}
+
+// GetFriendSuggestionTimeKey exists as long as the suggestions of the user are valid, even when there are none.
+func GetFriendSuggestionTimeKey(userID string) string {
+	return FriendSuggestTime + userID
+}
+
+// GetFriendSuggestionStaleKey is a set of the users whose suggestions are to be computed again.
+func GetFriendSuggestionStaleKey() string {
+	return FriendSuggestStale
+}
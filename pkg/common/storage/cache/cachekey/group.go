@@ -28,6 +28,9 @@ const (
 	JoinedGroupsKey            = "JOIN_GROUPS_KEY:"
 	GroupMemberNumKey          = "GROUP_MEMBER_NUM_CACHE:"
 	GroupRoleLevelMemberIDsKey = "GROUP_ROLE_LEVEL_MEMBER_IDS:"
+	GroupRolesKey              = "GROUP_ROLES:"
+	GroupMsgPolicyKey          = "GROUP_MSG_POLICY:"
+	GroupSlowModeKey           = "GROUP_SLOW_MODE:"
 )
 
 func GetGroupInfoKey(groupID string) string {
@@ -57,3 +60,15 @@ func GetGroupMemberNumKey(groupID string) string {
 func GetGroupRoleLevelMemberIDsKey(groupID string, roleLevel int32) string {
 	return GroupRoleLevelMemberIDsKey + groupID + "-" + strconv.Itoa(int(roleLevel))
 }
+
+func GetGroupRolesKey(groupID string) string {
+	return GroupRolesKey + groupID
+}
+
+func GetGroupMsgPolicyKey(groupID string) string {
+	return GroupMsgPolicyKey + groupID
+}
+
+func GetGroupSlowModeKey(groupID, userID string) string {
+	return GroupSlowModeKey + groupID + "-" + userID
+}
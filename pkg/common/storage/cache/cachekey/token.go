@@ -3,9 +3,19 @@ package cachekey
 import "github.com/openimsdk/protocol/constant"
 
 const (
-	UidPidToken = "UID_PID_TOKEN_STATUS:"
+	UidPidToken        = "UID_PID_TOKEN_STATUS:"
+	UidPidRefreshToken = "UID_PID_REFRESH_TOKEN:"
+	UidPidTokenSession = "UID_PID_TOKEN_SESSION:"
 )
 
 func GetTokenKey(userID string, platformID int) string {
 	return UidPidToken + userID + ":" + constant.PlatformIDToName(platformID)
 }
+
+func GetRefreshTokenKey(userID string, platformID int) string {
+	return UidPidRefreshToken + userID + ":" + constant.PlatformIDToName(platformID)
+}
+
+func GetTokenSessionKey(userID string, platformID int) string {
+	return UidPidTokenSession + userID + ":" + constant.PlatformIDToName(platformID)
+}
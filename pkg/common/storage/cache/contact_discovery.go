@@ -0,0 +1,25 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+)
+
+type ContactDiscoveryQuotaCache interface {
+	// AcquireContactDiscoveryQuota counts count looked up hashes of the user on the current day in UTC,
+	// it reports false when the user would look up more than limit hashes that day.
+	AcquireContactDiscoveryQuota(ctx context.Context, userID string, count int64, limit int64) (bool, error)
+}
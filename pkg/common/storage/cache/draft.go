@@ -0,0 +1,35 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+)
+
+// DraftCache holds the drafts not yet written to the database, and the users they belong to.
+type DraftCache interface {
+	// SetDraft keeps the draft until it is written to the database and marks the user as pending.
+	SetDraft(ctx context.Context, draft *model.ConversationDraft) error
+	// GetDrafts returns the pending drafts of the user.
+	GetDrafts(ctx context.Context, userID string) ([]*model.ConversationDraft, error)
+	// GetPendingUsers returns up to count random pending users, they stay pending until DelPendingUser.
+	GetPendingUsers(ctx context.Context, count int64) ([]string, error)
+	// DelPendingUser removes the pending mark of the user, it is kept when drafts were set in the meantime.
+	DelPendingUser(ctx context.Context, userID string) error
+	// DelWrittenDrafts removes the pending drafts of the user that were not updated since they were written.
+	DelWrittenDrafts(ctx context.Context, userID string, drafts []*model.ConversationDraft) error
+}
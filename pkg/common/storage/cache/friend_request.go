@@ -0,0 +1,27 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+)
+
+type FriendRequestQuotaCache interface {
+	// AcquireFriendRequestQuota counts a friend request of the user on the current day in UTC,
+	// it reports false when the user already sent limit requests that day.
+	AcquireFriendRequestQuota(ctx context.Context, userID string, limit int64) (bool, error)
+	// ReleaseFriendRequestQuota gives back a request counted on the current day, for requests that failed to be sent.
+	ReleaseFriendRequestQuota(ctx context.Context, userID string) error
+}
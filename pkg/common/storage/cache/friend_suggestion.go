@@ -0,0 +1,38 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+)
+
+// FriendSuggestionCache holds the precomputed friend suggestions of the users and the users to compute them for.
+type FriendSuggestionCache interface {
+	// SetFriendSuggestions replaces the suggestions of the user, they are valid until expire.
+	SetFriendSuggestions(ctx context.Context, userID string, suggestions []*model.FriendSuggestion, expire time.Duration) error
+	// GetFriendSuggestions returns the suggestions ranked from start to stop, computed is false when the user has no valid suggestions.
+	GetFriendSuggestions(ctx context.Context, userID string, start int64, stop int64) (computed bool, total int64, suggestions []*model.FriendSuggestion, err error)
+	// DelFriendSuggestions removes users from the suggestions of the user.
+	DelFriendSuggestions(ctx context.Context, userID string, suggestedUserIDs []string) error
+	// DelUserFriendSuggestions removes all suggestions of the user.
+	DelUserFriendSuggestions(ctx context.Context, userID string) error
+	// AddStaleUsers marks the suggestions of the users to be computed again.
+	AddStaleUsers(ctx context.Context, userIDs []string) error
+	// PopStaleUsers removes up to count stale users and returns them.
+	PopStaleUsers(ctx context.Context, count int64) ([]string, error)
+}
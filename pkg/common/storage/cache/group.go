@@ -59,4 +59,7 @@ type GroupCache interface {
 	GetGroupRolesLevelMemberInfo(ctx context.Context, groupID string, roleLevels []int32) ([]*model.GroupMember, error)
 	GetGroupMemberNum(ctx context.Context, groupID string) (memberNum int64, err error)
 	DelGroupsMemberNum(groupID ...string) GroupCache
+
+	GetGroupRoles(ctx context.Context, groupID string) ([]*model.GroupRole, error)
+	DelGroupRoles(groupIDs ...string) GroupCache
 }
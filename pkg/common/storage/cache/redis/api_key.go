@@ -0,0 +1,116 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dtm-labs/rockscache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache/cachekey"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/errs"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	apiKeyExpireTime = time.Hour * 12
+
+	apiKeyUsageTotal    = "total"
+	apiKeyUsageScope    = "scope:"
+	apiKeyUsageLastTime = "last_used_time"
+	apiKeyUsageLastIP   = "last_ip"
+)
+
+func NewAPIKeyCacheRedis(rdb redis.UniversalClient, apiKeyDB database.APIKey) cache.APIKeyCache {
+	opts := rockscache.NewDefaultOptions()
+	batchHandler := NewBatchDeleterRedis(rdb, &opts, nil)
+	return &apiKeyCacheRedis{
+		BatchDeleter: batchHandler,
+		rdb:          rdb,
+		rcClient:     rockscache.NewClient(rdb, opts),
+		expireTime:   apiKeyExpireTime,
+		apiKeyDB:     apiKeyDB,
+	}
+}
+
+type apiKeyCacheRedis struct {
+	cache.BatchDeleter
+	rdb        redis.UniversalClient
+	rcClient   *rockscache.Client
+	expireTime time.Duration
+	apiKeyDB   database.APIKey
+}
+
+func (a *apiKeyCacheRedis) CloneAPIKeyCache() cache.APIKeyCache {
+	return &apiKeyCacheRedis{
+		BatchDeleter: a.BatchDeleter.Clone(),
+		rdb:          a.rdb,
+		rcClient:     a.rcClient,
+		expireTime:   a.expireTime,
+		apiKeyDB:     a.apiKeyDB,
+	}
+}
+
+func (a *apiKeyCacheRedis) GetAPIKey(ctx context.Context, keyID string) (*model.APIKey, error) {
+	return getCache(ctx, a.rcClient, cachekey.GetAPIKeyKey(keyID), a.expireTime, func(ctx context.Context) (*model.APIKey, error) {
+		return a.apiKeyDB.Take(ctx, keyID)
+	})
+}
+
+func (a *apiKeyCacheRedis) DelAPIKey(keyIDs ...string) cache.APIKeyCache {
+	c := a.CloneAPIKeyCache()
+	keys := make([]string, 0, len(keyIDs))
+	for _, keyID := range keyIDs {
+		keys = append(keys, cachekey.GetAPIKeyKey(keyID))
+	}
+	c.AddKeys(keys...)
+	return c
+}
+
+func (a *apiKeyCacheRedis) IncrAPIKeyUsage(ctx context.Context, keyID string, scope string, ip string) error {
+	key := cachekey.GetAPIKeyUsageKey(keyID)
+	pipe := a.rdb.Pipeline()
+	pipe.HIncrBy(ctx, key, apiKeyUsageTotal, 1)
+	pipe.HIncrBy(ctx, key, apiKeyUsageScope+scope, 1)
+	pipe.HSet(ctx, key, apiKeyUsageLastTime, time.Now().UnixMilli(), apiKeyUsageLastIP, ip)
+	_, err := pipe.Exec(ctx)
+	return errs.Wrap(err)
+}
+
+func (a *apiKeyCacheRedis) GetAPIKeyUsage(ctx context.Context, keyID string) (*cache.APIKeyUsage, error) {
+	m, err := a.rdb.HGetAll(ctx, cachekey.GetAPIKeyUsageKey(keyID)).Result()
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	usage := &cache.APIKeyUsage{Scopes: make(map[string]int64)}
+	for k, v := range m {
+		switch {
+		case k == apiKeyUsageTotal:
+			usage.Total, _ = strconv.ParseInt(v, 10, 64)
+		case k == apiKeyUsageLastTime:
+			usage.LastUsedTime, _ = strconv.ParseInt(v, 10, 64)
+		case k == apiKeyUsageLastIP:
+			usage.LastIP = v
+		case strings.HasPrefix(k, apiKeyUsageScope):
+			usage.Scopes[strings.TrimPrefix(k, apiKeyUsageScope)], _ = strconv.ParseInt(v, 10, 64)
+		}
+	}
+	return usage, nil
+}
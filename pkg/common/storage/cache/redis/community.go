@@ -0,0 +1,74 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"time"
+
+	"github.com/dtm-labs/rockscache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache/cachekey"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	communityExpireTime = time.Second * 60 * 60 * 12
+)
+
+type CommunityCacheRedis struct {
+	cache.BatchDeleter
+	expireTime time.Duration
+	rcClient   *rockscache.Client
+	memberDB   database.CommunityMember
+}
+
+// NewCommunityCacheRedis publishes deletions on the group topic, community members are held by the group local caches.
+func NewCommunityCacheRedis(rdb redis.UniversalClient, localCache *config.LocalCache, memberDB database.CommunityMember, options *rockscache.Options) cache.CommunityCache {
+	return &CommunityCacheRedis{
+		BatchDeleter: NewBatchDeleterRedis(rdb, options, []string{localCache.Group.Topic}),
+		expireTime:   communityExpireTime,
+		rcClient:     rockscache.NewClient(rdb, *options),
+		memberDB:     memberDB,
+	}
+}
+
+func (c *CommunityCacheRedis) CloneCommunityCache() cache.CommunityCache {
+	return &CommunityCacheRedis{
+		BatchDeleter: c.BatchDeleter.Clone(),
+		expireTime:   c.expireTime,
+		rcClient:     c.rcClient,
+		memberDB:     c.memberDB,
+	}
+}
+
+func (c *CommunityCacheRedis) GetCommunityMember(ctx context.Context, communityID string, userID string) (*model.CommunityMember, error) {
+	return getCache(ctx, c.rcClient, cachekey.GetCommunityMemberKey(communityID, userID), c.expireTime, func(ctx context.Context) (*model.CommunityMember, error) {
+		return c.memberDB.Take(ctx, communityID, userID)
+	})
+}
+
+func (c *CommunityCacheRedis) DelCommunityMembers(communityID string, userIDs ...string) cache.CommunityCache {
+	newCache := c.CloneCommunityCache()
+	keys := make([]string, 0, len(userIDs))
+	for _, userID := range userIDs {
+		keys = append(keys, cachekey.GetCommunityMemberKey(communityID, userID))
+	}
+	newCache.AddKeys(keys...)
+	return newCache
+}
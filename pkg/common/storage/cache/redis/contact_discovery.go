@@ -0,0 +1,52 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache/cachekey"
+	"github.com/openimsdk/tools/errs"
+	"github.com/redis/go-redis/v9"
+)
+
+func NewContactDiscoveryQuotaCache(rdb redis.UniversalClient) cache.ContactDiscoveryQuotaCache {
+	return &contactDiscoveryQuotaCache{rdb: rdb}
+}
+
+type contactDiscoveryQuotaCache struct {
+	rdb redis.UniversalClient
+}
+
+func (c *contactDiscoveryQuotaCache) AcquireContactDiscoveryQuota(ctx context.Context, userID string, count int64, limit int64) (bool, error) {
+	key := cachekey.GetContactDiscoveryQuotaKey(userID, time.Now().UTC().Format(time.DateOnly))
+	pipe := c.rdb.TxPipeline()
+	incr := pipe.IncrBy(ctx, key, count)
+	// Same lifetime as the daily friend request counters.
+	pipe.Expire(ctx, key, friendRequestQuotaExpire)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return false, errs.Wrap(err)
+	}
+	if incr.Val() <= limit {
+		return true, nil
+	}
+	// The rejected lookup does not use the quota, so that smaller lookups can still fit in it.
+	if err := c.rdb.DecrBy(ctx, key, count).Err(); err != nil {
+		return false, errs.Wrap(err)
+	}
+	return false, nil
+}
@@ -0,0 +1,80 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"time"
+
+	"github.com/dtm-labs/rockscache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache/cachekey"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	doNotDisturbExpireTime = time.Second * 60 * 60 * 12
+)
+
+type DoNotDisturbCacheRedis struct {
+	cache.BatchDeleter
+	expireTime time.Duration
+	rcClient   *rockscache.Client
+	dndDB      database.DoNotDisturb
+}
+
+func NewDoNotDisturbCacheRedis(rdb redis.UniversalClient, dndDB database.DoNotDisturb, options *rockscache.Options) cache.DoNotDisturbCache {
+	return &DoNotDisturbCacheRedis{
+		BatchDeleter: NewBatchDeleterRedis(rdb, options, nil),
+		expireTime:   doNotDisturbExpireTime,
+		rcClient:     rockscache.NewClient(rdb, *options),
+		dndDB:        dndDB,
+	}
+}
+
+func (c *DoNotDisturbCacheRedis) CloneDoNotDisturbCache() cache.DoNotDisturbCache {
+	return &DoNotDisturbCacheRedis{
+		BatchDeleter: c.BatchDeleter.Clone(),
+		expireTime:   c.expireTime,
+		rcClient:     c.rcClient,
+		dndDB:        c.dndDB,
+	}
+}
+
+func (c *DoNotDisturbCacheRedis) GetDoNotDisturbs(ctx context.Context, userIDs []string) ([]*model.DoNotDisturb, error) {
+	return batchGetCache(ctx, c.rcClient, c.expireTime, userIDs, cachekey.GetDoNotDisturbKey, func(ctx context.Context, userID string) (*model.DoNotDisturb, error) {
+		dnds, err := c.dndDB.Find(ctx, []string{userID})
+		if err != nil {
+			return nil, err
+		}
+		// Users without settings are cached too, most users have none and are looked up on every offline push.
+		if len(dnds) == 0 {
+			return &model.DoNotDisturb{UserID: userID}, nil
+		}
+		return dnds[0], nil
+	})
+}
+
+func (c *DoNotDisturbCacheRedis) DelDoNotDisturbs(userIDs ...string) cache.DoNotDisturbCache {
+	newCache := c.CloneDoNotDisturbCache()
+	keys := make([]string, 0, len(userIDs))
+	for _, userID := range userIDs {
+		keys = append(keys, cachekey.GetDoNotDisturbKey(userID))
+	}
+	newCache.AddKeys(keys...)
+	return newCache
+}
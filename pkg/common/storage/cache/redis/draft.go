@@ -0,0 +1,134 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache/cachekey"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/redis/go-redis/v9"
+)
+
+// delWrittenDraftsScript deletes the fields whose value is still the written one, ARGV is field and value pairs.
+var delWrittenDraftsScript = redis.NewScript(`
+for i = 1, #ARGV, 2 do
+	if redis.call("HGET", KEYS[1], ARGV[i]) == ARGV[i + 1] then
+		redis.call("HDEL", KEYS[1], ARGV[i])
+	end
+end
+return 0
+`)
+
+func NewDraftCache(rdb redis.UniversalClient) cache.DraftCache {
+	return &draftCache{rdb: rdb}
+}
+
+type draftCache struct {
+	rdb redis.UniversalClient
+}
+
+type draftValue struct {
+	Draft      string `json:"draft"`
+	UpdateTime int64  `json:"updateTime"`
+}
+
+func encodeDraft(draft *model.ConversationDraft) (string, error) {
+	data, err := json.Marshal(draftValue{Draft: draft.Draft, UpdateTime: draft.UpdateTime.UnixMilli()})
+	if err != nil {
+		return "", errs.Wrap(err)
+	}
+	return string(data), nil
+}
+
+func (c *draftCache) SetDraft(ctx context.Context, draft *model.ConversationDraft) error {
+	value, err := encodeDraft(draft)
+	if err != nil {
+		return err
+	}
+	// The pending mark is added after the draft, DelPendingUser relies on it. The keys are in different slots in a
+	// cluster and are not written atomically there, a failed SADD leaves the draft unwritten until the user sets one again.
+	pipe := c.rdb.TxPipeline()
+	pipe.HSet(ctx, cachekey.GetConversationDraftKey(draft.OwnerUserID), draft.ConversationID, value)
+	pipe.SAdd(ctx, cachekey.GetConversationDraftDirtyKey(), draft.OwnerUserID)
+	_, err = pipe.Exec(ctx)
+	return errs.Wrap(err)
+}
+
+func (c *draftCache) GetDrafts(ctx context.Context, userID string) ([]*model.ConversationDraft, error) {
+	values, err := c.rdb.HGetAll(ctx, cachekey.GetConversationDraftKey(userID)).Result()
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	drafts := make([]*model.ConversationDraft, 0, len(values))
+	for conversationID, value := range values {
+		var v draftValue
+		if err := json.Unmarshal([]byte(value), &v); err != nil {
+			log.ZWarn(ctx, "invalid cached draft", err, "userID", userID, "conversationID", conversationID)
+			continue
+		}
+		drafts = append(drafts, &model.ConversationDraft{
+			OwnerUserID:    userID,
+			ConversationID: conversationID,
+			Draft:          v.Draft,
+			UpdateTime:     time.UnixMilli(v.UpdateTime),
+		})
+	}
+	return drafts, nil
+}
+
+func (c *draftCache) GetPendingUsers(ctx context.Context, count int64) ([]string, error) {
+	userIDs, err := c.rdb.SRandMemberN(ctx, cachekey.GetConversationDraftDirtyKey(), count).Result()
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return userIDs, nil
+}
+
+func (c *draftCache) DelPendingUser(ctx context.Context, userID string) error {
+	dirtyKey := cachekey.GetConversationDraftDirtyKey()
+	if err := c.rdb.SRem(ctx, dirtyKey, userID).Err(); err != nil {
+		return errs.Wrap(err)
+	}
+	// A draft set before the SREM has its field already, mark the user again so that it is written too.
+	n, err := c.rdb.HLen(ctx, cachekey.GetConversationDraftKey(userID)).Result()
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	if n > 0 {
+		return errs.Wrap(c.rdb.SAdd(ctx, dirtyKey, userID).Err())
+	}
+	return nil
+}
+
+func (c *draftCache) DelWrittenDrafts(ctx context.Context, userID string, drafts []*model.ConversationDraft) error {
+	if len(drafts) == 0 {
+		return nil
+	}
+	args := make([]any, 0, len(drafts)*2)
+	for _, draft := range drafts {
+		value, err := encodeDraft(draft)
+		if err != nil {
+			return err
+		}
+		args = append(args, draft.ConversationID, value)
+	}
+	return errs.Wrap(delWrittenDraftsScript.Run(ctx, c.rdb, []string{cachekey.GetConversationDraftKey(userID)}, args...).Err())
+}
@@ -0,0 +1,75 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache/cachekey"
+	"github.com/openimsdk/tools/errs"
+	"github.com/redis/go-redis/v9"
+)
+
+// friendRequestQuotaExpire keeps the counter of a day a bit longer than the day itself.
+const friendRequestQuotaExpire = time.Hour * 25
+
+// acquireFriendRequestQuotaScript counts a request only while the limit is not reached, so refused requests use no quota.
+var acquireFriendRequestQuotaScript = redis.NewScript(`
+if tonumber(redis.call("GET", KEYS[1]) or "0") >= tonumber(ARGV[1]) then
+	return 0
+end
+redis.call("INCR", KEYS[1])
+redis.call("EXPIRE", KEYS[1], ARGV[2])
+return 1
+`)
+
+// releaseFriendRequestQuotaScript gives back a counted request without going below zero.
+var releaseFriendRequestQuotaScript = redis.NewScript(`
+if tonumber(redis.call("GET", KEYS[1]) or "0") > 0 then
+	redis.call("DECR", KEYS[1])
+end
+return 0
+`)
+
+func NewFriendRequestQuotaCache(rdb redis.UniversalClient) cache.FriendRequestQuotaCache {
+	return &friendRequestQuotaCache{rdb: rdb}
+}
+
+type friendRequestQuotaCache struct {
+	rdb redis.UniversalClient
+}
+
+func friendRequestQuotaKey(userID string) string {
+	return cachekey.GetFriendRequestQuotaKey(userID, time.Now().UTC().Format(time.DateOnly))
+}
+
+func (f *friendRequestQuotaCache) AcquireFriendRequestQuota(ctx context.Context, userID string, limit int64) (bool, error) {
+	keys := []string{friendRequestQuotaKey(userID)}
+	acquired, err := acquireFriendRequestQuotaScript.Run(ctx, f.rdb, keys, limit, int64(friendRequestQuotaExpire/time.Second)).Int()
+	if err != nil {
+		return false, errs.Wrap(err)
+	}
+	return acquired == 1, nil
+}
+
+func (f *friendRequestQuotaCache) ReleaseFriendRequestQuota(ctx context.Context, userID string) error {
+	keys := []string{friendRequestQuotaKey(userID)}
+	if err := releaseFriendRequestQuotaScript.Run(ctx, f.rdb, keys).Err(); err != nil {
+		return errs.Wrap(err)
+	}
+	return nil
+}
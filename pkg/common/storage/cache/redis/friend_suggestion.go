@@ -0,0 +1,150 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache/cachekey"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/redis/go-redis/v9"
+)
+
+func NewFriendSuggestionCache(rdb redis.UniversalClient) cache.FriendSuggestionCache {
+	return &friendSuggestionCache{rdb: rdb}
+}
+
+type friendSuggestionCache struct {
+	rdb redis.UniversalClient
+}
+
+type friendSuggestionValue struct {
+	MutualFriends int64 `json:"mutualFriends"`
+	SharedGroups  int64 `json:"sharedGroups"`
+}
+
+func (f *friendSuggestionCache) SetFriendSuggestions(ctx context.Context, userID string, suggestions []*model.FriendSuggestion, expire time.Duration) error {
+	key, infoKey := cachekey.GetFriendSuggestionKey(userID), cachekey.GetFriendSuggestionInfoKey(userID)
+	members := make([]redis.Z, 0, len(suggestions))
+	values := make(map[string]any, len(suggestions))
+	for _, suggestion := range suggestions {
+		data, err := json.Marshal(friendSuggestionValue{MutualFriends: suggestion.MutualFriends, SharedGroups: suggestion.SharedGroups})
+		if err != nil {
+			return errs.Wrap(err)
+		}
+		members = append(members, redis.Z{Score: suggestion.Score, Member: suggestion.UserID})
+		values[suggestion.UserID] = string(data)
+	}
+	pipe := f.rdb.TxPipeline()
+	// One key per command, the keys may be in different slots of a cluster.
+	pipe.Del(ctx, key)
+	pipe.Del(ctx, infoKey)
+	if len(members) > 0 {
+		pipe.ZAdd(ctx, key, members...)
+		pipe.HSet(ctx, infoKey, values)
+		pipe.Expire(ctx, key, expire)
+		pipe.Expire(ctx, infoKey, expire)
+	}
+	pipe.Set(ctx, cachekey.GetFriendSuggestionTimeKey(userID), time.Now().UnixMilli(), expire)
+	_, err := pipe.Exec(ctx)
+	return errs.Wrap(err)
+}
+
+func (f *friendSuggestionCache) GetFriendSuggestions(ctx context.Context, userID string, start int64, stop int64) (bool, int64, []*model.FriendSuggestion, error) {
+	key := cachekey.GetFriendSuggestionKey(userID)
+	pipe := f.rdb.Pipeline()
+	exists := pipe.Exists(ctx, cachekey.GetFriendSuggestionTimeKey(userID))
+	total := pipe.ZCard(ctx, key)
+	members := pipe.ZRevRangeWithScores(ctx, key, start, stop)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return false, 0, nil, errs.Wrap(err)
+	}
+	if exists.Val() == 0 {
+		return false, 0, nil, nil
+	}
+	if len(members.Val()) == 0 {
+		return true, total.Val(), nil, nil
+	}
+	userIDs := make([]string, 0, len(members.Val()))
+	for _, member := range members.Val() {
+		userIDs = append(userIDs, member.Member.(string))
+	}
+	values, err := f.rdb.HMGet(ctx, cachekey.GetFriendSuggestionInfoKey(userID), userIDs...).Result()
+	if err != nil {
+		return false, 0, nil, errs.Wrap(err)
+	}
+	suggestions := make([]*model.FriendSuggestion, 0, len(userIDs))
+	for i, member := range members.Val() {
+		suggestion := &model.FriendSuggestion{UserID: userIDs[i], Score: member.Score}
+		if value, ok := values[i].(string); ok {
+			var v friendSuggestionValue
+			if err := json.Unmarshal([]byte(value), &v); err != nil {
+				log.ZWarn(ctx, "invalid cached friend suggestion", err, "userID", userID, "suggestedUserID", userIDs[i])
+			}
+			suggestion.MutualFriends, suggestion.SharedGroups = v.MutualFriends, v.SharedGroups
+		}
+		suggestions = append(suggestions, suggestion)
+	}
+	return true, total.Val(), suggestions, nil
+}
+
+func (f *friendSuggestionCache) DelFriendSuggestions(ctx context.Context, userID string, suggestedUserIDs []string) error {
+	if len(suggestedUserIDs) == 0 {
+		return nil
+	}
+	members := make([]any, 0, len(suggestedUserIDs))
+	for _, suggestedUserID := range suggestedUserIDs {
+		members = append(members, suggestedUserID)
+	}
+	pipe := f.rdb.Pipeline()
+	pipe.ZRem(ctx, cachekey.GetFriendSuggestionKey(userID), members...)
+	pipe.HDel(ctx, cachekey.GetFriendSuggestionInfoKey(userID), suggestedUserIDs...)
+	_, err := pipe.Exec(ctx)
+	return errs.Wrap(err)
+}
+
+func (f *friendSuggestionCache) DelUserFriendSuggestions(ctx context.Context, userID string) error {
+	pipe := f.rdb.Pipeline()
+	// One key per command, the keys may be in different slots of a cluster.
+	pipe.Del(ctx, cachekey.GetFriendSuggestionKey(userID))
+	pipe.Del(ctx, cachekey.GetFriendSuggestionInfoKey(userID))
+	pipe.Del(ctx, cachekey.GetFriendSuggestionTimeKey(userID))
+	_, err := pipe.Exec(ctx)
+	return errs.Wrap(err)
+}
+
+func (f *friendSuggestionCache) AddStaleUsers(ctx context.Context, userIDs []string) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+	members := make([]any, 0, len(userIDs))
+	for _, userID := range userIDs {
+		members = append(members, userID)
+	}
+	return errs.Wrap(f.rdb.SAdd(ctx, cachekey.GetFriendSuggestionStaleKey(), members...).Err())
+}
+
+func (f *friendSuggestionCache) PopStaleUsers(ctx context.Context, count int64) ([]string, error) {
+	userIDs, err := f.rdb.SPopN(ctx, cachekey.GetFriendSuggestionStaleKey(), count).Result()
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return userIDs, nil
+}
@@ -43,6 +43,7 @@ type GroupCacheRedis struct {
 	groupDB        database.Group
 	groupMemberDB  database.GroupMember
 	groupRequestDB database.GroupRequest
+	groupRoleDB    database.GroupRole
 	expireTime     time.Duration
 	rcClient       *rockscache.Client
 	groupHash      cache.GroupHash
@@ -54,6 +55,7 @@ func NewGroupCacheRedis(
 	groupDB database.Group,
 	groupMemberDB database.GroupMember,
 	groupRequestDB database.GroupRequest,
+	groupRoleDB database.GroupRole,
 	hashCode cache.GroupHash,
 	opts *rockscache.Options,
 ) cache.GroupCache {
@@ -68,6 +70,7 @@ func NewGroupCacheRedis(
 		groupDB:        groupDB,
 		groupMemberDB:  groupMemberDB,
 		groupRequestDB: groupRequestDB,
+		groupRoleDB:    groupRoleDB,
 		groupHash:      hashCode,
 	}
 }
@@ -80,6 +83,7 @@ func (g *GroupCacheRedis) CloneGroupCache() cache.GroupCache {
 		groupDB:        g.groupDB,
 		groupMemberDB:  g.groupMemberDB,
 		groupRequestDB: g.groupRequestDB,
+		groupRoleDB:    g.groupRoleDB,
 	}
 }
 
@@ -111,6 +115,10 @@ func (g *GroupCacheRedis) getGroupRoleLevelMemberIDsKey(groupID string, roleLeve
 	return cachekey.GetGroupRoleLevelMemberIDsKey(groupID, roleLevel)
 }
 
+func (g *GroupCacheRedis) getGroupRolesKey(groupID string) string {
+	return cachekey.GetGroupRolesKey(groupID)
+}
+
 func (g *GroupCacheRedis) GetGroupIndex(group *model.Group, keys []string) (int, error) {
 	key := g.getGroupInfoKey(group.GroupID)
 	for i, _key := range keys {
@@ -149,9 +157,10 @@ func (g *GroupCacheRedis) GetGroupInfo(ctx context.Context, groupID string) (gro
 
 func (g *GroupCacheRedis) DelGroupsInfo(groupIDs ...string) cache.GroupCache {
 	newGroupCache := g.CloneGroupCache()
-	keys := make([]string, 0, len(groupIDs))
+	keys := make([]string, 0, len(groupIDs)*3)
 	for _, groupID := range groupIDs {
-		keys = append(keys, g.getGroupInfoKey(groupID))
+		// The message policy and the community are part of the group info and only held by local caches.
+		keys = append(keys, g.getGroupInfoKey(groupID), cachekey.GetGroupMsgPolicyKey(groupID), cachekey.GetGroupCommunityKey(groupID))
 	}
 	newGroupCache.AddKeys(keys...)
 
@@ -406,3 +415,20 @@ func (g *GroupCacheRedis) FindGroupMemberUser(ctx context.Context, groupIDs []st
 		return g.groupMemberDB.Take(ctx, groupID, userID)
 	})
 }
+
+func (g *GroupCacheRedis) GetGroupRoles(ctx context.Context, groupID string) ([]*model.GroupRole, error) {
+	return getCache(ctx, g.rcClient, g.getGroupRolesKey(groupID), g.expireTime, func(ctx context.Context) ([]*model.GroupRole, error) {
+		return g.groupRoleDB.Find(ctx, groupID)
+	})
+}
+
+func (g *GroupCacheRedis) DelGroupRoles(groupIDs ...string) cache.GroupCache {
+	newGroupCache := g.CloneGroupCache()
+	keys := make([]string, 0, len(groupIDs))
+	for _, groupID := range groupIDs {
+		keys = append(keys, g.getGroupRolesKey(groupID))
+	}
+	newGroupCache.AddKeys(keys...)
+
+	return newGroupCache
+}
@@ -0,0 +1,53 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache/cachekey"
+	"github.com/openimsdk/tools/errs"
+	"github.com/redis/go-redis/v9"
+)
+
+func NewSlowModeCache(rdb redis.UniversalClient) cache.SlowModeCache {
+	return &slowModeCache{rdb: rdb}
+}
+
+type slowModeCache struct {
+	rdb redis.UniversalClient
+}
+
+func (s *slowModeCache) AcquireSlowMode(ctx context.Context, groupID string, userID string, interval time.Duration) (time.Duration, error) {
+	key := cachekey.GetGroupSlowModeKey(groupID, userID)
+	ok, err := s.rdb.SetNX(ctx, key, time.Now().UnixMilli(), interval).Result()
+	if err != nil {
+		return 0, errs.Wrap(err)
+	}
+	if ok {
+		return 0, nil
+	}
+	ttl, err := s.rdb.PTTL(ctx, key).Result()
+	if err != nil {
+		return 0, errs.Wrap(err)
+	}
+	if ttl <= 0 {
+		// The key expired in between, the message is allowed.
+		return 0, nil
+	}
+	return ttl, nil
+}
@@ -16,6 +16,9 @@ package redis
 
 import (
 	"context"
+	"encoding/json"
+	"time"
+
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache/cachekey"
 	"github.com/openimsdk/tools/errs"
@@ -23,6 +26,17 @@ import (
 	"github.com/redis/go-redis/v9"
 )
 
+var rotateRefreshTokenScript = redis.NewScript(`
+if redis.call("HGET", KEYS[1], ARGV[1]) ~= ARGV[2] then
+	return 0
+end
+for i = 4, #ARGV, 2 do
+	redis.call("HSET", KEYS[1], ARGV[i], ARGV[i + 1])
+end
+redis.call("PEXPIRE", KEYS[1], ARGV[3])
+return 1
+`)
+
 type tokenCache struct {
 	rdb redis.UniversalClient
 }
@@ -61,3 +75,98 @@ func (c *tokenCache) SetTokenMapByUidPid(ctx context.Context, userID string, pla
 func (c *tokenCache) DeleteTokenByUidPid(ctx context.Context, userID string, platformID int, fields []string) error {
 	return errs.Wrap(c.rdb.HDel(ctx, cachekey.GetTokenKey(userID, platformID), fields...).Err())
 }
+
+func (c *tokenCache) GetRefreshTokens(ctx context.Context, userID string, platformID int) (map[string]*cache.RefreshToken, error) {
+	m, err := c.rdb.HGetAll(ctx, cachekey.GetRefreshTokenKey(userID, platformID)).Result()
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	mm := make(map[string]*cache.RefreshToken, len(m))
+	for k, v := range m {
+		var t cache.RefreshToken
+		if err := json.Unmarshal([]byte(v), &t); err != nil {
+			return nil, errs.WrapMsg(err, "refresh token json.Unmarshal failed", "value", v)
+		}
+		mm[k] = &t
+	}
+	return mm, nil
+}
+
+func (c *tokenCache) SetRefreshTokens(ctx context.Context, userID string, platformID int, m map[string]*cache.RefreshToken, expire time.Duration) error {
+	mm := make(map[string]any, len(m))
+	for k, v := range m {
+		data, err := json.Marshal(v)
+		if err != nil {
+			return errs.Wrap(err)
+		}
+		mm[k] = string(data)
+	}
+	key := cachekey.GetRefreshTokenKey(userID, platformID)
+	pipe := c.rdb.TxPipeline()
+	pipe.HSet(ctx, key, mm)
+	pipe.Expire(ctx, key, expire)
+	_, err := pipe.Exec(ctx)
+	return errs.Wrap(err)
+}
+
+func (c *tokenCache) DeleteRefreshTokens(ctx context.Context, userID string, platformID int, fields []string) error {
+	return errs.Wrap(c.rdb.HDel(ctx, cachekey.GetRefreshTokenKey(userID, platformID), fields...).Err())
+}
+
+func (c *tokenCache) RotateRefreshToken(ctx context.Context, userID string, platformID int, refreshToken string, old *cache.RefreshToken, m map[string]*cache.RefreshToken, expire time.Duration) (bool, error) {
+	oldData, err := json.Marshal(old)
+	if err != nil {
+		return false, errs.Wrap(err)
+	}
+	args := make([]any, 0, 3+len(m)*2)
+	args = append(args, refreshToken, string(oldData), expire.Milliseconds())
+	for k, v := range m {
+		data, err := json.Marshal(v)
+		if err != nil {
+			return false, errs.Wrap(err)
+		}
+		args = append(args, k, string(data))
+	}
+	res, err := rotateRefreshTokenScript.Run(ctx, c.rdb, []string{cachekey.GetRefreshTokenKey(userID, platformID)}, args...).Int()
+	if err != nil {
+		return false, errs.Wrap(err)
+	}
+	return res == 1, nil
+}
+
+func (c *tokenCache) GetTokenSessions(ctx context.Context, userID string, platformID int) (map[string]*cache.TokenSession, error) {
+	m, err := c.rdb.HGetAll(ctx, cachekey.GetTokenSessionKey(userID, platformID)).Result()
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	mm := make(map[string]*cache.TokenSession, len(m))
+	for k, v := range m {
+		var t cache.TokenSession
+		if err := json.Unmarshal([]byte(v), &t); err != nil {
+			return nil, errs.WrapMsg(err, "token session json.Unmarshal failed", "value", v)
+		}
+		mm[k] = &t
+	}
+	return mm, nil
+}
+
+func (c *tokenCache) SetTokenSessions(ctx context.Context, userID string, platformID int, m map[string]*cache.TokenSession, expire time.Duration) error {
+	mm := make(map[string]any, len(m))
+	for k, v := range m {
+		data, err := json.Marshal(v)
+		if err != nil {
+			return errs.Wrap(err)
+		}
+		mm[k] = string(data)
+	}
+	key := cachekey.GetTokenSessionKey(userID, platformID)
+	pipe := c.rdb.TxPipeline()
+	pipe.HSet(ctx, key, mm)
+	pipe.Expire(ctx, key, expire)
+	_, err := pipe.Exec(ctx)
+	return errs.Wrap(err)
+}
+
+func (c *tokenCache) DeleteTokenSessions(ctx context.Context, userID string, platformID int, fields []string) error {
+	return errs.Wrap(c.rdb.HDel(ctx, cachekey.GetTokenSessionKey(userID, platformID), fields...).Err())
+}
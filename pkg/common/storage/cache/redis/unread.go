@@ -0,0 +1,250 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache/cachekey"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/utils/datautil"
+	"github.com/redis/go-redis/v9"
+)
+
+// maxUnreadSeqs is the number of latest counted messages kept per conversation to recount partially read conversations.
+const maxUnreadSeqs = 1000
+
+// unreadCountBatchSize is the number of users whose counts are updated in one pipeline.
+const unreadCountBatchSize = 1000
+
+// unreadCountInitField marks the counts of the user as built, users without it have their counts rebuilt from seqs.
+const unreadCountInitField = "_init"
+
+var decrUnreadCountScript = redis.NewScript(`
+local v = redis.call("HINCRBY", KEYS[1], ARGV[1], -tonumber(ARGV[2]))
+if v <= 0 then
+	redis.call("HDEL", KEYS[1], ARGV[1])
+	return 0
+end
+return v
+`)
+
+func NewUnreadCache(rdb redis.UniversalClient) cache.UnreadCache {
+	return &unreadCache{rdb: rdb}
+}
+
+type unreadCache struct {
+	rdb redis.UniversalClient
+}
+
+func unreadSeqMember(seq int64, sendID string) string {
+	return strconv.FormatInt(seq, 10) + ":" + sendID
+}
+
+func parseUnreadSeqMember(member string) (int64, string) {
+	seq, sendID, _ := strings.Cut(member, ":")
+	val, _ := strconv.ParseInt(seq, 10, 64)
+	return val, sendID
+}
+
+func (c *unreadCache) AddUnreadSeqs(ctx context.Context, conversationID string, seqs map[int64]string) error {
+	if len(seqs) == 0 {
+		return nil
+	}
+	key := cachekey.GetUnreadSeqKey(conversationID)
+	members := make([]redis.Z, 0, len(seqs))
+	for seq, sendID := range seqs {
+		members = append(members, redis.Z{Score: float64(seq), Member: unreadSeqMember(seq, sendID)})
+	}
+	pipe := c.rdb.Pipeline()
+	pipe.ZAdd(ctx, key, members...)
+	pipe.ZRemRangeByRank(ctx, key, 0, -(maxUnreadSeqs + 1))
+	_, err := pipe.Exec(ctx)
+	return errs.Wrap(err)
+}
+
+func (c *unreadCache) CountUnreadSeqs(ctx context.Context, conversationID string, userID string, seq int64) (int64, int64, error) {
+	key := cachekey.GetUnreadSeqKey(conversationID)
+	pipe := c.rdb.Pipeline()
+	after := pipe.ZRangeByScore(ctx, key, &redis.ZRangeBy{
+		Min: "(" + strconv.FormatInt(seq, 10),
+		Max: "+inf",
+	})
+	first := pipe.ZRangeWithScores(ctx, key, 0, 0)
+	deleted := pipe.ZRangeByScore(ctx, cachekey.GetUnreadDeletedSeqKey(conversationID, userID), &redis.ZRangeBy{
+		Min: "(" + strconv.FormatInt(seq, 10),
+		Max: "+inf",
+	})
+	if _, err := pipe.Exec(ctx); err != nil && err != redis.Nil {
+		return 0, 0, errs.Wrap(err)
+	}
+	deletedSeqs := datautil.SliceSet(deleted.Val())
+	var count, oldest int64
+	for _, member := range after.Val() {
+		seq, sendID := parseUnreadSeqMember(member)
+		if _, ok := deletedSeqs[strconv.FormatInt(seq, 10)]; ok || sendID == userID {
+			continue
+		}
+		count++
+	}
+	if zs := first.Val(); len(zs) > 0 {
+		oldest = int64(zs[0].Score)
+	}
+	return count, oldest, nil
+}
+
+func (c *unreadCache) AddDeletedUnreadSeqs(ctx context.Context, conversationID string, userID string, hasReadSeq int64, seqs []int64) error {
+	if len(seqs) == 0 {
+		return nil
+	}
+	key := cachekey.GetUnreadDeletedSeqKey(conversationID, userID)
+	members := make([]redis.Z, 0, len(seqs))
+	for _, seq := range seqs {
+		members = append(members, redis.Z{Score: float64(seq), Member: strconv.FormatInt(seq, 10)})
+	}
+	pipe := c.rdb.Pipeline()
+	pipe.ZAdd(ctx, key, members...)
+	pipe.ZRemRangeByScore(ctx, key, "-inf", strconv.FormatInt(hasReadSeq, 10))
+	pipe.ZRemRangeByRank(ctx, key, 0, -(maxUnreadSeqs + 1))
+	_, err := pipe.Exec(ctx)
+	return errs.Wrap(err)
+}
+
+func (c *unreadCache) GetUnreadSeqSenders(ctx context.Context, conversationID string, seqs []int64) (map[int64]string, error) {
+	key := cachekey.GetUnreadSeqKey(conversationID)
+	pipe := c.rdb.Pipeline()
+	cmds := make([]*redis.StringSliceCmd, 0, len(seqs))
+	for _, seq := range seqs {
+		s := strconv.FormatInt(seq, 10)
+		cmds = append(cmds, pipe.ZRangeByScore(ctx, key, &redis.ZRangeBy{Min: s, Max: s}))
+	}
+	if _, err := pipe.Exec(ctx); err != nil && err != redis.Nil {
+		return nil, errs.Wrap(err)
+	}
+	senders := make(map[int64]string, len(seqs))
+	for _, cmd := range cmds {
+		for _, member := range cmd.Val() {
+			seq, sendID := parseUnreadSeqMember(member)
+			senders[seq] = sendID
+		}
+	}
+	return senders, nil
+}
+
+func (c *unreadCache) UpdateUnreadCounts(ctx context.Context, conversationID string, incr map[string]int64, set map[string]int64) error {
+	pipe := c.rdb.Pipeline()
+	exec := func(force bool) error {
+		if pipe.Len() == 0 || (!force && pipe.Len() < unreadCountBatchSize) {
+			return nil
+		}
+		_, err := pipe.Exec(ctx)
+		return errs.Wrap(err)
+	}
+	for userID, count := range incr {
+		pipe.HIncrBy(ctx, cachekey.GetUnreadCountKey(userID), conversationID, count)
+		if err := exec(false); err != nil {
+			return err
+		}
+	}
+	for userID, count := range set {
+		if count > 0 {
+			pipe.HSet(ctx, cachekey.GetUnreadCountKey(userID), conversationID, count)
+		} else {
+			pipe.HDel(ctx, cachekey.GetUnreadCountKey(userID), conversationID)
+		}
+		if err := exec(false); err != nil {
+			return err
+		}
+	}
+	return exec(true)
+}
+
+func (c *unreadCache) DecrUnreadCount(ctx context.Context, userID string, conversationID string, count int64) error {
+	if count <= 0 {
+		return nil
+	}
+	return errs.Wrap(decrUnreadCountScript.Run(ctx, c.rdb, []string{cachekey.GetUnreadCountKey(userID)}, conversationID, count).Err())
+}
+
+func (c *unreadCache) GetUnreadCounts(ctx context.Context, userID string, conversationIDs []string) (map[string]int64, error) {
+	counts := make(map[string]int64, len(conversationIDs))
+	if len(conversationIDs) == 0 {
+		return counts, nil
+	}
+	values, err := c.rdb.HMGet(ctx, cachekey.GetUnreadCountKey(userID), conversationIDs...).Result()
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	for i, value := range values {
+		s, ok := value.(string)
+		if !ok {
+			continue
+		}
+		if count, err := strconv.ParseInt(s, 10, 64); err == nil && count > 0 {
+			counts[conversationIDs[i]] = count
+		}
+	}
+	return counts, nil
+}
+
+func (c *unreadCache) InitUnreadCounts(ctx context.Context, userID string, counts map[string]int64) error {
+	key := cachekey.GetUnreadCountKey(userID)
+	values := []any{unreadCountInitField, 1}
+	for conversationID, count := range counts {
+		if count > 0 {
+			values = append(values, conversationID, count)
+		}
+	}
+	pipe := c.rdb.TxPipeline()
+	pipe.Del(ctx, key)
+	pipe.HSet(ctx, key, values...)
+	_, err := pipe.Exec(ctx)
+	return errs.Wrap(err)
+}
+
+func (c *unreadCache) GetUnreadCountSums(ctx context.Context, userIDs []string) (map[string]int64, error) {
+	sums := make(map[string]int64, len(userIDs))
+	if len(userIDs) == 0 {
+		return sums, nil
+	}
+	pipe := c.rdb.Pipeline()
+	cmds := make(map[string]*redis.MapStringStringCmd, len(userIDs))
+	for _, userID := range userIDs {
+		cmds[userID] = pipe.HGetAll(ctx, cachekey.GetUnreadCountKey(userID))
+	}
+	if _, err := pipe.Exec(ctx); err != nil && err != redis.Nil {
+		return nil, errs.Wrap(err)
+	}
+	for userID, cmd := range cmds {
+		values := cmd.Val()
+		if _, ok := values[unreadCountInitField]; !ok {
+			continue
+		}
+		var sum int64
+		for field, value := range values {
+			if field == unreadCountInitField {
+				continue
+			}
+			if count, err := strconv.ParseInt(value, 10, 64); err == nil && count > 0 {
+				sum += count
+			}
+		}
+		sums[userID] = sum
+	}
+	return sums, nil
+}
@@ -2,11 +2,42 @@ package cache
 
 import (
 	"context"
+	"time"
 )
 
+// RefreshToken is the state kept for an opaque refresh token.
+type RefreshToken struct {
+	// FamilyID is shared by every refresh token rotated from the same login.
+	FamilyID string `json:"familyID"`
+	// AccessToken is the access token issued together with this refresh token.
+	AccessToken string `json:"accessToken"`
+	// Used is set once the refresh token has been rotated; presenting it again revokes the family.
+	Used       bool  `json:"used"`
+	ExpireTime int64 `json:"expireTime"`
+}
+
+// TokenSession describes the device a token was issued to.
+type TokenSession struct {
+	DeviceName string `json:"deviceName"`
+	IP         string `json:"ip"`
+	UserAgent  string `json:"userAgent"`
+	// ConnID is the id of the last long connection established with the token.
+	ConnID       string `json:"connID"`
+	CreateTime   int64  `json:"createTime"`
+	LastSeenTime int64  `json:"lastSeenTime"`
+}
+
 type TokenModel interface {
 	AddTokenFlag(ctx context.Context, userID string, platformID int, token string, flag int) error
 	GetTokensWithoutError(ctx context.Context, userID string, platformID int) (map[string]int, error)
 	SetTokenMapByUidPid(ctx context.Context, userID string, platformID int, m map[string]int) error
 	DeleteTokenByUidPid(ctx context.Context, userID string, platformID int, fields []string) error
+	GetRefreshTokens(ctx context.Context, userID string, platformID int) (map[string]*RefreshToken, error)
+	SetRefreshTokens(ctx context.Context, userID string, platformID int, m map[string]*RefreshToken, expire time.Duration) error
+	DeleteRefreshTokens(ctx context.Context, userID string, platformID int, fields []string) error
+	// RotateRefreshToken sets the entries in m only if refreshToken still holds old, it reports whether they were set.
+	RotateRefreshToken(ctx context.Context, userID string, platformID int, refreshToken string, old *RefreshToken, m map[string]*RefreshToken, expire time.Duration) (bool, error)
+	GetTokenSessions(ctx context.Context, userID string, platformID int) (map[string]*TokenSession, error)
+	SetTokenSessions(ctx context.Context, userID string, platformID int, m map[string]*TokenSession, expire time.Duration) error
+	DeleteTokenSessions(ctx context.Context, userID string, platformID int, fields []string) error
 }
@@ -0,0 +1,43 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+)
+
+type UnreadCache interface {
+	// k: seq, v: sender of a message counted as unread
+	AddUnreadSeqs(ctx context.Context, conversationID string, seqs map[int64]string) error
+	// CountUnreadSeqs counts the recorded messages after seq that were neither sent nor deleted by the user,
+	// oldest is the oldest recorded seq of the conversation, 0 when none is recorded.
+	CountUnreadSeqs(ctx context.Context, conversationID string, userID string, seq int64) (count int64, oldest int64, err error)
+	// AddDeletedUnreadSeqs records the unread messages the user deleted, so that recounts leave them out.
+	// Seqs up to hasReadSeq are read and no longer recorded.
+	AddDeletedUnreadSeqs(ctx context.Context, conversationID string, userID string, hasReadSeq int64, seqs []int64) error
+	// k: seq, v: sender, seqs not counted as unread are left out
+	GetUnreadSeqSenders(ctx context.Context, conversationID string, seqs []int64) (map[int64]string, error)
+	// UpdateUnreadCounts adds incr to and replaces with set the counts of the conversation, pipelined in batches.
+	// k: user, v: count, zero counts are removed
+	UpdateUnreadCounts(ctx context.Context, conversationID string, incr map[string]int64, set map[string]int64) error
+	// DecrUnreadCount subtracts from the count without going below zero.
+	DecrUnreadCount(ctx context.Context, userID string, conversationID string, count int64) error
+	// k: conversation, v: count
+	GetUnreadCounts(ctx context.Context, userID string, conversationIDs []string) (map[string]int64, error)
+	// InitUnreadCounts replaces all counts of the user and marks them as built.
+	InitUnreadCounts(ctx context.Context, userID string, counts map[string]int64) error
+	// k: user, v: sum of the counts, users whose counts were never built are left out
+	GetUnreadCountSums(ctx context.Context, userIDs []string) (map[string]int64, error)
+}
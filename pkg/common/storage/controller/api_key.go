@@ -0,0 +1,100 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/db/pagination"
+)
+
+type APIKeyDatabase interface {
+	// CreateAPIKey stores a new API key and audits its creation.
+	CreateAPIKey(ctx context.Context, key *model.APIKey) error
+	TakeAPIKey(ctx context.Context, keyID string) (*model.APIKey, error)
+	// RevokeAPIKey disables an API key and audits the revocation.
+	RevokeAPIKey(ctx context.Context, keyID string, operatorUserID string) error
+	PageAPIKeys(ctx context.Context, pagination pagination.Pagination) (int64, []*model.APIKey, error)
+	IncrAPIKeyUsage(ctx context.Context, keyID string, scope string, ip string) error
+	GetAPIKeyUsage(ctx context.Context, keyID string) (*cache.APIKeyUsage, error)
+	AddAPIKeyAudit(ctx context.Context, audit *model.APIKeyAudit) error
+	PageAPIKeyAudits(ctx context.Context, keyID string, pagination pagination.Pagination) (int64, []*model.APIKeyAudit, error)
+}
+
+func NewAPIKeyDatabase(apiKey database.APIKey, audit database.APIKeyAudit, cache cache.APIKeyCache) APIKeyDatabase {
+	return &apiKeyDatabase{apiKey: apiKey, audit: audit, cache: cache}
+}
+
+type apiKeyDatabase struct {
+	apiKey database.APIKey
+	audit  database.APIKeyAudit
+	cache  cache.APIKeyCache
+}
+
+func (a *apiKeyDatabase) CreateAPIKey(ctx context.Context, key *model.APIKey) error {
+	if err := a.apiKey.Create(ctx, key); err != nil {
+		return err
+	}
+	return a.AddAPIKeyAudit(ctx, &model.APIKeyAudit{
+		KeyID:          key.KeyID,
+		Action:         model.APIKeyActionCreate,
+		OperatorUserID: key.CreatorUserID,
+	})
+}
+
+func (a *apiKeyDatabase) TakeAPIKey(ctx context.Context, keyID string) (*model.APIKey, error) {
+	return a.cache.GetAPIKey(ctx, keyID)
+}
+
+func (a *apiKeyDatabase) RevokeAPIKey(ctx context.Context, keyID string, operatorUserID string) error {
+	if err := a.apiKey.UpdateStatus(ctx, keyID, model.APIKeyStatusRevoked); err != nil {
+		return err
+	}
+	if err := a.cache.DelAPIKey(keyID).ChainExecDel(ctx); err != nil {
+		return err
+	}
+	return a.AddAPIKeyAudit(ctx, &model.APIKeyAudit{
+		KeyID:          keyID,
+		Action:         model.APIKeyActionRevoke,
+		OperatorUserID: operatorUserID,
+	})
+}
+
+func (a *apiKeyDatabase) PageAPIKeys(ctx context.Context, pagination pagination.Pagination) (int64, []*model.APIKey, error) {
+	return a.apiKey.Page(ctx, pagination)
+}
+
+func (a *apiKeyDatabase) IncrAPIKeyUsage(ctx context.Context, keyID string, scope string, ip string) error {
+	return a.cache.IncrAPIKeyUsage(ctx, keyID, scope, ip)
+}
+
+func (a *apiKeyDatabase) GetAPIKeyUsage(ctx context.Context, keyID string) (*cache.APIKeyUsage, error) {
+	return a.cache.GetAPIKeyUsage(ctx, keyID)
+}
+
+func (a *apiKeyDatabase) AddAPIKeyAudit(ctx context.Context, audit *model.APIKeyAudit) error {
+	if audit.CreateTime.IsZero() {
+		audit.CreateTime = time.Now()
+	}
+	return a.audit.Create(ctx, []*model.APIKeyAudit{audit})
+}
+
+func (a *apiKeyDatabase) PageAPIKeyAudits(ctx context.Context, keyID string, pagination pagination.Pagination) (int64, []*model.APIKeyAudit, error) {
+	return a.audit.Page(ctx, keyID, pagination)
+}
@@ -16,13 +16,19 @@ package controller
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"sort"
+	"time"
 
 	"github.com/golang-jwt/jwt/v4"
 	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/servererrs"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
 	"github.com/openimsdk/protocol/constant"
 	"github.com/openimsdk/tools/errs"
 	"github.com/openimsdk/tools/tokenverify"
+	"github.com/openimsdk/tools/utils/datautil"
 )
 
 type AuthDatabase interface {
@@ -32,16 +38,38 @@ type AuthDatabase interface {
 	CreateToken(ctx context.Context, userID string, platformID int) (string, error)
 
 	SetTokenMapByUidPid(ctx context.Context, userID string, platformID int, m map[string]int) error
+	// CreateRefreshToken creates a refresh token that starts a new token family for accessToken.
+	CreateRefreshToken(ctx context.Context, userID string, platformID int, accessToken string) (string, error)
+	// RefreshToken rotates refreshToken and returns a new access token and refresh token.
+	// Presenting an already rotated refresh token revokes its whole family.
+	RefreshToken(ctx context.Context, userID string, platformID int, refreshToken string) (string, string, error)
+	// AddTokenSession records the device a token was issued to.
+	AddTokenSession(ctx context.Context, userID string, platformID int, token string, session *cache.TokenSession) error
+	// TouchTokenSession updates the last seen time and connection of a token session.
+	TouchTokenSession(ctx context.Context, userID string, platformID int, token string, connID string, ip string, userAgent string) error
+	// GetTokenSessions returns the sessions of all valid tokens of the user.
+	GetTokenSessions(ctx context.Context, userID string) ([]*UserTokenSession, error)
+	// RevokeTokenSession kicks a single token, together with the refresh tokens issued with it.
+	RevokeTokenSession(ctx context.Context, userID string, platformID int, token string) error
+	// RevokeUserTokens kicks every token of the user on all platforms and deletes their sessions and refresh tokens.
+	RevokeUserTokens(ctx context.Context, userID string) (int, error)
+}
+
+type UserTokenSession struct {
+	Token      string
+	PlatformID int
+	*cache.TokenSession
 }
 
 type authDatabase struct {
-	cache        cache.TokenModel
-	accessSecret string
-	accessExpire int64
+	cache         cache.TokenModel
+	tokenKeys     *authverify.TokenKeys
+	accessExpire  time.Duration
+	refreshExpire time.Duration
 }
 
-func NewAuthDatabase(cache cache.TokenModel, accessSecret string, accessExpire int64) AuthDatabase {
-	return &authDatabase{cache: cache, accessSecret: accessSecret, accessExpire: accessExpire}
+func NewAuthDatabase(cache cache.TokenModel, tokenKeys *authverify.TokenKeys, accessExpire time.Duration, refreshExpire time.Duration) AuthDatabase {
+	return &authDatabase{cache: cache, tokenKeys: tokenKeys, accessExpire: accessExpire, refreshExpire: refreshExpire}
 }
 
 // If the result is empty.
@@ -61,7 +89,7 @@ func (a *authDatabase) CreateToken(ctx context.Context, userID string, platformI
 	}
 	var deleteTokenKey []string
 	for k, v := range tokens {
-		_, err = tokenverify.GetClaimFromToken(k, authverify.Secret(a.accessSecret))
+		_, err = tokenverify.GetClaimFromToken(k, a.tokenKeys.Keyfunc)
 		if err != nil || v != constant.NormalToken {
 			deleteTokenKey = append(deleteTokenKey, k)
 		}
@@ -71,13 +99,316 @@ func (a *authDatabase) CreateToken(ctx context.Context, userID string, platformI
 		if err != nil {
 			return "", err
 		}
+		if err := a.cache.DeleteTokenSessions(ctx, userID, platformID, deleteTokenKey); err != nil {
+			return "", err
+		}
 	}
 
-	claims := tokenverify.BuildClaims(userID, platformID, a.accessExpire)
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	tokenString, err := token.SignedString([]byte(a.accessSecret))
+	claims := tokenverify.BuildClaims(userID, platformID, 0)
+	claims.ExpiresAt = jwt.NewNumericDate(claims.IssuedAt.Add(a.accessExpire))
+	tokenString, err := a.tokenKeys.Sign(claims)
 	if err != nil {
-		return "", errs.WrapMsg(err, "token.SignedString")
+		return "", err
 	}
 	return tokenString, a.cache.AddTokenFlag(ctx, userID, platformID, tokenString, constant.NormalToken)
 }
+
+func (a *authDatabase) CreateRefreshToken(ctx context.Context, userID string, platformID int, accessToken string) (string, error) {
+	tokens, err := a.cache.GetRefreshTokens(ctx, userID, platformID)
+	if err != nil {
+		return "", err
+	}
+	if err := a.deleteExpiredRefreshTokens(ctx, userID, platformID, tokens); err != nil {
+		return "", err
+	}
+	familyID, err := genOpaqueToken(16)
+	if err != nil {
+		return "", err
+	}
+	refreshToken, token, err := a.newRefreshToken(familyID, accessToken)
+	if err != nil {
+		return "", err
+	}
+	if err := a.cache.SetRefreshTokens(ctx, userID, platformID, map[string]*cache.RefreshToken{refreshToken: token}, a.refreshExpire); err != nil {
+		return "", err
+	}
+	return refreshToken, nil
+}
+
+func (a *authDatabase) RefreshToken(ctx context.Context, userID string, platformID int, refreshToken string) (string, string, error) {
+	tokens, err := a.cache.GetRefreshTokens(ctx, userID, platformID)
+	if err != nil {
+		return "", "", err
+	}
+	current, ok := tokens[refreshToken]
+	if !ok {
+		return "", "", servererrs.ErrTokenNotExist.WrapMsg("refresh token not exist")
+	}
+	if current.Used {
+		if err := a.revokeRefreshTokenFamily(ctx, userID, platformID, tokens, current.FamilyID); err != nil {
+			return "", "", err
+		}
+		return "", "", servererrs.ErrTokenKicked.WrapMsg("refresh token reused, token family revoked")
+	}
+	if current.ExpireTime <= time.Now().UnixMilli() {
+		if err := a.cache.DeleteRefreshTokens(ctx, userID, platformID, []string{refreshToken}); err != nil {
+			return "", "", err
+		}
+		return "", "", servererrs.ErrTokenExpired.WrapMsg("refresh token expired")
+	}
+	if err := a.deleteExpiredRefreshTokens(ctx, userID, platformID, tokens); err != nil {
+		return "", "", err
+	}
+	accessToken, err := a.CreateToken(ctx, userID, platformID)
+	if err != nil {
+		return "", "", err
+	}
+	newRefreshToken, next, err := a.newRefreshToken(current.FamilyID, accessToken)
+	if err != nil {
+		return "", "", err
+	}
+	used := *current
+	used.Used = true
+	m := map[string]*cache.RefreshToken{refreshToken: &used, newRefreshToken: next}
+	ok, err = a.cache.RotateRefreshToken(ctx, userID, platformID, refreshToken, current, m, a.refreshExpire)
+	if err != nil {
+		return "", "", err
+	}
+	if !ok {
+		// Another request rotated the refresh token first, which is handled like a reuse.
+		if err := a.cache.SetTokenMapByUidPid(ctx, userID, platformID, map[string]int{accessToken: constant.KickedToken}); err != nil {
+			return "", "", err
+		}
+		tokens, err := a.cache.GetRefreshTokens(ctx, userID, platformID)
+		if err != nil {
+			return "", "", err
+		}
+		if err := a.revokeRefreshTokenFamily(ctx, userID, platformID, tokens, current.FamilyID); err != nil {
+			return "", "", err
+		}
+		return "", "", servererrs.ErrTokenKicked.WrapMsg("refresh token reused, token family revoked")
+	}
+	if err := a.moveTokenSession(ctx, userID, platformID, current.AccessToken, accessToken); err != nil {
+		return "", "", err
+	}
+	return accessToken, newRefreshToken, nil
+}
+
+// newRefreshToken generates a refresh token of familyID issued together with accessToken.
+func (a *authDatabase) newRefreshToken(familyID string, accessToken string) (string, *cache.RefreshToken, error) {
+	refreshToken, err := genOpaqueToken(32)
+	if err != nil {
+		return "", nil, err
+	}
+	return refreshToken, &cache.RefreshToken{
+		FamilyID:    familyID,
+		AccessToken: accessToken,
+		ExpireTime:  time.Now().Add(a.refreshExpire).UnixMilli(),
+	}, nil
+}
+
+// revokeRefreshTokenFamily deletes every refresh token of familyID and kicks the access tokens issued with them.
+func (a *authDatabase) revokeRefreshTokenFamily(ctx context.Context, userID string, platformID int, tokens map[string]*cache.RefreshToken, familyID string) error {
+	var (
+		refreshTokens []string
+		accessTokens  = make(map[string]struct{})
+	)
+	for k, v := range tokens {
+		if v.FamilyID != familyID {
+			continue
+		}
+		refreshTokens = append(refreshTokens, k)
+		accessTokens[v.AccessToken] = struct{}{}
+	}
+	if err := a.cache.DeleteRefreshTokens(ctx, userID, platformID, refreshTokens); err != nil {
+		return err
+	}
+	m, err := a.cache.GetTokensWithoutError(ctx, userID, platformID)
+	if err != nil {
+		return err
+	}
+	kicked := make(map[string]int)
+	for k := range m {
+		if _, ok := accessTokens[k]; ok {
+			kicked[k] = constant.KickedToken
+		}
+	}
+	if len(kicked) == 0 {
+		return nil
+	}
+	return a.cache.SetTokenMapByUidPid(ctx, userID, platformID, kicked)
+}
+
+func (a *authDatabase) deleteExpiredRefreshTokens(ctx context.Context, userID string, platformID int, tokens map[string]*cache.RefreshToken) error {
+	now := time.Now().UnixMilli()
+	var expired []string
+	for k, v := range tokens {
+		if v.ExpireTime <= now {
+			expired = append(expired, k)
+		}
+	}
+	if len(expired) == 0 {
+		return nil
+	}
+	return a.cache.DeleteRefreshTokens(ctx, userID, platformID, expired)
+}
+
+func (a *authDatabase) AddTokenSession(ctx context.Context, userID string, platformID int, token string, session *cache.TokenSession) error {
+	now := time.Now().UnixMilli()
+	if session.CreateTime == 0 {
+		session.CreateTime = now
+	}
+	if session.LastSeenTime == 0 {
+		session.LastSeenTime = now
+	}
+	return a.cache.SetTokenSessions(ctx, userID, platformID, map[string]*cache.TokenSession{token: session}, a.accessExpire)
+}
+
+func (a *authDatabase) TouchTokenSession(ctx context.Context, userID string, platformID int, token string, connID string, ip string, userAgent string) error {
+	tokens, err := a.cache.GetTokensWithoutError(ctx, userID, platformID)
+	if err != nil {
+		return err
+	}
+	if status, ok := tokens[token]; !ok || status != constant.NormalToken {
+		// the token was kicked or replaced meanwhile, its session must not come back
+		return nil
+	}
+	sessions, err := a.cache.GetTokenSessions(ctx, userID, platformID)
+	if err != nil {
+		return err
+	}
+	session, ok := sessions[token]
+	if !ok {
+		session = &cache.TokenSession{CreateTime: time.Now().UnixMilli()}
+	}
+	session.ConnID = connID
+	session.LastSeenTime = time.Now().UnixMilli()
+	if ip != "" {
+		session.IP = ip
+	}
+	if userAgent != "" {
+		session.UserAgent = userAgent
+	}
+	return a.cache.SetTokenSessions(ctx, userID, platformID, map[string]*cache.TokenSession{token: session}, a.accessExpire)
+}
+
+// moveTokenSession keeps the session of a device when its access token is replaced by a refreshed one.
+func (a *authDatabase) moveTokenSession(ctx context.Context, userID string, platformID int, oldToken string, newToken string) error {
+	sessions, err := a.cache.GetTokenSessions(ctx, userID, platformID)
+	if err != nil {
+		return err
+	}
+	session, ok := sessions[oldToken]
+	if !ok {
+		return nil
+	}
+	session.LastSeenTime = time.Now().UnixMilli()
+	if err := a.cache.SetTokenSessions(ctx, userID, platformID, map[string]*cache.TokenSession{newToken: session}, a.accessExpire); err != nil {
+		return err
+	}
+	return a.cache.DeleteTokenSessions(ctx, userID, platformID, []string{oldToken})
+}
+
+func (a *authDatabase) GetTokenSessions(ctx context.Context, userID string) ([]*UserTokenSession, error) {
+	var res []*UserTokenSession
+	for platformID := range constant.PlatformID2Name {
+		tokens, err := a.cache.GetTokensWithoutError(ctx, userID, platformID)
+		if err != nil {
+			return nil, err
+		}
+		if len(tokens) == 0 {
+			continue
+		}
+		sessions, err := a.cache.GetTokenSessions(ctx, userID, platformID)
+		if err != nil {
+			return nil, err
+		}
+		for token, status := range tokens {
+			if status != constant.NormalToken {
+				continue
+			}
+			claims, err := tokenverify.GetClaimFromToken(token, a.tokenKeys.Keyfunc)
+			if err != nil {
+				continue
+			}
+			session, ok := sessions[token]
+			if !ok {
+				// tokens issued without session metadata, e.g. by GetUserToken
+				session = &cache.TokenSession{CreateTime: claims.IssuedAt.UnixMilli()}
+			}
+			res = append(res, &UserTokenSession{Token: token, PlatformID: platformID, TokenSession: session})
+		}
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].LastSeenTime > res[j].LastSeenTime
+	})
+	return res, nil
+}
+
+func (a *authDatabase) RevokeTokenSession(ctx context.Context, userID string, platformID int, token string) error {
+	if err := a.cache.SetTokenMapByUidPid(ctx, userID, platformID, map[string]int{token: constant.KickedToken}); err != nil {
+		return err
+	}
+	if err := a.cache.DeleteTokenSessions(ctx, userID, platformID, []string{token}); err != nil {
+		return err
+	}
+	refreshTokens, err := a.cache.GetRefreshTokens(ctx, userID, platformID)
+	if err != nil {
+		return err
+	}
+	for _, v := range refreshTokens {
+		if v.AccessToken == token {
+			return a.revokeRefreshTokenFamily(ctx, userID, platformID, refreshTokens, v.FamilyID)
+		}
+	}
+	return nil
+}
+
+func (a *authDatabase) RevokeUserTokens(ctx context.Context, userID string) (int, error) {
+	var count int
+	for platformID := range constant.PlatformID2Name {
+		tokens, err := a.cache.GetTokensWithoutError(ctx, userID, platformID)
+		if err != nil {
+			return 0, err
+		}
+		kicked := make(map[string]int)
+		for token, status := range tokens {
+			if status != constant.KickedToken {
+				kicked[token] = constant.KickedToken
+			}
+		}
+		if len(kicked) > 0 {
+			if err := a.cache.SetTokenMapByUidPid(ctx, userID, platformID, kicked); err != nil {
+				return 0, err
+			}
+			count += len(kicked)
+		}
+		sessions, err := a.cache.GetTokenSessions(ctx, userID, platformID)
+		if err != nil {
+			return 0, err
+		}
+		if len(sessions) > 0 {
+			if err := a.cache.DeleteTokenSessions(ctx, userID, platformID, datautil.Keys(sessions)); err != nil {
+				return 0, err
+			}
+		}
+		refreshTokens, err := a.cache.GetRefreshTokens(ctx, userID, platformID)
+		if err != nil {
+			return 0, err
+		}
+		if len(refreshTokens) > 0 {
+			if err := a.cache.DeleteRefreshTokens(ctx, userID, platformID, datautil.Keys(refreshTokens)); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return count, nil
+}
+
+func genOpaqueToken(size int) (string, error) {
+	b := make([]byte, size)
+	if _, err := rand.Read(b); err != nil {
+		return "", errs.WrapMsg(err, "rand.Read")
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
@@ -0,0 +1,290 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/servererrs"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
+	"github.com/openimsdk/protocol/constant"
+)
+
+// tokenCacheStub keeps the refresh tokens encoded like the redis cache does, guarded by one lock as a redis script would be.
+type tokenCacheStub struct {
+	mu       sync.Mutex
+	tokens   map[string]int
+	refresh  map[string]string
+	sessions map[string]*cache.TokenSession
+	// sessionExpire is the ttl of the last SetTokenSessions call.
+	sessionExpire time.Duration
+}
+
+func newTokenCacheStub() *tokenCacheStub {
+	return &tokenCacheStub{
+		tokens:   make(map[string]int),
+		refresh:  make(map[string]string),
+		sessions: make(map[string]*cache.TokenSession),
+	}
+}
+
+func (t *tokenCacheStub) AddTokenFlag(ctx context.Context, userID string, platformID int, token string, flag int) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.tokens[token] = flag
+	return nil
+}
+
+func (t *tokenCacheStub) GetTokensWithoutError(ctx context.Context, userID string, platformID int) (map[string]int, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	m := make(map[string]int, len(t.tokens))
+	for k, v := range t.tokens {
+		m[k] = v
+	}
+	return m, nil
+}
+
+func (t *tokenCacheStub) SetTokenMapByUidPid(ctx context.Context, userID string, platformID int, m map[string]int) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for k, v := range m {
+		t.tokens[k] = v
+	}
+	return nil
+}
+
+func (t *tokenCacheStub) DeleteTokenByUidPid(ctx context.Context, userID string, platformID int, fields []string) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for _, field := range fields {
+		delete(t.tokens, field)
+	}
+	return nil
+}
+
+func (t *tokenCacheStub) GetRefreshTokens(ctx context.Context, userID string, platformID int) (map[string]*cache.RefreshToken, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	m := make(map[string]*cache.RefreshToken, len(t.refresh))
+	for k, v := range t.refresh {
+		var token cache.RefreshToken
+		if err := json.Unmarshal([]byte(v), &token); err != nil {
+			return nil, err
+		}
+		m[k] = &token
+	}
+	return m, nil
+}
+
+func (t *tokenCacheStub) SetRefreshTokens(ctx context.Context, userID string, platformID int, m map[string]*cache.RefreshToken, expire time.Duration) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for k, v := range m {
+		data, err := json.Marshal(v)
+		if err != nil {
+			return err
+		}
+		t.refresh[k] = string(data)
+	}
+	return nil
+}
+
+func (t *tokenCacheStub) DeleteRefreshTokens(ctx context.Context, userID string, platformID int, fields []string) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for _, field := range fields {
+		delete(t.refresh, field)
+	}
+	return nil
+}
+
+func (t *tokenCacheStub) RotateRefreshToken(ctx context.Context, userID string, platformID int, refreshToken string, old *cache.RefreshToken, m map[string]*cache.RefreshToken, expire time.Duration) (bool, error) {
+	oldData, err := json.Marshal(old)
+	if err != nil {
+		return false, err
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.refresh[refreshToken] != string(oldData) {
+		return false, nil
+	}
+	for k, v := range m {
+		data, err := json.Marshal(v)
+		if err != nil {
+			return false, err
+		}
+		t.refresh[k] = string(data)
+	}
+	return true, nil
+}
+
+func (t *tokenCacheStub) GetTokenSessions(ctx context.Context, userID string, platformID int) (map[string]*cache.TokenSession, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	m := make(map[string]*cache.TokenSession, len(t.sessions))
+	for k, v := range t.sessions {
+		m[k] = v
+	}
+	return m, nil
+}
+
+func (t *tokenCacheStub) SetTokenSessions(ctx context.Context, userID string, platformID int, m map[string]*cache.TokenSession, expire time.Duration) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.sessionExpire = expire
+	for k, v := range m {
+		t.sessions[k] = v
+	}
+	return nil
+}
+
+func (t *tokenCacheStub) DeleteTokenSessions(ctx context.Context, userID string, platformID int, fields []string) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for _, field := range fields {
+		delete(t.sessions, field)
+	}
+	return nil
+}
+
+func newTestAuthDatabase(t *testing.T, stub *tokenCacheStub) AuthDatabase {
+	keys, err := authverify.NewTokenKeys(&config.TokenSigning{}, "secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return NewAuthDatabase(stub, keys, time.Hour, 24*time.Hour)
+}
+
+func TestRefreshTokenReuseRevokesFamily(t *testing.T) {
+	ctx := context.Background()
+	stub := newTokenCacheStub()
+	db := newTestAuthDatabase(t, stub)
+	accessToken, err := db.CreateToken(ctx, "u1", constant.IOSPlatformID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	refreshToken, err := db.CreateRefreshToken(ctx, "u1", constant.IOSPlatformID, accessToken)
+	if err != nil {
+		t.Fatal(err)
+	}
+	newAccessToken, newRefreshToken, err := db.RefreshToken(ctx, "u1", constant.IOSPlatformID, refreshToken)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := db.RefreshToken(ctx, "u1", constant.IOSPlatformID, refreshToken); !servererrs.ErrTokenKicked.Is(err) {
+		t.Fatalf("reused refresh token: got %v, want token kicked", err)
+	}
+	if _, _, err := db.RefreshToken(ctx, "u1", constant.IOSPlatformID, newRefreshToken); !servererrs.ErrTokenNotExist.Is(err) {
+		t.Fatalf("refresh token of a revoked family: got %v, want token not exist", err)
+	}
+	if stub.tokens[newAccessToken] != constant.KickedToken {
+		t.Fatal("access token of a revoked family not kicked")
+	}
+}
+
+func TestRefreshTokenConcurrentRotation(t *testing.T) {
+	ctx := context.Background()
+	stub := newTokenCacheStub()
+	db := newTestAuthDatabase(t, stub)
+	accessToken, err := db.CreateToken(ctx, "u1", constant.IOSPlatformID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	refreshToken, err := db.CreateRefreshToken(ctx, "u1", constant.IOSPlatformID, accessToken)
+	if err != nil {
+		t.Fatal(err)
+	}
+	const n = 16
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		issued  []string
+		kicked  int
+		unknown []error
+	)
+	start := make(chan struct{})
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			<-start
+			newAccessToken, _, err := db.RefreshToken(ctx, "u1", constant.IOSPlatformID, refreshToken)
+			mu.Lock()
+			defer mu.Unlock()
+			switch {
+			case err == nil:
+				issued = append(issued, newAccessToken)
+			case servererrs.ErrTokenKicked.Is(err), servererrs.ErrTokenNotExist.Is(err):
+				kicked++
+			default:
+				unknown = append(unknown, err)
+			}
+		}()
+	}
+	close(start)
+	wg.Wait()
+	if len(unknown) > 0 {
+		t.Fatal(unknown)
+	}
+	if len(issued) != 1 || kicked != n-1 {
+		t.Fatalf("one refresh token rotated into %d access tokens, %d requests rejected", len(issued), kicked)
+	}
+	for token, flag := range stub.tokens {
+		if token != accessToken && token != issued[0] && flag == constant.NormalToken {
+			t.Fatal("a losing request left a valid access token")
+		}
+	}
+}
+
+func TestTouchTokenSession(t *testing.T) {
+	ctx := context.Background()
+	stub := newTokenCacheStub()
+	db := newTestAuthDatabase(t, stub)
+	token, err := db.CreateToken(ctx, "u1", constant.IOSPlatformID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.AddTokenSession(ctx, "u1", constant.IOSPlatformID, token, &cache.TokenSession{DeviceName: "phone", LastSeenTime: 1}); err != nil {
+		t.Fatal(err)
+	}
+	if stub.sessionExpire != time.Hour {
+		t.Fatalf("session ttl %s, want the access token lifetime", stub.sessionExpire)
+	}
+	if err := db.TouchTokenSession(ctx, "u1", constant.IOSPlatformID, token, "conn-1", "198.51.100.7", "sdk"); err != nil {
+		t.Fatal(err)
+	}
+	session := stub.sessions[token]
+	if session.LastSeenTime <= 1 || session.ConnID != "conn-1" || session.DeviceName != "phone" {
+		t.Fatalf("unexpected session %+v", session)
+	}
+
+	if err := db.RevokeTokenSession(ctx, "u1", constant.IOSPlatformID, token); err != nil {
+		t.Fatal(err)
+	}
+	// a heartbeat of a connection that is still closing must not bring the revoked session back
+	if err := db.TouchTokenSession(ctx, "u1", constant.IOSPlatformID, token, "conn-1", "198.51.100.7", "sdk"); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := stub.sessions[token]; ok {
+		t.Fatal("session of a revoked token recreated")
+	}
+}
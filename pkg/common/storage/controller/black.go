@@ -32,8 +32,14 @@ type BlackDatabase interface {
 	// FindOwnerBlacks get BlackList list
 	FindOwnerBlacks(ctx context.Context, ownerUserID string, pagination pagination.Pagination) (total int64, blacks []*model.Black, err error)
 	FindBlackInfos(ctx context.Context, ownerUserID string, userIDs []string) (blacks []*model.Black, err error)
+	// FindBlackIDs get the userIDs in the BlackList of the owner
+	FindBlackIDs(ctx context.Context, ownerUserID string) (blackIDs []string, err error)
+	// FindReversalBlackOwnerIDs get which of the owners have the user in their BlackList
+	FindReversalBlackOwnerIDs(ctx context.Context, blockUserID string, ownerUserIDs []string) (ownerIDs []string, err error)
 	// CheckIn Check whether user2 is in the black list of user1 (inUser1Blacks==true) Check whether user1 is in the black list of user2 (inUser2Blacks==true)
 	CheckIn(ctx context.Context, userID1, userID2 string) (inUser1Blacks bool, inUser2Blacks bool, err error)
+	// DeleteUserBlacks deletes the BlackList of the user and removes the user from every BlackList
+	DeleteUserBlacks(ctx context.Context, userID string) (err error)
 }
 
 type blackDatabase struct {
@@ -98,3 +104,25 @@ func (b *blackDatabase) FindBlackIDs(ctx context.Context, ownerUserID string) (b
 func (b *blackDatabase) FindBlackInfos(ctx context.Context, ownerUserID string, userIDs []string) (blacks []*model.Black, err error) {
 	return b.black.FindOwnerBlackInfos(ctx, ownerUserID, userIDs)
 }
+
+func (b *blackDatabase) FindReversalBlackOwnerIDs(ctx context.Context, blockUserID string, ownerUserIDs []string) (ownerIDs []string, err error) {
+	if len(ownerUserIDs) == 0 {
+		return nil, nil
+	}
+	return b.black.FindReversalBlackOwnerIDs(ctx, blockUserID, ownerUserIDs)
+}
+
+func (b *blackDatabase) DeleteUserBlacks(ctx context.Context, userID string) (err error) {
+	ownerUserIDs, err := b.black.FindOwnerUserIDs(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if err := b.black.DeleteUser(ctx, userID); err != nil {
+		return err
+	}
+	cache := b.cache.CloneBlackCache().DelBlackIDs(ctx, userID)
+	for _, ownerUserID := range ownerUserIDs {
+		cache = cache.DelBlackIDs(ctx, ownerUserID)
+	}
+	return cache.ChainExecDel(ctx)
+}
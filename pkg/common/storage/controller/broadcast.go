@@ -0,0 +1,109 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/db/pagination"
+)
+
+type BroadcastDatabase interface {
+	// CreateBroadcast stores job, together with the recipients of a job listing its users.
+	CreateBroadcast(ctx context.Context, job *model.BroadcastJob, recvIDs []string) error
+	TakeBroadcast(ctx context.Context, jobID string) (*model.BroadcastJob, error)
+	PageBroadcasts(ctx context.Context, pagination pagination.Pagination) (int64, []*model.BroadcastJob, error)
+	// AcquireBroadcast leases the next job to run for lease, it returns a not found error when there is none.
+	AcquireBroadcast(ctx context.Context, owner string, lease time.Duration) (*model.BroadcastJob, error)
+	// SaveBroadcastProgress records a processed batch and renews the lease of owner.
+	SaveBroadcastProgress(ctx context.Context, job *model.BroadcastJob, owner string, sent int64, failed []*model.BroadcastFailed, lease time.Duration) error
+	// RenewBroadcastLease extends the lease of owner, it returns a not found error once the job was canceled or taken over.
+	RenewBroadcastLease(ctx context.Context, jobID string, owner string, lease time.Duration) error
+	FinishBroadcast(ctx context.Context, jobID string, owner string) error
+	FailBroadcast(ctx context.Context, jobID string, owner string, errMsg string) error
+	CancelBroadcast(ctx context.Context, jobID string) error
+	FindBroadcastRecipients(ctx context.Context, jobID string, cursor int64, limit int) ([]string, error)
+	PageBroadcastFailed(ctx context.Context, jobID string, pagination pagination.Pagination) (int64, []*model.BroadcastFailed, error)
+}
+
+func NewBroadcastDatabase(broadcast database.Broadcast) BroadcastDatabase {
+	return &broadcastDatabase{broadcast: broadcast}
+}
+
+type broadcastDatabase struct {
+	broadcast database.Broadcast
+}
+
+func (b *broadcastDatabase) CreateBroadcast(ctx context.Context, job *model.BroadcastJob, recvIDs []string) error {
+	// the recipients are stored first, so a worker never picks up a job with a partial list
+	if len(recvIDs) > 0 {
+		recipients := make([]*model.BroadcastRecipient, 0, len(recvIDs))
+		for i, recvID := range recvIDs {
+			recipients = append(recipients, &model.BroadcastRecipient{JobID: job.JobID, Index: int64(i), UserID: recvID})
+		}
+		if err := b.broadcast.AddRecipients(ctx, recipients); err != nil {
+			return err
+		}
+	}
+	return b.broadcast.Create(ctx, job)
+}
+
+func (b *broadcastDatabase) TakeBroadcast(ctx context.Context, jobID string) (*model.BroadcastJob, error) {
+	return b.broadcast.Take(ctx, jobID)
+}
+
+func (b *broadcastDatabase) PageBroadcasts(ctx context.Context, pagination pagination.Pagination) (int64, []*model.BroadcastJob, error) {
+	return b.broadcast.Page(ctx, pagination)
+}
+
+func (b *broadcastDatabase) AcquireBroadcast(ctx context.Context, owner string, lease time.Duration) (*model.BroadcastJob, error) {
+	return b.broadcast.Acquire(ctx, owner, time.Now().Add(lease))
+}
+
+func (b *broadcastDatabase) SaveBroadcastProgress(ctx context.Context, job *model.BroadcastJob, owner string, sent int64, failed []*model.BroadcastFailed, lease time.Duration) error {
+	if len(failed) > 0 {
+		if err := b.broadcast.AddFailed(ctx, failed); err != nil {
+			return err
+		}
+	}
+	return b.broadcast.UpdateProgress(ctx, job.JobID, owner, job.Cursor, job.Total, sent, int64(len(failed)), time.Now().Add(lease))
+}
+
+func (b *broadcastDatabase) RenewBroadcastLease(ctx context.Context, jobID string, owner string, lease time.Duration) error {
+	return b.broadcast.RenewLease(ctx, jobID, owner, time.Now().Add(lease))
+}
+
+func (b *broadcastDatabase) FinishBroadcast(ctx context.Context, jobID string, owner string) error {
+	return b.broadcast.Finish(ctx, jobID, owner)
+}
+
+func (b *broadcastDatabase) FailBroadcast(ctx context.Context, jobID string, owner string, errMsg string) error {
+	return b.broadcast.Fail(ctx, jobID, owner, errMsg)
+}
+
+func (b *broadcastDatabase) CancelBroadcast(ctx context.Context, jobID string) error {
+	return b.broadcast.Cancel(ctx, jobID)
+}
+
+func (b *broadcastDatabase) FindBroadcastRecipients(ctx context.Context, jobID string, cursor int64, limit int) ([]string, error) {
+	return b.broadcast.FindRecipients(ctx, jobID, cursor, limit)
+}
+
+func (b *broadcastDatabase) PageBroadcastFailed(ctx context.Context, jobID string, pagination pagination.Pagination) (int64, []*model.BroadcastFailed, error) {
+	return b.broadcast.PageFailed(ctx, jobID, pagination)
+}
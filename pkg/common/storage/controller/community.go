@@ -0,0 +1,134 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/db/pagination"
+	"github.com/openimsdk/tools/utils/datautil"
+)
+
+type CommunityDatabase interface {
+	// CreateCommunity creates a community with its owner as first member.
+	CreateCommunity(ctx context.Context, community *model.Community, owner *model.CommunityMember) error
+	TakeCommunity(ctx context.Context, communityID string) (*model.Community, error)
+	FindCommunities(ctx context.Context, communityIDs []string) ([]*model.Community, error)
+	UpdateCommunity(ctx context.Context, communityID string, data map[string]any) error
+	// DeleteCommunity deletes a community and its members.
+	DeleteCommunity(ctx context.Context, communityID string) error
+	AddCommunityMembers(ctx context.Context, members []*model.CommunityMember) error
+	DeleteCommunityMembers(ctx context.Context, communityID string, userIDs []string) error
+	// TakeCommunityMember is cached, it returns a not found error for users outside the community.
+	TakeCommunityMember(ctx context.Context, communityID string, userID string) (*model.CommunityMember, error)
+	FindCommunityMembers(ctx context.Context, communityID string, userIDs []string) ([]*model.CommunityMember, error)
+	SetCommunityMemberRoleLevel(ctx context.Context, communityID string, userID string, roleLevel int32) error
+	PageCommunityMembers(ctx context.Context, communityID string, pagination pagination.Pagination) (int64, []*model.CommunityMember, error)
+	CountCommunityMembers(ctx context.Context, communityID string) (int64, error)
+	FindJoinedCommunityIDs(ctx context.Context, userID string) ([]string, error)
+}
+
+func NewCommunityDatabase(community database.Community, member database.CommunityMember, cache cache.CommunityCache) CommunityDatabase {
+	return &communityDatabase{community: community, member: member, cache: cache}
+}
+
+type communityDatabase struct {
+	community database.Community
+	member    database.CommunityMember
+	cache     cache.CommunityCache
+}
+
+func (c *communityDatabase) CreateCommunity(ctx context.Context, community *model.Community, owner *model.CommunityMember) error {
+	if err := c.community.Create(ctx, community); err != nil {
+		return err
+	}
+	return c.AddCommunityMembers(ctx, []*model.CommunityMember{owner})
+}
+
+func (c *communityDatabase) TakeCommunity(ctx context.Context, communityID string) (*model.Community, error) {
+	return c.community.Take(ctx, communityID)
+}
+
+func (c *communityDatabase) FindCommunities(ctx context.Context, communityIDs []string) ([]*model.Community, error) {
+	return c.community.Find(ctx, communityIDs)
+}
+
+func (c *communityDatabase) UpdateCommunity(ctx context.Context, communityID string, data map[string]any) error {
+	return c.community.UpdateMap(ctx, communityID, data)
+}
+
+func (c *communityDatabase) DeleteCommunity(ctx context.Context, communityID string) error {
+	userIDs, err := c.member.FindMemberUserIDs(ctx, communityID)
+	if err != nil {
+		return err
+	}
+	if err := c.member.DeleteAll(ctx, communityID); err != nil {
+		return err
+	}
+	if err := c.community.Delete(ctx, communityID); err != nil {
+		return err
+	}
+	return c.cache.DelCommunityMembers(communityID, userIDs...).ChainExecDel(ctx)
+}
+
+func (c *communityDatabase) AddCommunityMembers(ctx context.Context, members []*model.CommunityMember) error {
+	if len(members) == 0 {
+		return nil
+	}
+	if err := c.member.Create(ctx, members); err != nil {
+		return err
+	}
+	// Users looked up before joining are cached as not found.
+	communityID := members[0].CommunityID
+	userIDs := datautil.Slice(members, func(e *model.CommunityMember) string { return e.UserID })
+	return c.cache.DelCommunityMembers(communityID, userIDs...).ChainExecDel(ctx)
+}
+
+func (c *communityDatabase) DeleteCommunityMembers(ctx context.Context, communityID string, userIDs []string) error {
+	if err := c.member.Delete(ctx, communityID, userIDs); err != nil {
+		return err
+	}
+	return c.cache.DelCommunityMembers(communityID, userIDs...).ChainExecDel(ctx)
+}
+
+func (c *communityDatabase) TakeCommunityMember(ctx context.Context, communityID string, userID string) (*model.CommunityMember, error) {
+	return c.cache.GetCommunityMember(ctx, communityID, userID)
+}
+
+func (c *communityDatabase) FindCommunityMembers(ctx context.Context, communityID string, userIDs []string) ([]*model.CommunityMember, error) {
+	return c.member.Find(ctx, communityID, userIDs)
+}
+
+func (c *communityDatabase) SetCommunityMemberRoleLevel(ctx context.Context, communityID string, userID string, roleLevel int32) error {
+	if err := c.member.UpdateRoleLevel(ctx, communityID, userID, roleLevel); err != nil {
+		return err
+	}
+	return c.cache.DelCommunityMembers(communityID, userID).ChainExecDel(ctx)
+}
+
+func (c *communityDatabase) PageCommunityMembers(ctx context.Context, communityID string, pagination pagination.Pagination) (int64, []*model.CommunityMember, error) {
+	return c.member.Page(ctx, communityID, pagination)
+}
+
+func (c *communityDatabase) CountCommunityMembers(ctx context.Context, communityID string) (int64, error) {
+	return c.member.Count(ctx, communityID)
+}
+
+func (c *communityDatabase) FindJoinedCommunityIDs(ctx context.Context, userID string) ([]string, error) {
+	return c.member.FindJoinedCommunityIDs(ctx, userID)
+}
@@ -0,0 +1,98 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/db/tx"
+	"github.com/openimsdk/tools/utils/datautil"
+)
+
+// ContactDiscoveryDatabase matches hashed phone numbers and emails with the users who registered them.
+type ContactDiscoveryDatabase interface {
+	// SetContactHashes replaces the hashes of the user of the type, the hashes are taken from the users who held them before.
+	SetContactHashes(ctx context.Context, userID string, typ int32, hashes []string) error
+	FindContactHashes(ctx context.Context, hashes []string) ([]*model.ContactHash, error)
+	SetContactDiscoverySetting(ctx context.Context, setting *model.ContactDiscoverySetting) error
+	TakeContactDiscoverySetting(ctx context.Context, userID string) (*model.ContactDiscoverySetting, error)
+	FindContactDiscoverySettings(ctx context.Context, userIDs []string) ([]*model.ContactDiscoverySetting, error)
+	// DeleteUserContactDiscovery deletes the hashes and the setting of the user.
+	DeleteUserContactDiscovery(ctx context.Context, userID string) error
+	// AcquireContactDiscoveryQuota counts looked up hashes of the user, it reports false when the daily limit would be exceeded.
+	AcquireContactDiscoveryQuota(ctx context.Context, userID string, count int64, limit int64) (bool, error)
+}
+
+func NewContactDiscoveryDatabase(hash database.ContactHash, setting database.ContactDiscoverySetting, quota cache.ContactDiscoveryQuotaCache, tx tx.Tx) ContactDiscoveryDatabase {
+	return &contactDiscoveryDatabase{hash: hash, setting: setting, quota: quota, tx: tx}
+}
+
+type contactDiscoveryDatabase struct {
+	hash    database.ContactHash
+	setting database.ContactDiscoverySetting
+	quota   cache.ContactDiscoveryQuotaCache
+	tx      tx.Tx
+}
+
+func (c *contactDiscoveryDatabase) SetContactHashes(ctx context.Context, userID string, typ int32, hashes []string) error {
+	return c.tx.Transaction(ctx, func(ctx context.Context) error {
+		if err := c.hash.DeleteUserType(ctx, userID, typ); err != nil {
+			return err
+		}
+		if len(hashes) == 0 {
+			return nil
+		}
+		if err := c.hash.DeleteHashes(ctx, hashes); err != nil {
+			return err
+		}
+		now := time.Now()
+		return c.hash.Create(ctx, datautil.Slice(hashes, func(hash string) *model.ContactHash {
+			return &model.ContactHash{Hash: hash, Type: typ, UserID: userID, CreateTime: now}
+		}))
+	})
+}
+
+func (c *contactDiscoveryDatabase) FindContactHashes(ctx context.Context, hashes []string) ([]*model.ContactHash, error) {
+	return c.hash.Find(ctx, hashes)
+}
+
+func (c *contactDiscoveryDatabase) SetContactDiscoverySetting(ctx context.Context, setting *model.ContactDiscoverySetting) error {
+	return c.setting.Set(ctx, setting)
+}
+
+func (c *contactDiscoveryDatabase) TakeContactDiscoverySetting(ctx context.Context, userID string) (*model.ContactDiscoverySetting, error) {
+	return c.setting.Take(ctx, userID)
+}
+
+func (c *contactDiscoveryDatabase) FindContactDiscoverySettings(ctx context.Context, userIDs []string) ([]*model.ContactDiscoverySetting, error) {
+	return c.setting.Find(ctx, userIDs)
+}
+
+func (c *contactDiscoveryDatabase) DeleteUserContactDiscovery(ctx context.Context, userID string) error {
+	return c.tx.Transaction(ctx, func(ctx context.Context) error {
+		if err := c.hash.DeleteUser(ctx, userID); err != nil {
+			return err
+		}
+		return c.setting.Delete(ctx, userID)
+	})
+}
+
+func (c *contactDiscoveryDatabase) AcquireContactDiscoveryQuota(ctx context.Context, userID string, count int64, limit int64) (bool, error) {
+	return c.quota.AcquireContactDiscoveryQuota(ctx, userID, count, limit)
+}
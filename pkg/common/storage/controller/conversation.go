@@ -64,13 +64,21 @@ type ConversationDatabase interface {
 	GetConversationIDsNeedDestruct(ctx context.Context) ([]*relationtb.Conversation, error)
 	// GetConversationNotReceiveMessageUserIDs gets user IDs for users in a conversation who have not received messages.
 	GetConversationNotReceiveMessageUserIDs(ctx context.Context, conversationID string) ([]string, error)
+	// FindConversationChangeLog returns the conversation list version of a user and at most limit conversations changed after version.
+	FindConversationChangeLog(ctx context.Context, ownerUserID string, version uint64, limit int) (*relationtb.VersionLog, []*relationtb.VersionLogElem, error)
+	// IncrConversationsVersion records a change of the user's conversations kept outside the conversation itself,
+	// such as its folder, draft or mute, in the conversation list version.
+	IncrConversationsVersion(ctx context.Context, ownerUserID string, conversationIDs []string) error
+	// DeleteUserConversations deletes all conversations owned by the user.
+	DeleteUserConversations(ctx context.Context, ownerUserID string) error
 	// GetUserAllHasReadSeqs(ctx context.Context, ownerUserID string) (map[string]int64, error)
 	// FindRecvMsgNotNotifyUserIDs(ctx context.Context, groupID string) ([]string, error)
 }
 
-func NewConversationDatabase(conversation database.Conversation, cache cache.ConversationCache, tx tx.Tx) ConversationDatabase {
+func NewConversationDatabase(conversation database.Conversation, version database.VersionLog, cache cache.ConversationCache, tx tx.Tx) ConversationDatabase {
 	return &conversationDatabase{
 		conversationDB: conversation,
+		versionDB:      version,
 		cache:          cache,
 		tx:             tx,
 	}
@@ -78,10 +86,30 @@ func NewConversationDatabase(conversation database.Conversation, cache cache.Con
 
 type conversationDatabase struct {
 	conversationDB database.Conversation
+	versionDB      database.VersionLog
 	cache          cache.ConversationCache
 	tx             tx.Tx
 }
 
+// incrUsersVersion records the change of a conversation in the conversation list version of every user.
+func (c *conversationDatabase) incrUsersVersion(ctx context.Context, userIDs []string, conversationID string, state int32) error {
+	return c.versionDB.IncrVersions(ctx, userIDs, conversationID, state)
+}
+
+// incrConversationsVersion records the change of conversations in the conversation list version of their owners.
+func (c *conversationDatabase) incrConversationsVersion(ctx context.Context, conversations []*relationtb.Conversation, state int32) error {
+	ownerConversationIDs := make(map[string][]string)
+	for _, conversation := range conversations {
+		ownerConversationIDs[conversation.OwnerUserID] = append(ownerConversationIDs[conversation.OwnerUserID], conversation.ConversationID)
+	}
+	for ownerUserID, conversationIDs := range ownerConversationIDs {
+		if err := c.versionDB.IncrVersion(ctx, ownerUserID, conversationIDs, state); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (c *conversationDatabase) SetUsersConversationFieldTx(ctx context.Context, userIDs []string, conversation *relationtb.Conversation, fieldMap map[string]any) (err error) {
 	return c.tx.Transaction(ctx, func(ctx context.Context) error {
 		cache := c.cache.CloneConversationCache()
@@ -97,6 +125,9 @@ func (c *conversationDatabase) SetUsersConversationFieldTx(ctx context.Context,
 			if err != nil {
 				return err
 			}
+			if err := c.incrUsersVersion(ctx, haveUserIDs, conversation.ConversationID, relationtb.VersionStateUpdate); err != nil {
+				return err
+			}
 			cache = cache.DelUsersConversation(conversation.ConversationID, haveUserIDs...)
 			if _, ok := fieldMap["has_read_seq"]; ok {
 				for _, userID := range haveUserIDs {
@@ -125,6 +156,9 @@ func (c *conversationDatabase) SetUsersConversationFieldTx(ctx context.Context,
 			if err != nil {
 				return err
 			}
+			if err := c.incrUsersVersion(ctx, NotUserIDs, conversation.ConversationID, relationtb.VersionStateInsert); err != nil {
+				return err
+			}
 			cache = cache.DelConversationIDs(NotUserIDs...).DelUserConversationIDsHash(NotUserIDs...).DelConversations(conversation.ConversationID, NotUserIDs...)
 		}
 		return cache.ChainExecDel(ctx)
@@ -136,6 +170,9 @@ func (c *conversationDatabase) UpdateUsersConversationField(ctx context.Context,
 	if err != nil {
 		return err
 	}
+	if err := c.incrUsersVersion(ctx, userIDs, conversationID, relationtb.VersionStateUpdate); err != nil {
+		return err
+	}
 	cache := c.cache.CloneConversationCache()
 	cache = cache.DelUsersConversation(conversationID, userIDs...)
 	if _, ok := args["recv_msg_opt"]; ok {
@@ -148,6 +185,9 @@ func (c *conversationDatabase) CreateConversation(ctx context.Context, conversat
 	if err := c.conversationDB.Create(ctx, conversations); err != nil {
 		return err
 	}
+	if err := c.incrConversationsVersion(ctx, conversations, relationtb.VersionStateInsert); err != nil {
+		return err
+	}
 	var userIDs []string
 	cache := c.cache.CloneConversationCache()
 	for _, conversation := range conversations {
@@ -158,6 +198,31 @@ func (c *conversationDatabase) CreateConversation(ctx context.Context, conversat
 	return cache.DelConversationIDs(userIDs...).DelUserConversationIDsHash(userIDs...).ChainExecDel(ctx)
 }
 
+func (c *conversationDatabase) DeleteUserConversations(ctx context.Context, ownerUserID string) error {
+	conversations, err := c.conversationDB.FindUserIDAllConversations(ctx, ownerUserID)
+	if err != nil {
+		return err
+	}
+	if len(conversations) == 0 {
+		return nil
+	}
+	if err := c.conversationDB.DeleteByOwner(ctx, ownerUserID); err != nil {
+		return err
+	}
+	if err := c.incrConversationsVersion(ctx, conversations, relationtb.VersionStateDelete); err != nil {
+		return err
+	}
+	cache := c.cache.CloneConversationCache()
+	for _, conversation := range conversations {
+		cache = cache.DelConversations(ownerUserID, conversation.ConversationID)
+		cache = cache.DelConversationNotReceiveMessageUserIDs(conversation.ConversationID)
+		if conversation.GroupID != "" {
+			cache = cache.DelSuperGroupRecvMsgNotNotifyUserIDs(conversation.GroupID).DelSuperGroupRecvMsgNotNotifyUserIDsHash(conversation.GroupID)
+		}
+	}
+	return cache.DelConversationIDs(ownerUserID).DelUserConversationIDsHash(ownerUserID).ChainExecDel(ctx)
+}
+
 func (c *conversationDatabase) SyncPeerUserPrivateConversationTx(ctx context.Context, conversations []*relationtb.Conversation) error {
 	return c.tx.Transaction(ctx, func(ctx context.Context) error {
 		cache := c.cache.CloneConversationCache()
@@ -174,6 +239,9 @@ func (c *conversationDatabase) SyncPeerUserPrivateConversationTx(ctx context.Con
 					if err != nil {
 						return err
 					}
+					if err := c.versionDB.IncrVersion(ctx, ownerUserID, []string{conversation.ConversationID}, relationtb.VersionStateUpdate); err != nil {
+						return err
+					}
 					cache = cache.DelUsersConversation(conversation.ConversationID, ownerUserID)
 				} else {
 					newConversation := *conversation
@@ -184,6 +252,9 @@ func (c *conversationDatabase) SyncPeerUserPrivateConversationTx(ctx context.Con
 					if err := c.conversationDB.Create(ctx, []*relationtb.Conversation{&newConversation}); err != nil {
 						return err
 					}
+					if err := c.versionDB.IncrVersion(ctx, ownerUserID, []string{conversation.ConversationID}, relationtb.VersionStateInsert); err != nil {
+						return err
+					}
 					cache = cache.DelConversationIDs(ownerUserID).DelUserConversationIDsHash(ownerUserID)
 				}
 			}
@@ -229,6 +300,9 @@ func (c *conversationDatabase) SetUserConversations(ctx context.Context, ownerUs
 					return err
 				}
 			}
+			if err := c.incrConversationsVersion(ctx, existConversations, relationtb.VersionStateUpdate); err != nil {
+				return err
+			}
 		}
 		var existConversationIDs []string
 		for _, conversation := range existConversations {
@@ -246,6 +320,9 @@ func (c *conversationDatabase) SetUserConversations(ctx context.Context, ownerUs
 			if err != nil {
 				return err
 			}
+			if err := c.incrConversationsVersion(ctx, notExistConversations, relationtb.VersionStateInsert); err != nil {
+				return err
+			}
 			cache = cache.DelConversationIDs(ownerUserID).
 				DelUserConversationIDsHash(ownerUserID).
 				DelConversationNotReceiveMessageUserIDs(datautil.Slice(notExistConversations, func(e *relationtb.Conversation) string { return e.ConversationID })...)
@@ -279,11 +356,17 @@ func (c *conversationDatabase) CreateGroupChatConversation(ctx context.Context,
 			if err != nil {
 				return err
 			}
+			if err := c.incrUsersVersion(ctx, notExistUserIDs, conversationID, relationtb.VersionStateInsert); err != nil {
+				return err
+			}
 		}
 		_, err = c.conversationDB.UpdateByMap(ctx, existConversationUserIDs, conversationID, map[string]any{"max_seq": 0})
 		if err != nil {
 			return err
 		}
+		if err := c.incrUsersVersion(ctx, existConversationUserIDs, conversationID, relationtb.VersionStateUpdate); err != nil {
+			return err
+		}
 		for _, v := range existConversationUserIDs {
 			cache = cache.DelConversations(v, conversationID)
 		}
@@ -322,3 +405,22 @@ func (c *conversationDatabase) GetConversationIDsNeedDestruct(ctx context.Contex
 func (c *conversationDatabase) GetConversationNotReceiveMessageUserIDs(ctx context.Context, conversationID string) ([]string, error) {
 	return c.cache.GetConversationNotReceiveMessageUserIDs(ctx, conversationID)
 }
+
+func (c *conversationDatabase) IncrConversationsVersion(ctx context.Context, ownerUserID string, conversationIDs []string) error {
+	return c.versionDB.IncrVersion(ctx, ownerUserID, conversationIDs, relationtb.VersionStateUpdate)
+}
+
+func (c *conversationDatabase) FindConversationChangeLog(ctx context.Context, ownerUserID string, version uint64, limit int) (*relationtb.VersionLog, []*relationtb.VersionLogElem, error) {
+	head, err := c.versionDB.TakeVersion(ctx, ownerUserID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if head.Version <= version {
+		return head, nil, nil
+	}
+	logs, err := c.versionDB.FindChangeLog(ctx, ownerUserID, version, limit)
+	if err != nil {
+		return nil, nil, err
+	}
+	return head, logs, nil
+}
@@ -0,0 +1,145 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/utils/datautil"
+)
+
+type ConversationDraftDatabase interface {
+	// SetDraft saves the draft in the cache, it is written to the database later by WriteDrafts.
+	// An empty draft clears the draft of the conversation.
+	SetDraft(ctx context.Context, userID string, conversationID string, draft string) (*model.ConversationDraft, error)
+	// GetDrafts returns the non-empty drafts of the conversations, all drafts of the user if conversationIDs is empty.
+	GetDrafts(ctx context.Context, userID string, conversationIDs []string) ([]*model.ConversationDraft, error)
+	// WriteDrafts writes the cached drafts of up to count users to the database, it returns the number of users written.
+	WriteDrafts(ctx context.Context, count int64) (int, error)
+	// DeleteUserDrafts deletes the stored and the pending drafts of the user.
+	DeleteUserDrafts(ctx context.Context, userID string) error
+}
+
+func NewConversationDraftDatabase(draft database.ConversationDraft, cache cache.DraftCache) ConversationDraftDatabase {
+	return &conversationDraftDatabase{draft: draft, cache: cache}
+}
+
+type conversationDraftDatabase struct {
+	draft database.ConversationDraft
+	cache cache.DraftCache
+}
+
+func (c *conversationDraftDatabase) SetDraft(ctx context.Context, userID string, conversationID string, draft string) (*model.ConversationDraft, error) {
+	d := &model.ConversationDraft{
+		OwnerUserID:    userID,
+		ConversationID: conversationID,
+		Draft:          draft,
+		UpdateTime:     time.UnixMilli(time.Now().UnixMilli()),
+	}
+	if err := c.cache.SetDraft(ctx, d); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+func (c *conversationDraftDatabase) GetDrafts(ctx context.Context, userID string, conversationIDs []string) ([]*model.ConversationDraft, error) {
+	drafts, err := c.draft.Find(ctx, userID, conversationIDs)
+	if err != nil {
+		return nil, err
+	}
+	pending, err := c.cache.GetDrafts(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	draftMap := datautil.SliceToMap(drafts, func(e *model.ConversationDraft) string { return e.ConversationID })
+	var filter map[string]struct{}
+	if len(conversationIDs) > 0 {
+		filter = datautil.SliceSet(conversationIDs)
+	}
+	for _, draft := range pending {
+		if filter != nil {
+			if _, ok := filter[draft.ConversationID]; !ok {
+				continue
+			}
+		}
+		if stored, ok := draftMap[draft.ConversationID]; ok && stored.UpdateTime.After(draft.UpdateTime) {
+			continue
+		}
+		draftMap[draft.ConversationID] = draft
+	}
+	res := make([]*model.ConversationDraft, 0, len(draftMap))
+	for _, draft := range draftMap {
+		if draft.Draft != "" {
+			res = append(res, draft)
+		}
+	}
+	return res, nil
+}
+
+func (c *conversationDraftDatabase) WriteDrafts(ctx context.Context, count int64) (int, error) {
+	userIDs, err := c.cache.GetPendingUsers(ctx, count)
+	if err != nil {
+		return 0, err
+	}
+	for i, userID := range userIDs {
+		if err := c.writeUserDrafts(ctx, userID); err != nil {
+			// The users left are still pending and written by a later call.
+			return i, err
+		}
+	}
+	return len(userIDs), nil
+}
+
+func (c *conversationDraftDatabase) writeUserDrafts(ctx context.Context, userID string) error {
+	drafts, err := c.cache.GetDrafts(ctx, userID)
+	if err != nil {
+		return err
+	}
+	var saved, cleared []*model.ConversationDraft
+	for _, draft := range drafts {
+		if draft.Draft == "" {
+			cleared = append(cleared, draft)
+		} else {
+			saved = append(saved, draft)
+		}
+	}
+	if err := c.draft.Save(ctx, saved); err != nil {
+		return err
+	}
+	if err := c.draft.Delete(ctx, cleared); err != nil {
+		return err
+	}
+	if err := c.cache.DelWrittenDrafts(ctx, userID, drafts); err != nil {
+		return err
+	}
+	return c.cache.DelPendingUser(ctx, userID)
+}
+
+func (c *conversationDraftDatabase) DeleteUserDrafts(ctx context.Context, userID string) error {
+	pending, err := c.cache.GetDrafts(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if len(pending) > 0 {
+		if err := c.cache.DelWrittenDrafts(ctx, userID, pending); err != nil {
+			return err
+		}
+	}
+	return c.draft.DeleteByOwner(ctx, userID)
+}
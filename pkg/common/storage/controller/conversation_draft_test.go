@@ -0,0 +1,192 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/utils/datautil"
+)
+
+type draftCacheStub struct {
+	drafts  map[string]*model.ConversationDraft
+	pending map[string]struct{}
+}
+
+func (d *draftCacheStub) SetDraft(ctx context.Context, draft *model.ConversationDraft) error {
+	d.drafts[draft.ConversationID] = draft
+	d.pending[draft.OwnerUserID] = struct{}{}
+	return nil
+}
+
+func (d *draftCacheStub) GetDrafts(ctx context.Context, userID string) ([]*model.ConversationDraft, error) {
+	var drafts []*model.ConversationDraft
+	for _, draft := range d.drafts {
+		if draft.OwnerUserID == userID {
+			copied := *draft
+			drafts = append(drafts, &copied)
+		}
+	}
+	return drafts, nil
+}
+
+func (d *draftCacheStub) GetPendingUsers(ctx context.Context, count int64) ([]string, error) {
+	var userIDs []string
+	for userID := range d.pending {
+		if int64(len(userIDs)) == count {
+			break
+		}
+		userIDs = append(userIDs, userID)
+	}
+	return userIDs, nil
+}
+
+func (d *draftCacheStub) DelPendingUser(ctx context.Context, userID string) error {
+	delete(d.pending, userID)
+	for _, draft := range d.drafts {
+		if draft.OwnerUserID == userID {
+			d.pending[userID] = struct{}{}
+		}
+	}
+	return nil
+}
+
+func (d *draftCacheStub) DelWrittenDrafts(ctx context.Context, userID string, drafts []*model.ConversationDraft) error {
+	for _, draft := range drafts {
+		if cached, ok := d.drafts[draft.ConversationID]; ok && *cached == *draft {
+			delete(d.drafts, draft.ConversationID)
+		}
+	}
+	return nil
+}
+
+type draftStub struct {
+	drafts map[string]*model.ConversationDraft
+	fail   bool
+}
+
+func (d *draftStub) Save(ctx context.Context, drafts []*model.ConversationDraft) error {
+	if d.fail {
+		return errors.New("save failed")
+	}
+	for _, draft := range drafts {
+		if stored, ok := d.drafts[draft.ConversationID]; !ok || !stored.UpdateTime.After(draft.UpdateTime) {
+			d.drafts[draft.ConversationID] = draft
+		}
+	}
+	return nil
+}
+
+func (d *draftStub) Delete(ctx context.Context, drafts []*model.ConversationDraft) error {
+	for _, draft := range drafts {
+		if stored, ok := d.drafts[draft.ConversationID]; ok && !stored.UpdateTime.After(draft.UpdateTime) {
+			delete(d.drafts, draft.ConversationID)
+		}
+	}
+	return nil
+}
+
+func (d *draftStub) DeleteByOwner(ctx context.Context, ownerUserID string) error {
+	for conversationID, draft := range d.drafts {
+		if draft.OwnerUserID == ownerUserID {
+			delete(d.drafts, conversationID)
+		}
+	}
+	return nil
+}
+
+func (d *draftStub) Find(ctx context.Context, ownerUserID string, conversationIDs []string) ([]*model.ConversationDraft, error) {
+	if len(conversationIDs) == 0 {
+		return datautil.Values(d.drafts), nil
+	}
+	var drafts []*model.ConversationDraft
+	for _, conversationID := range conversationIDs {
+		if draft, ok := d.drafts[conversationID]; ok {
+			drafts = append(drafts, draft)
+		}
+	}
+	return drafts, nil
+}
+
+func getDraftMap(t *testing.T, db ConversationDraftDatabase, conversationIDs ...string) map[string]string {
+	drafts, err := db.GetDrafts(context.Background(), "u1", conversationIDs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res := make(map[string]string)
+	for _, draft := range drafts {
+		res[draft.ConversationID] = draft.Draft
+	}
+	return res
+}
+
+func TestConversationDraftDatabase(t *testing.T) {
+	ctx := context.Background()
+	old := time.Now().Add(-time.Hour)
+	stored := &draftStub{drafts: map[string]*model.ConversationDraft{
+		"c1": {OwnerUserID: "u1", ConversationID: "c1", Draft: "stored", UpdateTime: old},
+		"c2": {OwnerUserID: "u1", ConversationID: "c2", Draft: "stored", UpdateTime: old},
+	}}
+	cached := &draftCacheStub{drafts: map[string]*model.ConversationDraft{}, pending: map[string]struct{}{}}
+	db := NewConversationDraftDatabase(stored, cached)
+
+	if _, err := db.SetDraft(ctx, "u1", "c1", "typing"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.SetDraft(ctx, "u1", "c2", ""); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.SetDraft(ctx, "u1", "c3", "new"); err != nil {
+		t.Fatal(err)
+	}
+	// pending drafts override the stored ones before they are written
+	drafts := getDraftMap(t, db)
+	if len(drafts) != 2 || drafts["c1"] != "typing" || drafts["c3"] != "new" {
+		t.Fatalf("unexpected drafts before write: %v", drafts)
+	}
+	if drafts := getDraftMap(t, db, "c3"); len(drafts) != 1 || drafts["c3"] != "new" {
+		t.Fatalf("unexpected filtered drafts: %v", drafts)
+	}
+
+	stored.fail = true
+	if _, err := db.WriteDrafts(ctx, 10); err == nil {
+		t.Fatal("failed write reported no error")
+	}
+	if _, ok := cached.pending["u1"]; !ok || len(cached.drafts) != 3 {
+		t.Fatalf("drafts of a failed write no longer pending: %v %v", cached.drafts, cached.pending)
+	}
+	stored.fail = false
+
+	n, err := db.WriteDrafts(ctx, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 1 {
+		t.Fatalf("written users %d, want 1", n)
+	}
+	if len(cached.drafts) != 0 || len(cached.pending) != 0 {
+		t.Fatalf("written drafts still cached: %v %v", cached.drafts, cached.pending)
+	}
+	if _, ok := stored.drafts["c2"]; ok {
+		t.Fatal("cleared draft still stored")
+	}
+	if drafts := getDraftMap(t, db); len(drafts) != 2 || drafts["c1"] != "typing" || drafts["c3"] != "new" {
+		t.Fatalf("unexpected drafts after write: %v", drafts)
+	}
+}
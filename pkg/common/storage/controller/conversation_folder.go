@@ -0,0 +1,85 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+)
+
+type ConversationFolderDatabase interface {
+	CreateFolder(ctx context.Context, folder *model.ConversationFolder) error
+	TakeFolder(ctx context.Context, ownerUserID string, folderID string) (*model.ConversationFolder, error)
+	// FindFolders returns the folders of the user by ascending order.
+	FindFolders(ctx context.Context, ownerUserID string) ([]*model.ConversationFolder, error)
+	UpdateFolder(ctx context.Context, ownerUserID string, folderID string, data map[string]any) error
+	// SortFolders sets the order of each folder, k: folderID, v: order.
+	SortFolders(ctx context.Context, ownerUserID string, orders map[string]int32) error
+	DeleteFolder(ctx context.Context, ownerUserID string, folderID string) error
+	DeleteUserFolders(ctx context.Context, ownerUserID string) error
+	AddFolderConversations(ctx context.Context, ownerUserID string, folderID string, conversationIDs []string) error
+	RemoveFolderConversations(ctx context.Context, ownerUserID string, folderID string, conversationIDs []string) error
+}
+
+func NewConversationFolderDatabase(folder database.ConversationFolder) ConversationFolderDatabase {
+	return &conversationFolderDatabase{folder: folder}
+}
+
+type conversationFolderDatabase struct {
+	folder database.ConversationFolder
+}
+
+func (c *conversationFolderDatabase) CreateFolder(ctx context.Context, folder *model.ConversationFolder) error {
+	return c.folder.Create(ctx, folder)
+}
+
+func (c *conversationFolderDatabase) TakeFolder(ctx context.Context, ownerUserID string, folderID string) (*model.ConversationFolder, error) {
+	return c.folder.Take(ctx, ownerUserID, folderID)
+}
+
+func (c *conversationFolderDatabase) FindFolders(ctx context.Context, ownerUserID string) ([]*model.ConversationFolder, error) {
+	return c.folder.Find(ctx, ownerUserID)
+}
+
+func (c *conversationFolderDatabase) UpdateFolder(ctx context.Context, ownerUserID string, folderID string, data map[string]any) error {
+	return c.folder.Update(ctx, ownerUserID, folderID, data)
+}
+
+func (c *conversationFolderDatabase) SortFolders(ctx context.Context, ownerUserID string, orders map[string]int32) error {
+	for folderID, order := range orders {
+		if err := c.folder.Update(ctx, ownerUserID, folderID, map[string]any{"order": order}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *conversationFolderDatabase) DeleteFolder(ctx context.Context, ownerUserID string, folderID string) error {
+	return c.folder.Delete(ctx, ownerUserID, folderID)
+}
+
+func (c *conversationFolderDatabase) DeleteUserFolders(ctx context.Context, ownerUserID string) error {
+	return c.folder.DeleteByOwner(ctx, ownerUserID)
+}
+
+func (c *conversationFolderDatabase) AddFolderConversations(ctx context.Context, ownerUserID string, folderID string, conversationIDs []string) error {
+	return c.folder.AddConversations(ctx, ownerUserID, folderID, conversationIDs)
+}
+
+func (c *conversationFolderDatabase) RemoveFolderConversations(ctx context.Context, ownerUserID string, folderID string, conversationIDs []string) error {
+	return c.folder.RemoveConversations(ctx, ownerUserID, folderID, conversationIDs)
+}
@@ -0,0 +1,91 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+)
+
+type versionIncr struct {
+	dIDs  []string
+	eIDs  []string
+	state int32
+}
+
+type versionLogStub struct {
+	database.VersionLog
+	incrs []versionIncr
+}
+
+func (v *versionLogStub) IncrVersion(_ context.Context, dID string, eIDs []string, state int32) error {
+	v.incrs = append(v.incrs, versionIncr{dIDs: []string{dID}, eIDs: eIDs, state: state})
+	return nil
+}
+
+func (v *versionLogStub) IncrVersions(_ context.Context, dIDs []string, eID string, state int32) error {
+	v.incrs = append(v.incrs, versionIncr{dIDs: dIDs, eIDs: []string{eID}, state: state})
+	return nil
+}
+
+type conversationStub struct {
+	database.Conversation
+}
+
+func (conversationStub) UpdateByMap(_ context.Context, userIDs []string, _ string, _ map[string]any) (int64, error) {
+	return int64(len(userIDs)), nil
+}
+
+type conversationCacheStub struct {
+	cache.ConversationCache
+}
+
+func (c conversationCacheStub) CloneConversationCache() cache.ConversationCache {
+	return c
+}
+
+func (c conversationCacheStub) DelUsersConversation(string, ...string) cache.ConversationCache {
+	return c
+}
+
+func (c conversationCacheStub) ChainExecDel(context.Context) error {
+	return nil
+}
+
+func TestConversationVersion(t *testing.T) {
+	versionDB := &versionLogStub{}
+	db := NewConversationDatabase(conversationStub{}, versionDB, conversationCacheStub{}, nil)
+	ctx := context.Background()
+
+	userIDs := []string{"u1", "u2", "u3"}
+	if err := db.UpdateUsersConversationField(ctx, userIDs, "sg_g1", map[string]any{"is_pinned": true}); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.IncrConversationsVersion(ctx, "u1", []string{"sg_g1", "si_u1_u2"}); err != nil {
+		t.Fatal(err)
+	}
+	want := []versionIncr{
+		{dIDs: userIDs, eIDs: []string{"sg_g1"}, state: model.VersionStateUpdate},
+		{dIDs: []string{"u1"}, eIDs: []string{"sg_g1", "si_u1_u2"}, state: model.VersionStateUpdate},
+	}
+	if !reflect.DeepEqual(versionDB.incrs, want) {
+		t.Errorf("version increments %+v, want %+v", versionDB.incrs, want)
+	}
+}
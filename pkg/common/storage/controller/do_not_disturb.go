@@ -0,0 +1,71 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+)
+
+type DoNotDisturbDatabase interface {
+	SetDoNotDisturb(ctx context.Context, dnd *model.DoNotDisturb) error
+	TakeDoNotDisturb(ctx context.Context, userID string) (*model.DoNotDisturb, error)
+	// FindDoNotDisturbs is cached, users without settings have empty ones.
+	FindDoNotDisturbs(ctx context.Context, userIDs []string) ([]*model.DoNotDisturb, error)
+	MuteConversation(ctx context.Context, userID string, conversationID string, muteUntil time.Time) error
+	DeleteDoNotDisturb(ctx context.Context, userID string) error
+}
+
+func NewDoNotDisturbDatabase(dnd database.DoNotDisturb, cache cache.DoNotDisturbCache) DoNotDisturbDatabase {
+	return &doNotDisturbDatabase{dnd: dnd, cache: cache}
+}
+
+type doNotDisturbDatabase struct {
+	dnd   database.DoNotDisturb
+	cache cache.DoNotDisturbCache
+}
+
+func (d *doNotDisturbDatabase) SetDoNotDisturb(ctx context.Context, dnd *model.DoNotDisturb) error {
+	if err := d.dnd.Set(ctx, dnd); err != nil {
+		return err
+	}
+	return d.cache.DelDoNotDisturbs(dnd.UserID).ChainExecDel(ctx)
+}
+
+func (d *doNotDisturbDatabase) TakeDoNotDisturb(ctx context.Context, userID string) (*model.DoNotDisturb, error) {
+	return d.dnd.Take(ctx, userID)
+}
+
+func (d *doNotDisturbDatabase) FindDoNotDisturbs(ctx context.Context, userIDs []string) ([]*model.DoNotDisturb, error) {
+	return d.cache.GetDoNotDisturbs(ctx, userIDs)
+}
+
+func (d *doNotDisturbDatabase) MuteConversation(ctx context.Context, userID string, conversationID string, muteUntil time.Time) error {
+	if err := d.dnd.MuteConversation(ctx, userID, conversationID, muteUntil); err != nil {
+		return err
+	}
+	return d.cache.DelDoNotDisturbs(userID).ChainExecDel(ctx)
+}
+
+func (d *doNotDisturbDatabase) DeleteDoNotDisturb(ctx context.Context, userID string) error {
+	if err := d.dnd.Delete(ctx, userID); err != nil {
+		return err
+	}
+	return d.cache.DelDoNotDisturbs(userID).ChainExecDel(ctx)
+}
@@ -72,22 +72,67 @@ type FriendDatabase interface {
 	// FindFriendUserIDs retrieves the friend IDs of a user
 	FindFriendUserIDs(ctx context.Context, ownerUserID string) (friendUserIDs []string, err error)
 
+	// HasCommonFriend reports whether the two users have a friend in common
+	HasCommonFriend(ctx context.Context, userID1 string, userID2 string) (bool, error)
+
 	// FindBothFriendRequests finds friend requests sent and received
 	FindBothFriendRequests(ctx context.Context, fromUserID, toUserID string) (friends []*model.FriendRequest, err error)
 
+	// FindExpiredFriendRequests retrieves the oldest pending friend requests sent before the given time, following
+	// after when it is not nil
+	FindExpiredFriendRequests(ctx context.Context, before time.Time, after *model.FriendRequest, limit int64) (friendRequests []*model.FriendRequest, err error)
+
+	// ExpireFriendRequest refuses a pending friend request without a handler, returns an error if it was already handled
+	ExpireFriendRequest(ctx context.Context, fromUserID, toUserID string, handleMsg string) (err error)
+
+	// CountFriendsOfFriends counts of how many of the given users each user is a friend, up to limit users
+	CountFriendsOfFriends(ctx context.Context, userIDs []string, limit int64) (map[string]int64, error)
+	// FindPendingFriendRequestUserIDs retrieves the users with a pending friend request from or to the user
+	FindPendingFriendRequestUserIDs(ctx context.Context, userID string) ([]string, error)
+	// DeleteUserRelations deletes the friends, friend requests and friend groups of the user and removes the user from
+	// the friend lists of others. It returns the users whose friend list changed.
+	DeleteUserRelations(ctx context.Context, userID string) (changedUserIDs []string, err error)
+	// CountFriendRequestSenders counts the friend requests sent since the given time by sender, the senders with the most requests first
+	CountFriendRequestSenders(ctx context.Context, since time.Time, limit int64) (senders []*model.FriendRequestSender, err error)
+
 	// UpdateFriends updates fields for friends
 	UpdateFriends(ctx context.Context, ownerUserID string, friendUserIDs []string, val map[string]any) (err error)
+
+	// CreateFriendGroup creates a friend group of the owner
+	CreateFriendGroup(ctx context.Context, group *model.FriendGroup) (err error)
+
+	// TakeFriendGroup retrieves a friend group of the owner, returns an error if not found
+	TakeFriendGroup(ctx context.Context, ownerUserID string, groupID string) (group *model.FriendGroup, err error)
+
+	// FindFriendGroups retrieves the friend groups of the owner by ascending order
+	FindFriendGroups(ctx context.Context, ownerUserID string) (groups []*model.FriendGroup, err error)
+
+	// UpdateFriendGroup updates fields of a friend group
+	UpdateFriendGroup(ctx context.Context, ownerUserID string, groupID string, val map[string]any) (err error)
+
+	// SortFriendGroups sets the order of each friend group, k: groupID, v: order
+	SortFriendGroups(ctx context.Context, ownerUserID string, orders map[string]int32) (err error)
+
+	// DeleteFriendGroup deletes a friend group and takes its friends out of it, returning their IDs
+	DeleteFriendGroup(ctx context.Context, ownerUserID string, groupID string) (friendUserIDs []string, err error)
+
+	// MoveFriendsGroup takes friends out of fromGroupID, if not empty, and puts them in toGroupID, if not empty
+	MoveFriendsGroup(ctx context.Context, ownerUserID string, friendUserIDs []string, fromGroupID string, toGroupID string) (err error)
+
+	// PageGroupFriends retrieves the friends in a friend group with pagination
+	PageGroupFriends(ctx context.Context, ownerUserID string, groupID string, pagination pagination.Pagination) (total int64, friends []*model.Friend, err error)
 }
 
 type friendDatabase struct {
 	friend        database.Friend
 	friendRequest database.FriendRequest
+	friendGroup   database.FriendGroup
 	tx            tx.Tx
 	cache         cache.FriendCache
 }
 
-func NewFriendDatabase(friend database.Friend, friendRequest database.FriendRequest, cache cache.FriendCache, tx tx.Tx) FriendDatabase {
-	return &friendDatabase{friend: friend, friendRequest: friendRequest, cache: cache, tx: tx}
+func NewFriendDatabase(friend database.Friend, friendRequest database.FriendRequest, friendGroup database.FriendGroup, cache cache.FriendCache, tx tx.Tx) FriendDatabase {
+	return &friendDatabase{friend: friend, friendRequest: friendRequest, friendGroup: friendGroup, cache: cache, tx: tx}
 }
 
 // CheckIn verifies if user2 is in user1's friend list (inUser1Friends returns true) and
@@ -203,6 +248,7 @@ func (f *friendDatabase) RefuseFriendRequest(ctx context.Context, friendRequest
 
 	// Mark the friend request as refused and update the handle time.
 	friendRequest.HandleResult = constant.FriendResponseRefuse
+	friendRequest.HandlerUserID = mcontext.GetOpUserID(ctx)
 	friendRequest.HandleTime = time.Now()
 	if err := f.friendRequest.Update(ctx, friendRequest); err != nil {
 		return fmt.Errorf("failed to update friend request from %s to %s as refused: %w", friendRequest.FromUserID, friendRequest.ToUserID, err)
@@ -334,9 +380,52 @@ func (f *friendDatabase) FindFriendUserIDs(ctx context.Context, ownerUserID stri
 	return f.cache.GetFriendIDs(ctx, ownerUserID)
 }
 
+func (f *friendDatabase) HasCommonFriend(ctx context.Context, userID1 string, userID2 string) (bool, error) {
+	return f.friend.HasCommonFriend(ctx, userID1, userID2)
+}
+
 func (f *friendDatabase) FindBothFriendRequests(ctx context.Context, fromUserID, toUserID string) (friends []*model.FriendRequest, err error) {
 	return f.friendRequest.FindBothFriendRequests(ctx, fromUserID, toUserID)
 }
+
+func (f *friendDatabase) FindExpiredFriendRequests(ctx context.Context, before time.Time, after *model.FriendRequest, limit int64) (friendRequests []*model.FriendRequest, err error) {
+	return f.friendRequest.FindExpired(ctx, before, after, limit)
+}
+
+func (f *friendDatabase) ExpireFriendRequest(ctx context.Context, fromUserID, toUserID string, handleMsg string) (err error) {
+	return f.tx.Transaction(ctx, func(ctx context.Context) error {
+		fr, err := f.friendRequest.Take(ctx, fromUserID, toUserID)
+		if err != nil {
+			return err
+		}
+		if fr.HandleResult != 0 {
+			return errs.ErrArgs.WrapMsg("friend request has already been processed", "fromUserID", fromUserID, "toUserID", toUserID)
+		}
+		m := map[string]any{
+			"handle_result":   constant.FriendResponseRefuse,
+			"handler_user_id": "",
+			"handle_msg":      handleMsg,
+			"handle_time":     time.Now(),
+		}
+		return f.friendRequest.UpdateByMap(ctx, fromUserID, toUserID, m)
+	})
+}
+
+func (f *friendDatabase) CountFriendsOfFriends(ctx context.Context, userIDs []string, limit int64) (map[string]int64, error) {
+	if len(userIDs) == 0 {
+		return map[string]int64{}, nil
+	}
+	return f.friend.CountFriendsOfOwners(ctx, userIDs, limit)
+}
+
+func (f *friendDatabase) FindPendingFriendRequestUserIDs(ctx context.Context, userID string) ([]string, error) {
+	return f.friendRequest.FindPendingUserIDs(ctx, userID)
+}
+
+func (f *friendDatabase) CountFriendRequestSenders(ctx context.Context, since time.Time, limit int64) (senders []*model.FriendRequestSender, err error) {
+	return f.friendRequest.CountSenders(ctx, since, limit)
+}
+
 func (f *friendDatabase) UpdateFriends(ctx context.Context, ownerUserID string, friendUserIDs []string, val map[string]any) (err error) {
 	if len(val) == 0 {
 		return nil
@@ -346,3 +435,102 @@ func (f *friendDatabase) UpdateFriends(ctx context.Context, ownerUserID string,
 	}
 	return f.cache.DelFriends(ownerUserID, friendUserIDs).ChainExecDel(ctx)
 }
+
+func (f *friendDatabase) CreateFriendGroup(ctx context.Context, group *model.FriendGroup) error {
+	return f.friendGroup.Create(ctx, group)
+}
+
+func (f *friendDatabase) TakeFriendGroup(ctx context.Context, ownerUserID string, groupID string) (*model.FriendGroup, error) {
+	return f.friendGroup.Take(ctx, ownerUserID, groupID)
+}
+
+func (f *friendDatabase) FindFriendGroups(ctx context.Context, ownerUserID string) ([]*model.FriendGroup, error) {
+	return f.friendGroup.Find(ctx, ownerUserID)
+}
+
+func (f *friendDatabase) UpdateFriendGroup(ctx context.Context, ownerUserID string, groupID string, val map[string]any) error {
+	return f.friendGroup.Update(ctx, ownerUserID, groupID, val)
+}
+
+func (f *friendDatabase) SortFriendGroups(ctx context.Context, ownerUserID string, orders map[string]int32) error {
+	return f.tx.Transaction(ctx, func(ctx context.Context) error {
+		for groupID, order := range orders {
+			if err := f.friendGroup.Update(ctx, ownerUserID, groupID, map[string]any{"order": order}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (f *friendDatabase) DeleteFriendGroup(ctx context.Context, ownerUserID string, groupID string) (friendUserIDs []string, err error) {
+	err = f.tx.Transaction(ctx, func(ctx context.Context) error {
+		if err := f.friendGroup.Delete(ctx, ownerUserID, groupID); err != nil {
+			return err
+		}
+		friendUserIDs, err = f.friend.FindGroupFriendUserIDs(ctx, ownerUserID, groupID)
+		if err != nil {
+			return err
+		}
+		return f.friend.RemoveGroup(ctx, ownerUserID, friendUserIDs, groupID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return friendUserIDs, f.cache.DelFriends(ownerUserID, friendUserIDs).ChainExecDel(ctx)
+}
+
+func (f *friendDatabase) MoveFriendsGroup(ctx context.Context, ownerUserID string, friendUserIDs []string, fromGroupID string, toGroupID string) error {
+	err := f.tx.Transaction(ctx, func(ctx context.Context) error {
+		if fromGroupID != "" {
+			if err := f.friend.RemoveGroup(ctx, ownerUserID, friendUserIDs, fromGroupID); err != nil {
+				return err
+			}
+		}
+		if toGroupID != "" {
+			return f.friend.AddGroup(ctx, ownerUserID, friendUserIDs, toGroupID)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	return f.cache.DelFriends(ownerUserID, friendUserIDs).ChainExecDel(ctx)
+}
+
+func (f *friendDatabase) PageGroupFriends(ctx context.Context, ownerUserID string, groupID string, pagination pagination.Pagination) (total int64, friends []*model.Friend, err error) {
+	return f.friend.FindGroupFriends(ctx, ownerUserID, groupID, pagination)
+}
+
+func (f *friendDatabase) DeleteUserRelations(ctx context.Context, userID string) ([]string, error) {
+	friendUserIDs, err := f.friend.FindFriendUserIDs(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	ownerUserIDs, err := f.friend.FindOwnerUserIDs(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	err = f.tx.Transaction(ctx, func(ctx context.Context) error {
+		if err := f.friend.DeleteOwner(ctx, userID); err != nil {
+			return err
+		}
+		for _, ownerUserID := range ownerUserIDs {
+			if err := f.friend.Delete(ctx, ownerUserID, []string{userID}); err != nil {
+				return err
+			}
+		}
+		if err := f.friendRequest.DeleteUser(ctx, userID); err != nil {
+			return err
+		}
+		return f.friendGroup.DeleteOwner(ctx, userID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	changedUserIDs := datautil.Distinct(append(friendUserIDs, ownerUserIDs...))
+	if err := f.cache.DelFriendIDs(append(changedUserIDs, userID)...).ChainExecDel(ctx); err != nil {
+		return nil, err
+	}
+	return changedUserIDs, nil
+}
@@ -0,0 +1,63 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+)
+
+// FriendRequestLimitDatabase holds who can send friend requests to a user and how many a user can send.
+type FriendRequestLimitDatabase interface {
+	SetFriendAddSetting(ctx context.Context, setting *model.FriendAddSetting) error
+	TakeFriendAddSetting(ctx context.Context, userID string) (*model.FriendAddSetting, error)
+	DeleteFriendAddSetting(ctx context.Context, userID string) error
+	// AcquireFriendRequestQuota counts a friend request of the user, it reports false when the daily limit is reached.
+	AcquireFriendRequestQuota(ctx context.Context, userID string, limit int64) (bool, error)
+	// ReleaseFriendRequestQuota gives back a counted friend request that failed to be sent.
+	ReleaseFriendRequestQuota(ctx context.Context, userID string) error
+}
+
+func NewFriendRequestLimitDatabase(setting database.FriendAddSetting, quota cache.FriendRequestQuotaCache) FriendRequestLimitDatabase {
+	return &friendRequestLimitDatabase{setting: setting, quota: quota}
+}
+
+type friendRequestLimitDatabase struct {
+	setting database.FriendAddSetting
+	quota   cache.FriendRequestQuotaCache
+}
+
+func (f *friendRequestLimitDatabase) SetFriendAddSetting(ctx context.Context, setting *model.FriendAddSetting) error {
+	return f.setting.Set(ctx, setting)
+}
+
+func (f *friendRequestLimitDatabase) TakeFriendAddSetting(ctx context.Context, userID string) (*model.FriendAddSetting, error) {
+	return f.setting.Take(ctx, userID)
+}
+
+func (f *friendRequestLimitDatabase) DeleteFriendAddSetting(ctx context.Context, userID string) error {
+	return f.setting.Delete(ctx, userID)
+}
+
+func (f *friendRequestLimitDatabase) AcquireFriendRequestQuota(ctx context.Context, userID string, limit int64) (bool, error) {
+	return f.quota.AcquireFriendRequestQuota(ctx, userID, limit)
+}
+
+func (f *friendRequestLimitDatabase) ReleaseFriendRequestQuota(ctx context.Context, userID string) error {
+	return f.quota.ReleaseFriendRequestQuota(ctx, userID)
+}
@@ -0,0 +1,94 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/db/pagination"
+)
+
+type FriendSuggestionDatabase interface {
+	// SetFriendSuggestions replaces the precomputed suggestions of the user, they are valid until expire.
+	SetFriendSuggestions(ctx context.Context, userID string, suggestions []*model.FriendSuggestion, expire time.Duration) error
+	// PageFriendSuggestions returns a page of the suggestions of the user, computed is false when the user has no valid suggestions.
+	PageFriendSuggestions(ctx context.Context, userID string, pagination pagination.Pagination) (computed bool, total int64, suggestions []*model.FriendSuggestion, err error)
+	// DismissFriendSuggestion removes the user from the suggestions of the owner for good.
+	DismissFriendSuggestion(ctx context.Context, ownerUserID string, userID string) error
+	FindDismissedUserIDs(ctx context.Context, ownerUserID string) ([]string, error)
+	// DelFriendSuggestions removes the two users from the suggestions of each other.
+	DelFriendSuggestions(ctx context.Context, userID1 string, userID2 string) error
+	// MarkFriendSuggestionsStale queues the users to compute their suggestions again.
+	MarkFriendSuggestionsStale(ctx context.Context, userIDs []string) error
+	// PopStaleFriendSuggestionUsers dequeues up to count users to compute the suggestions of.
+	PopStaleFriendSuggestionUsers(ctx context.Context, count int64) ([]string, error)
+	// DeleteUserFriendSuggestions deletes the suggestions of the user and the dismissals made by or of the user.
+	DeleteUserFriendSuggestions(ctx context.Context, userID string) error
+}
+
+func NewFriendSuggestionDatabase(dismiss database.FriendSuggestionDismiss, cache cache.FriendSuggestionCache) FriendSuggestionDatabase {
+	return &friendSuggestionDatabase{dismiss: dismiss, cache: cache}
+}
+
+type friendSuggestionDatabase struct {
+	dismiss database.FriendSuggestionDismiss
+	cache   cache.FriendSuggestionCache
+}
+
+func (f *friendSuggestionDatabase) SetFriendSuggestions(ctx context.Context, userID string, suggestions []*model.FriendSuggestion, expire time.Duration) error {
+	return f.cache.SetFriendSuggestions(ctx, userID, suggestions, expire)
+}
+
+func (f *friendSuggestionDatabase) PageFriendSuggestions(ctx context.Context, userID string, pagination pagination.Pagination) (bool, int64, []*model.FriendSuggestion, error) {
+	start := int64(pagination.GetPageNumber()-1) * int64(pagination.GetShowNumber())
+	return f.cache.GetFriendSuggestions(ctx, userID, start, start+int64(pagination.GetShowNumber())-1)
+}
+
+func (f *friendSuggestionDatabase) DismissFriendSuggestion(ctx context.Context, ownerUserID string, userID string) error {
+	if err := f.dismiss.Create(ctx, &model.FriendSuggestionDismiss{OwnerUserID: ownerUserID, UserID: userID, CreateTime: time.Now()}); err != nil {
+		return err
+	}
+	return f.cache.DelFriendSuggestions(ctx, ownerUserID, []string{userID})
+}
+
+func (f *friendSuggestionDatabase) FindDismissedUserIDs(ctx context.Context, ownerUserID string) ([]string, error) {
+	return f.dismiss.FindUserIDs(ctx, ownerUserID)
+}
+
+func (f *friendSuggestionDatabase) DelFriendSuggestions(ctx context.Context, userID1 string, userID2 string) error {
+	if err := f.cache.DelFriendSuggestions(ctx, userID1, []string{userID2}); err != nil {
+		return err
+	}
+	return f.cache.DelFriendSuggestions(ctx, userID2, []string{userID1})
+}
+
+func (f *friendSuggestionDatabase) MarkFriendSuggestionsStale(ctx context.Context, userIDs []string) error {
+	return f.cache.AddStaleUsers(ctx, userIDs)
+}
+
+func (f *friendSuggestionDatabase) PopStaleFriendSuggestionUsers(ctx context.Context, count int64) ([]string, error) {
+	return f.cache.PopStaleUsers(ctx, count)
+}
+
+func (f *friendSuggestionDatabase) DeleteUserFriendSuggestions(ctx context.Context, userID string) error {
+	if err := f.dismiss.DeleteUser(ctx, userID); err != nil {
+		return err
+	}
+	return f.cache.DelUserFriendSuggestions(ctx, userID)
+}
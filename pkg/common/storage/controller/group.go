@@ -42,6 +42,14 @@ type GroupDatabase interface {
 	SearchGroup(ctx context.Context, keyword string, pagination pagination.Pagination) (int64, []*model.Group, error)
 	// UpdateGroup updates the properties of a group identified by its ID.
 	UpdateGroup(ctx context.Context, groupID string, data map[string]any) error
+	// SearchGroupDirectory searches the groups listed in the public directory.
+	SearchGroupDirectory(ctx context.Context, keyword string, category string, sort string, pagination pagination.Pagination) (int64, []*model.Group, error)
+	// FindGroupDirectoryIDs iterates the groups listed in the public directory.
+	FindGroupDirectoryIDs(ctx context.Context, afterGroupID string, limit int64) ([]string, error)
+	// FindCommunityGroups retrieves the channels of a community.
+	FindCommunityGroups(ctx context.Context, communityID string) ([]*model.Group, error)
+	// UpdateGroupDirectoryStats saves the member count and activity used to sort the directory.
+	UpdateGroupDirectoryStats(ctx context.Context, groupID string, memberCount int64, activity int64) error
 	// DismissGroup disbands a group and optionally removes its members based on the deleteMember flag.
 	DismissGroup(ctx context.Context, groupID string, deleteMember bool) error
 
@@ -63,6 +71,10 @@ type GroupDatabase interface {
 	FindGroupMemberUserID(ctx context.Context, groupID string) ([]string, error)
 	// FindGroupMemberNum retrieves the number of members in a group.
 	FindGroupMemberNum(ctx context.Context, groupID string) (uint32, error)
+	// FindJoinedGroupID retrieves the IDs of the groups a user joined.
+	FindJoinedGroupID(ctx context.Context, userID string) ([]string, error)
+	// TakeGroupSuccessor retrieves the member to hand the group over to when excludeUserID leaves, an admin before the earliest member.
+	TakeGroupSuccessor(ctx context.Context, groupID string, excludeUserID string) (*model.GroupMember, error)
 	// FindUserManagedGroupID retrieves group IDs managed by a user.
 	FindUserManagedGroupID(ctx context.Context, userID string) (groupIDs []string, err error)
 	// PageGroupRequest paginates through group requests for specified groups.
@@ -84,6 +96,9 @@ type GroupDatabase interface {
 	MapGroupMemberUserID(ctx context.Context, groupIDs []string) (map[string]*common.GroupSimpleUserID, error)
 	// MapGroupMemberNum maps group IDs to their member count.
 	MapGroupMemberNum(ctx context.Context, groupIDs []string) (map[string]uint32, error)
+	// CountSharedGroupMembers counts in how many of the groups of the user each other member is, up to limit
+	// members. Dismissed groups and groups with more than maxMembers members are not counted, 0 means no limit.
+	CountSharedGroupMembers(ctx context.Context, userID string, maxMembers int64, limit int64) (map[string]int64, error)
 	// TransferGroupOwner transfers the ownership of a group to another user.
 	TransferGroupOwner(ctx context.Context, groupID string, oldOwnerUserID, newOwnerUserID string, roleLevel int32) error
 	// UpdateGroupMember updates properties of a group member.
@@ -97,8 +112,13 @@ type GroupDatabase interface {
 	TakeGroupRequest(ctx context.Context, groupID string, userID string) (*model.GroupRequest, error)
 	// FindGroupRequests retrieves multiple group join requests.
 	FindGroupRequests(ctx context.Context, groupID string, userIDs []string) ([]*model.GroupRequest, error)
+	// FindExpiredGroupRequests retrieves the oldest pending group join requests sent before the given time, following
+	// after when it is not nil so requests failing to be handled are skipped.
+	FindExpiredGroupRequests(ctx context.Context, before time.Time, after *model.GroupRequest, limit int64) ([]*model.GroupRequest, error)
 	// PageGroupRequestUser paginates through group join requests made by a user.
 	PageGroupRequestUser(ctx context.Context, userID string, pagination pagination.Pagination) (int64, []*model.GroupRequest, error)
+	// DeleteUserGroupRequests deletes all group join requests made by a user.
+	DeleteUserGroupRequests(ctx context.Context, userID string) error
 
 	// CountTotal counts the total number of groups as of a certain date.
 	CountTotal(ctx context.Context, before *time.Time) (count int64, err error)
@@ -106,6 +126,15 @@ type GroupDatabase interface {
 	CountRangeEverydayTotal(ctx context.Context, start time.Time, end time.Time) (map[string]int64, error)
 	// DeleteGroupMemberHash deletes the hash entries for group members in specified groups.
 	DeleteGroupMemberHash(ctx context.Context, groupIDs []string) error
+
+	// CreateGroupRole creates a custom role in a group.
+	CreateGroupRole(ctx context.Context, role *model.GroupRole) error
+	// UpdateGroupRole renames a custom role and replaces its permissions.
+	UpdateGroupRole(ctx context.Context, groupID string, roleLevel int32, name string, permissions []string) error
+	// DeleteGroupRole removes a custom role from a group.
+	DeleteGroupRole(ctx context.Context, groupID string, roleLevel int32) error
+	// FindGroupRoles retrieves the custom roles of a group.
+	FindGroupRoles(ctx context.Context, groupID string) ([]*model.GroupRole, error)
 }
 
 func NewGroupDatabase(
@@ -114,6 +143,7 @@ func NewGroupDatabase(
 	groupDB database.Group,
 	groupMemberDB database.GroupMember,
 	groupRequestDB database.GroupRequest,
+	groupRoleDB database.GroupRole,
 	ctxTx tx.Tx,
 	groupHash cache.GroupHash,
 ) GroupDatabase {
@@ -121,8 +151,9 @@ func NewGroupDatabase(
 		groupDB:        groupDB,
 		groupMemberDB:  groupMemberDB,
 		groupRequestDB: groupRequestDB,
+		groupRoleDB:    groupRoleDB,
 		ctxTx:          ctxTx,
-		cache:          redis2.NewGroupCacheRedis(rdb, localCache, groupDB, groupMemberDB, groupRequestDB, groupHash, redis2.GetRocksCacheOptions()),
+		cache:          redis2.NewGroupCacheRedis(rdb, localCache, groupDB, groupMemberDB, groupRequestDB, groupRoleDB, groupHash, redis2.GetRocksCacheOptions()),
 	}
 }
 
@@ -130,6 +161,7 @@ type groupDatabase struct {
 	groupDB        database.Group
 	groupMemberDB  database.GroupMember
 	groupRequestDB database.GroupRequest
+	groupRoleDB    database.GroupRole
 	ctxTx          tx.Tx
 	cache          cache.GroupCache
 }
@@ -218,6 +250,22 @@ func (g *groupDatabase) SearchGroup(ctx context.Context, keyword string, paginat
 	return g.groupDB.Search(ctx, keyword, pagination)
 }
 
+func (g *groupDatabase) SearchGroupDirectory(ctx context.Context, keyword string, category string, sort string, pagination pagination.Pagination) (int64, []*model.Group, error) {
+	return g.groupDB.SearchDirectory(ctx, keyword, category, sort, pagination)
+}
+
+func (g *groupDatabase) FindGroupDirectoryIDs(ctx context.Context, afterGroupID string, limit int64) ([]string, error) {
+	return g.groupDB.FindDirectoryGroupIDs(ctx, afterGroupID, limit)
+}
+
+func (g *groupDatabase) FindCommunityGroups(ctx context.Context, communityID string) ([]*model.Group, error) {
+	return g.groupDB.FindByCommunity(ctx, communityID)
+}
+
+func (g *groupDatabase) UpdateGroupDirectoryStats(ctx context.Context, groupID string, memberCount int64, activity int64) error {
+	return g.UpdateGroup(ctx, groupID, map[string]any{"member_count": memberCount, "activity": activity})
+}
+
 func (g *groupDatabase) UpdateGroup(ctx context.Context, groupID string, data map[string]any) error {
 	if err := g.groupDB.UpdateMap(ctx, groupID, data); err != nil {
 		return err
@@ -258,6 +306,14 @@ func (g *groupDatabase) TakeGroupOwner(ctx context.Context, groupID string) (*mo
 	return g.cache.GetGroupOwner(ctx, groupID)
 }
 
+func (g *groupDatabase) FindJoinedGroupID(ctx context.Context, userID string) ([]string, error) {
+	return g.cache.GetJoinedGroupIDs(ctx, userID)
+}
+
+func (g *groupDatabase) TakeGroupSuccessor(ctx context.Context, groupID string, excludeUserID string) (*model.GroupMember, error) {
+	return g.groupMemberDB.TakeSuccessor(ctx, groupID, excludeUserID)
+}
+
 func (g *groupDatabase) FindUserManagedGroupID(ctx context.Context, userID string) (groupIDs []string, err error) {
 	return g.groupMemberDB.FindUserManagedGroupID(ctx, userID)
 }
@@ -355,6 +411,41 @@ func (g *groupDatabase) MapGroupMemberNum(ctx context.Context, groupIDs []string
 	return m, nil
 }
 
+func (g *groupDatabase) CountSharedGroupMembers(ctx context.Context, userID string, maxMembers int64, limit int64) (map[string]int64, error) {
+	groupIDs, err := g.cache.GetJoinedGroupIDs(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if len(groupIDs) == 0 {
+		return map[string]int64{}, nil
+	}
+	groups, err := g.cache.GetGroupsInfo(ctx, groupIDs)
+	if err != nil {
+		return nil, err
+	}
+	groupIDs = datautil.Filter(groups, func(e *model.Group) (string, bool) {
+		return e.GroupID, e.Status != constant.GroupStatusDismissed
+	})
+	if maxMembers > 0 && len(groupIDs) > 0 {
+		memberNums, err := g.groupMemberDB.CountMembers(ctx, groupIDs)
+		if err != nil {
+			return nil, err
+		}
+		groupIDs = datautil.Filter(groupIDs, func(groupID string) (string, bool) {
+			return groupID, memberNums[groupID] <= maxMembers
+		})
+	}
+	if len(groupIDs) == 0 {
+		return map[string]int64{}, nil
+	}
+	counts, err := g.groupMemberDB.CountUserGroups(ctx, groupIDs, limit+1)
+	if err != nil {
+		return nil, err
+	}
+	delete(counts, userID)
+	return counts, nil
+}
+
 func (g *groupDatabase) TransferGroupOwner(ctx context.Context, groupID string, oldOwnerUserID, newOwnerUserID string, roleLevel int32) error {
 	return g.ctxTx.Transaction(ctx, func(ctx context.Context) error {
 		if err := g.groupMemberDB.UpdateRoleLevel(ctx, groupID, oldOwnerUserID, roleLevel); err != nil {
@@ -417,6 +508,10 @@ func (g *groupDatabase) TakeGroupRequest(
 	return g.groupRequestDB.Take(ctx, groupID, userID)
 }
 
+func (g *groupDatabase) FindExpiredGroupRequests(ctx context.Context, before time.Time, after *model.GroupRequest, limit int64) ([]*model.GroupRequest, error) {
+	return g.groupRequestDB.FindExpired(ctx, before, after, limit)
+}
+
 func (g *groupDatabase) PageGroupRequestUser(ctx context.Context, userID string, pagination pagination.Pagination) (int64, []*model.GroupRequest, error) {
 	return g.groupRequestDB.Page(ctx, userID, pagination)
 }
@@ -433,6 +528,10 @@ func (g *groupDatabase) FindGroupRequests(ctx context.Context, groupID string, u
 	return g.groupRequestDB.FindGroupRequests(ctx, groupID, userIDs)
 }
 
+func (g *groupDatabase) DeleteUserGroupRequests(ctx context.Context, userID string) error {
+	return g.groupRequestDB.DeleteByUser(ctx, userID)
+}
+
 func (g *groupDatabase) DeleteGroupMemberHash(ctx context.Context, groupIDs []string) error {
 	if len(groupIDs) == 0 {
 		return nil
@@ -443,3 +542,28 @@ func (g *groupDatabase) DeleteGroupMemberHash(ctx context.Context, groupIDs []st
 	}
 	return c.ChainExecDel(ctx)
 }
+
+func (g *groupDatabase) CreateGroupRole(ctx context.Context, role *model.GroupRole) error {
+	if err := g.groupRoleDB.Create(ctx, role); err != nil {
+		return err
+	}
+	return g.cache.DelGroupRoles(role.GroupID).ChainExecDel(ctx)
+}
+
+func (g *groupDatabase) UpdateGroupRole(ctx context.Context, groupID string, roleLevel int32, name string, permissions []string) error {
+	if err := g.groupRoleDB.Update(ctx, groupID, roleLevel, name, permissions); err != nil {
+		return err
+	}
+	return g.cache.DelGroupRoles(groupID).ChainExecDel(ctx)
+}
+
+func (g *groupDatabase) DeleteGroupRole(ctx context.Context, groupID string, roleLevel int32) error {
+	if err := g.groupRoleDB.Delete(ctx, groupID, roleLevel); err != nil {
+		return err
+	}
+	return g.cache.DelGroupRoles(groupID).ChainExecDel(ctx)
+}
+
+func (g *groupDatabase) FindGroupRoles(ctx context.Context, groupID string) ([]*model.GroupRole, error) {
+	return g.cache.GetGroupRoles(ctx, groupID)
+}
@@ -0,0 +1,72 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+)
+
+type GroupInviteLinkDatabase interface {
+	CreateGroupInviteLink(ctx context.Context, link *model.GroupInviteLink) error
+	TakeGroupInviteLink(ctx context.Context, token string) (*model.GroupInviteLink, error)
+	FindGroupInviteLinks(ctx context.Context, groupID string, creatorUserID string) ([]*model.GroupInviteLink, error)
+	RevokeGroupInviteLink(ctx context.Context, token string) error
+	// RevokeUserGroupInviteLinks revokes the links of a group created by userIDs, used when they leave or lose their role.
+	RevokeUserGroupInviteLinks(ctx context.Context, groupID string, userIDs []string) error
+	// UseGroupInviteLink consumes one use of the link, it returns a not found error when the link is no longer usable.
+	UseGroupInviteLink(ctx context.Context, token string) error
+	// ReleaseGroupInviteLink gives back a use of the link when the join it was consumed for failed.
+	ReleaseGroupInviteLink(ctx context.Context, token string) error
+}
+
+func NewGroupInviteLinkDatabase(link database.GroupInviteLink) GroupInviteLinkDatabase {
+	return &groupInviteLinkDatabase{link: link}
+}
+
+type groupInviteLinkDatabase struct {
+	link database.GroupInviteLink
+}
+
+func (g *groupInviteLinkDatabase) CreateGroupInviteLink(ctx context.Context, link *model.GroupInviteLink) error {
+	return g.link.Create(ctx, link)
+}
+
+func (g *groupInviteLinkDatabase) TakeGroupInviteLink(ctx context.Context, token string) (*model.GroupInviteLink, error) {
+	return g.link.Take(ctx, token)
+}
+
+func (g *groupInviteLinkDatabase) FindGroupInviteLinks(ctx context.Context, groupID string, creatorUserID string) ([]*model.GroupInviteLink, error) {
+	return g.link.Find(ctx, groupID, creatorUserID)
+}
+
+func (g *groupInviteLinkDatabase) RevokeGroupInviteLink(ctx context.Context, token string) error {
+	return g.link.Revoke(ctx, token)
+}
+
+func (g *groupInviteLinkDatabase) RevokeUserGroupInviteLinks(ctx context.Context, groupID string, userIDs []string) error {
+	return g.link.RevokeByCreators(ctx, groupID, userIDs)
+}
+
+func (g *groupInviteLinkDatabase) UseGroupInviteLink(ctx context.Context, token string) error {
+	return g.link.IncrUse(ctx, token, time.Now())
+}
+
+func (g *groupInviteLinkDatabase) ReleaseGroupInviteLink(ctx context.Context, token string) error {
+	return g.link.DecrUse(ctx, token)
+}
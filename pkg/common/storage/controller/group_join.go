@@ -0,0 +1,60 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/db/pagination"
+)
+
+type GroupJoinDatabase interface {
+	SetGroupJoinQuestionnaire(ctx context.Context, questionnaire *model.GroupJoinQuestionnaire) error
+	TakeGroupJoinQuestionnaire(ctx context.Context, groupID string) (*model.GroupJoinQuestionnaire, error)
+	DeleteGroupJoinQuestionnaire(ctx context.Context, groupID string) error
+	CreateGroupRequestAudit(ctx context.Context, audit *model.GroupRequestAudit) error
+	PageGroupRequestAudits(ctx context.Context, groupID string, pagination pagination.Pagination) (int64, []*model.GroupRequestAudit, error)
+}
+
+func NewGroupJoinDatabase(questionnaire database.GroupJoinQuestionnaire, audit database.GroupRequestAudit) GroupJoinDatabase {
+	return &groupJoinDatabase{questionnaire: questionnaire, audit: audit}
+}
+
+type groupJoinDatabase struct {
+	questionnaire database.GroupJoinQuestionnaire
+	audit         database.GroupRequestAudit
+}
+
+func (g *groupJoinDatabase) SetGroupJoinQuestionnaire(ctx context.Context, questionnaire *model.GroupJoinQuestionnaire) error {
+	return g.questionnaire.Set(ctx, questionnaire)
+}
+
+func (g *groupJoinDatabase) TakeGroupJoinQuestionnaire(ctx context.Context, groupID string) (*model.GroupJoinQuestionnaire, error) {
+	return g.questionnaire.Take(ctx, groupID)
+}
+
+func (g *groupJoinDatabase) DeleteGroupJoinQuestionnaire(ctx context.Context, groupID string) error {
+	return g.questionnaire.Delete(ctx, groupID)
+}
+
+func (g *groupJoinDatabase) CreateGroupRequestAudit(ctx context.Context, audit *model.GroupRequestAudit) error {
+	return g.audit.Create(ctx, []*model.GroupRequestAudit{audit})
+}
+
+func (g *groupJoinDatabase) PageGroupRequestAudits(ctx context.Context, groupID string, pagination pagination.Pagination) (int64, []*model.GroupRequestAudit, error) {
+	return g.audit.Page(ctx, groupID, pagination)
+}
@@ -109,6 +109,10 @@ type CommonMsgDatabase interface {
 	// clear msg
 	GetBeforeMsg(ctx context.Context, ts int64, limit int) ([]*model.MsgDocModel, error)
 	DeleteDocMsgBefore(ctx context.Context, ts int64, doc *model.MsgDocModel) ([]int, error)
+
+	// AnonymizeUserMsgs replaces the sender information of the messages userID sent in the conversations.
+	// Cached messages keep the old information until they expire.
+	AnonymizeUserMsgs(ctx context.Context, userID string, conversationIDs []string, nickname string) (int64, error)
 }
 
 func NewCommonMsgDatabase(msgDocModel database.Msg, msg cache.MsgCache, seq cache.SeqCache, kafkaConf *config.Kafka) (CommonMsgDatabase, error) {
@@ -1055,6 +1059,18 @@ func (db *commonMsgDatabase) ConvertMsgsDocLen(ctx context.Context, conversation
 	db.msgDocDatabase.ConvertMsgsDocLen(ctx, conversationIDs)
 }
 
+func (db *commonMsgDatabase) AnonymizeUserMsgs(ctx context.Context, userID string, conversationIDs []string, nickname string) (int64, error) {
+	var count int64
+	for _, conversationID := range conversationIDs {
+		n, err := db.msgDocDatabase.AnonymizeSender(ctx, conversationID, userID, nickname)
+		if err != nil {
+			return 0, err
+		}
+		count += n
+	}
+	return count, nil
+}
+
 func (db *commonMsgDatabase) GetBeforeMsg(ctx context.Context, ts int64, limit int) ([]*model.MsgDocModel, error) {
 	return db.msgDocDatabase.GetBeforeMsg(ctx, ts, limit)
 }
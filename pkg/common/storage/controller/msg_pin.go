@@ -0,0 +1,59 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+)
+
+type MsgPinDatabase interface {
+	PinMsg(ctx context.Context, pin *model.MsgPin) error
+	UnpinMsgs(ctx context.Context, conversationID string, seqs []int64) error
+	// UnpinMsgsBefore removes the pins of the messages below minSeq, which were deleted physically.
+	UnpinMsgsBefore(ctx context.Context, conversationID string, minSeq int64) error
+	FindMsgPins(ctx context.Context, conversationID string) ([]*model.MsgPin, error)
+	CountMsgPins(ctx context.Context, conversationID string) (int64, error)
+}
+
+func NewMsgPinDatabase(pin database.MsgPin) MsgPinDatabase {
+	return &msgPinDatabase{pin: pin}
+}
+
+type msgPinDatabase struct {
+	pin database.MsgPin
+}
+
+func (m *msgPinDatabase) PinMsg(ctx context.Context, pin *model.MsgPin) error {
+	return m.pin.Create(ctx, pin)
+}
+
+func (m *msgPinDatabase) UnpinMsgs(ctx context.Context, conversationID string, seqs []int64) error {
+	return m.pin.Delete(ctx, conversationID, seqs)
+}
+
+func (m *msgPinDatabase) UnpinMsgsBefore(ctx context.Context, conversationID string, minSeq int64) error {
+	return m.pin.DeleteBefore(ctx, conversationID, minSeq)
+}
+
+func (m *msgPinDatabase) FindMsgPins(ctx context.Context, conversationID string) ([]*model.MsgPin, error) {
+	return m.pin.Find(ctx, conversationID)
+}
+
+func (m *msgPinDatabase) CountMsgPins(ctx context.Context, conversationID string) (int64, error) {
+	return m.pin.Count(ctx, conversationID)
+}
@@ -0,0 +1,121 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/db/pagination"
+	"github.com/openimsdk/tools/utils/datautil"
+)
+
+// pollTallyVoterLimit is the number of voters listed with the tally of an option, the others are paged through.
+const pollTallyVoterLimit = 20
+
+type PollDatabase interface {
+	CreatePoll(ctx context.Context, poll *model.Poll) error
+	TakePoll(ctx context.Context, pollID string) (*model.Poll, error)
+	FindPolls(ctx context.Context, pollIDs []string) ([]*model.Poll, error)
+	DeletePoll(ctx context.Context, pollID string) error
+	FindExpiredPolls(ctx context.Context, limit int64) ([]*model.Poll, error)
+	ClosePolls(ctx context.Context, pollIDs []string) error
+	SetPollVote(ctx context.Context, vote *model.PollVote) error
+	DeletePollVote(ctx context.Context, pollID string, userID string) error
+	FindUserPollVotes(ctx context.Context, userID string, pollIDs []string) ([]*model.PollVote, error)
+	// TallyPolls returns the number of voters and the votes of every option that received any, by pollID.
+	TallyPolls(ctx context.Context, pollIDs []string) (map[string]int64, map[string][]*model.PollTally, error)
+	// PagePollVoters returns the users who voted for an option, the earliest first.
+	PagePollVoters(ctx context.Context, pollID string, optionID string, pagination pagination.Pagination) (int64, []string, error)
+}
+
+func NewPollDatabase(poll database.Poll) PollDatabase {
+	return &pollDatabase{poll: poll}
+}
+
+type pollDatabase struct {
+	poll database.Poll
+}
+
+func (p *pollDatabase) CreatePoll(ctx context.Context, poll *model.Poll) error {
+	return p.poll.Create(ctx, poll)
+}
+
+func (p *pollDatabase) TakePoll(ctx context.Context, pollID string) (*model.Poll, error) {
+	return p.poll.Take(ctx, pollID)
+}
+
+func (p *pollDatabase) FindPolls(ctx context.Context, pollIDs []string) ([]*model.Poll, error) {
+	return p.poll.Find(ctx, pollIDs)
+}
+
+func (p *pollDatabase) DeletePoll(ctx context.Context, pollID string) error {
+	return p.poll.Delete(ctx, pollID)
+}
+
+func (p *pollDatabase) FindExpiredPolls(ctx context.Context, limit int64) ([]*model.Poll, error) {
+	return p.poll.FindExpired(ctx, time.Now(), limit)
+}
+
+func (p *pollDatabase) ClosePolls(ctx context.Context, pollIDs []string) error {
+	return p.poll.Close(ctx, pollIDs)
+}
+
+func (p *pollDatabase) SetPollVote(ctx context.Context, vote *model.PollVote) error {
+	return p.poll.SetVote(ctx, vote)
+}
+
+func (p *pollDatabase) DeletePollVote(ctx context.Context, pollID string, userID string) error {
+	return p.poll.DeleteVote(ctx, pollID, userID)
+}
+
+func (p *pollDatabase) FindUserPollVotes(ctx context.Context, userID string, pollIDs []string) ([]*model.PollVote, error) {
+	return p.poll.FindUserVotes(ctx, userID, pollIDs)
+}
+
+func (p *pollDatabase) TallyPolls(ctx context.Context, pollIDs []string) (map[string]int64, map[string][]*model.PollTally, error) {
+	if len(pollIDs) == 0 {
+		return nil, nil, nil
+	}
+	counts, err := p.poll.CountVoters(ctx, pollIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+	tallies, err := p.poll.Tally(ctx, pollIDs, pollTallyVoterLimit)
+	if err != nil {
+		return nil, nil, err
+	}
+	voterCounts := make(map[string]int64, len(counts))
+	for _, count := range counts {
+		voterCounts[count.PollID] = count.Count
+	}
+	pollTallies := make(map[string][]*model.PollTally, len(pollIDs))
+	for _, tally := range tallies {
+		pollTallies[tally.PollID] = append(pollTallies[tally.PollID], tally)
+	}
+	return voterCounts, pollTallies, nil
+}
+
+func (p *pollDatabase) PagePollVoters(ctx context.Context, pollID string, optionID string, pagination pagination.Pagination) (int64, []string, error) {
+	total, votes, err := p.poll.PageVoters(ctx, pollID, optionID, pagination)
+	if err != nil {
+		return 0, nil, err
+	}
+	return total, datautil.Slice(votes, func(e *model.PollVote) string {
+		return e.UserID
+	}), nil
+}
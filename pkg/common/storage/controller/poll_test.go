@@ -0,0 +1,57 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+)
+
+type pollStub struct {
+	database.Poll
+	voterLimit int
+}
+
+func (p *pollStub) CountVoters(ctx context.Context, pollIDs []string) ([]*model.PollVoterCount, error) {
+	return []*model.PollVoterCount{{PollID: "p1", Count: 3}}, nil
+}
+
+func (p *pollStub) Tally(ctx context.Context, pollIDs []string, voterLimit int) ([]*model.PollTally, error) {
+	p.voterLimit = voterLimit
+	return []*model.PollTally{
+		{PollID: "p1", OptionID: "1", Count: 2},
+		{PollID: "p1", OptionID: "2", Count: 1},
+	}, nil
+}
+
+func TestTallyPolls(t *testing.T) {
+	stub := &pollStub{}
+	voterCounts, tallies, err := NewPollDatabase(stub).TallyPolls(context.Background(), []string{"p1", "p2"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stub.voterLimit != pollTallyVoterLimit {
+		t.Errorf("voter limit %d", stub.voterLimit)
+	}
+	if voterCounts["p1"] != 3 || voterCounts["p2"] != 0 {
+		t.Errorf("voter counts %v", voterCounts)
+	}
+	if len(tallies["p1"]) != 2 || len(tallies["p2"]) != 0 {
+		t.Errorf("tallies %v", tallies)
+	}
+}
@@ -38,20 +38,28 @@ type S3Database interface {
 	SetObject(ctx context.Context, info *model.Object) error
 	StatObject(ctx context.Context, name string) (*s3.ObjectInfo, error)
 	FormData(ctx context.Context, name string, size int64, contentType string, duration time.Duration) (*s3.FormData, error)
+	// DeleteUserObjects deletes up to limit objects of the user, the stored file of an object is removed
+	// once no other object refers to it. It returns the number of objects deleted.
+	DeleteUserObjects(ctx context.Context, userID string, limit int64) (int, error)
 }
 
 func NewS3Database(rdb redis.UniversalClient, s3 s3.Interface, obj database.ObjectInfo) S3Database {
+	s3cache := redis2.NewS3Cache(rdb, s3)
 	return &s3Database{
-		s3:    cont.New(redis2.NewS3Cache(rdb, s3), s3),
-		cache: redis2.NewObjectCacheRedis(rdb, obj),
-		db:    obj,
+		s3:      cont.New(s3cache, s3),
+		impl:    s3,
+		s3cache: s3cache,
+		cache:   redis2.NewObjectCacheRedis(rdb, obj),
+		db:      obj,
 	}
 }
 
 type s3Database struct {
-	s3    *cont.Controller
-	cache cache.ObjectCache
-	db    database.ObjectInfo
+	s3      *cont.Controller
+	impl    s3.Interface
+	s3cache cont.S3Cache
+	cache   cache.ObjectCache
+	db      database.ObjectInfo
 }
 
 func (s *s3Database) PartSize(ctx context.Context, size int64) (int64, error) {
@@ -111,3 +119,34 @@ func (s *s3Database) StatObject(ctx context.Context, name string) (*s3.ObjectInf
 func (s *s3Database) FormData(ctx context.Context, name string, size int64, contentType string, duration time.Duration) (*s3.FormData, error) {
 	return s.s3.FormData(ctx, name, size, contentType, duration)
 }
+
+func (s *s3Database) DeleteUserObjects(ctx context.Context, userID string, limit int64) (int, error) {
+	engine := s.s3.Engine()
+	objs, err := s.db.FindUserObjects(ctx, engine, userID, limit)
+	if err != nil {
+		return 0, err
+	}
+	for _, obj := range objs {
+		// files are stored by hash, the same file may be referred to by the objects of other users.
+		// The file goes first so that a failed call leaves the object to be deleted by the next one.
+		count, err := s.db.CountKey(ctx, engine, obj.Key)
+		if err != nil {
+			return 0, err
+		}
+		if count <= 1 {
+			if err := s.impl.DeleteObject(ctx, obj.Key); err != nil && !s.impl.IsNotFound(err) {
+				return 0, err
+			}
+			if err := s.s3cache.DelS3Key(ctx, engine, obj.Key); err != nil {
+				return 0, err
+			}
+		}
+		if err := s.db.Delete(ctx, engine, obj.Name); err != nil {
+			return 0, err
+		}
+		if err := s.cache.DelObjectName(engine, obj.Name).ChainExecDel(ctx); err != nil {
+			return 0, err
+		}
+	}
+	return len(objs), nil
+}
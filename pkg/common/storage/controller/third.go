@@ -21,6 +21,7 @@ import (
 	"time"
 
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
+	"github.com/openimsdk/protocol/constant"
 	"github.com/openimsdk/tools/db/pagination"
 )
 
@@ -32,6 +33,8 @@ type ThirdDatabase interface {
 	DeleteLogs(ctx context.Context, logID []string, userID string) error
 	SearchLogs(ctx context.Context, keyword string, start time.Time, end time.Time, pagination pagination.Pagination) (int64, []*model.Log, error)
 	GetLogs(ctx context.Context, LogIDs []string, userID string) ([]*model.Log, error)
+	// DeleteUserData deletes the push tokens, the badge and the uploaded logs of the user.
+	DeleteUserData(ctx context.Context, userID string) error
 }
 
 type thirdDatabase struct {
@@ -70,3 +73,15 @@ func (t *thirdDatabase) FcmUpdateToken(ctx context.Context, account string, plat
 func (t *thirdDatabase) SetAppBadge(ctx context.Context, userID string, value int) error {
 	return t.cache.SetUserBadgeUnreadCountSum(ctx, userID, value)
 }
+
+func (t *thirdDatabase) DeleteUserData(ctx context.Context, userID string) error {
+	for platformID := range constant.PlatformID2Name {
+		if err := t.cache.DelFcmToken(ctx, userID, platformID); err != nil {
+			return err
+		}
+	}
+	if err := t.cache.SetUserBadgeUnreadCountSum(ctx, userID, 0); err != nil {
+		return err
+	}
+	return t.logdb.DeleteUser(ctx, userID)
+}
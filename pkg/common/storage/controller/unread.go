@@ -0,0 +1,161 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/msgprocessor"
+	"github.com/openimsdk/protocol/sdkws"
+)
+
+type UnreadDatabase interface {
+	// IncrUnreadCounts counts new messages of a conversation as unread for the members of the conversation other
+	// than their senders, the messages must have their seqs. Senders have read the conversation up to their last message.
+	IncrUnreadCounts(ctx context.Context, conversationID string, msgs []*sdkws.MsgData, userIDs []string) error
+	// ResetUnreadCount recounts the unread messages of the user after hasReadSeq.
+	ResetUnreadCount(ctx context.Context, userID string, conversationID string, hasReadSeq int64, maxSeq int64) error
+	// RebuildUnreadCounts recounts the unread messages of all conversations of the user and returns their sum,
+	// used for users whose counts were never built. k: conversation, v: seq
+	RebuildUnreadCounts(ctx context.Context, userID string, hasReadSeqs map[string]int64, maxSeqs map[string]int64) (int64, error)
+	// DeleteUnreadSeqs stops counting the unread messages the user deleted, recounts leave them out too.
+	DeleteUnreadSeqs(ctx context.Context, userID string, conversationID string, hasReadSeq int64, seqs []int64) error
+	// k: conversation, v: count
+	GetUnreadCounts(ctx context.Context, userID string, conversationIDs []string) (map[string]int64, error)
+	// k: user, v: sum of the counts, users whose counts were never built are left out
+	GetUnreadCountSums(ctx context.Context, userIDs []string) (map[string]int64, error)
+}
+
+func NewUnreadDatabase(cache cache.UnreadCache) UnreadDatabase {
+	return &unreadDatabase{cache: cache}
+}
+
+type unreadDatabase struct {
+	cache cache.UnreadCache
+}
+
+func (u *unreadDatabase) IncrUnreadCounts(ctx context.Context, conversationID string, msgs []*sdkws.MsgData, userIDs []string) error {
+	var (
+		total int64
+		seqs  = make(map[int64]string)
+		// counted messages after the last message of each sender
+		afterSent = make(map[string]int64)
+	)
+	for _, msg := range msgs {
+		afterSent[msg.SendID] = 0
+		if !msgprocessor.Options(msg.Options).IsUnreadCount() {
+			continue
+		}
+		total++
+		seqs[msg.Seq] = msg.SendID
+		for userID := range afterSent {
+			if userID != msg.SendID {
+				afterSent[userID]++
+			}
+		}
+	}
+	if err := u.cache.AddUnreadSeqs(ctx, conversationID, seqs); err != nil {
+		return err
+	}
+	incr := make(map[string]int64)
+	set := make(map[string]int64)
+	for _, userID := range userIDs {
+		if count, ok := afterSent[userID]; ok {
+			set[userID] = count
+		} else if total > 0 {
+			incr[userID] = total
+		}
+	}
+	return u.cache.UpdateUnreadCounts(ctx, conversationID, incr, set)
+}
+
+// countUnread counts the unread messages of the user after hasReadSeq but the ones they deleted. Messages older than the recorded ones
+// can't be told apart and are all counted, as the seqs did before the counts were kept.
+func (u *unreadDatabase) countUnread(ctx context.Context, userID string, conversationID string, hasReadSeq int64, maxSeq int64) (int64, error) {
+	if hasReadSeq >= maxSeq {
+		return 0, nil
+	}
+	count, oldest, err := u.cache.CountUnreadSeqs(ctx, conversationID, userID, hasReadSeq)
+	if err != nil {
+		return 0, err
+	}
+	if oldest == 0 || oldest > maxSeq {
+		oldest = maxSeq + 1
+	}
+	if gap := oldest - 1 - hasReadSeq; gap > 0 {
+		count += gap
+	}
+	return count, nil
+}
+
+func (u *unreadDatabase) ResetUnreadCount(ctx context.Context, userID string, conversationID string, hasReadSeq int64, maxSeq int64) error {
+	count, err := u.countUnread(ctx, userID, conversationID, hasReadSeq, maxSeq)
+	if err != nil {
+		return err
+	}
+	return u.cache.UpdateUnreadCounts(ctx, conversationID, nil, map[string]int64{userID: count})
+}
+
+func (u *unreadDatabase) RebuildUnreadCounts(ctx context.Context, userID string, hasReadSeqs map[string]int64, maxSeqs map[string]int64) (int64, error) {
+	var sum int64
+	counts := make(map[string]int64, len(maxSeqs))
+	for conversationID, maxSeq := range maxSeqs {
+		count, err := u.countUnread(ctx, userID, conversationID, hasReadSeqs[conversationID], maxSeq)
+		if err != nil {
+			return 0, err
+		}
+		counts[conversationID] = count
+		sum += count
+	}
+	if err := u.cache.InitUnreadCounts(ctx, userID, counts); err != nil {
+		return 0, err
+	}
+	return sum, nil
+}
+
+func (u *unreadDatabase) DeleteUnreadSeqs(ctx context.Context, userID string, conversationID string, hasReadSeq int64, seqs []int64) error {
+	var unreadSeqs []int64
+	for _, seq := range seqs {
+		if seq > hasReadSeq {
+			unreadSeqs = append(unreadSeqs, seq)
+		}
+	}
+	if len(unreadSeqs) == 0 {
+		return nil
+	}
+	senders, err := u.cache.GetUnreadSeqSenders(ctx, conversationID, unreadSeqs)
+	if err != nil {
+		return err
+	}
+	deleted := make([]int64, 0, len(senders))
+	for seq, sendID := range senders {
+		if sendID != userID {
+			deleted = append(deleted, seq)
+		}
+	}
+	if err := u.cache.AddDeletedUnreadSeqs(ctx, conversationID, userID, hasReadSeq, deleted); err != nil {
+		return err
+	}
+	return u.cache.DecrUnreadCount(ctx, userID, conversationID, int64(len(deleted)))
+}
+
+func (u *unreadDatabase) GetUnreadCounts(ctx context.Context, userID string, conversationIDs []string) (map[string]int64, error) {
+	return u.cache.GetUnreadCounts(ctx, userID, conversationIDs)
+}
+
+func (u *unreadDatabase) GetUnreadCountSums(ctx context.Context, userIDs []string) (map[string]int64, error) {
+	return u.cache.GetUnreadCountSums(ctx, userIDs)
+}
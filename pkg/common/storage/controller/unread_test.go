@@ -0,0 +1,191 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/protocol/sdkws"
+)
+
+type unreadCacheStub struct {
+	// seqs of the "g" conversation, other conversations have none recorded
+	seqs   map[int64]string
+	counts map[string]int64
+	// k: user, v: counts set by InitUnreadCounts
+	inits map[string]map[string]int64
+	// k: user, v: seqs of "g" the user deleted
+	deleted map[string]map[int64]struct{}
+}
+
+func (u *unreadCacheStub) AddUnreadSeqs(ctx context.Context, conversationID string, seqs map[int64]string) error {
+	for seq, sendID := range seqs {
+		u.seqs[seq] = sendID
+	}
+	return nil
+}
+
+func (u *unreadCacheStub) CountUnreadSeqs(ctx context.Context, conversationID string, userID string, seq int64) (int64, int64, error) {
+	if conversationID != "g" {
+		return 0, 0, nil
+	}
+	var count, oldest int64
+	for s, sendID := range u.seqs {
+		if _, ok := u.deleted[userID][s]; !ok && s > seq && sendID != userID {
+			count++
+		}
+		if oldest == 0 || s < oldest {
+			oldest = s
+		}
+	}
+	return count, oldest, nil
+}
+
+func (u *unreadCacheStub) AddDeletedUnreadSeqs(ctx context.Context, conversationID string, userID string, hasReadSeq int64, seqs []int64) error {
+	if u.deleted[userID] == nil {
+		u.deleted[userID] = make(map[int64]struct{})
+	}
+	for _, seq := range seqs {
+		u.deleted[userID][seq] = struct{}{}
+	}
+	return nil
+}
+
+func (u *unreadCacheStub) GetUnreadSeqSenders(ctx context.Context, conversationID string, seqs []int64) (map[int64]string, error) {
+	senders := make(map[int64]string)
+	for _, seq := range seqs {
+		if sendID, ok := u.seqs[seq]; ok {
+			senders[seq] = sendID
+		}
+	}
+	return senders, nil
+}
+
+func (u *unreadCacheStub) UpdateUnreadCounts(ctx context.Context, conversationID string, incr map[string]int64, set map[string]int64) error {
+	for userID, count := range incr {
+		u.counts[userID] += count
+	}
+	for userID, count := range set {
+		u.counts[userID] = count
+	}
+	return nil
+}
+
+func (u *unreadCacheStub) DecrUnreadCount(ctx context.Context, userID string, conversationID string, count int64) error {
+	u.counts[userID] = max(u.counts[userID]-count, 0)
+	return nil
+}
+
+func (u *unreadCacheStub) GetUnreadCounts(ctx context.Context, userID string, conversationIDs []string) (map[string]int64, error) {
+	return map[string]int64{conversationIDs[0]: u.counts[userID]}, nil
+}
+
+func (u *unreadCacheStub) InitUnreadCounts(ctx context.Context, userID string, counts map[string]int64) error {
+	u.inits[userID] = counts
+	return nil
+}
+
+func (u *unreadCacheStub) GetUnreadCountSums(ctx context.Context, userIDs []string) (map[string]int64, error) {
+	sums := make(map[string]int64)
+	for _, userID := range userIDs {
+		if _, ok := u.inits[userID]; ok {
+			sums[userID] = u.counts[userID]
+		}
+	}
+	return sums, nil
+}
+
+func TestUnreadCounts(t *testing.T) {
+	ctx := context.Background()
+	stub := &unreadCacheStub{
+		seqs:    make(map[int64]string),
+		counts:  map[string]int64{"a": 1},
+		inits:   make(map[string]map[string]int64),
+		deleted: make(map[string]map[int64]struct{}),
+	}
+	db := NewUnreadDatabase(stub)
+	msgs := []*sdkws.MsgData{
+		{SendID: "a", Seq: 2},
+		{SendID: "b", Seq: 3},
+		{SendID: "b", Seq: 4, Options: map[string]bool{constant.IsUnreadCount: false}},
+		{SendID: "c", Seq: 5},
+	}
+	if err := db.IncrUnreadCounts(ctx, "g", msgs, []string{"a", "b", "c", "d"}); err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]int64{"a": 2, "b": 1, "c": 0, "d": 3}
+	for userID, count := range expected {
+		if stub.counts[userID] != count {
+			t.Errorf("user %s unread %d, expected %d", userID, stub.counts[userID], count)
+		}
+	}
+	if err := db.ResetUnreadCount(ctx, "d", "g", 3, 5); err != nil {
+		t.Fatal(err)
+	}
+	if stub.counts["d"] != 1 {
+		t.Errorf("user d unread %d after reading seq 3, expected 1", stub.counts["d"])
+	}
+	if err := db.DeleteUnreadSeqs(ctx, "a", "g", 2, []int64{1, 3, 4}); err != nil {
+		t.Fatal(err)
+	}
+	if stub.counts["a"] != 1 {
+		t.Errorf("user a unread %d after deleting seq 3, expected 1", stub.counts["a"])
+	}
+	if err := db.DeleteUnreadSeqs(ctx, "d", "g", 1, []int64{5}); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.ResetUnreadCount(ctx, "d", "g", 2, 5); err != nil {
+		t.Fatal(err)
+	}
+	if stub.counts["d"] != 1 {
+		t.Errorf("user d unread %d after deleting seq 5 and reading seq 2, expected 1", stub.counts["d"])
+	}
+}
+
+func TestRebuildUnreadCounts(t *testing.T) {
+	ctx := context.Background()
+	stub := &unreadCacheStub{
+		seqs:    map[int64]string{3: "b", 4: "a", 5: "b"},
+		counts:  make(map[string]int64),
+		inits:   make(map[string]map[string]int64),
+		deleted: make(map[string]map[int64]struct{}),
+	}
+	db := NewUnreadDatabase(stub)
+	sums, err := db.GetUnreadCountSums(ctx, []string{"a"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := sums["a"]; ok {
+		t.Fatal("user a has a sum before the counts were built")
+	}
+	// seqs 1 and 2 of "g" were sent before the counts were kept, "s" has no recorded seqs at all
+	sum, err := db.RebuildUnreadCounts(ctx, "a",
+		map[string]int64{"g": 1, "s": 7, "r": 2},
+		map[string]int64{"g": 5, "s": 10, "r": 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]int64{"g": 3, "s": 3, "r": 0}
+	for conversationID, count := range expected {
+		if stub.inits["a"][conversationID] != count {
+			t.Errorf("conversation %s unread %d, expected %d", conversationID, stub.inits["a"][conversationID], count)
+		}
+	}
+	if sum != 6 {
+		t.Errorf("unread sum %d, expected 6", sum)
+	}
+}
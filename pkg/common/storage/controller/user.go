@@ -73,6 +73,10 @@ type UserDatabase interface {
 	// SetUserStatus Set the user status and store the user status in redis
 	SetUserStatus(ctx context.Context, userID string, status, platformID int32) error
 
+	// AnonymizeUser clears the profile, commands and subscriptions of a deleted user and marks it deleted,
+	// the row is kept for the messages it sent.
+	AnonymizeUser(ctx context.Context, userID string, nickname string) error
+
 	// CRUD user command
 	AddUserCommand(ctx context.Context, userID string, Type int32, UUID string, value string, ex string) error
 	DeleteUserCommand(ctx context.Context, userID string, Type int32, UUID string) error
@@ -166,6 +170,16 @@ func (u *userDatabase) UpdateByMap(ctx context.Context, userID string, args map[
 	})
 }
 
+func (u *userDatabase) AnonymizeUser(ctx context.Context, userID string, nickname string) error {
+	if err := u.mongoDB.DeleteUser(ctx, userID); err != nil {
+		return err
+	}
+	if err := u.userDB.DeleteAllUserCommands(ctx, userID); err != nil {
+		return err
+	}
+	return u.UpdateByMap(ctx, userID, map[string]any{"nickname": nickname, "face_url": "", "ex": "", "status": model.UserStatusDeleted})
+}
+
 // Page Gets, returns no error if not found.
 func (u *userDatabase) Page(ctx context.Context, pagination pagination.Pagination) (count int64, users []*model.User, err error) {
 	return u.userDB.Page(ctx, pagination)
@@ -0,0 +1,93 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+)
+
+// UserDeletionDatabase holds the account deletions and data exports requested by users.
+type UserDeletionDatabase interface {
+	CreateUserDeletion(ctx context.Context, deletion *model.UserDeletion) error
+	TakeUserDeletion(ctx context.Context, userID string) (*model.UserDeletion, error)
+	// CancelUserDeletion removes the deletion of the user, it returns a not found error once the purge has started.
+	CancelUserDeletion(ctx context.Context, userID string) error
+	// AcquireUserDeletion takes the next deletion due and postpones it by retry in case the purge fails,
+	// it returns a not found error when there is none.
+	AcquireUserDeletion(ctx context.Context, retry time.Duration) (*model.UserDeletion, error)
+	// SetUserDeletionStatus returns a not found error when the user has no deletion.
+	SetUserDeletionStatus(ctx context.Context, userID string, status int32, errMsg string) error
+
+	CreateUserDataExport(ctx context.Context, export *model.UserDataExport) error
+	FindUserDataExports(ctx context.Context, userID string, limit int64) ([]*model.UserDataExport, error)
+	// AcquireUserDataExport leases the next pending export for lease, it returns a not found error when there is none.
+	AcquireUserDataExport(ctx context.Context, lease time.Duration) (*model.UserDataExport, error)
+	FinishUserDataExport(ctx context.Context, exportID string, status int32, object string, errMsg string) error
+	DeleteUserDataExports(ctx context.Context, userID string) error
+}
+
+func NewUserDeletionDatabase(deletion database.UserDeletion, export database.UserDataExport) UserDeletionDatabase {
+	return &userDeletionDatabase{deletion: deletion, export: export}
+}
+
+type userDeletionDatabase struct {
+	deletion database.UserDeletion
+	export   database.UserDataExport
+}
+
+func (u *userDeletionDatabase) CreateUserDeletion(ctx context.Context, deletion *model.UserDeletion) error {
+	return u.deletion.Create(ctx, deletion)
+}
+
+func (u *userDeletionDatabase) TakeUserDeletion(ctx context.Context, userID string) (*model.UserDeletion, error) {
+	return u.deletion.Take(ctx, userID)
+}
+
+func (u *userDeletionDatabase) CancelUserDeletion(ctx context.Context, userID string) error {
+	return u.deletion.DeletePending(ctx, userID, time.Now())
+}
+
+func (u *userDeletionDatabase) AcquireUserDeletion(ctx context.Context, retry time.Duration) (*model.UserDeletion, error) {
+	now := time.Now()
+	return u.deletion.Acquire(ctx, now, now.Add(retry))
+}
+
+func (u *userDeletionDatabase) SetUserDeletionStatus(ctx context.Context, userID string, status int32, errMsg string) error {
+	return u.deletion.SetStatus(ctx, userID, status, errMsg)
+}
+
+func (u *userDeletionDatabase) CreateUserDataExport(ctx context.Context, export *model.UserDataExport) error {
+	return u.export.Create(ctx, export)
+}
+
+func (u *userDeletionDatabase) FindUserDataExports(ctx context.Context, userID string, limit int64) ([]*model.UserDataExport, error) {
+	return u.export.FindByUser(ctx, userID, limit)
+}
+
+func (u *userDeletionDatabase) AcquireUserDataExport(ctx context.Context, lease time.Duration) (*model.UserDataExport, error) {
+	return u.export.Acquire(ctx, time.Now().Add(lease))
+}
+
+func (u *userDeletionDatabase) FinishUserDataExport(ctx context.Context, exportID string, status int32, object string, errMsg string) error {
+	return u.export.Finish(ctx, exportID, status, object, errMsg)
+}
+
+func (u *userDeletionDatabase) DeleteUserDataExports(ctx context.Context, userID string) error {
+	return u.export.DeleteByUser(ctx, userID)
+}
@@ -0,0 +1,34 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/db/pagination"
+)
+
+type APIKey interface {
+	Create(ctx context.Context, key *model.APIKey) error
+	Take(ctx context.Context, keyID string) (*model.APIKey, error)
+	UpdateStatus(ctx context.Context, keyID string, status int32) error
+	Page(ctx context.Context, pagination pagination.Pagination) (int64, []*model.APIKey, error)
+}
+
+type APIKeyAudit interface {
+	Create(ctx context.Context, audits []*model.APIKeyAudit) error
+	Page(ctx context.Context, keyID string, pagination pagination.Pagination) (int64, []*model.APIKeyAudit, error)
+}
@@ -28,4 +28,10 @@ type Black interface {
 	FindOwnerBlacks(ctx context.Context, ownerUserID string, pagination pagination.Pagination) (total int64, blacks []*model.Black, err error)
 	FindOwnerBlackInfos(ctx context.Context, ownerUserID string, userIDs []string) (blacks []*model.Black, err error)
 	FindBlackUserIDs(ctx context.Context, ownerUserID string) (blackUserIDs []string, err error)
+	// FindOwnerUserIDs finds the owners who have the user in their black list.
+	FindOwnerUserIDs(ctx context.Context, blockUserID string) (ownerUserIDs []string, err error)
+	// DeleteUser deletes the black list of the user and the user from every black list.
+	DeleteUser(ctx context.Context, userID string) (err error)
+	// FindReversalBlackOwnerIDs finds which of the owners have the user in their black list.
+	FindReversalBlackOwnerIDs(ctx context.Context, blockUserID string, ownerUserIDs []string) (ownerIDs []string, err error)
 }
@@ -0,0 +1,44 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/db/pagination"
+)
+
+type Broadcast interface {
+	Create(ctx context.Context, job *model.BroadcastJob) error
+	Take(ctx context.Context, jobID string) (*model.BroadcastJob, error)
+	Page(ctx context.Context, pagination pagination.Pagination) (int64, []*model.BroadcastJob, error)
+	// Acquire leases the oldest unfinished job that no worker holds a valid lease on.
+	Acquire(ctx context.Context, owner string, leaseExpire time.Time) (*model.BroadcastJob, error)
+	// UpdateProgress advances a running job held by owner, it fails with not found once the job was canceled or the lease lost.
+	UpdateProgress(ctx context.Context, jobID string, owner string, cursor int64, total int64, sent int64, failed int64, leaseExpire time.Time) error
+	// RenewLease extends the lease of owner on a running job, it fails with not found once the job was canceled or the lease lost.
+	RenewLease(ctx context.Context, jobID string, owner string, leaseExpire time.Time) error
+	Finish(ctx context.Context, jobID string, owner string) error
+	// Fail stops a running job held by owner that cannot be processed.
+	Fail(ctx context.Context, jobID string, owner string, errMsg string) error
+	Cancel(ctx context.Context, jobID string) error
+	AddRecipients(ctx context.Context, recipients []*model.BroadcastRecipient) error
+	// FindRecipients returns up to limit recipients of jobID starting at index.
+	FindRecipients(ctx context.Context, jobID string, index int64, limit int) ([]string, error)
+	AddFailed(ctx context.Context, failed []*model.BroadcastFailed) error
+	PageFailed(ctx context.Context, jobID string, pagination pagination.Pagination) (int64, []*model.BroadcastFailed, error)
+}
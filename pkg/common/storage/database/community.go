@@ -0,0 +1,43 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/db/pagination"
+)
+
+type Community interface {
+	Create(ctx context.Context, community *model.Community) error
+	Take(ctx context.Context, communityID string) (*model.Community, error)
+	Find(ctx context.Context, communityIDs []string) ([]*model.Community, error)
+	UpdateMap(ctx context.Context, communityID string, args map[string]any) error
+	Delete(ctx context.Context, communityID string) error
+}
+
+type CommunityMember interface {
+	Create(ctx context.Context, members []*model.CommunityMember) error
+	Delete(ctx context.Context, communityID string, userIDs []string) error
+	DeleteAll(ctx context.Context, communityID string) error
+	Take(ctx context.Context, communityID string, userID string) (*model.CommunityMember, error)
+	Find(ctx context.Context, communityID string, userIDs []string) ([]*model.CommunityMember, error)
+	UpdateRoleLevel(ctx context.Context, communityID string, userID string, roleLevel int32) error
+	FindMemberUserIDs(ctx context.Context, communityID string) ([]string, error)
+	Page(ctx context.Context, communityID string, pagination pagination.Pagination) (int64, []*model.CommunityMember, error)
+	Count(ctx context.Context, communityID string) (int64, error)
+	FindJoinedCommunityIDs(ctx context.Context, userID string) ([]string, error)
+}
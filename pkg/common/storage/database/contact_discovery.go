@@ -0,0 +1,40 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+)
+
+type ContactHash interface {
+	Create(ctx context.Context, hashes []*model.ContactHash) error
+	// DeleteUserType deletes the hashes of the user of the type.
+	DeleteUserType(ctx context.Context, userID string, typ int32) error
+	// DeleteUser deletes all hashes of the user.
+	DeleteUser(ctx context.Context, userID string) error
+	// DeleteHashes deletes the given hashes whoever they belong to.
+	DeleteHashes(ctx context.Context, hashes []string) error
+	Find(ctx context.Context, hashes []string) ([]*model.ContactHash, error)
+}
+
+type ContactDiscoverySetting interface {
+	// Set creates or replaces the setting of the user.
+	Set(ctx context.Context, setting *model.ContactDiscoverySetting) error
+	Take(ctx context.Context, userID string) (*model.ContactDiscoverySetting, error)
+	Find(ctx context.Context, userIDs []string) ([]*model.ContactDiscoverySetting, error)
+	Delete(ctx context.Context, userID string) error
+}
@@ -0,0 +1,31 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+)
+
+type ConversationDraft interface {
+	// Save upserts the drafts, drafts older than the stored ones are ignored.
+	Save(ctx context.Context, drafts []*model.ConversationDraft) error
+	// Delete removes the drafts not updated after the update time of the given ones.
+	Delete(ctx context.Context, drafts []*model.ConversationDraft) error
+	// Find returns the drafts of the conversations, all drafts of the user if conversationIDs is empty.
+	Find(ctx context.Context, ownerUserID string, conversationIDs []string) ([]*model.ConversationDraft, error)
+	DeleteByOwner(ctx context.Context, ownerUserID string) error
+}
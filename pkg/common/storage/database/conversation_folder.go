@@ -0,0 +1,32 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+)
+
+type ConversationFolder interface {
+	Create(ctx context.Context, folder *model.ConversationFolder) error
+	Take(ctx context.Context, ownerUserID string, folderID string) (*model.ConversationFolder, error)
+	Find(ctx context.Context, ownerUserID string) ([]*model.ConversationFolder, error)
+	Update(ctx context.Context, ownerUserID string, folderID string, data map[string]any) error
+	Delete(ctx context.Context, ownerUserID string, folderID string) error
+	DeleteByOwner(ctx context.Context, ownerUserID string) error
+	AddConversations(ctx context.Context, ownerUserID string, folderID string, conversationIDs []string) error
+	RemoveConversations(ctx context.Context, ownerUserID string, folderID string, conversationIDs []string) error
+}
@@ -0,0 +1,32 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+)
+
+type DoNotDisturb interface {
+	// Set replaces the quiet hours of the user, the muted conversations are kept.
+	Set(ctx context.Context, dnd *model.DoNotDisturb) error
+	Take(ctx context.Context, userID string) (*model.DoNotDisturb, error)
+	Find(ctx context.Context, userIDs []string) ([]*model.DoNotDisturb, error)
+	// MuteConversation mutes the conversation until the time, a past time unmutes it. Expired mutes are removed.
+	MuteConversation(ctx context.Context, userID string, conversationID string, muteUntil time.Time) error
+	Delete(ctx context.Context, userID string) error
+}
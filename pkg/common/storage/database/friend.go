@@ -42,8 +42,24 @@ type Friend interface {
 	FindOwnerFriends(ctx context.Context, ownerUserID string, pagination pagination.Pagination) (total int64, friends []*model.Friend, err error)
 	// FindInWhoseFriends finds users who have added the specified user as a friend, with pagination.
 	FindInWhoseFriends(ctx context.Context, friendUserID string, pagination pagination.Pagination) (total int64, friends []*model.Friend, err error)
+	// FindOwnerUserIDs retrieves the users who have added the specified user as a friend.
+	FindOwnerUserIDs(ctx context.Context, friendUserID string) (ownerUserIDs []string, err error)
+	// DeleteOwner removes all friends of the owner user.
+	DeleteOwner(ctx context.Context, ownerUserID string) (err error)
 	// FindFriendUserIDs retrieves a list of friend user IDs for a given owner.
 	FindFriendUserIDs(ctx context.Context, ownerUserID string) (friendUserIDs []string, err error)
+	// CountFriendsOfOwners counts of how many of the owners each user is a friend, the users with the most owners first.
+	CountFriendsOfOwners(ctx context.Context, ownerUserIDs []string, limit int64) (map[string]int64, error)
+	// HasCommonFriend reports whether the two owners have a friend in common.
+	HasCommonFriend(ctx context.Context, ownerUserID1 string, ownerUserID2 string) (bool, error)
 	// UpdateFriends update friends' fields
 	UpdateFriends(ctx context.Context, ownerUserID string, friendUserIDs []string, val map[string]any) (err error)
+	// AddGroup puts friends of the owner in a friend group.
+	AddGroup(ctx context.Context, ownerUserID string, friendUserIDs []string, groupID string) (err error)
+	// RemoveGroup takes friends of the owner out of a friend group.
+	RemoveGroup(ctx context.Context, ownerUserID string, friendUserIDs []string, groupID string) (err error)
+	// FindGroupFriendUserIDs retrieves the user IDs of the friends in a friend group.
+	FindGroupFriendUserIDs(ctx context.Context, ownerUserID string, groupID string) (friendUserIDs []string, err error)
+	// FindGroupFriends retrieves a paginated list of the friends in a friend group.
+	FindGroupFriends(ctx context.Context, ownerUserID string, groupID string, pagination pagination.Pagination) (total int64, friends []*model.Friend, err error)
 }
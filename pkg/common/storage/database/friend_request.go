@@ -16,6 +16,8 @@ package database
 
 import (
 	"context"
+	"time"
+
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
 	"github.com/openimsdk/tools/db/pagination"
 )
@@ -25,6 +27,8 @@ type FriendRequest interface {
 	Create(ctx context.Context, friendRequests []*model.FriendRequest) (err error)
 	// Delete record
 	Delete(ctx context.Context, fromUserID, toUserID string) (err error)
+	// DeleteUser deletes the requests sent by or to the user
+	DeleteUser(ctx context.Context, userID string) (err error)
 	// Update with zero values
 	UpdateByMap(ctx context.Context, formUserID string, toUserID string, args map[string]any) (err error)
 	// Update multiple records (non-zero values)
@@ -37,4 +41,10 @@ type FriendRequest interface {
 	// Get list of friend requests sent by fromUserID
 	FindFromUserID(ctx context.Context, fromUserID string, pagination pagination.Pagination) (total int64, friendRequests []*model.FriendRequest, err error)
 	FindBothFriendRequests(ctx context.Context, fromUserID, toUserID string) (friends []*model.FriendRequest, err error)
+	// FindPendingUserIDs returns the users with a pending request from or to the user.
+	FindPendingUserIDs(ctx context.Context, userID string) ([]string, error)
+	// FindExpired returns the oldest pending requests sent before the given time, following after when it is not nil.
+	FindExpired(ctx context.Context, before time.Time, after *model.FriendRequest, limit int64) ([]*model.FriendRequest, error)
+	// CountSenders counts the requests sent since the given time by sender, the senders with the most requests first.
+	CountSenders(ctx context.Context, since time.Time, limit int64) ([]*model.FriendRequestSender, error)
 }
@@ -28,6 +28,12 @@ type Group interface {
 	Find(ctx context.Context, groupIDs []string) (groups []*model.Group, err error)
 	Take(ctx context.Context, groupID string) (group *model.Group, err error)
 	Search(ctx context.Context, keyword string, pagination pagination.Pagination) (total int64, groups []*model.Group, err error)
+	// SearchDirectory searches the listed groups, sort is the field to sort by in descending order.
+	SearchDirectory(ctx context.Context, keyword string, category string, sort string, pagination pagination.Pagination) (total int64, groups []*model.Group, err error)
+	// FindDirectoryGroupIDs returns up to limit listed group IDs greater than afterGroupID in ascending order.
+	FindDirectoryGroupIDs(ctx context.Context, afterGroupID string, limit int64) ([]string, error)
+	// FindByCommunity returns the channels of a community.
+	FindByCommunity(ctx context.Context, communityID string) ([]*model.Group, error)
 	// Get Group total quantity
 	CountTotal(ctx context.Context, before *time.Time) (count int64, err error)
 	// Get Group total quantity every day
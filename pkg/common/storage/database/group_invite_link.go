@@ -0,0 +1,36 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+)
+
+type GroupInviteLink interface {
+	Create(ctx context.Context, link *model.GroupInviteLink) error
+	Take(ctx context.Context, token string) (*model.GroupInviteLink, error)
+	// Find returns the links of a group, all of them when creatorUserID is empty.
+	Find(ctx context.Context, groupID string, creatorUserID string) ([]*model.GroupInviteLink, error)
+	Revoke(ctx context.Context, token string) error
+	// RevokeByCreators revokes the links of a group created by creatorUserIDs.
+	RevokeByCreators(ctx context.Context, groupID string, creatorUserIDs []string) error
+	// IncrUse counts a use of a link still usable at now, it fails with not found otherwise.
+	IncrUse(ctx context.Context, token string, now time.Time) error
+	// DecrUse gives back a use counted by IncrUse.
+	DecrUse(ctx context.Context, token string) error
+}
@@ -0,0 +1,33 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/db/pagination"
+)
+
+type GroupJoinQuestionnaire interface {
+	Set(ctx context.Context, questionnaire *model.GroupJoinQuestionnaire) error
+	Take(ctx context.Context, groupID string) (*model.GroupJoinQuestionnaire, error)
+	Delete(ctx context.Context, groupID string) error
+}
+
+type GroupRequestAudit interface {
+	Create(ctx context.Context, audits []*model.GroupRequestAudit) error
+	Page(ctx context.Context, groupID string, pagination pagination.Pagination) (int64, []*model.GroupRequestAudit, error)
+}
@@ -28,10 +28,16 @@ type GroupMember interface {
 	FindMemberUserID(ctx context.Context, groupID string) (userIDs []string, err error)
 	Take(ctx context.Context, groupID string, userID string) (groupMember *model.GroupMember, err error)
 	TakeOwner(ctx context.Context, groupID string) (groupMember *model.GroupMember, err error)
+	// TakeSuccessor returns the member of the highest role level other than excludeUserID, the earliest joined first.
+	TakeSuccessor(ctx context.Context, groupID string, excludeUserID string) (*model.GroupMember, error)
 	SearchMember(ctx context.Context, keyword string, groupID string, pagination pagination.Pagination) (total int64, groupList []*model.GroupMember, err error)
 	FindRoleLevelUserIDs(ctx context.Context, groupID string, roleLevel int32) ([]string, error)
 	FindUserJoinedGroupID(ctx context.Context, userID string) (groupIDs []string, err error)
 	TakeGroupMemberNum(ctx context.Context, groupID string) (count int64, err error)
 	FindUserManagedGroupID(ctx context.Context, userID string) (groupIDs []string, err error)
+	// CountMembers counts the members of each group.
+	CountMembers(ctx context.Context, groupIDs []string) (map[string]int64, error)
+	// CountUserGroups counts in how many of the groups each member is, the members of the most groups first.
+	CountUserGroups(ctx context.Context, groupIDs []string, limit int64) (map[string]int64, error)
 	IsUpdateRoleLevel(data map[string]any) bool
 }
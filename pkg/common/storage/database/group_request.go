@@ -16,6 +16,8 @@ package database
 
 import (
 	"context"
+	"time"
+
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
 	"github.com/openimsdk/tools/db/pagination"
 )
@@ -23,9 +25,12 @@ import (
 type GroupRequest interface {
 	Create(ctx context.Context, groupRequests []*model.GroupRequest) (err error)
 	Delete(ctx context.Context, groupID string, userID string) (err error)
+	DeleteByUser(ctx context.Context, userID string) (err error)
 	UpdateHandler(ctx context.Context, groupID string, userID string, handledMsg string, handleResult int32) (err error)
 	Take(ctx context.Context, groupID string, userID string) (groupRequest *model.GroupRequest, err error)
 	FindGroupRequests(ctx context.Context, groupID string, userIDs []string) ([]*model.GroupRequest, error)
 	Page(ctx context.Context, userID string, pagination pagination.Pagination) (total int64, groups []*model.GroupRequest, err error)
 	PageGroup(ctx context.Context, groupIDs []string, pagination pagination.Pagination) (total int64, groups []*model.GroupRequest, err error)
+	// FindExpired returns the oldest pending requests sent before the given time, following after when it is not nil.
+	FindExpired(ctx context.Context, before time.Time, after *model.GroupRequest, limit int64) ([]*model.GroupRequest, error)
 }
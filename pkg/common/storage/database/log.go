@@ -26,4 +26,5 @@ type Log interface {
 	Search(ctx context.Context, keyword string, start time.Time, end time.Time, pagination pagination.Pagination) (int64, []*model.Log, error)
 	Delete(ctx context.Context, logID []string, userID string) error
 	Get(ctx context.Context, logIDs []string, userID string) ([]*model.Log, error)
+	DeleteUser(ctx context.Context, userID string) error
 }
@@ -0,0 +1,92 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mgo
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/tools/db/pagination"
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func NewAPIKeyMongo(db *mongo.Database) (database.APIKey, error) {
+	coll := db.Collection("api_key")
+	_, err := coll.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "key_id", Value: 1},
+		},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &APIKeyMgo{coll: coll}, nil
+}
+
+type APIKeyMgo struct {
+	coll *mongo.Collection
+}
+
+func (a *APIKeyMgo) Create(ctx context.Context, key *model.APIKey) error {
+	return mongoutil.InsertMany(ctx, a.coll, []*model.APIKey{key})
+}
+
+func (a *APIKeyMgo) Take(ctx context.Context, keyID string) (*model.APIKey, error) {
+	return mongoutil.FindOne[*model.APIKey](ctx, a.coll, bson.M{"key_id": keyID})
+}
+
+func (a *APIKeyMgo) UpdateStatus(ctx context.Context, keyID string, status int32) error {
+	return mongoutil.UpdateOne(ctx, a.coll, bson.M{"key_id": keyID}, bson.M{"$set": bson.M{"status": status}}, true)
+}
+
+func (a *APIKeyMgo) Page(ctx context.Context, pagination pagination.Pagination) (int64, []*model.APIKey, error) {
+	return mongoutil.FindPage[*model.APIKey](ctx, a.coll, bson.M{}, pagination, options.Find().SetSort(bson.M{"create_time": -1}))
+}
+
+func NewAPIKeyAuditMongo(db *mongo.Database) (database.APIKeyAudit, error) {
+	coll := db.Collection("api_key_audit")
+	_, err := coll.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "key_id", Value: 1},
+			{Key: "create_time", Value: -1},
+		},
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &APIKeyAuditMgo{coll: coll}, nil
+}
+
+type APIKeyAuditMgo struct {
+	coll *mongo.Collection
+}
+
+func (a *APIKeyAuditMgo) Create(ctx context.Context, audits []*model.APIKeyAudit) error {
+	return mongoutil.InsertMany(ctx, a.coll, audits)
+}
+
+func (a *APIKeyAuditMgo) Page(ctx context.Context, keyID string, pagination pagination.Pagination) (int64, []*model.APIKeyAudit, error) {
+	filter := bson.M{}
+	if keyID != "" {
+		filter["key_id"] = keyID
+	}
+	return mongoutil.FindPage[*model.APIKeyAudit](ctx, a.coll, filter, pagination, options.Find().SetSort(bson.M{"create_time": -1}))
+}
@@ -100,6 +100,21 @@ func (b *BlackMgo) FindOwnerBlackInfos(ctx context.Context, ownerUserID string,
 	return mongoutil.Find[*model.Black](ctx, b.coll, bson.M{"owner_user_id": ownerUserID, "block_user_id": bson.M{"$in": userIDs}})
 }
 
+func (b *BlackMgo) FindReversalBlackOwnerIDs(ctx context.Context, blockUserID string, ownerUserIDs []string) (ownerIDs []string, err error) {
+	filter := bson.M{"block_user_id": blockUserID, "owner_user_id": bson.M{"$in": ownerUserIDs}}
+	return mongoutil.Find[string](ctx, b.coll, filter, options.Find().SetProjection(bson.M{"_id": 0, "owner_user_id": 1}))
+}
+
 func (b *BlackMgo) FindBlackUserIDs(ctx context.Context, ownerUserID string) (blackUserIDs []string, err error) {
 	return mongoutil.Find[string](ctx, b.coll, bson.M{"owner_user_id": ownerUserID}, options.Find().SetProjection(bson.M{"_id": 0, "block_user_id": 1}))
 }
+
+func (b *BlackMgo) FindOwnerUserIDs(ctx context.Context, blockUserID string) ([]string, error) {
+	filter := bson.M{"block_user_id": blockUserID}
+	return mongoutil.Find[string](ctx, b.coll, filter, options.Find().SetProjection(bson.M{"_id": 0, "owner_user_id": 1}))
+}
+
+func (b *BlackMgo) DeleteUser(ctx context.Context, userID string) error {
+	filter := bson.M{"$or": []bson.M{{"owner_user_id": userID}, {"block_user_id": userID}}}
+	return mongoutil.DeleteMany(ctx, b.coll, filter)
+}
@@ -0,0 +1,157 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mgo
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/tools/db/pagination"
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func NewBroadcastMongo(db *mongo.Database) (database.Broadcast, error) {
+	coll := db.Collection("broadcast_job")
+	_, err := coll.Indexes().CreateMany(context.Background(), []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "job_id", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{{Key: "status", Value: 1}, {Key: "create_time", Value: 1}},
+		},
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	failed := db.Collection("broadcast_failed")
+	_, err = failed.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "job_id", Value: 1},
+			{Key: "user_id", Value: 1},
+		},
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	recipient := db.Collection("broadcast_recipient")
+	_, err = recipient.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "job_id", Value: 1},
+			{Key: "index", Value: 1},
+		},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &BroadcastMgo{coll: coll, failed: failed, recipient: recipient}, nil
+}
+
+type BroadcastMgo struct {
+	coll      *mongo.Collection
+	failed    *mongo.Collection
+	recipient *mongo.Collection
+}
+
+func (b *BroadcastMgo) Create(ctx context.Context, job *model.BroadcastJob) error {
+	return mongoutil.InsertMany(ctx, b.coll, []*model.BroadcastJob{job})
+}
+
+func (b *BroadcastMgo) Take(ctx context.Context, jobID string) (*model.BroadcastJob, error) {
+	return mongoutil.FindOne[*model.BroadcastJob](ctx, b.coll, bson.M{"job_id": jobID})
+}
+
+func (b *BroadcastMgo) Page(ctx context.Context, pagination pagination.Pagination) (int64, []*model.BroadcastJob, error) {
+	opts := options.Find().SetSort(bson.M{"create_time": -1}).SetProjection(bson.M{"msg": 0})
+	return mongoutil.FindPage[*model.BroadcastJob](ctx, b.coll, bson.M{}, pagination, opts)
+}
+
+func (b *BroadcastMgo) Acquire(ctx context.Context, owner string, leaseExpire time.Time) (*model.BroadcastJob, error) {
+	now := time.Now()
+	filter := bson.M{
+		"status":       bson.M{"$in": []int32{model.BroadcastStatusPending, model.BroadcastStatusRunning}},
+		"lease_expire": bson.M{"$lt": now},
+	}
+	update := bson.M{"$set": bson.M{
+		"status":       model.BroadcastStatusRunning,
+		"owner":        owner,
+		"lease_expire": leaseExpire,
+		"update_time":  now,
+	}}
+	opts := options.FindOneAndUpdate().SetSort(bson.M{"create_time": 1}).SetReturnDocument(options.After)
+	return mongoutil.FindOneAndUpdate[*model.BroadcastJob](ctx, b.coll, filter, update, opts)
+}
+
+func (b *BroadcastMgo) UpdateProgress(ctx context.Context, jobID string, owner string, cursor int64, total int64, sent int64, failed int64, leaseExpire time.Time) error {
+	filter := bson.M{"job_id": jobID, "owner": owner, "status": model.BroadcastStatusRunning}
+	update := bson.M{
+		"$set": bson.M{"cursor": cursor, "total": total, "lease_expire": leaseExpire, "update_time": time.Now()},
+		"$inc": bson.M{"sent": sent, "failed": failed},
+	}
+	return mongoutil.UpdateOne(ctx, b.coll, filter, update, true)
+}
+
+func (b *BroadcastMgo) RenewLease(ctx context.Context, jobID string, owner string, leaseExpire time.Time) error {
+	filter := bson.M{"job_id": jobID, "owner": owner, "status": model.BroadcastStatusRunning}
+	return mongoutil.UpdateOne(ctx, b.coll, filter, bson.M{"$set": bson.M{"lease_expire": leaseExpire}}, true)
+}
+
+func (b *BroadcastMgo) Finish(ctx context.Context, jobID string, owner string) error {
+	now := time.Now()
+	filter := bson.M{"job_id": jobID, "owner": owner, "status": model.BroadcastStatusRunning}
+	update := bson.M{"$set": bson.M{"status": model.BroadcastStatusCompleted, "update_time": now, "finish_time": now}}
+	return mongoutil.UpdateOne(ctx, b.coll, filter, update, true)
+}
+
+func (b *BroadcastMgo) Fail(ctx context.Context, jobID string, owner string, errMsg string) error {
+	now := time.Now()
+	filter := bson.M{"job_id": jobID, "owner": owner, "status": model.BroadcastStatusRunning}
+	update := bson.M{"$set": bson.M{"status": model.BroadcastStatusFailed, "error": errMsg, "update_time": now, "finish_time": now}}
+	return mongoutil.UpdateOne(ctx, b.coll, filter, update, true)
+}
+
+func (b *BroadcastMgo) Cancel(ctx context.Context, jobID string) error {
+	now := time.Now()
+	filter := bson.M{
+		"job_id": jobID,
+		"status": bson.M{"$in": []int32{model.BroadcastStatusPending, model.BroadcastStatusRunning}},
+	}
+	update := bson.M{"$set": bson.M{"status": model.BroadcastStatusCanceled, "update_time": now, "finish_time": now}}
+	return mongoutil.UpdateOne(ctx, b.coll, filter, update, true)
+}
+
+func (b *BroadcastMgo) AddRecipients(ctx context.Context, recipients []*model.BroadcastRecipient) error {
+	return mongoutil.InsertMany(ctx, b.recipient, recipients)
+}
+
+func (b *BroadcastMgo) FindRecipients(ctx context.Context, jobID string, index int64, limit int) ([]string, error) {
+	opts := options.Find().SetSort(bson.M{"index": 1}).SetLimit(int64(limit)).SetProjection(bson.M{"_id": 0, "user_id": 1})
+	return mongoutil.Find[string](ctx, b.recipient, bson.M{"job_id": jobID, "index": bson.M{"$gte": index}}, opts)
+}
+
+func (b *BroadcastMgo) AddFailed(ctx context.Context, failed []*model.BroadcastFailed) error {
+	return mongoutil.InsertMany(ctx, b.failed, failed)
+}
+
+func (b *BroadcastMgo) PageFailed(ctx context.Context, jobID string, pagination pagination.Pagination) (int64, []*model.BroadcastFailed, error) {
+	return mongoutil.FindPage[*model.BroadcastFailed](ctx, b.failed, bson.M{"job_id": jobID}, pagination)
+}
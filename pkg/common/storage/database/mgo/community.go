@@ -0,0 +1,133 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mgo
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/tools/db/pagination"
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func NewCommunityMgo(db *mongo.Database) (database.Community, error) {
+	coll := db.Collection("community")
+	_, err := coll.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys:    bson.D{{Key: "community_id", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &CommunityMgo{coll: coll}, nil
+}
+
+type CommunityMgo struct {
+	coll *mongo.Collection
+}
+
+func (c *CommunityMgo) Create(ctx context.Context, community *model.Community) error {
+	return mongoutil.InsertMany(ctx, c.coll, []*model.Community{community})
+}
+
+func (c *CommunityMgo) Take(ctx context.Context, communityID string) (*model.Community, error) {
+	return mongoutil.FindOne[*model.Community](ctx, c.coll, bson.M{"community_id": communityID})
+}
+
+func (c *CommunityMgo) Find(ctx context.Context, communityIDs []string) ([]*model.Community, error) {
+	return mongoutil.Find[*model.Community](ctx, c.coll, bson.M{"community_id": bson.M{"$in": communityIDs}})
+}
+
+func (c *CommunityMgo) UpdateMap(ctx context.Context, communityID string, args map[string]any) error {
+	if len(args) == 0 {
+		return nil
+	}
+	return mongoutil.UpdateOne(ctx, c.coll, bson.M{"community_id": communityID}, bson.M{"$set": args}, true)
+}
+
+func (c *CommunityMgo) Delete(ctx context.Context, communityID string) error {
+	return mongoutil.DeleteOne(ctx, c.coll, bson.M{"community_id": communityID})
+}
+
+func NewCommunityMemberMgo(db *mongo.Database) (database.CommunityMember, error) {
+	coll := db.Collection("community_member")
+	_, err := coll.Indexes().CreateMany(context.Background(), []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "community_id", Value: 1},
+				{Key: "user_id", Value: 1},
+			},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{{Key: "user_id", Value: 1}},
+		},
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &CommunityMemberMgo{coll: coll}, nil
+}
+
+type CommunityMemberMgo struct {
+	coll *mongo.Collection
+}
+
+func (c *CommunityMemberMgo) Create(ctx context.Context, members []*model.CommunityMember) error {
+	return mongoutil.InsertMany(ctx, c.coll, members)
+}
+
+func (c *CommunityMemberMgo) Delete(ctx context.Context, communityID string, userIDs []string) error {
+	return mongoutil.DeleteMany(ctx, c.coll, bson.M{"community_id": communityID, "user_id": bson.M{"$in": userIDs}})
+}
+
+func (c *CommunityMemberMgo) DeleteAll(ctx context.Context, communityID string) error {
+	return mongoutil.DeleteMany(ctx, c.coll, bson.M{"community_id": communityID})
+}
+
+func (c *CommunityMemberMgo) Take(ctx context.Context, communityID string, userID string) (*model.CommunityMember, error) {
+	return mongoutil.FindOne[*model.CommunityMember](ctx, c.coll, bson.M{"community_id": communityID, "user_id": userID})
+}
+
+func (c *CommunityMemberMgo) Find(ctx context.Context, communityID string, userIDs []string) ([]*model.CommunityMember, error) {
+	return mongoutil.Find[*model.CommunityMember](ctx, c.coll, bson.M{"community_id": communityID, "user_id": bson.M{"$in": userIDs}})
+}
+
+func (c *CommunityMemberMgo) UpdateRoleLevel(ctx context.Context, communityID string, userID string, roleLevel int32) error {
+	filter := bson.M{"community_id": communityID, "user_id": userID}
+	return mongoutil.UpdateOne(ctx, c.coll, filter, bson.M{"$set": bson.M{"role_level": roleLevel}}, true)
+}
+
+func (c *CommunityMemberMgo) FindMemberUserIDs(ctx context.Context, communityID string) ([]string, error) {
+	return mongoutil.Find[string](ctx, c.coll, bson.M{"community_id": communityID}, options.Find().SetProjection(bson.M{"_id": 0, "user_id": 1}))
+}
+
+func (c *CommunityMemberMgo) Page(ctx context.Context, communityID string, pagination pagination.Pagination) (int64, []*model.CommunityMember, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "role_level", Value: -1}, {Key: "join_time", Value: 1}})
+	return mongoutil.FindPage[*model.CommunityMember](ctx, c.coll, bson.M{"community_id": communityID}, pagination, opts)
+}
+
+func (c *CommunityMemberMgo) Count(ctx context.Context, communityID string) (int64, error) {
+	return mongoutil.Count(ctx, c.coll, bson.M{"community_id": communityID})
+}
+
+func (c *CommunityMemberMgo) FindJoinedCommunityIDs(ctx context.Context, userID string) ([]string, error) {
+	return mongoutil.Find[string](ctx, c.coll, bson.M{"user_id": userID}, options.Find().SetProjection(bson.M{"_id": 0, "community_id": 1}))
+}
@@ -0,0 +1,113 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mgo
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func NewContactHashMgo(db *mongo.Database) (database.ContactHash, error) {
+	coll := db.Collection("contact_hash")
+	_, err := coll.Indexes().CreateMany(context.Background(), []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "hash", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{
+				{Key: "user_id", Value: 1},
+				{Key: "type", Value: 1},
+			},
+		},
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &ContactHashMgo{coll: coll}, nil
+}
+
+type ContactHashMgo struct {
+	coll *mongo.Collection
+}
+
+func (c *ContactHashMgo) Create(ctx context.Context, hashes []*model.ContactHash) error {
+	return mongoutil.InsertMany(ctx, c.coll, hashes)
+}
+
+func (c *ContactHashMgo) DeleteUserType(ctx context.Context, userID string, typ int32) error {
+	return mongoutil.DeleteMany(ctx, c.coll, bson.M{"user_id": userID, "type": typ})
+}
+
+func (c *ContactHashMgo) DeleteHashes(ctx context.Context, hashes []string) error {
+	if len(hashes) == 0 {
+		return nil
+	}
+	return mongoutil.DeleteMany(ctx, c.coll, bson.M{"hash": bson.M{"$in": hashes}})
+}
+
+func (c *ContactHashMgo) Find(ctx context.Context, hashes []string) ([]*model.ContactHash, error) {
+	if len(hashes) == 0 {
+		return nil, nil
+	}
+	return mongoutil.Find[*model.ContactHash](ctx, c.coll, bson.M{"hash": bson.M{"$in": hashes}})
+}
+
+func NewContactDiscoverySettingMgo(db *mongo.Database) (database.ContactDiscoverySetting, error) {
+	coll := db.Collection("contact_discovery_setting")
+	_, err := coll.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys:    bson.D{{Key: "user_id", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &ContactDiscoverySettingMgo{coll: coll}, nil
+}
+
+type ContactDiscoverySettingMgo struct {
+	coll *mongo.Collection
+}
+
+func (c *ContactDiscoverySettingMgo) Set(ctx context.Context, setting *model.ContactDiscoverySetting) error {
+	filter := bson.M{"user_id": setting.UserID}
+	return mongoutil.UpdateOne(ctx, c.coll, filter, bson.M{"$set": setting}, false, options.Update().SetUpsert(true))
+}
+
+func (c *ContactDiscoverySettingMgo) Take(ctx context.Context, userID string) (*model.ContactDiscoverySetting, error) {
+	return mongoutil.FindOne[*model.ContactDiscoverySetting](ctx, c.coll, bson.M{"user_id": userID})
+}
+
+func (c *ContactDiscoverySettingMgo) Find(ctx context.Context, userIDs []string) ([]*model.ContactDiscoverySetting, error) {
+	if len(userIDs) == 0 {
+		return nil, nil
+	}
+	return mongoutil.Find[*model.ContactDiscoverySetting](ctx, c.coll, bson.M{"user_id": bson.M{"$in": userIDs}})
+}
+
+func (c *ContactHashMgo) DeleteUser(ctx context.Context, userID string) error {
+	return mongoutil.DeleteMany(ctx, c.coll, bson.M{"user_id": userID})
+}
+
+func (c *ContactDiscoverySettingMgo) Delete(ctx context.Context, userID string) error {
+	return mongoutil.DeleteOne(ctx, c.coll, bson.M{"user_id": userID})
+}
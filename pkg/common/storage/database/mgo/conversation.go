@@ -55,6 +55,10 @@ func (c *ConversationMgo) Delete(ctx context.Context, groupIDs []string) (err er
 	return mongoutil.DeleteMany(ctx, c.coll, bson.M{"group_id": bson.M{"$in": groupIDs}})
 }
 
+func (c *ConversationMgo) DeleteByOwner(ctx context.Context, ownerUserID string) (err error) {
+	return mongoutil.DeleteMany(ctx, c.coll, bson.M{"owner_user_id": ownerUserID})
+}
+
 func (c *ConversationMgo) UpdateByMap(ctx context.Context, userIDs []string, conversationID string, args map[string]any) (rows int64, err error) {
 	if len(args) == 0 {
 		return 0, nil
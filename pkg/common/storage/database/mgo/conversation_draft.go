@@ -0,0 +1,94 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mgo
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func NewConversationDraftMgo(db *mongo.Database) (database.ConversationDraft, error) {
+	coll := db.Collection("conversation_draft")
+	_, err := coll.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "owner_user_id", Value: 1},
+			{Key: "conversation_id", Value: 1},
+		},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &ConversationDraftMgo{coll: coll}, nil
+}
+
+type ConversationDraftMgo struct {
+	coll *mongo.Collection
+}
+
+func (c *ConversationDraftMgo) Save(ctx context.Context, drafts []*model.ConversationDraft) error {
+	if len(drafts) == 0 {
+		return nil
+	}
+	models := make([]mongo.WriteModel, 0, len(drafts))
+	for _, draft := range drafts {
+		// Only the stored draft older than the update is replaced, the aggregation keeps the newer one.
+		filter := bson.M{"owner_user_id": draft.OwnerUserID, "conversation_id": draft.ConversationID}
+		newer := bson.M{"$gt": bson.A{"$update_time", draft.UpdateTime}}
+		update := bson.A{bson.M{"$set": bson.M{
+			"draft":       bson.M{"$cond": bson.A{newer, "$draft", draft.Draft}},
+			"update_time": bson.M{"$cond": bson.A{newer, "$update_time", draft.UpdateTime}},
+		}}}
+		models = append(models, mongo.NewUpdateOneModel().SetFilter(filter).SetUpdate(update).SetUpsert(true))
+	}
+	_, err := c.coll.BulkWrite(ctx, models, options.BulkWrite().SetOrdered(false))
+	return errs.Wrap(err)
+}
+
+func (c *ConversationDraftMgo) Delete(ctx context.Context, drafts []*model.ConversationDraft) error {
+	if len(drafts) == 0 {
+		return nil
+	}
+	models := make([]mongo.WriteModel, 0, len(drafts))
+	for _, draft := range drafts {
+		filter := bson.M{
+			"owner_user_id":   draft.OwnerUserID,
+			"conversation_id": draft.ConversationID,
+			"update_time":     bson.M{"$lte": draft.UpdateTime},
+		}
+		models = append(models, mongo.NewDeleteOneModel().SetFilter(filter))
+	}
+	_, err := c.coll.BulkWrite(ctx, models, options.BulkWrite().SetOrdered(false))
+	return errs.Wrap(err)
+}
+
+func (c *ConversationDraftMgo) Find(ctx context.Context, ownerUserID string, conversationIDs []string) ([]*model.ConversationDraft, error) {
+	filter := bson.M{"owner_user_id": ownerUserID}
+	if len(conversationIDs) > 0 {
+		filter["conversation_id"] = bson.M{"$in": conversationIDs}
+	}
+	return mongoutil.Find[*model.ConversationDraft](ctx, c.coll, filter)
+}
+
+func (c *ConversationDraftMgo) DeleteByOwner(ctx context.Context, ownerUserID string) error {
+	return mongoutil.DeleteMany(ctx, c.coll, bson.M{"owner_user_id": ownerUserID})
+}
@@ -0,0 +1,87 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mgo
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func NewConversationFolderMgo(db *mongo.Database) (database.ConversationFolder, error) {
+	coll := db.Collection("conversation_folder")
+	_, err := coll.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "owner_user_id", Value: 1},
+			{Key: "folder_id", Value: 1},
+		},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &ConversationFolderMgo{coll: coll}, nil
+}
+
+type ConversationFolderMgo struct {
+	coll *mongo.Collection
+}
+
+func (c *ConversationFolderMgo) Create(ctx context.Context, folder *model.ConversationFolder) error {
+	return mongoutil.InsertMany(ctx, c.coll, []*model.ConversationFolder{folder})
+}
+
+func (c *ConversationFolderMgo) Take(ctx context.Context, ownerUserID string, folderID string) (*model.ConversationFolder, error) {
+	return mongoutil.FindOne[*model.ConversationFolder](ctx, c.coll, bson.M{"owner_user_id": ownerUserID, "folder_id": folderID})
+}
+
+func (c *ConversationFolderMgo) Find(ctx context.Context, ownerUserID string) ([]*model.ConversationFolder, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "order", Value: 1}, {Key: "create_time", Value: 1}})
+	return mongoutil.Find[*model.ConversationFolder](ctx, c.coll, bson.M{"owner_user_id": ownerUserID}, opts)
+}
+
+func (c *ConversationFolderMgo) Update(ctx context.Context, ownerUserID string, folderID string, data map[string]any) error {
+	if len(data) == 0 {
+		return nil
+	}
+	filter := bson.M{"owner_user_id": ownerUserID, "folder_id": folderID}
+	return mongoutil.UpdateOne(ctx, c.coll, filter, bson.M{"$set": data}, true)
+}
+
+func (c *ConversationFolderMgo) Delete(ctx context.Context, ownerUserID string, folderID string) error {
+	return mongoutil.DeleteOne(ctx, c.coll, bson.M{"owner_user_id": ownerUserID, "folder_id": folderID})
+}
+
+func (c *ConversationFolderMgo) DeleteByOwner(ctx context.Context, ownerUserID string) error {
+	return mongoutil.DeleteMany(ctx, c.coll, bson.M{"owner_user_id": ownerUserID})
+}
+
+func (c *ConversationFolderMgo) AddConversations(ctx context.Context, ownerUserID string, folderID string, conversationIDs []string) error {
+	filter := bson.M{"owner_user_id": ownerUserID, "folder_id": folderID}
+	update := bson.M{"$addToSet": bson.M{"conversation_ids": bson.M{"$each": conversationIDs}}}
+	return mongoutil.UpdateOne(ctx, c.coll, filter, update, true)
+}
+
+func (c *ConversationFolderMgo) RemoveConversations(ctx context.Context, ownerUserID string, folderID string, conversationIDs []string) error {
+	filter := bson.M{"owner_user_id": ownerUserID, "folder_id": folderID}
+	update := bson.M{"$pull": bson.M{"conversation_ids": bson.M{"$in": conversationIDs}}}
+	return mongoutil.UpdateOne(ctx, c.coll, filter, update, true)
+}
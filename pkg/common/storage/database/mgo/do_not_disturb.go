@@ -0,0 +1,87 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mgo
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func NewDoNotDisturbMgo(db *mongo.Database) (database.DoNotDisturb, error) {
+	coll := db.Collection("do_not_disturb")
+	_, err := coll.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys:    bson.D{{Key: "user_id", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &DoNotDisturbMgo{coll: coll}, nil
+}
+
+type DoNotDisturbMgo struct {
+	coll *mongo.Collection
+}
+
+func (d *DoNotDisturbMgo) Set(ctx context.Context, dnd *model.DoNotDisturb) error {
+	update := bson.M{"$set": bson.M{
+		"time_zone":               dnd.TimeZone,
+		"schedules":               dnd.Schedules,
+		"allow_mentions":          dnd.AllowMentions,
+		"except_conversation_ids": dnd.ExceptConversationIDs,
+		"update_time":             dnd.UpdateTime,
+	}}
+	return mongoutil.UpdateOne(ctx, d.coll, bson.M{"user_id": dnd.UserID}, update, false, options.Update().SetUpsert(true))
+}
+
+func (d *DoNotDisturbMgo) Take(ctx context.Context, userID string) (*model.DoNotDisturb, error) {
+	return mongoutil.FindOne[*model.DoNotDisturb](ctx, d.coll, bson.M{"user_id": userID})
+}
+
+func (d *DoNotDisturbMgo) Find(ctx context.Context, userIDs []string) ([]*model.DoNotDisturb, error) {
+	return mongoutil.Find[*model.DoNotDisturb](ctx, d.coll, bson.M{"user_id": bson.M{"$in": userIDs}})
+}
+
+func (d *DoNotDisturbMgo) MuteConversation(ctx context.Context, userID string, conversationID string, muteUntil time.Time) error {
+	now := time.Now()
+	filter := bson.M{"user_id": userID}
+	pull := bson.M{"$pull": bson.M{"muted_conversations": bson.M{"$or": bson.A{
+		bson.M{"conversation_id": conversationID},
+		bson.M{"mute_until": bson.M{"$lte": now}},
+	}}}}
+	if err := mongoutil.UpdateOne(ctx, d.coll, filter, pull, false); err != nil {
+		return err
+	}
+	if !muteUntil.After(now) {
+		return nil
+	}
+	push := bson.M{
+		"$push": bson.M{"muted_conversations": model.MutedConversation{ConversationID: conversationID, MuteUntil: muteUntil}},
+		"$set":  bson.M{"update_time": now},
+	}
+	return mongoutil.UpdateOne(ctx, d.coll, filter, push, false, options.Update().SetUpsert(true))
+}
+
+func (d *DoNotDisturbMgo) Delete(ctx context.Context, userID string) error {
+	return mongoutil.DeleteOne(ctx, d.coll, bson.M{"user_id": userID})
+}
@@ -143,6 +143,54 @@ func (f *FriendMgo) FindFriendUserIDs(ctx context.Context, ownerUserID string) (
 	return mongoutil.Find[string](ctx, f.coll, filter, options.Find().SetProjection(bson.M{"_id": 0, "friend_user_id": 1}))
 }
 
+func (f *FriendMgo) FindOwnerUserIDs(ctx context.Context, friendUserID string) ([]string, error) {
+	filter := bson.M{"friend_user_id": friendUserID}
+	return mongoutil.Find[string](ctx, f.coll, filter, options.Find().SetProjection(bson.M{"_id": 0, "owner_user_id": 1}))
+}
+
+func (f *FriendMgo) DeleteOwner(ctx context.Context, ownerUserID string) error {
+	return mongoutil.DeleteMany(ctx, f.coll, bson.M{"owner_user_id": ownerUserID})
+}
+
+func (f *FriendMgo) CountFriendsOfOwners(ctx context.Context, ownerUserIDs []string, limit int64) (map[string]int64, error) {
+	pipeline := bson.A{
+		bson.M{"$match": bson.M{"owner_user_id": bson.M{"$in": ownerUserIDs}}},
+		bson.M{"$group": bson.M{"_id": "$friend_user_id", "count": bson.M{"$sum": 1}}},
+		bson.M{"$sort": bson.D{{Key: "count", Value: -1}, {Key: "_id", Value: 1}}},
+		bson.M{"$limit": limit},
+	}
+	type Item struct {
+		UserID string `bson:"_id"`
+		Count  int64  `bson:"count"`
+	}
+	items, err := mongoutil.Aggregate[Item](ctx, f.coll, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	res := make(map[string]int64, len(items))
+	for _, item := range items {
+		res[item.UserID] = item.Count
+	}
+	return res, nil
+}
+
+func (f *FriendMgo) HasCommonFriend(ctx context.Context, ownerUserID1 string, ownerUserID2 string) (bool, error) {
+	pipeline := bson.A{
+		bson.M{"$match": bson.M{"owner_user_id": bson.M{"$in": bson.A{ownerUserID1, ownerUserID2}}}},
+		bson.M{"$group": bson.M{"_id": "$friend_user_id", "count": bson.M{"$sum": 1}}},
+		bson.M{"$match": bson.M{"count": bson.M{"$gte": 2}}},
+		bson.M{"$limit": 1},
+	}
+	type Item struct {
+		UserID string `bson:"_id"`
+	}
+	items, err := mongoutil.Aggregate[Item](ctx, f.coll, pipeline)
+	if err != nil {
+		return false, err
+	}
+	return len(items) > 0, nil
+}
+
 func (f *FriendMgo) UpdateFriends(ctx context.Context, ownerUserID string, friendUserIDs []string, val map[string]any) error {
 	// Ensure there are IDs to update
 	if len(friendUserIDs) == 0 {
@@ -162,3 +210,35 @@ func (f *FriendMgo) UpdateFriends(ctx context.Context, ownerUserID string, frien
 	_, err := mongoutil.UpdateMany(ctx, f.coll, filter, update)
 	return err
 }
+
+// AddGroup puts friends of the owner in a friend group.
+func (f *FriendMgo) AddGroup(ctx context.Context, ownerUserID string, friendUserIDs []string, groupID string) error {
+	if len(friendUserIDs) == 0 {
+		return nil
+	}
+	filter := bson.M{"owner_user_id": ownerUserID, "friend_user_id": bson.M{"$in": friendUserIDs}}
+	_, err := mongoutil.UpdateMany(ctx, f.coll, filter, bson.M{"$addToSet": bson.M{"group_ids": groupID}})
+	return err
+}
+
+// RemoveGroup takes friends of the owner out of a friend group.
+func (f *FriendMgo) RemoveGroup(ctx context.Context, ownerUserID string, friendUserIDs []string, groupID string) error {
+	if len(friendUserIDs) == 0 {
+		return nil
+	}
+	filter := bson.M{"owner_user_id": ownerUserID, "friend_user_id": bson.M{"$in": friendUserIDs}}
+	_, err := mongoutil.UpdateMany(ctx, f.coll, filter, bson.M{"$pull": bson.M{"group_ids": groupID}})
+	return err
+}
+
+// FindGroupFriendUserIDs retrieves the user IDs of the friends in a friend group.
+func (f *FriendMgo) FindGroupFriendUserIDs(ctx context.Context, ownerUserID string, groupID string) ([]string, error) {
+	filter := bson.M{"owner_user_id": ownerUserID, "group_ids": groupID}
+	return mongoutil.Find[string](ctx, f.coll, filter, options.Find().SetProjection(bson.M{"_id": 0, "friend_user_id": 1}))
+}
+
+// FindGroupFriends retrieves a paginated list of the friends in a friend group.
+func (f *FriendMgo) FindGroupFriends(ctx context.Context, ownerUserID string, groupID string, pagination pagination.Pagination) (int64, []*model.Friend, error) {
+	filter := bson.M{"owner_user_id": ownerUserID, "group_ids": groupID}
+	return mongoutil.FindPage[*model.Friend](ctx, f.coll, filter, pagination)
+}
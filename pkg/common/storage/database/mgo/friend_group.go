@@ -0,0 +1,75 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mgo
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func NewFriendGroupMgo(db *mongo.Database) (database.FriendGroup, error) {
+	coll := db.Collection("friend_group")
+	_, err := coll.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "owner_user_id", Value: 1},
+			{Key: "group_id", Value: 1},
+		},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &FriendGroupMgo{coll: coll}, nil
+}
+
+type FriendGroupMgo struct {
+	coll *mongo.Collection
+}
+
+func (g *FriendGroupMgo) Create(ctx context.Context, group *model.FriendGroup) error {
+	return mongoutil.InsertMany(ctx, g.coll, []*model.FriendGroup{group})
+}
+
+func (g *FriendGroupMgo) Take(ctx context.Context, ownerUserID string, groupID string) (*model.FriendGroup, error) {
+	return mongoutil.FindOne[*model.FriendGroup](ctx, g.coll, bson.M{"owner_user_id": ownerUserID, "group_id": groupID})
+}
+
+func (g *FriendGroupMgo) Find(ctx context.Context, ownerUserID string) ([]*model.FriendGroup, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "order", Value: 1}, {Key: "create_time", Value: 1}})
+	return mongoutil.Find[*model.FriendGroup](ctx, g.coll, bson.M{"owner_user_id": ownerUserID}, opts)
+}
+
+func (g *FriendGroupMgo) Update(ctx context.Context, ownerUserID string, groupID string, data map[string]any) error {
+	if len(data) == 0 {
+		return nil
+	}
+	filter := bson.M{"owner_user_id": ownerUserID, "group_id": groupID}
+	return mongoutil.UpdateOne(ctx, g.coll, filter, bson.M{"$set": data}, true)
+}
+
+func (g *FriendGroupMgo) Delete(ctx context.Context, ownerUserID string, groupID string) error {
+	return mongoutil.DeleteOne(ctx, g.coll, bson.M{"owner_user_id": ownerUserID, "group_id": groupID})
+}
+
+func (g *FriendGroupMgo) DeleteOwner(ctx context.Context, ownerUserID string) error {
+	return mongoutil.DeleteMany(ctx, g.coll, bson.M{"owner_user_id": ownerUserID})
+}
@@ -16,9 +16,12 @@ package mgo
 
 import (
 	"context"
+	"time"
+
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
 
+	"github.com/openimsdk/protocol/constant"
 	"github.com/openimsdk/tools/db/mongoutil"
 	"github.com/openimsdk/tools/db/pagination"
 	"go.mongodb.org/mongo-driver/bson"
@@ -28,12 +31,31 @@ import (
 
 func NewFriendRequestMongo(db *mongo.Database) (database.FriendRequest, error) {
 	coll := db.Collection("friend_request")
-	_, err := coll.Indexes().CreateOne(context.Background(), mongo.IndexModel{
-		Keys: bson.D{
-			{Key: "from_user_id", Value: 1},
-			{Key: "to_user_id", Value: 1},
+	_, err := coll.Indexes().CreateMany(context.Background(), []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "from_user_id", Value: 1},
+				{Key: "to_user_id", Value: 1},
+			},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{
+				{Key: "handle_result", Value: 1},
+				{Key: "create_time", Value: 1},
+			},
+		},
+		{
+			Keys: bson.D{
+				{Key: "create_time", Value: 1},
+			},
+		},
+		{
+			Keys: bson.D{
+				{Key: "to_user_id", Value: 1},
+				{Key: "handle_result", Value: 1},
+			},
 		},
-		Options: options.Index().SetUnique(true),
 	})
 	if err != nil {
 		return nil, err
@@ -110,3 +132,65 @@ func (f *FriendRequestMgo) Find(ctx context.Context, fromUserID, toUserID string
 func (f *FriendRequestMgo) Take(ctx context.Context, fromUserID, toUserID string) (friendRequest *model.FriendRequest, err error) {
 	return f.Find(ctx, fromUserID, toUserID)
 }
+
+func (f *FriendRequestMgo) FindPendingUserIDs(ctx context.Context, userID string) ([]string, error) {
+	filter := bson.M{"handle_result": 0, "$or": []bson.M{
+		{"from_user_id": userID},
+		{"to_user_id": userID},
+	}}
+	opts := options.Find().SetProjection(bson.M{"_id": 0, "from_user_id": 1, "to_user_id": 1})
+	friendRequests, err := mongoutil.Find[*model.FriendRequest](ctx, f.coll, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	userIDs := make([]string, 0, len(friendRequests))
+	for _, friendRequest := range friendRequests {
+		if friendRequest.FromUserID == userID {
+			userIDs = append(userIDs, friendRequest.ToUserID)
+		} else {
+			userIDs = append(userIDs, friendRequest.FromUserID)
+		}
+	}
+	return userIDs, nil
+}
+
+func (f *FriendRequestMgo) FindExpired(ctx context.Context, before time.Time, after *model.FriendRequest, limit int64) ([]*model.FriendRequest, error) {
+	filter := bson.M{"handle_result": 0, "create_time": bson.M{"$lt": before}}
+	if after != nil {
+		filter["$or"] = bson.A{
+			bson.M{"create_time": bson.M{"$gt": after.CreateTime}},
+			bson.M{"create_time": after.CreateTime, "from_user_id": bson.M{"$gt": after.FromUserID}},
+			bson.M{"create_time": after.CreateTime, "from_user_id": after.FromUserID, "to_user_id": bson.M{"$gt": after.ToUserID}},
+		}
+	}
+	sort := bson.D{{Key: "create_time", Value: 1}, {Key: "from_user_id", Value: 1}, {Key: "to_user_id", Value: 1}}
+	return mongoutil.Find[*model.FriendRequest](ctx, f.coll, filter, options.Find().SetSort(sort).SetLimit(limit))
+}
+
+// CountSenders only counts the requests refused by their receivers as refused, not the expired ones.
+func (f *FriendRequestMgo) CountSenders(ctx context.Context, since time.Time, limit int64) ([]*model.FriendRequestSender, error) {
+	count := func(cond any) bson.M {
+		return bson.M{"$sum": bson.M{"$cond": bson.A{cond, 1, 0}}}
+	}
+	pipeline := []bson.M{
+		{"$match": bson.M{"create_time": bson.M{"$gte": since}}},
+		{"$group": bson.M{
+			"_id":      "$from_user_id",
+			"sent":     bson.M{"$sum": 1},
+			"accepted": count(bson.M{"$eq": bson.A{"$handle_result", constant.FriendResponseAgree}}),
+			"refused": count(bson.M{"$and": bson.A{
+				bson.M{"$eq": bson.A{"$handle_result", constant.FriendResponseRefuse}},
+				bson.M{"$gt": bson.A{"$handler_user_id", ""}},
+			}}),
+			"pending": count(bson.M{"$eq": bson.A{"$handle_result", 0}}),
+		}},
+		{"$sort": bson.D{{Key: "sent", Value: -1}, {Key: "_id", Value: 1}}},
+		{"$limit": limit},
+	}
+	return mongoutil.Aggregate[*model.FriendRequestSender](ctx, f.coll, pipeline)
+}
+
+func (f *FriendRequestMgo) DeleteUser(ctx context.Context, userID string) error {
+	filter := bson.M{"$or": []bson.M{{"from_user_id": userID}, {"to_user_id": userID}}}
+	return mongoutil.DeleteMany(ctx, f.coll, filter)
+}
@@ -0,0 +1,60 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mgo
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func NewFriendSuggestionDismissMgo(db *mongo.Database) (database.FriendSuggestionDismiss, error) {
+	coll := db.Collection("friend_suggestion_dismiss")
+	_, err := coll.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "owner_user_id", Value: 1},
+			{Key: "user_id", Value: 1},
+		},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &FriendSuggestionDismissMgo{coll: coll}, nil
+}
+
+type FriendSuggestionDismissMgo struct {
+	coll *mongo.Collection
+}
+
+func (f *FriendSuggestionDismissMgo) Create(ctx context.Context, dismiss *model.FriendSuggestionDismiss) error {
+	filter := bson.M{"owner_user_id": dismiss.OwnerUserID, "user_id": dismiss.UserID}
+	return mongoutil.UpdateOne(ctx, f.coll, filter, bson.M{"$setOnInsert": dismiss}, false, options.Update().SetUpsert(true))
+}
+
+func (f *FriendSuggestionDismissMgo) FindUserIDs(ctx context.Context, ownerUserID string) ([]string, error) {
+	return mongoutil.Find[string](ctx, f.coll, bson.M{"owner_user_id": ownerUserID}, options.Find().SetProjection(bson.M{"_id": 0, "user_id": 1}))
+}
+
+func (f *FriendSuggestionDismissMgo) DeleteUser(ctx context.Context, userID string) error {
+	filter := bson.M{"$or": []bson.M{{"owner_user_id": userID}, {"user_id": userID}}}
+	return mongoutil.DeleteMany(ctx, f.coll, filter)
+}
@@ -16,9 +16,11 @@ package mgo
 
 import (
 	"context"
+	"regexp"
+	"time"
+
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
-	"time"
 
 	"github.com/openimsdk/protocol/constant"
 	"github.com/openimsdk/tools/db/mongoutil"
@@ -31,11 +33,35 @@ import (
 
 func NewGroupMongo(db *mongo.Database) (database.Group, error) {
 	coll := db.Collection("group")
-	_, err := coll.Indexes().CreateOne(context.Background(), mongo.IndexModel{
-		Keys: bson.D{
-			{Key: "group_id", Value: 1},
+	_, err := coll.Indexes().CreateMany(context.Background(), []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "group_id", Value: 1},
+			},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{
+				{Key: "directory_listed", Value: 1},
+				{Key: "activity", Value: -1},
+			},
+		},
+		{
+			Keys: bson.D{
+				{Key: "directory_listed", Value: 1},
+				{Key: "member_count", Value: -1},
+			},
+		},
+		{
+			Keys: bson.D{
+				{Key: "directory_categories", Value: 1},
+			},
+		},
+		{
+			Keys: bson.D{
+				{Key: "community_id", Value: 1},
+			},
 		},
-		Options: options.Index().SetUnique(true),
 	})
 	if err != nil {
 		return nil, errs.Wrap(err)
@@ -81,6 +107,43 @@ func (g *GroupMgo) Search(ctx context.Context, keyword string, pagination pagina
 	}, pagination, opts)
 }
 
+func (g *GroupMgo) SearchDirectory(ctx context.Context, keyword string, category string, sort string, pagination pagination.Pagination) (total int64, groups []*model.Group, err error) {
+	filter := bson.M{
+		"directory_listed": true,
+		"status":           bson.M{"$ne": constant.GroupStatusDismissed},
+	}
+	if keyword != "" {
+		regex := bson.M{"$regex": regexp.QuoteMeta(keyword), "$options": "i"}
+		filter["$or"] = bson.A{
+			bson.M{"group_name": regex},
+			bson.M{"directory_description": regex},
+		}
+	}
+	if category != "" {
+		filter["directory_categories"] = category
+	}
+	opts := options.Find().SetSort(bson.D{{Key: sort, Value: -1}, {Key: "group_id", Value: 1}})
+	return mongoutil.FindPage[*model.Group](ctx, g.coll, filter, pagination, opts)
+}
+
+func (g *GroupMgo) FindDirectoryGroupIDs(ctx context.Context, afterGroupID string, limit int64) ([]string, error) {
+	filter := bson.M{
+		"directory_listed": true,
+		"status":           bson.M{"$ne": constant.GroupStatusDismissed},
+		"group_id":         bson.M{"$gt": afterGroupID},
+	}
+	opts := options.Find().SetSort(bson.M{"group_id": 1}).SetLimit(limit).SetProjection(bson.M{"_id": 0, "group_id": 1})
+	return mongoutil.Find[string](ctx, g.coll, filter, opts)
+}
+
+func (g *GroupMgo) FindByCommunity(ctx context.Context, communityID string) ([]*model.Group, error) {
+	filter := bson.M{
+		"community_id": communityID,
+		"status":       bson.M{"$ne": constant.GroupStatusDismissed},
+	}
+	return mongoutil.Find[*model.Group](ctx, g.coll, filter, options.Find().SetSort(bson.M{"create_time": 1}))
+}
+
 func (g *GroupMgo) CountTotal(ctx context.Context, before *time.Time) (count int64, err error) {
 	if before == nil {
 		return mongoutil.Count(ctx, g.coll, bson.M{})
@@ -0,0 +1,104 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mgo
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func NewGroupInviteLinkMgo(db *mongo.Database) (database.GroupInviteLink, error) {
+	coll := db.Collection("group_invite_link")
+	_, err := coll.Indexes().CreateMany(context.Background(), []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "token", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{
+				{Key: "group_id", Value: 1},
+				{Key: "create_time", Value: -1},
+			},
+		},
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &GroupInviteLinkMgo{coll: coll}, nil
+}
+
+type GroupInviteLinkMgo struct {
+	coll *mongo.Collection
+}
+
+func (g *GroupInviteLinkMgo) Create(ctx context.Context, link *model.GroupInviteLink) error {
+	return mongoutil.InsertMany(ctx, g.coll, []*model.GroupInviteLink{link})
+}
+
+func (g *GroupInviteLinkMgo) Take(ctx context.Context, token string) (*model.GroupInviteLink, error) {
+	return mongoutil.FindOne[*model.GroupInviteLink](ctx, g.coll, bson.M{"token": token})
+}
+
+func (g *GroupInviteLinkMgo) Find(ctx context.Context, groupID string, creatorUserID string) ([]*model.GroupInviteLink, error) {
+	filter := bson.M{"group_id": groupID}
+	if creatorUserID != "" {
+		filter["creator_user_id"] = creatorUserID
+	}
+	return mongoutil.Find[*model.GroupInviteLink](ctx, g.coll, filter, options.Find().SetSort(bson.M{"create_time": -1}))
+}
+
+func (g *GroupInviteLinkMgo) Revoke(ctx context.Context, token string) error {
+	return mongoutil.UpdateOne(ctx, g.coll, bson.M{"token": token}, bson.M{"$set": bson.M{"revoked": true}}, true)
+}
+
+func (g *GroupInviteLinkMgo) RevokeByCreators(ctx context.Context, groupID string, creatorUserIDs []string) error {
+	if len(creatorUserIDs) == 0 {
+		return nil
+	}
+	filter := bson.M{"group_id": groupID, "creator_user_id": bson.M{"$in": creatorUserIDs}, "revoked": false}
+	_, err := mongoutil.UpdateMany(ctx, g.coll, filter, bson.M{"$set": bson.M{"revoked": true}})
+	return err
+}
+
+func (g *GroupInviteLinkMgo) IncrUse(ctx context.Context, token string, now time.Time) error {
+	filter := bson.M{
+		"token":   token,
+		"revoked": false,
+		"$and": bson.A{
+			bson.M{"$or": bson.A{
+				bson.M{"expire_time": time.Time{}},
+				bson.M{"expire_time": bson.M{"$gt": now}},
+			}},
+			bson.M{"$or": bson.A{
+				bson.M{"max_uses": 0},
+				bson.M{"$expr": bson.M{"$lt": bson.A{"$use_count", "$max_uses"}}},
+			}},
+		},
+	}
+	return mongoutil.UpdateOne(ctx, g.coll, filter, bson.M{"$inc": bson.M{"use_count": 1}}, true)
+}
+
+func (g *GroupInviteLinkMgo) DecrUse(ctx context.Context, token string) error {
+	filter := bson.M{"token": token, "use_count": bson.M{"$gt": 0}}
+	return mongoutil.UpdateOne(ctx, g.coll, filter, bson.M{"$inc": bson.M{"use_count": -1}}, false)
+}
@@ -0,0 +1,83 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mgo
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/tools/db/pagination"
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func NewGroupJoinQuestionnaireMgo(db *mongo.Database) (database.GroupJoinQuestionnaire, error) {
+	coll := db.Collection("group_join_questionnaire")
+	_, err := coll.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys:    bson.D{{Key: "group_id", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &GroupJoinQuestionnaireMgo{coll: coll}, nil
+}
+
+type GroupJoinQuestionnaireMgo struct {
+	coll *mongo.Collection
+}
+
+func (g *GroupJoinQuestionnaireMgo) Set(ctx context.Context, questionnaire *model.GroupJoinQuestionnaire) error {
+	filter := bson.M{"group_id": questionnaire.GroupID}
+	return mongoutil.UpdateOne(ctx, g.coll, filter, bson.M{"$set": questionnaire}, false, options.Update().SetUpsert(true))
+}
+
+func (g *GroupJoinQuestionnaireMgo) Take(ctx context.Context, groupID string) (*model.GroupJoinQuestionnaire, error) {
+	return mongoutil.FindOne[*model.GroupJoinQuestionnaire](ctx, g.coll, bson.M{"group_id": groupID})
+}
+
+func (g *GroupJoinQuestionnaireMgo) Delete(ctx context.Context, groupID string) error {
+	return mongoutil.DeleteOne(ctx, g.coll, bson.M{"group_id": groupID})
+}
+
+func NewGroupRequestAuditMgo(db *mongo.Database) (database.GroupRequestAudit, error) {
+	coll := db.Collection("group_request_audit")
+	_, err := coll.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "group_id", Value: 1},
+			{Key: "handled_time", Value: -1},
+		},
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &GroupRequestAuditMgo{coll: coll}, nil
+}
+
+type GroupRequestAuditMgo struct {
+	coll *mongo.Collection
+}
+
+func (g *GroupRequestAuditMgo) Create(ctx context.Context, audits []*model.GroupRequestAudit) error {
+	return mongoutil.InsertMany(ctx, g.coll, audits)
+}
+
+func (g *GroupRequestAuditMgo) Page(ctx context.Context, groupID string, pagination pagination.Pagination) (int64, []*model.GroupRequestAudit, error) {
+	return mongoutil.FindPage[*model.GroupRequestAudit](ctx, g.coll, bson.M{"group_id": groupID}, pagination, options.Find().SetSort(bson.M{"handled_time": -1}))
+}
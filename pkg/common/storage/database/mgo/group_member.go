@@ -84,6 +84,12 @@ func (g *GroupMemberMgo) TakeOwner(ctx context.Context, groupID string) (groupMe
 	return mongoutil.FindOne[*model.GroupMember](ctx, g.coll, bson.M{"group_id": groupID, "role_level": constant.GroupOwner})
 }
 
+func (g *GroupMemberMgo) TakeSuccessor(ctx context.Context, groupID string, excludeUserID string) (*model.GroupMember, error) {
+	filter := bson.M{"group_id": groupID, "user_id": bson.M{"$ne": excludeUserID}}
+	opts := options.FindOne().SetSort(bson.D{{Key: "role_level", Value: -1}, {Key: "join_time", Value: 1}})
+	return mongoutil.FindOne[*model.GroupMember](ctx, g.coll, filter, opts)
+}
+
 func (g *GroupMemberMgo) FindRoleLevelUserIDs(ctx context.Context, groupID string, roleLevel int32) ([]string, error) {
 	return mongoutil.Find[string](ctx, g.coll, bson.M{"group_id": groupID, "role_level": roleLevel}, options.Find().SetProjection(bson.M{"_id": 0, "user_id": 1}))
 }
@@ -101,6 +107,40 @@ func (g *GroupMemberMgo) TakeGroupMemberNum(ctx context.Context, groupID string)
 	return mongoutil.Count(ctx, g.coll, bson.M{"group_id": groupID})
 }
 
+func (g *GroupMemberMgo) CountMembers(ctx context.Context, groupIDs []string) (map[string]int64, error) {
+	pipeline := bson.A{
+		bson.M{"$match": bson.M{"group_id": bson.M{"$in": groupIDs}}},
+		bson.M{"$group": bson.M{"_id": "$group_id", "count": bson.M{"$sum": 1}}},
+	}
+	return g.aggregateCount(ctx, pipeline)
+}
+
+func (g *GroupMemberMgo) CountUserGroups(ctx context.Context, groupIDs []string, limit int64) (map[string]int64, error) {
+	pipeline := bson.A{
+		bson.M{"$match": bson.M{"group_id": bson.M{"$in": groupIDs}}},
+		bson.M{"$group": bson.M{"_id": "$user_id", "count": bson.M{"$sum": 1}}},
+		bson.M{"$sort": bson.D{{Key: "count", Value: -1}, {Key: "_id", Value: 1}}},
+		bson.M{"$limit": limit},
+	}
+	return g.aggregateCount(ctx, pipeline)
+}
+
+func (g *GroupMemberMgo) aggregateCount(ctx context.Context, pipeline bson.A) (map[string]int64, error) {
+	type Item struct {
+		ID    string `bson:"_id"`
+		Count int64  `bson:"count"`
+	}
+	items, err := mongoutil.Aggregate[Item](ctx, g.coll, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	res := make(map[string]int64, len(items))
+	for _, item := range items {
+		res[item.ID] = item.Count
+	}
+	return res, nil
+}
+
 func (g *GroupMemberMgo) FindUserManagedGroupID(ctx context.Context, userID string) (groupIDs []string, err error) {
 	filter := bson.M{
 		"user_id": userID,
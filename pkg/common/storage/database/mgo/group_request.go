@@ -16,6 +16,8 @@ package mgo
 
 import (
 	"context"
+	"time"
+
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
 
@@ -29,12 +31,20 @@ import (
 
 func NewGroupRequestMgo(db *mongo.Database) (database.GroupRequest, error) {
 	coll := db.Collection("group_request")
-	_, err := coll.Indexes().CreateOne(context.Background(), mongo.IndexModel{
-		Keys: bson.D{
-			{Key: "group_id", Value: 1},
-			{Key: "user_id", Value: 1},
+	_, err := coll.Indexes().CreateMany(context.Background(), []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "group_id", Value: 1},
+				{Key: "user_id", Value: 1},
+			},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{
+				{Key: "handle_result", Value: 1},
+				{Key: "req_time", Value: 1},
+			},
 		},
-		Options: options.Index().SetUnique(true),
 	})
 	if err != nil {
 		return nil, errs.Wrap(err)
@@ -54,6 +64,10 @@ func (g *GroupRequestMgo) Delete(ctx context.Context, groupID string, userID str
 	return mongoutil.DeleteOne(ctx, g.coll, bson.M{"group_id": groupID, "user_id": userID})
 }
 
+func (g *GroupRequestMgo) DeleteByUser(ctx context.Context, userID string) (err error) {
+	return mongoutil.DeleteMany(ctx, g.coll, bson.M{"user_id": userID})
+}
+
 func (g *GroupRequestMgo) UpdateHandler(ctx context.Context, groupID string, userID string, handledMsg string, handleResult int32) (err error) {
 	return mongoutil.UpdateOne(ctx, g.coll, bson.M{"group_id": groupID, "user_id": userID}, bson.M{"$set": bson.M{"handle_msg": handledMsg, "handle_result": handleResult}}, true)
 }
@@ -73,3 +87,16 @@ func (g *GroupRequestMgo) Page(ctx context.Context, userID string, pagination pa
 func (g *GroupRequestMgo) PageGroup(ctx context.Context, groupIDs []string, pagination pagination.Pagination) (total int64, groups []*model.GroupRequest, err error) {
 	return mongoutil.FindPage[*model.GroupRequest](ctx, g.coll, bson.M{"group_id": bson.M{"$in": groupIDs}}, pagination)
 }
+
+func (g *GroupRequestMgo) FindExpired(ctx context.Context, before time.Time, after *model.GroupRequest, limit int64) ([]*model.GroupRequest, error) {
+	filter := bson.M{"handle_result": 0, "req_time": bson.M{"$lt": before}}
+	if after != nil {
+		filter["$or"] = bson.A{
+			bson.M{"req_time": bson.M{"$gt": after.ReqTime}},
+			bson.M{"req_time": after.ReqTime, "group_id": bson.M{"$gt": after.GroupID}},
+			bson.M{"req_time": after.ReqTime, "group_id": after.GroupID, "user_id": bson.M{"$gt": after.UserID}},
+		}
+	}
+	sort := bson.D{{Key: "req_time", Value: 1}, {Key: "group_id", Value: 1}, {Key: "user_id", Value: 1}}
+	return mongoutil.Find[*model.GroupRequest](ctx, g.coll, filter, options.Find().SetSort(sort).SetLimit(limit))
+}
@@ -0,0 +1,63 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mgo
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func NewGroupRoleMgo(db *mongo.Database) (database.GroupRole, error) {
+	coll := db.Collection("group_role")
+	_, err := coll.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "group_id", Value: 1},
+			{Key: "role_level", Value: 1},
+		},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &GroupRoleMgo{coll: coll}, nil
+}
+
+type GroupRoleMgo struct {
+	coll *mongo.Collection
+}
+
+func (g *GroupRoleMgo) Create(ctx context.Context, role *model.GroupRole) error {
+	return mongoutil.InsertMany(ctx, g.coll, []*model.GroupRole{role})
+}
+
+func (g *GroupRoleMgo) Update(ctx context.Context, groupID string, roleLevel int32, name string, permissions []string) error {
+	filter := bson.M{"group_id": groupID, "role_level": roleLevel}
+	return mongoutil.UpdateOne(ctx, g.coll, filter, bson.M{"$set": bson.M{"name": name, "permissions": permissions}}, true)
+}
+
+func (g *GroupRoleMgo) Delete(ctx context.Context, groupID string, roleLevel int32) error {
+	return mongoutil.DeleteOne(ctx, g.coll, bson.M{"group_id": groupID, "role_level": roleLevel})
+}
+
+func (g *GroupRoleMgo) Find(ctx context.Context, groupID string) ([]*model.GroupRole, error) {
+	return mongoutil.Find[*model.GroupRole](ctx, g.coll, bson.M{"group_id": groupID}, options.Find().SetSort(bson.M{"role_level": -1}))
+}
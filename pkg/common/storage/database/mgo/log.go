@@ -82,3 +82,7 @@ func (l *LogMgo) Get(ctx context.Context, logIDs []string, userID string) ([]*mo
 	}
 	return mongoutil.Find[*model.Log](ctx, l.coll, bson.M{"log_id": bson.M{"$in": logIDs}, "user_id": userID})
 }
+
+func (l *LogMgo) DeleteUser(ctx context.Context, userID string) error {
+	return mongoutil.DeleteMany(ctx, l.coll, bson.M{"user_id": userID})
+}
@@ -976,6 +976,30 @@ func (m *MsgMgo) DeleteMsgByIndex(ctx context.Context, docID string, index []int
 //	}
 //}
 
+func (m *MsgMgo) AnonymizeSender(ctx context.Context, conversationID string, sendID string, nickname string) (int64, error) {
+	docFilter := primitive.Regex{Pattern: fmt.Sprintf("^%s:", conversationID)}
+	res, err := mongoutil.UpdateMany(ctx, m.coll,
+		bson.M{"doc_id": docFilter, "msgs.msg.send_id": sendID},
+		bson.M{"$set": bson.M{
+			"msgs.$[m].msg.sender_nickname": nickname,
+			"msgs.$[m].msg.sender_face_url": "",
+		}},
+		options.Update().SetArrayFilters(options.ArrayFilters{Filters: []any{bson.M{"m.msg.send_id": sendID}}}),
+	)
+	if err != nil {
+		return 0, err
+	}
+	revoked, err := mongoutil.UpdateMany(ctx, m.coll,
+		bson.M{"doc_id": docFilter, "msgs.revoke.user_id": sendID},
+		bson.M{"$set": bson.M{"msgs.$[r].revoke.nickname": nickname}},
+		options.Update().SetArrayFilters(options.ArrayFilters{Filters: []any{bson.M{"r.revoke.user_id": sendID}}}),
+	)
+	if err != nil {
+		return 0, err
+	}
+	return res.ModifiedCount + revoked.ModifiedCount, nil
+}
+
 func (m *MsgMgo) DeleteDoc(ctx context.Context, docID string) error {
 	return mongoutil.DeleteOne(ctx, m.coll, bson.M{"doc_id": docID})
 }
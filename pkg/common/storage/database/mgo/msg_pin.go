@@ -0,0 +1,69 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mgo
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func NewMsgPinMongo(db *mongo.Database) (database.MsgPin, error) {
+	coll := db.Collection("msg_pin")
+	_, err := coll.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "conversation_id", Value: 1},
+			{Key: "seq", Value: 1},
+		},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &MsgPinMgo{coll: coll}, nil
+}
+
+type MsgPinMgo struct {
+	coll *mongo.Collection
+}
+
+func (m *MsgPinMgo) Create(ctx context.Context, pin *model.MsgPin) error {
+	return mongoutil.InsertMany(ctx, m.coll, []*model.MsgPin{pin})
+}
+
+func (m *MsgPinMgo) Delete(ctx context.Context, conversationID string, seqs []int64) error {
+	if len(seqs) == 0 {
+		return nil
+	}
+	return mongoutil.DeleteMany(ctx, m.coll, bson.M{"conversation_id": conversationID, "seq": bson.M{"$in": seqs}})
+}
+
+func (m *MsgPinMgo) DeleteBefore(ctx context.Context, conversationID string, seq int64) error {
+	return mongoutil.DeleteMany(ctx, m.coll, bson.M{"conversation_id": conversationID, "seq": bson.M{"$lt": seq}})
+}
+
+func (m *MsgPinMgo) Find(ctx context.Context, conversationID string) ([]*model.MsgPin, error) {
+	return mongoutil.Find[*model.MsgPin](ctx, m.coll, bson.M{"conversation_id": conversationID}, options.Find().SetSort(bson.M{"pin_time": -1}))
+}
+
+func (m *MsgPinMgo) Count(ctx context.Context, conversationID string) (int64, error) {
+	return mongoutil.Count(ctx, m.coll, bson.M{"conversation_id": conversationID})
+}
@@ -18,10 +18,12 @@ import (
 	"context"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"regexp"
 
 	"github.com/openimsdk/tools/db/mongoutil"
 	"github.com/openimsdk/tools/errs"
 	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 )
@@ -48,6 +50,8 @@ func (o *S3Mongo) SetObject(ctx context.Context, obj *model.Object) error {
 	filter := bson.M{"name": obj.Name, "engine": obj.Engine}
 	update := bson.M{
 		"name":         obj.Name,
+		"user_id":      obj.UserID,
+		"hash":         obj.Hash,
 		"engine":       obj.Engine,
 		"key":          obj.Key,
 		"size":         obj.Size,
@@ -68,3 +72,18 @@ func (o *S3Mongo) Take(ctx context.Context, engine string, name string) (*model.
 func (o *S3Mongo) Delete(ctx context.Context, engine string, name string) error {
 	return mongoutil.DeleteOne(ctx, o.coll, bson.M{"name": name, "engine": engine})
 }
+
+func (o *S3Mongo) FindUserObjects(ctx context.Context, engine string, userID string, limit int64) ([]*model.Object, error) {
+	filter := bson.M{
+		"engine": engine,
+		"$or": []bson.M{
+			{"user_id": userID},
+			{"name": primitive.Regex{Pattern: "^" + regexp.QuoteMeta(userID+"/")}},
+		},
+	}
+	return mongoutil.Find[*model.Object](ctx, o.coll, filter, options.Find().SetLimit(limit))
+}
+
+func (o *S3Mongo) CountKey(ctx context.Context, engine string, key string) (int64, error) {
+	return mongoutil.Count(ctx, o.coll, bson.M{"engine": engine, "key": key})
+}
@@ -0,0 +1,148 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mgo
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/tools/db/pagination"
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func NewPollMongo(db *mongo.Database) (database.Poll, error) {
+	coll := db.Collection("poll")
+	_, err := coll.Indexes().CreateMany(context.Background(), []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "poll_id", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{
+				{Key: "closed", Value: 1},
+				{Key: "close_time", Value: 1},
+			},
+		},
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	vote := db.Collection("poll_vote")
+	_, err = vote.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "poll_id", Value: 1},
+			{Key: "user_id", Value: 1},
+		},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &PollMgo{coll: coll, vote: vote}, nil
+}
+
+type PollMgo struct {
+	coll *mongo.Collection
+	vote *mongo.Collection
+}
+
+func (p *PollMgo) Create(ctx context.Context, poll *model.Poll) error {
+	return mongoutil.InsertMany(ctx, p.coll, []*model.Poll{poll})
+}
+
+func (p *PollMgo) Take(ctx context.Context, pollID string) (*model.Poll, error) {
+	return mongoutil.FindOne[*model.Poll](ctx, p.coll, bson.M{"poll_id": pollID})
+}
+
+func (p *PollMgo) Find(ctx context.Context, pollIDs []string) ([]*model.Poll, error) {
+	return mongoutil.Find[*model.Poll](ctx, p.coll, bson.M{"poll_id": bson.M{"$in": pollIDs}})
+}
+
+func (p *PollMgo) Delete(ctx context.Context, pollID string) error {
+	if err := mongoutil.DeleteOne(ctx, p.coll, bson.M{"poll_id": pollID}); err != nil {
+		return err
+	}
+	return mongoutil.DeleteMany(ctx, p.vote, bson.M{"poll_id": pollID})
+}
+
+func (p *PollMgo) FindExpired(ctx context.Context, now time.Time, limit int64) ([]*model.Poll, error) {
+	filter := bson.M{
+		"closed":     false,
+		"close_time": bson.M{"$gt": time.Time{}, "$lte": now},
+	}
+	return mongoutil.Find[*model.Poll](ctx, p.coll, filter, options.Find().SetSort(bson.M{"close_time": 1}).SetLimit(limit))
+}
+
+func (p *PollMgo) Close(ctx context.Context, pollIDs []string) error {
+	if len(pollIDs) == 0 {
+		return nil
+	}
+	_, err := mongoutil.UpdateMany(ctx, p.coll, bson.M{"poll_id": bson.M{"$in": pollIDs}}, bson.M{"$set": bson.M{"closed": true}})
+	return err
+}
+
+func (p *PollMgo) SetVote(ctx context.Context, vote *model.PollVote) error {
+	filter := bson.M{"poll_id": vote.PollID, "user_id": vote.UserID}
+	update := bson.M{"$set": bson.M{"option_ids": vote.OptionIDs, "vote_time": vote.VoteTime}}
+	return mongoutil.UpdateOne(ctx, p.vote, filter, update, false, options.Update().SetUpsert(true))
+}
+
+func (p *PollMgo) DeleteVote(ctx context.Context, pollID string, userID string) error {
+	return mongoutil.DeleteOne(ctx, p.vote, bson.M{"poll_id": pollID, "user_id": userID})
+}
+
+func (p *PollMgo) FindUserVotes(ctx context.Context, userID string, pollIDs []string) ([]*model.PollVote, error) {
+	return mongoutil.Find[*model.PollVote](ctx, p.vote, bson.M{"user_id": userID, "poll_id": bson.M{"$in": pollIDs}})
+}
+
+func (p *PollMgo) CountVoters(ctx context.Context, pollIDs []string) ([]*model.PollVoterCount, error) {
+	pipeline := []bson.M{
+		{"$match": bson.M{"poll_id": bson.M{"$in": pollIDs}}},
+		{"$group": bson.M{"_id": "$poll_id", "count": bson.M{"$sum": 1}}},
+	}
+	return mongoutil.Aggregate[*model.PollVoterCount](ctx, p.vote, pipeline)
+}
+
+func (p *PollMgo) Tally(ctx context.Context, pollIDs []string, voterLimit int) ([]*model.PollTally, error) {
+	pipeline := []bson.M{
+		{"$match": bson.M{"poll_id": bson.M{"$in": pollIDs}}},
+		{"$sort": bson.M{"vote_time": 1}},
+		{"$unwind": "$option_ids"},
+		{"$group": bson.M{
+			"_id":      bson.M{"poll_id": "$poll_id", "option_id": "$option_ids"},
+			"count":    bson.M{"$sum": 1},
+			"user_ids": bson.M{"$firstN": bson.M{"input": "$user_id", "n": voterLimit}},
+		}},
+		{"$project": bson.M{
+			"_id":       0,
+			"poll_id":   "$_id.poll_id",
+			"option_id": "$_id.option_id",
+			"count":     1,
+			"user_ids":  1,
+		}},
+	}
+	return mongoutil.Aggregate[*model.PollTally](ctx, p.vote, pipeline)
+}
+
+func (p *PollMgo) PageVoters(ctx context.Context, pollID string, optionID string, pagination pagination.Pagination) (int64, []*model.PollVote, error) {
+	filter := bson.M{"poll_id": pollID, "option_ids": optionID}
+	return mongoutil.FindPage[*model.PollVote](ctx, p.vote, filter, pagination, options.Find().SetSort(bson.M{"vote_time": 1}))
+}
@@ -187,3 +187,34 @@ func (u *UserMongoDriver) GetSubscribedList(ctx context.Context, userID string)
 	}
 	return user.UserIDList, nil
 }
+
+// DeleteUser removes the subscriptions of the user and the user from the subscriptions of others.
+func (u *UserMongoDriver) DeleteUser(ctx context.Context, userID string) error {
+	subscriptions, err := u.GetAllSubscribeList(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if err := u.RemoveSubscribedListFromUser(ctx, userID, subscriptions); err != nil {
+		return err
+	}
+	subscribers, err := u.GetSubscribedList(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if len(subscribers) > 0 {
+		keys := make([]string, 0, len(subscribers))
+		for _, subscriber := range subscribers {
+			keys = append(keys, SubscriptionPrefix+subscriber)
+		}
+		_, err = u.userCollection.UpdateMany(
+			ctx,
+			bson.M{"user_id": bson.M{"$in": keys}},
+			bson.M{"$pull": bson.M{"user_id_list": userID}},
+		)
+		if err != nil {
+			return errs.Wrap(err)
+		}
+	}
+	_, err = u.userCollection.DeleteMany(ctx, bson.M{"user_id": bson.M{"$in": []string{SubscriptionPrefix + userID, SubscribedPrefix + userID}}})
+	return errs.Wrap(err)
+}
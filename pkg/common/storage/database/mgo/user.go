@@ -173,6 +173,13 @@ func (u *UserMgo) DeleteUserCommand(ctx context.Context, userID string, Type int
 	}
 	return errs.Wrap(err)
 }
+
+func (u *UserMgo) DeleteAllUserCommands(ctx context.Context, userID string) error {
+	collection := u.coll.Database().Collection("userCommands")
+	_, err := collection.DeleteMany(ctx, bson.M{"userID": userID})
+	return errs.Wrap(err)
+}
+
 func (u *UserMgo) UpdateUserCommand(ctx context.Context, userID string, Type int32, UUID string, val map[string]any) error {
 	if len(val) == 0 {
 		return nil
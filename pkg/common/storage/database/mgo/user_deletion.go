@@ -0,0 +1,143 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mgo
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func NewUserDeletionMongo(db *mongo.Database) (database.UserDeletion, error) {
+	coll := db.Collection("user_deletion")
+	_, err := coll.Indexes().CreateMany(context.Background(), []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "user_id", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{{Key: "status", Value: 1}, {Key: "purge_time", Value: 1}},
+		},
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &UserDeletionMgo{coll: coll}, nil
+}
+
+type UserDeletionMgo struct {
+	coll *mongo.Collection
+}
+
+func (u *UserDeletionMgo) Create(ctx context.Context, deletion *model.UserDeletion) error {
+	return mongoutil.InsertMany(ctx, u.coll, []*model.UserDeletion{deletion})
+}
+
+func (u *UserDeletionMgo) Take(ctx context.Context, userID string) (*model.UserDeletion, error) {
+	return mongoutil.FindOne[*model.UserDeletion](ctx, u.coll, bson.M{"user_id": userID})
+}
+
+func (u *UserDeletionMgo) DeletePending(ctx context.Context, userID string, now time.Time) error {
+	filter := bson.M{
+		"user_id":    userID,
+		"status":     model.UserDeletionStatusPending,
+		"attempts":   0,
+		"purge_time": bson.M{"$gt": now},
+	}
+	res, err := u.coll.DeleteOne(ctx, filter)
+	if err != nil {
+		return errs.WrapMsg(err, "mongo delete one")
+	}
+	if res.DeletedCount == 0 {
+		return errs.Wrap(mongo.ErrNoDocuments)
+	}
+	return nil
+}
+
+func (u *UserDeletionMgo) Acquire(ctx context.Context, now time.Time, retryTime time.Time) (*model.UserDeletion, error) {
+	filter := bson.M{
+		"status":     model.UserDeletionStatusPending,
+		"purge_time": bson.M{"$lte": now},
+	}
+	update := bson.M{
+		"$set": bson.M{"purge_time": retryTime},
+		"$inc": bson.M{"attempts": 1},
+	}
+	opts := options.FindOneAndUpdate().SetSort(bson.M{"purge_time": 1}).SetReturnDocument(options.After)
+	return mongoutil.FindOneAndUpdate[*model.UserDeletion](ctx, u.coll, filter, update, opts)
+}
+
+func (u *UserDeletionMgo) SetStatus(ctx context.Context, userID string, status int32, errMsg string) error {
+	return mongoutil.UpdateOne(ctx, u.coll, bson.M{"user_id": userID}, bson.M{"$set": bson.M{"status": status, "error": errMsg}}, true)
+}
+
+func NewUserDataExportMongo(db *mongo.Database) (database.UserDataExport, error) {
+	coll := db.Collection("user_data_export")
+	_, err := coll.Indexes().CreateMany(context.Background(), []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "export_id", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{{Key: "user_id", Value: 1}, {Key: "create_time", Value: -1}},
+		},
+		{
+			Keys: bson.D{{Key: "status", Value: 1}, {Key: "create_time", Value: 1}},
+		},
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &UserDataExportMgo{coll: coll}, nil
+}
+
+type UserDataExportMgo struct {
+	coll *mongo.Collection
+}
+
+func (u *UserDataExportMgo) Create(ctx context.Context, export *model.UserDataExport) error {
+	return mongoutil.InsertMany(ctx, u.coll, []*model.UserDataExport{export})
+}
+
+func (u *UserDataExportMgo) FindByUser(ctx context.Context, userID string, limit int64) ([]*model.UserDataExport, error) {
+	opts := options.Find().SetSort(bson.M{"create_time": -1}).SetLimit(limit)
+	return mongoutil.Find[*model.UserDataExport](ctx, u.coll, bson.M{"user_id": userID}, opts)
+}
+
+func (u *UserDataExportMgo) Acquire(ctx context.Context, leaseExpire time.Time) (*model.UserDataExport, error) {
+	filter := bson.M{
+		"status":       model.UserDataExportStatusPending,
+		"lease_expire": bson.M{"$lt": time.Now()},
+	}
+	update := bson.M{"$set": bson.M{"lease_expire": leaseExpire}}
+	opts := options.FindOneAndUpdate().SetSort(bson.M{"create_time": 1}).SetReturnDocument(options.After)
+	return mongoutil.FindOneAndUpdate[*model.UserDataExport](ctx, u.coll, filter, update, opts)
+}
+
+func (u *UserDataExportMgo) Finish(ctx context.Context, exportID string, status int32, object string, errMsg string) error {
+	update := bson.M{"$set": bson.M{"status": status, "object": object, "error": errMsg, "finish_time": time.Now()}}
+	return mongoutil.UpdateOne(ctx, u.coll, bson.M{"export_id": exportID}, update, false)
+}
+
+func (u *UserDataExportMgo) DeleteByUser(ctx context.Context, userID string) error {
+	return mongoutil.DeleteMany(ctx, u.coll, bson.M{"user_id": userID})
+}
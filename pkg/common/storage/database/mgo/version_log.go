@@ -0,0 +1,141 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mgo
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// NewVersionLogMgo keeps the change logs of one kind of list, name is the prefix of its collections.
+func NewVersionLogMgo(db *mongo.Database, name string) (database.VersionLog, error) {
+	coll := db.Collection(name + "_version")
+	_, err := coll.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys:    bson.D{{Key: "d_id", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	elemColl := db.Collection(name + "_version_log")
+	_, err = elemColl.Indexes().CreateMany(context.Background(), []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "d_id", Value: 1}, {Key: "e_id", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{{Key: "d_id", Value: 1}, {Key: "version", Value: 1}},
+		},
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &VersionLogMgo{coll: coll, elemColl: elemColl}, nil
+}
+
+type VersionLogMgo struct {
+	coll     *mongo.Collection
+	elemColl *mongo.Collection
+}
+
+func (v *VersionLogMgo) IncrVersion(ctx context.Context, dID string, eIDs []string, state int32) error {
+	if len(eIDs) == 0 {
+		return nil
+	}
+	now := time.Now()
+	update := bson.M{
+		"$inc": bson.M{"version": 1},
+		"$set": bson.M{"last_update": now},
+	}
+	opts := options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After)
+	head, err := mongoutil.FindOneAndUpdate[*model.VersionLog](ctx, v.coll, bson.M{"d_id": dID}, update, opts)
+	if err != nil {
+		return err
+	}
+	models := make([]mongo.WriteModel, 0, len(eIDs))
+	for _, eID := range eIDs {
+		models = append(models, newVersionElemModel(dID, eID, state, head.Version, now))
+	}
+	if _, err := v.elemColl.BulkWrite(ctx, models, options.BulkWrite().SetOrdered(false)); err != nil {
+		return errs.WrapMsg(err, "version log bulk write failed", "dID", dID)
+	}
+	return nil
+}
+
+// IncrVersions takes three round trips whatever the number of lists. A list changed concurrently may get the element
+// recorded at the version of the other change, which is still newer than any version a client saw before this one.
+func (v *VersionLogMgo) IncrVersions(ctx context.Context, dIDs []string, eID string, state int32) error {
+	if len(dIDs) == 0 {
+		return nil
+	}
+	now := time.Now()
+	heads := make([]mongo.WriteModel, 0, len(dIDs))
+	for _, dID := range dIDs {
+		heads = append(heads, mongo.NewUpdateOneModel().
+			SetFilter(bson.M{"d_id": dID}).
+			SetUpdate(bson.M{"$inc": bson.M{"version": 1}, "$set": bson.M{"last_update": now}}).
+			SetUpsert(true))
+	}
+	if _, err := v.coll.BulkWrite(ctx, heads, options.BulkWrite().SetOrdered(false)); err != nil {
+		return errs.WrapMsg(err, "version bulk write failed", "eID", eID)
+	}
+	opts := options.Find().SetProjection(bson.M{"d_id": 1, "version": 1})
+	versions, err := mongoutil.Find[*model.VersionLog](ctx, v.coll, bson.M{"d_id": bson.M{"$in": dIDs}}, opts)
+	if err != nil {
+		return err
+	}
+	models := make([]mongo.WriteModel, 0, len(versions))
+	for _, head := range versions {
+		models = append(models, newVersionElemModel(head.DID, eID, state, head.Version, now))
+	}
+	if _, err := v.elemColl.BulkWrite(ctx, models, options.BulkWrite().SetOrdered(false)); err != nil {
+		return errs.WrapMsg(err, "version log bulk write failed", "eID", eID)
+	}
+	return nil
+}
+
+// newVersionElemModel records the state of an element at version. An element inserted and then updated since the
+// last sync of a client is still reported as inserted, so the client does not get an update of an element it does
+// not have.
+func newVersionElemModel(dID string, eID string, state int32, version uint64, now time.Time) mongo.WriteModel {
+	var newState any = state
+	if state == model.VersionStateUpdate {
+		newState = bson.M{"$cond": bson.A{
+			bson.M{"$eq": bson.A{"$state", model.VersionStateInsert}},
+			model.VersionStateInsert,
+			model.VersionStateUpdate,
+		}}
+	}
+	update := bson.A{bson.M{"$set": bson.M{"state": newState, "version": version, "last_update": now}}}
+	return mongo.NewUpdateOneModel().SetFilter(bson.M{"d_id": dID, "e_id": eID}).SetUpdate(update).SetUpsert(true)
+}
+
+func (v *VersionLogMgo) TakeVersion(ctx context.Context, dID string) (*model.VersionLog, error) {
+	return mongoutil.FindOne[*model.VersionLog](ctx, v.coll, bson.M{"d_id": dID})
+}
+
+func (v *VersionLogMgo) FindChangeLog(ctx context.Context, dID string, version uint64, limit int) ([]*model.VersionLogElem, error) {
+	filter := bson.M{"d_id": dID, "version": bson.M{"$gt": version}}
+	opts := options.Find().SetSort(bson.D{{Key: "version", Value: 1}}).SetLimit(int64(limit))
+	return mongoutil.Find[*model.VersionLogElem](ctx, v.elemColl, filter, opts)
+}
@@ -45,4 +45,7 @@ type Msg interface {
 	DeleteDoc(ctx context.Context, docID string) error
 	DeleteMsgByIndex(ctx context.Context, docID string, index []int) error
 	GetBeforeMsg(ctx context.Context, ts int64, limit int) ([]*model.MsgDocModel, error)
+	// AnonymizeSender replaces the nickname and clears the face URL of the messages sendID sent in the conversation,
+	// including the revoker nickname of the messages they revoked. It returns the number of documents updated.
+	AnonymizeSender(ctx context.Context, conversationID string, sendID string, nickname string) (int64, error)
 }
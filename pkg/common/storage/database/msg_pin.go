@@ -0,0 +1,31 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+)
+
+type MsgPin interface {
+	Create(ctx context.Context, pin *model.MsgPin) error
+	Delete(ctx context.Context, conversationID string, seqs []int64) error
+	// DeleteBefore deletes the pins of messages with a seq lower than seq.
+	DeleteBefore(ctx context.Context, conversationID string, seq int64) error
+	// Find returns the pins of a conversation, the latest first.
+	Find(ctx context.Context, conversationID string) ([]*model.MsgPin, error)
+	Count(ctx context.Context, conversationID string) (int64, error)
+}
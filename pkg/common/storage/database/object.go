@@ -23,4 +23,8 @@ type ObjectInfo interface {
 	SetObject(ctx context.Context, obj *model.Object) error
 	Take(ctx context.Context, engine string, name string) (*model.Object, error)
 	Delete(ctx context.Context, engine string, name string) error
+	// FindUserObjects returns up to limit objects uploaded by the user, objects named under the user directory included.
+	FindUserObjects(ctx context.Context, engine string, userID string, limit int64) ([]*model.Object, error)
+	// CountKey counts the objects stored as the key.
+	CountKey(ctx context.Context, engine string, key string) (int64, error)
 }
@@ -0,0 +1,41 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/db/pagination"
+)
+
+type Poll interface {
+	Create(ctx context.Context, poll *model.Poll) error
+	Take(ctx context.Context, pollID string) (*model.Poll, error)
+	Find(ctx context.Context, pollIDs []string) ([]*model.Poll, error)
+	Delete(ctx context.Context, pollID string) error
+	// FindExpired returns open polls whose close time is before now.
+	FindExpired(ctx context.Context, now time.Time, limit int64) ([]*model.Poll, error)
+	Close(ctx context.Context, pollIDs []string) error
+	SetVote(ctx context.Context, vote *model.PollVote) error
+	DeleteVote(ctx context.Context, pollID string, userID string) error
+	FindUserVotes(ctx context.Context, userID string, pollIDs []string) ([]*model.PollVote, error)
+	CountVoters(ctx context.Context, pollIDs []string) ([]*model.PollVoterCount, error)
+	// Tally counts the votes of every option of the polls, listing at most voterLimit voters per option.
+	Tally(ctx context.Context, pollIDs []string, voterLimit int) ([]*model.PollTally, error)
+	// PageVoters returns the votes for an option, the earliest first.
+	PageVoters(ctx context.Context, pollID string, optionID string, pagination pagination.Pagination) (int64, []*model.PollVote, error)
+}
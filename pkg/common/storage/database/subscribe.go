@@ -28,4 +28,6 @@ type SubscribeUser interface {
 	GetAllSubscribeList(ctx context.Context, id string) (userIDList []string, err error)
 	// GetSubscribedList Get the user subscribed by those users
 	GetSubscribedList(ctx context.Context, id string) (userIDList []string, err error)
+	// DeleteUser removes the subscriptions of the user and the user from the subscriptions of others.
+	DeleteUser(ctx context.Context, userID string) error
 }
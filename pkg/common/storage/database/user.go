@@ -42,6 +42,7 @@ type User interface {
 	// CRUD user command
 	AddUserCommand(ctx context.Context, userID string, Type int32, UUID string, value string, ex string) error
 	DeleteUserCommand(ctx context.Context, userID string, Type int32, UUID string) error
+	DeleteAllUserCommands(ctx context.Context, userID string) error
 	UpdateUserCommand(ctx context.Context, userID string, Type int32, UUID string, val map[string]any) error
 	GetUserCommand(ctx context.Context, userID string, Type int32) ([]*user.CommandInfoResp, error)
 	GetAllUserCommand(ctx context.Context, userID string) ([]*user.AllCommandInfoResp, error)
@@ -0,0 +1,42 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+)
+
+type UserDeletion interface {
+	Create(ctx context.Context, deletion *model.UserDeletion) error
+	Take(ctx context.Context, userID string) (*model.UserDeletion, error)
+	// DeletePending removes the deletion of the user unless its purge has started by now, not found otherwise.
+	DeletePending(ctx context.Context, userID string, now time.Time) error
+	// Acquire takes a pending deletion due before now and pushes its purge time to retryTime, nil when none is due.
+	Acquire(ctx context.Context, now time.Time, retryTime time.Time) (*model.UserDeletion, error)
+	SetStatus(ctx context.Context, userID string, status int32, errMsg string) error
+}
+
+type UserDataExport interface {
+	Create(ctx context.Context, export *model.UserDataExport) error
+	// FindByUser returns the newest exports of the user first.
+	FindByUser(ctx context.Context, userID string, limit int64) ([]*model.UserDataExport, error)
+	// Acquire takes a pending export whose lease has expired and leases it until leaseExpire, nil when none is left.
+	Acquire(ctx context.Context, leaseExpire time.Time) (*model.UserDataExport, error)
+	Finish(ctx context.Context, exportID string, status int32, object string, errMsg string) error
+	DeleteByUser(ctx context.Context, userID string) error
+}
@@ -0,0 +1,32 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+)
+
+type VersionLog interface {
+	// IncrVersion bumps the version of the list dID and records the state of the elements eIDs at the new version.
+	IncrVersion(ctx context.Context, dID string, eIDs []string, state int32) error
+	// IncrVersions bumps the version of every list dIDs and records the state of the element eID in each of them.
+	IncrVersions(ctx context.Context, dIDs []string, eID string, state int32) error
+	// TakeVersion returns the head of the list, it is not found before the first change.
+	TakeVersion(ctx context.Context, dID string) (*model.VersionLog, error)
+	// FindChangeLog returns at most limit elements changed after version, in version order.
+	FindChangeLog(ctx context.Context, dID string, version uint64, limit int) ([]*model.VersionLogElem, error)
+}
@@ -0,0 +1,56 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"time"
+)
+
+const (
+	APIKeyStatusNormal  = 0
+	APIKeyStatusRevoked = 1
+)
+
+// APIKey is a credential for server-side integrations, limited to the scopes it was granted.
+type APIKey struct {
+	KeyID string `bson:"key_id"`
+	Name  string `bson:"name"`
+	// SecretHash is the hex SHA-256 of the key secret, the secret itself is only shown on creation.
+	SecretHash string   `bson:"secret_hash"`
+	Scopes     []string `bson:"scopes"`
+	// IPAllowlist holds IPs or CIDRs the key may be used from, empty means any.
+	IPAllowlist   []string  `bson:"ip_allowlist"`
+	ExpireTime    time.Time `bson:"expire_time"`
+	Status        int32     `bson:"status"`
+	CreatorUserID string    `bson:"creator_user_id"`
+	CreateTime    time.Time `bson:"create_time"`
+}
+
+const (
+	APIKeyActionCreate = "create"
+	APIKeyActionRevoke = "revoke"
+	APIKeyActionDeny   = "deny"
+)
+
+// APIKeyAudit records the management of an API key and the requests it was refused for.
+type APIKeyAudit struct {
+	KeyID          string    `bson:"key_id"`
+	Action         string    `bson:"action"`
+	OperatorUserID string    `bson:"operator_user_id"`
+	IP             string    `bson:"ip"`
+	Scope          string    `bson:"scope"`
+	Reason         string    `bson:"reason"`
+	CreateTime     time.Time `bson:"create_time"`
+}
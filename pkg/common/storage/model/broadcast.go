@@ -0,0 +1,69 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"time"
+)
+
+const (
+	BroadcastStatusPending   = 0
+	BroadcastStatusRunning   = 1
+	BroadcastStatusCompleted = 2
+	BroadcastStatusCanceled  = 3
+	BroadcastStatusFailed    = 4
+)
+
+// BroadcastJob is a message sent to many users in batches by a background worker.
+type BroadcastJob struct {
+	JobID          string `bson:"job_id"`
+	Mode           int32  `bson:"mode"`
+	FilterUserID   string `bson:"filter_user_id"`
+	FilterNickname string `bson:"filter_nickname"`
+	// Msg is the protobuf encoded sdkws.MsgData, cloned for every recipient.
+	Msg    []byte `bson:"msg"`
+	Status int32  `bson:"status"`
+	Total  int64  `bson:"total"`
+	Sent   int64  `bson:"sent"`
+	Failed int64  `bson:"failed"`
+	// Cursor is the number of recipients already processed, a restarted job resumes from it.
+	Cursor        int64  `bson:"cursor"`
+	CreatorUserID string `bson:"creator_user_id"`
+	OperationID   string `bson:"operation_id"`
+	// Owner and LeaseExpire mark the worker processing the job, an expired lease can be taken over.
+	Owner       string    `bson:"owner"`
+	LeaseExpire time.Time `bson:"lease_expire"`
+	CreateTime  time.Time `bson:"create_time"`
+	UpdateTime  time.Time `bson:"update_time"`
+	FinishTime  time.Time `bson:"finish_time"`
+	// Error is why a failed job could not run.
+	Error string `bson:"error"`
+}
+
+// BroadcastRecipient is a recipient of a job listing its users, stored apart from the job so the list is not bound by the document size.
+type BroadcastRecipient struct {
+	JobID string `bson:"job_id"`
+	// Index is the position of the user in the list, the cursor of the job refers to it.
+	Index  int64  `bson:"index"`
+	UserID string `bson:"user_id"`
+}
+
+// BroadcastFailed is a recipient a broadcast job could not send to.
+type BroadcastFailed struct {
+	JobID      string    `bson:"job_id"`
+	UserID     string    `bson:"user_id"`
+	Error      string    `bson:"error"`
+	CreateTime time.Time `bson:"create_time"`
+}
@@ -0,0 +1,41 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"time"
+)
+
+// Community is a space grouping several groups, its channels, under one membership.
+type Community struct {
+	CommunityID   string    `bson:"community_id"`
+	Name          string    `bson:"name"`
+	FaceURL       string    `bson:"face_url"`
+	Introduction  string    `bson:"introduction"`
+	OwnerUserID   string    `bson:"owner_user_id"`
+	InviteOnly    bool      `bson:"invite_only"`
+	CreatorUserID string    `bson:"creator_user_id"`
+	CreateTime    time.Time `bson:"create_time"`
+	Ex            string    `bson:"ex"`
+}
+
+// CommunityMember uses the role levels of group members.
+type CommunityMember struct {
+	CommunityID   string    `bson:"community_id"`
+	UserID        string    `bson:"user_id"`
+	RoleLevel     int32     `bson:"role_level"`
+	JoinTime      time.Time `bson:"join_time"`
+	InviterUserID string    `bson:"inviter_user_id"`
+}
@@ -0,0 +1,35 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"time"
+)
+
+// ContactHash is a hashed verified phone number or email a user can be discovered by.
+type ContactHash struct {
+	Hash       string    `bson:"hash"`
+	Type       int32     `bson:"type"`
+	UserID     string    `bson:"user_id"`
+	CreateTime time.Time `bson:"create_time"`
+}
+
+// ContactDiscoverySetting holds by which identifiers a user can be discovered, users are not discoverable by default.
+type ContactDiscoverySetting struct {
+	UserID     string    `bson:"user_id"`
+	ByPhone    bool      `bson:"by_phone"`
+	ByEmail    bool      `bson:"by_email"`
+	UpdateTime time.Time `bson:"update_time"`
+}
@@ -0,0 +1,27 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"time"
+)
+
+// ConversationDraft is the unsent text of a user in a conversation, an empty draft means the draft was cleared.
+type ConversationDraft struct {
+	OwnerUserID    string    `bson:"owner_user_id"`
+	ConversationID string    `bson:"conversation_id"`
+	Draft          string    `bson:"draft"`
+	UpdateTime     time.Time `bson:"update_time"`
+}
@@ -0,0 +1,30 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"time"
+)
+
+// ConversationFolder is a folder a user sorts their conversations into, a conversation can be in several folders.
+type ConversationFolder struct {
+	OwnerUserID     string    `bson:"owner_user_id"`
+	FolderID        string    `bson:"folder_id"`
+	Name            string    `bson:"name"`
+	Order           int32     `bson:"order"`
+	ConversationIDs []string  `bson:"conversation_ids"`
+	Ex              string    `bson:"ex"`
+	CreateTime      time.Time `bson:"create_time"`
+}
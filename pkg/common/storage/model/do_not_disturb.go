@@ -0,0 +1,44 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"time"
+)
+
+// DoNotDisturb holds the quiet hours of a user and the conversations the user muted for a while,
+// offline push is held back while either applies.
+type DoNotDisturb struct {
+	UserID                string                 `bson:"user_id"`
+	TimeZone              string                 `bson:"time_zone"`
+	Schedules             []DoNotDisturbSchedule `bson:"schedules"`
+	AllowMentions         bool                   `bson:"allow_mentions"`
+	ExceptConversationIDs []string               `bson:"except_conversation_ids"`
+	MutedConversations    []MutedConversation    `bson:"muted_conversations"`
+	UpdateTime            time.Time              `bson:"update_time"`
+}
+
+// DoNotDisturbSchedule is a daily quiet period, minutes are in the time zone of the user.
+// A period whose end is not after its start ends on the next day.
+type DoNotDisturbSchedule struct {
+	Weekdays    []int32 `bson:"weekdays"`
+	StartMinute int32   `bson:"start_minute"`
+	EndMinute   int32   `bson:"end_minute"`
+}
+
+type MutedConversation struct {
+	ConversationID string    `bson:"conversation_id"`
+	MuteUntil      time.Time `bson:"mute_until"`
+}
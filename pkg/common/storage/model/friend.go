@@ -28,4 +28,5 @@ type Friend struct {
 	OperatorUserID string    `bson:"operator_user_id"`
 	Ex             string    `bson:"ex"`
 	IsPinned       bool      `bson:"is_pinned"`
+	GroupIDs       []string  `bson:"group_ids"`
 }
@@ -0,0 +1,30 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"time"
+)
+
+// FriendGroup is a group a user sorts their friends into, a friend can be in several groups.
+// The groups of a friend are kept in Friend.GroupIDs.
+type FriendGroup struct {
+	OwnerUserID string    `bson:"owner_user_id"`
+	GroupID     string    `bson:"group_id"`
+	Name        string    `bson:"name"`
+	Order       int32     `bson:"order"`
+	Ex          string    `bson:"ex"`
+	CreateTime  time.Time `bson:"create_time"`
+}
@@ -29,3 +29,12 @@ type FriendRequest struct {
 	HandleTime    time.Time `bson:"handle_time"`
 	Ex            string    `bson:"ex"`
 }
+
+// FriendRequestSender counts the friend requests a user sent by how they were handled.
+type FriendRequestSender struct {
+	UserID   string `bson:"_id"`
+	Sent     int64  `bson:"sent"`
+	Accepted int64  `bson:"accepted"`
+	Refused  int64  `bson:"refused"`
+	Pending  int64  `bson:"pending"`
+}
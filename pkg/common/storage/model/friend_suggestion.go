@@ -0,0 +1,34 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"time"
+)
+
+// FriendSuggestion is a user suggested to another one, the suggestions with higher scores come first.
+type FriendSuggestion struct {
+	UserID        string
+	MutualFriends int64
+	SharedGroups  int64
+	Score         float64
+}
+
+// FriendSuggestionDismiss is a user the owner does not want to be suggested again.
+type FriendSuggestionDismiss struct {
+	OwnerUserID string    `bson:"owner_user_id"`
+	UserID      string    `bson:"user_id"`
+	CreateTime  time.Time `bson:"create_time"`
+}
@@ -34,4 +34,17 @@ type Group struct {
 	ApplyMemberFriend      int32     `bson:"apply_member_friend"`
 	NotificationUpdateTime time.Time `bson:"notification_update_time"`
 	NotificationUserID     string    `bson:"notification_user_id"`
+	SlowModeSeconds        int32     `bson:"slow_mode_seconds"`
+	MaxMsgLength           int32     `bson:"max_msg_length"`
+	DisallowLinks          bool      `bson:"disallow_links"`
+	DisallowAttachments    bool      `bson:"disallow_attachments"`
+	DirectoryListed        bool      `bson:"directory_listed"`
+	DirectoryCategories    []string  `bson:"directory_categories"`
+	DirectoryDescription   string    `bson:"directory_description"`
+	// MemberCount and Activity are refreshed periodically to sort the directory
+	MemberCount int64 `bson:"member_count"`
+	Activity    int64 `bson:"activity"`
+	// CommunityID is set when the group is a channel of a community
+	CommunityID   string `bson:"community_id"`
+	PublicChannel bool   `bson:"public_channel"`
 }
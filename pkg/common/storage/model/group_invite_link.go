@@ -0,0 +1,43 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"time"
+)
+
+// GroupInviteLink is a shareable token letting users join a group.
+type GroupInviteLink struct {
+	Token          string    `bson:"token"`
+	GroupID        string    `bson:"group_id"`
+	CreatorUserID  string    `bson:"creator_user_id"`
+	ExpireTime     time.Time `bson:"expire_time"`
+	MaxUses        int32     `bson:"max_uses"`
+	UseCount       int32     `bson:"use_count"`
+	BypassApproval bool      `bson:"bypass_approval"`
+	Revoked        bool      `bson:"revoked"`
+	CreateTime     time.Time `bson:"create_time"`
+}
+
+// Usable reports whether the link can still be used at now, a zero ExpireTime or MaxUses means no limit.
+func (l *GroupInviteLink) Usable(now time.Time) bool {
+	if l.Revoked {
+		return false
+	}
+	if !l.ExpireTime.IsZero() && !now.Before(l.ExpireTime) {
+		return false
+	}
+	return l.MaxUses == 0 || l.UseCount < l.MaxUses
+}
@@ -0,0 +1,53 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"time"
+)
+
+// GroupJoinQuestion is a question applicants answer when requesting to join a group.
+type GroupJoinQuestion struct {
+	QuestionID      string   `bson:"question_id"`
+	Type            int32    `bson:"type"`
+	Content         string   `bson:"content"`
+	Options         []string `bson:"options"`
+	Required        bool     `bson:"required"`
+	AcceptedAnswers []string `bson:"accepted_answers"`
+}
+
+// GroupJoinQuestionnaire holds the join questions of a group and whether matching answers are approved automatically.
+type GroupJoinQuestionnaire struct {
+	GroupID     string              `bson:"group_id"`
+	Questions   []GroupJoinQuestion `bson:"questions"`
+	AutoApprove bool                `bson:"auto_approve"`
+	UpdateTime  time.Time           `bson:"update_time"`
+}
+
+type GroupJoinAnswer struct {
+	QuestionID string   `bson:"question_id"`
+	Values     []string `bson:"values"`
+}
+
+// GroupRequestAudit records who handled a group join request and how.
+type GroupRequestAudit struct {
+	GroupID      string    `bson:"group_id"`
+	UserID       string    `bson:"user_id"`
+	HandleUserID string    `bson:"handle_user_id"`
+	HandleResult int32     `bson:"handle_result"`
+	HandledMsg   string    `bson:"handled_msg"`
+	HandleType   int32     `bson:"handle_type"`
+	HandledTime  time.Time `bson:"handled_time"`
+}
@@ -30,4 +30,6 @@ type GroupRequest struct {
 	JoinSource    int32     `bson:"join_source"`
 	InviterUserID string    `bson:"inviter_user_id"`
 	Ex            string    `bson:"ex"`
+	// Answers to the join questionnaire of the group
+	Answers []GroupJoinAnswer `bson:"answers"`
 }
@@ -0,0 +1,68 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"time"
+)
+
+type PollOption struct {
+	OptionID string `bson:"option_id"`
+	Text     string `bson:"text"`
+}
+
+// Poll is the server side state of a poll message.
+type Poll struct {
+	PollID         string       `bson:"poll_id"`
+	ConversationID string       `bson:"conversation_id"`
+	SessionType    int32        `bson:"session_type"`
+	GroupID        string       `bson:"group_id"`
+	SendID         string       `bson:"send_id"`
+	RecvID         string       `bson:"recv_id"`
+	ClientMsgID    string       `bson:"client_msg_id"`
+	Question       string       `bson:"question"`
+	Options        []PollOption `bson:"options"`
+	MultiChoice    bool         `bson:"multi_choice"`
+	Anonymous      bool         `bson:"anonymous"`
+	CloseTime      time.Time    `bson:"close_time"`
+	Closed         bool         `bson:"closed"`
+	CreateTime     time.Time    `bson:"create_time"`
+}
+
+// IsClosed reports whether votes are no longer accepted at now, a zero CloseTime means the poll stays open.
+func (p *Poll) IsClosed(now time.Time) bool {
+	return p.Closed || (!p.CloseTime.IsZero() && !now.Before(p.CloseTime))
+}
+
+type PollVote struct {
+	PollID    string    `bson:"poll_id"`
+	UserID    string    `bson:"user_id"`
+	OptionIDs []string  `bson:"option_ids"`
+	VoteTime  time.Time `bson:"vote_time"`
+}
+
+// PollTally counts the votes of one option, UserIDs holds only the first voters.
+type PollTally struct {
+	PollID   string   `bson:"poll_id"`
+	OptionID string   `bson:"option_id"`
+	Count    int64    `bson:"count"`
+	UserIDs  []string `bson:"user_ids"`
+}
+
+// PollVoterCount is the number of users who voted in a poll.
+type PollVoterCount struct {
+	PollID string `bson:"_id"`
+	Count  int64  `bson:"count"`
+}
@@ -18,6 +18,12 @@ import (
 	"time"
 )
 
+const (
+	UserStatusNormal = 0
+	// UserStatusDeleted marks a purged account, its row is kept for the messages it sent.
+	UserStatusDeleted = 1
+)
+
 type User struct {
 	UserID           string    `bson:"user_id"`
 	Nickname         string    `bson:"nickname"`
@@ -25,6 +31,7 @@ type User struct {
 	Ex               string    `bson:"ex"`
 	AppMangerLevel   int32     `bson:"app_manger_level"`
 	GlobalRecvMsgOpt int32     `bson:"global_recv_msg_opt"`
+	Status           int32     `bson:"status"`
 	CreateTime       time.Time `bson:"create_time"`
 }
 
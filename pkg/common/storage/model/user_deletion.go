@@ -0,0 +1,55 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"time"
+)
+
+const (
+	UserDeletionStatusPending = 0
+	UserDeletionStatusPurged  = 1
+)
+
+const (
+	UserDataExportStatusPending   = 0
+	UserDataExportStatusSucceeded = 1
+	UserDataExportStatusFailed    = 2
+)
+
+// UserDeletion is an account deletion requested by the user, the account is purged once PurgeTime has passed.
+type UserDeletion struct {
+	UserID      string    `bson:"user_id"`
+	Status      int32     `bson:"status"`
+	RequestTime time.Time `bson:"request_time"`
+	// PurgeTime is pushed back by the retry interval every time a purge of the account is attempted.
+	PurgeTime time.Time `bson:"purge_time"`
+	Attempts  int32     `bson:"attempts"`
+	Error     string    `bson:"error"`
+}
+
+// UserDataExport is an archive of the data of a user, built by the cron task and uploaded through the third service.
+type UserDataExport struct {
+	ExportID string `bson:"export_id"`
+	UserID   string `bson:"user_id"`
+	Status   int32  `bson:"status"`
+	// Object is the name of the uploaded archive in the object storage.
+	Object string `bson:"object"`
+	Error  string `bson:"error"`
+	// LeaseExpire marks the worker building the archive, an expired lease can be taken over.
+	LeaseExpire time.Time `bson:"lease_expire"`
+	CreateTime  time.Time `bson:"create_time"`
+	FinishTime  time.Time `bson:"finish_time"`
+}
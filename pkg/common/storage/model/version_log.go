@@ -0,0 +1,45 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	VersionStateInsert = 1
+	VersionStateDelete = 2
+	VersionStateUpdate = 3
+)
+
+// VersionLog is the head of the change log of a list, such as the conversations of a user.
+// The ID changes when the log is recreated, clients holding another ID must resync in full.
+type VersionLog struct {
+	ID         primitive.ObjectID `bson:"_id"`
+	DID        string             `bson:"d_id"`
+	Version    uint64             `bson:"version"`
+	LastUpdate time.Time          `bson:"last_update"`
+}
+
+// VersionLogElem is the latest change of an element of a list.
+type VersionLogElem struct {
+	DID        string    `bson:"d_id"`
+	EID        string    `bson:"e_id"`
+	State      int32     `bson:"state"`
+	Version    uint64    `bson:"version"`
+	LastUpdate time.Time `bson:"last_update"`
+}
@@ -38,7 +38,7 @@ func InitLocalCache(localCache *config.LocalCache) {
 			},
 			{
 				Local: localCache.Group,
-				Keys:  []string{cachekey.GroupMemberIDsKey, cachekey.GroupInfoKey, cachekey.GroupMemberInfoKey},
+				Keys:  []string{cachekey.GroupMemberIDsKey, cachekey.GroupInfoKey, cachekey.GroupMemberInfoKey, cachekey.GroupRolesKey, cachekey.GroupMsgPolicyKey, cachekey.GroupCommunityKey, cachekey.CommunityMemberKey},
 			},
 			{
 				Local: localCache.Friend,
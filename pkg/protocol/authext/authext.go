@@ -0,0 +1,126 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package authext
+
+import (
+	"errors"
+
+	"github.com/openimsdk/protocol/constant"
+)
+
+func (x *IssueTokenReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	if x.PlatformID > constant.AdminPlatformID || x.PlatformID < constant.IOSPlatformID {
+		return errors.New("platform is invalidate")
+	}
+	return nil
+}
+
+func (x *RefreshTokenReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	if x.PlatformID > constant.AdminPlatformID || x.PlatformID < constant.IOSPlatformID {
+		return errors.New("platform is invalidate")
+	}
+	if x.RefreshToken == "" {
+		return errors.New("refreshToken is empty")
+	}
+	return nil
+}
+
+func (x *OidcLoginReq) Check() error {
+	if x.IdToken == "" {
+		return errors.New("idToken is empty")
+	}
+	if x.PlatformID > constant.AdminPlatformID || x.PlatformID < constant.IOSPlatformID {
+		return errors.New("platform is invalidate")
+	}
+	return nil
+}
+
+func (x *GetSessionsReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	return nil
+}
+
+func (x *RevokeSessionReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	if x.SessionID == "" {
+		return errors.New("sessionID is empty")
+	}
+	return nil
+}
+
+func (x *TouchSessionReq) Check() error {
+	if x.Token == "" {
+		return errors.New("token is empty")
+	}
+	return nil
+}
+
+func (x *CreateAPIKeyReq) Check() error {
+	if x.Name == "" {
+		return errors.New("name is empty")
+	}
+	if len(x.Scopes) == 0 {
+		return errors.New("scopes is empty")
+	}
+	return nil
+}
+
+func (x *RevokeAPIKeyReq) Check() error {
+	if x.KeyID == "" {
+		return errors.New("keyID is empty")
+	}
+	return nil
+}
+
+func (x *GetAPIKeysReq) Check() error {
+	if x.Pagination == nil {
+		return errors.New("pagination is empty")
+	}
+	return nil
+}
+
+func (x *GetAPIKeyAuditsReq) Check() error {
+	if x.Pagination == nil {
+		return errors.New("pagination is empty")
+	}
+	return nil
+}
+
+func (x *VerifyAPIKeyReq) Check() error {
+	if x.ApiKey == "" {
+		return errors.New("apiKey is empty")
+	}
+	if x.Scope == "" {
+		return errors.New("scope is empty")
+	}
+	return nil
+}
+
+func (x *RevokeUserTokensReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	return nil
+}
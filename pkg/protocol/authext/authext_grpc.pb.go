@@ -0,0 +1,585 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: authext/authext.proto
+
+package authext
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	AuthExt_IssueToken_FullMethodName       = "/openim.authext.AuthExt/issueToken"
+	AuthExt_RefreshToken_FullMethodName     = "/openim.authext.AuthExt/refreshToken"
+	AuthExt_GetJWKS_FullMethodName          = "/openim.authext.AuthExt/getJWKS"
+	AuthExt_OidcLogin_FullMethodName        = "/openim.authext.AuthExt/oidcLogin"
+	AuthExt_GetSessions_FullMethodName      = "/openim.authext.AuthExt/getSessions"
+	AuthExt_RevokeSession_FullMethodName    = "/openim.authext.AuthExt/revokeSession"
+	AuthExt_TouchSession_FullMethodName     = "/openim.authext.AuthExt/touchSession"
+	AuthExt_CreateAPIKey_FullMethodName     = "/openim.authext.AuthExt/createAPIKey"
+	AuthExt_RevokeAPIKey_FullMethodName     = "/openim.authext.AuthExt/revokeAPIKey"
+	AuthExt_GetAPIKeys_FullMethodName       = "/openim.authext.AuthExt/getAPIKeys"
+	AuthExt_GetAPIKeyAudits_FullMethodName  = "/openim.authext.AuthExt/getAPIKeyAudits"
+	AuthExt_VerifyAPIKey_FullMethodName     = "/openim.authext.AuthExt/verifyAPIKey"
+	AuthExt_RevokeUserTokens_FullMethodName = "/openim.authext.AuthExt/revokeUserTokens"
+)
+
+// AuthExtClient is the client API for AuthExt service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AuthExtClient interface {
+	// Generate an access token, together with a refresh token when the refresh policy is enabled
+	IssueToken(ctx context.Context, in *IssueTokenReq, opts ...grpc.CallOption) (*IssueTokenResp, error)
+	// Rotate a refresh token and issue a new access token
+	RefreshToken(ctx context.Context, in *RefreshTokenReq, opts ...grpc.CallOption) (*RefreshTokenResp, error)
+	// Public keys that verify tokens, in JWKS format
+	GetJWKS(ctx context.Context, in *GetJWKSReq, opts ...grpc.CallOption) (*GetJWKSResp, error)
+	// Exchange an ID token of a trusted OpenID Connect issuer for a token
+	OidcLogin(ctx context.Context, in *OidcLoginReq, opts ...grpc.CallOption) (*OidcLoginResp, error)
+	// Devices the user is logged in on
+	GetSessions(ctx context.Context, in *GetSessionsReq, opts ...grpc.CallOption) (*GetSessionsResp, error)
+	// Kick a single device of the user
+	RevokeSession(ctx context.Context, in *RevokeSessionReq, opts ...grpc.CallOption) (*RevokeSessionResp, error)
+	// Record a long connection established with a token, called by msg gateway
+	TouchSession(ctx context.Context, in *TouchSessionReq, opts ...grpc.CallOption) (*TouchSessionResp, error)
+	// Create a scoped API key for server-side integrations
+	CreateAPIKey(ctx context.Context, in *CreateAPIKeyReq, opts ...grpc.CallOption) (*CreateAPIKeyResp, error)
+	RevokeAPIKey(ctx context.Context, in *RevokeAPIKeyReq, opts ...grpc.CallOption) (*RevokeAPIKeyResp, error)
+	GetAPIKeys(ctx context.Context, in *GetAPIKeysReq, opts ...grpc.CallOption) (*GetAPIKeysResp, error)
+	GetAPIKeyAudits(ctx context.Context, in *GetAPIKeyAuditsReq, opts ...grpc.CallOption) (*GetAPIKeyAuditsResp, error)
+	// Check an API key for a request of the scope, called by the api server
+	VerifyAPIKey(ctx context.Context, in *VerifyAPIKeyReq, opts ...grpc.CallOption) (*VerifyAPIKeyResp, error)
+	// Admin only, kick all devices of the user and revoke their tokens and refresh tokens
+	RevokeUserTokens(ctx context.Context, in *RevokeUserTokensReq, opts ...grpc.CallOption) (*RevokeUserTokensResp, error)
+}
+
+type authExtClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAuthExtClient(cc grpc.ClientConnInterface) AuthExtClient {
+	return &authExtClient{cc}
+}
+
+func (c *authExtClient) IssueToken(ctx context.Context, in *IssueTokenReq, opts ...grpc.CallOption) (*IssueTokenResp, error) {
+	out := new(IssueTokenResp)
+	err := c.cc.Invoke(ctx, AuthExt_IssueToken_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authExtClient) RefreshToken(ctx context.Context, in *RefreshTokenReq, opts ...grpc.CallOption) (*RefreshTokenResp, error) {
+	out := new(RefreshTokenResp)
+	err := c.cc.Invoke(ctx, AuthExt_RefreshToken_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authExtClient) GetJWKS(ctx context.Context, in *GetJWKSReq, opts ...grpc.CallOption) (*GetJWKSResp, error) {
+	out := new(GetJWKSResp)
+	err := c.cc.Invoke(ctx, AuthExt_GetJWKS_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authExtClient) OidcLogin(ctx context.Context, in *OidcLoginReq, opts ...grpc.CallOption) (*OidcLoginResp, error) {
+	out := new(OidcLoginResp)
+	err := c.cc.Invoke(ctx, AuthExt_OidcLogin_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authExtClient) GetSessions(ctx context.Context, in *GetSessionsReq, opts ...grpc.CallOption) (*GetSessionsResp, error) {
+	out := new(GetSessionsResp)
+	err := c.cc.Invoke(ctx, AuthExt_GetSessions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authExtClient) RevokeSession(ctx context.Context, in *RevokeSessionReq, opts ...grpc.CallOption) (*RevokeSessionResp, error) {
+	out := new(RevokeSessionResp)
+	err := c.cc.Invoke(ctx, AuthExt_RevokeSession_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authExtClient) TouchSession(ctx context.Context, in *TouchSessionReq, opts ...grpc.CallOption) (*TouchSessionResp, error) {
+	out := new(TouchSessionResp)
+	err := c.cc.Invoke(ctx, AuthExt_TouchSession_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authExtClient) CreateAPIKey(ctx context.Context, in *CreateAPIKeyReq, opts ...grpc.CallOption) (*CreateAPIKeyResp, error) {
+	out := new(CreateAPIKeyResp)
+	err := c.cc.Invoke(ctx, AuthExt_CreateAPIKey_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authExtClient) RevokeAPIKey(ctx context.Context, in *RevokeAPIKeyReq, opts ...grpc.CallOption) (*RevokeAPIKeyResp, error) {
+	out := new(RevokeAPIKeyResp)
+	err := c.cc.Invoke(ctx, AuthExt_RevokeAPIKey_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authExtClient) GetAPIKeys(ctx context.Context, in *GetAPIKeysReq, opts ...grpc.CallOption) (*GetAPIKeysResp, error) {
+	out := new(GetAPIKeysResp)
+	err := c.cc.Invoke(ctx, AuthExt_GetAPIKeys_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authExtClient) GetAPIKeyAudits(ctx context.Context, in *GetAPIKeyAuditsReq, opts ...grpc.CallOption) (*GetAPIKeyAuditsResp, error) {
+	out := new(GetAPIKeyAuditsResp)
+	err := c.cc.Invoke(ctx, AuthExt_GetAPIKeyAudits_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authExtClient) VerifyAPIKey(ctx context.Context, in *VerifyAPIKeyReq, opts ...grpc.CallOption) (*VerifyAPIKeyResp, error) {
+	out := new(VerifyAPIKeyResp)
+	err := c.cc.Invoke(ctx, AuthExt_VerifyAPIKey_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authExtClient) RevokeUserTokens(ctx context.Context, in *RevokeUserTokensReq, opts ...grpc.CallOption) (*RevokeUserTokensResp, error) {
+	out := new(RevokeUserTokensResp)
+	err := c.cc.Invoke(ctx, AuthExt_RevokeUserTokens_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AuthExtServer is the server API for AuthExt service.
+// All implementations should embed UnimplementedAuthExtServer
+// for forward compatibility
+type AuthExtServer interface {
+	// Generate an access token, together with a refresh token when the refresh policy is enabled
+	IssueToken(context.Context, *IssueTokenReq) (*IssueTokenResp, error)
+	// Rotate a refresh token and issue a new access token
+	RefreshToken(context.Context, *RefreshTokenReq) (*RefreshTokenResp, error)
+	// Public keys that verify tokens, in JWKS format
+	GetJWKS(context.Context, *GetJWKSReq) (*GetJWKSResp, error)
+	// Exchange an ID token of a trusted OpenID Connect issuer for a token
+	OidcLogin(context.Context, *OidcLoginReq) (*OidcLoginResp, error)
+	// Devices the user is logged in on
+	GetSessions(context.Context, *GetSessionsReq) (*GetSessionsResp, error)
+	// Kick a single device of the user
+	RevokeSession(context.Context, *RevokeSessionReq) (*RevokeSessionResp, error)
+	// Record a long connection established with a token, called by msg gateway
+	TouchSession(context.Context, *TouchSessionReq) (*TouchSessionResp, error)
+	// Create a scoped API key for server-side integrations
+	CreateAPIKey(context.Context, *CreateAPIKeyReq) (*CreateAPIKeyResp, error)
+	RevokeAPIKey(context.Context, *RevokeAPIKeyReq) (*RevokeAPIKeyResp, error)
+	GetAPIKeys(context.Context, *GetAPIKeysReq) (*GetAPIKeysResp, error)
+	GetAPIKeyAudits(context.Context, *GetAPIKeyAuditsReq) (*GetAPIKeyAuditsResp, error)
+	// Check an API key for a request of the scope, called by the api server
+	VerifyAPIKey(context.Context, *VerifyAPIKeyReq) (*VerifyAPIKeyResp, error)
+	// Admin only, kick all devices of the user and revoke their tokens and refresh tokens
+	RevokeUserTokens(context.Context, *RevokeUserTokensReq) (*RevokeUserTokensResp, error)
+}
+
+// UnimplementedAuthExtServer should be embedded to have forward compatible implementations.
+type UnimplementedAuthExtServer struct {
+}
+
+func (UnimplementedAuthExtServer) IssueToken(context.Context, *IssueTokenReq) (*IssueTokenResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IssueToken not implemented")
+}
+func (UnimplementedAuthExtServer) RefreshToken(context.Context, *RefreshTokenReq) (*RefreshTokenResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshToken not implemented")
+}
+func (UnimplementedAuthExtServer) GetJWKS(context.Context, *GetJWKSReq) (*GetJWKSResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJWKS not implemented")
+}
+func (UnimplementedAuthExtServer) OidcLogin(context.Context, *OidcLoginReq) (*OidcLoginResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OidcLogin not implemented")
+}
+func (UnimplementedAuthExtServer) GetSessions(context.Context, *GetSessionsReq) (*GetSessionsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSessions not implemented")
+}
+func (UnimplementedAuthExtServer) RevokeSession(context.Context, *RevokeSessionReq) (*RevokeSessionResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeSession not implemented")
+}
+func (UnimplementedAuthExtServer) TouchSession(context.Context, *TouchSessionReq) (*TouchSessionResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TouchSession not implemented")
+}
+func (UnimplementedAuthExtServer) CreateAPIKey(context.Context, *CreateAPIKeyReq) (*CreateAPIKeyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAPIKey not implemented")
+}
+func (UnimplementedAuthExtServer) RevokeAPIKey(context.Context, *RevokeAPIKeyReq) (*RevokeAPIKeyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAPIKey not implemented")
+}
+func (UnimplementedAuthExtServer) GetAPIKeys(context.Context, *GetAPIKeysReq) (*GetAPIKeysResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAPIKeys not implemented")
+}
+func (UnimplementedAuthExtServer) GetAPIKeyAudits(context.Context, *GetAPIKeyAuditsReq) (*GetAPIKeyAuditsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAPIKeyAudits not implemented")
+}
+func (UnimplementedAuthExtServer) VerifyAPIKey(context.Context, *VerifyAPIKeyReq) (*VerifyAPIKeyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyAPIKey not implemented")
+}
+func (UnimplementedAuthExtServer) RevokeUserTokens(context.Context, *RevokeUserTokensReq) (*RevokeUserTokensResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeUserTokens not implemented")
+}
+
+// UnsafeAuthExtServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AuthExtServer will
+// result in compilation errors.
+type UnsafeAuthExtServer interface {
+	mustEmbedUnimplementedAuthExtServer()
+}
+
+func RegisterAuthExtServer(s grpc.ServiceRegistrar, srv AuthExtServer) {
+	s.RegisterService(&AuthExt_ServiceDesc, srv)
+}
+
+func _AuthExt_IssueToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IssueTokenReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthExtServer).IssueToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthExt_IssueToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthExtServer).IssueToken(ctx, req.(*IssueTokenReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthExt_RefreshToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshTokenReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthExtServer).RefreshToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthExt_RefreshToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthExtServer).RefreshToken(ctx, req.(*RefreshTokenReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthExt_GetJWKS_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJWKSReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthExtServer).GetJWKS(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthExt_GetJWKS_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthExtServer).GetJWKS(ctx, req.(*GetJWKSReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthExt_OidcLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OidcLoginReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthExtServer).OidcLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthExt_OidcLogin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthExtServer).OidcLogin(ctx, req.(*OidcLoginReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthExt_GetSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSessionsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthExtServer).GetSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthExt_GetSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthExtServer).GetSessions(ctx, req.(*GetSessionsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthExt_RevokeSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeSessionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthExtServer).RevokeSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthExt_RevokeSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthExtServer).RevokeSession(ctx, req.(*RevokeSessionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthExt_TouchSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TouchSessionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthExtServer).TouchSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthExt_TouchSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthExtServer).TouchSession(ctx, req.(*TouchSessionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthExt_CreateAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAPIKeyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthExtServer).CreateAPIKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthExt_CreateAPIKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthExtServer).CreateAPIKey(ctx, req.(*CreateAPIKeyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthExt_RevokeAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeAPIKeyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthExtServer).RevokeAPIKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthExt_RevokeAPIKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthExtServer).RevokeAPIKey(ctx, req.(*RevokeAPIKeyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthExt_GetAPIKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAPIKeysReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthExtServer).GetAPIKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthExt_GetAPIKeys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthExtServer).GetAPIKeys(ctx, req.(*GetAPIKeysReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthExt_GetAPIKeyAudits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAPIKeyAuditsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthExtServer).GetAPIKeyAudits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthExt_GetAPIKeyAudits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthExtServer).GetAPIKeyAudits(ctx, req.(*GetAPIKeyAuditsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthExt_VerifyAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyAPIKeyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthExtServer).VerifyAPIKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthExt_VerifyAPIKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthExtServer).VerifyAPIKey(ctx, req.(*VerifyAPIKeyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthExt_RevokeUserTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeUserTokensReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthExtServer).RevokeUserTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthExt_RevokeUserTokens_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthExtServer).RevokeUserTokens(ctx, req.(*RevokeUserTokensReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AuthExt_ServiceDesc is the grpc.ServiceDesc for AuthExt service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AuthExt_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "openim.authext.AuthExt",
+	HandlerType: (*AuthExtServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "issueToken",
+			Handler:    _AuthExt_IssueToken_Handler,
+		},
+		{
+			MethodName: "refreshToken",
+			Handler:    _AuthExt_RefreshToken_Handler,
+		},
+		{
+			MethodName: "getJWKS",
+			Handler:    _AuthExt_GetJWKS_Handler,
+		},
+		{
+			MethodName: "oidcLogin",
+			Handler:    _AuthExt_OidcLogin_Handler,
+		},
+		{
+			MethodName: "getSessions",
+			Handler:    _AuthExt_GetSessions_Handler,
+		},
+		{
+			MethodName: "revokeSession",
+			Handler:    _AuthExt_RevokeSession_Handler,
+		},
+		{
+			MethodName: "touchSession",
+			Handler:    _AuthExt_TouchSession_Handler,
+		},
+		{
+			MethodName: "createAPIKey",
+			Handler:    _AuthExt_CreateAPIKey_Handler,
+		},
+		{
+			MethodName: "revokeAPIKey",
+			Handler:    _AuthExt_RevokeAPIKey_Handler,
+		},
+		{
+			MethodName: "getAPIKeys",
+			Handler:    _AuthExt_GetAPIKeys_Handler,
+		},
+		{
+			MethodName: "getAPIKeyAudits",
+			Handler:    _AuthExt_GetAPIKeyAudits_Handler,
+		},
+		{
+			MethodName: "verifyAPIKey",
+			Handler:    _AuthExt_VerifyAPIKey_Handler,
+		},
+		{
+			MethodName: "revokeUserTokens",
+			Handler:    _AuthExt_RevokeUserTokens_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "authext/authext.proto",
+}
@@ -0,0 +1,203 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conversationext
+
+import (
+	"errors"
+
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/tools/utils/datautil"
+)
+
+// ConversationDraftNotification tells the devices of a user a draft changed, following constant.ConversationUnreadNotification.
+const ConversationDraftNotification = constant.ConversationUnreadNotification + 1
+
+// ConversationFolderNotification tells the devices of a user the conversation folders or their conversations changed.
+const ConversationFolderNotification = ConversationDraftNotification + 1
+
+// DoNotDisturbNotification tells the devices of a user the quiet hours changed.
+const DoNotDisturbNotification = ConversationFolderNotification + 1
+
+// MaxDraftLength is the maximum length of a draft in bytes.
+const MaxDraftLength = 8 * 1024
+
+const minutesPerDay = 24 * 60
+
+func (x *GetIncrementalConversationReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	return nil
+}
+
+func (x *CreateConversationFolderReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	if x.Name == "" {
+		return errors.New("name is empty")
+	}
+	return nil
+}
+
+func (x *SetConversationFolderReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	if x.FolderID == "" {
+		return errors.New("folderID is empty")
+	}
+	if x.Name == "" {
+		return errors.New("name is empty")
+	}
+	return nil
+}
+
+func (x *DeleteConversationFolderReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	if x.FolderID == "" {
+		return errors.New("folderID is empty")
+	}
+	return nil
+}
+
+func (x *SortConversationFoldersReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	if len(x.FolderIDs) == 0 {
+		return errors.New("folderIDs is empty")
+	}
+	if datautil.Duplicate(x.FolderIDs) {
+		return errors.New("folderIDs is duplicate")
+	}
+	return nil
+}
+
+func (x *GetConversationFoldersReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	return nil
+}
+
+func (x *AddFolderConversationsReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	if x.FolderID == "" {
+		return errors.New("folderID is empty")
+	}
+	if len(x.ConversationIDs) == 0 {
+		return errors.New("conversationIDs is empty")
+	}
+	return nil
+}
+
+func (x *RemoveFolderConversationsReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	if x.FolderID == "" {
+		return errors.New("folderID is empty")
+	}
+	if len(x.ConversationIDs) == 0 {
+		return errors.New("conversationIDs is empty")
+	}
+	return nil
+}
+
+func (x *GetFolderSortedConversationListReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	if x.FolderID == "" {
+		return errors.New("folderID is empty")
+	}
+	return nil
+}
+
+func (x *SetConversationDraftReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	if x.ConversationID == "" {
+		return errors.New("conversationID is empty")
+	}
+	if len(x.Draft) > MaxDraftLength {
+		return errors.New("draft is too long")
+	}
+	return nil
+}
+
+func (x *GetConversationDraftsReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	return nil
+}
+
+func (x *SetDoNotDisturbReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	for _, schedule := range x.Schedules {
+		if schedule.StartMinute < 0 || schedule.StartMinute >= minutesPerDay || schedule.EndMinute < 0 || schedule.EndMinute >= minutesPerDay {
+			return errors.New("schedule minute is invalid")
+		}
+		for _, weekday := range schedule.Weekdays {
+			if weekday < 0 || weekday > 6 {
+				return errors.New("schedule weekday is invalid")
+			}
+		}
+	}
+	return nil
+}
+
+func (x *GetDoNotDisturbReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	return nil
+}
+
+func (x *MuteConversationUntilReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	if x.ConversationID == "" {
+		return errors.New("conversationID is empty")
+	}
+	if x.MuteUntil < 0 {
+		return errors.New("muteUntil is invalid")
+	}
+	return nil
+}
+
+func (x *GetOfflinePushUserIDsReq) Check() error {
+	if x.ConversationID == "" {
+		return errors.New("conversationID is empty")
+	}
+	return nil
+}
+
+func (x *PurgeUserConversationsReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	return nil
+}
@@ -0,0 +1,3348 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.33.0
+// 	protoc        (unknown)
+// source: conversationext/conversationext.proto
+
+package conversationext
+
+import (
+	conversation "github.com/openimsdk/protocol/conversation"
+	sdkws "github.com/openimsdk/protocol/sdkws"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetIncrementalConversationReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	// versionID and version returned by the last sync, empty for the first sync
+	VersionID string `protobuf:"bytes,2,opt,name=versionID,proto3" json:"versionID"`
+	Version   uint64 `protobuf:"varint,3,opt,name=version,proto3" json:"version"`
+}
+
+func (x *GetIncrementalConversationReq) Reset() {
+	*x = GetIncrementalConversationReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_conversationext_conversationext_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetIncrementalConversationReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIncrementalConversationReq) ProtoMessage() {}
+
+func (x *GetIncrementalConversationReq) ProtoReflect() protoreflect.Message {
+	mi := &file_conversationext_conversationext_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIncrementalConversationReq.ProtoReflect.Descriptor instead.
+func (*GetIncrementalConversationReq) Descriptor() ([]byte, []int) {
+	return file_conversationext_conversationext_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetIncrementalConversationReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *GetIncrementalConversationReq) GetVersionID() string {
+	if x != nil {
+		return x.VersionID
+	}
+	return ""
+}
+
+func (x *GetIncrementalConversationReq) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type GetIncrementalConversationResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VersionID string `protobuf:"bytes,1,opt,name=versionID,proto3" json:"versionID"`
+	Version   uint64 `protobuf:"varint,2,opt,name=version,proto3" json:"version"`
+	// the change log can't be replayed from version, the client must fetch all conversations and keep versionID and version
+	Full bool `protobuf:"varint,3,opt,name=full,proto3" json:"full"`
+	// clients should upsert both, a conversation created and then changed since version is reported as updated
+	Insert []*conversation.Conversation `protobuf:"bytes,4,rep,name=insert,proto3" json:"insert"`
+	Update []*conversation.Conversation `protobuf:"bytes,5,rep,name=update,proto3" json:"update"`
+	Delete []string                     `protobuf:"bytes,6,rep,name=delete,proto3" json:"delete"`
+	// all drafts of the user, drafts are not versioned
+	Drafts []*ConversationDraft `protobuf:"bytes,7,rep,name=drafts,proto3" json:"drafts"`
+}
+
+func (x *GetIncrementalConversationResp) Reset() {
+	*x = GetIncrementalConversationResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_conversationext_conversationext_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetIncrementalConversationResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIncrementalConversationResp) ProtoMessage() {}
+
+func (x *GetIncrementalConversationResp) ProtoReflect() protoreflect.Message {
+	mi := &file_conversationext_conversationext_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIncrementalConversationResp.ProtoReflect.Descriptor instead.
+func (*GetIncrementalConversationResp) Descriptor() ([]byte, []int) {
+	return file_conversationext_conversationext_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetIncrementalConversationResp) GetVersionID() string {
+	if x != nil {
+		return x.VersionID
+	}
+	return ""
+}
+
+func (x *GetIncrementalConversationResp) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *GetIncrementalConversationResp) GetFull() bool {
+	if x != nil {
+		return x.Full
+	}
+	return false
+}
+
+func (x *GetIncrementalConversationResp) GetInsert() []*conversation.Conversation {
+	if x != nil {
+		return x.Insert
+	}
+	return nil
+}
+
+func (x *GetIncrementalConversationResp) GetUpdate() []*conversation.Conversation {
+	if x != nil {
+		return x.Update
+	}
+	return nil
+}
+
+func (x *GetIncrementalConversationResp) GetDelete() []string {
+	if x != nil {
+		return x.Delete
+	}
+	return nil
+}
+
+func (x *GetIncrementalConversationResp) GetDrafts() []*ConversationDraft {
+	if x != nil {
+		return x.Drafts
+	}
+	return nil
+}
+
+type ConversationFolder struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FolderID string `protobuf:"bytes,1,opt,name=folderID,proto3" json:"folderID"`
+	Name     string `protobuf:"bytes,2,opt,name=name,proto3" json:"name"`
+	// folders are listed by ascending order
+	Order           int32    `protobuf:"varint,3,opt,name=order,proto3" json:"order"`
+	ConversationIDs []string `protobuf:"bytes,4,rep,name=conversationIDs,proto3" json:"conversationIDs"`
+	Ex              string   `protobuf:"bytes,5,opt,name=ex,proto3" json:"ex"`
+	CreateTime      int64    `protobuf:"varint,6,opt,name=createTime,proto3" json:"createTime"`
+	// unread messages of the conversations in the folder, only set by getConversationFolders
+	UnreadTotal int64 `protobuf:"varint,7,opt,name=unreadTotal,proto3" json:"unreadTotal"`
+}
+
+func (x *ConversationFolder) Reset() {
+	*x = ConversationFolder{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_conversationext_conversationext_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConversationFolder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConversationFolder) ProtoMessage() {}
+
+func (x *ConversationFolder) ProtoReflect() protoreflect.Message {
+	mi := &file_conversationext_conversationext_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConversationFolder.ProtoReflect.Descriptor instead.
+func (*ConversationFolder) Descriptor() ([]byte, []int) {
+	return file_conversationext_conversationext_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ConversationFolder) GetFolderID() string {
+	if x != nil {
+		return x.FolderID
+	}
+	return ""
+}
+
+func (x *ConversationFolder) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ConversationFolder) GetOrder() int32 {
+	if x != nil {
+		return x.Order
+	}
+	return 0
+}
+
+func (x *ConversationFolder) GetConversationIDs() []string {
+	if x != nil {
+		return x.ConversationIDs
+	}
+	return nil
+}
+
+func (x *ConversationFolder) GetEx() string {
+	if x != nil {
+		return x.Ex
+	}
+	return ""
+}
+
+func (x *ConversationFolder) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+func (x *ConversationFolder) GetUnreadTotal() int64 {
+	if x != nil {
+		return x.UnreadTotal
+	}
+	return 0
+}
+
+type CreateConversationFolderReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	Name   string `protobuf:"bytes,2,opt,name=name,proto3" json:"name"`
+	Ex     string `protobuf:"bytes,3,opt,name=ex,proto3" json:"ex"`
+}
+
+func (x *CreateConversationFolderReq) Reset() {
+	*x = CreateConversationFolderReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_conversationext_conversationext_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateConversationFolderReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateConversationFolderReq) ProtoMessage() {}
+
+func (x *CreateConversationFolderReq) ProtoReflect() protoreflect.Message {
+	mi := &file_conversationext_conversationext_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateConversationFolderReq.ProtoReflect.Descriptor instead.
+func (*CreateConversationFolderReq) Descriptor() ([]byte, []int) {
+	return file_conversationext_conversationext_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateConversationFolderReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *CreateConversationFolderReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateConversationFolderReq) GetEx() string {
+	if x != nil {
+		return x.Ex
+	}
+	return ""
+}
+
+type CreateConversationFolderResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Folder *ConversationFolder `protobuf:"bytes,1,opt,name=folder,proto3" json:"folder"`
+}
+
+func (x *CreateConversationFolderResp) Reset() {
+	*x = CreateConversationFolderResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_conversationext_conversationext_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateConversationFolderResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateConversationFolderResp) ProtoMessage() {}
+
+func (x *CreateConversationFolderResp) ProtoReflect() protoreflect.Message {
+	mi := &file_conversationext_conversationext_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateConversationFolderResp.ProtoReflect.Descriptor instead.
+func (*CreateConversationFolderResp) Descriptor() ([]byte, []int) {
+	return file_conversationext_conversationext_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateConversationFolderResp) GetFolder() *ConversationFolder {
+	if x != nil {
+		return x.Folder
+	}
+	return nil
+}
+
+type SetConversationFolderReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID   string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	FolderID string `protobuf:"bytes,2,opt,name=folderID,proto3" json:"folderID"`
+	Name     string `protobuf:"bytes,3,opt,name=name,proto3" json:"name"`
+	Ex       string `protobuf:"bytes,4,opt,name=ex,proto3" json:"ex"`
+}
+
+func (x *SetConversationFolderReq) Reset() {
+	*x = SetConversationFolderReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_conversationext_conversationext_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetConversationFolderReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetConversationFolderReq) ProtoMessage() {}
+
+func (x *SetConversationFolderReq) ProtoReflect() protoreflect.Message {
+	mi := &file_conversationext_conversationext_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetConversationFolderReq.ProtoReflect.Descriptor instead.
+func (*SetConversationFolderReq) Descriptor() ([]byte, []int) {
+	return file_conversationext_conversationext_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SetConversationFolderReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *SetConversationFolderReq) GetFolderID() string {
+	if x != nil {
+		return x.FolderID
+	}
+	return ""
+}
+
+func (x *SetConversationFolderReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SetConversationFolderReq) GetEx() string {
+	if x != nil {
+		return x.Ex
+	}
+	return ""
+}
+
+type SetConversationFolderResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetConversationFolderResp) Reset() {
+	*x = SetConversationFolderResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_conversationext_conversationext_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetConversationFolderResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetConversationFolderResp) ProtoMessage() {}
+
+func (x *SetConversationFolderResp) ProtoReflect() protoreflect.Message {
+	mi := &file_conversationext_conversationext_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetConversationFolderResp.ProtoReflect.Descriptor instead.
+func (*SetConversationFolderResp) Descriptor() ([]byte, []int) {
+	return file_conversationext_conversationext_proto_rawDescGZIP(), []int{6}
+}
+
+type DeleteConversationFolderReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID   string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	FolderID string `protobuf:"bytes,2,opt,name=folderID,proto3" json:"folderID"`
+}
+
+func (x *DeleteConversationFolderReq) Reset() {
+	*x = DeleteConversationFolderReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_conversationext_conversationext_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteConversationFolderReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteConversationFolderReq) ProtoMessage() {}
+
+func (x *DeleteConversationFolderReq) ProtoReflect() protoreflect.Message {
+	mi := &file_conversationext_conversationext_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteConversationFolderReq.ProtoReflect.Descriptor instead.
+func (*DeleteConversationFolderReq) Descriptor() ([]byte, []int) {
+	return file_conversationext_conversationext_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DeleteConversationFolderReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *DeleteConversationFolderReq) GetFolderID() string {
+	if x != nil {
+		return x.FolderID
+	}
+	return ""
+}
+
+type DeleteConversationFolderResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteConversationFolderResp) Reset() {
+	*x = DeleteConversationFolderResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_conversationext_conversationext_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteConversationFolderResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteConversationFolderResp) ProtoMessage() {}
+
+func (x *DeleteConversationFolderResp) ProtoReflect() protoreflect.Message {
+	mi := &file_conversationext_conversationext_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteConversationFolderResp.ProtoReflect.Descriptor instead.
+func (*DeleteConversationFolderResp) Descriptor() ([]byte, []int) {
+	return file_conversationext_conversationext_proto_rawDescGZIP(), []int{8}
+}
+
+type SortConversationFoldersReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	// folders not listed keep their order after the listed ones
+	FolderIDs []string `protobuf:"bytes,2,rep,name=folderIDs,proto3" json:"folderIDs"`
+}
+
+func (x *SortConversationFoldersReq) Reset() {
+	*x = SortConversationFoldersReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_conversationext_conversationext_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SortConversationFoldersReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SortConversationFoldersReq) ProtoMessage() {}
+
+func (x *SortConversationFoldersReq) ProtoReflect() protoreflect.Message {
+	mi := &file_conversationext_conversationext_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SortConversationFoldersReq.ProtoReflect.Descriptor instead.
+func (*SortConversationFoldersReq) Descriptor() ([]byte, []int) {
+	return file_conversationext_conversationext_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SortConversationFoldersReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *SortConversationFoldersReq) GetFolderIDs() []string {
+	if x != nil {
+		return x.FolderIDs
+	}
+	return nil
+}
+
+type SortConversationFoldersResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SortConversationFoldersResp) Reset() {
+	*x = SortConversationFoldersResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_conversationext_conversationext_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SortConversationFoldersResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SortConversationFoldersResp) ProtoMessage() {}
+
+func (x *SortConversationFoldersResp) ProtoReflect() protoreflect.Message {
+	mi := &file_conversationext_conversationext_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SortConversationFoldersResp.ProtoReflect.Descriptor instead.
+func (*SortConversationFoldersResp) Descriptor() ([]byte, []int) {
+	return file_conversationext_conversationext_proto_rawDescGZIP(), []int{10}
+}
+
+type GetConversationFoldersReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+}
+
+func (x *GetConversationFoldersReq) Reset() {
+	*x = GetConversationFoldersReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_conversationext_conversationext_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetConversationFoldersReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConversationFoldersReq) ProtoMessage() {}
+
+func (x *GetConversationFoldersReq) ProtoReflect() protoreflect.Message {
+	mi := &file_conversationext_conversationext_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConversationFoldersReq.ProtoReflect.Descriptor instead.
+func (*GetConversationFoldersReq) Descriptor() ([]byte, []int) {
+	return file_conversationext_conversationext_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetConversationFoldersReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+type GetConversationFoldersResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Folders []*ConversationFolder `protobuf:"bytes,1,rep,name=folders,proto3" json:"folders"`
+}
+
+func (x *GetConversationFoldersResp) Reset() {
+	*x = GetConversationFoldersResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_conversationext_conversationext_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetConversationFoldersResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConversationFoldersResp) ProtoMessage() {}
+
+func (x *GetConversationFoldersResp) ProtoReflect() protoreflect.Message {
+	mi := &file_conversationext_conversationext_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConversationFoldersResp.ProtoReflect.Descriptor instead.
+func (*GetConversationFoldersResp) Descriptor() ([]byte, []int) {
+	return file_conversationext_conversationext_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetConversationFoldersResp) GetFolders() []*ConversationFolder {
+	if x != nil {
+		return x.Folders
+	}
+	return nil
+}
+
+type AddFolderConversationsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID          string   `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	FolderID        string   `protobuf:"bytes,2,opt,name=folderID,proto3" json:"folderID"`
+	ConversationIDs []string `protobuf:"bytes,3,rep,name=conversationIDs,proto3" json:"conversationIDs"`
+}
+
+func (x *AddFolderConversationsReq) Reset() {
+	*x = AddFolderConversationsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_conversationext_conversationext_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddFolderConversationsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddFolderConversationsReq) ProtoMessage() {}
+
+func (x *AddFolderConversationsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_conversationext_conversationext_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddFolderConversationsReq.ProtoReflect.Descriptor instead.
+func (*AddFolderConversationsReq) Descriptor() ([]byte, []int) {
+	return file_conversationext_conversationext_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *AddFolderConversationsReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *AddFolderConversationsReq) GetFolderID() string {
+	if x != nil {
+		return x.FolderID
+	}
+	return ""
+}
+
+func (x *AddFolderConversationsReq) GetConversationIDs() []string {
+	if x != nil {
+		return x.ConversationIDs
+	}
+	return nil
+}
+
+type AddFolderConversationsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AddFolderConversationsResp) Reset() {
+	*x = AddFolderConversationsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_conversationext_conversationext_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddFolderConversationsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddFolderConversationsResp) ProtoMessage() {}
+
+func (x *AddFolderConversationsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_conversationext_conversationext_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddFolderConversationsResp.ProtoReflect.Descriptor instead.
+func (*AddFolderConversationsResp) Descriptor() ([]byte, []int) {
+	return file_conversationext_conversationext_proto_rawDescGZIP(), []int{14}
+}
+
+type RemoveFolderConversationsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID          string   `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	FolderID        string   `protobuf:"bytes,2,opt,name=folderID,proto3" json:"folderID"`
+	ConversationIDs []string `protobuf:"bytes,3,rep,name=conversationIDs,proto3" json:"conversationIDs"`
+}
+
+func (x *RemoveFolderConversationsReq) Reset() {
+	*x = RemoveFolderConversationsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_conversationext_conversationext_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveFolderConversationsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveFolderConversationsReq) ProtoMessage() {}
+
+func (x *RemoveFolderConversationsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_conversationext_conversationext_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveFolderConversationsReq.ProtoReflect.Descriptor instead.
+func (*RemoveFolderConversationsReq) Descriptor() ([]byte, []int) {
+	return file_conversationext_conversationext_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *RemoveFolderConversationsReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *RemoveFolderConversationsReq) GetFolderID() string {
+	if x != nil {
+		return x.FolderID
+	}
+	return ""
+}
+
+func (x *RemoveFolderConversationsReq) GetConversationIDs() []string {
+	if x != nil {
+		return x.ConversationIDs
+	}
+	return nil
+}
+
+type RemoveFolderConversationsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RemoveFolderConversationsResp) Reset() {
+	*x = RemoveFolderConversationsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_conversationext_conversationext_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveFolderConversationsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveFolderConversationsResp) ProtoMessage() {}
+
+func (x *RemoveFolderConversationsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_conversationext_conversationext_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveFolderConversationsResp.ProtoReflect.Descriptor instead.
+func (*RemoveFolderConversationsResp) Descriptor() ([]byte, []int) {
+	return file_conversationext_conversationext_proto_rawDescGZIP(), []int{16}
+}
+
+type GetFolderSortedConversationListReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID     string                   `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	FolderID   string                   `protobuf:"bytes,2,opt,name=folderID,proto3" json:"folderID"`
+	Pagination *sdkws.RequestPagination `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination"`
+}
+
+func (x *GetFolderSortedConversationListReq) Reset() {
+	*x = GetFolderSortedConversationListReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_conversationext_conversationext_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFolderSortedConversationListReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFolderSortedConversationListReq) ProtoMessage() {}
+
+func (x *GetFolderSortedConversationListReq) ProtoReflect() protoreflect.Message {
+	mi := &file_conversationext_conversationext_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFolderSortedConversationListReq.ProtoReflect.Descriptor instead.
+func (*GetFolderSortedConversationListReq) Descriptor() ([]byte, []int) {
+	return file_conversationext_conversationext_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetFolderSortedConversationListReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *GetFolderSortedConversationListReq) GetFolderID() string {
+	if x != nil {
+		return x.FolderID
+	}
+	return ""
+}
+
+func (x *GetFolderSortedConversationListReq) GetPagination() *sdkws.RequestPagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type GetFolderSortedConversationListResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConversationTotal int64                            `protobuf:"varint,1,opt,name=conversationTotal,proto3" json:"conversationTotal"`
+	UnreadTotal       int64                            `protobuf:"varint,2,opt,name=unreadTotal,proto3" json:"unreadTotal"`
+	ConversationElems []*conversation.ConversationElem `protobuf:"bytes,3,rep,name=conversationElems,proto3" json:"conversationElems"`
+	// drafts of the listed conversations
+	Drafts []*ConversationDraft `protobuf:"bytes,4,rep,name=drafts,proto3" json:"drafts"`
+}
+
+func (x *GetFolderSortedConversationListResp) Reset() {
+	*x = GetFolderSortedConversationListResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_conversationext_conversationext_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFolderSortedConversationListResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFolderSortedConversationListResp) ProtoMessage() {}
+
+func (x *GetFolderSortedConversationListResp) ProtoReflect() protoreflect.Message {
+	mi := &file_conversationext_conversationext_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFolderSortedConversationListResp.ProtoReflect.Descriptor instead.
+func (*GetFolderSortedConversationListResp) Descriptor() ([]byte, []int) {
+	return file_conversationext_conversationext_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetFolderSortedConversationListResp) GetConversationTotal() int64 {
+	if x != nil {
+		return x.ConversationTotal
+	}
+	return 0
+}
+
+func (x *GetFolderSortedConversationListResp) GetUnreadTotal() int64 {
+	if x != nil {
+		return x.UnreadTotal
+	}
+	return 0
+}
+
+func (x *GetFolderSortedConversationListResp) GetConversationElems() []*conversation.ConversationElem {
+	if x != nil {
+		return x.ConversationElems
+	}
+	return nil
+}
+
+func (x *GetFolderSortedConversationListResp) GetDrafts() []*ConversationDraft {
+	if x != nil {
+		return x.Drafts
+	}
+	return nil
+}
+
+// content of ConversationFolderNotification, sent to all devices of the user
+type ConversationFolderTips struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	// folders created, changed, reordered or deleted
+	FolderIDs []string `protobuf:"bytes,2,rep,name=folderIDs,proto3" json:"folderIDs"`
+	// conversations added to or removed from the folders
+	ConversationIDs []string `protobuf:"bytes,3,rep,name=conversationIDs,proto3" json:"conversationIDs"`
+}
+
+func (x *ConversationFolderTips) Reset() {
+	*x = ConversationFolderTips{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_conversationext_conversationext_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConversationFolderTips) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConversationFolderTips) ProtoMessage() {}
+
+func (x *ConversationFolderTips) ProtoReflect() protoreflect.Message {
+	mi := &file_conversationext_conversationext_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConversationFolderTips.ProtoReflect.Descriptor instead.
+func (*ConversationFolderTips) Descriptor() ([]byte, []int) {
+	return file_conversationext_conversationext_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ConversationFolderTips) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *ConversationFolderTips) GetFolderIDs() []string {
+	if x != nil {
+		return x.FolderIDs
+	}
+	return nil
+}
+
+func (x *ConversationFolderTips) GetConversationIDs() []string {
+	if x != nil {
+		return x.ConversationIDs
+	}
+	return nil
+}
+
+// content of DoNotDisturbNotification, sent to all devices of the user
+type DoNotDisturbTips struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+}
+
+func (x *DoNotDisturbTips) Reset() {
+	*x = DoNotDisturbTips{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_conversationext_conversationext_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DoNotDisturbTips) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DoNotDisturbTips) ProtoMessage() {}
+
+func (x *DoNotDisturbTips) ProtoReflect() protoreflect.Message {
+	mi := &file_conversationext_conversationext_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DoNotDisturbTips.ProtoReflect.Descriptor instead.
+func (*DoNotDisturbTips) Descriptor() ([]byte, []int) {
+	return file_conversationext_conversationext_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *DoNotDisturbTips) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+type ConversationDraft struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConversationID string `protobuf:"bytes,1,opt,name=conversationID,proto3" json:"conversationID"`
+	Draft          string `protobuf:"bytes,2,opt,name=draft,proto3" json:"draft"`
+	UpdateTime     int64  `protobuf:"varint,3,opt,name=updateTime,proto3" json:"updateTime"`
+}
+
+func (x *ConversationDraft) Reset() {
+	*x = ConversationDraft{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_conversationext_conversationext_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConversationDraft) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConversationDraft) ProtoMessage() {}
+
+func (x *ConversationDraft) ProtoReflect() protoreflect.Message {
+	mi := &file_conversationext_conversationext_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConversationDraft.ProtoReflect.Descriptor instead.
+func (*ConversationDraft) Descriptor() ([]byte, []int) {
+	return file_conversationext_conversationext_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ConversationDraft) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *ConversationDraft) GetDraft() string {
+	if x != nil {
+		return x.Draft
+	}
+	return ""
+}
+
+func (x *ConversationDraft) GetUpdateTime() int64 {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return 0
+}
+
+type SetConversationDraftReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID         string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	ConversationID string `protobuf:"bytes,2,opt,name=conversationID,proto3" json:"conversationID"`
+	// empty to clear the draft
+	Draft string `protobuf:"bytes,3,opt,name=draft,proto3" json:"draft"`
+}
+
+func (x *SetConversationDraftReq) Reset() {
+	*x = SetConversationDraftReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_conversationext_conversationext_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetConversationDraftReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetConversationDraftReq) ProtoMessage() {}
+
+func (x *SetConversationDraftReq) ProtoReflect() protoreflect.Message {
+	mi := &file_conversationext_conversationext_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetConversationDraftReq.ProtoReflect.Descriptor instead.
+func (*SetConversationDraftReq) Descriptor() ([]byte, []int) {
+	return file_conversationext_conversationext_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *SetConversationDraftReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *SetConversationDraftReq) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *SetConversationDraftReq) GetDraft() string {
+	if x != nil {
+		return x.Draft
+	}
+	return ""
+}
+
+type SetConversationDraftResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UpdateTime int64 `protobuf:"varint,1,opt,name=updateTime,proto3" json:"updateTime"`
+}
+
+func (x *SetConversationDraftResp) Reset() {
+	*x = SetConversationDraftResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_conversationext_conversationext_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetConversationDraftResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetConversationDraftResp) ProtoMessage() {}
+
+func (x *SetConversationDraftResp) ProtoReflect() protoreflect.Message {
+	mi := &file_conversationext_conversationext_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetConversationDraftResp.ProtoReflect.Descriptor instead.
+func (*SetConversationDraftResp) Descriptor() ([]byte, []int) {
+	return file_conversationext_conversationext_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SetConversationDraftResp) GetUpdateTime() int64 {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return 0
+}
+
+type GetConversationDraftsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	// empty for all drafts of the user
+	ConversationIDs []string `protobuf:"bytes,2,rep,name=conversationIDs,proto3" json:"conversationIDs"`
+}
+
+func (x *GetConversationDraftsReq) Reset() {
+	*x = GetConversationDraftsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_conversationext_conversationext_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetConversationDraftsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConversationDraftsReq) ProtoMessage() {}
+
+func (x *GetConversationDraftsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_conversationext_conversationext_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConversationDraftsReq.ProtoReflect.Descriptor instead.
+func (*GetConversationDraftsReq) Descriptor() ([]byte, []int) {
+	return file_conversationext_conversationext_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetConversationDraftsReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *GetConversationDraftsReq) GetConversationIDs() []string {
+	if x != nil {
+		return x.ConversationIDs
+	}
+	return nil
+}
+
+type GetConversationDraftsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Drafts []*ConversationDraft `protobuf:"bytes,1,rep,name=drafts,proto3" json:"drafts"`
+}
+
+func (x *GetConversationDraftsResp) Reset() {
+	*x = GetConversationDraftsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_conversationext_conversationext_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetConversationDraftsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConversationDraftsResp) ProtoMessage() {}
+
+func (x *GetConversationDraftsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_conversationext_conversationext_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConversationDraftsResp.ProtoReflect.Descriptor instead.
+func (*GetConversationDraftsResp) Descriptor() ([]byte, []int) {
+	return file_conversationext_conversationext_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetConversationDraftsResp) GetDrafts() []*ConversationDraft {
+	if x != nil {
+		return x.Drafts
+	}
+	return nil
+}
+
+// content of ConversationDraftNotification, sent to the devices of the user online
+type ConversationDraftTips struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID string             `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	Draft  *ConversationDraft `protobuf:"bytes,2,opt,name=draft,proto3" json:"draft"`
+	// platform of the device that changed the draft
+	PlatformID int32 `protobuf:"varint,3,opt,name=platformID,proto3" json:"platformID"`
+}
+
+func (x *ConversationDraftTips) Reset() {
+	*x = ConversationDraftTips{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_conversationext_conversationext_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConversationDraftTips) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConversationDraftTips) ProtoMessage() {}
+
+func (x *ConversationDraftTips) ProtoReflect() protoreflect.Message {
+	mi := &file_conversationext_conversationext_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConversationDraftTips.ProtoReflect.Descriptor instead.
+func (*ConversationDraftTips) Descriptor() ([]byte, []int) {
+	return file_conversationext_conversationext_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ConversationDraftTips) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *ConversationDraftTips) GetDraft() *ConversationDraft {
+	if x != nil {
+		return x.Draft
+	}
+	return nil
+}
+
+func (x *ConversationDraftTips) GetPlatformID() int32 {
+	if x != nil {
+		return x.PlatformID
+	}
+	return 0
+}
+
+type DoNotDisturbSchedule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// days of the week the quiet hours start on, 0 is Sunday, empty for every day
+	Weekdays []int32 `protobuf:"varint,1,rep,packed,name=weekdays,proto3" json:"weekdays"`
+	// minutes of the day in the time zone of the user, quiet hours ending before they start end on the next day,
+	// equal start and end make the whole day quiet
+	StartMinute int32 `protobuf:"varint,2,opt,name=startMinute,proto3" json:"startMinute"`
+	EndMinute   int32 `protobuf:"varint,3,opt,name=endMinute,proto3" json:"endMinute"`
+}
+
+func (x *DoNotDisturbSchedule) Reset() {
+	*x = DoNotDisturbSchedule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_conversationext_conversationext_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DoNotDisturbSchedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DoNotDisturbSchedule) ProtoMessage() {}
+
+func (x *DoNotDisturbSchedule) ProtoReflect() protoreflect.Message {
+	mi := &file_conversationext_conversationext_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DoNotDisturbSchedule.ProtoReflect.Descriptor instead.
+func (*DoNotDisturbSchedule) Descriptor() ([]byte, []int) {
+	return file_conversationext_conversationext_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *DoNotDisturbSchedule) GetWeekdays() []int32 {
+	if x != nil {
+		return x.Weekdays
+	}
+	return nil
+}
+
+func (x *DoNotDisturbSchedule) GetStartMinute() int32 {
+	if x != nil {
+		return x.StartMinute
+	}
+	return 0
+}
+
+func (x *DoNotDisturbSchedule) GetEndMinute() int32 {
+	if x != nil {
+		return x.EndMinute
+	}
+	return 0
+}
+
+type MutedConversation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConversationID string `protobuf:"bytes,1,opt,name=conversationID,proto3" json:"conversationID"`
+	// unix milliseconds, the mute expires by itself
+	MuteUntil int64 `protobuf:"varint,2,opt,name=muteUntil,proto3" json:"muteUntil"`
+}
+
+func (x *MutedConversation) Reset() {
+	*x = MutedConversation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_conversationext_conversationext_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MutedConversation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MutedConversation) ProtoMessage() {}
+
+func (x *MutedConversation) ProtoReflect() protoreflect.Message {
+	mi := &file_conversationext_conversationext_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MutedConversation.ProtoReflect.Descriptor instead.
+func (*MutedConversation) Descriptor() ([]byte, []int) {
+	return file_conversationext_conversationext_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *MutedConversation) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *MutedConversation) GetMuteUntil() int64 {
+	if x != nil {
+		return x.MuteUntil
+	}
+	return 0
+}
+
+type DoNotDisturb struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// IANA time zone of the schedules, UTC if empty
+	TimeZone  string                  `protobuf:"bytes,1,opt,name=timeZone,proto3" json:"timeZone"`
+	Schedules []*DoNotDisturbSchedule `protobuf:"bytes,2,rep,name=schedules,proto3" json:"schedules"`
+	// messages mentioning the user are pushed during the quiet hours
+	AllowMentions bool `protobuf:"varint,3,opt,name=allowMentions,proto3" json:"allowMentions"`
+	// conversations pushed during the quiet hours
+	ExceptConversationIDs []string `protobuf:"bytes,4,rep,name=exceptConversationIDs,proto3" json:"exceptConversationIDs"`
+	// conversations not pushed until a time, regardless of the schedules
+	MutedConversations []*MutedConversation `protobuf:"bytes,5,rep,name=mutedConversations,proto3" json:"mutedConversations"`
+}
+
+func (x *DoNotDisturb) Reset() {
+	*x = DoNotDisturb{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_conversationext_conversationext_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DoNotDisturb) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DoNotDisturb) ProtoMessage() {}
+
+func (x *DoNotDisturb) ProtoReflect() protoreflect.Message {
+	mi := &file_conversationext_conversationext_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DoNotDisturb.ProtoReflect.Descriptor instead.
+func (*DoNotDisturb) Descriptor() ([]byte, []int) {
+	return file_conversationext_conversationext_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *DoNotDisturb) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *DoNotDisturb) GetSchedules() []*DoNotDisturbSchedule {
+	if x != nil {
+		return x.Schedules
+	}
+	return nil
+}
+
+func (x *DoNotDisturb) GetAllowMentions() bool {
+	if x != nil {
+		return x.AllowMentions
+	}
+	return false
+}
+
+func (x *DoNotDisturb) GetExceptConversationIDs() []string {
+	if x != nil {
+		return x.ExceptConversationIDs
+	}
+	return nil
+}
+
+func (x *DoNotDisturb) GetMutedConversations() []*MutedConversation {
+	if x != nil {
+		return x.MutedConversations
+	}
+	return nil
+}
+
+type SetDoNotDisturbReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID                string                  `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	TimeZone              string                  `protobuf:"bytes,2,opt,name=timeZone,proto3" json:"timeZone"`
+	Schedules             []*DoNotDisturbSchedule `protobuf:"bytes,3,rep,name=schedules,proto3" json:"schedules"`
+	AllowMentions         bool                    `protobuf:"varint,4,opt,name=allowMentions,proto3" json:"allowMentions"`
+	ExceptConversationIDs []string                `protobuf:"bytes,5,rep,name=exceptConversationIDs,proto3" json:"exceptConversationIDs"`
+}
+
+func (x *SetDoNotDisturbReq) Reset() {
+	*x = SetDoNotDisturbReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_conversationext_conversationext_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetDoNotDisturbReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDoNotDisturbReq) ProtoMessage() {}
+
+func (x *SetDoNotDisturbReq) ProtoReflect() protoreflect.Message {
+	mi := &file_conversationext_conversationext_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDoNotDisturbReq.ProtoReflect.Descriptor instead.
+func (*SetDoNotDisturbReq) Descriptor() ([]byte, []int) {
+	return file_conversationext_conversationext_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *SetDoNotDisturbReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *SetDoNotDisturbReq) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *SetDoNotDisturbReq) GetSchedules() []*DoNotDisturbSchedule {
+	if x != nil {
+		return x.Schedules
+	}
+	return nil
+}
+
+func (x *SetDoNotDisturbReq) GetAllowMentions() bool {
+	if x != nil {
+		return x.AllowMentions
+	}
+	return false
+}
+
+func (x *SetDoNotDisturbReq) GetExceptConversationIDs() []string {
+	if x != nil {
+		return x.ExceptConversationIDs
+	}
+	return nil
+}
+
+type SetDoNotDisturbResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetDoNotDisturbResp) Reset() {
+	*x = SetDoNotDisturbResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_conversationext_conversationext_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetDoNotDisturbResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDoNotDisturbResp) ProtoMessage() {}
+
+func (x *SetDoNotDisturbResp) ProtoReflect() protoreflect.Message {
+	mi := &file_conversationext_conversationext_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDoNotDisturbResp.ProtoReflect.Descriptor instead.
+func (*SetDoNotDisturbResp) Descriptor() ([]byte, []int) {
+	return file_conversationext_conversationext_proto_rawDescGZIP(), []int{31}
+}
+
+type GetDoNotDisturbReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+}
+
+func (x *GetDoNotDisturbReq) Reset() {
+	*x = GetDoNotDisturbReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_conversationext_conversationext_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDoNotDisturbReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDoNotDisturbReq) ProtoMessage() {}
+
+func (x *GetDoNotDisturbReq) ProtoReflect() protoreflect.Message {
+	mi := &file_conversationext_conversationext_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDoNotDisturbReq.ProtoReflect.Descriptor instead.
+func (*GetDoNotDisturbReq) Descriptor() ([]byte, []int) {
+	return file_conversationext_conversationext_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetDoNotDisturbReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+type GetDoNotDisturbResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DoNotDisturb *DoNotDisturb `protobuf:"bytes,1,opt,name=doNotDisturb,proto3" json:"doNotDisturb"`
+}
+
+func (x *GetDoNotDisturbResp) Reset() {
+	*x = GetDoNotDisturbResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_conversationext_conversationext_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDoNotDisturbResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDoNotDisturbResp) ProtoMessage() {}
+
+func (x *GetDoNotDisturbResp) ProtoReflect() protoreflect.Message {
+	mi := &file_conversationext_conversationext_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDoNotDisturbResp.ProtoReflect.Descriptor instead.
+func (*GetDoNotDisturbResp) Descriptor() ([]byte, []int) {
+	return file_conversationext_conversationext_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetDoNotDisturbResp) GetDoNotDisturb() *DoNotDisturb {
+	if x != nil {
+		return x.DoNotDisturb
+	}
+	return nil
+}
+
+type MuteConversationUntilReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID         string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	ConversationID string `protobuf:"bytes,2,opt,name=conversationID,proto3" json:"conversationID"`
+	// unix milliseconds, 0 to unmute
+	MuteUntil int64 `protobuf:"varint,3,opt,name=muteUntil,proto3" json:"muteUntil"`
+}
+
+func (x *MuteConversationUntilReq) Reset() {
+	*x = MuteConversationUntilReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_conversationext_conversationext_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MuteConversationUntilReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MuteConversationUntilReq) ProtoMessage() {}
+
+func (x *MuteConversationUntilReq) ProtoReflect() protoreflect.Message {
+	mi := &file_conversationext_conversationext_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MuteConversationUntilReq.ProtoReflect.Descriptor instead.
+func (*MuteConversationUntilReq) Descriptor() ([]byte, []int) {
+	return file_conversationext_conversationext_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *MuteConversationUntilReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *MuteConversationUntilReq) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *MuteConversationUntilReq) GetMuteUntil() int64 {
+	if x != nil {
+		return x.MuteUntil
+	}
+	return 0
+}
+
+type MuteConversationUntilResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MuteConversationUntilResp) Reset() {
+	*x = MuteConversationUntilResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_conversationext_conversationext_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MuteConversationUntilResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MuteConversationUntilResp) ProtoMessage() {}
+
+func (x *MuteConversationUntilResp) ProtoReflect() protoreflect.Message {
+	mi := &file_conversationext_conversationext_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MuteConversationUntilResp.ProtoReflect.Descriptor instead.
+func (*MuteConversationUntilResp) Descriptor() ([]byte, []int) {
+	return file_conversationext_conversationext_proto_rawDescGZIP(), []int{35}
+}
+
+type GetOfflinePushUserIDsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConversationID string   `protobuf:"bytes,1,opt,name=conversationID,proto3" json:"conversationID"`
+	UserIDs        []string `protobuf:"bytes,2,rep,name=userIDs,proto3" json:"userIDs"`
+	// users mentioned by the message
+	MentionedUserIDs []string `protobuf:"bytes,3,rep,name=mentionedUserIDs,proto3" json:"mentionedUserIDs"`
+	// only apply the do not disturb settings, the receive option of single chats is applied when the message is sent
+	DoNotDisturbOnly bool `protobuf:"varint,4,opt,name=doNotDisturbOnly,proto3" json:"doNotDisturbOnly"`
+}
+
+func (x *GetOfflinePushUserIDsReq) Reset() {
+	*x = GetOfflinePushUserIDsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_conversationext_conversationext_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetOfflinePushUserIDsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOfflinePushUserIDsReq) ProtoMessage() {}
+
+func (x *GetOfflinePushUserIDsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_conversationext_conversationext_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOfflinePushUserIDsReq.ProtoReflect.Descriptor instead.
+func (*GetOfflinePushUserIDsReq) Descriptor() ([]byte, []int) {
+	return file_conversationext_conversationext_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetOfflinePushUserIDsReq) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *GetOfflinePushUserIDsReq) GetUserIDs() []string {
+	if x != nil {
+		return x.UserIDs
+	}
+	return nil
+}
+
+func (x *GetOfflinePushUserIDsReq) GetMentionedUserIDs() []string {
+	if x != nil {
+		return x.MentionedUserIDs
+	}
+	return nil
+}
+
+func (x *GetOfflinePushUserIDsReq) GetDoNotDisturbOnly() bool {
+	if x != nil {
+		return x.DoNotDisturbOnly
+	}
+	return false
+}
+
+type GetOfflinePushUserIDsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserIDs []string `protobuf:"bytes,1,rep,name=userIDs,proto3" json:"userIDs"`
+}
+
+func (x *GetOfflinePushUserIDsResp) Reset() {
+	*x = GetOfflinePushUserIDsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_conversationext_conversationext_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetOfflinePushUserIDsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOfflinePushUserIDsResp) ProtoMessage() {}
+
+func (x *GetOfflinePushUserIDsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_conversationext_conversationext_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOfflinePushUserIDsResp.ProtoReflect.Descriptor instead.
+func (*GetOfflinePushUserIDsResp) Descriptor() ([]byte, []int) {
+	return file_conversationext_conversationext_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetOfflinePushUserIDsResp) GetUserIDs() []string {
+	if x != nil {
+		return x.UserIDs
+	}
+	return nil
+}
+
+type PurgeUserConversationsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+}
+
+func (x *PurgeUserConversationsReq) Reset() {
+	*x = PurgeUserConversationsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_conversationext_conversationext_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PurgeUserConversationsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeUserConversationsReq) ProtoMessage() {}
+
+func (x *PurgeUserConversationsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_conversationext_conversationext_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeUserConversationsReq.ProtoReflect.Descriptor instead.
+func (*PurgeUserConversationsReq) Descriptor() ([]byte, []int) {
+	return file_conversationext_conversationext_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *PurgeUserConversationsReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+type PurgeUserConversationsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *PurgeUserConversationsResp) Reset() {
+	*x = PurgeUserConversationsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_conversationext_conversationext_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PurgeUserConversationsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeUserConversationsResp) ProtoMessage() {}
+
+func (x *PurgeUserConversationsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_conversationext_conversationext_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeUserConversationsResp.ProtoReflect.Descriptor instead.
+func (*PurgeUserConversationsResp) Descriptor() ([]byte, []int) {
+	return file_conversationext_conversationext_proto_rawDescGZIP(), []int{39}
+}
+
+var File_conversationext_conversationext_proto protoreflect.FileDescriptor
+
+var file_conversationext_conversationext_proto_rawDesc = []byte{
+	0x0a, 0x25, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x65, 0x78,
+	0x74, 0x2f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x65, 0x78,
+	0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x16, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
+	0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x65, 0x78, 0x74, 0x1a,
+	0x1f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x63, 0x6f,
+	0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x11, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2f, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0x6f, 0x0a, 0x1d, 0x67, 0x65, 0x74, 0x49, 0x6e, 0x63, 0x72, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x22, 0xbd, 0x02, 0x0a, 0x1e, 0x67, 0x65, 0x74, 0x49, 0x6e, 0x63, 0x72,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x12, 0x0a, 0x04, 0x66, 0x75, 0x6c, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x66,
+	0x75, 0x6c, 0x6c, 0x12, 0x39, 0x0a, 0x06, 0x69, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x6f, 0x6e,
+	0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x69, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x12, 0x39,
+	0x0a, 0x06, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21,
+	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x06, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x12, 0x41, 0x0a, 0x06, 0x64, 0x72, 0x61, 0x66, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x29, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65,
+	0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x65, 0x78, 0x74, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65,
+	0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x06, 0x64, 0x72,
+	0x61, 0x66, 0x74, 0x73, 0x22, 0xd6, 0x01, 0x0a, 0x12, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x66,
+	0x6f, 0x6c, 0x64, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66,
+	0x6f, 0x6c, 0x64, 0x65, 0x72, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x44, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x76,
+	0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x65,
+	0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x65, 0x78, 0x12, 0x1e, 0x0a, 0x0a, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x75,
+	0x6e, 0x72, 0x65, 0x61, 0x64, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0b, 0x75, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x59, 0x0a,
+	0x1b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x65, 0x78, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x65, 0x78, 0x22, 0x62, 0x0a, 0x1c, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x6f,
+	0x6c, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x12, 0x42, 0x0a, 0x06, 0x66, 0x6f, 0x6c, 0x64,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69,
+	0x6d, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x65, 0x78,
+	0x74, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x6f,
+	0x6c, 0x64, 0x65, 0x72, 0x52, 0x06, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x22, 0x72, 0x0a, 0x18,
+	0x73, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46,
+	0x6f, 0x6c, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x12, 0x1a, 0x0a, 0x08, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x0e, 0x0a, 0x02, 0x65, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x65, 0x78,
+	0x22, 0x1b, 0x0a, 0x19, 0x73, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x22, 0x51, 0x0a,
+	0x1b, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x49, 0x44,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x49, 0x44,
+	0x22, 0x1e, 0x0a, 0x1c, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x22, 0x52, 0x0a, 0x1a, 0x73, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x12, 0x16,
+	0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72,
+	0x49, 0x44, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x66, 0x6f, 0x6c, 0x64, 0x65,
+	0x72, 0x49, 0x44, 0x73, 0x22, 0x1d, 0x0a, 0x1b, 0x73, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6e, 0x76,
+	0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x22, 0x33, 0x0a, 0x19, 0x67, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71,
+	0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x62, 0x0a, 0x1a, 0x67, 0x65, 0x74, 0x43,
+	0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x6f, 0x6c, 0x64, 0x65,
+	0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x44, 0x0a, 0x07, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d,
+	0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x65, 0x78, 0x74,
+	0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x6f, 0x6c,
+	0x64, 0x65, 0x72, 0x52, 0x07, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x22, 0x79, 0x0a, 0x19,
+	0x61, 0x64, 0x64, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x49, 0x44, 0x12, 0x28, 0x0a,
+	0x0f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x73, 0x22, 0x1c, 0x0a, 0x1a, 0x61, 0x64, 0x64, 0x46, 0x6f,
+	0x6c, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x7c, 0x0a, 0x1c, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46,
+	0x6f, 0x6c, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a,
+	0x08, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x49, 0x44, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x6f, 0x6e,
+	0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x44, 0x73, 0x22, 0x1f, 0x0a, 0x1d, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x6f, 0x6c,
+	0x64, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x22, 0x99, 0x01, 0x0a, 0x22, 0x67, 0x65, 0x74, 0x46, 0x6f, 0x6c, 0x64,
+	0x65, 0x72, 0x53, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x49, 0x44, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x49, 0x44, 0x12,
+	0x3f, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x64, 0x6b,
+	0x77, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0x8d, 0x02, 0x0a, 0x23, 0x67, 0x65, 0x74, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x53, 0x6f,
+	0x72, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x2c, 0x0a, 0x11, 0x63, 0x6f, 0x6e, 0x76,
+	0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x11, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x75, 0x6e, 0x72, 0x65, 0x61, 0x64,
+	0x54, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x75, 0x6e, 0x72,
+	0x65, 0x61, 0x64, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x53, 0x0a, 0x11, 0x63, 0x6f, 0x6e, 0x76,
+	0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6c, 0x65, 0x6d, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x6f, 0x6e,
+	0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6c, 0x65, 0x6d, 0x52, 0x11, 0x63, 0x6f, 0x6e, 0x76,
+	0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6c, 0x65, 0x6d, 0x73, 0x12, 0x41, 0x0a,
+	0x06, 0x64, 0x72, 0x61, 0x66, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e,
+	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x65, 0x78, 0x74, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x06, 0x64, 0x72, 0x61, 0x66, 0x74, 0x73,
+	0x22, 0x78, 0x0a, 0x16, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x54, 0x69, 0x70, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x49, 0x44, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x49, 0x44, 0x73,
+	0x12, 0x28, 0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x44, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x76, 0x65,
+	0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x73, 0x22, 0x2a, 0x0a, 0x10, 0x64, 0x6f,
+	0x4e, 0x6f, 0x74, 0x44, 0x69, 0x73, 0x74, 0x75, 0x72, 0x62, 0x54, 0x69, 0x70, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x71, 0x0a, 0x11, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x72, 0x61, 0x66, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x63,
+	0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x72, 0x61, 0x66, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x64, 0x72, 0x61, 0x66, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x6f, 0x0a, 0x17, 0x73, 0x65, 0x74,
+	0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x72, 0x61, 0x66,
+	0x74, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x26, 0x0a, 0x0e,
+	0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x72, 0x61, 0x66, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x72, 0x61, 0x66, 0x74, 0x22, 0x3a, 0x0a, 0x18, 0x73, 0x65,
+	0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x72, 0x61,
+	0x66, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x54, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x5c, 0x0a, 0x18, 0x67, 0x65, 0x74, 0x43, 0x6f, 0x6e,
+	0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x72, 0x61, 0x66, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x6f,
+	0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x44, 0x73, 0x22, 0x5e, 0x0a, 0x19, 0x67, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65,
+	0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x72, 0x61, 0x66, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x41, 0x0a, 0x06, 0x64, 0x72, 0x61, 0x66, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x29, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65,
+	0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x65, 0x78, 0x74, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65,
+	0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x06, 0x64, 0x72,
+	0x61, 0x66, 0x74, 0x73, 0x22, 0x90, 0x01, 0x0a, 0x15, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x72, 0x61, 0x66, 0x74, 0x54, 0x69, 0x70, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x3f, 0x0a, 0x05, 0x64, 0x72, 0x61, 0x66, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63,
+	0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x65, 0x78, 0x74, 0x2e, 0x63,
+	0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x72, 0x61, 0x66, 0x74,
+	0x52, 0x05, 0x64, 0x72, 0x61, 0x66, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x74, 0x66,
+	0x6f, 0x72, 0x6d, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x70, 0x6c, 0x61,
+	0x74, 0x66, 0x6f, 0x72, 0x6d, 0x49, 0x44, 0x22, 0x72, 0x0a, 0x14, 0x64, 0x6f, 0x4e, 0x6f, 0x74,
+	0x44, 0x69, 0x73, 0x74, 0x75, 0x72, 0x62, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x77, 0x65, 0x65, 0x6b, 0x64, 0x61, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x05, 0x52, 0x08, 0x77, 0x65, 0x65, 0x6b, 0x64, 0x61, 0x79, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x12, 0x1c, 0x0a,
+	0x09, 0x65, 0x6e, 0x64, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x09, 0x65, 0x6e, 0x64, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x22, 0x59, 0x0a, 0x11, 0x6d,
+	0x75, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x75, 0x74, 0x65,
+	0x55, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6d, 0x75, 0x74,
+	0x65, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x22, 0xad, 0x02, 0x0a, 0x0c, 0x64, 0x6f, 0x4e, 0x6f, 0x74,
+	0x44, 0x69, 0x73, 0x74, 0x75, 0x72, 0x62, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x5a,
+	0x6f, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x5a,
+	0x6f, 0x6e, 0x65, 0x12, 0x4a, 0x0a, 0x09, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
+	0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x65, 0x78, 0x74, 0x2e,
+	0x64, 0x6f, 0x4e, 0x6f, 0x74, 0x44, 0x69, 0x73, 0x74, 0x75, 0x72, 0x62, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x52, 0x09, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x12,
+	0x24, 0x0a, 0x0d, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x4d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x4d, 0x65, 0x6e,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x34, 0x0a, 0x15, 0x65, 0x78, 0x63, 0x65, 0x70, 0x74, 0x43,
+	0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x15, 0x65, 0x78, 0x63, 0x65, 0x70, 0x74, 0x43, 0x6f, 0x6e, 0x76,
+	0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x73, 0x12, 0x59, 0x0a, 0x12, 0x6d,
+	0x75, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d,
+	0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x65, 0x78, 0x74,
+	0x2e, 0x6d, 0x75, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x12, 0x6d, 0x75, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xf0, 0x01, 0x0a, 0x12, 0x73, 0x65, 0x74, 0x44, 0x6f,
+	0x4e, 0x6f, 0x74, 0x44, 0x69, 0x73, 0x74, 0x75, 0x72, 0x62, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x5a, 0x6f, 0x6e,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x5a, 0x6f, 0x6e,
+	0x65, 0x12, 0x4a, 0x0a, 0x09, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x6f,
+	0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x65, 0x78, 0x74, 0x2e, 0x64, 0x6f,
+	0x4e, 0x6f, 0x74, 0x44, 0x69, 0x73, 0x74, 0x75, 0x72, 0x62, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x52, 0x09, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x24, 0x0a,
+	0x0d, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x4d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x4d, 0x65, 0x6e, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x34, 0x0a, 0x15, 0x65, 0x78, 0x63, 0x65, 0x70, 0x74, 0x43, 0x6f, 0x6e,
+	0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x73, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x15, 0x65, 0x78, 0x63, 0x65, 0x70, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x73, 0x22, 0x15, 0x0a, 0x13, 0x73, 0x65, 0x74,
+	0x44, 0x6f, 0x4e, 0x6f, 0x74, 0x44, 0x69, 0x73, 0x74, 0x75, 0x72, 0x62, 0x52, 0x65, 0x73, 0x70,
+	0x22, 0x2c, 0x0a, 0x12, 0x67, 0x65, 0x74, 0x44, 0x6f, 0x4e, 0x6f, 0x74, 0x44, 0x69, 0x73, 0x74,
+	0x75, 0x72, 0x62, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x5f,
+	0x0a, 0x13, 0x67, 0x65, 0x74, 0x44, 0x6f, 0x4e, 0x6f, 0x74, 0x44, 0x69, 0x73, 0x74, 0x75, 0x72,
+	0x62, 0x52, 0x65, 0x73, 0x70, 0x12, 0x48, 0x0a, 0x0c, 0x64, 0x6f, 0x4e, 0x6f, 0x74, 0x44, 0x69,
+	0x73, 0x74, 0x75, 0x72, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6f, 0x70,
+	0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x65, 0x78, 0x74, 0x2e, 0x64, 0x6f, 0x4e, 0x6f, 0x74, 0x44, 0x69, 0x73, 0x74, 0x75, 0x72,
+	0x62, 0x52, 0x0c, 0x64, 0x6f, 0x4e, 0x6f, 0x74, 0x44, 0x69, 0x73, 0x74, 0x75, 0x72, 0x62, 0x22,
+	0x78, 0x0a, 0x18, 0x6d, 0x75, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e,
+	0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x6d,
+	0x75, 0x74, 0x65, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x6d, 0x75, 0x74, 0x65, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x22, 0x1b, 0x0a, 0x19, 0x6d, 0x75, 0x74,
+	0x65, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x6e, 0x74,
+	0x69, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x22, 0xb4, 0x01, 0x0a, 0x18, 0x67, 0x65, 0x74, 0x4f, 0x66,
+	0x66, 0x6c, 0x69, 0x6e, 0x65, 0x50, 0x75, 0x73, 0x68, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73,
+	0x52, 0x65, 0x71, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e,
+	0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x73, 0x12, 0x2a, 0x0a, 0x10, 0x6d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e,
+	0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x10, 0x6d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x73, 0x12, 0x2a, 0x0a, 0x10, 0x64, 0x6f, 0x4e, 0x6f, 0x74, 0x44, 0x69, 0x73, 0x74, 0x75, 0x72,
+	0x62, 0x4f, 0x6e, 0x6c, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x64, 0x6f, 0x4e,
+	0x6f, 0x74, 0x44, 0x69, 0x73, 0x74, 0x75, 0x72, 0x62, 0x4f, 0x6e, 0x6c, 0x79, 0x22, 0x35, 0x0a,
+	0x19, 0x67, 0x65, 0x74, 0x4f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x50, 0x75, 0x73, 0x68, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x73, 0x22, 0x33, 0x0a, 0x19, 0x70, 0x75, 0x72, 0x67, 0x65, 0x55, 0x73, 0x65,
+	0x72, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x1c, 0x0a, 0x1a, 0x70, 0x75, 0x72,
+	0x67, 0x65, 0x55, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x32, 0xaa, 0x10, 0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x76,
+	0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x74, 0x12, 0x8b, 0x01, 0x0a, 0x1a,
+	0x67, 0x65, 0x74, 0x49, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x43, 0x6f,
+	0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x35, 0x2e, 0x6f, 0x70, 0x65,
+	0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x65, 0x78, 0x74, 0x2e, 0x67, 0x65, 0x74, 0x49, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x61, 0x6c, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x1a, 0x36, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65,
+	0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x65, 0x78, 0x74, 0x2e, 0x67, 0x65, 0x74, 0x49, 0x6e,
+	0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x85, 0x01, 0x0a, 0x18, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x12, 0x33, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
+	0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x65, 0x78, 0x74, 0x2e,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x34, 0x2e, 0x6f, 0x70,
+	0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x65, 0x78, 0x74, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x76, 0x65,
+	0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x7c, 0x0a, 0x15, 0x73, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x12, 0x30, 0x2e, 0x6f, 0x70, 0x65,
+	0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x65, 0x78, 0x74, 0x2e, 0x73, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x31, 0x2e, 0x6f,
+	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x65, 0x78, 0x74, 0x2e, 0x73, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x85, 0x01, 0x0a, 0x18, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x12, 0x33, 0x2e, 0x6f,
+	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x65, 0x78, 0x74, 0x2e, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x76,
+	0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x1a, 0x34, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65,
+	0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x65, 0x78, 0x74, 0x2e, 0x64, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x6f, 0x6c,
+	0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x12, 0x82, 0x01, 0x0a, 0x17, 0x73, 0x6f, 0x72, 0x74,
+	0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x6f, 0x6c, 0x64,
+	0x65, 0x72, 0x73, 0x12, 0x32, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x6f, 0x6e,
+	0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x65, 0x78, 0x74, 0x2e, 0x73, 0x6f, 0x72,
+	0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x6f, 0x6c,
+	0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x33, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d,
+	0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x65, 0x78, 0x74,
+	0x2e, 0x73, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x7f, 0x0a, 0x16,
+	0x67, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46,
+	0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x12, 0x31, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
+	0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x65, 0x78, 0x74, 0x2e,
+	0x67, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46,
+	0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x32, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x69, 0x6d, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x65,
+	0x78, 0x74, 0x2e, 0x67, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x7f, 0x0a,
+	0x16, 0x61, 0x64, 0x64, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x31, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d,
+	0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x65, 0x78, 0x74,
+	0x2e, 0x61, 0x64, 0x64, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x32, 0x2e, 0x6f, 0x70, 0x65,
+	0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x65, 0x78, 0x74, 0x2e, 0x61, 0x64, 0x64, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x6e,
+	0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x88,
+	0x01, 0x0a, 0x19, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x43,
+	0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x34, 0x2e, 0x6f,
+	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x65, 0x78, 0x74, 0x2e, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x6f, 0x6c, 0x64,
+	0x65, 0x72, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x71, 0x1a, 0x35, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x6f, 0x6e, 0x76,
+	0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x65, 0x78, 0x74, 0x2e, 0x72, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x9a, 0x01, 0x0a, 0x1f, 0x67, 0x65,
+	0x74, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x53, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x6e,
+	0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x3a, 0x2e,
+	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x65, 0x78, 0x74, 0x2e, 0x67, 0x65, 0x74, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72,
+	0x53, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x3b, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x69, 0x6d, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x65,
+	0x78, 0x74, 0x2e, 0x67, 0x65, 0x74, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x53, 0x6f, 0x72, 0x74,
+	0x65, 0x64, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x79, 0x0a, 0x14, 0x73, 0x65, 0x74, 0x43, 0x6f, 0x6e,
+	0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x72, 0x61, 0x66, 0x74, 0x12, 0x2f,
+	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x65, 0x78, 0x74, 0x2e, 0x73, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65,
+	0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x65, 0x71, 0x1a,
+	0x30, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x65, 0x78, 0x74, 0x2e, 0x73, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x76,
+	0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x7c, 0x0a, 0x15, 0x67, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x44, 0x72, 0x61, 0x66, 0x74, 0x73, 0x12, 0x30, 0x2e, 0x6f, 0x70, 0x65,
+	0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x65, 0x78, 0x74, 0x2e, 0x67, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x44, 0x72, 0x61, 0x66, 0x74, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x31, 0x2e, 0x6f,
+	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x65, 0x78, 0x74, 0x2e, 0x67, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x72, 0x61, 0x66, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x6a, 0x0a, 0x0f, 0x73, 0x65, 0x74, 0x44, 0x6f, 0x4e, 0x6f, 0x74, 0x44, 0x69, 0x73, 0x74, 0x75,
+	0x72, 0x62, 0x12, 0x2a, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x6f, 0x6e, 0x76,
+	0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x65, 0x78, 0x74, 0x2e, 0x73, 0x65, 0x74, 0x44,
+	0x6f, 0x4e, 0x6f, 0x74, 0x44, 0x69, 0x73, 0x74, 0x75, 0x72, 0x62, 0x52, 0x65, 0x71, 0x1a, 0x2b,
+	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x65, 0x78, 0x74, 0x2e, 0x73, 0x65, 0x74, 0x44, 0x6f, 0x4e, 0x6f, 0x74,
+	0x44, 0x69, 0x73, 0x74, 0x75, 0x72, 0x62, 0x52, 0x65, 0x73, 0x70, 0x12, 0x6a, 0x0a, 0x0f, 0x67,
+	0x65, 0x74, 0x44, 0x6f, 0x4e, 0x6f, 0x74, 0x44, 0x69, 0x73, 0x74, 0x75, 0x72, 0x62, 0x12, 0x2a,
+	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x65, 0x78, 0x74, 0x2e, 0x67, 0x65, 0x74, 0x44, 0x6f, 0x4e, 0x6f, 0x74,
+	0x44, 0x69, 0x73, 0x74, 0x75, 0x72, 0x62, 0x52, 0x65, 0x71, 0x1a, 0x2b, 0x2e, 0x6f, 0x70, 0x65,
+	0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x65, 0x78, 0x74, 0x2e, 0x67, 0x65, 0x74, 0x44, 0x6f, 0x4e, 0x6f, 0x74, 0x44, 0x69, 0x73, 0x74,
+	0x75, 0x72, 0x62, 0x52, 0x65, 0x73, 0x70, 0x12, 0x7c, 0x0a, 0x15, 0x6d, 0x75, 0x74, 0x65, 0x43,
+	0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x6e, 0x74, 0x69, 0x6c,
+	0x12, 0x30, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x65, 0x78, 0x74, 0x2e, 0x6d, 0x75, 0x74, 0x65, 0x43, 0x6f,
+	0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x52,
+	0x65, 0x71, 0x1a, 0x31, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x6f, 0x6e, 0x76,
+	0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x65, 0x78, 0x74, 0x2e, 0x6d, 0x75, 0x74, 0x65,
+	0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x6e, 0x74, 0x69,
+	0x6c, 0x52, 0x65, 0x73, 0x70, 0x12, 0x7c, 0x0a, 0x15, 0x67, 0x65, 0x74, 0x4f, 0x66, 0x66, 0x6c,
+	0x69, 0x6e, 0x65, 0x50, 0x75, 0x73, 0x68, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x12, 0x30,
+	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x65, 0x78, 0x74, 0x2e, 0x67, 0x65, 0x74, 0x4f, 0x66, 0x66, 0x6c, 0x69,
+	0x6e, 0x65, 0x50, 0x75, 0x73, 0x68, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x52, 0x65, 0x71,
+	0x1a, 0x31, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x65, 0x78, 0x74, 0x2e, 0x67, 0x65, 0x74, 0x4f, 0x66, 0x66,
+	0x6c, 0x69, 0x6e, 0x65, 0x50, 0x75, 0x73, 0x68, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x7f, 0x0a, 0x16, 0x70, 0x75, 0x72, 0x67, 0x65, 0x55, 0x73, 0x65, 0x72,
+	0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x31, 0x2e,
+	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x65, 0x78, 0x74, 0x2e, 0x70, 0x75, 0x72, 0x67, 0x65, 0x55, 0x73, 0x65, 0x72,
+	0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71,
+	0x1a, 0x32, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x65, 0x78, 0x74, 0x2e, 0x70, 0x75, 0x72, 0x67, 0x65, 0x55,
+	0x73, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x42, 0x45, 0x5a, 0x43, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x73, 0x64, 0x6b, 0x2f, 0x6f, 0x70, 0x65,
+	0x6e, 0x2d, 0x69, 0x6d, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x76, 0x33, 0x2f, 0x70,
+	0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x6f, 0x6e, 0x76,
+	0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x65, 0x78, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+}
+
+var (
+	file_conversationext_conversationext_proto_rawDescOnce sync.Once
+	file_conversationext_conversationext_proto_rawDescData = file_conversationext_conversationext_proto_rawDesc
+)
+
+func file_conversationext_conversationext_proto_rawDescGZIP() []byte {
+	file_conversationext_conversationext_proto_rawDescOnce.Do(func() {
+		file_conversationext_conversationext_proto_rawDescData = protoimpl.X.CompressGZIP(file_conversationext_conversationext_proto_rawDescData)
+	})
+	return file_conversationext_conversationext_proto_rawDescData
+}
+
+var file_conversationext_conversationext_proto_msgTypes = make([]protoimpl.MessageInfo, 40)
+var file_conversationext_conversationext_proto_goTypes = []interface{}{
+	(*GetIncrementalConversationReq)(nil),       // 0: openim.conversationext.getIncrementalConversationReq
+	(*GetIncrementalConversationResp)(nil),      // 1: openim.conversationext.getIncrementalConversationResp
+	(*ConversationFolder)(nil),                  // 2: openim.conversationext.conversationFolder
+	(*CreateConversationFolderReq)(nil),         // 3: openim.conversationext.createConversationFolderReq
+	(*CreateConversationFolderResp)(nil),        // 4: openim.conversationext.createConversationFolderResp
+	(*SetConversationFolderReq)(nil),            // 5: openim.conversationext.setConversationFolderReq
+	(*SetConversationFolderResp)(nil),           // 6: openim.conversationext.setConversationFolderResp
+	(*DeleteConversationFolderReq)(nil),         // 7: openim.conversationext.deleteConversationFolderReq
+	(*DeleteConversationFolderResp)(nil),        // 8: openim.conversationext.deleteConversationFolderResp
+	(*SortConversationFoldersReq)(nil),          // 9: openim.conversationext.sortConversationFoldersReq
+	(*SortConversationFoldersResp)(nil),         // 10: openim.conversationext.sortConversationFoldersResp
+	(*GetConversationFoldersReq)(nil),           // 11: openim.conversationext.getConversationFoldersReq
+	(*GetConversationFoldersResp)(nil),          // 12: openim.conversationext.getConversationFoldersResp
+	(*AddFolderConversationsReq)(nil),           // 13: openim.conversationext.addFolderConversationsReq
+	(*AddFolderConversationsResp)(nil),          // 14: openim.conversationext.addFolderConversationsResp
+	(*RemoveFolderConversationsReq)(nil),        // 15: openim.conversationext.removeFolderConversationsReq
+	(*RemoveFolderConversationsResp)(nil),       // 16: openim.conversationext.removeFolderConversationsResp
+	(*GetFolderSortedConversationListReq)(nil),  // 17: openim.conversationext.getFolderSortedConversationListReq
+	(*GetFolderSortedConversationListResp)(nil), // 18: openim.conversationext.getFolderSortedConversationListResp
+	(*ConversationFolderTips)(nil),              // 19: openim.conversationext.conversationFolderTips
+	(*DoNotDisturbTips)(nil),                    // 20: openim.conversationext.doNotDisturbTips
+	(*ConversationDraft)(nil),                   // 21: openim.conversationext.conversationDraft
+	(*SetConversationDraftReq)(nil),             // 22: openim.conversationext.setConversationDraftReq
+	(*SetConversationDraftResp)(nil),            // 23: openim.conversationext.setConversationDraftResp
+	(*GetConversationDraftsReq)(nil),            // 24: openim.conversationext.getConversationDraftsReq
+	(*GetConversationDraftsResp)(nil),           // 25: openim.conversationext.getConversationDraftsResp
+	(*ConversationDraftTips)(nil),               // 26: openim.conversationext.conversationDraftTips
+	(*DoNotDisturbSchedule)(nil),                // 27: openim.conversationext.doNotDisturbSchedule
+	(*MutedConversation)(nil),                   // 28: openim.conversationext.mutedConversation
+	(*DoNotDisturb)(nil),                        // 29: openim.conversationext.doNotDisturb
+	(*SetDoNotDisturbReq)(nil),                  // 30: openim.conversationext.setDoNotDisturbReq
+	(*SetDoNotDisturbResp)(nil),                 // 31: openim.conversationext.setDoNotDisturbResp
+	(*GetDoNotDisturbReq)(nil),                  // 32: openim.conversationext.getDoNotDisturbReq
+	(*GetDoNotDisturbResp)(nil),                 // 33: openim.conversationext.getDoNotDisturbResp
+	(*MuteConversationUntilReq)(nil),            // 34: openim.conversationext.muteConversationUntilReq
+	(*MuteConversationUntilResp)(nil),           // 35: openim.conversationext.muteConversationUntilResp
+	(*GetOfflinePushUserIDsReq)(nil),            // 36: openim.conversationext.getOfflinePushUserIDsReq
+	(*GetOfflinePushUserIDsResp)(nil),           // 37: openim.conversationext.getOfflinePushUserIDsResp
+	(*PurgeUserConversationsReq)(nil),           // 38: openim.conversationext.purgeUserConversationsReq
+	(*PurgeUserConversationsResp)(nil),          // 39: openim.conversationext.purgeUserConversationsResp
+	(*conversation.Conversation)(nil),           // 40: openim.conversation.Conversation
+	(*sdkws.RequestPagination)(nil),             // 41: openim.sdkws.RequestPagination
+	(*conversation.ConversationElem)(nil),       // 42: openim.conversation.ConversationElem
+}
+var file_conversationext_conversationext_proto_depIdxs = []int32{
+	40, // 0: openim.conversationext.getIncrementalConversationResp.insert:type_name -> openim.conversation.Conversation
+	40, // 1: openim.conversationext.getIncrementalConversationResp.update:type_name -> openim.conversation.Conversation
+	21, // 2: openim.conversationext.getIncrementalConversationResp.drafts:type_name -> openim.conversationext.conversationDraft
+	2,  // 3: openim.conversationext.createConversationFolderResp.folder:type_name -> openim.conversationext.conversationFolder
+	2,  // 4: openim.conversationext.getConversationFoldersResp.folders:type_name -> openim.conversationext.conversationFolder
+	41, // 5: openim.conversationext.getFolderSortedConversationListReq.pagination:type_name -> openim.sdkws.RequestPagination
+	42, // 6: openim.conversationext.getFolderSortedConversationListResp.conversationElems:type_name -> openim.conversation.ConversationElem
+	21, // 7: openim.conversationext.getFolderSortedConversationListResp.drafts:type_name -> openim.conversationext.conversationDraft
+	21, // 8: openim.conversationext.getConversationDraftsResp.drafts:type_name -> openim.conversationext.conversationDraft
+	21, // 9: openim.conversationext.conversationDraftTips.draft:type_name -> openim.conversationext.conversationDraft
+	27, // 10: openim.conversationext.doNotDisturb.schedules:type_name -> openim.conversationext.doNotDisturbSchedule
+	28, // 11: openim.conversationext.doNotDisturb.mutedConversations:type_name -> openim.conversationext.mutedConversation
+	27, // 12: openim.conversationext.setDoNotDisturbReq.schedules:type_name -> openim.conversationext.doNotDisturbSchedule
+	29, // 13: openim.conversationext.getDoNotDisturbResp.doNotDisturb:type_name -> openim.conversationext.doNotDisturb
+	0,  // 14: openim.conversationext.conversationExt.getIncrementalConversation:input_type -> openim.conversationext.getIncrementalConversationReq
+	3,  // 15: openim.conversationext.conversationExt.createConversationFolder:input_type -> openim.conversationext.createConversationFolderReq
+	5,  // 16: openim.conversationext.conversationExt.setConversationFolder:input_type -> openim.conversationext.setConversationFolderReq
+	7,  // 17: openim.conversationext.conversationExt.deleteConversationFolder:input_type -> openim.conversationext.deleteConversationFolderReq
+	9,  // 18: openim.conversationext.conversationExt.sortConversationFolders:input_type -> openim.conversationext.sortConversationFoldersReq
+	11, // 19: openim.conversationext.conversationExt.getConversationFolders:input_type -> openim.conversationext.getConversationFoldersReq
+	13, // 20: openim.conversationext.conversationExt.addFolderConversations:input_type -> openim.conversationext.addFolderConversationsReq
+	15, // 21: openim.conversationext.conversationExt.removeFolderConversations:input_type -> openim.conversationext.removeFolderConversationsReq
+	17, // 22: openim.conversationext.conversationExt.getFolderSortedConversationList:input_type -> openim.conversationext.getFolderSortedConversationListReq
+	22, // 23: openim.conversationext.conversationExt.setConversationDraft:input_type -> openim.conversationext.setConversationDraftReq
+	24, // 24: openim.conversationext.conversationExt.getConversationDrafts:input_type -> openim.conversationext.getConversationDraftsReq
+	30, // 25: openim.conversationext.conversationExt.setDoNotDisturb:input_type -> openim.conversationext.setDoNotDisturbReq
+	32, // 26: openim.conversationext.conversationExt.getDoNotDisturb:input_type -> openim.conversationext.getDoNotDisturbReq
+	34, // 27: openim.conversationext.conversationExt.muteConversationUntil:input_type -> openim.conversationext.muteConversationUntilReq
+	36, // 28: openim.conversationext.conversationExt.getOfflinePushUserIDs:input_type -> openim.conversationext.getOfflinePushUserIDsReq
+	38, // 29: openim.conversationext.conversationExt.purgeUserConversations:input_type -> openim.conversationext.purgeUserConversationsReq
+	1,  // 30: openim.conversationext.conversationExt.getIncrementalConversation:output_type -> openim.conversationext.getIncrementalConversationResp
+	4,  // 31: openim.conversationext.conversationExt.createConversationFolder:output_type -> openim.conversationext.createConversationFolderResp
+	6,  // 32: openim.conversationext.conversationExt.setConversationFolder:output_type -> openim.conversationext.setConversationFolderResp
+	8,  // 33: openim.conversationext.conversationExt.deleteConversationFolder:output_type -> openim.conversationext.deleteConversationFolderResp
+	10, // 34: openim.conversationext.conversationExt.sortConversationFolders:output_type -> openim.conversationext.sortConversationFoldersResp
+	12, // 35: openim.conversationext.conversationExt.getConversationFolders:output_type -> openim.conversationext.getConversationFoldersResp
+	14, // 36: openim.conversationext.conversationExt.addFolderConversations:output_type -> openim.conversationext.addFolderConversationsResp
+	16, // 37: openim.conversationext.conversationExt.removeFolderConversations:output_type -> openim.conversationext.removeFolderConversationsResp
+	18, // 38: openim.conversationext.conversationExt.getFolderSortedConversationList:output_type -> openim.conversationext.getFolderSortedConversationListResp
+	23, // 39: openim.conversationext.conversationExt.setConversationDraft:output_type -> openim.conversationext.setConversationDraftResp
+	25, // 40: openim.conversationext.conversationExt.getConversationDrafts:output_type -> openim.conversationext.getConversationDraftsResp
+	31, // 41: openim.conversationext.conversationExt.setDoNotDisturb:output_type -> openim.conversationext.setDoNotDisturbResp
+	33, // 42: openim.conversationext.conversationExt.getDoNotDisturb:output_type -> openim.conversationext.getDoNotDisturbResp
+	35, // 43: openim.conversationext.conversationExt.muteConversationUntil:output_type -> openim.conversationext.muteConversationUntilResp
+	37, // 44: openim.conversationext.conversationExt.getOfflinePushUserIDs:output_type -> openim.conversationext.getOfflinePushUserIDsResp
+	39, // 45: openim.conversationext.conversationExt.purgeUserConversations:output_type -> openim.conversationext.purgeUserConversationsResp
+	30, // [30:46] is the sub-list for method output_type
+	14, // [14:30] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
+}
+
+func init() { file_conversationext_conversationext_proto_init() }
+func file_conversationext_conversationext_proto_init() {
+	if File_conversationext_conversationext_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_conversationext_conversationext_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetIncrementalConversationReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_conversationext_conversationext_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetIncrementalConversationResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_conversationext_conversationext_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConversationFolder); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_conversationext_conversationext_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateConversationFolderReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_conversationext_conversationext_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateConversationFolderResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_conversationext_conversationext_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetConversationFolderReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_conversationext_conversationext_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetConversationFolderResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_conversationext_conversationext_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteConversationFolderReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_conversationext_conversationext_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteConversationFolderResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_conversationext_conversationext_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SortConversationFoldersReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_conversationext_conversationext_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SortConversationFoldersResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_conversationext_conversationext_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetConversationFoldersReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_conversationext_conversationext_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetConversationFoldersResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_conversationext_conversationext_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddFolderConversationsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_conversationext_conversationext_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddFolderConversationsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_conversationext_conversationext_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveFolderConversationsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_conversationext_conversationext_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveFolderConversationsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_conversationext_conversationext_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFolderSortedConversationListReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_conversationext_conversationext_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFolderSortedConversationListResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_conversationext_conversationext_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConversationFolderTips); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_conversationext_conversationext_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DoNotDisturbTips); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_conversationext_conversationext_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConversationDraft); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_conversationext_conversationext_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetConversationDraftReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_conversationext_conversationext_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetConversationDraftResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_conversationext_conversationext_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetConversationDraftsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_conversationext_conversationext_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetConversationDraftsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_conversationext_conversationext_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConversationDraftTips); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_conversationext_conversationext_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DoNotDisturbSchedule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_conversationext_conversationext_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MutedConversation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_conversationext_conversationext_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DoNotDisturb); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_conversationext_conversationext_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetDoNotDisturbReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_conversationext_conversationext_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetDoNotDisturbResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_conversationext_conversationext_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDoNotDisturbReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_conversationext_conversationext_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDoNotDisturbResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_conversationext_conversationext_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MuteConversationUntilReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_conversationext_conversationext_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MuteConversationUntilResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_conversationext_conversationext_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetOfflinePushUserIDsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_conversationext_conversationext_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetOfflinePushUserIDsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_conversationext_conversationext_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PurgeUserConversationsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_conversationext_conversationext_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PurgeUserConversationsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_conversationext_conversationext_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   40,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_conversationext_conversationext_proto_goTypes,
+		DependencyIndexes: file_conversationext_conversationext_proto_depIdxs,
+		MessageInfos:      file_conversationext_conversationext_proto_msgTypes,
+	}.Build()
+	File_conversationext_conversationext_proto = out.File
+	file_conversationext_conversationext_proto_rawDesc = nil
+	file_conversationext_conversationext_proto_goTypes = nil
+	file_conversationext_conversationext_proto_depIdxs = nil
+}
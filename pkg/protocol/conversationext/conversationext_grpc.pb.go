@@ -0,0 +1,694 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: conversationext/conversationext.proto
+
+package conversationext
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	ConversationExt_GetIncrementalConversation_FullMethodName      = "/openim.conversationext.conversationExt/getIncrementalConversation"
+	ConversationExt_CreateConversationFolder_FullMethodName        = "/openim.conversationext.conversationExt/createConversationFolder"
+	ConversationExt_SetConversationFolder_FullMethodName           = "/openim.conversationext.conversationExt/setConversationFolder"
+	ConversationExt_DeleteConversationFolder_FullMethodName        = "/openim.conversationext.conversationExt/deleteConversationFolder"
+	ConversationExt_SortConversationFolders_FullMethodName         = "/openim.conversationext.conversationExt/sortConversationFolders"
+	ConversationExt_GetConversationFolders_FullMethodName          = "/openim.conversationext.conversationExt/getConversationFolders"
+	ConversationExt_AddFolderConversations_FullMethodName          = "/openim.conversationext.conversationExt/addFolderConversations"
+	ConversationExt_RemoveFolderConversations_FullMethodName       = "/openim.conversationext.conversationExt/removeFolderConversations"
+	ConversationExt_GetFolderSortedConversationList_FullMethodName = "/openim.conversationext.conversationExt/getFolderSortedConversationList"
+	ConversationExt_SetConversationDraft_FullMethodName            = "/openim.conversationext.conversationExt/setConversationDraft"
+	ConversationExt_GetConversationDrafts_FullMethodName           = "/openim.conversationext.conversationExt/getConversationDrafts"
+	ConversationExt_SetDoNotDisturb_FullMethodName                 = "/openim.conversationext.conversationExt/setDoNotDisturb"
+	ConversationExt_GetDoNotDisturb_FullMethodName                 = "/openim.conversationext.conversationExt/getDoNotDisturb"
+	ConversationExt_MuteConversationUntil_FullMethodName           = "/openim.conversationext.conversationExt/muteConversationUntil"
+	ConversationExt_GetOfflinePushUserIDs_FullMethodName           = "/openim.conversationext.conversationExt/getOfflinePushUserIDs"
+	ConversationExt_PurgeUserConversations_FullMethodName          = "/openim.conversationext.conversationExt/purgeUserConversations"
+)
+
+// ConversationExtClient is the client API for ConversationExt service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ConversationExtClient interface {
+	// returns the conversations changed since a version of the conversation list
+	GetIncrementalConversation(ctx context.Context, in *GetIncrementalConversationReq, opts ...grpc.CallOption) (*GetIncrementalConversationResp, error)
+	// per-user conversation folders, changes are notified to all devices of the user by ConversationFolderNotification
+	CreateConversationFolder(ctx context.Context, in *CreateConversationFolderReq, opts ...grpc.CallOption) (*CreateConversationFolderResp, error)
+	SetConversationFolder(ctx context.Context, in *SetConversationFolderReq, opts ...grpc.CallOption) (*SetConversationFolderResp, error)
+	DeleteConversationFolder(ctx context.Context, in *DeleteConversationFolderReq, opts ...grpc.CallOption) (*DeleteConversationFolderResp, error)
+	SortConversationFolders(ctx context.Context, in *SortConversationFoldersReq, opts ...grpc.CallOption) (*SortConversationFoldersResp, error)
+	GetConversationFolders(ctx context.Context, in *GetConversationFoldersReq, opts ...grpc.CallOption) (*GetConversationFoldersResp, error)
+	AddFolderConversations(ctx context.Context, in *AddFolderConversationsReq, opts ...grpc.CallOption) (*AddFolderConversationsResp, error)
+	RemoveFolderConversations(ctx context.Context, in *RemoveFolderConversationsReq, opts ...grpc.CallOption) (*RemoveFolderConversationsResp, error)
+	// same as getSortedConversationList, limited to the conversations of a folder
+	GetFolderSortedConversationList(ctx context.Context, in *GetFolderSortedConversationListReq, opts ...grpc.CallOption) (*GetFolderSortedConversationListResp, error)
+	// drafts of unsent messages, changes are notified to the devices of the user online by ConversationDraftNotification
+	SetConversationDraft(ctx context.Context, in *SetConversationDraftReq, opts ...grpc.CallOption) (*SetConversationDraftResp, error)
+	GetConversationDrafts(ctx context.Context, in *GetConversationDraftsReq, opts ...grpc.CallOption) (*GetConversationDraftsResp, error)
+	// quiet hours and temporary mutes of offline push, quiet hour changes are notified to all devices of the user
+	// by DoNotDisturbNotification
+	SetDoNotDisturb(ctx context.Context, in *SetDoNotDisturbReq, opts ...grpc.CallOption) (*SetDoNotDisturbResp, error)
+	GetDoNotDisturb(ctx context.Context, in *GetDoNotDisturbReq, opts ...grpc.CallOption) (*GetDoNotDisturbResp, error)
+	MuteConversationUntil(ctx context.Context, in *MuteConversationUntilReq, opts ...grpc.CallOption) (*MuteConversationUntilResp, error)
+	// filters the users that receive the offline push of a message of the conversation now,
+	// by the receive option of the conversation and the do not disturb settings of the users
+	GetOfflinePushUserIDs(ctx context.Context, in *GetOfflinePushUserIDsReq, opts ...grpc.CallOption) (*GetOfflinePushUserIDsResp, error)
+	// admin only, deletes the conversations, folders, drafts and do not disturb settings of a deleted user
+	PurgeUserConversations(ctx context.Context, in *PurgeUserConversationsReq, opts ...grpc.CallOption) (*PurgeUserConversationsResp, error)
+}
+
+type conversationExtClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewConversationExtClient(cc grpc.ClientConnInterface) ConversationExtClient {
+	return &conversationExtClient{cc}
+}
+
+func (c *conversationExtClient) GetIncrementalConversation(ctx context.Context, in *GetIncrementalConversationReq, opts ...grpc.CallOption) (*GetIncrementalConversationResp, error) {
+	out := new(GetIncrementalConversationResp)
+	err := c.cc.Invoke(ctx, ConversationExt_GetIncrementalConversation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationExtClient) CreateConversationFolder(ctx context.Context, in *CreateConversationFolderReq, opts ...grpc.CallOption) (*CreateConversationFolderResp, error) {
+	out := new(CreateConversationFolderResp)
+	err := c.cc.Invoke(ctx, ConversationExt_CreateConversationFolder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationExtClient) SetConversationFolder(ctx context.Context, in *SetConversationFolderReq, opts ...grpc.CallOption) (*SetConversationFolderResp, error) {
+	out := new(SetConversationFolderResp)
+	err := c.cc.Invoke(ctx, ConversationExt_SetConversationFolder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationExtClient) DeleteConversationFolder(ctx context.Context, in *DeleteConversationFolderReq, opts ...grpc.CallOption) (*DeleteConversationFolderResp, error) {
+	out := new(DeleteConversationFolderResp)
+	err := c.cc.Invoke(ctx, ConversationExt_DeleteConversationFolder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationExtClient) SortConversationFolders(ctx context.Context, in *SortConversationFoldersReq, opts ...grpc.CallOption) (*SortConversationFoldersResp, error) {
+	out := new(SortConversationFoldersResp)
+	err := c.cc.Invoke(ctx, ConversationExt_SortConversationFolders_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationExtClient) GetConversationFolders(ctx context.Context, in *GetConversationFoldersReq, opts ...grpc.CallOption) (*GetConversationFoldersResp, error) {
+	out := new(GetConversationFoldersResp)
+	err := c.cc.Invoke(ctx, ConversationExt_GetConversationFolders_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationExtClient) AddFolderConversations(ctx context.Context, in *AddFolderConversationsReq, opts ...grpc.CallOption) (*AddFolderConversationsResp, error) {
+	out := new(AddFolderConversationsResp)
+	err := c.cc.Invoke(ctx, ConversationExt_AddFolderConversations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationExtClient) RemoveFolderConversations(ctx context.Context, in *RemoveFolderConversationsReq, opts ...grpc.CallOption) (*RemoveFolderConversationsResp, error) {
+	out := new(RemoveFolderConversationsResp)
+	err := c.cc.Invoke(ctx, ConversationExt_RemoveFolderConversations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationExtClient) GetFolderSortedConversationList(ctx context.Context, in *GetFolderSortedConversationListReq, opts ...grpc.CallOption) (*GetFolderSortedConversationListResp, error) {
+	out := new(GetFolderSortedConversationListResp)
+	err := c.cc.Invoke(ctx, ConversationExt_GetFolderSortedConversationList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationExtClient) SetConversationDraft(ctx context.Context, in *SetConversationDraftReq, opts ...grpc.CallOption) (*SetConversationDraftResp, error) {
+	out := new(SetConversationDraftResp)
+	err := c.cc.Invoke(ctx, ConversationExt_SetConversationDraft_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationExtClient) GetConversationDrafts(ctx context.Context, in *GetConversationDraftsReq, opts ...grpc.CallOption) (*GetConversationDraftsResp, error) {
+	out := new(GetConversationDraftsResp)
+	err := c.cc.Invoke(ctx, ConversationExt_GetConversationDrafts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationExtClient) SetDoNotDisturb(ctx context.Context, in *SetDoNotDisturbReq, opts ...grpc.CallOption) (*SetDoNotDisturbResp, error) {
+	out := new(SetDoNotDisturbResp)
+	err := c.cc.Invoke(ctx, ConversationExt_SetDoNotDisturb_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationExtClient) GetDoNotDisturb(ctx context.Context, in *GetDoNotDisturbReq, opts ...grpc.CallOption) (*GetDoNotDisturbResp, error) {
+	out := new(GetDoNotDisturbResp)
+	err := c.cc.Invoke(ctx, ConversationExt_GetDoNotDisturb_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationExtClient) MuteConversationUntil(ctx context.Context, in *MuteConversationUntilReq, opts ...grpc.CallOption) (*MuteConversationUntilResp, error) {
+	out := new(MuteConversationUntilResp)
+	err := c.cc.Invoke(ctx, ConversationExt_MuteConversationUntil_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationExtClient) GetOfflinePushUserIDs(ctx context.Context, in *GetOfflinePushUserIDsReq, opts ...grpc.CallOption) (*GetOfflinePushUserIDsResp, error) {
+	out := new(GetOfflinePushUserIDsResp)
+	err := c.cc.Invoke(ctx, ConversationExt_GetOfflinePushUserIDs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationExtClient) PurgeUserConversations(ctx context.Context, in *PurgeUserConversationsReq, opts ...grpc.CallOption) (*PurgeUserConversationsResp, error) {
+	out := new(PurgeUserConversationsResp)
+	err := c.cc.Invoke(ctx, ConversationExt_PurgeUserConversations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ConversationExtServer is the server API for ConversationExt service.
+// All implementations should embed UnimplementedConversationExtServer
+// for forward compatibility
+type ConversationExtServer interface {
+	// returns the conversations changed since a version of the conversation list
+	GetIncrementalConversation(context.Context, *GetIncrementalConversationReq) (*GetIncrementalConversationResp, error)
+	// per-user conversation folders, changes are notified to all devices of the user by ConversationFolderNotification
+	CreateConversationFolder(context.Context, *CreateConversationFolderReq) (*CreateConversationFolderResp, error)
+	SetConversationFolder(context.Context, *SetConversationFolderReq) (*SetConversationFolderResp, error)
+	DeleteConversationFolder(context.Context, *DeleteConversationFolderReq) (*DeleteConversationFolderResp, error)
+	SortConversationFolders(context.Context, *SortConversationFoldersReq) (*SortConversationFoldersResp, error)
+	GetConversationFolders(context.Context, *GetConversationFoldersReq) (*GetConversationFoldersResp, error)
+	AddFolderConversations(context.Context, *AddFolderConversationsReq) (*AddFolderConversationsResp, error)
+	RemoveFolderConversations(context.Context, *RemoveFolderConversationsReq) (*RemoveFolderConversationsResp, error)
+	// same as getSortedConversationList, limited to the conversations of a folder
+	GetFolderSortedConversationList(context.Context, *GetFolderSortedConversationListReq) (*GetFolderSortedConversationListResp, error)
+	// drafts of unsent messages, changes are notified to the devices of the user online by ConversationDraftNotification
+	SetConversationDraft(context.Context, *SetConversationDraftReq) (*SetConversationDraftResp, error)
+	GetConversationDrafts(context.Context, *GetConversationDraftsReq) (*GetConversationDraftsResp, error)
+	// quiet hours and temporary mutes of offline push, quiet hour changes are notified to all devices of the user
+	// by DoNotDisturbNotification
+	SetDoNotDisturb(context.Context, *SetDoNotDisturbReq) (*SetDoNotDisturbResp, error)
+	GetDoNotDisturb(context.Context, *GetDoNotDisturbReq) (*GetDoNotDisturbResp, error)
+	MuteConversationUntil(context.Context, *MuteConversationUntilReq) (*MuteConversationUntilResp, error)
+	// filters the users that receive the offline push of a message of the conversation now,
+	// by the receive option of the conversation and the do not disturb settings of the users
+	GetOfflinePushUserIDs(context.Context, *GetOfflinePushUserIDsReq) (*GetOfflinePushUserIDsResp, error)
+	// admin only, deletes the conversations, folders, drafts and do not disturb settings of a deleted user
+	PurgeUserConversations(context.Context, *PurgeUserConversationsReq) (*PurgeUserConversationsResp, error)
+}
+
+// UnimplementedConversationExtServer should be embedded to have forward compatible implementations.
+type UnimplementedConversationExtServer struct {
+}
+
+func (UnimplementedConversationExtServer) GetIncrementalConversation(context.Context, *GetIncrementalConversationReq) (*GetIncrementalConversationResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIncrementalConversation not implemented")
+}
+func (UnimplementedConversationExtServer) CreateConversationFolder(context.Context, *CreateConversationFolderReq) (*CreateConversationFolderResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateConversationFolder not implemented")
+}
+func (UnimplementedConversationExtServer) SetConversationFolder(context.Context, *SetConversationFolderReq) (*SetConversationFolderResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetConversationFolder not implemented")
+}
+func (UnimplementedConversationExtServer) DeleteConversationFolder(context.Context, *DeleteConversationFolderReq) (*DeleteConversationFolderResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteConversationFolder not implemented")
+}
+func (UnimplementedConversationExtServer) SortConversationFolders(context.Context, *SortConversationFoldersReq) (*SortConversationFoldersResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SortConversationFolders not implemented")
+}
+func (UnimplementedConversationExtServer) GetConversationFolders(context.Context, *GetConversationFoldersReq) (*GetConversationFoldersResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConversationFolders not implemented")
+}
+func (UnimplementedConversationExtServer) AddFolderConversations(context.Context, *AddFolderConversationsReq) (*AddFolderConversationsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddFolderConversations not implemented")
+}
+func (UnimplementedConversationExtServer) RemoveFolderConversations(context.Context, *RemoveFolderConversationsReq) (*RemoveFolderConversationsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveFolderConversations not implemented")
+}
+func (UnimplementedConversationExtServer) GetFolderSortedConversationList(context.Context, *GetFolderSortedConversationListReq) (*GetFolderSortedConversationListResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFolderSortedConversationList not implemented")
+}
+func (UnimplementedConversationExtServer) SetConversationDraft(context.Context, *SetConversationDraftReq) (*SetConversationDraftResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetConversationDraft not implemented")
+}
+func (UnimplementedConversationExtServer) GetConversationDrafts(context.Context, *GetConversationDraftsReq) (*GetConversationDraftsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConversationDrafts not implemented")
+}
+func (UnimplementedConversationExtServer) SetDoNotDisturb(context.Context, *SetDoNotDisturbReq) (*SetDoNotDisturbResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDoNotDisturb not implemented")
+}
+func (UnimplementedConversationExtServer) GetDoNotDisturb(context.Context, *GetDoNotDisturbReq) (*GetDoNotDisturbResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDoNotDisturb not implemented")
+}
+func (UnimplementedConversationExtServer) MuteConversationUntil(context.Context, *MuteConversationUntilReq) (*MuteConversationUntilResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MuteConversationUntil not implemented")
+}
+func (UnimplementedConversationExtServer) GetOfflinePushUserIDs(context.Context, *GetOfflinePushUserIDsReq) (*GetOfflinePushUserIDsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOfflinePushUserIDs not implemented")
+}
+func (UnimplementedConversationExtServer) PurgeUserConversations(context.Context, *PurgeUserConversationsReq) (*PurgeUserConversationsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeUserConversations not implemented")
+}
+
+// UnsafeConversationExtServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ConversationExtServer will
+// result in compilation errors.
+type UnsafeConversationExtServer interface {
+	mustEmbedUnimplementedConversationExtServer()
+}
+
+func RegisterConversationExtServer(s grpc.ServiceRegistrar, srv ConversationExtServer) {
+	s.RegisterService(&ConversationExt_ServiceDesc, srv)
+}
+
+func _ConversationExt_GetIncrementalConversation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIncrementalConversationReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationExtServer).GetIncrementalConversation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConversationExt_GetIncrementalConversation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationExtServer).GetIncrementalConversation(ctx, req.(*GetIncrementalConversationReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConversationExt_CreateConversationFolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateConversationFolderReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationExtServer).CreateConversationFolder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConversationExt_CreateConversationFolder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationExtServer).CreateConversationFolder(ctx, req.(*CreateConversationFolderReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConversationExt_SetConversationFolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetConversationFolderReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationExtServer).SetConversationFolder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConversationExt_SetConversationFolder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationExtServer).SetConversationFolder(ctx, req.(*SetConversationFolderReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConversationExt_DeleteConversationFolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteConversationFolderReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationExtServer).DeleteConversationFolder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConversationExt_DeleteConversationFolder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationExtServer).DeleteConversationFolder(ctx, req.(*DeleteConversationFolderReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConversationExt_SortConversationFolders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SortConversationFoldersReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationExtServer).SortConversationFolders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConversationExt_SortConversationFolders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationExtServer).SortConversationFolders(ctx, req.(*SortConversationFoldersReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConversationExt_GetConversationFolders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConversationFoldersReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationExtServer).GetConversationFolders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConversationExt_GetConversationFolders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationExtServer).GetConversationFolders(ctx, req.(*GetConversationFoldersReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConversationExt_AddFolderConversations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddFolderConversationsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationExtServer).AddFolderConversations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConversationExt_AddFolderConversations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationExtServer).AddFolderConversations(ctx, req.(*AddFolderConversationsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConversationExt_RemoveFolderConversations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveFolderConversationsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationExtServer).RemoveFolderConversations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConversationExt_RemoveFolderConversations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationExtServer).RemoveFolderConversations(ctx, req.(*RemoveFolderConversationsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConversationExt_GetFolderSortedConversationList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFolderSortedConversationListReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationExtServer).GetFolderSortedConversationList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConversationExt_GetFolderSortedConversationList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationExtServer).GetFolderSortedConversationList(ctx, req.(*GetFolderSortedConversationListReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConversationExt_SetConversationDraft_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetConversationDraftReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationExtServer).SetConversationDraft(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConversationExt_SetConversationDraft_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationExtServer).SetConversationDraft(ctx, req.(*SetConversationDraftReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConversationExt_GetConversationDrafts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConversationDraftsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationExtServer).GetConversationDrafts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConversationExt_GetConversationDrafts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationExtServer).GetConversationDrafts(ctx, req.(*GetConversationDraftsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConversationExt_SetDoNotDisturb_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDoNotDisturbReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationExtServer).SetDoNotDisturb(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConversationExt_SetDoNotDisturb_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationExtServer).SetDoNotDisturb(ctx, req.(*SetDoNotDisturbReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConversationExt_GetDoNotDisturb_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDoNotDisturbReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationExtServer).GetDoNotDisturb(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConversationExt_GetDoNotDisturb_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationExtServer).GetDoNotDisturb(ctx, req.(*GetDoNotDisturbReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConversationExt_MuteConversationUntil_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MuteConversationUntilReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationExtServer).MuteConversationUntil(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConversationExt_MuteConversationUntil_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationExtServer).MuteConversationUntil(ctx, req.(*MuteConversationUntilReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConversationExt_GetOfflinePushUserIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOfflinePushUserIDsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationExtServer).GetOfflinePushUserIDs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConversationExt_GetOfflinePushUserIDs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationExtServer).GetOfflinePushUserIDs(ctx, req.(*GetOfflinePushUserIDsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConversationExt_PurgeUserConversations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeUserConversationsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationExtServer).PurgeUserConversations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConversationExt_PurgeUserConversations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationExtServer).PurgeUserConversations(ctx, req.(*PurgeUserConversationsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ConversationExt_ServiceDesc is the grpc.ServiceDesc for ConversationExt service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ConversationExt_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "openim.conversationext.conversationExt",
+	HandlerType: (*ConversationExtServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "getIncrementalConversation",
+			Handler:    _ConversationExt_GetIncrementalConversation_Handler,
+		},
+		{
+			MethodName: "createConversationFolder",
+			Handler:    _ConversationExt_CreateConversationFolder_Handler,
+		},
+		{
+			MethodName: "setConversationFolder",
+			Handler:    _ConversationExt_SetConversationFolder_Handler,
+		},
+		{
+			MethodName: "deleteConversationFolder",
+			Handler:    _ConversationExt_DeleteConversationFolder_Handler,
+		},
+		{
+			MethodName: "sortConversationFolders",
+			Handler:    _ConversationExt_SortConversationFolders_Handler,
+		},
+		{
+			MethodName: "getConversationFolders",
+			Handler:    _ConversationExt_GetConversationFolders_Handler,
+		},
+		{
+			MethodName: "addFolderConversations",
+			Handler:    _ConversationExt_AddFolderConversations_Handler,
+		},
+		{
+			MethodName: "removeFolderConversations",
+			Handler:    _ConversationExt_RemoveFolderConversations_Handler,
+		},
+		{
+			MethodName: "getFolderSortedConversationList",
+			Handler:    _ConversationExt_GetFolderSortedConversationList_Handler,
+		},
+		{
+			MethodName: "setConversationDraft",
+			Handler:    _ConversationExt_SetConversationDraft_Handler,
+		},
+		{
+			MethodName: "getConversationDrafts",
+			Handler:    _ConversationExt_GetConversationDrafts_Handler,
+		},
+		{
+			MethodName: "setDoNotDisturb",
+			Handler:    _ConversationExt_SetDoNotDisturb_Handler,
+		},
+		{
+			MethodName: "getDoNotDisturb",
+			Handler:    _ConversationExt_GetDoNotDisturb_Handler,
+		},
+		{
+			MethodName: "muteConversationUntil",
+			Handler:    _ConversationExt_MuteConversationUntil_Handler,
+		},
+		{
+			MethodName: "getOfflinePushUserIDs",
+			Handler:    _ConversationExt_GetOfflinePushUserIDs_Handler,
+		},
+		{
+			MethodName: "purgeUserConversations",
+			Handler:    _ConversationExt_PurgeUserConversations_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "conversationext/conversationext.proto",
+}
@@ -0,0 +1,327 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package friendext
+
+import (
+	"encoding/hex"
+	"errors"
+	"strings"
+
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/tools/utils/datautil"
+)
+
+// FriendGroupChangedNotification tells the devices of a user the friend groups or their members changed.
+const FriendGroupChangedNotification = constant.FriendsInfoUpdateNotification + 1
+
+// Policies deciding who can send friend requests to a user.
+const (
+	AddPolicyEveryone = iota
+	AddPolicyFriendsOfFriends
+	AddPolicyNobody
+	AddPolicyQuestion
+)
+
+// MaxFriendAddQuestionLength is the maximum length of the question and of the answer in bytes.
+const MaxFriendAddQuestionLength = 256
+
+// Types of the identifiers of contact discovery.
+const (
+	ContactPhone = iota + 1
+	ContactEmail
+)
+
+// MaxContactHashesPerUser is the maximum number of hashes of one type a user can register.
+const MaxContactHashesPerUser = 10
+
+// MaxFriendRequestSenders is the maximum number of senders returned by getFriendRequestSenders.
+const MaxFriendRequestSenders = 1000
+
+func (x *CreateFriendGroupReq) Check() error {
+	if x.OwnerUserID == "" {
+		return errors.New("ownerUserID is empty")
+	}
+	if x.Name == "" {
+		return errors.New("name is empty")
+	}
+	return nil
+}
+
+func (x *SetFriendGroupReq) Check() error {
+	if x.OwnerUserID == "" {
+		return errors.New("ownerUserID is empty")
+	}
+	if x.GroupID == "" {
+		return errors.New("groupID is empty")
+	}
+	if x.Name == "" {
+		return errors.New("name is empty")
+	}
+	return nil
+}
+
+func (x *DeleteFriendGroupReq) Check() error {
+	if x.OwnerUserID == "" {
+		return errors.New("ownerUserID is empty")
+	}
+	if x.GroupID == "" {
+		return errors.New("groupID is empty")
+	}
+	return nil
+}
+
+func (x *SortFriendGroupsReq) Check() error {
+	if x.OwnerUserID == "" {
+		return errors.New("ownerUserID is empty")
+	}
+	if len(x.GroupIDs) == 0 {
+		return errors.New("groupIDs is empty")
+	}
+	if datautil.Duplicate(x.GroupIDs) {
+		return errors.New("groupIDs is duplicate")
+	}
+	return nil
+}
+
+func (x *GetFriendGroupsReq) Check() error {
+	if x.OwnerUserID == "" {
+		return errors.New("ownerUserID is empty")
+	}
+	return nil
+}
+
+func (x *AddFriendsToGroupReq) Check() error {
+	if x.OwnerUserID == "" {
+		return errors.New("ownerUserID is empty")
+	}
+	if x.GroupID == "" {
+		return errors.New("groupID is empty")
+	}
+	if len(x.FriendUserIDs) == 0 {
+		return errors.New("friendUserIDs is empty")
+	}
+	if datautil.Duplicate(x.FriendUserIDs) {
+		return errors.New("friendUserIDs is duplicate")
+	}
+	return nil
+}
+
+func (x *RemoveFriendsFromGroupReq) Check() error {
+	if x.OwnerUserID == "" {
+		return errors.New("ownerUserID is empty")
+	}
+	if x.GroupID == "" {
+		return errors.New("groupID is empty")
+	}
+	if len(x.FriendUserIDs) == 0 {
+		return errors.New("friendUserIDs is empty")
+	}
+	if datautil.Duplicate(x.FriendUserIDs) {
+		return errors.New("friendUserIDs is duplicate")
+	}
+	return nil
+}
+
+func (x *MoveFriendsToGroupReq) Check() error {
+	if x.OwnerUserID == "" {
+		return errors.New("ownerUserID is empty")
+	}
+	if len(x.FriendUserIDs) == 0 {
+		return errors.New("friendUserIDs is empty")
+	}
+	if datautil.Duplicate(x.FriendUserIDs) {
+		return errors.New("friendUserIDs is duplicate")
+	}
+	if x.ToGroupID == "" {
+		return errors.New("toGroupID is empty")
+	}
+	if x.FromGroupID == x.ToGroupID {
+		return errors.New("fromGroupID is the same as toGroupID")
+	}
+	return nil
+}
+
+func (x *GetFriendsGroupIDsReq) Check() error {
+	if x.OwnerUserID == "" {
+		return errors.New("ownerUserID is empty")
+	}
+	if len(x.FriendUserIDs) == 0 {
+		return errors.New("friendUserIDs is empty")
+	}
+	return nil
+}
+
+func (x *GetPaginationGroupFriendsReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	if x.GroupID == "" {
+		return errors.New("groupID is empty")
+	}
+	return nil
+}
+
+func (x *FriendAddSetting) Check() error {
+	switch x.AddPolicy {
+	case AddPolicyEveryone, AddPolicyFriendsOfFriends, AddPolicyNobody:
+	case AddPolicyQuestion:
+		if x.Question == "" {
+			return errors.New("question is empty")
+		}
+		if x.Answer == "" {
+			return errors.New("answer is empty")
+		}
+	default:
+		return errors.New("addPolicy is invalid")
+	}
+	if len(x.Question) > MaxFriendAddQuestionLength || len(x.Answer) > MaxFriendAddQuestionLength {
+		return errors.New("question or answer is too long")
+	}
+	return nil
+}
+
+func (x *SetFriendAddSettingReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	if x.Setting == nil {
+		return errors.New("setting is nil")
+	}
+	return x.Setting.Check()
+}
+
+func (x *GetFriendAddSettingReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	return nil
+}
+
+func (x *GetFriendAddPolicyReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	return nil
+}
+
+func (x *ApplyToAddFriendWithAnswerReq) Check() error {
+	if x.FromUserID == "" {
+		return errors.New("fromUserID is empty")
+	}
+	if x.ToUserID == "" {
+		return errors.New("toUserID is empty")
+	}
+	if len(x.Answer) > MaxFriendAddQuestionLength {
+		return errors.New("answer is too long")
+	}
+	return nil
+}
+
+func (x *ExpireFriendRequestsReq) Check() error {
+	if x.Before <= 0 {
+		return errors.New("before is invalid")
+	}
+	return nil
+}
+
+func (x *GetFriendRequestSendersReq) Check() error {
+	if x.Limit <= 0 || x.Limit > MaxFriendRequestSenders {
+		return errors.New("limit is invalid")
+	}
+	return nil
+}
+
+// checkContactHashes checks the hashes are lowercase hex SHA-256 digests.
+func checkContactHashes(hashes []string) error {
+	for _, hash := range hashes {
+		if len(hash) != 64 || strings.ToLower(hash) != hash {
+			return errors.New("hash is not a lowercase hex sha256")
+		}
+		if _, err := hex.DecodeString(hash); err != nil {
+			return errors.New("hash is not a lowercase hex sha256")
+		}
+	}
+	if datautil.Duplicate(hashes) {
+		return errors.New("hashes is duplicate")
+	}
+	return nil
+}
+
+func (x *SetContactHashesReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	if x.Type != ContactPhone && x.Type != ContactEmail {
+		return errors.New("type is invalid")
+	}
+	if len(x.Hashes) > MaxContactHashesPerUser {
+		return errors.New("too many hashes")
+	}
+	return checkContactHashes(x.Hashes)
+}
+
+func (x *SetContactDiscoverySettingReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	if x.Setting == nil {
+		return errors.New("setting is nil")
+	}
+	return nil
+}
+
+func (x *GetContactDiscoverySettingReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	return nil
+}
+
+func (x *DiscoverContactsReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	if len(x.Hashes) == 0 {
+		return errors.New("hashes is empty")
+	}
+	return checkContactHashes(x.Hashes)
+}
+
+func (x *GetFriendSuggestionsReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	if x.Pagination == nil || x.Pagination.PageNumber < 1 || x.Pagination.ShowNumber < 1 {
+		return errors.New("pagination is invalid")
+	}
+	return nil
+}
+
+func (x *DismissFriendSuggestionReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	if x.SuggestedUserID == "" {
+		return errors.New("suggestedUserID is empty")
+	}
+	return nil
+}
+
+func (x *PurgeUserRelationsReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	return nil
+}
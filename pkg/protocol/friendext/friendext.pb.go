@@ -0,0 +1,4401 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.33.0
+// 	protoc        (unknown)
+// source: friendext/friendext.proto
+
+package friendext
+
+import (
+	sdkws "github.com/openimsdk/protocol/sdkws"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type FriendGroup struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupID string `protobuf:"bytes,1,opt,name=groupID,proto3" json:"groupID"`
+	Name    string `protobuf:"bytes,2,opt,name=name,proto3" json:"name"`
+	// groups are listed by ascending order
+	Order      int32  `protobuf:"varint,3,opt,name=order,proto3" json:"order"`
+	Ex         string `protobuf:"bytes,4,opt,name=ex,proto3" json:"ex"`
+	CreateTime int64  `protobuf:"varint,5,opt,name=createTime,proto3" json:"createTime"`
+}
+
+func (x *FriendGroup) Reset() {
+	*x = FriendGroup{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_friendext_friendext_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FriendGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FriendGroup) ProtoMessage() {}
+
+func (x *FriendGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_friendext_friendext_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FriendGroup.ProtoReflect.Descriptor instead.
+func (*FriendGroup) Descriptor() ([]byte, []int) {
+	return file_friendext_friendext_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *FriendGroup) GetGroupID() string {
+	if x != nil {
+		return x.GroupID
+	}
+	return ""
+}
+
+func (x *FriendGroup) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FriendGroup) GetOrder() int32 {
+	if x != nil {
+		return x.Order
+	}
+	return 0
+}
+
+func (x *FriendGroup) GetEx() string {
+	if x != nil {
+		return x.Ex
+	}
+	return ""
+}
+
+func (x *FriendGroup) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+type CreateFriendGroupReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OwnerUserID string `protobuf:"bytes,1,opt,name=ownerUserID,proto3" json:"ownerUserID"`
+	Name        string `protobuf:"bytes,2,opt,name=name,proto3" json:"name"`
+	Ex          string `protobuf:"bytes,3,opt,name=ex,proto3" json:"ex"`
+}
+
+func (x *CreateFriendGroupReq) Reset() {
+	*x = CreateFriendGroupReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_friendext_friendext_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateFriendGroupReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFriendGroupReq) ProtoMessage() {}
+
+func (x *CreateFriendGroupReq) ProtoReflect() protoreflect.Message {
+	mi := &file_friendext_friendext_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFriendGroupReq.ProtoReflect.Descriptor instead.
+func (*CreateFriendGroupReq) Descriptor() ([]byte, []int) {
+	return file_friendext_friendext_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateFriendGroupReq) GetOwnerUserID() string {
+	if x != nil {
+		return x.OwnerUserID
+	}
+	return ""
+}
+
+func (x *CreateFriendGroupReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateFriendGroupReq) GetEx() string {
+	if x != nil {
+		return x.Ex
+	}
+	return ""
+}
+
+type CreateFriendGroupResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Group *FriendGroup `protobuf:"bytes,1,opt,name=group,proto3" json:"group"`
+}
+
+func (x *CreateFriendGroupResp) Reset() {
+	*x = CreateFriendGroupResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_friendext_friendext_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateFriendGroupResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFriendGroupResp) ProtoMessage() {}
+
+func (x *CreateFriendGroupResp) ProtoReflect() protoreflect.Message {
+	mi := &file_friendext_friendext_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFriendGroupResp.ProtoReflect.Descriptor instead.
+func (*CreateFriendGroupResp) Descriptor() ([]byte, []int) {
+	return file_friendext_friendext_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateFriendGroupResp) GetGroup() *FriendGroup {
+	if x != nil {
+		return x.Group
+	}
+	return nil
+}
+
+type SetFriendGroupReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OwnerUserID string `protobuf:"bytes,1,opt,name=ownerUserID,proto3" json:"ownerUserID"`
+	GroupID     string `protobuf:"bytes,2,opt,name=groupID,proto3" json:"groupID"`
+	Name        string `protobuf:"bytes,3,opt,name=name,proto3" json:"name"`
+	Ex          string `protobuf:"bytes,4,opt,name=ex,proto3" json:"ex"`
+}
+
+func (x *SetFriendGroupReq) Reset() {
+	*x = SetFriendGroupReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_friendext_friendext_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetFriendGroupReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetFriendGroupReq) ProtoMessage() {}
+
+func (x *SetFriendGroupReq) ProtoReflect() protoreflect.Message {
+	mi := &file_friendext_friendext_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetFriendGroupReq.ProtoReflect.Descriptor instead.
+func (*SetFriendGroupReq) Descriptor() ([]byte, []int) {
+	return file_friendext_friendext_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SetFriendGroupReq) GetOwnerUserID() string {
+	if x != nil {
+		return x.OwnerUserID
+	}
+	return ""
+}
+
+func (x *SetFriendGroupReq) GetGroupID() string {
+	if x != nil {
+		return x.GroupID
+	}
+	return ""
+}
+
+func (x *SetFriendGroupReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SetFriendGroupReq) GetEx() string {
+	if x != nil {
+		return x.Ex
+	}
+	return ""
+}
+
+type SetFriendGroupResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetFriendGroupResp) Reset() {
+	*x = SetFriendGroupResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_friendext_friendext_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetFriendGroupResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetFriendGroupResp) ProtoMessage() {}
+
+func (x *SetFriendGroupResp) ProtoReflect() protoreflect.Message {
+	mi := &file_friendext_friendext_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetFriendGroupResp.ProtoReflect.Descriptor instead.
+func (*SetFriendGroupResp) Descriptor() ([]byte, []int) {
+	return file_friendext_friendext_proto_rawDescGZIP(), []int{4}
+}
+
+type DeleteFriendGroupReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OwnerUserID string `protobuf:"bytes,1,opt,name=ownerUserID,proto3" json:"ownerUserID"`
+	GroupID     string `protobuf:"bytes,2,opt,name=groupID,proto3" json:"groupID"`
+}
+
+func (x *DeleteFriendGroupReq) Reset() {
+	*x = DeleteFriendGroupReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_friendext_friendext_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteFriendGroupReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFriendGroupReq) ProtoMessage() {}
+
+func (x *DeleteFriendGroupReq) ProtoReflect() protoreflect.Message {
+	mi := &file_friendext_friendext_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFriendGroupReq.ProtoReflect.Descriptor instead.
+func (*DeleteFriendGroupReq) Descriptor() ([]byte, []int) {
+	return file_friendext_friendext_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DeleteFriendGroupReq) GetOwnerUserID() string {
+	if x != nil {
+		return x.OwnerUserID
+	}
+	return ""
+}
+
+func (x *DeleteFriendGroupReq) GetGroupID() string {
+	if x != nil {
+		return x.GroupID
+	}
+	return ""
+}
+
+type DeleteFriendGroupResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteFriendGroupResp) Reset() {
+	*x = DeleteFriendGroupResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_friendext_friendext_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteFriendGroupResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFriendGroupResp) ProtoMessage() {}
+
+func (x *DeleteFriendGroupResp) ProtoReflect() protoreflect.Message {
+	mi := &file_friendext_friendext_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFriendGroupResp.ProtoReflect.Descriptor instead.
+func (*DeleteFriendGroupResp) Descriptor() ([]byte, []int) {
+	return file_friendext_friendext_proto_rawDescGZIP(), []int{6}
+}
+
+type SortFriendGroupsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OwnerUserID string `protobuf:"bytes,1,opt,name=ownerUserID,proto3" json:"ownerUserID"`
+	// groups not listed keep their order after the listed ones
+	GroupIDs []string `protobuf:"bytes,2,rep,name=groupIDs,proto3" json:"groupIDs"`
+}
+
+func (x *SortFriendGroupsReq) Reset() {
+	*x = SortFriendGroupsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_friendext_friendext_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SortFriendGroupsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SortFriendGroupsReq) ProtoMessage() {}
+
+func (x *SortFriendGroupsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_friendext_friendext_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SortFriendGroupsReq.ProtoReflect.Descriptor instead.
+func (*SortFriendGroupsReq) Descriptor() ([]byte, []int) {
+	return file_friendext_friendext_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SortFriendGroupsReq) GetOwnerUserID() string {
+	if x != nil {
+		return x.OwnerUserID
+	}
+	return ""
+}
+
+func (x *SortFriendGroupsReq) GetGroupIDs() []string {
+	if x != nil {
+		return x.GroupIDs
+	}
+	return nil
+}
+
+type SortFriendGroupsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SortFriendGroupsResp) Reset() {
+	*x = SortFriendGroupsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_friendext_friendext_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SortFriendGroupsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SortFriendGroupsResp) ProtoMessage() {}
+
+func (x *SortFriendGroupsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_friendext_friendext_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SortFriendGroupsResp.ProtoReflect.Descriptor instead.
+func (*SortFriendGroupsResp) Descriptor() ([]byte, []int) {
+	return file_friendext_friendext_proto_rawDescGZIP(), []int{8}
+}
+
+type GetFriendGroupsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OwnerUserID string `protobuf:"bytes,1,opt,name=ownerUserID,proto3" json:"ownerUserID"`
+}
+
+func (x *GetFriendGroupsReq) Reset() {
+	*x = GetFriendGroupsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_friendext_friendext_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFriendGroupsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFriendGroupsReq) ProtoMessage() {}
+
+func (x *GetFriendGroupsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_friendext_friendext_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFriendGroupsReq.ProtoReflect.Descriptor instead.
+func (*GetFriendGroupsReq) Descriptor() ([]byte, []int) {
+	return file_friendext_friendext_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetFriendGroupsReq) GetOwnerUserID() string {
+	if x != nil {
+		return x.OwnerUserID
+	}
+	return ""
+}
+
+type GetFriendGroupsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Groups []*FriendGroup `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups"`
+}
+
+func (x *GetFriendGroupsResp) Reset() {
+	*x = GetFriendGroupsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_friendext_friendext_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFriendGroupsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFriendGroupsResp) ProtoMessage() {}
+
+func (x *GetFriendGroupsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_friendext_friendext_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFriendGroupsResp.ProtoReflect.Descriptor instead.
+func (*GetFriendGroupsResp) Descriptor() ([]byte, []int) {
+	return file_friendext_friendext_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetFriendGroupsResp) GetGroups() []*FriendGroup {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+type AddFriendsToGroupReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OwnerUserID   string   `protobuf:"bytes,1,opt,name=ownerUserID,proto3" json:"ownerUserID"`
+	GroupID       string   `protobuf:"bytes,2,opt,name=groupID,proto3" json:"groupID"`
+	FriendUserIDs []string `protobuf:"bytes,3,rep,name=friendUserIDs,proto3" json:"friendUserIDs"`
+}
+
+func (x *AddFriendsToGroupReq) Reset() {
+	*x = AddFriendsToGroupReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_friendext_friendext_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddFriendsToGroupReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddFriendsToGroupReq) ProtoMessage() {}
+
+func (x *AddFriendsToGroupReq) ProtoReflect() protoreflect.Message {
+	mi := &file_friendext_friendext_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddFriendsToGroupReq.ProtoReflect.Descriptor instead.
+func (*AddFriendsToGroupReq) Descriptor() ([]byte, []int) {
+	return file_friendext_friendext_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *AddFriendsToGroupReq) GetOwnerUserID() string {
+	if x != nil {
+		return x.OwnerUserID
+	}
+	return ""
+}
+
+func (x *AddFriendsToGroupReq) GetGroupID() string {
+	if x != nil {
+		return x.GroupID
+	}
+	return ""
+}
+
+func (x *AddFriendsToGroupReq) GetFriendUserIDs() []string {
+	if x != nil {
+		return x.FriendUserIDs
+	}
+	return nil
+}
+
+type AddFriendsToGroupResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AddFriendsToGroupResp) Reset() {
+	*x = AddFriendsToGroupResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_friendext_friendext_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddFriendsToGroupResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddFriendsToGroupResp) ProtoMessage() {}
+
+func (x *AddFriendsToGroupResp) ProtoReflect() protoreflect.Message {
+	mi := &file_friendext_friendext_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddFriendsToGroupResp.ProtoReflect.Descriptor instead.
+func (*AddFriendsToGroupResp) Descriptor() ([]byte, []int) {
+	return file_friendext_friendext_proto_rawDescGZIP(), []int{12}
+}
+
+type RemoveFriendsFromGroupReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OwnerUserID   string   `protobuf:"bytes,1,opt,name=ownerUserID,proto3" json:"ownerUserID"`
+	GroupID       string   `protobuf:"bytes,2,opt,name=groupID,proto3" json:"groupID"`
+	FriendUserIDs []string `protobuf:"bytes,3,rep,name=friendUserIDs,proto3" json:"friendUserIDs"`
+}
+
+func (x *RemoveFriendsFromGroupReq) Reset() {
+	*x = RemoveFriendsFromGroupReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_friendext_friendext_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveFriendsFromGroupReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveFriendsFromGroupReq) ProtoMessage() {}
+
+func (x *RemoveFriendsFromGroupReq) ProtoReflect() protoreflect.Message {
+	mi := &file_friendext_friendext_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveFriendsFromGroupReq.ProtoReflect.Descriptor instead.
+func (*RemoveFriendsFromGroupReq) Descriptor() ([]byte, []int) {
+	return file_friendext_friendext_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *RemoveFriendsFromGroupReq) GetOwnerUserID() string {
+	if x != nil {
+		return x.OwnerUserID
+	}
+	return ""
+}
+
+func (x *RemoveFriendsFromGroupReq) GetGroupID() string {
+	if x != nil {
+		return x.GroupID
+	}
+	return ""
+}
+
+func (x *RemoveFriendsFromGroupReq) GetFriendUserIDs() []string {
+	if x != nil {
+		return x.FriendUserIDs
+	}
+	return nil
+}
+
+type RemoveFriendsFromGroupResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RemoveFriendsFromGroupResp) Reset() {
+	*x = RemoveFriendsFromGroupResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_friendext_friendext_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveFriendsFromGroupResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveFriendsFromGroupResp) ProtoMessage() {}
+
+func (x *RemoveFriendsFromGroupResp) ProtoReflect() protoreflect.Message {
+	mi := &file_friendext_friendext_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveFriendsFromGroupResp.ProtoReflect.Descriptor instead.
+func (*RemoveFriendsFromGroupResp) Descriptor() ([]byte, []int) {
+	return file_friendext_friendext_proto_rawDescGZIP(), []int{14}
+}
+
+type MoveFriendsToGroupReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OwnerUserID   string   `protobuf:"bytes,1,opt,name=ownerUserID,proto3" json:"ownerUserID"`
+	FriendUserIDs []string `protobuf:"bytes,2,rep,name=friendUserIDs,proto3" json:"friendUserIDs"`
+	// the friends stay in their other groups
+	FromGroupID string `protobuf:"bytes,3,opt,name=fromGroupID,proto3" json:"fromGroupID"`
+	ToGroupID   string `protobuf:"bytes,4,opt,name=toGroupID,proto3" json:"toGroupID"`
+}
+
+func (x *MoveFriendsToGroupReq) Reset() {
+	*x = MoveFriendsToGroupReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_friendext_friendext_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MoveFriendsToGroupReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveFriendsToGroupReq) ProtoMessage() {}
+
+func (x *MoveFriendsToGroupReq) ProtoReflect() protoreflect.Message {
+	mi := &file_friendext_friendext_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveFriendsToGroupReq.ProtoReflect.Descriptor instead.
+func (*MoveFriendsToGroupReq) Descriptor() ([]byte, []int) {
+	return file_friendext_friendext_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *MoveFriendsToGroupReq) GetOwnerUserID() string {
+	if x != nil {
+		return x.OwnerUserID
+	}
+	return ""
+}
+
+func (x *MoveFriendsToGroupReq) GetFriendUserIDs() []string {
+	if x != nil {
+		return x.FriendUserIDs
+	}
+	return nil
+}
+
+func (x *MoveFriendsToGroupReq) GetFromGroupID() string {
+	if x != nil {
+		return x.FromGroupID
+	}
+	return ""
+}
+
+func (x *MoveFriendsToGroupReq) GetToGroupID() string {
+	if x != nil {
+		return x.ToGroupID
+	}
+	return ""
+}
+
+type MoveFriendsToGroupResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MoveFriendsToGroupResp) Reset() {
+	*x = MoveFriendsToGroupResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_friendext_friendext_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MoveFriendsToGroupResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveFriendsToGroupResp) ProtoMessage() {}
+
+func (x *MoveFriendsToGroupResp) ProtoReflect() protoreflect.Message {
+	mi := &file_friendext_friendext_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveFriendsToGroupResp.ProtoReflect.Descriptor instead.
+func (*MoveFriendsToGroupResp) Descriptor() ([]byte, []int) {
+	return file_friendext_friendext_proto_rawDescGZIP(), []int{16}
+}
+
+type FriendGroupIDs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FriendUserID string   `protobuf:"bytes,1,opt,name=friendUserID,proto3" json:"friendUserID"`
+	GroupIDs     []string `protobuf:"bytes,2,rep,name=groupIDs,proto3" json:"groupIDs"`
+}
+
+func (x *FriendGroupIDs) Reset() {
+	*x = FriendGroupIDs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_friendext_friendext_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FriendGroupIDs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FriendGroupIDs) ProtoMessage() {}
+
+func (x *FriendGroupIDs) ProtoReflect() protoreflect.Message {
+	mi := &file_friendext_friendext_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FriendGroupIDs.ProtoReflect.Descriptor instead.
+func (*FriendGroupIDs) Descriptor() ([]byte, []int) {
+	return file_friendext_friendext_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *FriendGroupIDs) GetFriendUserID() string {
+	if x != nil {
+		return x.FriendUserID
+	}
+	return ""
+}
+
+func (x *FriendGroupIDs) GetGroupIDs() []string {
+	if x != nil {
+		return x.GroupIDs
+	}
+	return nil
+}
+
+type GetFriendsGroupIDsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OwnerUserID   string   `protobuf:"bytes,1,opt,name=ownerUserID,proto3" json:"ownerUserID"`
+	FriendUserIDs []string `protobuf:"bytes,2,rep,name=friendUserIDs,proto3" json:"friendUserIDs"`
+}
+
+func (x *GetFriendsGroupIDsReq) Reset() {
+	*x = GetFriendsGroupIDsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_friendext_friendext_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFriendsGroupIDsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFriendsGroupIDsReq) ProtoMessage() {}
+
+func (x *GetFriendsGroupIDsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_friendext_friendext_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFriendsGroupIDsReq.ProtoReflect.Descriptor instead.
+func (*GetFriendsGroupIDsReq) Descriptor() ([]byte, []int) {
+	return file_friendext_friendext_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetFriendsGroupIDsReq) GetOwnerUserID() string {
+	if x != nil {
+		return x.OwnerUserID
+	}
+	return ""
+}
+
+func (x *GetFriendsGroupIDsReq) GetFriendUserIDs() []string {
+	if x != nil {
+		return x.FriendUserIDs
+	}
+	return nil
+}
+
+type GetFriendsGroupIDsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Friends []*FriendGroupIDs `protobuf:"bytes,1,rep,name=friends,proto3" json:"friends"`
+}
+
+func (x *GetFriendsGroupIDsResp) Reset() {
+	*x = GetFriendsGroupIDsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_friendext_friendext_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFriendsGroupIDsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFriendsGroupIDsResp) ProtoMessage() {}
+
+func (x *GetFriendsGroupIDsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_friendext_friendext_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFriendsGroupIDsResp.ProtoReflect.Descriptor instead.
+func (*GetFriendsGroupIDsResp) Descriptor() ([]byte, []int) {
+	return file_friendext_friendext_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetFriendsGroupIDsResp) GetFriends() []*FriendGroupIDs {
+	if x != nil {
+		return x.Friends
+	}
+	return nil
+}
+
+type GetPaginationGroupFriendsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID     string                   `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	GroupID    string                   `protobuf:"bytes,2,opt,name=groupID,proto3" json:"groupID"`
+	Pagination *sdkws.RequestPagination `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination"`
+}
+
+func (x *GetPaginationGroupFriendsReq) Reset() {
+	*x = GetPaginationGroupFriendsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_friendext_friendext_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPaginationGroupFriendsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPaginationGroupFriendsReq) ProtoMessage() {}
+
+func (x *GetPaginationGroupFriendsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_friendext_friendext_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPaginationGroupFriendsReq.ProtoReflect.Descriptor instead.
+func (*GetPaginationGroupFriendsReq) Descriptor() ([]byte, []int) {
+	return file_friendext_friendext_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetPaginationGroupFriendsReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *GetPaginationGroupFriendsReq) GetGroupID() string {
+	if x != nil {
+		return x.GroupID
+	}
+	return ""
+}
+
+func (x *GetPaginationGroupFriendsReq) GetPagination() *sdkws.RequestPagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type GetPaginationGroupFriendsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FriendsInfo []*sdkws.FriendInfo `protobuf:"bytes,1,rep,name=friendsInfo,proto3" json:"friendsInfo"`
+	Total       int32               `protobuf:"varint,2,opt,name=total,proto3" json:"total"`
+}
+
+func (x *GetPaginationGroupFriendsResp) Reset() {
+	*x = GetPaginationGroupFriendsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_friendext_friendext_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPaginationGroupFriendsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPaginationGroupFriendsResp) ProtoMessage() {}
+
+func (x *GetPaginationGroupFriendsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_friendext_friendext_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPaginationGroupFriendsResp.ProtoReflect.Descriptor instead.
+func (*GetPaginationGroupFriendsResp) Descriptor() ([]byte, []int) {
+	return file_friendext_friendext_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetPaginationGroupFriendsResp) GetFriendsInfo() []*sdkws.FriendInfo {
+	if x != nil {
+		return x.FriendsInfo
+	}
+	return nil
+}
+
+func (x *GetPaginationGroupFriendsResp) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type FriendGroupChangedTips struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OwnerUserID string `protobuf:"bytes,1,opt,name=ownerUserID,proto3" json:"ownerUserID"`
+	// groups created, changed, reordered or deleted
+	GroupIDs []string `protobuf:"bytes,2,rep,name=groupIDs,proto3" json:"groupIDs"`
+	// friends whose groups changed
+	FriendUserIDs []string `protobuf:"bytes,3,rep,name=friendUserIDs,proto3" json:"friendUserIDs"`
+}
+
+func (x *FriendGroupChangedTips) Reset() {
+	*x = FriendGroupChangedTips{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_friendext_friendext_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FriendGroupChangedTips) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FriendGroupChangedTips) ProtoMessage() {}
+
+func (x *FriendGroupChangedTips) ProtoReflect() protoreflect.Message {
+	mi := &file_friendext_friendext_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FriendGroupChangedTips.ProtoReflect.Descriptor instead.
+func (*FriendGroupChangedTips) Descriptor() ([]byte, []int) {
+	return file_friendext_friendext_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *FriendGroupChangedTips) GetOwnerUserID() string {
+	if x != nil {
+		return x.OwnerUserID
+	}
+	return ""
+}
+
+func (x *FriendGroupChangedTips) GetGroupIDs() []string {
+	if x != nil {
+		return x.GroupIDs
+	}
+	return nil
+}
+
+func (x *FriendGroupChangedTips) GetFriendUserIDs() []string {
+	if x != nil {
+		return x.FriendUserIDs
+	}
+	return nil
+}
+
+// Who can send friend requests to a user
+type FriendAddSetting struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 0 everyone, 1 friends of friends, 2 nobody, 3 those who answer the question
+	AddPolicy int32  `protobuf:"varint,1,opt,name=addPolicy,proto3" json:"addPolicy"`
+	Question  string `protobuf:"bytes,2,opt,name=question,proto3" json:"question"`
+	// Compared ignoring case and surrounding spaces
+	Answer string `protobuf:"bytes,3,opt,name=answer,proto3" json:"answer"`
+}
+
+func (x *FriendAddSetting) Reset() {
+	*x = FriendAddSetting{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_friendext_friendext_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FriendAddSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FriendAddSetting) ProtoMessage() {}
+
+func (x *FriendAddSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_friendext_friendext_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FriendAddSetting.ProtoReflect.Descriptor instead.
+func (*FriendAddSetting) Descriptor() ([]byte, []int) {
+	return file_friendext_friendext_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *FriendAddSetting) GetAddPolicy() int32 {
+	if x != nil {
+		return x.AddPolicy
+	}
+	return 0
+}
+
+func (x *FriendAddSetting) GetQuestion() string {
+	if x != nil {
+		return x.Question
+	}
+	return ""
+}
+
+func (x *FriendAddSetting) GetAnswer() string {
+	if x != nil {
+		return x.Answer
+	}
+	return ""
+}
+
+type SetFriendAddSettingReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID  string            `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	Setting *FriendAddSetting `protobuf:"bytes,2,opt,name=setting,proto3" json:"setting"`
+}
+
+func (x *SetFriendAddSettingReq) Reset() {
+	*x = SetFriendAddSettingReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_friendext_friendext_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetFriendAddSettingReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetFriendAddSettingReq) ProtoMessage() {}
+
+func (x *SetFriendAddSettingReq) ProtoReflect() protoreflect.Message {
+	mi := &file_friendext_friendext_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetFriendAddSettingReq.ProtoReflect.Descriptor instead.
+func (*SetFriendAddSettingReq) Descriptor() ([]byte, []int) {
+	return file_friendext_friendext_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SetFriendAddSettingReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *SetFriendAddSettingReq) GetSetting() *FriendAddSetting {
+	if x != nil {
+		return x.Setting
+	}
+	return nil
+}
+
+type SetFriendAddSettingResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetFriendAddSettingResp) Reset() {
+	*x = SetFriendAddSettingResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_friendext_friendext_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetFriendAddSettingResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetFriendAddSettingResp) ProtoMessage() {}
+
+func (x *SetFriendAddSettingResp) ProtoReflect() protoreflect.Message {
+	mi := &file_friendext_friendext_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetFriendAddSettingResp.ProtoReflect.Descriptor instead.
+func (*SetFriendAddSettingResp) Descriptor() ([]byte, []int) {
+	return file_friendext_friendext_proto_rawDescGZIP(), []int{25}
+}
+
+type GetFriendAddSettingReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+}
+
+func (x *GetFriendAddSettingReq) Reset() {
+	*x = GetFriendAddSettingReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_friendext_friendext_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFriendAddSettingReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFriendAddSettingReq) ProtoMessage() {}
+
+func (x *GetFriendAddSettingReq) ProtoReflect() protoreflect.Message {
+	mi := &file_friendext_friendext_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFriendAddSettingReq.ProtoReflect.Descriptor instead.
+func (*GetFriendAddSettingReq) Descriptor() ([]byte, []int) {
+	return file_friendext_friendext_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetFriendAddSettingReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+type GetFriendAddSettingResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Setting *FriendAddSetting `protobuf:"bytes,1,opt,name=setting,proto3" json:"setting"`
+}
+
+func (x *GetFriendAddSettingResp) Reset() {
+	*x = GetFriendAddSettingResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_friendext_friendext_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFriendAddSettingResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFriendAddSettingResp) ProtoMessage() {}
+
+func (x *GetFriendAddSettingResp) ProtoReflect() protoreflect.Message {
+	mi := &file_friendext_friendext_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFriendAddSettingResp.ProtoReflect.Descriptor instead.
+func (*GetFriendAddSettingResp) Descriptor() ([]byte, []int) {
+	return file_friendext_friendext_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetFriendAddSettingResp) GetSetting() *FriendAddSetting {
+	if x != nil {
+		return x.Setting
+	}
+	return nil
+}
+
+type GetFriendAddPolicyReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+}
+
+func (x *GetFriendAddPolicyReq) Reset() {
+	*x = GetFriendAddPolicyReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_friendext_friendext_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFriendAddPolicyReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFriendAddPolicyReq) ProtoMessage() {}
+
+func (x *GetFriendAddPolicyReq) ProtoReflect() protoreflect.Message {
+	mi := &file_friendext_friendext_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFriendAddPolicyReq.ProtoReflect.Descriptor instead.
+func (*GetFriendAddPolicyReq) Descriptor() ([]byte, []int) {
+	return file_friendext_friendext_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetFriendAddPolicyReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+type GetFriendAddPolicyResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AddPolicy int32 `protobuf:"varint,1,opt,name=addPolicy,proto3" json:"addPolicy"`
+	// Only set for the question policy
+	Question string `protobuf:"bytes,2,opt,name=question,proto3" json:"question"`
+}
+
+func (x *GetFriendAddPolicyResp) Reset() {
+	*x = GetFriendAddPolicyResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_friendext_friendext_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFriendAddPolicyResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFriendAddPolicyResp) ProtoMessage() {}
+
+func (x *GetFriendAddPolicyResp) ProtoReflect() protoreflect.Message {
+	mi := &file_friendext_friendext_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFriendAddPolicyResp.ProtoReflect.Descriptor instead.
+func (*GetFriendAddPolicyResp) Descriptor() ([]byte, []int) {
+	return file_friendext_friendext_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetFriendAddPolicyResp) GetAddPolicy() int32 {
+	if x != nil {
+		return x.AddPolicy
+	}
+	return 0
+}
+
+func (x *GetFriendAddPolicyResp) GetQuestion() string {
+	if x != nil {
+		return x.Question
+	}
+	return ""
+}
+
+// Same as applyToAddFriend of the friend service, with the answer to the question of the receiver
+type ApplyToAddFriendWithAnswerReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FromUserID string `protobuf:"bytes,1,opt,name=fromUserID,proto3" json:"fromUserID"`
+	ToUserID   string `protobuf:"bytes,2,opt,name=toUserID,proto3" json:"toUserID"`
+	ReqMsg     string `protobuf:"bytes,3,opt,name=reqMsg,proto3" json:"reqMsg"`
+	Ex         string `protobuf:"bytes,4,opt,name=ex,proto3" json:"ex"`
+	Answer     string `protobuf:"bytes,5,opt,name=answer,proto3" json:"answer"`
+}
+
+func (x *ApplyToAddFriendWithAnswerReq) Reset() {
+	*x = ApplyToAddFriendWithAnswerReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_friendext_friendext_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApplyToAddFriendWithAnswerReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyToAddFriendWithAnswerReq) ProtoMessage() {}
+
+func (x *ApplyToAddFriendWithAnswerReq) ProtoReflect() protoreflect.Message {
+	mi := &file_friendext_friendext_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyToAddFriendWithAnswerReq.ProtoReflect.Descriptor instead.
+func (*ApplyToAddFriendWithAnswerReq) Descriptor() ([]byte, []int) {
+	return file_friendext_friendext_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ApplyToAddFriendWithAnswerReq) GetFromUserID() string {
+	if x != nil {
+		return x.FromUserID
+	}
+	return ""
+}
+
+func (x *ApplyToAddFriendWithAnswerReq) GetToUserID() string {
+	if x != nil {
+		return x.ToUserID
+	}
+	return ""
+}
+
+func (x *ApplyToAddFriendWithAnswerReq) GetReqMsg() string {
+	if x != nil {
+		return x.ReqMsg
+	}
+	return ""
+}
+
+func (x *ApplyToAddFriendWithAnswerReq) GetEx() string {
+	if x != nil {
+		return x.Ex
+	}
+	return ""
+}
+
+func (x *ApplyToAddFriendWithAnswerReq) GetAnswer() string {
+	if x != nil {
+		return x.Answer
+	}
+	return ""
+}
+
+type ApplyToAddFriendWithAnswerResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ApplyToAddFriendWithAnswerResp) Reset() {
+	*x = ApplyToAddFriendWithAnswerResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_friendext_friendext_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApplyToAddFriendWithAnswerResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyToAddFriendWithAnswerResp) ProtoMessage() {}
+
+func (x *ApplyToAddFriendWithAnswerResp) ProtoReflect() protoreflect.Message {
+	mi := &file_friendext_friendext_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyToAddFriendWithAnswerResp.ProtoReflect.Descriptor instead.
+func (*ApplyToAddFriendWithAnswerResp) Descriptor() ([]byte, []int) {
+	return file_friendext_friendext_proto_rawDescGZIP(), []int{31}
+}
+
+type ExpireFriendRequestsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Unix milliseconds, pending requests sent before it are refused
+	Before int64 `protobuf:"varint,1,opt,name=before,proto3" json:"before"`
+}
+
+func (x *ExpireFriendRequestsReq) Reset() {
+	*x = ExpireFriendRequestsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_friendext_friendext_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExpireFriendRequestsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpireFriendRequestsReq) ProtoMessage() {}
+
+func (x *ExpireFriendRequestsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_friendext_friendext_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpireFriendRequestsReq.ProtoReflect.Descriptor instead.
+func (*ExpireFriendRequestsReq) Descriptor() ([]byte, []int) {
+	return file_friendext_friendext_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ExpireFriendRequestsReq) GetBefore() int64 {
+	if x != nil {
+		return x.Before
+	}
+	return 0
+}
+
+type ExpireFriendRequestsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count int32 `protobuf:"varint,1,opt,name=count,proto3" json:"count"`
+}
+
+func (x *ExpireFriendRequestsResp) Reset() {
+	*x = ExpireFriendRequestsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_friendext_friendext_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExpireFriendRequestsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpireFriendRequestsResp) ProtoMessage() {}
+
+func (x *ExpireFriendRequestsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_friendext_friendext_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpireFriendRequestsResp.ProtoReflect.Descriptor instead.
+func (*ExpireFriendRequestsResp) Descriptor() ([]byte, []int) {
+	return file_friendext_friendext_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ExpireFriendRequestsResp) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type FriendRequestSender struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID   string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	Sent     int64  `protobuf:"varint,2,opt,name=sent,proto3" json:"sent"`
+	Accepted int64  `protobuf:"varint,3,opt,name=accepted,proto3" json:"accepted"`
+	// Refused by the receivers, expired requests are not counted
+	Refused int64 `protobuf:"varint,4,opt,name=refused,proto3" json:"refused"`
+	Pending int64 `protobuf:"varint,5,opt,name=pending,proto3" json:"pending"`
+}
+
+func (x *FriendRequestSender) Reset() {
+	*x = FriendRequestSender{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_friendext_friendext_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FriendRequestSender) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FriendRequestSender) ProtoMessage() {}
+
+func (x *FriendRequestSender) ProtoReflect() protoreflect.Message {
+	mi := &file_friendext_friendext_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FriendRequestSender.ProtoReflect.Descriptor instead.
+func (*FriendRequestSender) Descriptor() ([]byte, []int) {
+	return file_friendext_friendext_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *FriendRequestSender) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *FriendRequestSender) GetSent() int64 {
+	if x != nil {
+		return x.Sent
+	}
+	return 0
+}
+
+func (x *FriendRequestSender) GetAccepted() int64 {
+	if x != nil {
+		return x.Accepted
+	}
+	return 0
+}
+
+func (x *FriendRequestSender) GetRefused() int64 {
+	if x != nil {
+		return x.Refused
+	}
+	return 0
+}
+
+func (x *FriendRequestSender) GetPending() int64 {
+	if x != nil {
+		return x.Pending
+	}
+	return 0
+}
+
+type GetFriendRequestSendersReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Unix milliseconds, only the requests sent since it are counted
+	Since int64 `protobuf:"varint,1,opt,name=since,proto3" json:"since"`
+	Limit int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit"`
+}
+
+func (x *GetFriendRequestSendersReq) Reset() {
+	*x = GetFriendRequestSendersReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_friendext_friendext_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFriendRequestSendersReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFriendRequestSendersReq) ProtoMessage() {}
+
+func (x *GetFriendRequestSendersReq) ProtoReflect() protoreflect.Message {
+	mi := &file_friendext_friendext_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFriendRequestSendersReq.ProtoReflect.Descriptor instead.
+func (*GetFriendRequestSendersReq) Descriptor() ([]byte, []int) {
+	return file_friendext_friendext_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetFriendRequestSendersReq) GetSince() int64 {
+	if x != nil {
+		return x.Since
+	}
+	return 0
+}
+
+func (x *GetFriendRequestSendersReq) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetFriendRequestSendersResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The senders with the most requests first
+	Senders []*FriendRequestSender `protobuf:"bytes,1,rep,name=senders,proto3" json:"senders"`
+}
+
+func (x *GetFriendRequestSendersResp) Reset() {
+	*x = GetFriendRequestSendersResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_friendext_friendext_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFriendRequestSendersResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFriendRequestSendersResp) ProtoMessage() {}
+
+func (x *GetFriendRequestSendersResp) ProtoReflect() protoreflect.Message {
+	mi := &file_friendext_friendext_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFriendRequestSendersResp.ProtoReflect.Descriptor instead.
+func (*GetFriendRequestSendersResp) Descriptor() ([]byte, []int) {
+	return file_friendext_friendext_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetFriendRequestSendersResp) GetSenders() []*FriendRequestSender {
+	if x != nil {
+		return x.Senders
+	}
+	return nil
+}
+
+// Contact discovery matches address books with the users who opted in. Identifiers are hashed by the clients as the
+// lowercase hex SHA-256 of the salt followed by the phone number in E.164 format or the lowercase email.
+type GetContactDiscoverySaltReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetContactDiscoverySaltReq) Reset() {
+	*x = GetContactDiscoverySaltReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_friendext_friendext_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetContactDiscoverySaltReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetContactDiscoverySaltReq) ProtoMessage() {}
+
+func (x *GetContactDiscoverySaltReq) ProtoReflect() protoreflect.Message {
+	mi := &file_friendext_friendext_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetContactDiscoverySaltReq.ProtoReflect.Descriptor instead.
+func (*GetContactDiscoverySaltReq) Descriptor() ([]byte, []int) {
+	return file_friendext_friendext_proto_rawDescGZIP(), []int{37}
+}
+
+type GetContactDiscoverySaltResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Salt string `protobuf:"bytes,1,opt,name=salt,proto3" json:"salt"`
+}
+
+func (x *GetContactDiscoverySaltResp) Reset() {
+	*x = GetContactDiscoverySaltResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_friendext_friendext_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetContactDiscoverySaltResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetContactDiscoverySaltResp) ProtoMessage() {}
+
+func (x *GetContactDiscoverySaltResp) ProtoReflect() protoreflect.Message {
+	mi := &file_friendext_friendext_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetContactDiscoverySaltResp.ProtoReflect.Descriptor instead.
+func (*GetContactDiscoverySaltResp) Descriptor() ([]byte, []int) {
+	return file_friendext_friendext_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetContactDiscoverySaltResp) GetSalt() string {
+	if x != nil {
+		return x.Salt
+	}
+	return ""
+}
+
+type SetContactHashesReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	// 1 phone, 2 email
+	Type int32 `protobuf:"varint,2,opt,name=type,proto3" json:"type"`
+	// Replace the hashes of the user of the type, empty to clear them
+	Hashes []string `protobuf:"bytes,3,rep,name=hashes,proto3" json:"hashes"`
+}
+
+func (x *SetContactHashesReq) Reset() {
+	*x = SetContactHashesReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_friendext_friendext_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetContactHashesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetContactHashesReq) ProtoMessage() {}
+
+func (x *SetContactHashesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_friendext_friendext_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetContactHashesReq.ProtoReflect.Descriptor instead.
+func (*SetContactHashesReq) Descriptor() ([]byte, []int) {
+	return file_friendext_friendext_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *SetContactHashesReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *SetContactHashesReq) GetType() int32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *SetContactHashesReq) GetHashes() []string {
+	if x != nil {
+		return x.Hashes
+	}
+	return nil
+}
+
+type SetContactHashesResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetContactHashesResp) Reset() {
+	*x = SetContactHashesResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_friendext_friendext_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetContactHashesResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetContactHashesResp) ProtoMessage() {}
+
+func (x *SetContactHashesResp) ProtoReflect() protoreflect.Message {
+	mi := &file_friendext_friendext_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetContactHashesResp.ProtoReflect.Descriptor instead.
+func (*SetContactHashesResp) Descriptor() ([]byte, []int) {
+	return file_friendext_friendext_proto_rawDescGZIP(), []int{40}
+}
+
+type ContactDiscoverySetting struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ByPhone bool `protobuf:"varint,1,opt,name=byPhone,proto3" json:"byPhone"`
+	ByEmail bool `protobuf:"varint,2,opt,name=byEmail,proto3" json:"byEmail"`
+}
+
+func (x *ContactDiscoverySetting) Reset() {
+	*x = ContactDiscoverySetting{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_friendext_friendext_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContactDiscoverySetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContactDiscoverySetting) ProtoMessage() {}
+
+func (x *ContactDiscoverySetting) ProtoReflect() protoreflect.Message {
+	mi := &file_friendext_friendext_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContactDiscoverySetting.ProtoReflect.Descriptor instead.
+func (*ContactDiscoverySetting) Descriptor() ([]byte, []int) {
+	return file_friendext_friendext_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ContactDiscoverySetting) GetByPhone() bool {
+	if x != nil {
+		return x.ByPhone
+	}
+	return false
+}
+
+func (x *ContactDiscoverySetting) GetByEmail() bool {
+	if x != nil {
+		return x.ByEmail
+	}
+	return false
+}
+
+type SetContactDiscoverySettingReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID  string                   `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	Setting *ContactDiscoverySetting `protobuf:"bytes,2,opt,name=setting,proto3" json:"setting"`
+}
+
+func (x *SetContactDiscoverySettingReq) Reset() {
+	*x = SetContactDiscoverySettingReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_friendext_friendext_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetContactDiscoverySettingReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetContactDiscoverySettingReq) ProtoMessage() {}
+
+func (x *SetContactDiscoverySettingReq) ProtoReflect() protoreflect.Message {
+	mi := &file_friendext_friendext_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetContactDiscoverySettingReq.ProtoReflect.Descriptor instead.
+func (*SetContactDiscoverySettingReq) Descriptor() ([]byte, []int) {
+	return file_friendext_friendext_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *SetContactDiscoverySettingReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *SetContactDiscoverySettingReq) GetSetting() *ContactDiscoverySetting {
+	if x != nil {
+		return x.Setting
+	}
+	return nil
+}
+
+type SetContactDiscoverySettingResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetContactDiscoverySettingResp) Reset() {
+	*x = SetContactDiscoverySettingResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_friendext_friendext_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetContactDiscoverySettingResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetContactDiscoverySettingResp) ProtoMessage() {}
+
+func (x *SetContactDiscoverySettingResp) ProtoReflect() protoreflect.Message {
+	mi := &file_friendext_friendext_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetContactDiscoverySettingResp.ProtoReflect.Descriptor instead.
+func (*SetContactDiscoverySettingResp) Descriptor() ([]byte, []int) {
+	return file_friendext_friendext_proto_rawDescGZIP(), []int{43}
+}
+
+type GetContactDiscoverySettingReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+}
+
+func (x *GetContactDiscoverySettingReq) Reset() {
+	*x = GetContactDiscoverySettingReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_friendext_friendext_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetContactDiscoverySettingReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetContactDiscoverySettingReq) ProtoMessage() {}
+
+func (x *GetContactDiscoverySettingReq) ProtoReflect() protoreflect.Message {
+	mi := &file_friendext_friendext_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetContactDiscoverySettingReq.ProtoReflect.Descriptor instead.
+func (*GetContactDiscoverySettingReq) Descriptor() ([]byte, []int) {
+	return file_friendext_friendext_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GetContactDiscoverySettingReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+type GetContactDiscoverySettingResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Setting *ContactDiscoverySetting `protobuf:"bytes,1,opt,name=setting,proto3" json:"setting"`
+}
+
+func (x *GetContactDiscoverySettingResp) Reset() {
+	*x = GetContactDiscoverySettingResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_friendext_friendext_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetContactDiscoverySettingResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetContactDiscoverySettingResp) ProtoMessage() {}
+
+func (x *GetContactDiscoverySettingResp) ProtoReflect() protoreflect.Message {
+	mi := &file_friendext_friendext_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetContactDiscoverySettingResp.ProtoReflect.Descriptor instead.
+func (*GetContactDiscoverySettingResp) Descriptor() ([]byte, []int) {
+	return file_friendext_friendext_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GetContactDiscoverySettingResp) GetSetting() *ContactDiscoverySetting {
+	if x != nil {
+		return x.Setting
+	}
+	return nil
+}
+
+type ContactMatch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hash   string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash"`
+	UserID string `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID"`
+}
+
+func (x *ContactMatch) Reset() {
+	*x = ContactMatch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_friendext_friendext_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContactMatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContactMatch) ProtoMessage() {}
+
+func (x *ContactMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_friendext_friendext_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContactMatch.ProtoReflect.Descriptor instead.
+func (*ContactMatch) Descriptor() ([]byte, []int) {
+	return file_friendext_friendext_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ContactMatch) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *ContactMatch) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+type DiscoverContactsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	// Hashed address book, not stored by the server
+	Hashes []string `protobuf:"bytes,2,rep,name=hashes,proto3" json:"hashes"`
+}
+
+func (x *DiscoverContactsReq) Reset() {
+	*x = DiscoverContactsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_friendext_friendext_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiscoverContactsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiscoverContactsReq) ProtoMessage() {}
+
+func (x *DiscoverContactsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_friendext_friendext_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiscoverContactsReq.ProtoReflect.Descriptor instead.
+func (*DiscoverContactsReq) Descriptor() ([]byte, []int) {
+	return file_friendext_friendext_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *DiscoverContactsReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *DiscoverContactsReq) GetHashes() []string {
+	if x != nil {
+		return x.Hashes
+	}
+	return nil
+}
+
+type DiscoverContactsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Matches []*ContactMatch `protobuf:"bytes,1,rep,name=matches,proto3" json:"matches"`
+}
+
+func (x *DiscoverContactsResp) Reset() {
+	*x = DiscoverContactsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_friendext_friendext_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiscoverContactsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiscoverContactsResp) ProtoMessage() {}
+
+func (x *DiscoverContactsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_friendext_friendext_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiscoverContactsResp.ProtoReflect.Descriptor instead.
+func (*DiscoverContactsResp) Descriptor() ([]byte, []int) {
+	return file_friendext_friendext_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *DiscoverContactsResp) GetMatches() []*ContactMatch {
+	if x != nil {
+		return x.Matches
+	}
+	return nil
+}
+
+// A user who may be known, by the mutual friends and the shared groups
+type FriendSuggestion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	User          *sdkws.PublicUserInfo `protobuf:"bytes,1,opt,name=user,proto3" json:"user"`
+	MutualFriends int64                 `protobuf:"varint,2,opt,name=mutualFriends,proto3" json:"mutualFriends"`
+	SharedGroups  int64                 `protobuf:"varint,3,opt,name=sharedGroups,proto3" json:"sharedGroups"`
+}
+
+func (x *FriendSuggestion) Reset() {
+	*x = FriendSuggestion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_friendext_friendext_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FriendSuggestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FriendSuggestion) ProtoMessage() {}
+
+func (x *FriendSuggestion) ProtoReflect() protoreflect.Message {
+	mi := &file_friendext_friendext_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FriendSuggestion.ProtoReflect.Descriptor instead.
+func (*FriendSuggestion) Descriptor() ([]byte, []int) {
+	return file_friendext_friendext_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *FriendSuggestion) GetUser() *sdkws.PublicUserInfo {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *FriendSuggestion) GetMutualFriends() int64 {
+	if x != nil {
+		return x.MutualFriends
+	}
+	return 0
+}
+
+func (x *FriendSuggestion) GetSharedGroups() int64 {
+	if x != nil {
+		return x.SharedGroups
+	}
+	return 0
+}
+
+type GetFriendSuggestionsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID     string                   `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	Pagination *sdkws.RequestPagination `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination"`
+}
+
+func (x *GetFriendSuggestionsReq) Reset() {
+	*x = GetFriendSuggestionsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_friendext_friendext_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFriendSuggestionsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFriendSuggestionsReq) ProtoMessage() {}
+
+func (x *GetFriendSuggestionsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_friendext_friendext_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFriendSuggestionsReq.ProtoReflect.Descriptor instead.
+func (*GetFriendSuggestionsReq) Descriptor() ([]byte, []int) {
+	return file_friendext_friendext_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *GetFriendSuggestionsReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *GetFriendSuggestionsReq) GetPagination() *sdkws.RequestPagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type GetFriendSuggestionsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Suggestions []*FriendSuggestion `protobuf:"bytes,1,rep,name=suggestions,proto3" json:"suggestions"`
+	Total       int64               `protobuf:"varint,2,opt,name=total,proto3" json:"total"`
+	// True when the suggestions are being computed, they are available after the next run of the cron task
+	Computing bool `protobuf:"varint,3,opt,name=computing,proto3" json:"computing"`
+}
+
+func (x *GetFriendSuggestionsResp) Reset() {
+	*x = GetFriendSuggestionsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_friendext_friendext_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFriendSuggestionsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFriendSuggestionsResp) ProtoMessage() {}
+
+func (x *GetFriendSuggestionsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_friendext_friendext_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFriendSuggestionsResp.ProtoReflect.Descriptor instead.
+func (*GetFriendSuggestionsResp) Descriptor() ([]byte, []int) {
+	return file_friendext_friendext_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *GetFriendSuggestionsResp) GetSuggestions() []*FriendSuggestion {
+	if x != nil {
+		return x.Suggestions
+	}
+	return nil
+}
+
+func (x *GetFriendSuggestionsResp) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetFriendSuggestionsResp) GetComputing() bool {
+	if x != nil {
+		return x.Computing
+	}
+	return false
+}
+
+type DismissFriendSuggestionReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID          string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	SuggestedUserID string `protobuf:"bytes,2,opt,name=suggestedUserID,proto3" json:"suggestedUserID"`
+}
+
+func (x *DismissFriendSuggestionReq) Reset() {
+	*x = DismissFriendSuggestionReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_friendext_friendext_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DismissFriendSuggestionReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DismissFriendSuggestionReq) ProtoMessage() {}
+
+func (x *DismissFriendSuggestionReq) ProtoReflect() protoreflect.Message {
+	mi := &file_friendext_friendext_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DismissFriendSuggestionReq.ProtoReflect.Descriptor instead.
+func (*DismissFriendSuggestionReq) Descriptor() ([]byte, []int) {
+	return file_friendext_friendext_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *DismissFriendSuggestionReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *DismissFriendSuggestionReq) GetSuggestedUserID() string {
+	if x != nil {
+		return x.SuggestedUserID
+	}
+	return ""
+}
+
+type DismissFriendSuggestionResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DismissFriendSuggestionResp) Reset() {
+	*x = DismissFriendSuggestionResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_friendext_friendext_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DismissFriendSuggestionResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DismissFriendSuggestionResp) ProtoMessage() {}
+
+func (x *DismissFriendSuggestionResp) ProtoReflect() protoreflect.Message {
+	mi := &file_friendext_friendext_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DismissFriendSuggestionResp.ProtoReflect.Descriptor instead.
+func (*DismissFriendSuggestionResp) Descriptor() ([]byte, []int) {
+	return file_friendext_friendext_proto_rawDescGZIP(), []int{53}
+}
+
+type RefreshFriendSuggestionsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RefreshFriendSuggestionsReq) Reset() {
+	*x = RefreshFriendSuggestionsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_friendext_friendext_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RefreshFriendSuggestionsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshFriendSuggestionsReq) ProtoMessage() {}
+
+func (x *RefreshFriendSuggestionsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_friendext_friendext_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshFriendSuggestionsReq.ProtoReflect.Descriptor instead.
+func (*RefreshFriendSuggestionsReq) Descriptor() ([]byte, []int) {
+	return file_friendext_friendext_proto_rawDescGZIP(), []int{54}
+}
+
+type RefreshFriendSuggestionsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count int32 `protobuf:"varint,1,opt,name=count,proto3" json:"count"`
+}
+
+func (x *RefreshFriendSuggestionsResp) Reset() {
+	*x = RefreshFriendSuggestionsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_friendext_friendext_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RefreshFriendSuggestionsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshFriendSuggestionsResp) ProtoMessage() {}
+
+func (x *RefreshFriendSuggestionsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_friendext_friendext_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshFriendSuggestionsResp.ProtoReflect.Descriptor instead.
+func (*RefreshFriendSuggestionsResp) Descriptor() ([]byte, []int) {
+	return file_friendext_friendext_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *RefreshFriendSuggestionsResp) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type PurgeUserRelationsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+}
+
+func (x *PurgeUserRelationsReq) Reset() {
+	*x = PurgeUserRelationsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_friendext_friendext_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PurgeUserRelationsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeUserRelationsReq) ProtoMessage() {}
+
+func (x *PurgeUserRelationsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_friendext_friendext_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeUserRelationsReq.ProtoReflect.Descriptor instead.
+func (*PurgeUserRelationsReq) Descriptor() ([]byte, []int) {
+	return file_friendext_friendext_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *PurgeUserRelationsReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+type PurgeUserRelationsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *PurgeUserRelationsResp) Reset() {
+	*x = PurgeUserRelationsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_friendext_friendext_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PurgeUserRelationsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeUserRelationsResp) ProtoMessage() {}
+
+func (x *PurgeUserRelationsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_friendext_friendext_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeUserRelationsResp.ProtoReflect.Descriptor instead.
+func (*PurgeUserRelationsResp) Descriptor() ([]byte, []int) {
+	return file_friendext_friendext_proto_rawDescGZIP(), []int{57}
+}
+
+var File_friendext_friendext_proto protoreflect.FileDescriptor
+
+var file_friendext_friendext_proto_rawDesc = []byte{
+	0x0a, 0x19, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x65, 0x78, 0x74, 0x2f, 0x66, 0x72, 0x69, 0x65,
+	0x6e, 0x64, 0x65, 0x78, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x10, 0x6f, 0x70, 0x65,
+	0x6e, 0x69, 0x6d, 0x2e, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x65, 0x78, 0x74, 0x1a, 0x11, 0x73,
+	0x64, 0x6b, 0x77, 0x73, 0x2f, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0x81, 0x01, 0x0a, 0x0b, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x65, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x65, 0x78, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69,
+	0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x54, 0x69, 0x6d, 0x65, 0x22, 0x5c, 0x0a, 0x14, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x72,
+	0x69, 0x65, 0x6e, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x12, 0x20, 0x0a, 0x0b,
+	0x6f, 0x77, 0x6e, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x65, 0x78, 0x22, 0x4c, 0x0a, 0x15, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x72, 0x69, 0x65,
+	0x6e, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x12, 0x33, 0x0a, 0x05, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6f, 0x70, 0x65,
+	0x6e, 0x69, 0x6d, 0x2e, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x65, 0x78, 0x74, 0x2e, 0x66, 0x72,
+	0x69, 0x65, 0x6e, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x22, 0x73, 0x0a, 0x11, 0x73, 0x65, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x52, 0x65, 0x71, 0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x55, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x77, 0x6e, 0x65,
+	0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49,
+	0x44, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x65, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x65, 0x78, 0x22, 0x14, 0x0a, 0x12, 0x73, 0x65, 0x74, 0x46, 0x72, 0x69, 0x65,
+	0x6e, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x22, 0x52, 0x0a, 0x14, 0x64,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x52, 0x65, 0x71, 0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x22,
+	0x17, 0x0a, 0x15, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x22, 0x53, 0x0a, 0x13, 0x73, 0x6f, 0x72, 0x74,
+	0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x71, 0x12,
+	0x20, 0x0a, 0x0b, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x12, 0x1a, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x73, 0x22, 0x16, 0x0a,
+	0x14, 0x73, 0x6f, 0x72, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x36, 0x0a, 0x12, 0x67, 0x65, 0x74, 0x46, 0x72, 0x69, 0x65,
+	0x6e, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x71, 0x12, 0x20, 0x0a, 0x0b, 0x6f,
+	0x77, 0x6e, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x4c, 0x0a,
+	0x13, 0x67, 0x65, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x35, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x66, 0x72,
+	0x69, 0x65, 0x6e, 0x64, 0x65, 0x78, 0x74, 0x2e, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x22, 0x78, 0x0a, 0x14, 0x61,
+	0x64, 0x64, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x73, 0x54, 0x6f, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x52, 0x65, 0x71, 0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x12,
+	0x24, 0x0a, 0x0d, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x55, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x73, 0x22, 0x17, 0x0a, 0x15, 0x61, 0x64, 0x64, 0x46, 0x72, 0x69, 0x65,
+	0x6e, 0x64, 0x73, 0x54, 0x6f, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x22, 0x7d,
+	0x0a, 0x19, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x73, 0x46,
+	0x72, 0x6f, 0x6d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x12, 0x20, 0x0a, 0x0b, 0x6f,
+	0x77, 0x6e, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x18, 0x0a,
+	0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x12, 0x24, 0x0a, 0x0d, 0x66, 0x72, 0x69, 0x65, 0x6e,
+	0x64, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d,
+	0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x22, 0x1c, 0x0a,
+	0x1a, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x73, 0x46, 0x72,
+	0x6f, 0x6d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x22, 0x9f, 0x01, 0x0a, 0x15,
+	0x6d, 0x6f, 0x76, 0x65, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x73, 0x54, 0x6f, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x52, 0x65, 0x71, 0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x55, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x77, 0x6e, 0x65,
+	0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x24, 0x0a, 0x0d, 0x66, 0x72, 0x69, 0x65, 0x6e,
+	0x64, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d,
+	0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x12, 0x20, 0x0a,
+	0x0b, 0x66, 0x72, 0x6f, 0x6d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x66, 0x72, 0x6f, 0x6d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x12,
+	0x1c, 0x0a, 0x09, 0x74, 0x6f, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x74, 0x6f, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x22, 0x18, 0x0a,
+	0x16, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x73, 0x54, 0x6f, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x22, 0x50, 0x0a, 0x0e, 0x66, 0x72, 0x69, 0x65, 0x6e,
+	0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x66, 0x72, 0x69,
+	0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a,
+	0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x73, 0x22, 0x5f, 0x0a, 0x15, 0x67, 0x65, 0x74,
+	0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x73, 0x52,
+	0x65, 0x71, 0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x55, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x12, 0x24, 0x0a, 0x0d, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x55, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x66, 0x72, 0x69,
+	0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x22, 0x54, 0x0a, 0x16, 0x67, 0x65,
+	0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x3a, 0x0a, 0x07, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x66,
+	0x72, 0x69, 0x65, 0x6e, 0x64, 0x65, 0x78, 0x74, 0x2e, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x73, 0x52, 0x07, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x73,
+	0x22, 0x91, 0x01, 0x0a, 0x1c, 0x67, 0x65, 0x74, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x73, 0x52, 0x65,
+	0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x49, 0x44, 0x12, 0x3f, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d,
+	0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61,
+	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x71, 0x0a, 0x1d, 0x67, 0x65, 0x74, 0x50, 0x61, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x3a, 0x0a, 0x0b, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x73,
+	0x49, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6f, 0x70, 0x65,
+	0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x73, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x7c, 0x0a, 0x16, 0x66, 0x72, 0x69, 0x65, 0x6e,
+	0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x54, 0x69, 0x70,
+	0x73, 0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x73, 0x12,
+	0x24, 0x0a, 0x0d, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x55, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x73, 0x22, 0x64, 0x0a, 0x10, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x41,
+	0x64, 0x64, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x64, 0x64,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x61, 0x64,
+	0x64, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x22, 0x6e, 0x0a, 0x16, 0x73,
+	0x65, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x41, 0x64, 0x64, 0x53, 0x65, 0x74, 0x74, 0x69,
+	0x6e, 0x67, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x3c, 0x0a,
+	0x07, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22,
+	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x65, 0x78,
+	0x74, 0x2e, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x41, 0x64, 0x64, 0x53, 0x65, 0x74, 0x74, 0x69,
+	0x6e, 0x67, 0x52, 0x07, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x22, 0x19, 0x0a, 0x17, 0x73,
+	0x65, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x41, 0x64, 0x64, 0x53, 0x65, 0x74, 0x74, 0x69,
+	0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x22, 0x30, 0x0a, 0x16, 0x67, 0x65, 0x74, 0x46, 0x72, 0x69,
+	0x65, 0x6e, 0x64, 0x41, 0x64, 0x64, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71,
+	0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x57, 0x0a, 0x17, 0x67, 0x65, 0x74, 0x46,
+	0x72, 0x69, 0x65, 0x6e, 0x64, 0x41, 0x64, 0x64, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x3c, 0x0a, 0x07, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x66, 0x72,
+	0x69, 0x65, 0x6e, 0x64, 0x65, 0x78, 0x74, 0x2e, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x41, 0x64,
+	0x64, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e,
+	0x67, 0x22, 0x2f, 0x0a, 0x15, 0x67, 0x65, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x41, 0x64,
+	0x64, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x22, 0x52, 0x0a, 0x16, 0x67, 0x65, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x41,
+	0x64, 0x64, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x12, 0x1c, 0x0a, 0x09,
+	0x61, 0x64, 0x64, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x09, 0x61, 0x64, 0x64, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x9b, 0x01, 0x0a, 0x1d, 0x61, 0x70, 0x70, 0x6c, 0x79,
+	0x54, 0x6f, 0x41, 0x64, 0x64, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x57, 0x69, 0x74, 0x68, 0x41,
+	0x6e, 0x73, 0x77, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x72, 0x6f, 0x6d,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x72,
+	0x6f, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x6f, 0x55, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x6f, 0x55, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x71, 0x4d, 0x73, 0x67, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x71, 0x4d, 0x73, 0x67, 0x12, 0x0e, 0x0a, 0x02,
+	0x65, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x65, 0x78, 0x12, 0x16, 0x0a, 0x06,
+	0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6e,
+	0x73, 0x77, 0x65, 0x72, 0x22, 0x20, 0x0a, 0x1e, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x54, 0x6f, 0x41,
+	0x64, 0x64, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x57, 0x69, 0x74, 0x68, 0x41, 0x6e, 0x73, 0x77,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x22, 0x31, 0x0a, 0x17, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x22, 0x30, 0x0a, 0x18, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x91, 0x01, 0x0a, 0x13,
+	0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x65, 0x6e,
+	0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x73,
+	0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x65, 0x6e, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x08, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x72,
+	0x65, 0x66, 0x75, 0x73, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x72, 0x65,
+	0x66, 0x75, 0x73, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x22,
+	0x48, 0x0a, 0x1a, 0x67, 0x65, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a,
+	0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x69,
+	0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x5e, 0x0a, 0x1b, 0x67, 0x65, 0x74,
+	0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x65, 0x6e,
+	0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x3f, 0x0a, 0x07, 0x73, 0x65, 0x6e, 0x64,
+	0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x69, 0x6d, 0x2e, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x65, 0x78, 0x74, 0x2e, 0x66, 0x72, 0x69,
+	0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x65, 0x6e, 0x64, 0x65, 0x72,
+	0x52, 0x07, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x73, 0x22, 0x1c, 0x0a, 0x1a, 0x67, 0x65, 0x74,
+	0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79,
+	0x53, 0x61, 0x6c, 0x74, 0x52, 0x65, 0x71, 0x22, 0x31, 0x0a, 0x1b, 0x67, 0x65, 0x74, 0x43, 0x6f,
+	0x6e, 0x74, 0x61, 0x63, 0x74, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x53, 0x61,
+	0x6c, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x61, 0x6c, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x61, 0x6c, 0x74, 0x22, 0x59, 0x0a, 0x13, 0x73, 0x65,
+	0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x68,
+	0x61, 0x73, 0x68, 0x65, 0x73, 0x22, 0x16, 0x0a, 0x14, 0x73, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74,
+	0x61, 0x63, 0x74, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x4d, 0x0a,
+	0x17, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72,
+	0x79, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x79, 0x50, 0x68,
+	0x6f, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x62, 0x79, 0x50, 0x68, 0x6f,
+	0x6e, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x79, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x62, 0x79, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0x7c, 0x0a, 0x1d,
+	0x73, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76,
+	0x65, 0x72, 0x79, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x43, 0x0a, 0x07, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
+	0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x65, 0x78, 0x74, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63,
+	0x74, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e,
+	0x67, 0x52, 0x07, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x22, 0x20, 0x0a, 0x1e, 0x73, 0x65,
+	0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72,
+	0x79, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x22, 0x37, 0x0a, 0x1d,
+	0x67, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76,
+	0x65, 0x72, 0x79, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x65, 0x0a, 0x1e, 0x67, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74,
+	0x61, 0x63, 0x74, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x53, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x43, 0x0a, 0x07, 0x73, 0x65, 0x74, 0x74, 0x69,
+	0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69,
+	0x6d, 0x2e, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x65, 0x78, 0x74, 0x2e, 0x63, 0x6f, 0x6e, 0x74,
+	0x61, 0x63, 0x74, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x53, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x52, 0x07, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x22, 0x3a, 0x0a, 0x0c,
+	0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x12, 0x0a, 0x04,
+	0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68,
+	0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x45, 0x0a, 0x13, 0x64, 0x69, 0x73, 0x63,
+	0x6f, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x12,
+	0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x61, 0x73, 0x68, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x22,
+	0x50, 0x0a, 0x14, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x61,
+	0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x38, 0x0a, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69,
+	0x6d, 0x2e, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x65, 0x78, 0x74, 0x2e, 0x63, 0x6f, 0x6e, 0x74,
+	0x61, 0x63, 0x74, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65,
+	0x73, 0x22, 0x8e, 0x01, 0x0a, 0x10, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x53, 0x75, 0x67, 0x67,
+	0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x64,
+	0x6b, 0x77, 0x73, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x24, 0x0a, 0x0d, 0x6d, 0x75, 0x74, 0x75,
+	0x61, 0x6c, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0d, 0x6d, 0x75, 0x74, 0x75, 0x61, 0x6c, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x73, 0x12, 0x22,
+	0x0a, 0x0c, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x73, 0x22, 0x72, 0x0a, 0x17, 0x67, 0x65, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x53,
+	0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x3f, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x69, 0x6d, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x94, 0x01, 0x0a, 0x18, 0x67, 0x65, 0x74, 0x46, 0x72,
+	0x69, 0x65, 0x6e, 0x64, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x44, 0x0a, 0x0b, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69,
+	0x6d, 0x2e, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x65, 0x78, 0x74, 0x2e, 0x66, 0x72, 0x69, 0x65,
+	0x6e, 0x64, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x73, 0x75,
+	0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12,
+	0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x22, 0x5e, 0x0a,
+	0x1a, 0x64, 0x69, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x53, 0x75,
+	0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x12, 0x28, 0x0a, 0x0f, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x65, 0x64,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x73, 0x75,
+	0x67, 0x67, 0x65, 0x73, 0x74, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x1d, 0x0a,
+	0x1b, 0x64, 0x69, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x53, 0x75,
+	0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x22, 0x1d, 0x0a, 0x1b,
+	0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x53, 0x75, 0x67,
+	0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x22, 0x34, 0x0a, 0x1c, 0x72,
+	0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x53, 0x75, 0x67, 0x67,
+	0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x22, 0x2f, 0x0a, 0x15, 0x70, 0x75, 0x72, 0x67, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x22, 0x18, 0x0a, 0x16, 0x70, 0x75, 0x72, 0x67, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x32, 0xd6, 0x15, 0x0a,
+	0x09, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x45, 0x78, 0x74, 0x12, 0x64, 0x0a, 0x11, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12,
+	0x26, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x65,
+	0x78, 0x74, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x1a, 0x27, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d,
+	0x2e, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x65, 0x78, 0x74, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x5b, 0x0a, 0x0e, 0x73, 0x65, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x12, 0x23, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x66, 0x72, 0x69, 0x65,
+	0x6e, 0x64, 0x65, 0x78, 0x74, 0x2e, 0x73, 0x65, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x1a, 0x24, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d,
+	0x2e, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x65, 0x78, 0x74, 0x2e, 0x73, 0x65, 0x74, 0x46, 0x72,
+	0x69, 0x65, 0x6e, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x12, 0x64, 0x0a,
+	0x11, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x12, 0x26, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x66, 0x72, 0x69, 0x65,
+	0x6e, 0x64, 0x65, 0x78, 0x74, 0x2e, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x72, 0x69, 0x65,
+	0x6e, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x1a, 0x27, 0x2e, 0x6f, 0x70, 0x65,
+	0x6e, 0x69, 0x6d, 0x2e, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x65, 0x78, 0x74, 0x2e, 0x64, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x61, 0x0a, 0x10, 0x73, 0x6f, 0x72, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e,
+	0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x25, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d,
+	0x2e, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x65, 0x78, 0x74, 0x2e, 0x73, 0x6f, 0x72, 0x74, 0x46,
+	0x72, 0x69, 0x65, 0x6e, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x26,
+	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x65, 0x78,
+	0x74, 0x2e, 0x73, 0x6f, 0x72, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5e, 0x0a, 0x0f, 0x67, 0x65, 0x74, 0x46, 0x72, 0x69,
+	0x65, 0x6e, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x24, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x69, 0x6d, 0x2e, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x65, 0x78, 0x74, 0x2e, 0x67, 0x65, 0x74,
+	0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x71, 0x1a,
+	0x25, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x65,
+	0x78, 0x74, 0x2e, 0x67, 0x65, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x64, 0x0a, 0x11, 0x61, 0x64, 0x64, 0x46, 0x72, 0x69,
+	0x65, 0x6e, 0x64, 0x73, 0x54, 0x6f, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x26, 0x2e, 0x6f, 0x70,
+	0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x65, 0x78, 0x74, 0x2e, 0x61,
+	0x64, 0x64, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x73, 0x54, 0x6f, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x52, 0x65, 0x71, 0x1a, 0x27, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x66, 0x72, 0x69,
+	0x65, 0x6e, 0x64, 0x65, 0x78, 0x74, 0x2e, 0x61, 0x64, 0x64, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64,
+	0x73, 0x54, 0x6f, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x12, 0x73, 0x0a, 0x16,
+	0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x73, 0x46, 0x72, 0x6f,
+	0x6d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x2b, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
+	0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x65, 0x78, 0x74, 0x2e, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x73, 0x46, 0x72, 0x6f, 0x6d, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x52, 0x65, 0x71, 0x1a, 0x2c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x66, 0x72, 0x69,
+	0x65, 0x6e, 0x64, 0x65, 0x78, 0x74, 0x2e, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x72, 0x69,
+	0x65, 0x6e, 0x64, 0x73, 0x46, 0x72, 0x6f, 0x6d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x67, 0x0a, 0x12, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x73,
+	0x54, 0x6f, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x27, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d,
+	0x2e, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x65, 0x78, 0x74, 0x2e, 0x6d, 0x6f, 0x76, 0x65, 0x46,
+	0x72, 0x69, 0x65, 0x6e, 0x64, 0x73, 0x54, 0x6f, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71,
+	0x1a, 0x28, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64,
+	0x65, 0x78, 0x74, 0x2e, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x73, 0x54,
+	0x6f, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x12, 0x67, 0x0a, 0x12, 0x67, 0x65,
+	0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x73,
+	0x12, 0x27, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64,
+	0x65, 0x78, 0x74, 0x2e, 0x67, 0x65, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x73, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x49, 0x44, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x28, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x69, 0x6d, 0x2e, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x65, 0x78, 0x74, 0x2e, 0x67, 0x65, 0x74,
+	0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x7c, 0x0a, 0x19, 0x67, 0x65, 0x74, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x73,
+	0x12, 0x2e, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64,
+	0x65, 0x78, 0x74, 0x2e, 0x67, 0x65, 0x74, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x71,
+	0x1a, 0x2f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64,
+	0x65, 0x78, 0x74, 0x2e, 0x67, 0x65, 0x74, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x6a, 0x0a, 0x13, 0x73, 0x65, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x41, 0x64,
+	0x64, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x28, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69,
+	0x6d, 0x2e, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x65, 0x78, 0x74, 0x2e, 0x73, 0x65, 0x74, 0x46,
+	0x72, 0x69, 0x65, 0x6e, 0x64, 0x41, 0x64, 0x64, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x52,
+	0x65, 0x71, 0x1a, 0x29, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x66, 0x72, 0x69, 0x65,
+	0x6e, 0x64, 0x65, 0x78, 0x74, 0x2e, 0x73, 0x65, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x41,
+	0x64, 0x64, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x6a, 0x0a,
+	0x13, 0x67, 0x65, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x41, 0x64, 0x64, 0x53, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x12, 0x28, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x66, 0x72,
+	0x69, 0x65, 0x6e, 0x64, 0x65, 0x78, 0x74, 0x2e, 0x67, 0x65, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e,
+	0x64, 0x41, 0x64, 0x64, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x29,
+	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x65, 0x78,
+	0x74, 0x2e, 0x67, 0x65, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x41, 0x64, 0x64, 0x53, 0x65,
+	0x74, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x67, 0x0a, 0x12, 0x67, 0x65, 0x74,
+	0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x41, 0x64, 0x64, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12,
+	0x27, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x65,
+	0x78, 0x74, 0x2e, 0x67, 0x65, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x41, 0x64, 0x64, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x28, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69,
+	0x6d, 0x2e, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x65, 0x78, 0x74, 0x2e, 0x67, 0x65, 0x74, 0x46,
+	0x72, 0x69, 0x65, 0x6e, 0x64, 0x41, 0x64, 0x64, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x7f, 0x0a, 0x1a, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x54, 0x6f, 0x41, 0x64, 0x64,
+	0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x57, 0x69, 0x74, 0x68, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72,
+	0x12, 0x2f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64,
+	0x65, 0x78, 0x74, 0x2e, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x54, 0x6f, 0x41, 0x64, 0x64, 0x46, 0x72,
+	0x69, 0x65, 0x6e, 0x64, 0x57, 0x69, 0x74, 0x68, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x1a, 0x30, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x66, 0x72, 0x69, 0x65, 0x6e,
+	0x64, 0x65, 0x78, 0x74, 0x2e, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x54, 0x6f, 0x41, 0x64, 0x64, 0x46,
+	0x72, 0x69, 0x65, 0x6e, 0x64, 0x57, 0x69, 0x74, 0x68, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x6d, 0x0a, 0x14, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x46, 0x72, 0x69,
+	0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x29, 0x2e, 0x6f, 0x70,
+	0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x65, 0x78, 0x74, 0x2e, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x2a, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
+	0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x65, 0x78, 0x74, 0x2e, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x76, 0x0a, 0x17, 0x67, 0x65, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x73, 0x12, 0x2c, 0x2e,
+	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x65, 0x78, 0x74,
+	0x2e, 0x67, 0x65, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x2d, 0x2e, 0x6f, 0x70,
+	0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x65, 0x78, 0x74, 0x2e, 0x67,
+	0x65, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53,
+	0x65, 0x6e, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x76, 0x0a, 0x17, 0x67, 0x65,
+	0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72,
+	0x79, 0x53, 0x61, 0x6c, 0x74, 0x12, 0x2c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x66,
+	0x72, 0x69, 0x65, 0x6e, 0x64, 0x65, 0x78, 0x74, 0x2e, 0x67, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74,
+	0x61, 0x63, 0x74, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x53, 0x61, 0x6c, 0x74,
+	0x52, 0x65, 0x71, 0x1a, 0x2d, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x66, 0x72, 0x69,
+	0x65, 0x6e, 0x64, 0x65, 0x78, 0x74, 0x2e, 0x67, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63,
+	0x74, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x53, 0x61, 0x6c, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x61, 0x0a, 0x10, 0x73, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74,
+	0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x12, 0x25, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
+	0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x65, 0x78, 0x74, 0x2e, 0x73, 0x65, 0x74, 0x43, 0x6f, 0x6e,
+	0x74, 0x61, 0x63, 0x74, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x26, 0x2e,
+	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x65, 0x78, 0x74,
+	0x2e, 0x73, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x48, 0x61, 0x73, 0x68, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x7f, 0x0a, 0x1a, 0x73, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74,
+	0x61, 0x63, 0x74, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x53, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x12, 0x2f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x66, 0x72, 0x69,
+	0x65, 0x6e, 0x64, 0x65, 0x78, 0x74, 0x2e, 0x73, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63,
+	0x74, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e,
+	0x67, 0x52, 0x65, 0x71, 0x1a, 0x30, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x66, 0x72,
+	0x69, 0x65, 0x6e, 0x64, 0x65, 0x78, 0x74, 0x2e, 0x73, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61,
+	0x63, 0x74, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x53, 0x65, 0x74, 0x74, 0x69,
+	0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x7f, 0x0a, 0x1a, 0x67, 0x65, 0x74, 0x43, 0x6f, 0x6e,
+	0x74, 0x61, 0x63, 0x74, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x53, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x12, 0x2f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x66, 0x72,
+	0x69, 0x65, 0x6e, 0x64, 0x65, 0x78, 0x74, 0x2e, 0x67, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61,
+	0x63, 0x74, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x53, 0x65, 0x74, 0x74, 0x69,
+	0x6e, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x30, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x66,
+	0x72, 0x69, 0x65, 0x6e, 0x64, 0x65, 0x78, 0x74, 0x2e, 0x67, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74,
+	0x61, 0x63, 0x74, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x53, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x61, 0x0a, 0x10, 0x64, 0x69, 0x73, 0x63, 0x6f,
+	0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x73, 0x12, 0x25, 0x2e, 0x6f, 0x70,
+	0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x65, 0x78, 0x74, 0x2e, 0x64,
+	0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x1a, 0x26, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x66, 0x72, 0x69, 0x65,
+	0x6e, 0x64, 0x65, 0x78, 0x74, 0x2e, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x43, 0x6f,
+	0x6e, 0x74, 0x61, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x6d, 0x0a, 0x14, 0x67, 0x65,
+	0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x29, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x66, 0x72, 0x69, 0x65,
+	0x6e, 0x64, 0x65, 0x78, 0x74, 0x2e, 0x67, 0x65, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x53,
+	0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x2a, 0x2e,
+	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x65, 0x78, 0x74,
+	0x2e, 0x67, 0x65, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x76, 0x0a, 0x17, 0x64, 0x69, 0x73,
+	0x6d, 0x69, 0x73, 0x73, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x66, 0x72,
+	0x69, 0x65, 0x6e, 0x64, 0x65, 0x78, 0x74, 0x2e, 0x64, 0x69, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x46,
+	0x72, 0x69, 0x65, 0x6e, 0x64, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x1a, 0x2d, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x66, 0x72, 0x69, 0x65,
+	0x6e, 0x64, 0x65, 0x78, 0x74, 0x2e, 0x64, 0x69, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x46, 0x72, 0x69,
+	0x65, 0x6e, 0x64, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x79, 0x0a, 0x18, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x46, 0x72, 0x69, 0x65,
+	0x6e, 0x64, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2d, 0x2e,
+	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x65, 0x78, 0x74,
+	0x2e, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x53, 0x75,
+	0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x2e, 0x2e, 0x6f,
+	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x65, 0x78, 0x74, 0x2e,
+	0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x53, 0x75, 0x67,
+	0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x67, 0x0a, 0x12,
+	0x70, 0x75, 0x72, 0x67, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x27, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x66, 0x72, 0x69, 0x65,
+	0x6e, 0x64, 0x65, 0x78, 0x74, 0x2e, 0x70, 0x75, 0x72, 0x67, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x28, 0x2e, 0x6f, 0x70,
+	0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x65, 0x78, 0x74, 0x2e, 0x70,
+	0x75, 0x72, 0x67, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x42, 0x3f, 0x5a, 0x3d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x73, 0x64, 0x6b, 0x2f, 0x6f, 0x70,
+	0x65, 0x6e, 0x2d, 0x69, 0x6d, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x76, 0x33, 0x2f,
+	0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x66, 0x72, 0x69,
+	0x65, 0x6e, 0x64, 0x65, 0x78, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_friendext_friendext_proto_rawDescOnce sync.Once
+	file_friendext_friendext_proto_rawDescData = file_friendext_friendext_proto_rawDesc
+)
+
+func file_friendext_friendext_proto_rawDescGZIP() []byte {
+	file_friendext_friendext_proto_rawDescOnce.Do(func() {
+		file_friendext_friendext_proto_rawDescData = protoimpl.X.CompressGZIP(file_friendext_friendext_proto_rawDescData)
+	})
+	return file_friendext_friendext_proto_rawDescData
+}
+
+var file_friendext_friendext_proto_msgTypes = make([]protoimpl.MessageInfo, 58)
+var file_friendext_friendext_proto_goTypes = []interface{}{
+	(*FriendGroup)(nil),                    // 0: openim.friendext.friendGroup
+	(*CreateFriendGroupReq)(nil),           // 1: openim.friendext.createFriendGroupReq
+	(*CreateFriendGroupResp)(nil),          // 2: openim.friendext.createFriendGroupResp
+	(*SetFriendGroupReq)(nil),              // 3: openim.friendext.setFriendGroupReq
+	(*SetFriendGroupResp)(nil),             // 4: openim.friendext.setFriendGroupResp
+	(*DeleteFriendGroupReq)(nil),           // 5: openim.friendext.deleteFriendGroupReq
+	(*DeleteFriendGroupResp)(nil),          // 6: openim.friendext.deleteFriendGroupResp
+	(*SortFriendGroupsReq)(nil),            // 7: openim.friendext.sortFriendGroupsReq
+	(*SortFriendGroupsResp)(nil),           // 8: openim.friendext.sortFriendGroupsResp
+	(*GetFriendGroupsReq)(nil),             // 9: openim.friendext.getFriendGroupsReq
+	(*GetFriendGroupsResp)(nil),            // 10: openim.friendext.getFriendGroupsResp
+	(*AddFriendsToGroupReq)(nil),           // 11: openim.friendext.addFriendsToGroupReq
+	(*AddFriendsToGroupResp)(nil),          // 12: openim.friendext.addFriendsToGroupResp
+	(*RemoveFriendsFromGroupReq)(nil),      // 13: openim.friendext.removeFriendsFromGroupReq
+	(*RemoveFriendsFromGroupResp)(nil),     // 14: openim.friendext.removeFriendsFromGroupResp
+	(*MoveFriendsToGroupReq)(nil),          // 15: openim.friendext.moveFriendsToGroupReq
+	(*MoveFriendsToGroupResp)(nil),         // 16: openim.friendext.moveFriendsToGroupResp
+	(*FriendGroupIDs)(nil),                 // 17: openim.friendext.friendGroupIDs
+	(*GetFriendsGroupIDsReq)(nil),          // 18: openim.friendext.getFriendsGroupIDsReq
+	(*GetFriendsGroupIDsResp)(nil),         // 19: openim.friendext.getFriendsGroupIDsResp
+	(*GetPaginationGroupFriendsReq)(nil),   // 20: openim.friendext.getPaginationGroupFriendsReq
+	(*GetPaginationGroupFriendsResp)(nil),  // 21: openim.friendext.getPaginationGroupFriendsResp
+	(*FriendGroupChangedTips)(nil),         // 22: openim.friendext.friendGroupChangedTips
+	(*FriendAddSetting)(nil),               // 23: openim.friendext.friendAddSetting
+	(*SetFriendAddSettingReq)(nil),         // 24: openim.friendext.setFriendAddSettingReq
+	(*SetFriendAddSettingResp)(nil),        // 25: openim.friendext.setFriendAddSettingResp
+	(*GetFriendAddSettingReq)(nil),         // 26: openim.friendext.getFriendAddSettingReq
+	(*GetFriendAddSettingResp)(nil),        // 27: openim.friendext.getFriendAddSettingResp
+	(*GetFriendAddPolicyReq)(nil),          // 28: openim.friendext.getFriendAddPolicyReq
+	(*GetFriendAddPolicyResp)(nil),         // 29: openim.friendext.getFriendAddPolicyResp
+	(*ApplyToAddFriendWithAnswerReq)(nil),  // 30: openim.friendext.applyToAddFriendWithAnswerReq
+	(*ApplyToAddFriendWithAnswerResp)(nil), // 31: openim.friendext.applyToAddFriendWithAnswerResp
+	(*ExpireFriendRequestsReq)(nil),        // 32: openim.friendext.expireFriendRequestsReq
+	(*ExpireFriendRequestsResp)(nil),       // 33: openim.friendext.expireFriendRequestsResp
+	(*FriendRequestSender)(nil),            // 34: openim.friendext.friendRequestSender
+	(*GetFriendRequestSendersReq)(nil),     // 35: openim.friendext.getFriendRequestSendersReq
+	(*GetFriendRequestSendersResp)(nil),    // 36: openim.friendext.getFriendRequestSendersResp
+	(*GetContactDiscoverySaltReq)(nil),     // 37: openim.friendext.getContactDiscoverySaltReq
+	(*GetContactDiscoverySaltResp)(nil),    // 38: openim.friendext.getContactDiscoverySaltResp
+	(*SetContactHashesReq)(nil),            // 39: openim.friendext.setContactHashesReq
+	(*SetContactHashesResp)(nil),           // 40: openim.friendext.setContactHashesResp
+	(*ContactDiscoverySetting)(nil),        // 41: openim.friendext.contactDiscoverySetting
+	(*SetContactDiscoverySettingReq)(nil),  // 42: openim.friendext.setContactDiscoverySettingReq
+	(*SetContactDiscoverySettingResp)(nil), // 43: openim.friendext.setContactDiscoverySettingResp
+	(*GetContactDiscoverySettingReq)(nil),  // 44: openim.friendext.getContactDiscoverySettingReq
+	(*GetContactDiscoverySettingResp)(nil), // 45: openim.friendext.getContactDiscoverySettingResp
+	(*ContactMatch)(nil),                   // 46: openim.friendext.contactMatch
+	(*DiscoverContactsReq)(nil),            // 47: openim.friendext.discoverContactsReq
+	(*DiscoverContactsResp)(nil),           // 48: openim.friendext.discoverContactsResp
+	(*FriendSuggestion)(nil),               // 49: openim.friendext.friendSuggestion
+	(*GetFriendSuggestionsReq)(nil),        // 50: openim.friendext.getFriendSuggestionsReq
+	(*GetFriendSuggestionsResp)(nil),       // 51: openim.friendext.getFriendSuggestionsResp
+	(*DismissFriendSuggestionReq)(nil),     // 52: openim.friendext.dismissFriendSuggestionReq
+	(*DismissFriendSuggestionResp)(nil),    // 53: openim.friendext.dismissFriendSuggestionResp
+	(*RefreshFriendSuggestionsReq)(nil),    // 54: openim.friendext.refreshFriendSuggestionsReq
+	(*RefreshFriendSuggestionsResp)(nil),   // 55: openim.friendext.refreshFriendSuggestionsResp
+	(*PurgeUserRelationsReq)(nil),          // 56: openim.friendext.purgeUserRelationsReq
+	(*PurgeUserRelationsResp)(nil),         // 57: openim.friendext.purgeUserRelationsResp
+	(*sdkws.RequestPagination)(nil),        // 58: openim.sdkws.RequestPagination
+	(*sdkws.FriendInfo)(nil),               // 59: openim.sdkws.FriendInfo
+	(*sdkws.PublicUserInfo)(nil),           // 60: openim.sdkws.PublicUserInfo
+}
+var file_friendext_friendext_proto_depIdxs = []int32{
+	0,  // 0: openim.friendext.createFriendGroupResp.group:type_name -> openim.friendext.friendGroup
+	0,  // 1: openim.friendext.getFriendGroupsResp.groups:type_name -> openim.friendext.friendGroup
+	17, // 2: openim.friendext.getFriendsGroupIDsResp.friends:type_name -> openim.friendext.friendGroupIDs
+	58, // 3: openim.friendext.getPaginationGroupFriendsReq.pagination:type_name -> openim.sdkws.RequestPagination
+	59, // 4: openim.friendext.getPaginationGroupFriendsResp.friendsInfo:type_name -> openim.sdkws.FriendInfo
+	23, // 5: openim.friendext.setFriendAddSettingReq.setting:type_name -> openim.friendext.friendAddSetting
+	23, // 6: openim.friendext.getFriendAddSettingResp.setting:type_name -> openim.friendext.friendAddSetting
+	34, // 7: openim.friendext.getFriendRequestSendersResp.senders:type_name -> openim.friendext.friendRequestSender
+	41, // 8: openim.friendext.setContactDiscoverySettingReq.setting:type_name -> openim.friendext.contactDiscoverySetting
+	41, // 9: openim.friendext.getContactDiscoverySettingResp.setting:type_name -> openim.friendext.contactDiscoverySetting
+	46, // 10: openim.friendext.discoverContactsResp.matches:type_name -> openim.friendext.contactMatch
+	60, // 11: openim.friendext.friendSuggestion.user:type_name -> openim.sdkws.PublicUserInfo
+	58, // 12: openim.friendext.getFriendSuggestionsReq.pagination:type_name -> openim.sdkws.RequestPagination
+	49, // 13: openim.friendext.getFriendSuggestionsResp.suggestions:type_name -> openim.friendext.friendSuggestion
+	1,  // 14: openim.friendext.friendExt.createFriendGroup:input_type -> openim.friendext.createFriendGroupReq
+	3,  // 15: openim.friendext.friendExt.setFriendGroup:input_type -> openim.friendext.setFriendGroupReq
+	5,  // 16: openim.friendext.friendExt.deleteFriendGroup:input_type -> openim.friendext.deleteFriendGroupReq
+	7,  // 17: openim.friendext.friendExt.sortFriendGroups:input_type -> openim.friendext.sortFriendGroupsReq
+	9,  // 18: openim.friendext.friendExt.getFriendGroups:input_type -> openim.friendext.getFriendGroupsReq
+	11, // 19: openim.friendext.friendExt.addFriendsToGroup:input_type -> openim.friendext.addFriendsToGroupReq
+	13, // 20: openim.friendext.friendExt.removeFriendsFromGroup:input_type -> openim.friendext.removeFriendsFromGroupReq
+	15, // 21: openim.friendext.friendExt.moveFriendsToGroup:input_type -> openim.friendext.moveFriendsToGroupReq
+	18, // 22: openim.friendext.friendExt.getFriendsGroupIDs:input_type -> openim.friendext.getFriendsGroupIDsReq
+	20, // 23: openim.friendext.friendExt.getPaginationGroupFriends:input_type -> openim.friendext.getPaginationGroupFriendsReq
+	24, // 24: openim.friendext.friendExt.setFriendAddSetting:input_type -> openim.friendext.setFriendAddSettingReq
+	26, // 25: openim.friendext.friendExt.getFriendAddSetting:input_type -> openim.friendext.getFriendAddSettingReq
+	28, // 26: openim.friendext.friendExt.getFriendAddPolicy:input_type -> openim.friendext.getFriendAddPolicyReq
+	30, // 27: openim.friendext.friendExt.applyToAddFriendWithAnswer:input_type -> openim.friendext.applyToAddFriendWithAnswerReq
+	32, // 28: openim.friendext.friendExt.expireFriendRequests:input_type -> openim.friendext.expireFriendRequestsReq
+	35, // 29: openim.friendext.friendExt.getFriendRequestSenders:input_type -> openim.friendext.getFriendRequestSendersReq
+	37, // 30: openim.friendext.friendExt.getContactDiscoverySalt:input_type -> openim.friendext.getContactDiscoverySaltReq
+	39, // 31: openim.friendext.friendExt.setContactHashes:input_type -> openim.friendext.setContactHashesReq
+	42, // 32: openim.friendext.friendExt.setContactDiscoverySetting:input_type -> openim.friendext.setContactDiscoverySettingReq
+	44, // 33: openim.friendext.friendExt.getContactDiscoverySetting:input_type -> openim.friendext.getContactDiscoverySettingReq
+	47, // 34: openim.friendext.friendExt.discoverContacts:input_type -> openim.friendext.discoverContactsReq
+	50, // 35: openim.friendext.friendExt.getFriendSuggestions:input_type -> openim.friendext.getFriendSuggestionsReq
+	52, // 36: openim.friendext.friendExt.dismissFriendSuggestion:input_type -> openim.friendext.dismissFriendSuggestionReq
+	54, // 37: openim.friendext.friendExt.refreshFriendSuggestions:input_type -> openim.friendext.refreshFriendSuggestionsReq
+	56, // 38: openim.friendext.friendExt.purgeUserRelations:input_type -> openim.friendext.purgeUserRelationsReq
+	2,  // 39: openim.friendext.friendExt.createFriendGroup:output_type -> openim.friendext.createFriendGroupResp
+	4,  // 40: openim.friendext.friendExt.setFriendGroup:output_type -> openim.friendext.setFriendGroupResp
+	6,  // 41: openim.friendext.friendExt.deleteFriendGroup:output_type -> openim.friendext.deleteFriendGroupResp
+	8,  // 42: openim.friendext.friendExt.sortFriendGroups:output_type -> openim.friendext.sortFriendGroupsResp
+	10, // 43: openim.friendext.friendExt.getFriendGroups:output_type -> openim.friendext.getFriendGroupsResp
+	12, // 44: openim.friendext.friendExt.addFriendsToGroup:output_type -> openim.friendext.addFriendsToGroupResp
+	14, // 45: openim.friendext.friendExt.removeFriendsFromGroup:output_type -> openim.friendext.removeFriendsFromGroupResp
+	16, // 46: openim.friendext.friendExt.moveFriendsToGroup:output_type -> openim.friendext.moveFriendsToGroupResp
+	19, // 47: openim.friendext.friendExt.getFriendsGroupIDs:output_type -> openim.friendext.getFriendsGroupIDsResp
+	21, // 48: openim.friendext.friendExt.getPaginationGroupFriends:output_type -> openim.friendext.getPaginationGroupFriendsResp
+	25, // 49: openim.friendext.friendExt.setFriendAddSetting:output_type -> openim.friendext.setFriendAddSettingResp
+	27, // 50: openim.friendext.friendExt.getFriendAddSetting:output_type -> openim.friendext.getFriendAddSettingResp
+	29, // 51: openim.friendext.friendExt.getFriendAddPolicy:output_type -> openim.friendext.getFriendAddPolicyResp
+	31, // 52: openim.friendext.friendExt.applyToAddFriendWithAnswer:output_type -> openim.friendext.applyToAddFriendWithAnswerResp
+	33, // 53: openim.friendext.friendExt.expireFriendRequests:output_type -> openim.friendext.expireFriendRequestsResp
+	36, // 54: openim.friendext.friendExt.getFriendRequestSenders:output_type -> openim.friendext.getFriendRequestSendersResp
+	38, // 55: openim.friendext.friendExt.getContactDiscoverySalt:output_type -> openim.friendext.getContactDiscoverySaltResp
+	40, // 56: openim.friendext.friendExt.setContactHashes:output_type -> openim.friendext.setContactHashesResp
+	43, // 57: openim.friendext.friendExt.setContactDiscoverySetting:output_type -> openim.friendext.setContactDiscoverySettingResp
+	45, // 58: openim.friendext.friendExt.getContactDiscoverySetting:output_type -> openim.friendext.getContactDiscoverySettingResp
+	48, // 59: openim.friendext.friendExt.discoverContacts:output_type -> openim.friendext.discoverContactsResp
+	51, // 60: openim.friendext.friendExt.getFriendSuggestions:output_type -> openim.friendext.getFriendSuggestionsResp
+	53, // 61: openim.friendext.friendExt.dismissFriendSuggestion:output_type -> openim.friendext.dismissFriendSuggestionResp
+	55, // 62: openim.friendext.friendExt.refreshFriendSuggestions:output_type -> openim.friendext.refreshFriendSuggestionsResp
+	57, // 63: openim.friendext.friendExt.purgeUserRelations:output_type -> openim.friendext.purgeUserRelationsResp
+	39, // [39:64] is the sub-list for method output_type
+	14, // [14:39] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
+}
+
+func init() { file_friendext_friendext_proto_init() }
+func file_friendext_friendext_proto_init() {
+	if File_friendext_friendext_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_friendext_friendext_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FriendGroup); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_friendext_friendext_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateFriendGroupReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_friendext_friendext_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateFriendGroupResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_friendext_friendext_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetFriendGroupReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_friendext_friendext_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetFriendGroupResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_friendext_friendext_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteFriendGroupReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_friendext_friendext_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteFriendGroupResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_friendext_friendext_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SortFriendGroupsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_friendext_friendext_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SortFriendGroupsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_friendext_friendext_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFriendGroupsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_friendext_friendext_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFriendGroupsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_friendext_friendext_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddFriendsToGroupReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_friendext_friendext_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddFriendsToGroupResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_friendext_friendext_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveFriendsFromGroupReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_friendext_friendext_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveFriendsFromGroupResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_friendext_friendext_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MoveFriendsToGroupReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_friendext_friendext_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MoveFriendsToGroupResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_friendext_friendext_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FriendGroupIDs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_friendext_friendext_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFriendsGroupIDsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_friendext_friendext_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFriendsGroupIDsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_friendext_friendext_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPaginationGroupFriendsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_friendext_friendext_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPaginationGroupFriendsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_friendext_friendext_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FriendGroupChangedTips); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_friendext_friendext_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FriendAddSetting); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_friendext_friendext_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetFriendAddSettingReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_friendext_friendext_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetFriendAddSettingResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_friendext_friendext_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFriendAddSettingReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_friendext_friendext_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFriendAddSettingResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_friendext_friendext_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFriendAddPolicyReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_friendext_friendext_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFriendAddPolicyResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_friendext_friendext_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApplyToAddFriendWithAnswerReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_friendext_friendext_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApplyToAddFriendWithAnswerResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_friendext_friendext_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExpireFriendRequestsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_friendext_friendext_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExpireFriendRequestsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_friendext_friendext_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FriendRequestSender); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_friendext_friendext_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFriendRequestSendersReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_friendext_friendext_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFriendRequestSendersResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_friendext_friendext_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetContactDiscoverySaltReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_friendext_friendext_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetContactDiscoverySaltResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_friendext_friendext_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetContactHashesReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_friendext_friendext_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetContactHashesResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_friendext_friendext_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContactDiscoverySetting); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_friendext_friendext_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetContactDiscoverySettingReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_friendext_friendext_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetContactDiscoverySettingResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_friendext_friendext_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetContactDiscoverySettingReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_friendext_friendext_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetContactDiscoverySettingResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_friendext_friendext_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContactMatch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_friendext_friendext_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiscoverContactsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_friendext_friendext_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiscoverContactsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_friendext_friendext_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FriendSuggestion); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_friendext_friendext_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFriendSuggestionsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_friendext_friendext_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFriendSuggestionsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_friendext_friendext_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DismissFriendSuggestionReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_friendext_friendext_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DismissFriendSuggestionResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_friendext_friendext_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RefreshFriendSuggestionsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_friendext_friendext_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RefreshFriendSuggestionsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_friendext_friendext_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PurgeUserRelationsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_friendext_friendext_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PurgeUserRelationsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_friendext_friendext_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   58,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_friendext_friendext_proto_goTypes,
+		DependencyIndexes: file_friendext_friendext_proto_depIdxs,
+		MessageInfos:      file_friendext_friendext_proto_msgTypes,
+	}.Build()
+	File_friendext_friendext_proto = out.File
+	file_friendext_friendext_proto_rawDesc = nil
+	file_friendext_friendext_proto_goTypes = nil
+	file_friendext_friendext_proto_depIdxs = nil
+}
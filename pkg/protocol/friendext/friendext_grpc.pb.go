@@ -0,0 +1,1031 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: friendext/friendext.proto
+
+package friendext
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	FriendExt_CreateFriendGroup_FullMethodName          = "/openim.friendext.friendExt/createFriendGroup"
+	FriendExt_SetFriendGroup_FullMethodName             = "/openim.friendext.friendExt/setFriendGroup"
+	FriendExt_DeleteFriendGroup_FullMethodName          = "/openim.friendext.friendExt/deleteFriendGroup"
+	FriendExt_SortFriendGroups_FullMethodName           = "/openim.friendext.friendExt/sortFriendGroups"
+	FriendExt_GetFriendGroups_FullMethodName            = "/openim.friendext.friendExt/getFriendGroups"
+	FriendExt_AddFriendsToGroup_FullMethodName          = "/openim.friendext.friendExt/addFriendsToGroup"
+	FriendExt_RemoveFriendsFromGroup_FullMethodName     = "/openim.friendext.friendExt/removeFriendsFromGroup"
+	FriendExt_MoveFriendsToGroup_FullMethodName         = "/openim.friendext.friendExt/moveFriendsToGroup"
+	FriendExt_GetFriendsGroupIDs_FullMethodName         = "/openim.friendext.friendExt/getFriendsGroupIDs"
+	FriendExt_GetPaginationGroupFriends_FullMethodName  = "/openim.friendext.friendExt/getPaginationGroupFriends"
+	FriendExt_SetFriendAddSetting_FullMethodName        = "/openim.friendext.friendExt/setFriendAddSetting"
+	FriendExt_GetFriendAddSetting_FullMethodName        = "/openim.friendext.friendExt/getFriendAddSetting"
+	FriendExt_GetFriendAddPolicy_FullMethodName         = "/openim.friendext.friendExt/getFriendAddPolicy"
+	FriendExt_ApplyToAddFriendWithAnswer_FullMethodName = "/openim.friendext.friendExt/applyToAddFriendWithAnswer"
+	FriendExt_ExpireFriendRequests_FullMethodName       = "/openim.friendext.friendExt/expireFriendRequests"
+	FriendExt_GetFriendRequestSenders_FullMethodName    = "/openim.friendext.friendExt/getFriendRequestSenders"
+	FriendExt_GetContactDiscoverySalt_FullMethodName    = "/openim.friendext.friendExt/getContactDiscoverySalt"
+	FriendExt_SetContactHashes_FullMethodName           = "/openim.friendext.friendExt/setContactHashes"
+	FriendExt_SetContactDiscoverySetting_FullMethodName = "/openim.friendext.friendExt/setContactDiscoverySetting"
+	FriendExt_GetContactDiscoverySetting_FullMethodName = "/openim.friendext.friendExt/getContactDiscoverySetting"
+	FriendExt_DiscoverContacts_FullMethodName           = "/openim.friendext.friendExt/discoverContacts"
+	FriendExt_GetFriendSuggestions_FullMethodName       = "/openim.friendext.friendExt/getFriendSuggestions"
+	FriendExt_DismissFriendSuggestion_FullMethodName    = "/openim.friendext.friendExt/dismissFriendSuggestion"
+	FriendExt_RefreshFriendSuggestions_FullMethodName   = "/openim.friendext.friendExt/refreshFriendSuggestions"
+	FriendExt_PurgeUserRelations_FullMethodName         = "/openim.friendext.friendExt/purgeUserRelations"
+)
+
+// FriendExtClient is the client API for FriendExt service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type FriendExtClient interface {
+	// per-user groups of friends, changes are notified to the devices of the user by FriendGroupChangedNotification
+	CreateFriendGroup(ctx context.Context, in *CreateFriendGroupReq, opts ...grpc.CallOption) (*CreateFriendGroupResp, error)
+	SetFriendGroup(ctx context.Context, in *SetFriendGroupReq, opts ...grpc.CallOption) (*SetFriendGroupResp, error)
+	DeleteFriendGroup(ctx context.Context, in *DeleteFriendGroupReq, opts ...grpc.CallOption) (*DeleteFriendGroupResp, error)
+	SortFriendGroups(ctx context.Context, in *SortFriendGroupsReq, opts ...grpc.CallOption) (*SortFriendGroupsResp, error)
+	GetFriendGroups(ctx context.Context, in *GetFriendGroupsReq, opts ...grpc.CallOption) (*GetFriendGroupsResp, error)
+	AddFriendsToGroup(ctx context.Context, in *AddFriendsToGroupReq, opts ...grpc.CallOption) (*AddFriendsToGroupResp, error)
+	RemoveFriendsFromGroup(ctx context.Context, in *RemoveFriendsFromGroupReq, opts ...grpc.CallOption) (*RemoveFriendsFromGroupResp, error)
+	MoveFriendsToGroup(ctx context.Context, in *MoveFriendsToGroupReq, opts ...grpc.CallOption) (*MoveFriendsToGroupResp, error)
+	GetFriendsGroupIDs(ctx context.Context, in *GetFriendsGroupIDsReq, opts ...grpc.CallOption) (*GetFriendsGroupIDsResp, error)
+	// same as getPaginationFriends, limited to the friends in a group
+	GetPaginationGroupFriends(ctx context.Context, in *GetPaginationGroupFriendsReq, opts ...grpc.CallOption) (*GetPaginationGroupFriendsResp, error)
+	SetFriendAddSetting(ctx context.Context, in *SetFriendAddSettingReq, opts ...grpc.CallOption) (*SetFriendAddSettingResp, error)
+	GetFriendAddSetting(ctx context.Context, in *GetFriendAddSettingReq, opts ...grpc.CallOption) (*GetFriendAddSettingResp, error)
+	// Open to every user, so that they know whether a question has to be answered
+	GetFriendAddPolicy(ctx context.Context, in *GetFriendAddPolicyReq, opts ...grpc.CallOption) (*GetFriendAddPolicyResp, error)
+	ApplyToAddFriendWithAnswer(ctx context.Context, in *ApplyToAddFriendWithAnswerReq, opts ...grpc.CallOption) (*ApplyToAddFriendWithAnswerResp, error)
+	// Admin only
+	ExpireFriendRequests(ctx context.Context, in *ExpireFriendRequestsReq, opts ...grpc.CallOption) (*ExpireFriendRequestsResp, error)
+	// Admin only
+	GetFriendRequestSenders(ctx context.Context, in *GetFriendRequestSendersReq, opts ...grpc.CallOption) (*GetFriendRequestSendersResp, error)
+	GetContactDiscoverySalt(ctx context.Context, in *GetContactDiscoverySaltReq, opts ...grpc.CallOption) (*GetContactDiscoverySaltResp, error)
+	// Admin only, called by the app server after verifying the phone numbers or emails
+	SetContactHashes(ctx context.Context, in *SetContactHashesReq, opts ...grpc.CallOption) (*SetContactHashesResp, error)
+	SetContactDiscoverySetting(ctx context.Context, in *SetContactDiscoverySettingReq, opts ...grpc.CallOption) (*SetContactDiscoverySettingResp, error)
+	GetContactDiscoverySetting(ctx context.Context, in *GetContactDiscoverySettingReq, opts ...grpc.CallOption) (*GetContactDiscoverySettingResp, error)
+	// Only matches the users discoverable by the type of the matched hash
+	DiscoverContacts(ctx context.Context, in *DiscoverContactsReq, opts ...grpc.CallOption) (*DiscoverContactsResp, error)
+	// People you may know, precomputed by refreshFriendSuggestions
+	GetFriendSuggestions(ctx context.Context, in *GetFriendSuggestionsReq, opts ...grpc.CallOption) (*GetFriendSuggestionsResp, error)
+	DismissFriendSuggestion(ctx context.Context, in *DismissFriendSuggestionReq, opts ...grpc.CallOption) (*DismissFriendSuggestionResp, error)
+	// Admin only, computes the suggestions of a batch of queued users
+	RefreshFriendSuggestions(ctx context.Context, in *RefreshFriendSuggestionsReq, opts ...grpc.CallOption) (*RefreshFriendSuggestionsResp, error)
+	// Admin only, deletes the friends, black lists, friend requests, friend groups and settings of a deleted user,
+	// in both directions
+	PurgeUserRelations(ctx context.Context, in *PurgeUserRelationsReq, opts ...grpc.CallOption) (*PurgeUserRelationsResp, error)
+}
+
+type friendExtClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewFriendExtClient(cc grpc.ClientConnInterface) FriendExtClient {
+	return &friendExtClient{cc}
+}
+
+func (c *friendExtClient) CreateFriendGroup(ctx context.Context, in *CreateFriendGroupReq, opts ...grpc.CallOption) (*CreateFriendGroupResp, error) {
+	out := new(CreateFriendGroupResp)
+	err := c.cc.Invoke(ctx, FriendExt_CreateFriendGroup_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *friendExtClient) SetFriendGroup(ctx context.Context, in *SetFriendGroupReq, opts ...grpc.CallOption) (*SetFriendGroupResp, error) {
+	out := new(SetFriendGroupResp)
+	err := c.cc.Invoke(ctx, FriendExt_SetFriendGroup_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *friendExtClient) DeleteFriendGroup(ctx context.Context, in *DeleteFriendGroupReq, opts ...grpc.CallOption) (*DeleteFriendGroupResp, error) {
+	out := new(DeleteFriendGroupResp)
+	err := c.cc.Invoke(ctx, FriendExt_DeleteFriendGroup_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *friendExtClient) SortFriendGroups(ctx context.Context, in *SortFriendGroupsReq, opts ...grpc.CallOption) (*SortFriendGroupsResp, error) {
+	out := new(SortFriendGroupsResp)
+	err := c.cc.Invoke(ctx, FriendExt_SortFriendGroups_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *friendExtClient) GetFriendGroups(ctx context.Context, in *GetFriendGroupsReq, opts ...grpc.CallOption) (*GetFriendGroupsResp, error) {
+	out := new(GetFriendGroupsResp)
+	err := c.cc.Invoke(ctx, FriendExt_GetFriendGroups_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *friendExtClient) AddFriendsToGroup(ctx context.Context, in *AddFriendsToGroupReq, opts ...grpc.CallOption) (*AddFriendsToGroupResp, error) {
+	out := new(AddFriendsToGroupResp)
+	err := c.cc.Invoke(ctx, FriendExt_AddFriendsToGroup_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *friendExtClient) RemoveFriendsFromGroup(ctx context.Context, in *RemoveFriendsFromGroupReq, opts ...grpc.CallOption) (*RemoveFriendsFromGroupResp, error) {
+	out := new(RemoveFriendsFromGroupResp)
+	err := c.cc.Invoke(ctx, FriendExt_RemoveFriendsFromGroup_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *friendExtClient) MoveFriendsToGroup(ctx context.Context, in *MoveFriendsToGroupReq, opts ...grpc.CallOption) (*MoveFriendsToGroupResp, error) {
+	out := new(MoveFriendsToGroupResp)
+	err := c.cc.Invoke(ctx, FriendExt_MoveFriendsToGroup_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *friendExtClient) GetFriendsGroupIDs(ctx context.Context, in *GetFriendsGroupIDsReq, opts ...grpc.CallOption) (*GetFriendsGroupIDsResp, error) {
+	out := new(GetFriendsGroupIDsResp)
+	err := c.cc.Invoke(ctx, FriendExt_GetFriendsGroupIDs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *friendExtClient) GetPaginationGroupFriends(ctx context.Context, in *GetPaginationGroupFriendsReq, opts ...grpc.CallOption) (*GetPaginationGroupFriendsResp, error) {
+	out := new(GetPaginationGroupFriendsResp)
+	err := c.cc.Invoke(ctx, FriendExt_GetPaginationGroupFriends_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *friendExtClient) SetFriendAddSetting(ctx context.Context, in *SetFriendAddSettingReq, opts ...grpc.CallOption) (*SetFriendAddSettingResp, error) {
+	out := new(SetFriendAddSettingResp)
+	err := c.cc.Invoke(ctx, FriendExt_SetFriendAddSetting_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *friendExtClient) GetFriendAddSetting(ctx context.Context, in *GetFriendAddSettingReq, opts ...grpc.CallOption) (*GetFriendAddSettingResp, error) {
+	out := new(GetFriendAddSettingResp)
+	err := c.cc.Invoke(ctx, FriendExt_GetFriendAddSetting_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *friendExtClient) GetFriendAddPolicy(ctx context.Context, in *GetFriendAddPolicyReq, opts ...grpc.CallOption) (*GetFriendAddPolicyResp, error) {
+	out := new(GetFriendAddPolicyResp)
+	err := c.cc.Invoke(ctx, FriendExt_GetFriendAddPolicy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *friendExtClient) ApplyToAddFriendWithAnswer(ctx context.Context, in *ApplyToAddFriendWithAnswerReq, opts ...grpc.CallOption) (*ApplyToAddFriendWithAnswerResp, error) {
+	out := new(ApplyToAddFriendWithAnswerResp)
+	err := c.cc.Invoke(ctx, FriendExt_ApplyToAddFriendWithAnswer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *friendExtClient) ExpireFriendRequests(ctx context.Context, in *ExpireFriendRequestsReq, opts ...grpc.CallOption) (*ExpireFriendRequestsResp, error) {
+	out := new(ExpireFriendRequestsResp)
+	err := c.cc.Invoke(ctx, FriendExt_ExpireFriendRequests_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *friendExtClient) GetFriendRequestSenders(ctx context.Context, in *GetFriendRequestSendersReq, opts ...grpc.CallOption) (*GetFriendRequestSendersResp, error) {
+	out := new(GetFriendRequestSendersResp)
+	err := c.cc.Invoke(ctx, FriendExt_GetFriendRequestSenders_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *friendExtClient) GetContactDiscoverySalt(ctx context.Context, in *GetContactDiscoverySaltReq, opts ...grpc.CallOption) (*GetContactDiscoverySaltResp, error) {
+	out := new(GetContactDiscoverySaltResp)
+	err := c.cc.Invoke(ctx, FriendExt_GetContactDiscoverySalt_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *friendExtClient) SetContactHashes(ctx context.Context, in *SetContactHashesReq, opts ...grpc.CallOption) (*SetContactHashesResp, error) {
+	out := new(SetContactHashesResp)
+	err := c.cc.Invoke(ctx, FriendExt_SetContactHashes_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *friendExtClient) SetContactDiscoverySetting(ctx context.Context, in *SetContactDiscoverySettingReq, opts ...grpc.CallOption) (*SetContactDiscoverySettingResp, error) {
+	out := new(SetContactDiscoverySettingResp)
+	err := c.cc.Invoke(ctx, FriendExt_SetContactDiscoverySetting_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *friendExtClient) GetContactDiscoverySetting(ctx context.Context, in *GetContactDiscoverySettingReq, opts ...grpc.CallOption) (*GetContactDiscoverySettingResp, error) {
+	out := new(GetContactDiscoverySettingResp)
+	err := c.cc.Invoke(ctx, FriendExt_GetContactDiscoverySetting_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *friendExtClient) DiscoverContacts(ctx context.Context, in *DiscoverContactsReq, opts ...grpc.CallOption) (*DiscoverContactsResp, error) {
+	out := new(DiscoverContactsResp)
+	err := c.cc.Invoke(ctx, FriendExt_DiscoverContacts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *friendExtClient) GetFriendSuggestions(ctx context.Context, in *GetFriendSuggestionsReq, opts ...grpc.CallOption) (*GetFriendSuggestionsResp, error) {
+	out := new(GetFriendSuggestionsResp)
+	err := c.cc.Invoke(ctx, FriendExt_GetFriendSuggestions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *friendExtClient) DismissFriendSuggestion(ctx context.Context, in *DismissFriendSuggestionReq, opts ...grpc.CallOption) (*DismissFriendSuggestionResp, error) {
+	out := new(DismissFriendSuggestionResp)
+	err := c.cc.Invoke(ctx, FriendExt_DismissFriendSuggestion_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *friendExtClient) RefreshFriendSuggestions(ctx context.Context, in *RefreshFriendSuggestionsReq, opts ...grpc.CallOption) (*RefreshFriendSuggestionsResp, error) {
+	out := new(RefreshFriendSuggestionsResp)
+	err := c.cc.Invoke(ctx, FriendExt_RefreshFriendSuggestions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *friendExtClient) PurgeUserRelations(ctx context.Context, in *PurgeUserRelationsReq, opts ...grpc.CallOption) (*PurgeUserRelationsResp, error) {
+	out := new(PurgeUserRelationsResp)
+	err := c.cc.Invoke(ctx, FriendExt_PurgeUserRelations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// FriendExtServer is the server API for FriendExt service.
+// All implementations should embed UnimplementedFriendExtServer
+// for forward compatibility
+type FriendExtServer interface {
+	// per-user groups of friends, changes are notified to the devices of the user by FriendGroupChangedNotification
+	CreateFriendGroup(context.Context, *CreateFriendGroupReq) (*CreateFriendGroupResp, error)
+	SetFriendGroup(context.Context, *SetFriendGroupReq) (*SetFriendGroupResp, error)
+	DeleteFriendGroup(context.Context, *DeleteFriendGroupReq) (*DeleteFriendGroupResp, error)
+	SortFriendGroups(context.Context, *SortFriendGroupsReq) (*SortFriendGroupsResp, error)
+	GetFriendGroups(context.Context, *GetFriendGroupsReq) (*GetFriendGroupsResp, error)
+	AddFriendsToGroup(context.Context, *AddFriendsToGroupReq) (*AddFriendsToGroupResp, error)
+	RemoveFriendsFromGroup(context.Context, *RemoveFriendsFromGroupReq) (*RemoveFriendsFromGroupResp, error)
+	MoveFriendsToGroup(context.Context, *MoveFriendsToGroupReq) (*MoveFriendsToGroupResp, error)
+	GetFriendsGroupIDs(context.Context, *GetFriendsGroupIDsReq) (*GetFriendsGroupIDsResp, error)
+	// same as getPaginationFriends, limited to the friends in a group
+	GetPaginationGroupFriends(context.Context, *GetPaginationGroupFriendsReq) (*GetPaginationGroupFriendsResp, error)
+	SetFriendAddSetting(context.Context, *SetFriendAddSettingReq) (*SetFriendAddSettingResp, error)
+	GetFriendAddSetting(context.Context, *GetFriendAddSettingReq) (*GetFriendAddSettingResp, error)
+	// Open to every user, so that they know whether a question has to be answered
+	GetFriendAddPolicy(context.Context, *GetFriendAddPolicyReq) (*GetFriendAddPolicyResp, error)
+	ApplyToAddFriendWithAnswer(context.Context, *ApplyToAddFriendWithAnswerReq) (*ApplyToAddFriendWithAnswerResp, error)
+	// Admin only
+	ExpireFriendRequests(context.Context, *ExpireFriendRequestsReq) (*ExpireFriendRequestsResp, error)
+	// Admin only
+	GetFriendRequestSenders(context.Context, *GetFriendRequestSendersReq) (*GetFriendRequestSendersResp, error)
+	GetContactDiscoverySalt(context.Context, *GetContactDiscoverySaltReq) (*GetContactDiscoverySaltResp, error)
+	// Admin only, called by the app server after verifying the phone numbers or emails
+	SetContactHashes(context.Context, *SetContactHashesReq) (*SetContactHashesResp, error)
+	SetContactDiscoverySetting(context.Context, *SetContactDiscoverySettingReq) (*SetContactDiscoverySettingResp, error)
+	GetContactDiscoverySetting(context.Context, *GetContactDiscoverySettingReq) (*GetContactDiscoverySettingResp, error)
+	// Only matches the users discoverable by the type of the matched hash
+	DiscoverContacts(context.Context, *DiscoverContactsReq) (*DiscoverContactsResp, error)
+	// People you may know, precomputed by refreshFriendSuggestions
+	GetFriendSuggestions(context.Context, *GetFriendSuggestionsReq) (*GetFriendSuggestionsResp, error)
+	DismissFriendSuggestion(context.Context, *DismissFriendSuggestionReq) (*DismissFriendSuggestionResp, error)
+	// Admin only, computes the suggestions of a batch of queued users
+	RefreshFriendSuggestions(context.Context, *RefreshFriendSuggestionsReq) (*RefreshFriendSuggestionsResp, error)
+	// Admin only, deletes the friends, black lists, friend requests, friend groups and settings of a deleted user,
+	// in both directions
+	PurgeUserRelations(context.Context, *PurgeUserRelationsReq) (*PurgeUserRelationsResp, error)
+}
+
+// UnimplementedFriendExtServer should be embedded to have forward compatible implementations.
+type UnimplementedFriendExtServer struct {
+}
+
+func (UnimplementedFriendExtServer) CreateFriendGroup(context.Context, *CreateFriendGroupReq) (*CreateFriendGroupResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateFriendGroup not implemented")
+}
+func (UnimplementedFriendExtServer) SetFriendGroup(context.Context, *SetFriendGroupReq) (*SetFriendGroupResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFriendGroup not implemented")
+}
+func (UnimplementedFriendExtServer) DeleteFriendGroup(context.Context, *DeleteFriendGroupReq) (*DeleteFriendGroupResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteFriendGroup not implemented")
+}
+func (UnimplementedFriendExtServer) SortFriendGroups(context.Context, *SortFriendGroupsReq) (*SortFriendGroupsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SortFriendGroups not implemented")
+}
+func (UnimplementedFriendExtServer) GetFriendGroups(context.Context, *GetFriendGroupsReq) (*GetFriendGroupsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFriendGroups not implemented")
+}
+func (UnimplementedFriendExtServer) AddFriendsToGroup(context.Context, *AddFriendsToGroupReq) (*AddFriendsToGroupResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddFriendsToGroup not implemented")
+}
+func (UnimplementedFriendExtServer) RemoveFriendsFromGroup(context.Context, *RemoveFriendsFromGroupReq) (*RemoveFriendsFromGroupResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveFriendsFromGroup not implemented")
+}
+func (UnimplementedFriendExtServer) MoveFriendsToGroup(context.Context, *MoveFriendsToGroupReq) (*MoveFriendsToGroupResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveFriendsToGroup not implemented")
+}
+func (UnimplementedFriendExtServer) GetFriendsGroupIDs(context.Context, *GetFriendsGroupIDsReq) (*GetFriendsGroupIDsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFriendsGroupIDs not implemented")
+}
+func (UnimplementedFriendExtServer) GetPaginationGroupFriends(context.Context, *GetPaginationGroupFriendsReq) (*GetPaginationGroupFriendsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPaginationGroupFriends not implemented")
+}
+func (UnimplementedFriendExtServer) SetFriendAddSetting(context.Context, *SetFriendAddSettingReq) (*SetFriendAddSettingResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFriendAddSetting not implemented")
+}
+func (UnimplementedFriendExtServer) GetFriendAddSetting(context.Context, *GetFriendAddSettingReq) (*GetFriendAddSettingResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFriendAddSetting not implemented")
+}
+func (UnimplementedFriendExtServer) GetFriendAddPolicy(context.Context, *GetFriendAddPolicyReq) (*GetFriendAddPolicyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFriendAddPolicy not implemented")
+}
+func (UnimplementedFriendExtServer) ApplyToAddFriendWithAnswer(context.Context, *ApplyToAddFriendWithAnswerReq) (*ApplyToAddFriendWithAnswerResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyToAddFriendWithAnswer not implemented")
+}
+func (UnimplementedFriendExtServer) ExpireFriendRequests(context.Context, *ExpireFriendRequestsReq) (*ExpireFriendRequestsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExpireFriendRequests not implemented")
+}
+func (UnimplementedFriendExtServer) GetFriendRequestSenders(context.Context, *GetFriendRequestSendersReq) (*GetFriendRequestSendersResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFriendRequestSenders not implemented")
+}
+func (UnimplementedFriendExtServer) GetContactDiscoverySalt(context.Context, *GetContactDiscoverySaltReq) (*GetContactDiscoverySaltResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetContactDiscoverySalt not implemented")
+}
+func (UnimplementedFriendExtServer) SetContactHashes(context.Context, *SetContactHashesReq) (*SetContactHashesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetContactHashes not implemented")
+}
+func (UnimplementedFriendExtServer) SetContactDiscoverySetting(context.Context, *SetContactDiscoverySettingReq) (*SetContactDiscoverySettingResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetContactDiscoverySetting not implemented")
+}
+func (UnimplementedFriendExtServer) GetContactDiscoverySetting(context.Context, *GetContactDiscoverySettingReq) (*GetContactDiscoverySettingResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetContactDiscoverySetting not implemented")
+}
+func (UnimplementedFriendExtServer) DiscoverContacts(context.Context, *DiscoverContactsReq) (*DiscoverContactsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiscoverContacts not implemented")
+}
+func (UnimplementedFriendExtServer) GetFriendSuggestions(context.Context, *GetFriendSuggestionsReq) (*GetFriendSuggestionsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFriendSuggestions not implemented")
+}
+func (UnimplementedFriendExtServer) DismissFriendSuggestion(context.Context, *DismissFriendSuggestionReq) (*DismissFriendSuggestionResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DismissFriendSuggestion not implemented")
+}
+func (UnimplementedFriendExtServer) RefreshFriendSuggestions(context.Context, *RefreshFriendSuggestionsReq) (*RefreshFriendSuggestionsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshFriendSuggestions not implemented")
+}
+func (UnimplementedFriendExtServer) PurgeUserRelations(context.Context, *PurgeUserRelationsReq) (*PurgeUserRelationsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeUserRelations not implemented")
+}
+
+// UnsafeFriendExtServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to FriendExtServer will
+// result in compilation errors.
+type UnsafeFriendExtServer interface {
+	mustEmbedUnimplementedFriendExtServer()
+}
+
+func RegisterFriendExtServer(s grpc.ServiceRegistrar, srv FriendExtServer) {
+	s.RegisterService(&FriendExt_ServiceDesc, srv)
+}
+
+func _FriendExt_CreateFriendGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateFriendGroupReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FriendExtServer).CreateFriendGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FriendExt_CreateFriendGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FriendExtServer).CreateFriendGroup(ctx, req.(*CreateFriendGroupReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FriendExt_SetFriendGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetFriendGroupReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FriendExtServer).SetFriendGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FriendExt_SetFriendGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FriendExtServer).SetFriendGroup(ctx, req.(*SetFriendGroupReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FriendExt_DeleteFriendGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteFriendGroupReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FriendExtServer).DeleteFriendGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FriendExt_DeleteFriendGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FriendExtServer).DeleteFriendGroup(ctx, req.(*DeleteFriendGroupReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FriendExt_SortFriendGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SortFriendGroupsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FriendExtServer).SortFriendGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FriendExt_SortFriendGroups_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FriendExtServer).SortFriendGroups(ctx, req.(*SortFriendGroupsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FriendExt_GetFriendGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFriendGroupsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FriendExtServer).GetFriendGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FriendExt_GetFriendGroups_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FriendExtServer).GetFriendGroups(ctx, req.(*GetFriendGroupsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FriendExt_AddFriendsToGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddFriendsToGroupReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FriendExtServer).AddFriendsToGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FriendExt_AddFriendsToGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FriendExtServer).AddFriendsToGroup(ctx, req.(*AddFriendsToGroupReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FriendExt_RemoveFriendsFromGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveFriendsFromGroupReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FriendExtServer).RemoveFriendsFromGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FriendExt_RemoveFriendsFromGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FriendExtServer).RemoveFriendsFromGroup(ctx, req.(*RemoveFriendsFromGroupReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FriendExt_MoveFriendsToGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveFriendsToGroupReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FriendExtServer).MoveFriendsToGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FriendExt_MoveFriendsToGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FriendExtServer).MoveFriendsToGroup(ctx, req.(*MoveFriendsToGroupReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FriendExt_GetFriendsGroupIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFriendsGroupIDsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FriendExtServer).GetFriendsGroupIDs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FriendExt_GetFriendsGroupIDs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FriendExtServer).GetFriendsGroupIDs(ctx, req.(*GetFriendsGroupIDsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FriendExt_GetPaginationGroupFriends_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPaginationGroupFriendsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FriendExtServer).GetPaginationGroupFriends(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FriendExt_GetPaginationGroupFriends_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FriendExtServer).GetPaginationGroupFriends(ctx, req.(*GetPaginationGroupFriendsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FriendExt_SetFriendAddSetting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetFriendAddSettingReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FriendExtServer).SetFriendAddSetting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FriendExt_SetFriendAddSetting_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FriendExtServer).SetFriendAddSetting(ctx, req.(*SetFriendAddSettingReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FriendExt_GetFriendAddSetting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFriendAddSettingReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FriendExtServer).GetFriendAddSetting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FriendExt_GetFriendAddSetting_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FriendExtServer).GetFriendAddSetting(ctx, req.(*GetFriendAddSettingReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FriendExt_GetFriendAddPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFriendAddPolicyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FriendExtServer).GetFriendAddPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FriendExt_GetFriendAddPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FriendExtServer).GetFriendAddPolicy(ctx, req.(*GetFriendAddPolicyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FriendExt_ApplyToAddFriendWithAnswer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyToAddFriendWithAnswerReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FriendExtServer).ApplyToAddFriendWithAnswer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FriendExt_ApplyToAddFriendWithAnswer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FriendExtServer).ApplyToAddFriendWithAnswer(ctx, req.(*ApplyToAddFriendWithAnswerReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FriendExt_ExpireFriendRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExpireFriendRequestsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FriendExtServer).ExpireFriendRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FriendExt_ExpireFriendRequests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FriendExtServer).ExpireFriendRequests(ctx, req.(*ExpireFriendRequestsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FriendExt_GetFriendRequestSenders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFriendRequestSendersReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FriendExtServer).GetFriendRequestSenders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FriendExt_GetFriendRequestSenders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FriendExtServer).GetFriendRequestSenders(ctx, req.(*GetFriendRequestSendersReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FriendExt_GetContactDiscoverySalt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetContactDiscoverySaltReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FriendExtServer).GetContactDiscoverySalt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FriendExt_GetContactDiscoverySalt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FriendExtServer).GetContactDiscoverySalt(ctx, req.(*GetContactDiscoverySaltReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FriendExt_SetContactHashes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetContactHashesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FriendExtServer).SetContactHashes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FriendExt_SetContactHashes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FriendExtServer).SetContactHashes(ctx, req.(*SetContactHashesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FriendExt_SetContactDiscoverySetting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetContactDiscoverySettingReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FriendExtServer).SetContactDiscoverySetting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FriendExt_SetContactDiscoverySetting_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FriendExtServer).SetContactDiscoverySetting(ctx, req.(*SetContactDiscoverySettingReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FriendExt_GetContactDiscoverySetting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetContactDiscoverySettingReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FriendExtServer).GetContactDiscoverySetting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FriendExt_GetContactDiscoverySetting_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FriendExtServer).GetContactDiscoverySetting(ctx, req.(*GetContactDiscoverySettingReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FriendExt_DiscoverContacts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiscoverContactsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FriendExtServer).DiscoverContacts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FriendExt_DiscoverContacts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FriendExtServer).DiscoverContacts(ctx, req.(*DiscoverContactsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FriendExt_GetFriendSuggestions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFriendSuggestionsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FriendExtServer).GetFriendSuggestions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FriendExt_GetFriendSuggestions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FriendExtServer).GetFriendSuggestions(ctx, req.(*GetFriendSuggestionsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FriendExt_DismissFriendSuggestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DismissFriendSuggestionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FriendExtServer).DismissFriendSuggestion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FriendExt_DismissFriendSuggestion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FriendExtServer).DismissFriendSuggestion(ctx, req.(*DismissFriendSuggestionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FriendExt_RefreshFriendSuggestions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshFriendSuggestionsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FriendExtServer).RefreshFriendSuggestions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FriendExt_RefreshFriendSuggestions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FriendExtServer).RefreshFriendSuggestions(ctx, req.(*RefreshFriendSuggestionsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FriendExt_PurgeUserRelations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeUserRelationsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FriendExtServer).PurgeUserRelations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FriendExt_PurgeUserRelations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FriendExtServer).PurgeUserRelations(ctx, req.(*PurgeUserRelationsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// FriendExt_ServiceDesc is the grpc.ServiceDesc for FriendExt service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var FriendExt_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "openim.friendext.friendExt",
+	HandlerType: (*FriendExtServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "createFriendGroup",
+			Handler:    _FriendExt_CreateFriendGroup_Handler,
+		},
+		{
+			MethodName: "setFriendGroup",
+			Handler:    _FriendExt_SetFriendGroup_Handler,
+		},
+		{
+			MethodName: "deleteFriendGroup",
+			Handler:    _FriendExt_DeleteFriendGroup_Handler,
+		},
+		{
+			MethodName: "sortFriendGroups",
+			Handler:    _FriendExt_SortFriendGroups_Handler,
+		},
+		{
+			MethodName: "getFriendGroups",
+			Handler:    _FriendExt_GetFriendGroups_Handler,
+		},
+		{
+			MethodName: "addFriendsToGroup",
+			Handler:    _FriendExt_AddFriendsToGroup_Handler,
+		},
+		{
+			MethodName: "removeFriendsFromGroup",
+			Handler:    _FriendExt_RemoveFriendsFromGroup_Handler,
+		},
+		{
+			MethodName: "moveFriendsToGroup",
+			Handler:    _FriendExt_MoveFriendsToGroup_Handler,
+		},
+		{
+			MethodName: "getFriendsGroupIDs",
+			Handler:    _FriendExt_GetFriendsGroupIDs_Handler,
+		},
+		{
+			MethodName: "getPaginationGroupFriends",
+			Handler:    _FriendExt_GetPaginationGroupFriends_Handler,
+		},
+		{
+			MethodName: "setFriendAddSetting",
+			Handler:    _FriendExt_SetFriendAddSetting_Handler,
+		},
+		{
+			MethodName: "getFriendAddSetting",
+			Handler:    _FriendExt_GetFriendAddSetting_Handler,
+		},
+		{
+			MethodName: "getFriendAddPolicy",
+			Handler:    _FriendExt_GetFriendAddPolicy_Handler,
+		},
+		{
+			MethodName: "applyToAddFriendWithAnswer",
+			Handler:    _FriendExt_ApplyToAddFriendWithAnswer_Handler,
+		},
+		{
+			MethodName: "expireFriendRequests",
+			Handler:    _FriendExt_ExpireFriendRequests_Handler,
+		},
+		{
+			MethodName: "getFriendRequestSenders",
+			Handler:    _FriendExt_GetFriendRequestSenders_Handler,
+		},
+		{
+			MethodName: "getContactDiscoverySalt",
+			Handler:    _FriendExt_GetContactDiscoverySalt_Handler,
+		},
+		{
+			MethodName: "setContactHashes",
+			Handler:    _FriendExt_SetContactHashes_Handler,
+		},
+		{
+			MethodName: "setContactDiscoverySetting",
+			Handler:    _FriendExt_SetContactDiscoverySetting_Handler,
+		},
+		{
+			MethodName: "getContactDiscoverySetting",
+			Handler:    _FriendExt_GetContactDiscoverySetting_Handler,
+		},
+		{
+			MethodName: "discoverContacts",
+			Handler:    _FriendExt_DiscoverContacts_Handler,
+		},
+		{
+			MethodName: "getFriendSuggestions",
+			Handler:    _FriendExt_GetFriendSuggestions_Handler,
+		},
+		{
+			MethodName: "dismissFriendSuggestion",
+			Handler:    _FriendExt_DismissFriendSuggestion_Handler,
+		},
+		{
+			MethodName: "refreshFriendSuggestions",
+			Handler:    _FriendExt_RefreshFriendSuggestions_Handler,
+		},
+		{
+			MethodName: "purgeUserRelations",
+			Handler:    _FriendExt_PurgeUserRelations_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "friendext/friendext.proto",
+}
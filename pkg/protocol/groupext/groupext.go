@@ -0,0 +1,452 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package groupext
+
+import (
+	"errors"
+
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/tools/utils/datautil"
+)
+
+// JoinByInviteLink is the join source of members that joined with an invite link, following constant.JoinByQRCode.
+const JoinByInviteLink = constant.JoinByQRCode + 1
+
+// JoinByCommunity is the join source of members that joined a channel through its community.
+const JoinByCommunity = JoinByInviteLink + 1
+
+// CommunityAnnouncementNotification is sent to every channel of a community, following constant.GroupInfoSetNameNotification.
+const CommunityAnnouncementNotification = constant.GroupInfoSetNameNotification + 1
+
+// GroupMsgPolicySetNotification carries the new msg policy of a group.
+const GroupMsgPolicySetNotification = CommunityAnnouncementNotification + 1
+
+// Types of the questions of a group join questionnaire.
+const (
+	JoinQuestionText = iota + 1
+	JoinQuestionSingleChoice
+	JoinQuestionMultipleChoice
+)
+
+// How a group join request was handled.
+const (
+	RequestHandledByAdmin = iota + 1
+	RequestAutoApproved
+	RequestExpired
+)
+
+// Orders of the public group directory.
+const (
+	DirectorySortByActivity = iota + 1
+	DirectorySortBySize
+	DirectorySortByNewest
+)
+
+func (x *CreateGroupRoleReq) Check() error {
+	if x.GroupID == "" {
+		return errors.New("groupID is empty")
+	}
+	if x.Name == "" {
+		return errors.New("name is empty")
+	}
+	return nil
+}
+
+func (x *UpdateGroupRoleReq) Check() error {
+	if x.GroupID == "" {
+		return errors.New("groupID is empty")
+	}
+	if x.Name == "" {
+		return errors.New("name is empty")
+	}
+	return nil
+}
+
+func (x *DeleteGroupRoleReq) Check() error {
+	if x.GroupID == "" {
+		return errors.New("groupID is empty")
+	}
+	return nil
+}
+
+func (x *GetGroupRolesReq) Check() error {
+	if x.GroupID == "" {
+		return errors.New("groupID is empty")
+	}
+	return nil
+}
+
+func (x *CreateGroupInviteLinkReq) Check() error {
+	if x.GroupID == "" {
+		return errors.New("groupID is empty")
+	}
+	if x.ExpireTime < 0 {
+		return errors.New("expireTime is invalid")
+	}
+	if x.MaxUses < 0 {
+		return errors.New("maxUses is invalid")
+	}
+	return nil
+}
+
+func (x *RevokeGroupInviteLinkReq) Check() error {
+	if x.Token == "" {
+		return errors.New("token is empty")
+	}
+	return nil
+}
+
+func (x *GetGroupInviteLinksReq) Check() error {
+	if x.GroupID == "" {
+		return errors.New("groupID is empty")
+	}
+	return nil
+}
+
+func (x *SetGroupMsgPolicyReq) Check() error {
+	if x.Policy == nil {
+		return errors.New("policy is empty")
+	}
+	if x.Policy.GroupID == "" {
+		return errors.New("groupID is empty")
+	}
+	if x.Policy.SlowModeSeconds < 0 {
+		return errors.New("slowModeSeconds is invalid")
+	}
+	if x.Policy.MaxMsgLength < 0 {
+		return errors.New("maxMsgLength is invalid")
+	}
+	return nil
+}
+
+func (x *GetGroupMsgPolicyReq) Check() error {
+	if x.GroupID == "" {
+		return errors.New("groupID is empty")
+	}
+	return nil
+}
+
+func (x *GroupJoinQuestion) Check() error {
+	if x.QuestionID == "" {
+		return errors.New("questionID is empty")
+	}
+	if x.Content == "" {
+		return errors.New("content is empty")
+	}
+	switch x.Type {
+	case JoinQuestionText:
+		if len(x.Options) > 0 {
+			return errors.New("text question has options")
+		}
+	case JoinQuestionSingleChoice, JoinQuestionMultipleChoice:
+		if len(x.Options) < 2 {
+			return errors.New("choice question needs at least two options")
+		}
+		if datautil.Duplicate(x.Options) {
+			return errors.New("options are duplicated")
+		}
+		for _, answer := range x.AcceptedAnswers {
+			if !datautil.Contain(answer, x.Options...) {
+				return errors.New("accepted answer is not an option")
+			}
+		}
+	default:
+		return errors.New("type is invalid")
+	}
+	return nil
+}
+
+func (x *SetGroupJoinQuestionnaireReq) Check() error {
+	if x.Questionnaire == nil {
+		return errors.New("questionnaire is empty")
+	}
+	if x.Questionnaire.GroupID == "" {
+		return errors.New("groupID is empty")
+	}
+	questionIDs := make([]string, 0, len(x.Questionnaire.Questions))
+	for _, question := range x.Questionnaire.Questions {
+		if question == nil {
+			return errors.New("question is empty")
+		}
+		if err := question.Check(); err != nil {
+			return err
+		}
+		questionIDs = append(questionIDs, question.QuestionID)
+	}
+	if datautil.Duplicate(questionIDs) {
+		return errors.New("questionID is duplicated")
+	}
+	return nil
+}
+
+func (x *GetGroupJoinQuestionnaireReq) Check() error {
+	if x.GroupID == "" {
+		return errors.New("groupID is empty")
+	}
+	return nil
+}
+
+func (x *ApplyJoinGroupReq) Check() error {
+	if x.GroupID == "" && x.InviteToken == "" {
+		return errors.New("groupID and inviteToken are empty")
+	}
+	for _, answer := range x.Answers {
+		if answer == nil || answer.QuestionID == "" {
+			return errors.New("questionID is empty")
+		}
+	}
+	return nil
+}
+
+func (x *GetGroupRequestAnswersReq) Check() error {
+	if x.GroupID == "" {
+		return errors.New("groupID is empty")
+	}
+	if len(x.UserIDs) == 0 {
+		return errors.New("userIDs is empty")
+	}
+	return nil
+}
+
+func (x *ExpireGroupRequestsReq) Check() error {
+	if x.Before <= 0 {
+		return errors.New("before is invalid")
+	}
+	return nil
+}
+
+func (x *GetGroupRequestAuditsReq) Check() error {
+	if x.GroupID == "" {
+		return errors.New("groupID is empty")
+	}
+	if x.Pagination == nil {
+		return errors.New("pagination is empty")
+	}
+	return nil
+}
+
+func (x *SetGroupDirectorySettingReq) Check() error {
+	if x.Setting == nil {
+		return errors.New("setting is empty")
+	}
+	if x.Setting.GroupID == "" {
+		return errors.New("groupID is empty")
+	}
+	if datautil.Duplicate(x.Setting.Categories) {
+		return errors.New("categories are duplicated")
+	}
+	return nil
+}
+
+func (x *GetGroupDirectorySettingReq) Check() error {
+	if x.GroupID == "" {
+		return errors.New("groupID is empty")
+	}
+	return nil
+}
+
+func (x *SearchGroupDirectoryReq) Check() error {
+	if x.Pagination == nil {
+		return errors.New("pagination is empty")
+	}
+	switch x.SortBy {
+	case 0, DirectorySortByActivity, DirectorySortBySize, DirectorySortByNewest:
+	default:
+		return errors.New("sortBy is invalid")
+	}
+	return nil
+}
+
+func (x *CreateCommunityReq) Check() error {
+	if x.Community == nil {
+		return errors.New("community is empty")
+	}
+	if x.Community.Name == "" {
+		return errors.New("name is empty")
+	}
+	return nil
+}
+
+func (x *SetCommunityInfoReq) Check() error {
+	if x.CommunityID == "" {
+		return errors.New("communityID is empty")
+	}
+	if x.Name == "" {
+		return errors.New("name is empty")
+	}
+	return nil
+}
+
+func (x *GetCommunitiesInfoReq) Check() error {
+	if len(x.CommunityIDs) == 0 {
+		return errors.New("communityIDs is empty")
+	}
+	return nil
+}
+
+func (x *DismissCommunityReq) Check() error {
+	if x.CommunityID == "" {
+		return errors.New("communityID is empty")
+	}
+	return nil
+}
+
+func (x *SetCommunityChannelReq) Check() error {
+	if x.CommunityID == "" {
+		return errors.New("communityID is empty")
+	}
+	if x.GroupID == "" {
+		return errors.New("groupID is empty")
+	}
+	return nil
+}
+
+func (x *RemoveCommunityChannelReq) Check() error {
+	if x.CommunityID == "" {
+		return errors.New("communityID is empty")
+	}
+	if x.GroupID == "" {
+		return errors.New("groupID is empty")
+	}
+	return nil
+}
+
+func (x *GetCommunityChannelsReq) Check() error {
+	if x.CommunityID == "" {
+		return errors.New("communityID is empty")
+	}
+	return nil
+}
+
+func (x *JoinCommunityReq) Check() error {
+	if x.CommunityID == "" {
+		return errors.New("communityID is empty")
+	}
+	return nil
+}
+
+func (x *InviteToCommunityReq) Check() error {
+	if x.CommunityID == "" {
+		return errors.New("communityID is empty")
+	}
+	if len(x.UserIDs) == 0 {
+		return errors.New("userIDs is empty")
+	}
+	if datautil.Duplicate(x.UserIDs) {
+		return errors.New("userIDs are duplicated")
+	}
+	return nil
+}
+
+func (x *JoinCommunityChannelReq) Check() error {
+	if x.GroupID == "" {
+		return errors.New("groupID is empty")
+	}
+	return nil
+}
+
+func (x *QuitCommunityReq) Check() error {
+	if x.CommunityID == "" {
+		return errors.New("communityID is empty")
+	}
+	return nil
+}
+
+func (x *KickCommunityMemberReq) Check() error {
+	if x.CommunityID == "" {
+		return errors.New("communityID is empty")
+	}
+	if len(x.UserIDs) == 0 {
+		return errors.New("userIDs is empty")
+	}
+	if datautil.Duplicate(x.UserIDs) {
+		return errors.New("userIDs are duplicated")
+	}
+	return nil
+}
+
+func (x *SetCommunityMemberRoleReq) Check() error {
+	if x.CommunityID == "" {
+		return errors.New("communityID is empty")
+	}
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	if x.RoleLevel != constant.GroupAdmin && x.RoleLevel != constant.GroupOrdinaryUsers {
+		return errors.New("roleLevel is invalid")
+	}
+	return nil
+}
+
+func (x *GetCommunityMembersReq) Check() error {
+	if x.CommunityID == "" {
+		return errors.New("communityID is empty")
+	}
+	if x.Pagination == nil {
+		return errors.New("pagination is empty")
+	}
+	return nil
+}
+
+func (x *SendCommunityAnnouncementReq) Check() error {
+	if x.CommunityID == "" {
+		return errors.New("communityID is empty")
+	}
+	if x.Content == "" {
+		return errors.New("content is empty")
+	}
+	return nil
+}
+
+func (x *GetGroupCommunityReq) Check() error {
+	if x.GroupID == "" {
+		return errors.New("groupID is empty")
+	}
+	return nil
+}
+
+func (x *GetCommunityMemberReq) Check() error {
+	if x.CommunityID == "" {
+		return errors.New("communityID is empty")
+	}
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	return nil
+}
+
+func (x *FindCommunityMemberIDsReq) Check() error {
+	if x.CommunityID == "" {
+		return errors.New("communityID is empty")
+	}
+	return nil
+}
+
+func (x *GetSharedGroupMembersReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	if x.Limit < 1 {
+		return errors.New("limit is invalid")
+	}
+	return nil
+}
+
+func (x *PurgeUserGroupsReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	return nil
+}
@@ -0,0 +1,7631 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.33.0
+// 	protoc        (unknown)
+// source: groupext/groupext.proto
+
+package groupext
+
+import (
+	sdkws "github.com/openimsdk/protocol/sdkws"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// A custom group role, members hold it when their roleLevel equals the role's roleLevel
+type GroupRole struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupID string `protobuf:"bytes,1,opt,name=groupID,proto3" json:"groupID"`
+	// Between the ordinary member and admin levels, unique within the group
+	RoleLevel int32  `protobuf:"varint,2,opt,name=roleLevel,proto3" json:"roleLevel"`
+	Name      string `protobuf:"bytes,3,opt,name=name,proto3" json:"name"`
+	// kick, mute, invite, editInfo, pin, sendDuringMute, approveJoin, atAll
+	Permissions []string `protobuf:"bytes,4,rep,name=permissions,proto3" json:"permissions"`
+	CreateTime  int64    `protobuf:"varint,5,opt,name=createTime,proto3" json:"createTime"`
+}
+
+func (x *GroupRole) Reset() {
+	*x = GroupRole{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GroupRole) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupRole) ProtoMessage() {}
+
+func (x *GroupRole) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupRole.ProtoReflect.Descriptor instead.
+func (*GroupRole) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GroupRole) GetGroupID() string {
+	if x != nil {
+		return x.GroupID
+	}
+	return ""
+}
+
+func (x *GroupRole) GetRoleLevel() int32 {
+	if x != nil {
+		return x.RoleLevel
+	}
+	return 0
+}
+
+func (x *GroupRole) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GroupRole) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+func (x *GroupRole) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+type CreateGroupRoleReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupID     string   `protobuf:"bytes,1,opt,name=groupID,proto3" json:"groupID"`
+	RoleLevel   int32    `protobuf:"varint,2,opt,name=roleLevel,proto3" json:"roleLevel"`
+	Name        string   `protobuf:"bytes,3,opt,name=name,proto3" json:"name"`
+	Permissions []string `protobuf:"bytes,4,rep,name=permissions,proto3" json:"permissions"`
+}
+
+func (x *CreateGroupRoleReq) Reset() {
+	*x = CreateGroupRoleReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateGroupRoleReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGroupRoleReq) ProtoMessage() {}
+
+func (x *CreateGroupRoleReq) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGroupRoleReq.ProtoReflect.Descriptor instead.
+func (*CreateGroupRoleReq) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateGroupRoleReq) GetGroupID() string {
+	if x != nil {
+		return x.GroupID
+	}
+	return ""
+}
+
+func (x *CreateGroupRoleReq) GetRoleLevel() int32 {
+	if x != nil {
+		return x.RoleLevel
+	}
+	return 0
+}
+
+func (x *CreateGroupRoleReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateGroupRoleReq) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+type CreateGroupRoleResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CreateGroupRoleResp) Reset() {
+	*x = CreateGroupRoleResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateGroupRoleResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGroupRoleResp) ProtoMessage() {}
+
+func (x *CreateGroupRoleResp) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGroupRoleResp.ProtoReflect.Descriptor instead.
+func (*CreateGroupRoleResp) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{2}
+}
+
+type UpdateGroupRoleReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupID     string   `protobuf:"bytes,1,opt,name=groupID,proto3" json:"groupID"`
+	RoleLevel   int32    `protobuf:"varint,2,opt,name=roleLevel,proto3" json:"roleLevel"`
+	Name        string   `protobuf:"bytes,3,opt,name=name,proto3" json:"name"`
+	Permissions []string `protobuf:"bytes,4,rep,name=permissions,proto3" json:"permissions"`
+}
+
+func (x *UpdateGroupRoleReq) Reset() {
+	*x = UpdateGroupRoleReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateGroupRoleReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGroupRoleReq) ProtoMessage() {}
+
+func (x *UpdateGroupRoleReq) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGroupRoleReq.ProtoReflect.Descriptor instead.
+func (*UpdateGroupRoleReq) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UpdateGroupRoleReq) GetGroupID() string {
+	if x != nil {
+		return x.GroupID
+	}
+	return ""
+}
+
+func (x *UpdateGroupRoleReq) GetRoleLevel() int32 {
+	if x != nil {
+		return x.RoleLevel
+	}
+	return 0
+}
+
+func (x *UpdateGroupRoleReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateGroupRoleReq) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+type UpdateGroupRoleResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UpdateGroupRoleResp) Reset() {
+	*x = UpdateGroupRoleResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateGroupRoleResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGroupRoleResp) ProtoMessage() {}
+
+func (x *UpdateGroupRoleResp) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGroupRoleResp.ProtoReflect.Descriptor instead.
+func (*UpdateGroupRoleResp) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{4}
+}
+
+type DeleteGroupRoleReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupID   string `protobuf:"bytes,1,opt,name=groupID,proto3" json:"groupID"`
+	RoleLevel int32  `protobuf:"varint,2,opt,name=roleLevel,proto3" json:"roleLevel"`
+}
+
+func (x *DeleteGroupRoleReq) Reset() {
+	*x = DeleteGroupRoleReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteGroupRoleReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteGroupRoleReq) ProtoMessage() {}
+
+func (x *DeleteGroupRoleReq) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteGroupRoleReq.ProtoReflect.Descriptor instead.
+func (*DeleteGroupRoleReq) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DeleteGroupRoleReq) GetGroupID() string {
+	if x != nil {
+		return x.GroupID
+	}
+	return ""
+}
+
+func (x *DeleteGroupRoleReq) GetRoleLevel() int32 {
+	if x != nil {
+		return x.RoleLevel
+	}
+	return 0
+}
+
+type DeleteGroupRoleResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteGroupRoleResp) Reset() {
+	*x = DeleteGroupRoleResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteGroupRoleResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteGroupRoleResp) ProtoMessage() {}
+
+func (x *DeleteGroupRoleResp) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteGroupRoleResp.ProtoReflect.Descriptor instead.
+func (*DeleteGroupRoleResp) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{6}
+}
+
+type GetGroupRolesReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupID string `protobuf:"bytes,1,opt,name=groupID,proto3" json:"groupID"`
+}
+
+func (x *GetGroupRolesReq) Reset() {
+	*x = GetGroupRolesReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGroupRolesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupRolesReq) ProtoMessage() {}
+
+func (x *GetGroupRolesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupRolesReq.ProtoReflect.Descriptor instead.
+func (*GetGroupRolesReq) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetGroupRolesReq) GetGroupID() string {
+	if x != nil {
+		return x.GroupID
+	}
+	return ""
+}
+
+type GetGroupRolesResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Roles []*GroupRole `protobuf:"bytes,1,rep,name=roles,proto3" json:"roles"`
+}
+
+func (x *GetGroupRolesResp) Reset() {
+	*x = GetGroupRolesResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGroupRolesResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupRolesResp) ProtoMessage() {}
+
+func (x *GetGroupRolesResp) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupRolesResp.ProtoReflect.Descriptor instead.
+func (*GetGroupRolesResp) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetGroupRolesResp) GetRoles() []*GroupRole {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+// A shareable link that lets users join a group without knowing its ID
+type GroupInviteLink struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token   string `protobuf:"bytes,1,opt,name=token,proto3" json:"token"`
+	GroupID string `protobuf:"bytes,2,opt,name=groupID,proto3" json:"groupID"`
+	// Recorded as the inviter of members joining with the link
+	CreatorUserID string `protobuf:"bytes,3,opt,name=creatorUserID,proto3" json:"creatorUserID"`
+	// Unix milliseconds, 0 means the link never expires
+	ExpireTime int64 `protobuf:"varint,4,opt,name=expireTime,proto3" json:"expireTime"`
+	// 0 means unlimited uses
+	MaxUses  int32 `protobuf:"varint,5,opt,name=maxUses,proto3" json:"maxUses"`
+	UseCount int32 `protobuf:"varint,6,opt,name=useCount,proto3" json:"useCount"`
+	// Join directly even when the group requires approval
+	BypassApproval bool  `protobuf:"varint,7,opt,name=bypassApproval,proto3" json:"bypassApproval"`
+	Revoked        bool  `protobuf:"varint,8,opt,name=revoked,proto3" json:"revoked"`
+	CreateTime     int64 `protobuf:"varint,9,opt,name=createTime,proto3" json:"createTime"`
+}
+
+func (x *GroupInviteLink) Reset() {
+	*x = GroupInviteLink{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GroupInviteLink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupInviteLink) ProtoMessage() {}
+
+func (x *GroupInviteLink) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupInviteLink.ProtoReflect.Descriptor instead.
+func (*GroupInviteLink) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GroupInviteLink) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *GroupInviteLink) GetGroupID() string {
+	if x != nil {
+		return x.GroupID
+	}
+	return ""
+}
+
+func (x *GroupInviteLink) GetCreatorUserID() string {
+	if x != nil {
+		return x.CreatorUserID
+	}
+	return ""
+}
+
+func (x *GroupInviteLink) GetExpireTime() int64 {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return 0
+}
+
+func (x *GroupInviteLink) GetMaxUses() int32 {
+	if x != nil {
+		return x.MaxUses
+	}
+	return 0
+}
+
+func (x *GroupInviteLink) GetUseCount() int32 {
+	if x != nil {
+		return x.UseCount
+	}
+	return 0
+}
+
+func (x *GroupInviteLink) GetBypassApproval() bool {
+	if x != nil {
+		return x.BypassApproval
+	}
+	return false
+}
+
+func (x *GroupInviteLink) GetRevoked() bool {
+	if x != nil {
+		return x.Revoked
+	}
+	return false
+}
+
+func (x *GroupInviteLink) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+type CreateGroupInviteLinkReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupID        string `protobuf:"bytes,1,opt,name=groupID,proto3" json:"groupID"`
+	ExpireTime     int64  `protobuf:"varint,2,opt,name=expireTime,proto3" json:"expireTime"`
+	MaxUses        int32  `protobuf:"varint,3,opt,name=maxUses,proto3" json:"maxUses"`
+	BypassApproval bool   `protobuf:"varint,4,opt,name=bypassApproval,proto3" json:"bypassApproval"`
+}
+
+func (x *CreateGroupInviteLinkReq) Reset() {
+	*x = CreateGroupInviteLinkReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateGroupInviteLinkReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGroupInviteLinkReq) ProtoMessage() {}
+
+func (x *CreateGroupInviteLinkReq) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGroupInviteLinkReq.ProtoReflect.Descriptor instead.
+func (*CreateGroupInviteLinkReq) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CreateGroupInviteLinkReq) GetGroupID() string {
+	if x != nil {
+		return x.GroupID
+	}
+	return ""
+}
+
+func (x *CreateGroupInviteLinkReq) GetExpireTime() int64 {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return 0
+}
+
+func (x *CreateGroupInviteLinkReq) GetMaxUses() int32 {
+	if x != nil {
+		return x.MaxUses
+	}
+	return 0
+}
+
+func (x *CreateGroupInviteLinkReq) GetBypassApproval() bool {
+	if x != nil {
+		return x.BypassApproval
+	}
+	return false
+}
+
+type CreateGroupInviteLinkResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Link *GroupInviteLink `protobuf:"bytes,1,opt,name=link,proto3" json:"link"`
+}
+
+func (x *CreateGroupInviteLinkResp) Reset() {
+	*x = CreateGroupInviteLinkResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateGroupInviteLinkResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGroupInviteLinkResp) ProtoMessage() {}
+
+func (x *CreateGroupInviteLinkResp) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGroupInviteLinkResp.ProtoReflect.Descriptor instead.
+func (*CreateGroupInviteLinkResp) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CreateGroupInviteLinkResp) GetLink() *GroupInviteLink {
+	if x != nil {
+		return x.Link
+	}
+	return nil
+}
+
+type RevokeGroupInviteLinkReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token"`
+}
+
+func (x *RevokeGroupInviteLinkReq) Reset() {
+	*x = RevokeGroupInviteLinkReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevokeGroupInviteLinkReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeGroupInviteLinkReq) ProtoMessage() {}
+
+func (x *RevokeGroupInviteLinkReq) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeGroupInviteLinkReq.ProtoReflect.Descriptor instead.
+func (*RevokeGroupInviteLinkReq) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RevokeGroupInviteLinkReq) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type RevokeGroupInviteLinkResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RevokeGroupInviteLinkResp) Reset() {
+	*x = RevokeGroupInviteLinkResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevokeGroupInviteLinkResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeGroupInviteLinkResp) ProtoMessage() {}
+
+func (x *RevokeGroupInviteLinkResp) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeGroupInviteLinkResp.ProtoReflect.Descriptor instead.
+func (*RevokeGroupInviteLinkResp) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{13}
+}
+
+type GetGroupInviteLinksReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupID string `protobuf:"bytes,1,opt,name=groupID,proto3" json:"groupID"`
+}
+
+func (x *GetGroupInviteLinksReq) Reset() {
+	*x = GetGroupInviteLinksReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGroupInviteLinksReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupInviteLinksReq) ProtoMessage() {}
+
+func (x *GetGroupInviteLinksReq) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupInviteLinksReq.ProtoReflect.Descriptor instead.
+func (*GetGroupInviteLinksReq) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetGroupInviteLinksReq) GetGroupID() string {
+	if x != nil {
+		return x.GroupID
+	}
+	return ""
+}
+
+type GetGroupInviteLinksResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Links []*GroupInviteLink `protobuf:"bytes,1,rep,name=links,proto3" json:"links"`
+}
+
+func (x *GetGroupInviteLinksResp) Reset() {
+	*x = GetGroupInviteLinksResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGroupInviteLinksResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupInviteLinksResp) ProtoMessage() {}
+
+func (x *GetGroupInviteLinksResp) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupInviteLinksResp.ProtoReflect.Descriptor instead.
+func (*GetGroupInviteLinksResp) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetGroupInviteLinksResp) GetLinks() []*GroupInviteLink {
+	if x != nil {
+		return x.Links
+	}
+	return nil
+}
+
+// Throttling of the messages members send to a group, owners and admins are exempt
+type GroupMsgPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupID string `protobuf:"bytes,1,opt,name=groupID,proto3" json:"groupID"`
+	// Minimum seconds between two messages of a member, 0 disables slow mode
+	SlowModeSeconds int32 `protobuf:"varint,2,opt,name=slowModeSeconds,proto3" json:"slowModeSeconds"`
+	// Maximum characters of text messages, 0 means no limit
+	MaxMsgLength  int32 `protobuf:"varint,3,opt,name=maxMsgLength,proto3" json:"maxMsgLength"`
+	DisallowLinks bool  `protobuf:"varint,4,opt,name=disallowLinks,proto3" json:"disallowLinks"`
+	// Pictures, voices, videos and files
+	DisallowAttachments bool `protobuf:"varint,5,opt,name=disallowAttachments,proto3" json:"disallowAttachments"`
+}
+
+func (x *GroupMsgPolicy) Reset() {
+	*x = GroupMsgPolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GroupMsgPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupMsgPolicy) ProtoMessage() {}
+
+func (x *GroupMsgPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupMsgPolicy.ProtoReflect.Descriptor instead.
+func (*GroupMsgPolicy) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GroupMsgPolicy) GetGroupID() string {
+	if x != nil {
+		return x.GroupID
+	}
+	return ""
+}
+
+func (x *GroupMsgPolicy) GetSlowModeSeconds() int32 {
+	if x != nil {
+		return x.SlowModeSeconds
+	}
+	return 0
+}
+
+func (x *GroupMsgPolicy) GetMaxMsgLength() int32 {
+	if x != nil {
+		return x.MaxMsgLength
+	}
+	return 0
+}
+
+func (x *GroupMsgPolicy) GetDisallowLinks() bool {
+	if x != nil {
+		return x.DisallowLinks
+	}
+	return false
+}
+
+func (x *GroupMsgPolicy) GetDisallowAttachments() bool {
+	if x != nil {
+		return x.DisallowAttachments
+	}
+	return false
+}
+
+type SetGroupMsgPolicyReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Policy *GroupMsgPolicy `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy"`
+}
+
+func (x *SetGroupMsgPolicyReq) Reset() {
+	*x = SetGroupMsgPolicyReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetGroupMsgPolicyReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetGroupMsgPolicyReq) ProtoMessage() {}
+
+func (x *SetGroupMsgPolicyReq) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetGroupMsgPolicyReq.ProtoReflect.Descriptor instead.
+func (*SetGroupMsgPolicyReq) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *SetGroupMsgPolicyReq) GetPolicy() *GroupMsgPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+type SetGroupMsgPolicyResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetGroupMsgPolicyResp) Reset() {
+	*x = SetGroupMsgPolicyResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetGroupMsgPolicyResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetGroupMsgPolicyResp) ProtoMessage() {}
+
+func (x *SetGroupMsgPolicyResp) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetGroupMsgPolicyResp.ProtoReflect.Descriptor instead.
+func (*SetGroupMsgPolicyResp) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{18}
+}
+
+type GetGroupMsgPolicyReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupID string `protobuf:"bytes,1,opt,name=groupID,proto3" json:"groupID"`
+}
+
+func (x *GetGroupMsgPolicyReq) Reset() {
+	*x = GetGroupMsgPolicyReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGroupMsgPolicyReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupMsgPolicyReq) ProtoMessage() {}
+
+func (x *GetGroupMsgPolicyReq) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupMsgPolicyReq.ProtoReflect.Descriptor instead.
+func (*GetGroupMsgPolicyReq) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetGroupMsgPolicyReq) GetGroupID() string {
+	if x != nil {
+		return x.GroupID
+	}
+	return ""
+}
+
+type GetGroupMsgPolicyResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Policy *GroupMsgPolicy `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy"`
+}
+
+func (x *GetGroupMsgPolicyResp) Reset() {
+	*x = GetGroupMsgPolicyResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGroupMsgPolicyResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupMsgPolicyResp) ProtoMessage() {}
+
+func (x *GetGroupMsgPolicyResp) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupMsgPolicyResp.ProtoReflect.Descriptor instead.
+func (*GetGroupMsgPolicyResp) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetGroupMsgPolicyResp) GetPolicy() *GroupMsgPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+// Detail of the group msg policy set notification
+type GroupMsgPolicySetTips struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Policy *GroupMsgPolicy            `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy"`
+	OpUser *sdkws.GroupMemberFullInfo `protobuf:"bytes,2,opt,name=opUser,proto3" json:"opUser"`
+}
+
+func (x *GroupMsgPolicySetTips) Reset() {
+	*x = GroupMsgPolicySetTips{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GroupMsgPolicySetTips) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupMsgPolicySetTips) ProtoMessage() {}
+
+func (x *GroupMsgPolicySetTips) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupMsgPolicySetTips.ProtoReflect.Descriptor instead.
+func (*GroupMsgPolicySetTips) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GroupMsgPolicySetTips) GetPolicy() *GroupMsgPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+func (x *GroupMsgPolicySetTips) GetOpUser() *sdkws.GroupMemberFullInfo {
+	if x != nil {
+		return x.OpUser
+	}
+	return nil
+}
+
+// A question applicants answer when requesting to join a group
+type GroupJoinQuestion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	QuestionID string `protobuf:"bytes,1,opt,name=questionID,proto3" json:"questionID"`
+	// 1 text, 2 single choice, 3 multiple choice
+	Type    int32  `protobuf:"varint,2,opt,name=type,proto3" json:"type"`
+	Content string `protobuf:"bytes,3,opt,name=content,proto3" json:"content"`
+	// The options of a choice question
+	Options  []string `protobuf:"bytes,4,rep,name=options,proto3" json:"options"`
+	Required bool     `protobuf:"varint,5,opt,name=required,proto3" json:"required"`
+	// Answers accepted by auto approval, case insensitive for text questions, empty accepts any answer
+	AcceptedAnswers []string `protobuf:"bytes,6,rep,name=acceptedAnswers,proto3" json:"acceptedAnswers"`
+}
+
+func (x *GroupJoinQuestion) Reset() {
+	*x = GroupJoinQuestion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GroupJoinQuestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupJoinQuestion) ProtoMessage() {}
+
+func (x *GroupJoinQuestion) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupJoinQuestion.ProtoReflect.Descriptor instead.
+func (*GroupJoinQuestion) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GroupJoinQuestion) GetQuestionID() string {
+	if x != nil {
+		return x.QuestionID
+	}
+	return ""
+}
+
+func (x *GroupJoinQuestion) GetType() int32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *GroupJoinQuestion) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *GroupJoinQuestion) GetOptions() []string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *GroupJoinQuestion) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *GroupJoinQuestion) GetAcceptedAnswers() []string {
+	if x != nil {
+		return x.AcceptedAnswers
+	}
+	return nil
+}
+
+type GroupJoinAnswer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	QuestionID string `protobuf:"bytes,1,opt,name=questionID,proto3" json:"questionID"`
+	// The text of a text question or the chosen options of a choice question
+	Values []string `protobuf:"bytes,2,rep,name=values,proto3" json:"values"`
+}
+
+func (x *GroupJoinAnswer) Reset() {
+	*x = GroupJoinAnswer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GroupJoinAnswer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupJoinAnswer) ProtoMessage() {}
+
+func (x *GroupJoinAnswer) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupJoinAnswer.ProtoReflect.Descriptor instead.
+func (*GroupJoinAnswer) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GroupJoinAnswer) GetQuestionID() string {
+	if x != nil {
+		return x.QuestionID
+	}
+	return ""
+}
+
+func (x *GroupJoinAnswer) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type GroupJoinQuestionnaire struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupID   string               `protobuf:"bytes,1,opt,name=groupID,proto3" json:"groupID"`
+	Questions []*GroupJoinQuestion `protobuf:"bytes,2,rep,name=questions,proto3" json:"questions"`
+	// Approve join requests whose answers all are accepted without waiting for an admin
+	AutoApprove bool `protobuf:"varint,3,opt,name=autoApprove,proto3" json:"autoApprove"`
+}
+
+func (x *GroupJoinQuestionnaire) Reset() {
+	*x = GroupJoinQuestionnaire{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GroupJoinQuestionnaire) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupJoinQuestionnaire) ProtoMessage() {}
+
+func (x *GroupJoinQuestionnaire) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupJoinQuestionnaire.ProtoReflect.Descriptor instead.
+func (*GroupJoinQuestionnaire) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GroupJoinQuestionnaire) GetGroupID() string {
+	if x != nil {
+		return x.GroupID
+	}
+	return ""
+}
+
+func (x *GroupJoinQuestionnaire) GetQuestions() []*GroupJoinQuestion {
+	if x != nil {
+		return x.Questions
+	}
+	return nil
+}
+
+func (x *GroupJoinQuestionnaire) GetAutoApprove() bool {
+	if x != nil {
+		return x.AutoApprove
+	}
+	return false
+}
+
+type SetGroupJoinQuestionnaireReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Questionnaire *GroupJoinQuestionnaire `protobuf:"bytes,1,opt,name=questionnaire,proto3" json:"questionnaire"`
+}
+
+func (x *SetGroupJoinQuestionnaireReq) Reset() {
+	*x = SetGroupJoinQuestionnaireReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetGroupJoinQuestionnaireReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetGroupJoinQuestionnaireReq) ProtoMessage() {}
+
+func (x *SetGroupJoinQuestionnaireReq) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetGroupJoinQuestionnaireReq.ProtoReflect.Descriptor instead.
+func (*SetGroupJoinQuestionnaireReq) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *SetGroupJoinQuestionnaireReq) GetQuestionnaire() *GroupJoinQuestionnaire {
+	if x != nil {
+		return x.Questionnaire
+	}
+	return nil
+}
+
+type SetGroupJoinQuestionnaireResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetGroupJoinQuestionnaireResp) Reset() {
+	*x = SetGroupJoinQuestionnaireResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetGroupJoinQuestionnaireResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetGroupJoinQuestionnaireResp) ProtoMessage() {}
+
+func (x *SetGroupJoinQuestionnaireResp) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetGroupJoinQuestionnaireResp.ProtoReflect.Descriptor instead.
+func (*SetGroupJoinQuestionnaireResp) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{26}
+}
+
+type GetGroupJoinQuestionnaireReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupID string `protobuf:"bytes,1,opt,name=groupID,proto3" json:"groupID"`
+}
+
+func (x *GetGroupJoinQuestionnaireReq) Reset() {
+	*x = GetGroupJoinQuestionnaireReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGroupJoinQuestionnaireReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupJoinQuestionnaireReq) ProtoMessage() {}
+
+func (x *GetGroupJoinQuestionnaireReq) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupJoinQuestionnaireReq.ProtoReflect.Descriptor instead.
+func (*GetGroupJoinQuestionnaireReq) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetGroupJoinQuestionnaireReq) GetGroupID() string {
+	if x != nil {
+		return x.GroupID
+	}
+	return ""
+}
+
+type GetGroupJoinQuestionnaireResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Questionnaire *GroupJoinQuestionnaire `protobuf:"bytes,1,opt,name=questionnaire,proto3" json:"questionnaire"`
+}
+
+func (x *GetGroupJoinQuestionnaireResp) Reset() {
+	*x = GetGroupJoinQuestionnaireResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGroupJoinQuestionnaireResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupJoinQuestionnaireResp) ProtoMessage() {}
+
+func (x *GetGroupJoinQuestionnaireResp) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupJoinQuestionnaireResp.ProtoReflect.Descriptor instead.
+func (*GetGroupJoinQuestionnaireResp) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetGroupJoinQuestionnaireResp) GetQuestionnaire() *GroupJoinQuestionnaire {
+	if x != nil {
+		return x.Questionnaire
+	}
+	return nil
+}
+
+type ApplyJoinGroupReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// May be empty when joining with an invite link
+	GroupID    string             `protobuf:"bytes,1,opt,name=groupID,proto3" json:"groupID"`
+	ReqMessage string             `protobuf:"bytes,2,opt,name=reqMessage,proto3" json:"reqMessage"`
+	Ex         string             `protobuf:"bytes,3,opt,name=ex,proto3" json:"ex"`
+	Answers    []*GroupJoinAnswer `protobuf:"bytes,4,rep,name=answers,proto3" json:"answers"`
+	// Token of an invite link, the join is an invitation from the link creator with the JoinByInviteLink source
+	InviteToken string `protobuf:"bytes,5,opt,name=inviteToken,proto3" json:"inviteToken"`
+}
+
+func (x *ApplyJoinGroupReq) Reset() {
+	*x = ApplyJoinGroupReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApplyJoinGroupReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyJoinGroupReq) ProtoMessage() {}
+
+func (x *ApplyJoinGroupReq) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyJoinGroupReq.ProtoReflect.Descriptor instead.
+func (*ApplyJoinGroupReq) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ApplyJoinGroupReq) GetGroupID() string {
+	if x != nil {
+		return x.GroupID
+	}
+	return ""
+}
+
+func (x *ApplyJoinGroupReq) GetReqMessage() string {
+	if x != nil {
+		return x.ReqMessage
+	}
+	return ""
+}
+
+func (x *ApplyJoinGroupReq) GetEx() string {
+	if x != nil {
+		return x.Ex
+	}
+	return ""
+}
+
+func (x *ApplyJoinGroupReq) GetAnswers() []*GroupJoinAnswer {
+	if x != nil {
+		return x.Answers
+	}
+	return nil
+}
+
+func (x *ApplyJoinGroupReq) GetInviteToken() string {
+	if x != nil {
+		return x.InviteToken
+	}
+	return ""
+}
+
+type ApplyJoinGroupResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// False when the join request waits for approval
+	Joined  bool   `protobuf:"varint,1,opt,name=joined,proto3" json:"joined"`
+	GroupID string `protobuf:"bytes,2,opt,name=groupID,proto3" json:"groupID"`
+}
+
+func (x *ApplyJoinGroupResp) Reset() {
+	*x = ApplyJoinGroupResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApplyJoinGroupResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyJoinGroupResp) ProtoMessage() {}
+
+func (x *ApplyJoinGroupResp) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyJoinGroupResp.ProtoReflect.Descriptor instead.
+func (*ApplyJoinGroupResp) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ApplyJoinGroupResp) GetJoined() bool {
+	if x != nil {
+		return x.Joined
+	}
+	return false
+}
+
+func (x *ApplyJoinGroupResp) GetGroupID() string {
+	if x != nil {
+		return x.GroupID
+	}
+	return ""
+}
+
+type GroupRequestAnswers struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID  string             `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	Answers []*GroupJoinAnswer `protobuf:"bytes,2,rep,name=answers,proto3" json:"answers"`
+}
+
+func (x *GroupRequestAnswers) Reset() {
+	*x = GroupRequestAnswers{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GroupRequestAnswers) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupRequestAnswers) ProtoMessage() {}
+
+func (x *GroupRequestAnswers) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupRequestAnswers.ProtoReflect.Descriptor instead.
+func (*GroupRequestAnswers) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GroupRequestAnswers) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *GroupRequestAnswers) GetAnswers() []*GroupJoinAnswer {
+	if x != nil {
+		return x.Answers
+	}
+	return nil
+}
+
+type GetGroupRequestAnswersReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupID string   `protobuf:"bytes,1,opt,name=groupID,proto3" json:"groupID"`
+	UserIDs []string `protobuf:"bytes,2,rep,name=userIDs,proto3" json:"userIDs"`
+}
+
+func (x *GetGroupRequestAnswersReq) Reset() {
+	*x = GetGroupRequestAnswersReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGroupRequestAnswersReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupRequestAnswersReq) ProtoMessage() {}
+
+func (x *GetGroupRequestAnswersReq) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupRequestAnswersReq.ProtoReflect.Descriptor instead.
+func (*GetGroupRequestAnswersReq) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetGroupRequestAnswersReq) GetGroupID() string {
+	if x != nil {
+		return x.GroupID
+	}
+	return ""
+}
+
+func (x *GetGroupRequestAnswersReq) GetUserIDs() []string {
+	if x != nil {
+		return x.UserIDs
+	}
+	return nil
+}
+
+type GetGroupRequestAnswersResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Requests []*GroupRequestAnswers `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests"`
+}
+
+func (x *GetGroupRequestAnswersResp) Reset() {
+	*x = GetGroupRequestAnswersResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGroupRequestAnswersResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupRequestAnswersResp) ProtoMessage() {}
+
+func (x *GetGroupRequestAnswersResp) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupRequestAnswersResp.ProtoReflect.Descriptor instead.
+func (*GetGroupRequestAnswersResp) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetGroupRequestAnswersResp) GetRequests() []*GroupRequestAnswers {
+	if x != nil {
+		return x.Requests
+	}
+	return nil
+}
+
+type ExpireGroupRequestsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Unix milliseconds, pending requests sent before it are refused
+	Before int64 `protobuf:"varint,1,opt,name=before,proto3" json:"before"`
+}
+
+func (x *ExpireGroupRequestsReq) Reset() {
+	*x = ExpireGroupRequestsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExpireGroupRequestsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpireGroupRequestsReq) ProtoMessage() {}
+
+func (x *ExpireGroupRequestsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpireGroupRequestsReq.ProtoReflect.Descriptor instead.
+func (*ExpireGroupRequestsReq) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ExpireGroupRequestsReq) GetBefore() int64 {
+	if x != nil {
+		return x.Before
+	}
+	return 0
+}
+
+type ExpireGroupRequestsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count int32 `protobuf:"varint,1,opt,name=count,proto3" json:"count"`
+}
+
+func (x *ExpireGroupRequestsResp) Reset() {
+	*x = ExpireGroupRequestsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExpireGroupRequestsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpireGroupRequestsResp) ProtoMessage() {}
+
+func (x *ExpireGroupRequestsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpireGroupRequestsResp.ProtoReflect.Descriptor instead.
+func (*ExpireGroupRequestsResp) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ExpireGroupRequestsResp) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// Who handled a join request and how
+type GroupRequestAudit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupID string `protobuf:"bytes,1,opt,name=groupID,proto3" json:"groupID"`
+	UserID  string `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID"`
+	// Empty for requests handled by the server
+	HandleUserID string `protobuf:"bytes,3,opt,name=handleUserID,proto3" json:"handleUserID"`
+	HandleResult int32  `protobuf:"varint,4,opt,name=handleResult,proto3" json:"handleResult"`
+	HandledMsg   string `protobuf:"bytes,5,opt,name=handledMsg,proto3" json:"handledMsg"`
+	// 1 handled by an admin, 2 auto approved, 3 expired
+	HandleType  int32 `protobuf:"varint,6,opt,name=handleType,proto3" json:"handleType"`
+	HandledTime int64 `protobuf:"varint,7,opt,name=handledTime,proto3" json:"handledTime"`
+}
+
+func (x *GroupRequestAudit) Reset() {
+	*x = GroupRequestAudit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GroupRequestAudit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupRequestAudit) ProtoMessage() {}
+
+func (x *GroupRequestAudit) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupRequestAudit.ProtoReflect.Descriptor instead.
+func (*GroupRequestAudit) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GroupRequestAudit) GetGroupID() string {
+	if x != nil {
+		return x.GroupID
+	}
+	return ""
+}
+
+func (x *GroupRequestAudit) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *GroupRequestAudit) GetHandleUserID() string {
+	if x != nil {
+		return x.HandleUserID
+	}
+	return ""
+}
+
+func (x *GroupRequestAudit) GetHandleResult() int32 {
+	if x != nil {
+		return x.HandleResult
+	}
+	return 0
+}
+
+func (x *GroupRequestAudit) GetHandledMsg() string {
+	if x != nil {
+		return x.HandledMsg
+	}
+	return ""
+}
+
+func (x *GroupRequestAudit) GetHandleType() int32 {
+	if x != nil {
+		return x.HandleType
+	}
+	return 0
+}
+
+func (x *GroupRequestAudit) GetHandledTime() int64 {
+	if x != nil {
+		return x.HandledTime
+	}
+	return 0
+}
+
+type GetGroupRequestAuditsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupID    string                   `protobuf:"bytes,1,opt,name=groupID,proto3" json:"groupID"`
+	Pagination *sdkws.RequestPagination `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination"`
+}
+
+func (x *GetGroupRequestAuditsReq) Reset() {
+	*x = GetGroupRequestAuditsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGroupRequestAuditsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupRequestAuditsReq) ProtoMessage() {}
+
+func (x *GetGroupRequestAuditsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupRequestAuditsReq.ProtoReflect.Descriptor instead.
+func (*GetGroupRequestAuditsReq) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetGroupRequestAuditsReq) GetGroupID() string {
+	if x != nil {
+		return x.GroupID
+	}
+	return ""
+}
+
+func (x *GetGroupRequestAuditsReq) GetPagination() *sdkws.RequestPagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type GetGroupRequestAuditsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total  int64                `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
+	Audits []*GroupRequestAudit `protobuf:"bytes,2,rep,name=audits,proto3" json:"audits"`
+}
+
+func (x *GetGroupRequestAuditsResp) Reset() {
+	*x = GetGroupRequestAuditsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGroupRequestAuditsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupRequestAuditsResp) ProtoMessage() {}
+
+func (x *GetGroupRequestAuditsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupRequestAuditsResp.ProtoReflect.Descriptor instead.
+func (*GetGroupRequestAuditsResp) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetGroupRequestAuditsResp) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetGroupRequestAuditsResp) GetAudits() []*GroupRequestAudit {
+	if x != nil {
+		return x.Audits
+	}
+	return nil
+}
+
+// How a group is shown in the public group directory
+type GroupDirectorySetting struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupID string `protobuf:"bytes,1,opt,name=groupID,proto3" json:"groupID"`
+	// Unlisted groups are private and never returned by searchGroupDirectory
+	Listed      bool     `protobuf:"varint,2,opt,name=listed,proto3" json:"listed"`
+	Categories  []string `protobuf:"bytes,3,rep,name=categories,proto3" json:"categories"`
+	Description string   `protobuf:"bytes,4,opt,name=description,proto3" json:"description"`
+}
+
+func (x *GroupDirectorySetting) Reset() {
+	*x = GroupDirectorySetting{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GroupDirectorySetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupDirectorySetting) ProtoMessage() {}
+
+func (x *GroupDirectorySetting) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupDirectorySetting.ProtoReflect.Descriptor instead.
+func (*GroupDirectorySetting) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GroupDirectorySetting) GetGroupID() string {
+	if x != nil {
+		return x.GroupID
+	}
+	return ""
+}
+
+func (x *GroupDirectorySetting) GetListed() bool {
+	if x != nil {
+		return x.Listed
+	}
+	return false
+}
+
+func (x *GroupDirectorySetting) GetCategories() []string {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *GroupDirectorySetting) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type SetGroupDirectorySettingReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Setting *GroupDirectorySetting `protobuf:"bytes,1,opt,name=setting,proto3" json:"setting"`
+}
+
+func (x *SetGroupDirectorySettingReq) Reset() {
+	*x = SetGroupDirectorySettingReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetGroupDirectorySettingReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetGroupDirectorySettingReq) ProtoMessage() {}
+
+func (x *SetGroupDirectorySettingReq) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetGroupDirectorySettingReq.ProtoReflect.Descriptor instead.
+func (*SetGroupDirectorySettingReq) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *SetGroupDirectorySettingReq) GetSetting() *GroupDirectorySetting {
+	if x != nil {
+		return x.Setting
+	}
+	return nil
+}
+
+type SetGroupDirectorySettingResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetGroupDirectorySettingResp) Reset() {
+	*x = SetGroupDirectorySettingResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetGroupDirectorySettingResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetGroupDirectorySettingResp) ProtoMessage() {}
+
+func (x *SetGroupDirectorySettingResp) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetGroupDirectorySettingResp.ProtoReflect.Descriptor instead.
+func (*SetGroupDirectorySettingResp) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{41}
+}
+
+type GetGroupDirectorySettingReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupID string `protobuf:"bytes,1,opt,name=groupID,proto3" json:"groupID"`
+}
+
+func (x *GetGroupDirectorySettingReq) Reset() {
+	*x = GetGroupDirectorySettingReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGroupDirectorySettingReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupDirectorySettingReq) ProtoMessage() {}
+
+func (x *GetGroupDirectorySettingReq) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupDirectorySettingReq.ProtoReflect.Descriptor instead.
+func (*GetGroupDirectorySettingReq) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetGroupDirectorySettingReq) GetGroupID() string {
+	if x != nil {
+		return x.GroupID
+	}
+	return ""
+}
+
+type GetGroupDirectorySettingResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Setting *GroupDirectorySetting `protobuf:"bytes,1,opt,name=setting,proto3" json:"setting"`
+}
+
+func (x *GetGroupDirectorySettingResp) Reset() {
+	*x = GetGroupDirectorySettingResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGroupDirectorySettingResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupDirectorySettingResp) ProtoMessage() {}
+
+func (x *GetGroupDirectorySettingResp) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupDirectorySettingResp.ProtoReflect.Descriptor instead.
+func (*GetGroupDirectorySettingResp) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetGroupDirectorySettingResp) GetSetting() *GroupDirectorySetting {
+	if x != nil {
+		return x.Setting
+	}
+	return nil
+}
+
+type GetGroupDirectoryCategoriesReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetGroupDirectoryCategoriesReq) Reset() {
+	*x = GetGroupDirectoryCategoriesReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGroupDirectoryCategoriesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupDirectoryCategoriesReq) ProtoMessage() {}
+
+func (x *GetGroupDirectoryCategoriesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupDirectoryCategoriesReq.ProtoReflect.Descriptor instead.
+func (*GetGroupDirectoryCategoriesReq) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{44}
+}
+
+type GetGroupDirectoryCategoriesResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Categories []string `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories"`
+}
+
+func (x *GetGroupDirectoryCategoriesResp) Reset() {
+	*x = GetGroupDirectoryCategoriesResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGroupDirectoryCategoriesResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupDirectoryCategoriesResp) ProtoMessage() {}
+
+func (x *GetGroupDirectoryCategoriesResp) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupDirectoryCategoriesResp.ProtoReflect.Descriptor instead.
+func (*GetGroupDirectoryCategoriesResp) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GetGroupDirectoryCategoriesResp) GetCategories() []string {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+type DirectoryGroup struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupID     string   `protobuf:"bytes,1,opt,name=groupID,proto3" json:"groupID"`
+	GroupName   string   `protobuf:"bytes,2,opt,name=groupName,proto3" json:"groupName"`
+	FaceURL     string   `protobuf:"bytes,3,opt,name=faceURL,proto3" json:"faceURL"`
+	Description string   `protobuf:"bytes,4,opt,name=description,proto3" json:"description"`
+	Categories  []string `protobuf:"bytes,5,rep,name=categories,proto3" json:"categories"`
+	MemberCount uint32   `protobuf:"varint,6,opt,name=memberCount,proto3" json:"memberCount"`
+	// The needVerification of the group
+	JoinPolicy int32 `protobuf:"varint,7,opt,name=joinPolicy,proto3" json:"joinPolicy"`
+	// Messages sent to the group within the configured activity window
+	Activity   int64 `protobuf:"varint,8,opt,name=activity,proto3" json:"activity"`
+	CreateTime int64 `protobuf:"varint,9,opt,name=createTime,proto3" json:"createTime"`
+}
+
+func (x *DirectoryGroup) Reset() {
+	*x = DirectoryGroup{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DirectoryGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DirectoryGroup) ProtoMessage() {}
+
+func (x *DirectoryGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DirectoryGroup.ProtoReflect.Descriptor instead.
+func (*DirectoryGroup) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *DirectoryGroup) GetGroupID() string {
+	if x != nil {
+		return x.GroupID
+	}
+	return ""
+}
+
+func (x *DirectoryGroup) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
+}
+
+func (x *DirectoryGroup) GetFaceURL() string {
+	if x != nil {
+		return x.FaceURL
+	}
+	return ""
+}
+
+func (x *DirectoryGroup) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *DirectoryGroup) GetCategories() []string {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *DirectoryGroup) GetMemberCount() uint32 {
+	if x != nil {
+		return x.MemberCount
+	}
+	return 0
+}
+
+func (x *DirectoryGroup) GetJoinPolicy() int32 {
+	if x != nil {
+		return x.JoinPolicy
+	}
+	return 0
+}
+
+func (x *DirectoryGroup) GetActivity() int64 {
+	if x != nil {
+		return x.Activity
+	}
+	return 0
+}
+
+func (x *DirectoryGroup) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+type SearchGroupDirectoryReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Matches the group name and the directory description
+	Keyword  string `protobuf:"bytes,1,opt,name=keyword,proto3" json:"keyword"`
+	Category string `protobuf:"bytes,2,opt,name=category,proto3" json:"category"`
+	// 1 activity, 2 member count, 3 newest first
+	SortBy     int32                    `protobuf:"varint,3,opt,name=sortBy,proto3" json:"sortBy"`
+	Pagination *sdkws.RequestPagination `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination"`
+}
+
+func (x *SearchGroupDirectoryReq) Reset() {
+	*x = SearchGroupDirectoryReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchGroupDirectoryReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchGroupDirectoryReq) ProtoMessage() {}
+
+func (x *SearchGroupDirectoryReq) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchGroupDirectoryReq.ProtoReflect.Descriptor instead.
+func (*SearchGroupDirectoryReq) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *SearchGroupDirectoryReq) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
+}
+
+func (x *SearchGroupDirectoryReq) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *SearchGroupDirectoryReq) GetSortBy() int32 {
+	if x != nil {
+		return x.SortBy
+	}
+	return 0
+}
+
+func (x *SearchGroupDirectoryReq) GetPagination() *sdkws.RequestPagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type SearchGroupDirectoryResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total  int64             `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
+	Groups []*DirectoryGroup `protobuf:"bytes,2,rep,name=groups,proto3" json:"groups"`
+}
+
+func (x *SearchGroupDirectoryResp) Reset() {
+	*x = SearchGroupDirectoryResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchGroupDirectoryResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchGroupDirectoryResp) ProtoMessage() {}
+
+func (x *SearchGroupDirectoryResp) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchGroupDirectoryResp.ProtoReflect.Descriptor instead.
+func (*SearchGroupDirectoryResp) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *SearchGroupDirectoryResp) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *SearchGroupDirectoryResp) GetGroups() []*DirectoryGroup {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+type RefreshGroupDirectoryReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RefreshGroupDirectoryReq) Reset() {
+	*x = RefreshGroupDirectoryReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RefreshGroupDirectoryReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshGroupDirectoryReq) ProtoMessage() {}
+
+func (x *RefreshGroupDirectoryReq) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshGroupDirectoryReq.ProtoReflect.Descriptor instead.
+func (*RefreshGroupDirectoryReq) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{49}
+}
+
+type RefreshGroupDirectoryResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count int32 `protobuf:"varint,1,opt,name=count,proto3" json:"count"`
+}
+
+func (x *RefreshGroupDirectoryResp) Reset() {
+	*x = RefreshGroupDirectoryResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RefreshGroupDirectoryResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshGroupDirectoryResp) ProtoMessage() {}
+
+func (x *RefreshGroupDirectoryResp) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshGroupDirectoryResp.ProtoReflect.Descriptor instead.
+func (*RefreshGroupDirectoryResp) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *RefreshGroupDirectoryResp) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// A space grouping several groups, its channels, under one membership
+type Community struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommunityID  string `protobuf:"bytes,1,opt,name=communityID,proto3" json:"communityID"`
+	Name         string `protobuf:"bytes,2,opt,name=name,proto3" json:"name"`
+	FaceURL      string `protobuf:"bytes,3,opt,name=faceURL,proto3" json:"faceURL"`
+	Introduction string `protobuf:"bytes,4,opt,name=introduction,proto3" json:"introduction"`
+	OwnerUserID  string `protobuf:"bytes,5,opt,name=ownerUserID,proto3" json:"ownerUserID"`
+	// Users can only be invited by the community admins
+	InviteOnly    bool   `protobuf:"varint,6,opt,name=inviteOnly,proto3" json:"inviteOnly"`
+	CreatorUserID string `protobuf:"bytes,7,opt,name=creatorUserID,proto3" json:"creatorUserID"`
+	CreateTime    int64  `protobuf:"varint,8,opt,name=createTime,proto3" json:"createTime"`
+	Ex            string `protobuf:"bytes,9,opt,name=ex,proto3" json:"ex"`
+	MemberCount   uint32 `protobuf:"varint,10,opt,name=memberCount,proto3" json:"memberCount"`
+}
+
+func (x *Community) Reset() {
+	*x = Community{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Community) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Community) ProtoMessage() {}
+
+func (x *Community) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Community.ProtoReflect.Descriptor instead.
+func (*Community) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *Community) GetCommunityID() string {
+	if x != nil {
+		return x.CommunityID
+	}
+	return ""
+}
+
+func (x *Community) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Community) GetFaceURL() string {
+	if x != nil {
+		return x.FaceURL
+	}
+	return ""
+}
+
+func (x *Community) GetIntroduction() string {
+	if x != nil {
+		return x.Introduction
+	}
+	return ""
+}
+
+func (x *Community) GetOwnerUserID() string {
+	if x != nil {
+		return x.OwnerUserID
+	}
+	return ""
+}
+
+func (x *Community) GetInviteOnly() bool {
+	if x != nil {
+		return x.InviteOnly
+	}
+	return false
+}
+
+func (x *Community) GetCreatorUserID() string {
+	if x != nil {
+		return x.CreatorUserID
+	}
+	return ""
+}
+
+func (x *Community) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+func (x *Community) GetEx() string {
+	if x != nil {
+		return x.Ex
+	}
+	return ""
+}
+
+func (x *Community) GetMemberCount() uint32 {
+	if x != nil {
+		return x.MemberCount
+	}
+	return 0
+}
+
+// Community members use the role levels of group members
+type CommunityMember struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommunityID   string `protobuf:"bytes,1,opt,name=communityID,proto3" json:"communityID"`
+	UserID        string `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID"`
+	RoleLevel     int32  `protobuf:"varint,3,opt,name=roleLevel,proto3" json:"roleLevel"`
+	JoinTime      int64  `protobuf:"varint,4,opt,name=joinTime,proto3" json:"joinTime"`
+	InviterUserID string `protobuf:"bytes,5,opt,name=inviterUserID,proto3" json:"inviterUserID"`
+}
+
+func (x *CommunityMember) Reset() {
+	*x = CommunityMember{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommunityMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommunityMember) ProtoMessage() {}
+
+func (x *CommunityMember) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommunityMember.ProtoReflect.Descriptor instead.
+func (*CommunityMember) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *CommunityMember) GetCommunityID() string {
+	if x != nil {
+		return x.CommunityID
+	}
+	return ""
+}
+
+func (x *CommunityMember) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *CommunityMember) GetRoleLevel() int32 {
+	if x != nil {
+		return x.RoleLevel
+	}
+	return 0
+}
+
+func (x *CommunityMember) GetJoinTime() int64 {
+	if x != nil {
+		return x.JoinTime
+	}
+	return 0
+}
+
+func (x *CommunityMember) GetInviterUserID() string {
+	if x != nil {
+		return x.InviterUserID
+	}
+	return ""
+}
+
+type CommunityChannel struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupID   string `protobuf:"bytes,1,opt,name=groupID,proto3" json:"groupID"`
+	GroupName string `protobuf:"bytes,2,opt,name=groupName,proto3" json:"groupName"`
+	FaceURL   string `protobuf:"bytes,3,opt,name=faceURL,proto3" json:"faceURL"`
+	// Community members join public channels without approval
+	Public bool `protobuf:"varint,4,opt,name=public,proto3" json:"public"`
+	Joined bool `protobuf:"varint,5,opt,name=joined,proto3" json:"joined"`
+}
+
+func (x *CommunityChannel) Reset() {
+	*x = CommunityChannel{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommunityChannel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommunityChannel) ProtoMessage() {}
+
+func (x *CommunityChannel) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommunityChannel.ProtoReflect.Descriptor instead.
+func (*CommunityChannel) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *CommunityChannel) GetGroupID() string {
+	if x != nil {
+		return x.GroupID
+	}
+	return ""
+}
+
+func (x *CommunityChannel) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
+}
+
+func (x *CommunityChannel) GetFaceURL() string {
+	if x != nil {
+		return x.FaceURL
+	}
+	return ""
+}
+
+func (x *CommunityChannel) GetPublic() bool {
+	if x != nil {
+		return x.Public
+	}
+	return false
+}
+
+func (x *CommunityChannel) GetJoined() bool {
+	if x != nil {
+		return x.Joined
+	}
+	return false
+}
+
+type CreateCommunityReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Community *Community `protobuf:"bytes,1,opt,name=community,proto3" json:"community"`
+}
+
+func (x *CreateCommunityReq) Reset() {
+	*x = CreateCommunityReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateCommunityReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCommunityReq) ProtoMessage() {}
+
+func (x *CreateCommunityReq) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCommunityReq.ProtoReflect.Descriptor instead.
+func (*CreateCommunityReq) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *CreateCommunityReq) GetCommunity() *Community {
+	if x != nil {
+		return x.Community
+	}
+	return nil
+}
+
+type CreateCommunityResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Community *Community `protobuf:"bytes,1,opt,name=community,proto3" json:"community"`
+}
+
+func (x *CreateCommunityResp) Reset() {
+	*x = CreateCommunityResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateCommunityResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCommunityResp) ProtoMessage() {}
+
+func (x *CreateCommunityResp) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCommunityResp.ProtoReflect.Descriptor instead.
+func (*CreateCommunityResp) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *CreateCommunityResp) GetCommunity() *Community {
+	if x != nil {
+		return x.Community
+	}
+	return nil
+}
+
+type SetCommunityInfoReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommunityID  string `protobuf:"bytes,1,opt,name=communityID,proto3" json:"communityID"`
+	Name         string `protobuf:"bytes,2,opt,name=name,proto3" json:"name"`
+	FaceURL      string `protobuf:"bytes,3,opt,name=faceURL,proto3" json:"faceURL"`
+	Introduction string `protobuf:"bytes,4,opt,name=introduction,proto3" json:"introduction"`
+	InviteOnly   bool   `protobuf:"varint,5,opt,name=inviteOnly,proto3" json:"inviteOnly"`
+	Ex           string `protobuf:"bytes,6,opt,name=ex,proto3" json:"ex"`
+}
+
+func (x *SetCommunityInfoReq) Reset() {
+	*x = SetCommunityInfoReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetCommunityInfoReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCommunityInfoReq) ProtoMessage() {}
+
+func (x *SetCommunityInfoReq) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCommunityInfoReq.ProtoReflect.Descriptor instead.
+func (*SetCommunityInfoReq) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *SetCommunityInfoReq) GetCommunityID() string {
+	if x != nil {
+		return x.CommunityID
+	}
+	return ""
+}
+
+func (x *SetCommunityInfoReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SetCommunityInfoReq) GetFaceURL() string {
+	if x != nil {
+		return x.FaceURL
+	}
+	return ""
+}
+
+func (x *SetCommunityInfoReq) GetIntroduction() string {
+	if x != nil {
+		return x.Introduction
+	}
+	return ""
+}
+
+func (x *SetCommunityInfoReq) GetInviteOnly() bool {
+	if x != nil {
+		return x.InviteOnly
+	}
+	return false
+}
+
+func (x *SetCommunityInfoReq) GetEx() string {
+	if x != nil {
+		return x.Ex
+	}
+	return ""
+}
+
+type SetCommunityInfoResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetCommunityInfoResp) Reset() {
+	*x = SetCommunityInfoResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetCommunityInfoResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCommunityInfoResp) ProtoMessage() {}
+
+func (x *SetCommunityInfoResp) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCommunityInfoResp.ProtoReflect.Descriptor instead.
+func (*SetCommunityInfoResp) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{57}
+}
+
+type GetCommunitiesInfoReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommunityIDs []string `protobuf:"bytes,1,rep,name=communityIDs,proto3" json:"communityIDs"`
+}
+
+func (x *GetCommunitiesInfoReq) Reset() {
+	*x = GetCommunitiesInfoReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCommunitiesInfoReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCommunitiesInfoReq) ProtoMessage() {}
+
+func (x *GetCommunitiesInfoReq) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCommunitiesInfoReq.ProtoReflect.Descriptor instead.
+func (*GetCommunitiesInfoReq) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *GetCommunitiesInfoReq) GetCommunityIDs() []string {
+	if x != nil {
+		return x.CommunityIDs
+	}
+	return nil
+}
+
+type GetCommunitiesInfoResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Communities []*Community `protobuf:"bytes,1,rep,name=communities,proto3" json:"communities"`
+}
+
+func (x *GetCommunitiesInfoResp) Reset() {
+	*x = GetCommunitiesInfoResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCommunitiesInfoResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCommunitiesInfoResp) ProtoMessage() {}
+
+func (x *GetCommunitiesInfoResp) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCommunitiesInfoResp.ProtoReflect.Descriptor instead.
+func (*GetCommunitiesInfoResp) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *GetCommunitiesInfoResp) GetCommunities() []*Community {
+	if x != nil {
+		return x.Communities
+	}
+	return nil
+}
+
+type DismissCommunityReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommunityID string `protobuf:"bytes,1,opt,name=communityID,proto3" json:"communityID"`
+}
+
+func (x *DismissCommunityReq) Reset() {
+	*x = DismissCommunityReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DismissCommunityReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DismissCommunityReq) ProtoMessage() {}
+
+func (x *DismissCommunityReq) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DismissCommunityReq.ProtoReflect.Descriptor instead.
+func (*DismissCommunityReq) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *DismissCommunityReq) GetCommunityID() string {
+	if x != nil {
+		return x.CommunityID
+	}
+	return ""
+}
+
+type DismissCommunityResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DismissCommunityResp) Reset() {
+	*x = DismissCommunityResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DismissCommunityResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DismissCommunityResp) ProtoMessage() {}
+
+func (x *DismissCommunityResp) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DismissCommunityResp.ProtoReflect.Descriptor instead.
+func (*DismissCommunityResp) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{61}
+}
+
+type SetCommunityChannelReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommunityID string `protobuf:"bytes,1,opt,name=communityID,proto3" json:"communityID"`
+	GroupID     string `protobuf:"bytes,2,opt,name=groupID,proto3" json:"groupID"`
+	Public      bool   `protobuf:"varint,3,opt,name=public,proto3" json:"public"`
+}
+
+func (x *SetCommunityChannelReq) Reset() {
+	*x = SetCommunityChannelReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetCommunityChannelReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCommunityChannelReq) ProtoMessage() {}
+
+func (x *SetCommunityChannelReq) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCommunityChannelReq.ProtoReflect.Descriptor instead.
+func (*SetCommunityChannelReq) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *SetCommunityChannelReq) GetCommunityID() string {
+	if x != nil {
+		return x.CommunityID
+	}
+	return ""
+}
+
+func (x *SetCommunityChannelReq) GetGroupID() string {
+	if x != nil {
+		return x.GroupID
+	}
+	return ""
+}
+
+func (x *SetCommunityChannelReq) GetPublic() bool {
+	if x != nil {
+		return x.Public
+	}
+	return false
+}
+
+type SetCommunityChannelResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetCommunityChannelResp) Reset() {
+	*x = SetCommunityChannelResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetCommunityChannelResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCommunityChannelResp) ProtoMessage() {}
+
+func (x *SetCommunityChannelResp) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCommunityChannelResp.ProtoReflect.Descriptor instead.
+func (*SetCommunityChannelResp) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{63}
+}
+
+type RemoveCommunityChannelReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommunityID string `protobuf:"bytes,1,opt,name=communityID,proto3" json:"communityID"`
+	GroupID     string `protobuf:"bytes,2,opt,name=groupID,proto3" json:"groupID"`
+}
+
+func (x *RemoveCommunityChannelReq) Reset() {
+	*x = RemoveCommunityChannelReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveCommunityChannelReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveCommunityChannelReq) ProtoMessage() {}
+
+func (x *RemoveCommunityChannelReq) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveCommunityChannelReq.ProtoReflect.Descriptor instead.
+func (*RemoveCommunityChannelReq) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *RemoveCommunityChannelReq) GetCommunityID() string {
+	if x != nil {
+		return x.CommunityID
+	}
+	return ""
+}
+
+func (x *RemoveCommunityChannelReq) GetGroupID() string {
+	if x != nil {
+		return x.GroupID
+	}
+	return ""
+}
+
+type RemoveCommunityChannelResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RemoveCommunityChannelResp) Reset() {
+	*x = RemoveCommunityChannelResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveCommunityChannelResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveCommunityChannelResp) ProtoMessage() {}
+
+func (x *RemoveCommunityChannelResp) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveCommunityChannelResp.ProtoReflect.Descriptor instead.
+func (*RemoveCommunityChannelResp) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{65}
+}
+
+type GetCommunityChannelsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommunityID string `protobuf:"bytes,1,opt,name=communityID,proto3" json:"communityID"`
+}
+
+func (x *GetCommunityChannelsReq) Reset() {
+	*x = GetCommunityChannelsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCommunityChannelsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCommunityChannelsReq) ProtoMessage() {}
+
+func (x *GetCommunityChannelsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCommunityChannelsReq.ProtoReflect.Descriptor instead.
+func (*GetCommunityChannelsReq) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *GetCommunityChannelsReq) GetCommunityID() string {
+	if x != nil {
+		return x.CommunityID
+	}
+	return ""
+}
+
+type GetCommunityChannelsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Channels []*CommunityChannel `protobuf:"bytes,1,rep,name=channels,proto3" json:"channels"`
+}
+
+func (x *GetCommunityChannelsResp) Reset() {
+	*x = GetCommunityChannelsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCommunityChannelsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCommunityChannelsResp) ProtoMessage() {}
+
+func (x *GetCommunityChannelsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCommunityChannelsResp.ProtoReflect.Descriptor instead.
+func (*GetCommunityChannelsResp) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *GetCommunityChannelsResp) GetChannels() []*CommunityChannel {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+type JoinCommunityReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommunityID string `protobuf:"bytes,1,opt,name=communityID,proto3" json:"communityID"`
+}
+
+func (x *JoinCommunityReq) Reset() {
+	*x = JoinCommunityReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JoinCommunityReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinCommunityReq) ProtoMessage() {}
+
+func (x *JoinCommunityReq) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinCommunityReq.ProtoReflect.Descriptor instead.
+func (*JoinCommunityReq) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *JoinCommunityReq) GetCommunityID() string {
+	if x != nil {
+		return x.CommunityID
+	}
+	return ""
+}
+
+type JoinCommunityResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *JoinCommunityResp) Reset() {
+	*x = JoinCommunityResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JoinCommunityResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinCommunityResp) ProtoMessage() {}
+
+func (x *JoinCommunityResp) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinCommunityResp.ProtoReflect.Descriptor instead.
+func (*JoinCommunityResp) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{69}
+}
+
+type InviteToCommunityReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommunityID string   `protobuf:"bytes,1,opt,name=communityID,proto3" json:"communityID"`
+	UserIDs     []string `protobuf:"bytes,2,rep,name=userIDs,proto3" json:"userIDs"`
+}
+
+func (x *InviteToCommunityReq) Reset() {
+	*x = InviteToCommunityReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InviteToCommunityReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteToCommunityReq) ProtoMessage() {}
+
+func (x *InviteToCommunityReq) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InviteToCommunityReq.ProtoReflect.Descriptor instead.
+func (*InviteToCommunityReq) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *InviteToCommunityReq) GetCommunityID() string {
+	if x != nil {
+		return x.CommunityID
+	}
+	return ""
+}
+
+func (x *InviteToCommunityReq) GetUserIDs() []string {
+	if x != nil {
+		return x.UserIDs
+	}
+	return nil
+}
+
+type InviteToCommunityResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *InviteToCommunityResp) Reset() {
+	*x = InviteToCommunityResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InviteToCommunityResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteToCommunityResp) ProtoMessage() {}
+
+func (x *InviteToCommunityResp) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InviteToCommunityResp.ProtoReflect.Descriptor instead.
+func (*InviteToCommunityResp) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{71}
+}
+
+type JoinCommunityChannelReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupID string `protobuf:"bytes,1,opt,name=groupID,proto3" json:"groupID"`
+}
+
+func (x *JoinCommunityChannelReq) Reset() {
+	*x = JoinCommunityChannelReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JoinCommunityChannelReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinCommunityChannelReq) ProtoMessage() {}
+
+func (x *JoinCommunityChannelReq) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinCommunityChannelReq.ProtoReflect.Descriptor instead.
+func (*JoinCommunityChannelReq) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *JoinCommunityChannelReq) GetGroupID() string {
+	if x != nil {
+		return x.GroupID
+	}
+	return ""
+}
+
+type JoinCommunityChannelResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *JoinCommunityChannelResp) Reset() {
+	*x = JoinCommunityChannelResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JoinCommunityChannelResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinCommunityChannelResp) ProtoMessage() {}
+
+func (x *JoinCommunityChannelResp) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinCommunityChannelResp.ProtoReflect.Descriptor instead.
+func (*JoinCommunityChannelResp) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{73}
+}
+
+type QuitCommunityReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommunityID string `protobuf:"bytes,1,opt,name=communityID,proto3" json:"communityID"`
+}
+
+func (x *QuitCommunityReq) Reset() {
+	*x = QuitCommunityReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuitCommunityReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuitCommunityReq) ProtoMessage() {}
+
+func (x *QuitCommunityReq) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuitCommunityReq.ProtoReflect.Descriptor instead.
+func (*QuitCommunityReq) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *QuitCommunityReq) GetCommunityID() string {
+	if x != nil {
+		return x.CommunityID
+	}
+	return ""
+}
+
+type QuitCommunityResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *QuitCommunityResp) Reset() {
+	*x = QuitCommunityResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuitCommunityResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuitCommunityResp) ProtoMessage() {}
+
+func (x *QuitCommunityResp) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuitCommunityResp.ProtoReflect.Descriptor instead.
+func (*QuitCommunityResp) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{75}
+}
+
+type KickCommunityMemberReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommunityID string   `protobuf:"bytes,1,opt,name=communityID,proto3" json:"communityID"`
+	UserIDs     []string `protobuf:"bytes,2,rep,name=userIDs,proto3" json:"userIDs"`
+}
+
+func (x *KickCommunityMemberReq) Reset() {
+	*x = KickCommunityMemberReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KickCommunityMemberReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KickCommunityMemberReq) ProtoMessage() {}
+
+func (x *KickCommunityMemberReq) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KickCommunityMemberReq.ProtoReflect.Descriptor instead.
+func (*KickCommunityMemberReq) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *KickCommunityMemberReq) GetCommunityID() string {
+	if x != nil {
+		return x.CommunityID
+	}
+	return ""
+}
+
+func (x *KickCommunityMemberReq) GetUserIDs() []string {
+	if x != nil {
+		return x.UserIDs
+	}
+	return nil
+}
+
+type KickCommunityMemberResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *KickCommunityMemberResp) Reset() {
+	*x = KickCommunityMemberResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KickCommunityMemberResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KickCommunityMemberResp) ProtoMessage() {}
+
+func (x *KickCommunityMemberResp) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KickCommunityMemberResp.ProtoReflect.Descriptor instead.
+func (*KickCommunityMemberResp) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{77}
+}
+
+type SetCommunityMemberRoleReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommunityID string `protobuf:"bytes,1,opt,name=communityID,proto3" json:"communityID"`
+	UserID      string `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID"`
+	RoleLevel   int32  `protobuf:"varint,3,opt,name=roleLevel,proto3" json:"roleLevel"`
+}
+
+func (x *SetCommunityMemberRoleReq) Reset() {
+	*x = SetCommunityMemberRoleReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetCommunityMemberRoleReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCommunityMemberRoleReq) ProtoMessage() {}
+
+func (x *SetCommunityMemberRoleReq) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCommunityMemberRoleReq.ProtoReflect.Descriptor instead.
+func (*SetCommunityMemberRoleReq) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *SetCommunityMemberRoleReq) GetCommunityID() string {
+	if x != nil {
+		return x.CommunityID
+	}
+	return ""
+}
+
+func (x *SetCommunityMemberRoleReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *SetCommunityMemberRoleReq) GetRoleLevel() int32 {
+	if x != nil {
+		return x.RoleLevel
+	}
+	return 0
+}
+
+type SetCommunityMemberRoleResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetCommunityMemberRoleResp) Reset() {
+	*x = SetCommunityMemberRoleResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetCommunityMemberRoleResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCommunityMemberRoleResp) ProtoMessage() {}
+
+func (x *SetCommunityMemberRoleResp) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCommunityMemberRoleResp.ProtoReflect.Descriptor instead.
+func (*SetCommunityMemberRoleResp) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{79}
+}
+
+type GetCommunityMembersReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommunityID string                   `protobuf:"bytes,1,opt,name=communityID,proto3" json:"communityID"`
+	Pagination  *sdkws.RequestPagination `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination"`
+}
+
+func (x *GetCommunityMembersReq) Reset() {
+	*x = GetCommunityMembersReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCommunityMembersReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCommunityMembersReq) ProtoMessage() {}
+
+func (x *GetCommunityMembersReq) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCommunityMembersReq.ProtoReflect.Descriptor instead.
+func (*GetCommunityMembersReq) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *GetCommunityMembersReq) GetCommunityID() string {
+	if x != nil {
+		return x.CommunityID
+	}
+	return ""
+}
+
+func (x *GetCommunityMembersReq) GetPagination() *sdkws.RequestPagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type GetCommunityMembersResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total   int64              `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
+	Members []*CommunityMember `protobuf:"bytes,2,rep,name=members,proto3" json:"members"`
+}
+
+func (x *GetCommunityMembersResp) Reset() {
+	*x = GetCommunityMembersResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCommunityMembersResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCommunityMembersResp) ProtoMessage() {}
+
+func (x *GetCommunityMembersResp) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCommunityMembersResp.ProtoReflect.Descriptor instead.
+func (*GetCommunityMembersResp) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *GetCommunityMembersResp) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetCommunityMembersResp) GetMembers() []*CommunityMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type GetJoinedCommunitiesReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetJoinedCommunitiesReq) Reset() {
+	*x = GetJoinedCommunitiesReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetJoinedCommunitiesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJoinedCommunitiesReq) ProtoMessage() {}
+
+func (x *GetJoinedCommunitiesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJoinedCommunitiesReq.ProtoReflect.Descriptor instead.
+func (*GetJoinedCommunitiesReq) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{82}
+}
+
+type GetJoinedCommunitiesResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Communities []*Community `protobuf:"bytes,1,rep,name=communities,proto3" json:"communities"`
+}
+
+func (x *GetJoinedCommunitiesResp) Reset() {
+	*x = GetJoinedCommunitiesResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetJoinedCommunitiesResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJoinedCommunitiesResp) ProtoMessage() {}
+
+func (x *GetJoinedCommunitiesResp) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJoinedCommunitiesResp.ProtoReflect.Descriptor instead.
+func (*GetJoinedCommunitiesResp) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *GetJoinedCommunitiesResp) GetCommunities() []*Community {
+	if x != nil {
+		return x.Communities
+	}
+	return nil
+}
+
+type SendCommunityAnnouncementReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommunityID string `protobuf:"bytes,1,opt,name=communityID,proto3" json:"communityID"`
+	Content     string `protobuf:"bytes,2,opt,name=content,proto3" json:"content"`
+}
+
+func (x *SendCommunityAnnouncementReq) Reset() {
+	*x = SendCommunityAnnouncementReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendCommunityAnnouncementReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendCommunityAnnouncementReq) ProtoMessage() {}
+
+func (x *SendCommunityAnnouncementReq) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendCommunityAnnouncementReq.ProtoReflect.Descriptor instead.
+func (*SendCommunityAnnouncementReq) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *SendCommunityAnnouncementReq) GetCommunityID() string {
+	if x != nil {
+		return x.CommunityID
+	}
+	return ""
+}
+
+func (x *SendCommunityAnnouncementReq) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+type SendCommunityAnnouncementResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Number of channels the announcement was sent to
+	Count int32 `protobuf:"varint,1,opt,name=count,proto3" json:"count"`
+}
+
+func (x *SendCommunityAnnouncementResp) Reset() {
+	*x = SendCommunityAnnouncementResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendCommunityAnnouncementResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendCommunityAnnouncementResp) ProtoMessage() {}
+
+func (x *SendCommunityAnnouncementResp) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendCommunityAnnouncementResp.ProtoReflect.Descriptor instead.
+func (*SendCommunityAnnouncementResp) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *SendCommunityAnnouncementResp) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type CommunityAnnouncementTips struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommunityID   string `protobuf:"bytes,1,opt,name=communityID,proto3" json:"communityID"`
+	CommunityName string `protobuf:"bytes,2,opt,name=communityName,proto3" json:"communityName"`
+	OpUserID      string `protobuf:"bytes,3,opt,name=opUserID,proto3" json:"opUserID"`
+	Content       string `protobuf:"bytes,4,opt,name=content,proto3" json:"content"`
+	SendTime      int64  `protobuf:"varint,5,opt,name=sendTime,proto3" json:"sendTime"`
+}
+
+func (x *CommunityAnnouncementTips) Reset() {
+	*x = CommunityAnnouncementTips{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommunityAnnouncementTips) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommunityAnnouncementTips) ProtoMessage() {}
+
+func (x *CommunityAnnouncementTips) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommunityAnnouncementTips.ProtoReflect.Descriptor instead.
+func (*CommunityAnnouncementTips) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *CommunityAnnouncementTips) GetCommunityID() string {
+	if x != nil {
+		return x.CommunityID
+	}
+	return ""
+}
+
+func (x *CommunityAnnouncementTips) GetCommunityName() string {
+	if x != nil {
+		return x.CommunityName
+	}
+	return ""
+}
+
+func (x *CommunityAnnouncementTips) GetOpUserID() string {
+	if x != nil {
+		return x.OpUserID
+	}
+	return ""
+}
+
+func (x *CommunityAnnouncementTips) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *CommunityAnnouncementTips) GetSendTime() int64 {
+	if x != nil {
+		return x.SendTime
+	}
+	return 0
+}
+
+type GetGroupCommunityReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupID string `protobuf:"bytes,1,opt,name=groupID,proto3" json:"groupID"`
+}
+
+func (x *GetGroupCommunityReq) Reset() {
+	*x = GetGroupCommunityReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGroupCommunityReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupCommunityReq) ProtoMessage() {}
+
+func (x *GetGroupCommunityReq) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupCommunityReq.ProtoReflect.Descriptor instead.
+func (*GetGroupCommunityReq) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *GetGroupCommunityReq) GetGroupID() string {
+	if x != nil {
+		return x.GroupID
+	}
+	return ""
+}
+
+type GetGroupCommunityResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Empty when the group is not a channel of a community
+	CommunityID string `protobuf:"bytes,1,opt,name=communityID,proto3" json:"communityID"`
+	Public      bool   `protobuf:"varint,2,opt,name=public,proto3" json:"public"`
+}
+
+func (x *GetGroupCommunityResp) Reset() {
+	*x = GetGroupCommunityResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGroupCommunityResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupCommunityResp) ProtoMessage() {}
+
+func (x *GetGroupCommunityResp) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupCommunityResp.ProtoReflect.Descriptor instead.
+func (*GetGroupCommunityResp) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *GetGroupCommunityResp) GetCommunityID() string {
+	if x != nil {
+		return x.CommunityID
+	}
+	return ""
+}
+
+func (x *GetGroupCommunityResp) GetPublic() bool {
+	if x != nil {
+		return x.Public
+	}
+	return false
+}
+
+type GetCommunityMemberReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommunityID string `protobuf:"bytes,1,opt,name=communityID,proto3" json:"communityID"`
+	UserID      string `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID"`
+}
+
+func (x *GetCommunityMemberReq) Reset() {
+	*x = GetCommunityMemberReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCommunityMemberReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCommunityMemberReq) ProtoMessage() {}
+
+func (x *GetCommunityMemberReq) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCommunityMemberReq.ProtoReflect.Descriptor instead.
+func (*GetCommunityMemberReq) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *GetCommunityMemberReq) GetCommunityID() string {
+	if x != nil {
+		return x.CommunityID
+	}
+	return ""
+}
+
+func (x *GetCommunityMemberReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+type GetCommunityMemberResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Member *CommunityMember `protobuf:"bytes,1,opt,name=member,proto3" json:"member"`
+}
+
+func (x *GetCommunityMemberResp) Reset() {
+	*x = GetCommunityMemberResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCommunityMemberResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCommunityMemberResp) ProtoMessage() {}
+
+func (x *GetCommunityMemberResp) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCommunityMemberResp.ProtoReflect.Descriptor instead.
+func (*GetCommunityMemberResp) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *GetCommunityMemberResp) GetMember() *CommunityMember {
+	if x != nil {
+		return x.Member
+	}
+	return nil
+}
+
+type FindCommunityMemberIDsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommunityID string   `protobuf:"bytes,1,opt,name=communityID,proto3" json:"communityID"`
+	UserIDs     []string `protobuf:"bytes,2,rep,name=userIDs,proto3" json:"userIDs"`
+}
+
+func (x *FindCommunityMemberIDsReq) Reset() {
+	*x = FindCommunityMemberIDsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindCommunityMemberIDsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindCommunityMemberIDsReq) ProtoMessage() {}
+
+func (x *FindCommunityMemberIDsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindCommunityMemberIDsReq.ProtoReflect.Descriptor instead.
+func (*FindCommunityMemberIDsReq) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *FindCommunityMemberIDsReq) GetCommunityID() string {
+	if x != nil {
+		return x.CommunityID
+	}
+	return ""
+}
+
+func (x *FindCommunityMemberIDsReq) GetUserIDs() []string {
+	if x != nil {
+		return x.UserIDs
+	}
+	return nil
+}
+
+type FindCommunityMemberIDsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserIDs []string `protobuf:"bytes,1,rep,name=userIDs,proto3" json:"userIDs"`
+}
+
+func (x *FindCommunityMemberIDsResp) Reset() {
+	*x = FindCommunityMemberIDsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindCommunityMemberIDsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindCommunityMemberIDsResp) ProtoMessage() {}
+
+func (x *FindCommunityMemberIDsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindCommunityMemberIDsResp.ProtoReflect.Descriptor instead.
+func (*FindCommunityMemberIDsResp) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *FindCommunityMemberIDsResp) GetUserIDs() []string {
+	if x != nil {
+		return x.UserIDs
+	}
+	return nil
+}
+
+type SharedGroupMember struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID       string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	SharedGroups int64  `protobuf:"varint,2,opt,name=sharedGroups,proto3" json:"sharedGroups"`
+}
+
+func (x *SharedGroupMember) Reset() {
+	*x = SharedGroupMember{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SharedGroupMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SharedGroupMember) ProtoMessage() {}
+
+func (x *SharedGroupMember) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SharedGroupMember.ProtoReflect.Descriptor instead.
+func (*SharedGroupMember) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *SharedGroupMember) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *SharedGroupMember) GetSharedGroups() int64 {
+	if x != nil {
+		return x.SharedGroups
+	}
+	return 0
+}
+
+type GetSharedGroupMembersReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	// Groups with more members are not counted, 0 means no limit
+	MaxGroupMembers int64 `protobuf:"varint,2,opt,name=maxGroupMembers,proto3" json:"maxGroupMembers"`
+	Limit           int64 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit"`
+}
+
+func (x *GetSharedGroupMembersReq) Reset() {
+	*x = GetSharedGroupMembersReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSharedGroupMembersReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSharedGroupMembersReq) ProtoMessage() {}
+
+func (x *GetSharedGroupMembersReq) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSharedGroupMembersReq.ProtoReflect.Descriptor instead.
+func (*GetSharedGroupMembersReq) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *GetSharedGroupMembersReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *GetSharedGroupMembersReq) GetMaxGroupMembers() int64 {
+	if x != nil {
+		return x.MaxGroupMembers
+	}
+	return 0
+}
+
+func (x *GetSharedGroupMembersReq) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetSharedGroupMembersResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Members []*SharedGroupMember `protobuf:"bytes,1,rep,name=members,proto3" json:"members"`
+}
+
+func (x *GetSharedGroupMembersResp) Reset() {
+	*x = GetSharedGroupMembersResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSharedGroupMembersResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSharedGroupMembersResp) ProtoMessage() {}
+
+func (x *GetSharedGroupMembersResp) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSharedGroupMembersResp.ProtoReflect.Descriptor instead.
+func (*GetSharedGroupMembersResp) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *GetSharedGroupMembersResp) GetMembers() []*SharedGroupMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type PurgeUserGroupsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+}
+
+func (x *PurgeUserGroupsReq) Reset() {
+	*x = PurgeUserGroupsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PurgeUserGroupsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeUserGroupsReq) ProtoMessage() {}
+
+func (x *PurgeUserGroupsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeUserGroupsReq.ProtoReflect.Descriptor instead.
+func (*PurgeUserGroupsReq) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *PurgeUserGroupsReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+type PurgeUserGroupsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// groups the user quit, including the groups dismissed because nobody was left
+	Count int32 `protobuf:"varint,1,opt,name=count,proto3" json:"count"`
+}
+
+func (x *PurgeUserGroupsResp) Reset() {
+	*x = PurgeUserGroupsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PurgeUserGroupsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeUserGroupsResp) ProtoMessage() {}
+
+func (x *PurgeUserGroupsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeUserGroupsResp.ProtoReflect.Descriptor instead.
+func (*PurgeUserGroupsResp) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *PurgeUserGroupsResp) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+var File_groupext_groupext_proto protoreflect.FileDescriptor
+
+var file_groupext_groupext_proto_rawDesc = []byte{
+	0x0a, 0x17, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x65, 0x78, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0f, 0x6f, 0x70, 0x65, 0x6e, 0x69,
+	0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x1a, 0x11, 0x73, 0x64, 0x6b, 0x77,
+	0x73, 0x2f, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x99, 0x01,
+	0x0a, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x6f, 0x6c, 0x65, 0x4c, 0x65, 0x76,
+	0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x72, 0x6f, 0x6c, 0x65, 0x4c, 0x65,
+	0x76, 0x65, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x65,
+	0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x82, 0x01, 0x0a, 0x12, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71,
+	0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x6f,
+	0x6c, 0x65, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x72,
+	0x6f, 0x6c, 0x65, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b,
+	0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x15,
+	0x0a, 0x13, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x6f, 0x6c,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x82, 0x01, 0x0a, 0x12, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x6f, 0x6c, 0x65, 0x4c, 0x65,
+	0x76, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x72, 0x6f, 0x6c, 0x65, 0x4c,
+	0x65, 0x76, 0x65, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x65, 0x72, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x70,
+	0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x15, 0x0a, 0x13, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x22, 0x4c, 0x0a, 0x12, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49,
+	0x44, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x6f, 0x6c, 0x65, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x72, 0x6f, 0x6c, 0x65, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x22,
+	0x15, 0x0a, 0x13, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x6f,
+	0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x2c, 0x0a, 0x10, 0x67, 0x65, 0x74, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x49, 0x44, 0x22, 0x45, 0x0a, 0x11, 0x67, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x52, 0x6f, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x30, 0x0a, 0x05, 0x72, 0x6f, 0x6c,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69,
+	0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x52, 0x6f, 0x6c, 0x65, 0x52, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x22, 0x9f, 0x02, 0x0a, 0x0f,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x12,
+	0x24, 0x0a, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54,
+	0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x61, 0x78, 0x55, 0x73, 0x65, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x55, 0x73, 0x65, 0x73, 0x12,
+	0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x08, 0x75, 0x73, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x62,
+	0x79, 0x70, 0x61, 0x73, 0x73, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0e, 0x62, 0x79, 0x70, 0x61, 0x73, 0x73, 0x41, 0x70, 0x70, 0x72, 0x6f,
+	0x76, 0x61, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x12, 0x1e, 0x0a,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x96, 0x01,
+	0x0a, 0x18, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x6e, 0x76,
+	0x69, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x61, 0x78, 0x55, 0x73, 0x65, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x55, 0x73, 0x65, 0x73, 0x12, 0x26,
+	0x0a, 0x0e, 0x62, 0x79, 0x70, 0x61, 0x73, 0x73, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x62, 0x79, 0x70, 0x61, 0x73, 0x73, 0x41, 0x70,
+	0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x22, 0x51, 0x0a, 0x19, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x34, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x20, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x65, 0x78, 0x74, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x4c,
+	0x69, 0x6e, 0x6b, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x22, 0x30, 0x0a, 0x18, 0x72, 0x65, 0x76,
+	0x6f, 0x6b, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x4c, 0x69,
+	0x6e, 0x6b, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x1b, 0x0a, 0x19, 0x72,
+	0x65, 0x76, 0x6f, 0x6b, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65,
+	0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x22, 0x32, 0x0a, 0x16, 0x67, 0x65, 0x74, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x52,
+	0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x22, 0x51, 0x0a, 0x17,
+	0x67, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x4c, 0x69,
+	0x6e, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x36, 0x0a, 0x05, 0x6c, 0x69, 0x6e, 0x6b, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x6e,
+	0x76, 0x69, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x05, 0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x22,
+	0xd0, 0x01, 0x0a, 0x0e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x73, 0x67, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x12, 0x28, 0x0a, 0x0f,
+	0x73, 0x6c, 0x6f, 0x77, 0x4d, 0x6f, 0x64, 0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x73, 0x6c, 0x6f, 0x77, 0x4d, 0x6f, 0x64, 0x65, 0x53,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x4d, 0x73, 0x67,
+	0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x6d, 0x61,
+	0x78, 0x4d, 0x73, 0x67, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x24, 0x0a, 0x0d, 0x64, 0x69,
+	0x73, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0d, 0x64, 0x69, 0x73, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x4c, 0x69, 0x6e, 0x6b, 0x73,
+	0x12, 0x30, 0x0a, 0x13, 0x64, 0x69, 0x73, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x41, 0x74, 0x74, 0x61,
+	0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x64,
+	0x69, 0x73, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x22, 0x4f, 0x0a, 0x14, 0x73, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x73,
+	0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x12, 0x37, 0x0a, 0x06, 0x70, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6f, 0x70, 0x65,
+	0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x4d, 0x73, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x06, 0x70, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x22, 0x17, 0x0a, 0x15, 0x73, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d,
+	0x73, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x22, 0x30, 0x0a, 0x14,
+	0x67, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x73, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x22, 0x50,
+	0x0a, 0x15, 0x67, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x73, 0x67, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x12, 0x37, 0x0a, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d,
+	0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4d,
+	0x73, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x22, 0x8b, 0x01, 0x0a, 0x15, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x73, 0x67, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x53, 0x65, 0x74, 0x54, 0x69, 0x70, 0x73, 0x12, 0x37, 0x0a, 0x06, 0x70, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6f, 0x70, 0x65,
+	0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x4d, 0x73, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x06, 0x70, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x12, 0x39, 0x0a, 0x06, 0x6f, 0x70, 0x55, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x64, 0x6b,
+	0x77, 0x73, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x46, 0x75,
+	0x6c, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06, 0x6f, 0x70, 0x55, 0x73, 0x65, 0x72, 0x22, 0xc1,
+	0x01, 0x0a, 0x11, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4a, 0x6f, 0x69, 0x6e, 0x51, 0x75, 0x65, 0x73,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1a, 0x0a, 0x08,
+	0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
+	0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x12, 0x28, 0x0a, 0x0f, 0x61, 0x63, 0x63, 0x65,
+	0x70, 0x74, 0x65, 0x64, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0f, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x41, 0x6e, 0x73, 0x77, 0x65,
+	0x72, 0x73, 0x22, 0x49, 0x0a, 0x0f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4a, 0x6f, 0x69, 0x6e, 0x41,
+	0x6e, 0x73, 0x77, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x96, 0x01,
+	0x0a, 0x16, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4a, 0x6f, 0x69, 0x6e, 0x51, 0x75, 0x65, 0x73, 0x74,
+	0x69, 0x6f, 0x6e, 0x6e, 0x61, 0x69, 0x72, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x49, 0x44, 0x12, 0x40, 0x0a, 0x09, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4a, 0x6f, 0x69,
+	0x6e, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x75, 0x74, 0x6f, 0x41, 0x70, 0x70, 0x72,
+	0x6f, 0x76, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x61, 0x75, 0x74, 0x6f, 0x41,
+	0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x22, 0x6d, 0x0a, 0x1c, 0x73, 0x65, 0x74, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x4a, 0x6f, 0x69, 0x6e, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x6e, 0x61,
+	0x69, 0x72, 0x65, 0x52, 0x65, 0x71, 0x12, 0x4d, 0x0a, 0x0d, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69,
+	0x6f, 0x6e, 0x6e, 0x61, 0x69, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e,
+	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x4a, 0x6f, 0x69, 0x6e, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f,
+	0x6e, 0x6e, 0x61, 0x69, 0x72, 0x65, 0x52, 0x0d, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
+	0x6e, 0x61, 0x69, 0x72, 0x65, 0x22, 0x1f, 0x0a, 0x1d, 0x73, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x4a, 0x6f, 0x69, 0x6e, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x6e, 0x61, 0x69,
+	0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x38, 0x0a, 0x1c, 0x67, 0x65, 0x74, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x4a, 0x6f, 0x69, 0x6e, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x6e, 0x61,
+	0x69, 0x72, 0x65, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44,
+	0x22, 0x6e, 0x0a, 0x1d, 0x67, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4a, 0x6f, 0x69, 0x6e,
+	0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x6e, 0x61, 0x69, 0x72, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x4d, 0x0a, 0x0d, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x6e, 0x61, 0x69,
+	0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69,
+	0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x4a, 0x6f, 0x69, 0x6e, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x6e, 0x61, 0x69, 0x72,
+	0x65, 0x52, 0x0d, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x6e, 0x61, 0x69, 0x72, 0x65,
+	0x22, 0xbb, 0x01, 0x0a, 0x11, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x4a, 0x6f, 0x69, 0x6e, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44,
+	0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x71, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x0e, 0x0a, 0x02, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x65, 0x78,
+	0x12, 0x3a, 0x0a, 0x07, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x20, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x65, 0x78, 0x74, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4a, 0x6f, 0x69, 0x6e, 0x41, 0x6e, 0x73,
+	0x77, 0x65, 0x72, 0x52, 0x07, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x12, 0x20, 0x0a, 0x0b,
+	0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x46,
+	0x0a, 0x12, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x4a, 0x6f, 0x69, 0x6e, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x6a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x22, 0x69, 0x0a, 0x13, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x3a, 0x0a, 0x07, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4a, 0x6f,
+	0x69, 0x6e, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x52, 0x07, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72,
+	0x73, 0x22, 0x4f, 0x0a, 0x19, 0x67, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x12, 0x18,
+	0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x73, 0x22, 0x5e, 0x0a, 0x1a, 0x67, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x40, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x65, 0x78, 0x74, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x73, 0x22, 0x30, 0x0a, 0x16, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06,
+	0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x62, 0x65,
+	0x66, 0x6f, 0x72, 0x65, 0x22, 0x2f, 0x0a, 0x17, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xef, 0x01, 0x0a, 0x11, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x22, 0x0a,
+	0x0c, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x55, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x12, 0x22, 0x0a, 0x0c, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x64,
+	0x4d, 0x73, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x68, 0x61, 0x6e, 0x64, 0x6c,
+	0x65, 0x64, 0x4d, 0x73, 0x67, 0x12, 0x1e, 0x0a, 0x0a, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x68, 0x61, 0x6e, 0x64, 0x6c,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x64,
+	0x54, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x68, 0x61, 0x6e, 0x64,
+	0x6c, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x75, 0x0a, 0x18, 0x67, 0x65, 0x74, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x12, 0x3f, 0x0a,
+	0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73,
+	0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x6d,
+	0x0a, 0x19, 0x67, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x12, 0x3a, 0x0a, 0x06, 0x61, 0x75, 0x64, 0x69, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x22, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x65, 0x78, 0x74, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x41, 0x75, 0x64, 0x69, 0x74, 0x52, 0x06, 0x61, 0x75, 0x64, 0x69, 0x74, 0x73, 0x22, 0x8b, 0x01,
+	0x0a, 0x15, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79,
+	0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49,
+	0x44, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x61, 0x74,
+	0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x63,
+	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x5f, 0x0a, 0x1b, 0x73,
+	0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79,
+	0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x12, 0x40, 0x0a, 0x07, 0x73, 0x65,
+	0x74, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6f, 0x70,
+	0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x53, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x52, 0x07, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x22, 0x1e, 0x0a, 0x1c,
+	0x73, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x79, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x22, 0x37, 0x0a, 0x1b,
+	0x67, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x79, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x49, 0x44, 0x22, 0x60, 0x0a, 0x1c, 0x67, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e,
+	0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x40, 0x0a, 0x07, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x44, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x07,
+	0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x22, 0x20, 0x0a, 0x1e, 0x67, 0x65, 0x74, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x43, 0x61, 0x74, 0x65,
+	0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x22, 0x41, 0x0a, 0x1f, 0x67, 0x65, 0x74,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x43, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x1e, 0x0a, 0x0a,
+	0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x22, 0xa2, 0x02, 0x0a,
+	0x0e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12,
+	0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x61, 0x63, 0x65, 0x55,
+	0x52, 0x4c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x66, 0x61, 0x63, 0x65, 0x55, 0x52,
+	0x4c, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65,
+	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
+	0x69, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x6a, 0x6f, 0x69, 0x6e, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6a, 0x6f, 0x69, 0x6e, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74,
+	0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74,
+	0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d,
+	0x65, 0x22, 0xa8, 0x01, 0x0a, 0x17, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a,
+	0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67,
+	0x6f, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67,
+	0x6f, 0x72, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x12, 0x3f, 0x0a, 0x0a, 0x70,
+	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x69, 0x0a, 0x18,
+	0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x44, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x37,
+	0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f,
+	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74,
+	0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52,
+	0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x22, 0x1a, 0x0a, 0x18, 0x72, 0x65, 0x66, 0x72, 0x65,
+	0x73, 0x68, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79,
+	0x52, 0x65, 0x71, 0x22, 0x31, 0x0a, 0x19, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xb9, 0x02, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x75,
+	0x6e, 0x69, 0x74, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74,
+	0x79, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x75,
+	0x6e, 0x69, 0x74, 0x79, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x61,
+	0x63, 0x65, 0x55, 0x52, 0x4c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x66, 0x61, 0x63,
+	0x65, 0x55, 0x52, 0x4c, 0x12, 0x22, 0x0a, 0x0c, 0x69, 0x6e, 0x74, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e, 0x74, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x77, 0x6e, 0x65,
+	0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f,
+	0x77, 0x6e, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x6e,
+	0x76, 0x69, 0x74, 0x65, 0x4f, 0x6e, 0x6c, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a,
+	0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x0e, 0x0a, 0x02, 0x65, 0x78, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x65, 0x78,
+	0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x22, 0xab, 0x01, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79,
+	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e,
+	0x69, 0x74, 0x79, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6d,
+	0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x12, 0x1c, 0x0a, 0x09, 0x72, 0x6f, 0x6c, 0x65, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x09, 0x72, 0x6f, 0x6c, 0x65, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x1a,
+	0x0a, 0x08, 0x6a, 0x6f, 0x69, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x08, 0x6a, 0x6f, 0x69, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x69, 0x6e,
+	0x76, 0x69, 0x74, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x22, 0x94, 0x01, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x43, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x12,
+	0x1c, 0x0a, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x66, 0x61, 0x63, 0x65, 0x55, 0x52, 0x4c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x66, 0x61, 0x63, 0x65, 0x55, 0x52, 0x4c, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x6c, 0x69,
+	0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x12,
+	0x16, 0x0a, 0x06, 0x6a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x6a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x22, 0x4e, 0x0a, 0x12, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x12, 0x38, 0x0a,
+	0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65,
+	0x78, 0x74, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x52, 0x09, 0x63, 0x6f,
+	0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x22, 0x4f, 0x0a, 0x13, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x12, 0x38,
+	0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x65, 0x78, 0x74, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x52, 0x09, 0x63,
+	0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x22, 0xb9, 0x01, 0x0a, 0x13, 0x73, 0x65, 0x74,
+	0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71,
+	0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79,
+	0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x61, 0x63, 0x65, 0x55, 0x52,
+	0x4c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x66, 0x61, 0x63, 0x65, 0x55, 0x52, 0x4c,
+	0x12, 0x22, 0x0a, 0x0c, 0x69, 0x6e, 0x74, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e, 0x74, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x4f, 0x6e,
+	0x6c, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65,
+	0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x65, 0x78, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x65, 0x78, 0x22, 0x16, 0x0a, 0x14, 0x73, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x75,
+	0x6e, 0x69, 0x74, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x22, 0x3b, 0x0a, 0x15,
+	0x67, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x69, 0x65, 0x73, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x65, 0x71, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69,
+	0x74, 0x79, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6d,
+	0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x49, 0x44, 0x73, 0x22, 0x56, 0x0a, 0x16, 0x67, 0x65, 0x74,
+	0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x69, 0x65, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x3c, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x69,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69,
+	0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x75,
+	0x6e, 0x69, 0x74, 0x79, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x69, 0x65,
+	0x73, 0x22, 0x37, 0x0a, 0x13, 0x64, 0x69, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x43, 0x6f, 0x6d, 0x6d,
+	0x75, 0x6e, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x6d,
+	0x75, 0x6e, 0x69, 0x74, 0x79, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63,
+	0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x49, 0x44, 0x22, 0x16, 0x0a, 0x14, 0x64, 0x69,
+	0x73, 0x6d, 0x69, 0x73, 0x73, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x22, 0x6c, 0x0a, 0x16, 0x73, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69,
+	0x74, 0x79, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x12, 0x20, 0x0a, 0x0b,
+	0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x49, 0x44, 0x12, 0x18,
+	0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x6c,
+	0x69, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63,
+	0x22, 0x19, 0x0a, 0x17, 0x73, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79,
+	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x22, 0x57, 0x0a, 0x19, 0x72,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x43, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x6d,
+	0x75, 0x6e, 0x69, 0x74, 0x79, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63,
+	0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x49, 0x44, 0x22, 0x1c, 0x0a, 0x1a, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f,
+	0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65,
+	0x73, 0x70, 0x22, 0x3b, 0x0a, 0x17, 0x67, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69,
+	0x74, 0x79, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x12, 0x20, 0x0a,
+	0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x49, 0x44, 0x22,
+	0x59, 0x0a, 0x18, 0x67, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x43,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x3d, 0x0a, 0x08, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e,
+	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x52, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x22, 0x34, 0x0a, 0x10, 0x6a, 0x6f,
+	0x69, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x12, 0x20,
+	0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x49, 0x44,
+	0x22, 0x13, 0x0a, 0x11, 0x6a, 0x6f, 0x69, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x22, 0x52, 0x0a, 0x14, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x54,
+	0x6f, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x12, 0x20, 0x0a,
+	0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x49, 0x44, 0x12,
+	0x18, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x22, 0x17, 0x0a, 0x15, 0x69, 0x6e, 0x76,
+	0x69, 0x74, 0x65, 0x54, 0x6f, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x22, 0x33, 0x0a, 0x17, 0x6a, 0x6f, 0x69, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e,
+	0x69, 0x74, 0x79, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a,
+	0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x22, 0x1a, 0x0a, 0x18, 0x6a, 0x6f, 0x69, 0x6e, 0x43,
+	0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52,
+	0x65, 0x73, 0x70, 0x22, 0x34, 0x0a, 0x10, 0x71, 0x75, 0x69, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x75,
+	0x6e, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x75,
+	0x6e, 0x69, 0x74, 0x79, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f,
+	0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x49, 0x44, 0x22, 0x13, 0x0a, 0x11, 0x71, 0x75, 0x69,
+	0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x22, 0x54,
+	0x0a, 0x16, 0x6b, 0x69, 0x63, 0x6b, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x4d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x6d,
+	0x75, 0x6e, 0x69, 0x74, 0x79, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63,
+	0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x73, 0x22, 0x19, 0x0a, 0x17, 0x6b, 0x69, 0x63, 0x6b, 0x43, 0x6f, 0x6d, 0x6d,
+	0x75, 0x6e, 0x69, 0x74, 0x79, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x22,
+	0x73, 0x0a, 0x19, 0x73, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x4d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x12, 0x20, 0x0a, 0x0b,
+	0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x49, 0x44, 0x12, 0x16,
+	0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x6f, 0x6c, 0x65, 0x4c, 0x65,
+	0x76, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x72, 0x6f, 0x6c, 0x65, 0x4c,
+	0x65, 0x76, 0x65, 0x6c, 0x22, 0x1c, 0x0a, 0x1a, 0x73, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x75,
+	0x6e, 0x69, 0x74, 0x79, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x22, 0x7b, 0x0a, 0x16, 0x67, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69,
+	0x74, 0x79, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x12, 0x20, 0x0a, 0x0b,
+	0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x49, 0x44, 0x12, 0x3f,
+	0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x64, 0x6b, 0x77,
+	0x73, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0x6b, 0x0a, 0x17, 0x67, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x4d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x12, 0x3a, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x20, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x65, 0x78, 0x74, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x4d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x22, 0x19, 0x0a, 0x17,
+	0x67, 0x65, 0x74, 0x4a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69,
+	0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x22, 0x58, 0x0a, 0x18, 0x67, 0x65, 0x74, 0x4a, 0x6f,
+	0x69, 0x6e, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x3c, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x69,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69,
+	0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x75,
+	0x6e, 0x69, 0x74, 0x79, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x69, 0x65,
+	0x73, 0x22, 0x5a, 0x0a, 0x1c, 0x73, 0x65, 0x6e, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69,
+	0x74, 0x79, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74,
+	0x79, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x35, 0x0a,
+	0x1d, 0x73, 0x65, 0x6e, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x41, 0x6e,
+	0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x14,
+	0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x22, 0xb5, 0x01, 0x0a, 0x19, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69,
+	0x74, 0x79, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x69,
+	0x70, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69,
+	0x74, 0x79, 0x49, 0x44, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74,
+	0x79, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6d,
+	0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x70,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x70,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x30, 0x0a, 0x14,
+	0x67, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74,
+	0x79, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x22, 0x51,
+	0x0a, 0x15, 0x67, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e,
+	0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x75,
+	0x6e, 0x69, 0x74, 0x79, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f,
+	0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62,
+	0x6c, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x75, 0x62, 0x6c, 0x69,
+	0x63, 0x22, 0x51, 0x0a, 0x15, 0x67, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74,
+	0x79, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f,
+	0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x22, 0x52, 0x0a, 0x16, 0x67, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x75,
+	0x6e, 0x69, 0x74, 0x79, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x12, 0x38,
+	0x0a, 0x06, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20,
+	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x52, 0x06, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x57, 0x0a, 0x19, 0x66, 0x69, 0x6e, 0x64,
+	0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x49,
+	0x44, 0x73, 0x52, 0x65, 0x71, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69,
+	0x74, 0x79, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x6d,
+	0x75, 0x6e, 0x69, 0x74, 0x79, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x73, 0x22, 0x36, 0x0a, 0x1a, 0x66, 0x69, 0x6e, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69,
+	0x74, 0x79, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x49, 0x44, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x18, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x22, 0x4f, 0x0a, 0x11, 0x73, 0x68, 0x61,
+	0x72, 0x65, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x16,
+	0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x73, 0x68,
+	0x61, 0x72, 0x65, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x22, 0x72, 0x0a, 0x18, 0x67, 0x65,
+	0x74, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x28,
+	0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x6d, 0x61, 0x78, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x59,
+	0x0a, 0x19, 0x67, 0x65, 0x74, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x3c, 0x0a, 0x07, 0x6d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6f,
+	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x73,
+	0x68, 0x61, 0x72, 0x65, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x22, 0x2c, 0x0a, 0x12, 0x70, 0x75, 0x72,
+	0x67, 0x65, 0x55, 0x73, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x71, 0x12,
+	0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x2b, 0x0a, 0x13, 0x70, 0x75, 0x72, 0x67, 0x65,
+	0x55, 0x73, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x14,
+	0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x32, 0x8e, 0x22, 0x0a, 0x08, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x45, 0x78,
+	0x74, 0x12, 0x5c, 0x0a, 0x0f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x52, 0x6f, 0x6c, 0x65, 0x12, 0x23, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x24, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x5c, 0x0a, 0x0f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x6f,
+	0x6c, 0x65, 0x12, 0x23, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x65, 0x78, 0x74, 0x2e, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x24, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d,
+	0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5c, 0x0a,
+	0x0f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x6f, 0x6c, 0x65,
+	0x12, 0x23, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65,
+	0x78, 0x74, 0x2e, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x6f,
+	0x6c, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x24, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x56, 0x0a, 0x0d, 0x67,
+	0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x21, 0x2e, 0x6f,
+	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x67,
+	0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x1a,
+	0x22, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78,
+	0x74, 0x2e, 0x67, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x6e, 0x0a, 0x15, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x29, 0x2e, 0x6f,
+	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65,
+	0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x1a, 0x2a, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d,
+	0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x6e, 0x0a, 0x15, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x29, 0x2e, 0x6f,
+	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x72,
+	0x65, 0x76, 0x6f, 0x6b, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65,
+	0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x1a, 0x2a, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d,
+	0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x68, 0x0a, 0x13, 0x67, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49,
+	0x6e, 0x76, 0x69, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x12, 0x27, 0x2e, 0x6f, 0x70, 0x65,
+	0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x67, 0x65, 0x74,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x73,
+	0x52, 0x65, 0x71, 0x1a, 0x28, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x67, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x6e,
+	0x76, 0x69, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x62, 0x0a,
+	0x11, 0x73, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x73, 0x67, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x12, 0x25, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x65, 0x78, 0x74, 0x2e, 0x73, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x73, 0x67,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x26, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x73, 0x65, 0x74, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x4d, 0x73, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x62, 0x0a, 0x11, 0x67, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x73, 0x67,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x25, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x67, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x4d, 0x73, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x26, 0x2e,
+	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e,
+	0x67, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x73, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x12, 0x7a, 0x0a, 0x19, 0x73, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x4a, 0x6f, 0x69, 0x6e, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x6e, 0x61, 0x69,
+	0x72, 0x65, 0x12, 0x2d, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x65, 0x78, 0x74, 0x2e, 0x73, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4a, 0x6f, 0x69,
+	0x6e, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x6e, 0x61, 0x69, 0x72, 0x65, 0x52, 0x65,
+	0x71, 0x1a, 0x2e, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x65, 0x78, 0x74, 0x2e, 0x73, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4a, 0x6f, 0x69, 0x6e,
+	0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x6e, 0x61, 0x69, 0x72, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x7a, 0x0a, 0x19, 0x67, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4a, 0x6f, 0x69,
+	0x6e, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x6e, 0x61, 0x69, 0x72, 0x65, 0x12, 0x2d,
+	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74,
+	0x2e, 0x67, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4a, 0x6f, 0x69, 0x6e, 0x51, 0x75, 0x65,
+	0x73, 0x74, 0x69, 0x6f, 0x6e, 0x6e, 0x61, 0x69, 0x72, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x2e, 0x2e,
+	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e,
+	0x67, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4a, 0x6f, 0x69, 0x6e, 0x51, 0x75, 0x65, 0x73,
+	0x74, 0x69, 0x6f, 0x6e, 0x6e, 0x61, 0x69, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x59, 0x0a,
+	0x0e, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x4a, 0x6f, 0x69, 0x6e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12,
+	0x22, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78,
+	0x74, 0x2e, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x4a, 0x6f, 0x69, 0x6e, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x52, 0x65, 0x71, 0x1a, 0x23, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x4a, 0x6f, 0x69, 0x6e, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x12, 0x71, 0x0a, 0x16, 0x67, 0x65, 0x74, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x6e, 0x73, 0x77, 0x65,
+	0x72, 0x73, 0x12, 0x2a, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x65, 0x78, 0x74, 0x2e, 0x67, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x2b,
+	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74,
+	0x2e, 0x67, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x68, 0x0a, 0x13, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x73, 0x12, 0x27, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x65, 0x78, 0x74, 0x2e, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x28, 0x2e, 0x6f, 0x70,
+	0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x6e, 0x0a, 0x15, 0x67, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x73, 0x12, 0x29,
+	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74,
+	0x2e, 0x67, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x41, 0x75, 0x64, 0x69, 0x74, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x2a, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x67, 0x65, 0x74, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x77, 0x0a, 0x18, 0x73, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e,
+	0x67, 0x12, 0x2c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x65, 0x78, 0x74, 0x2e, 0x73, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x44, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x79, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x1a,
+	0x2d, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78,
+	0x74, 0x2e, 0x73, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x79, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x77,
+	0x0a, 0x18, 0x67, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x79, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x2c, 0x2e, 0x6f, 0x70, 0x65,
+	0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x67, 0x65, 0x74,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x53, 0x65,
+	0x74, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x2d, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69,
+	0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x67, 0x65, 0x74, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x53, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x80, 0x01, 0x0a, 0x1b, 0x67, 0x65, 0x74, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x43, 0x61, 0x74,
+	0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x2f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d,
+	0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x67, 0x65, 0x74, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x43, 0x61, 0x74, 0x65, 0x67,
+	0x6f, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x30, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69,
+	0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x67, 0x65, 0x74, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x43, 0x61, 0x74, 0x65,
+	0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x6b, 0x0a, 0x14, 0x73, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x79, 0x12, 0x28, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x65, 0x78, 0x74, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x29, 0x2e, 0x6f,
+	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x73,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x12, 0x6e, 0x0a, 0x15, 0x72, 0x65, 0x66, 0x72, 0x65,
+	0x73, 0x68, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79,
+	0x12, 0x29, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65,
+	0x78, 0x74, 0x2e, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x44,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x2a, 0x2e, 0x6f, 0x70,
+	0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x72, 0x65,
+	0x66, 0x72, 0x65, 0x73, 0x68, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5c, 0x0a, 0x0f, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x12, 0x23, 0x2e, 0x6f, 0x70, 0x65,
+	0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x1a,
+	0x24, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78,
+	0x74, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5f, 0x0a, 0x10, 0x73, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d,
+	0x75, 0x6e, 0x69, 0x74, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x24, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x73, 0x65, 0x74, 0x43,
+	0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x1a,
+	0x25, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78,
+	0x74, 0x2e, 0x73, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x12, 0x65, 0x0a, 0x12, 0x67, 0x65, 0x74, 0x43, 0x6f, 0x6d,
+	0x6d, 0x75, 0x6e, 0x69, 0x74, 0x69, 0x65, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x26, 0x2e, 0x6f,
+	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x67,
+	0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x69, 0x65, 0x73, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x65, 0x71, 0x1a, 0x27, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x67, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e,
+	0x69, 0x74, 0x69, 0x65, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5f, 0x0a,
+	0x10, 0x64, 0x69, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74,
+	0x79, 0x12, 0x24, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x65, 0x78, 0x74, 0x2e, 0x64, 0x69, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x43, 0x6f, 0x6d, 0x6d, 0x75,
+	0x6e, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x25, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d,
+	0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x64, 0x69, 0x73, 0x6d, 0x69, 0x73,
+	0x73, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x12, 0x68,
+	0x0a, 0x13, 0x73, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x43, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x27, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x73, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x75,
+	0x6e, 0x69, 0x74, 0x79, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x1a, 0x28,
+	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74,
+	0x2e, 0x73, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x43, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x12, 0x71, 0x0a, 0x16, 0x72, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x43, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x12, 0x2a, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x65, 0x78, 0x74, 0x2e, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x75,
+	0x6e, 0x69, 0x74, 0x79, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x1a, 0x2b,
+	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74,
+	0x2e, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79,
+	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x12, 0x6b, 0x0a, 0x14, 0x67,
+	0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x43, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x73, 0x12, 0x28, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x67, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69,
+	0x74, 0x79, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x29, 0x2e,
+	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e,
+	0x67, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x43, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x56, 0x0a, 0x0d, 0x6a, 0x6f, 0x69, 0x6e,
+	0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x12, 0x21, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x6a, 0x6f, 0x69, 0x6e,
+	0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x22, 0x2e, 0x6f,
+	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x6a,
+	0x6f, 0x69, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x62, 0x0a, 0x11, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x54, 0x6f, 0x43, 0x6f, 0x6d, 0x6d,
+	0x75, 0x6e, 0x69, 0x74, 0x79, 0x12, 0x25, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x54, 0x6f,
+	0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x26, 0x2e, 0x6f,
+	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x69,
+	0x6e, 0x76, 0x69, 0x74, 0x65, 0x54, 0x6f, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x6b, 0x0a, 0x14, 0x6a, 0x6f, 0x69, 0x6e, 0x43, 0x6f, 0x6d, 0x6d,
+	0x75, 0x6e, 0x69, 0x74, 0x79, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x28, 0x2e, 0x6f,
+	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x6a,
+	0x6f, 0x69, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x43, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x1a, 0x29, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x6a, 0x6f, 0x69, 0x6e, 0x43, 0x6f, 0x6d,
+	0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x56, 0x0a, 0x0d, 0x71, 0x75, 0x69, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69,
+	0x74, 0x79, 0x12, 0x21, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x65, 0x78, 0x74, 0x2e, 0x71, 0x75, 0x69, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69,
+	0x74, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x22, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x71, 0x75, 0x69, 0x74, 0x43, 0x6f, 0x6d, 0x6d,
+	0x75, 0x6e, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x12, 0x68, 0x0a, 0x13, 0x6b, 0x69, 0x63,
+	0x6b, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x12, 0x27, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65,
+	0x78, 0x74, 0x2e, 0x6b, 0x69, 0x63, 0x6b, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79,
+	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x28, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x6b, 0x69, 0x63, 0x6b,
+	0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x71, 0x0a, 0x16, 0x73, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e,
+	0x69, 0x74, 0x79, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x2a, 0x2e,
+	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e,
+	0x73, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x4d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x2b, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x73, 0x65, 0x74, 0x43,
+	0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x6f,
+	0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x68, 0x0a, 0x13, 0x67, 0x65, 0x74, 0x43, 0x6f, 0x6d,
+	0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x27, 0x2e,
+	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e,
+	0x67, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x4d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x28, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x67, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d,
+	0x75, 0x6e, 0x69, 0x74, 0x79, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x6b, 0x0a, 0x14, 0x67, 0x65, 0x74, 0x4a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x43, 0x6f, 0x6d,
+	0x6d, 0x75, 0x6e, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x28, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69,
+	0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x67, 0x65, 0x74, 0x4a, 0x6f,
+	0x69, 0x6e, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x1a, 0x29, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x65, 0x78, 0x74, 0x2e, 0x67, 0x65, 0x74, 0x4a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x43, 0x6f,
+	0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x7a, 0x0a,
+	0x19, 0x73, 0x65, 0x6e, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x41, 0x6e,
+	0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2d, 0x2e, 0x6f, 0x70, 0x65,
+	0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x73, 0x65, 0x6e,
+	0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e,
+	0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x2e, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x73, 0x65, 0x6e, 0x64,
+	0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x62, 0x0a, 0x11, 0x67, 0x65, 0x74,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x12, 0x25,
+	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74,
+	0x2e, 0x67, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69,
+	0x74, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x26, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x67, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x12, 0x65, 0x0a,
+	0x12, 0x67, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x4d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x12, 0x26, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x67, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69,
+	0x74, 0x79, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x27, 0x2e, 0x6f, 0x70,
+	0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x67, 0x65,
+	0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x71, 0x0a, 0x16, 0x66, 0x69, 0x6e, 0x64, 0x43, 0x6f, 0x6d, 0x6d,
+	0x75, 0x6e, 0x69, 0x74, 0x79, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x49, 0x44, 0x73, 0x12, 0x2a,
+	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74,
+	0x2e, 0x66, 0x69, 0x6e, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x4d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x49, 0x44, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x2b, 0x2e, 0x6f, 0x70, 0x65,
+	0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x66, 0x69, 0x6e,
+	0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x49, 0x44, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x6e, 0x0a, 0x15, 0x67, 0x65, 0x74, 0x53, 0x68,
+	0x61, 0x72, 0x65, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73,
+	0x12, 0x29, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65,
+	0x78, 0x74, 0x2e, 0x67, 0x65, 0x74, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x2a, 0x2e, 0x6f, 0x70,
+	0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x67, 0x65,
+	0x74, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5c, 0x0a, 0x0f, 0x70, 0x75, 0x72, 0x67, 0x65,
+	0x55, 0x73, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x23, 0x2e, 0x6f, 0x70, 0x65,
+	0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x70, 0x75, 0x72,
+	0x67, 0x65, 0x55, 0x73, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x71, 0x1a,
+	0x24, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78,
+	0x74, 0x2e, 0x70, 0x75, 0x72, 0x67, 0x65, 0x55, 0x73, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x42, 0x3e, 0x5a, 0x3c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x73, 0x64, 0x6b, 0x2f, 0x6f, 0x70,
+	0x65, 0x6e, 0x2d, 0x69, 0x6d, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x76, 0x33, 0x2f,
+	0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x65, 0x78, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_groupext_groupext_proto_rawDescOnce sync.Once
+	file_groupext_groupext_proto_rawDescData = file_groupext_groupext_proto_rawDesc
+)
+
+func file_groupext_groupext_proto_rawDescGZIP() []byte {
+	file_groupext_groupext_proto_rawDescOnce.Do(func() {
+		file_groupext_groupext_proto_rawDescData = protoimpl.X.CompressGZIP(file_groupext_groupext_proto_rawDescData)
+	})
+	return file_groupext_groupext_proto_rawDescData
+}
+
+var file_groupext_groupext_proto_msgTypes = make([]protoimpl.MessageInfo, 98)
+var file_groupext_groupext_proto_goTypes = []interface{}{
+	(*GroupRole)(nil),                       // 0: openim.groupext.groupRole
+	(*CreateGroupRoleReq)(nil),              // 1: openim.groupext.createGroupRoleReq
+	(*CreateGroupRoleResp)(nil),             // 2: openim.groupext.createGroupRoleResp
+	(*UpdateGroupRoleReq)(nil),              // 3: openim.groupext.updateGroupRoleReq
+	(*UpdateGroupRoleResp)(nil),             // 4: openim.groupext.updateGroupRoleResp
+	(*DeleteGroupRoleReq)(nil),              // 5: openim.groupext.deleteGroupRoleReq
+	(*DeleteGroupRoleResp)(nil),             // 6: openim.groupext.deleteGroupRoleResp
+	(*GetGroupRolesReq)(nil),                // 7: openim.groupext.getGroupRolesReq
+	(*GetGroupRolesResp)(nil),               // 8: openim.groupext.getGroupRolesResp
+	(*GroupInviteLink)(nil),                 // 9: openim.groupext.groupInviteLink
+	(*CreateGroupInviteLinkReq)(nil),        // 10: openim.groupext.createGroupInviteLinkReq
+	(*CreateGroupInviteLinkResp)(nil),       // 11: openim.groupext.createGroupInviteLinkResp
+	(*RevokeGroupInviteLinkReq)(nil),        // 12: openim.groupext.revokeGroupInviteLinkReq
+	(*RevokeGroupInviteLinkResp)(nil),       // 13: openim.groupext.revokeGroupInviteLinkResp
+	(*GetGroupInviteLinksReq)(nil),          // 14: openim.groupext.getGroupInviteLinksReq
+	(*GetGroupInviteLinksResp)(nil),         // 15: openim.groupext.getGroupInviteLinksResp
+	(*GroupMsgPolicy)(nil),                  // 16: openim.groupext.groupMsgPolicy
+	(*SetGroupMsgPolicyReq)(nil),            // 17: openim.groupext.setGroupMsgPolicyReq
+	(*SetGroupMsgPolicyResp)(nil),           // 18: openim.groupext.setGroupMsgPolicyResp
+	(*GetGroupMsgPolicyReq)(nil),            // 19: openim.groupext.getGroupMsgPolicyReq
+	(*GetGroupMsgPolicyResp)(nil),           // 20: openim.groupext.getGroupMsgPolicyResp
+	(*GroupMsgPolicySetTips)(nil),           // 21: openim.groupext.groupMsgPolicySetTips
+	(*GroupJoinQuestion)(nil),               // 22: openim.groupext.groupJoinQuestion
+	(*GroupJoinAnswer)(nil),                 // 23: openim.groupext.groupJoinAnswer
+	(*GroupJoinQuestionnaire)(nil),          // 24: openim.groupext.groupJoinQuestionnaire
+	(*SetGroupJoinQuestionnaireReq)(nil),    // 25: openim.groupext.setGroupJoinQuestionnaireReq
+	(*SetGroupJoinQuestionnaireResp)(nil),   // 26: openim.groupext.setGroupJoinQuestionnaireResp
+	(*GetGroupJoinQuestionnaireReq)(nil),    // 27: openim.groupext.getGroupJoinQuestionnaireReq
+	(*GetGroupJoinQuestionnaireResp)(nil),   // 28: openim.groupext.getGroupJoinQuestionnaireResp
+	(*ApplyJoinGroupReq)(nil),               // 29: openim.groupext.applyJoinGroupReq
+	(*ApplyJoinGroupResp)(nil),              // 30: openim.groupext.applyJoinGroupResp
+	(*GroupRequestAnswers)(nil),             // 31: openim.groupext.groupRequestAnswers
+	(*GetGroupRequestAnswersReq)(nil),       // 32: openim.groupext.getGroupRequestAnswersReq
+	(*GetGroupRequestAnswersResp)(nil),      // 33: openim.groupext.getGroupRequestAnswersResp
+	(*ExpireGroupRequestsReq)(nil),          // 34: openim.groupext.expireGroupRequestsReq
+	(*ExpireGroupRequestsResp)(nil),         // 35: openim.groupext.expireGroupRequestsResp
+	(*GroupRequestAudit)(nil),               // 36: openim.groupext.groupRequestAudit
+	(*GetGroupRequestAuditsReq)(nil),        // 37: openim.groupext.getGroupRequestAuditsReq
+	(*GetGroupRequestAuditsResp)(nil),       // 38: openim.groupext.getGroupRequestAuditsResp
+	(*GroupDirectorySetting)(nil),           // 39: openim.groupext.groupDirectorySetting
+	(*SetGroupDirectorySettingReq)(nil),     // 40: openim.groupext.setGroupDirectorySettingReq
+	(*SetGroupDirectorySettingResp)(nil),    // 41: openim.groupext.setGroupDirectorySettingResp
+	(*GetGroupDirectorySettingReq)(nil),     // 42: openim.groupext.getGroupDirectorySettingReq
+	(*GetGroupDirectorySettingResp)(nil),    // 43: openim.groupext.getGroupDirectorySettingResp
+	(*GetGroupDirectoryCategoriesReq)(nil),  // 44: openim.groupext.getGroupDirectoryCategoriesReq
+	(*GetGroupDirectoryCategoriesResp)(nil), // 45: openim.groupext.getGroupDirectoryCategoriesResp
+	(*DirectoryGroup)(nil),                  // 46: openim.groupext.directoryGroup
+	(*SearchGroupDirectoryReq)(nil),         // 47: openim.groupext.searchGroupDirectoryReq
+	(*SearchGroupDirectoryResp)(nil),        // 48: openim.groupext.searchGroupDirectoryResp
+	(*RefreshGroupDirectoryReq)(nil),        // 49: openim.groupext.refreshGroupDirectoryReq
+	(*RefreshGroupDirectoryResp)(nil),       // 50: openim.groupext.refreshGroupDirectoryResp
+	(*Community)(nil),                       // 51: openim.groupext.community
+	(*CommunityMember)(nil),                 // 52: openim.groupext.communityMember
+	(*CommunityChannel)(nil),                // 53: openim.groupext.communityChannel
+	(*CreateCommunityReq)(nil),              // 54: openim.groupext.createCommunityReq
+	(*CreateCommunityResp)(nil),             // 55: openim.groupext.createCommunityResp
+	(*SetCommunityInfoReq)(nil),             // 56: openim.groupext.setCommunityInfoReq
+	(*SetCommunityInfoResp)(nil),            // 57: openim.groupext.setCommunityInfoResp
+	(*GetCommunitiesInfoReq)(nil),           // 58: openim.groupext.getCommunitiesInfoReq
+	(*GetCommunitiesInfoResp)(nil),          // 59: openim.groupext.getCommunitiesInfoResp
+	(*DismissCommunityReq)(nil),             // 60: openim.groupext.dismissCommunityReq
+	(*DismissCommunityResp)(nil),            // 61: openim.groupext.dismissCommunityResp
+	(*SetCommunityChannelReq)(nil),          // 62: openim.groupext.setCommunityChannelReq
+	(*SetCommunityChannelResp)(nil),         // 63: openim.groupext.setCommunityChannelResp
+	(*RemoveCommunityChannelReq)(nil),       // 64: openim.groupext.removeCommunityChannelReq
+	(*RemoveCommunityChannelResp)(nil),      // 65: openim.groupext.removeCommunityChannelResp
+	(*GetCommunityChannelsReq)(nil),         // 66: openim.groupext.getCommunityChannelsReq
+	(*GetCommunityChannelsResp)(nil),        // 67: openim.groupext.getCommunityChannelsResp
+	(*JoinCommunityReq)(nil),                // 68: openim.groupext.joinCommunityReq
+	(*JoinCommunityResp)(nil),               // 69: openim.groupext.joinCommunityResp
+	(*InviteToCommunityReq)(nil),            // 70: openim.groupext.inviteToCommunityReq
+	(*InviteToCommunityResp)(nil),           // 71: openim.groupext.inviteToCommunityResp
+	(*JoinCommunityChannelReq)(nil),         // 72: openim.groupext.joinCommunityChannelReq
+	(*JoinCommunityChannelResp)(nil),        // 73: openim.groupext.joinCommunityChannelResp
+	(*QuitCommunityReq)(nil),                // 74: openim.groupext.quitCommunityReq
+	(*QuitCommunityResp)(nil),               // 75: openim.groupext.quitCommunityResp
+	(*KickCommunityMemberReq)(nil),          // 76: openim.groupext.kickCommunityMemberReq
+	(*KickCommunityMemberResp)(nil),         // 77: openim.groupext.kickCommunityMemberResp
+	(*SetCommunityMemberRoleReq)(nil),       // 78: openim.groupext.setCommunityMemberRoleReq
+	(*SetCommunityMemberRoleResp)(nil),      // 79: openim.groupext.setCommunityMemberRoleResp
+	(*GetCommunityMembersReq)(nil),          // 80: openim.groupext.getCommunityMembersReq
+	(*GetCommunityMembersResp)(nil),         // 81: openim.groupext.getCommunityMembersResp
+	(*GetJoinedCommunitiesReq)(nil),         // 82: openim.groupext.getJoinedCommunitiesReq
+	(*GetJoinedCommunitiesResp)(nil),        // 83: openim.groupext.getJoinedCommunitiesResp
+	(*SendCommunityAnnouncementReq)(nil),    // 84: openim.groupext.sendCommunityAnnouncementReq
+	(*SendCommunityAnnouncementResp)(nil),   // 85: openim.groupext.sendCommunityAnnouncementResp
+	(*CommunityAnnouncementTips)(nil),       // 86: openim.groupext.communityAnnouncementTips
+	(*GetGroupCommunityReq)(nil),            // 87: openim.groupext.getGroupCommunityReq
+	(*GetGroupCommunityResp)(nil),           // 88: openim.groupext.getGroupCommunityResp
+	(*GetCommunityMemberReq)(nil),           // 89: openim.groupext.getCommunityMemberReq
+	(*GetCommunityMemberResp)(nil),          // 90: openim.groupext.getCommunityMemberResp
+	(*FindCommunityMemberIDsReq)(nil),       // 91: openim.groupext.findCommunityMemberIDsReq
+	(*FindCommunityMemberIDsResp)(nil),      // 92: openim.groupext.findCommunityMemberIDsResp
+	(*SharedGroupMember)(nil),               // 93: openim.groupext.sharedGroupMember
+	(*GetSharedGroupMembersReq)(nil),        // 94: openim.groupext.getSharedGroupMembersReq
+	(*GetSharedGroupMembersResp)(nil),       // 95: openim.groupext.getSharedGroupMembersResp
+	(*PurgeUserGroupsReq)(nil),              // 96: openim.groupext.purgeUserGroupsReq
+	(*PurgeUserGroupsResp)(nil),             // 97: openim.groupext.purgeUserGroupsResp
+	(*sdkws.GroupMemberFullInfo)(nil),       // 98: openim.sdkws.GroupMemberFullInfo
+	(*sdkws.RequestPagination)(nil),         // 99: openim.sdkws.RequestPagination
+}
+var file_groupext_groupext_proto_depIdxs = []int32{
+	0,  // 0: openim.groupext.getGroupRolesResp.roles:type_name -> openim.groupext.groupRole
+	9,  // 1: openim.groupext.createGroupInviteLinkResp.link:type_name -> openim.groupext.groupInviteLink
+	9,  // 2: openim.groupext.getGroupInviteLinksResp.links:type_name -> openim.groupext.groupInviteLink
+	16, // 3: openim.groupext.setGroupMsgPolicyReq.policy:type_name -> openim.groupext.groupMsgPolicy
+	16, // 4: openim.groupext.getGroupMsgPolicyResp.policy:type_name -> openim.groupext.groupMsgPolicy
+	16, // 5: openim.groupext.groupMsgPolicySetTips.policy:type_name -> openim.groupext.groupMsgPolicy
+	98, // 6: openim.groupext.groupMsgPolicySetTips.opUser:type_name -> openim.sdkws.GroupMemberFullInfo
+	22, // 7: openim.groupext.groupJoinQuestionnaire.questions:type_name -> openim.groupext.groupJoinQuestion
+	24, // 8: openim.groupext.setGroupJoinQuestionnaireReq.questionnaire:type_name -> openim.groupext.groupJoinQuestionnaire
+	24, // 9: openim.groupext.getGroupJoinQuestionnaireResp.questionnaire:type_name -> openim.groupext.groupJoinQuestionnaire
+	23, // 10: openim.groupext.applyJoinGroupReq.answers:type_name -> openim.groupext.groupJoinAnswer
+	23, // 11: openim.groupext.groupRequestAnswers.answers:type_name -> openim.groupext.groupJoinAnswer
+	31, // 12: openim.groupext.getGroupRequestAnswersResp.requests:type_name -> openim.groupext.groupRequestAnswers
+	99, // 13: openim.groupext.getGroupRequestAuditsReq.pagination:type_name -> openim.sdkws.RequestPagination
+	36, // 14: openim.groupext.getGroupRequestAuditsResp.audits:type_name -> openim.groupext.groupRequestAudit
+	39, // 15: openim.groupext.setGroupDirectorySettingReq.setting:type_name -> openim.groupext.groupDirectorySetting
+	39, // 16: openim.groupext.getGroupDirectorySettingResp.setting:type_name -> openim.groupext.groupDirectorySetting
+	99, // 17: openim.groupext.searchGroupDirectoryReq.pagination:type_name -> openim.sdkws.RequestPagination
+	46, // 18: openim.groupext.searchGroupDirectoryResp.groups:type_name -> openim.groupext.directoryGroup
+	51, // 19: openim.groupext.createCommunityReq.community:type_name -> openim.groupext.community
+	51, // 20: openim.groupext.createCommunityResp.community:type_name -> openim.groupext.community
+	51, // 21: openim.groupext.getCommunitiesInfoResp.communities:type_name -> openim.groupext.community
+	53, // 22: openim.groupext.getCommunityChannelsResp.channels:type_name -> openim.groupext.communityChannel
+	99, // 23: openim.groupext.getCommunityMembersReq.pagination:type_name -> openim.sdkws.RequestPagination
+	52, // 24: openim.groupext.getCommunityMembersResp.members:type_name -> openim.groupext.communityMember
+	51, // 25: openim.groupext.getJoinedCommunitiesResp.communities:type_name -> openim.groupext.community
+	52, // 26: openim.groupext.getCommunityMemberResp.member:type_name -> openim.groupext.communityMember
+	93, // 27: openim.groupext.getSharedGroupMembersResp.members:type_name -> openim.groupext.sharedGroupMember
+	1,  // 28: openim.groupext.GroupExt.createGroupRole:input_type -> openim.groupext.createGroupRoleReq
+	3,  // 29: openim.groupext.GroupExt.updateGroupRole:input_type -> openim.groupext.updateGroupRoleReq
+	5,  // 30: openim.groupext.GroupExt.deleteGroupRole:input_type -> openim.groupext.deleteGroupRoleReq
+	7,  // 31: openim.groupext.GroupExt.getGroupRoles:input_type -> openim.groupext.getGroupRolesReq
+	10, // 32: openim.groupext.GroupExt.createGroupInviteLink:input_type -> openim.groupext.createGroupInviteLinkReq
+	12, // 33: openim.groupext.GroupExt.revokeGroupInviteLink:input_type -> openim.groupext.revokeGroupInviteLinkReq
+	14, // 34: openim.groupext.GroupExt.getGroupInviteLinks:input_type -> openim.groupext.getGroupInviteLinksReq
+	17, // 35: openim.groupext.GroupExt.setGroupMsgPolicy:input_type -> openim.groupext.setGroupMsgPolicyReq
+	19, // 36: openim.groupext.GroupExt.getGroupMsgPolicy:input_type -> openim.groupext.getGroupMsgPolicyReq
+	25, // 37: openim.groupext.GroupExt.setGroupJoinQuestionnaire:input_type -> openim.groupext.setGroupJoinQuestionnaireReq
+	27, // 38: openim.groupext.GroupExt.getGroupJoinQuestionnaire:input_type -> openim.groupext.getGroupJoinQuestionnaireReq
+	29, // 39: openim.groupext.GroupExt.applyJoinGroup:input_type -> openim.groupext.applyJoinGroupReq
+	32, // 40: openim.groupext.GroupExt.getGroupRequestAnswers:input_type -> openim.groupext.getGroupRequestAnswersReq
+	34, // 41: openim.groupext.GroupExt.expireGroupRequests:input_type -> openim.groupext.expireGroupRequestsReq
+	37, // 42: openim.groupext.GroupExt.getGroupRequestAudits:input_type -> openim.groupext.getGroupRequestAuditsReq
+	40, // 43: openim.groupext.GroupExt.setGroupDirectorySetting:input_type -> openim.groupext.setGroupDirectorySettingReq
+	42, // 44: openim.groupext.GroupExt.getGroupDirectorySetting:input_type -> openim.groupext.getGroupDirectorySettingReq
+	44, // 45: openim.groupext.GroupExt.getGroupDirectoryCategories:input_type -> openim.groupext.getGroupDirectoryCategoriesReq
+	47, // 46: openim.groupext.GroupExt.searchGroupDirectory:input_type -> openim.groupext.searchGroupDirectoryReq
+	49, // 47: openim.groupext.GroupExt.refreshGroupDirectory:input_type -> openim.groupext.refreshGroupDirectoryReq
+	54, // 48: openim.groupext.GroupExt.createCommunity:input_type -> openim.groupext.createCommunityReq
+	56, // 49: openim.groupext.GroupExt.setCommunityInfo:input_type -> openim.groupext.setCommunityInfoReq
+	58, // 50: openim.groupext.GroupExt.getCommunitiesInfo:input_type -> openim.groupext.getCommunitiesInfoReq
+	60, // 51: openim.groupext.GroupExt.dismissCommunity:input_type -> openim.groupext.dismissCommunityReq
+	62, // 52: openim.groupext.GroupExt.setCommunityChannel:input_type -> openim.groupext.setCommunityChannelReq
+	64, // 53: openim.groupext.GroupExt.removeCommunityChannel:input_type -> openim.groupext.removeCommunityChannelReq
+	66, // 54: openim.groupext.GroupExt.getCommunityChannels:input_type -> openim.groupext.getCommunityChannelsReq
+	68, // 55: openim.groupext.GroupExt.joinCommunity:input_type -> openim.groupext.joinCommunityReq
+	70, // 56: openim.groupext.GroupExt.inviteToCommunity:input_type -> openim.groupext.inviteToCommunityReq
+	72, // 57: openim.groupext.GroupExt.joinCommunityChannel:input_type -> openim.groupext.joinCommunityChannelReq
+	74, // 58: openim.groupext.GroupExt.quitCommunity:input_type -> openim.groupext.quitCommunityReq
+	76, // 59: openim.groupext.GroupExt.kickCommunityMember:input_type -> openim.groupext.kickCommunityMemberReq
+	78, // 60: openim.groupext.GroupExt.setCommunityMemberRole:input_type -> openim.groupext.setCommunityMemberRoleReq
+	80, // 61: openim.groupext.GroupExt.getCommunityMembers:input_type -> openim.groupext.getCommunityMembersReq
+	82, // 62: openim.groupext.GroupExt.getJoinedCommunities:input_type -> openim.groupext.getJoinedCommunitiesReq
+	84, // 63: openim.groupext.GroupExt.sendCommunityAnnouncement:input_type -> openim.groupext.sendCommunityAnnouncementReq
+	87, // 64: openim.groupext.GroupExt.getGroupCommunity:input_type -> openim.groupext.getGroupCommunityReq
+	89, // 65: openim.groupext.GroupExt.getCommunityMember:input_type -> openim.groupext.getCommunityMemberReq
+	91, // 66: openim.groupext.GroupExt.findCommunityMemberIDs:input_type -> openim.groupext.findCommunityMemberIDsReq
+	94, // 67: openim.groupext.GroupExt.getSharedGroupMembers:input_type -> openim.groupext.getSharedGroupMembersReq
+	96, // 68: openim.groupext.GroupExt.purgeUserGroups:input_type -> openim.groupext.purgeUserGroupsReq
+	2,  // 69: openim.groupext.GroupExt.createGroupRole:output_type -> openim.groupext.createGroupRoleResp
+	4,  // 70: openim.groupext.GroupExt.updateGroupRole:output_type -> openim.groupext.updateGroupRoleResp
+	6,  // 71: openim.groupext.GroupExt.deleteGroupRole:output_type -> openim.groupext.deleteGroupRoleResp
+	8,  // 72: openim.groupext.GroupExt.getGroupRoles:output_type -> openim.groupext.getGroupRolesResp
+	11, // 73: openim.groupext.GroupExt.createGroupInviteLink:output_type -> openim.groupext.createGroupInviteLinkResp
+	13, // 74: openim.groupext.GroupExt.revokeGroupInviteLink:output_type -> openim.groupext.revokeGroupInviteLinkResp
+	15, // 75: openim.groupext.GroupExt.getGroupInviteLinks:output_type -> openim.groupext.getGroupInviteLinksResp
+	18, // 76: openim.groupext.GroupExt.setGroupMsgPolicy:output_type -> openim.groupext.setGroupMsgPolicyResp
+	20, // 77: openim.groupext.GroupExt.getGroupMsgPolicy:output_type -> openim.groupext.getGroupMsgPolicyResp
+	26, // 78: openim.groupext.GroupExt.setGroupJoinQuestionnaire:output_type -> openim.groupext.setGroupJoinQuestionnaireResp
+	28, // 79: openim.groupext.GroupExt.getGroupJoinQuestionnaire:output_type -> openim.groupext.getGroupJoinQuestionnaireResp
+	30, // 80: openim.groupext.GroupExt.applyJoinGroup:output_type -> openim.groupext.applyJoinGroupResp
+	33, // 81: openim.groupext.GroupExt.getGroupRequestAnswers:output_type -> openim.groupext.getGroupRequestAnswersResp
+	35, // 82: openim.groupext.GroupExt.expireGroupRequests:output_type -> openim.groupext.expireGroupRequestsResp
+	38, // 83: openim.groupext.GroupExt.getGroupRequestAudits:output_type -> openim.groupext.getGroupRequestAuditsResp
+	41, // 84: openim.groupext.GroupExt.setGroupDirectorySetting:output_type -> openim.groupext.setGroupDirectorySettingResp
+	43, // 85: openim.groupext.GroupExt.getGroupDirectorySetting:output_type -> openim.groupext.getGroupDirectorySettingResp
+	45, // 86: openim.groupext.GroupExt.getGroupDirectoryCategories:output_type -> openim.groupext.getGroupDirectoryCategoriesResp
+	48, // 87: openim.groupext.GroupExt.searchGroupDirectory:output_type -> openim.groupext.searchGroupDirectoryResp
+	50, // 88: openim.groupext.GroupExt.refreshGroupDirectory:output_type -> openim.groupext.refreshGroupDirectoryResp
+	55, // 89: openim.groupext.GroupExt.createCommunity:output_type -> openim.groupext.createCommunityResp
+	57, // 90: openim.groupext.GroupExt.setCommunityInfo:output_type -> openim.groupext.setCommunityInfoResp
+	59, // 91: openim.groupext.GroupExt.getCommunitiesInfo:output_type -> openim.groupext.getCommunitiesInfoResp
+	61, // 92: openim.groupext.GroupExt.dismissCommunity:output_type -> openim.groupext.dismissCommunityResp
+	63, // 93: openim.groupext.GroupExt.setCommunityChannel:output_type -> openim.groupext.setCommunityChannelResp
+	65, // 94: openim.groupext.GroupExt.removeCommunityChannel:output_type -> openim.groupext.removeCommunityChannelResp
+	67, // 95: openim.groupext.GroupExt.getCommunityChannels:output_type -> openim.groupext.getCommunityChannelsResp
+	69, // 96: openim.groupext.GroupExt.joinCommunity:output_type -> openim.groupext.joinCommunityResp
+	71, // 97: openim.groupext.GroupExt.inviteToCommunity:output_type -> openim.groupext.inviteToCommunityResp
+	73, // 98: openim.groupext.GroupExt.joinCommunityChannel:output_type -> openim.groupext.joinCommunityChannelResp
+	75, // 99: openim.groupext.GroupExt.quitCommunity:output_type -> openim.groupext.quitCommunityResp
+	77, // 100: openim.groupext.GroupExt.kickCommunityMember:output_type -> openim.groupext.kickCommunityMemberResp
+	79, // 101: openim.groupext.GroupExt.setCommunityMemberRole:output_type -> openim.groupext.setCommunityMemberRoleResp
+	81, // 102: openim.groupext.GroupExt.getCommunityMembers:output_type -> openim.groupext.getCommunityMembersResp
+	83, // 103: openim.groupext.GroupExt.getJoinedCommunities:output_type -> openim.groupext.getJoinedCommunitiesResp
+	85, // 104: openim.groupext.GroupExt.sendCommunityAnnouncement:output_type -> openim.groupext.sendCommunityAnnouncementResp
+	88, // 105: openim.groupext.GroupExt.getGroupCommunity:output_type -> openim.groupext.getGroupCommunityResp
+	90, // 106: openim.groupext.GroupExt.getCommunityMember:output_type -> openim.groupext.getCommunityMemberResp
+	92, // 107: openim.groupext.GroupExt.findCommunityMemberIDs:output_type -> openim.groupext.findCommunityMemberIDsResp
+	95, // 108: openim.groupext.GroupExt.getSharedGroupMembers:output_type -> openim.groupext.getSharedGroupMembersResp
+	97, // 109: openim.groupext.GroupExt.purgeUserGroups:output_type -> openim.groupext.purgeUserGroupsResp
+	69, // [69:110] is the sub-list for method output_type
+	28, // [28:69] is the sub-list for method input_type
+	28, // [28:28] is the sub-list for extension type_name
+	28, // [28:28] is the sub-list for extension extendee
+	0,  // [0:28] is the sub-list for field type_name
+}
+
+func init() { file_groupext_groupext_proto_init() }
+func file_groupext_groupext_proto_init() {
+	if File_groupext_groupext_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_groupext_groupext_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupRole); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateGroupRoleReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateGroupRoleResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateGroupRoleReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateGroupRoleResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteGroupRoleReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteGroupRoleResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGroupRolesReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGroupRolesResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupInviteLink); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateGroupInviteLinkReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateGroupInviteLinkResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevokeGroupInviteLinkReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevokeGroupInviteLinkResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGroupInviteLinksReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGroupInviteLinksResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupMsgPolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetGroupMsgPolicyReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetGroupMsgPolicyResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGroupMsgPolicyReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGroupMsgPolicyResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupMsgPolicySetTips); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupJoinQuestion); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupJoinAnswer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupJoinQuestionnaire); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetGroupJoinQuestionnaireReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetGroupJoinQuestionnaireResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGroupJoinQuestionnaireReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGroupJoinQuestionnaireResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApplyJoinGroupReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApplyJoinGroupResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupRequestAnswers); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGroupRequestAnswersReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGroupRequestAnswersResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExpireGroupRequestsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExpireGroupRequestsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupRequestAudit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGroupRequestAuditsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGroupRequestAuditsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupDirectorySetting); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetGroupDirectorySettingReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetGroupDirectorySettingResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGroupDirectorySettingReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGroupDirectorySettingResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGroupDirectoryCategoriesReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGroupDirectoryCategoriesResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DirectoryGroup); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchGroupDirectoryReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchGroupDirectoryResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RefreshGroupDirectoryReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RefreshGroupDirectoryResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Community); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommunityMember); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommunityChannel); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateCommunityReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateCommunityResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetCommunityInfoReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetCommunityInfoResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCommunitiesInfoReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCommunitiesInfoResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DismissCommunityReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DismissCommunityResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetCommunityChannelReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetCommunityChannelResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveCommunityChannelReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveCommunityChannelResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCommunityChannelsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCommunityChannelsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JoinCommunityReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JoinCommunityResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InviteToCommunityReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InviteToCommunityResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JoinCommunityChannelReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JoinCommunityChannelResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuitCommunityReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuitCommunityResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KickCommunityMemberReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KickCommunityMemberResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetCommunityMemberRoleReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetCommunityMemberRoleResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCommunityMembersReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCommunityMembersResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetJoinedCommunitiesReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetJoinedCommunitiesResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendCommunityAnnouncementReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendCommunityAnnouncementResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommunityAnnouncementTips); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGroupCommunityReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGroupCommunityResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCommunityMemberReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCommunityMemberResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindCommunityMemberIDsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindCommunityMemberIDsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SharedGroupMember); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSharedGroupMembersReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSharedGroupMembersResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PurgeUserGroupsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PurgeUserGroupsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_groupext_groupext_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   98,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_groupext_groupext_proto_goTypes,
+		DependencyIndexes: file_groupext_groupext_proto_depIdxs,
+		MessageInfos:      file_groupext_groupext_proto_msgTypes,
+	}.Build()
+	File_groupext_groupext_proto = out.File
+	file_groupext_groupext_proto_rawDesc = nil
+	file_groupext_groupext_proto_goTypes = nil
+	file_groupext_groupext_proto_depIdxs = nil
+}
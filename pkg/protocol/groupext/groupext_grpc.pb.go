@@ -0,0 +1,1667 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: groupext/groupext.proto
+
+package groupext
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	GroupExt_CreateGroupRole_FullMethodName             = "/openim.groupext.GroupExt/createGroupRole"
+	GroupExt_UpdateGroupRole_FullMethodName             = "/openim.groupext.GroupExt/updateGroupRole"
+	GroupExt_DeleteGroupRole_FullMethodName             = "/openim.groupext.GroupExt/deleteGroupRole"
+	GroupExt_GetGroupRoles_FullMethodName               = "/openim.groupext.GroupExt/getGroupRoles"
+	GroupExt_CreateGroupInviteLink_FullMethodName       = "/openim.groupext.GroupExt/createGroupInviteLink"
+	GroupExt_RevokeGroupInviteLink_FullMethodName       = "/openim.groupext.GroupExt/revokeGroupInviteLink"
+	GroupExt_GetGroupInviteLinks_FullMethodName         = "/openim.groupext.GroupExt/getGroupInviteLinks"
+	GroupExt_SetGroupMsgPolicy_FullMethodName           = "/openim.groupext.GroupExt/setGroupMsgPolicy"
+	GroupExt_GetGroupMsgPolicy_FullMethodName           = "/openim.groupext.GroupExt/getGroupMsgPolicy"
+	GroupExt_SetGroupJoinQuestionnaire_FullMethodName   = "/openim.groupext.GroupExt/setGroupJoinQuestionnaire"
+	GroupExt_GetGroupJoinQuestionnaire_FullMethodName   = "/openim.groupext.GroupExt/getGroupJoinQuestionnaire"
+	GroupExt_ApplyJoinGroup_FullMethodName              = "/openim.groupext.GroupExt/applyJoinGroup"
+	GroupExt_GetGroupRequestAnswers_FullMethodName      = "/openim.groupext.GroupExt/getGroupRequestAnswers"
+	GroupExt_ExpireGroupRequests_FullMethodName         = "/openim.groupext.GroupExt/expireGroupRequests"
+	GroupExt_GetGroupRequestAudits_FullMethodName       = "/openim.groupext.GroupExt/getGroupRequestAudits"
+	GroupExt_SetGroupDirectorySetting_FullMethodName    = "/openim.groupext.GroupExt/setGroupDirectorySetting"
+	GroupExt_GetGroupDirectorySetting_FullMethodName    = "/openim.groupext.GroupExt/getGroupDirectorySetting"
+	GroupExt_GetGroupDirectoryCategories_FullMethodName = "/openim.groupext.GroupExt/getGroupDirectoryCategories"
+	GroupExt_SearchGroupDirectory_FullMethodName        = "/openim.groupext.GroupExt/searchGroupDirectory"
+	GroupExt_RefreshGroupDirectory_FullMethodName       = "/openim.groupext.GroupExt/refreshGroupDirectory"
+	GroupExt_CreateCommunity_FullMethodName             = "/openim.groupext.GroupExt/createCommunity"
+	GroupExt_SetCommunityInfo_FullMethodName            = "/openim.groupext.GroupExt/setCommunityInfo"
+	GroupExt_GetCommunitiesInfo_FullMethodName          = "/openim.groupext.GroupExt/getCommunitiesInfo"
+	GroupExt_DismissCommunity_FullMethodName            = "/openim.groupext.GroupExt/dismissCommunity"
+	GroupExt_SetCommunityChannel_FullMethodName         = "/openim.groupext.GroupExt/setCommunityChannel"
+	GroupExt_RemoveCommunityChannel_FullMethodName      = "/openim.groupext.GroupExt/removeCommunityChannel"
+	GroupExt_GetCommunityChannels_FullMethodName        = "/openim.groupext.GroupExt/getCommunityChannels"
+	GroupExt_JoinCommunity_FullMethodName               = "/openim.groupext.GroupExt/joinCommunity"
+	GroupExt_InviteToCommunity_FullMethodName           = "/openim.groupext.GroupExt/inviteToCommunity"
+	GroupExt_JoinCommunityChannel_FullMethodName        = "/openim.groupext.GroupExt/joinCommunityChannel"
+	GroupExt_QuitCommunity_FullMethodName               = "/openim.groupext.GroupExt/quitCommunity"
+	GroupExt_KickCommunityMember_FullMethodName         = "/openim.groupext.GroupExt/kickCommunityMember"
+	GroupExt_SetCommunityMemberRole_FullMethodName      = "/openim.groupext.GroupExt/setCommunityMemberRole"
+	GroupExt_GetCommunityMembers_FullMethodName         = "/openim.groupext.GroupExt/getCommunityMembers"
+	GroupExt_GetJoinedCommunities_FullMethodName        = "/openim.groupext.GroupExt/getJoinedCommunities"
+	GroupExt_SendCommunityAnnouncement_FullMethodName   = "/openim.groupext.GroupExt/sendCommunityAnnouncement"
+	GroupExt_GetGroupCommunity_FullMethodName           = "/openim.groupext.GroupExt/getGroupCommunity"
+	GroupExt_GetCommunityMember_FullMethodName          = "/openim.groupext.GroupExt/getCommunityMember"
+	GroupExt_FindCommunityMemberIDs_FullMethodName      = "/openim.groupext.GroupExt/findCommunityMemberIDs"
+	GroupExt_GetSharedGroupMembers_FullMethodName       = "/openim.groupext.GroupExt/getSharedGroupMembers"
+	GroupExt_PurgeUserGroups_FullMethodName             = "/openim.groupext.GroupExt/purgeUserGroups"
+)
+
+// GroupExtClient is the client API for GroupExt service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type GroupExtClient interface {
+	// Only the group owner can manage the custom roles of a group
+	CreateGroupRole(ctx context.Context, in *CreateGroupRoleReq, opts ...grpc.CallOption) (*CreateGroupRoleResp, error)
+	UpdateGroupRole(ctx context.Context, in *UpdateGroupRoleReq, opts ...grpc.CallOption) (*UpdateGroupRoleResp, error)
+	// Fails while members still hold the role
+	DeleteGroupRole(ctx context.Context, in *DeleteGroupRoleReq, opts ...grpc.CallOption) (*DeleteGroupRoleResp, error)
+	GetGroupRoles(ctx context.Context, in *GetGroupRolesReq, opts ...grpc.CallOption) (*GetGroupRolesResp, error)
+	// Bypassing approval requires the invite permission
+	CreateGroupInviteLink(ctx context.Context, in *CreateGroupInviteLinkReq, opts ...grpc.CallOption) (*CreateGroupInviteLinkResp, error)
+	// Allowed to the creator of the link and members with the invite permission
+	RevokeGroupInviteLink(ctx context.Context, in *RevokeGroupInviteLinkReq, opts ...grpc.CallOption) (*RevokeGroupInviteLinkResp, error)
+	// Members with the invite permission get all links of the group, other members their own links
+	GetGroupInviteLinks(ctx context.Context, in *GetGroupInviteLinksReq, opts ...grpc.CallOption) (*GetGroupInviteLinksResp, error)
+	// Requires the editInfo permission, members are told with a group info set notification
+	SetGroupMsgPolicy(ctx context.Context, in *SetGroupMsgPolicyReq, opts ...grpc.CallOption) (*SetGroupMsgPolicyResp, error)
+	GetGroupMsgPolicy(ctx context.Context, in *GetGroupMsgPolicyReq, opts ...grpc.CallOption) (*GetGroupMsgPolicyResp, error)
+	// Requires the approveJoin permission, an empty question list removes the questionnaire
+	SetGroupJoinQuestionnaire(ctx context.Context, in *SetGroupJoinQuestionnaireReq, opts ...grpc.CallOption) (*SetGroupJoinQuestionnaireResp, error)
+	GetGroupJoinQuestionnaire(ctx context.Context, in *GetGroupJoinQuestionnaireReq, opts ...grpc.CallOption) (*GetGroupJoinQuestionnaireResp, error)
+	// JoinGroup with the answers to the questionnaire of the group or an invite link, the operator is the applicant
+	ApplyJoinGroup(ctx context.Context, in *ApplyJoinGroupReq, opts ...grpc.CallOption) (*ApplyJoinGroupResp, error)
+	// Requires the approveJoin permission
+	GetGroupRequestAnswers(ctx context.Context, in *GetGroupRequestAnswersReq, opts ...grpc.CallOption) (*GetGroupRequestAnswersResp, error)
+	// Only the app manager, called by the cron task
+	ExpireGroupRequests(ctx context.Context, in *ExpireGroupRequestsReq, opts ...grpc.CallOption) (*ExpireGroupRequestsResp, error)
+	// Requires the approveJoin permission
+	GetGroupRequestAudits(ctx context.Context, in *GetGroupRequestAuditsReq, opts ...grpc.CallOption) (*GetGroupRequestAuditsResp, error)
+	// Requires the editInfo permission
+	SetGroupDirectorySetting(ctx context.Context, in *SetGroupDirectorySettingReq, opts ...grpc.CallOption) (*SetGroupDirectorySettingResp, error)
+	GetGroupDirectorySetting(ctx context.Context, in *GetGroupDirectorySettingReq, opts ...grpc.CallOption) (*GetGroupDirectorySettingResp, error)
+	GetGroupDirectoryCategories(ctx context.Context, in *GetGroupDirectoryCategoriesReq, opts ...grpc.CallOption) (*GetGroupDirectoryCategoriesResp, error)
+	// Searches the listed groups, open to every user
+	SearchGroupDirectory(ctx context.Context, in *SearchGroupDirectoryReq, opts ...grpc.CallOption) (*SearchGroupDirectoryResp, error)
+	// Only the app manager, called by the cron task to refresh the member counts and activity used for sorting
+	RefreshGroupDirectory(ctx context.Context, in *RefreshGroupDirectoryReq, opts ...grpc.CallOption) (*RefreshGroupDirectoryResp, error)
+	// The operator becomes the owner of the community
+	CreateCommunity(ctx context.Context, in *CreateCommunityReq, opts ...grpc.CallOption) (*CreateCommunityResp, error)
+	// Requires a community admin
+	SetCommunityInfo(ctx context.Context, in *SetCommunityInfoReq, opts ...grpc.CallOption) (*SetCommunityInfoResp, error)
+	GetCommunitiesInfo(ctx context.Context, in *GetCommunitiesInfoReq, opts ...grpc.CallOption) (*GetCommunitiesInfoResp, error)
+	// Only the owner, the channels become standalone groups again
+	DismissCommunity(ctx context.Context, in *DismissCommunityReq, opts ...grpc.CallOption) (*DismissCommunityResp, error)
+	// Adds a group owned by the operator to the community or changes whether it is public, requires a community admin.
+	// Members of the group join the community
+	SetCommunityChannel(ctx context.Context, in *SetCommunityChannelReq, opts ...grpc.CallOption) (*SetCommunityChannelResp, error)
+	// Requires a community admin, the group becomes standalone again
+	RemoveCommunityChannel(ctx context.Context, in *RemoveCommunityChannelReq, opts ...grpc.CallOption) (*RemoveCommunityChannelResp, error)
+	// Private channels are only listed to their members and the community admins
+	GetCommunityChannels(ctx context.Context, in *GetCommunityChannelsReq, opts ...grpc.CallOption) (*GetCommunityChannelsResp, error)
+	// Joins the community and its public channels, fails for invite only communities
+	JoinCommunity(ctx context.Context, in *JoinCommunityReq, opts ...grpc.CallOption) (*JoinCommunityResp, error)
+	// Requires a community admin, the users join the public channels
+	InviteToCommunity(ctx context.Context, in *InviteToCommunityReq, opts ...grpc.CallOption) (*InviteToCommunityResp, error)
+	// Joins a public channel of a community the operator is a member of
+	JoinCommunityChannel(ctx context.Context, in *JoinCommunityChannelReq, opts ...grpc.CallOption) (*JoinCommunityChannelResp, error)
+	// Quits the community and all its channels, the owner cannot quit
+	QuitCommunity(ctx context.Context, in *QuitCommunityReq, opts ...grpc.CallOption) (*QuitCommunityResp, error)
+	// Requires a community admin, the users are removed from all channels
+	KickCommunityMember(ctx context.Context, in *KickCommunityMemberReq, opts ...grpc.CallOption) (*KickCommunityMemberResp, error)
+	// Only the owner, sets members to admins or ordinary members
+	SetCommunityMemberRole(ctx context.Context, in *SetCommunityMemberRoleReq, opts ...grpc.CallOption) (*SetCommunityMemberRoleResp, error)
+	GetCommunityMembers(ctx context.Context, in *GetCommunityMembersReq, opts ...grpc.CallOption) (*GetCommunityMembersResp, error)
+	GetJoinedCommunities(ctx context.Context, in *GetJoinedCommunitiesReq, opts ...grpc.CallOption) (*GetJoinedCommunitiesResp, error)
+	// Requires a community admin, the announcement is sent to every channel
+	SendCommunityAnnouncement(ctx context.Context, in *SendCommunityAnnouncementReq, opts ...grpc.CallOption) (*SendCommunityAnnouncementResp, error)
+	GetGroupCommunity(ctx context.Context, in *GetGroupCommunityReq, opts ...grpc.CallOption) (*GetGroupCommunityResp, error)
+	// Returns a not found error for users outside the community
+	GetCommunityMember(ctx context.Context, in *GetCommunityMemberReq, opts ...grpc.CallOption) (*GetCommunityMemberResp, error)
+	// The users among userIDs who are members of the community
+	FindCommunityMemberIDs(ctx context.Context, in *FindCommunityMemberIDsReq, opts ...grpc.CallOption) (*FindCommunityMemberIDsResp, error)
+	// The other members of the groups of a user, by the number of groups they share with the user
+	GetSharedGroupMembers(ctx context.Context, in *GetSharedGroupMembersReq, opts ...grpc.CallOption) (*GetSharedGroupMembersResp, error)
+	// Admin only, remove a deleted user from all groups and communities and delete their join requests.
+	// Groups and communities they own are transferred to an admin, or to the earliest member when there is no admin.
+	PurgeUserGroups(ctx context.Context, in *PurgeUserGroupsReq, opts ...grpc.CallOption) (*PurgeUserGroupsResp, error)
+}
+
+type groupExtClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGroupExtClient(cc grpc.ClientConnInterface) GroupExtClient {
+	return &groupExtClient{cc}
+}
+
+func (c *groupExtClient) CreateGroupRole(ctx context.Context, in *CreateGroupRoleReq, opts ...grpc.CallOption) (*CreateGroupRoleResp, error) {
+	out := new(CreateGroupRoleResp)
+	err := c.cc.Invoke(ctx, GroupExt_CreateGroupRole_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupExtClient) UpdateGroupRole(ctx context.Context, in *UpdateGroupRoleReq, opts ...grpc.CallOption) (*UpdateGroupRoleResp, error) {
+	out := new(UpdateGroupRoleResp)
+	err := c.cc.Invoke(ctx, GroupExt_UpdateGroupRole_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupExtClient) DeleteGroupRole(ctx context.Context, in *DeleteGroupRoleReq, opts ...grpc.CallOption) (*DeleteGroupRoleResp, error) {
+	out := new(DeleteGroupRoleResp)
+	err := c.cc.Invoke(ctx, GroupExt_DeleteGroupRole_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupExtClient) GetGroupRoles(ctx context.Context, in *GetGroupRolesReq, opts ...grpc.CallOption) (*GetGroupRolesResp, error) {
+	out := new(GetGroupRolesResp)
+	err := c.cc.Invoke(ctx, GroupExt_GetGroupRoles_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupExtClient) CreateGroupInviteLink(ctx context.Context, in *CreateGroupInviteLinkReq, opts ...grpc.CallOption) (*CreateGroupInviteLinkResp, error) {
+	out := new(CreateGroupInviteLinkResp)
+	err := c.cc.Invoke(ctx, GroupExt_CreateGroupInviteLink_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupExtClient) RevokeGroupInviteLink(ctx context.Context, in *RevokeGroupInviteLinkReq, opts ...grpc.CallOption) (*RevokeGroupInviteLinkResp, error) {
+	out := new(RevokeGroupInviteLinkResp)
+	err := c.cc.Invoke(ctx, GroupExt_RevokeGroupInviteLink_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupExtClient) GetGroupInviteLinks(ctx context.Context, in *GetGroupInviteLinksReq, opts ...grpc.CallOption) (*GetGroupInviteLinksResp, error) {
+	out := new(GetGroupInviteLinksResp)
+	err := c.cc.Invoke(ctx, GroupExt_GetGroupInviteLinks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupExtClient) SetGroupMsgPolicy(ctx context.Context, in *SetGroupMsgPolicyReq, opts ...grpc.CallOption) (*SetGroupMsgPolicyResp, error) {
+	out := new(SetGroupMsgPolicyResp)
+	err := c.cc.Invoke(ctx, GroupExt_SetGroupMsgPolicy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupExtClient) GetGroupMsgPolicy(ctx context.Context, in *GetGroupMsgPolicyReq, opts ...grpc.CallOption) (*GetGroupMsgPolicyResp, error) {
+	out := new(GetGroupMsgPolicyResp)
+	err := c.cc.Invoke(ctx, GroupExt_GetGroupMsgPolicy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupExtClient) SetGroupJoinQuestionnaire(ctx context.Context, in *SetGroupJoinQuestionnaireReq, opts ...grpc.CallOption) (*SetGroupJoinQuestionnaireResp, error) {
+	out := new(SetGroupJoinQuestionnaireResp)
+	err := c.cc.Invoke(ctx, GroupExt_SetGroupJoinQuestionnaire_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupExtClient) GetGroupJoinQuestionnaire(ctx context.Context, in *GetGroupJoinQuestionnaireReq, opts ...grpc.CallOption) (*GetGroupJoinQuestionnaireResp, error) {
+	out := new(GetGroupJoinQuestionnaireResp)
+	err := c.cc.Invoke(ctx, GroupExt_GetGroupJoinQuestionnaire_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupExtClient) ApplyJoinGroup(ctx context.Context, in *ApplyJoinGroupReq, opts ...grpc.CallOption) (*ApplyJoinGroupResp, error) {
+	out := new(ApplyJoinGroupResp)
+	err := c.cc.Invoke(ctx, GroupExt_ApplyJoinGroup_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupExtClient) GetGroupRequestAnswers(ctx context.Context, in *GetGroupRequestAnswersReq, opts ...grpc.CallOption) (*GetGroupRequestAnswersResp, error) {
+	out := new(GetGroupRequestAnswersResp)
+	err := c.cc.Invoke(ctx, GroupExt_GetGroupRequestAnswers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupExtClient) ExpireGroupRequests(ctx context.Context, in *ExpireGroupRequestsReq, opts ...grpc.CallOption) (*ExpireGroupRequestsResp, error) {
+	out := new(ExpireGroupRequestsResp)
+	err := c.cc.Invoke(ctx, GroupExt_ExpireGroupRequests_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupExtClient) GetGroupRequestAudits(ctx context.Context, in *GetGroupRequestAuditsReq, opts ...grpc.CallOption) (*GetGroupRequestAuditsResp, error) {
+	out := new(GetGroupRequestAuditsResp)
+	err := c.cc.Invoke(ctx, GroupExt_GetGroupRequestAudits_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupExtClient) SetGroupDirectorySetting(ctx context.Context, in *SetGroupDirectorySettingReq, opts ...grpc.CallOption) (*SetGroupDirectorySettingResp, error) {
+	out := new(SetGroupDirectorySettingResp)
+	err := c.cc.Invoke(ctx, GroupExt_SetGroupDirectorySetting_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupExtClient) GetGroupDirectorySetting(ctx context.Context, in *GetGroupDirectorySettingReq, opts ...grpc.CallOption) (*GetGroupDirectorySettingResp, error) {
+	out := new(GetGroupDirectorySettingResp)
+	err := c.cc.Invoke(ctx, GroupExt_GetGroupDirectorySetting_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupExtClient) GetGroupDirectoryCategories(ctx context.Context, in *GetGroupDirectoryCategoriesReq, opts ...grpc.CallOption) (*GetGroupDirectoryCategoriesResp, error) {
+	out := new(GetGroupDirectoryCategoriesResp)
+	err := c.cc.Invoke(ctx, GroupExt_GetGroupDirectoryCategories_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupExtClient) SearchGroupDirectory(ctx context.Context, in *SearchGroupDirectoryReq, opts ...grpc.CallOption) (*SearchGroupDirectoryResp, error) {
+	out := new(SearchGroupDirectoryResp)
+	err := c.cc.Invoke(ctx, GroupExt_SearchGroupDirectory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupExtClient) RefreshGroupDirectory(ctx context.Context, in *RefreshGroupDirectoryReq, opts ...grpc.CallOption) (*RefreshGroupDirectoryResp, error) {
+	out := new(RefreshGroupDirectoryResp)
+	err := c.cc.Invoke(ctx, GroupExt_RefreshGroupDirectory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupExtClient) CreateCommunity(ctx context.Context, in *CreateCommunityReq, opts ...grpc.CallOption) (*CreateCommunityResp, error) {
+	out := new(CreateCommunityResp)
+	err := c.cc.Invoke(ctx, GroupExt_CreateCommunity_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupExtClient) SetCommunityInfo(ctx context.Context, in *SetCommunityInfoReq, opts ...grpc.CallOption) (*SetCommunityInfoResp, error) {
+	out := new(SetCommunityInfoResp)
+	err := c.cc.Invoke(ctx, GroupExt_SetCommunityInfo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupExtClient) GetCommunitiesInfo(ctx context.Context, in *GetCommunitiesInfoReq, opts ...grpc.CallOption) (*GetCommunitiesInfoResp, error) {
+	out := new(GetCommunitiesInfoResp)
+	err := c.cc.Invoke(ctx, GroupExt_GetCommunitiesInfo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupExtClient) DismissCommunity(ctx context.Context, in *DismissCommunityReq, opts ...grpc.CallOption) (*DismissCommunityResp, error) {
+	out := new(DismissCommunityResp)
+	err := c.cc.Invoke(ctx, GroupExt_DismissCommunity_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupExtClient) SetCommunityChannel(ctx context.Context, in *SetCommunityChannelReq, opts ...grpc.CallOption) (*SetCommunityChannelResp, error) {
+	out := new(SetCommunityChannelResp)
+	err := c.cc.Invoke(ctx, GroupExt_SetCommunityChannel_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupExtClient) RemoveCommunityChannel(ctx context.Context, in *RemoveCommunityChannelReq, opts ...grpc.CallOption) (*RemoveCommunityChannelResp, error) {
+	out := new(RemoveCommunityChannelResp)
+	err := c.cc.Invoke(ctx, GroupExt_RemoveCommunityChannel_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupExtClient) GetCommunityChannels(ctx context.Context, in *GetCommunityChannelsReq, opts ...grpc.CallOption) (*GetCommunityChannelsResp, error) {
+	out := new(GetCommunityChannelsResp)
+	err := c.cc.Invoke(ctx, GroupExt_GetCommunityChannels_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupExtClient) JoinCommunity(ctx context.Context, in *JoinCommunityReq, opts ...grpc.CallOption) (*JoinCommunityResp, error) {
+	out := new(JoinCommunityResp)
+	err := c.cc.Invoke(ctx, GroupExt_JoinCommunity_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupExtClient) InviteToCommunity(ctx context.Context, in *InviteToCommunityReq, opts ...grpc.CallOption) (*InviteToCommunityResp, error) {
+	out := new(InviteToCommunityResp)
+	err := c.cc.Invoke(ctx, GroupExt_InviteToCommunity_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupExtClient) JoinCommunityChannel(ctx context.Context, in *JoinCommunityChannelReq, opts ...grpc.CallOption) (*JoinCommunityChannelResp, error) {
+	out := new(JoinCommunityChannelResp)
+	err := c.cc.Invoke(ctx, GroupExt_JoinCommunityChannel_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupExtClient) QuitCommunity(ctx context.Context, in *QuitCommunityReq, opts ...grpc.CallOption) (*QuitCommunityResp, error) {
+	out := new(QuitCommunityResp)
+	err := c.cc.Invoke(ctx, GroupExt_QuitCommunity_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupExtClient) KickCommunityMember(ctx context.Context, in *KickCommunityMemberReq, opts ...grpc.CallOption) (*KickCommunityMemberResp, error) {
+	out := new(KickCommunityMemberResp)
+	err := c.cc.Invoke(ctx, GroupExt_KickCommunityMember_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupExtClient) SetCommunityMemberRole(ctx context.Context, in *SetCommunityMemberRoleReq, opts ...grpc.CallOption) (*SetCommunityMemberRoleResp, error) {
+	out := new(SetCommunityMemberRoleResp)
+	err := c.cc.Invoke(ctx, GroupExt_SetCommunityMemberRole_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupExtClient) GetCommunityMembers(ctx context.Context, in *GetCommunityMembersReq, opts ...grpc.CallOption) (*GetCommunityMembersResp, error) {
+	out := new(GetCommunityMembersResp)
+	err := c.cc.Invoke(ctx, GroupExt_GetCommunityMembers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupExtClient) GetJoinedCommunities(ctx context.Context, in *GetJoinedCommunitiesReq, opts ...grpc.CallOption) (*GetJoinedCommunitiesResp, error) {
+	out := new(GetJoinedCommunitiesResp)
+	err := c.cc.Invoke(ctx, GroupExt_GetJoinedCommunities_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupExtClient) SendCommunityAnnouncement(ctx context.Context, in *SendCommunityAnnouncementReq, opts ...grpc.CallOption) (*SendCommunityAnnouncementResp, error) {
+	out := new(SendCommunityAnnouncementResp)
+	err := c.cc.Invoke(ctx, GroupExt_SendCommunityAnnouncement_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupExtClient) GetGroupCommunity(ctx context.Context, in *GetGroupCommunityReq, opts ...grpc.CallOption) (*GetGroupCommunityResp, error) {
+	out := new(GetGroupCommunityResp)
+	err := c.cc.Invoke(ctx, GroupExt_GetGroupCommunity_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupExtClient) GetCommunityMember(ctx context.Context, in *GetCommunityMemberReq, opts ...grpc.CallOption) (*GetCommunityMemberResp, error) {
+	out := new(GetCommunityMemberResp)
+	err := c.cc.Invoke(ctx, GroupExt_GetCommunityMember_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupExtClient) FindCommunityMemberIDs(ctx context.Context, in *FindCommunityMemberIDsReq, opts ...grpc.CallOption) (*FindCommunityMemberIDsResp, error) {
+	out := new(FindCommunityMemberIDsResp)
+	err := c.cc.Invoke(ctx, GroupExt_FindCommunityMemberIDs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupExtClient) GetSharedGroupMembers(ctx context.Context, in *GetSharedGroupMembersReq, opts ...grpc.CallOption) (*GetSharedGroupMembersResp, error) {
+	out := new(GetSharedGroupMembersResp)
+	err := c.cc.Invoke(ctx, GroupExt_GetSharedGroupMembers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupExtClient) PurgeUserGroups(ctx context.Context, in *PurgeUserGroupsReq, opts ...grpc.CallOption) (*PurgeUserGroupsResp, error) {
+	out := new(PurgeUserGroupsResp)
+	err := c.cc.Invoke(ctx, GroupExt_PurgeUserGroups_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GroupExtServer is the server API for GroupExt service.
+// All implementations should embed UnimplementedGroupExtServer
+// for forward compatibility
+type GroupExtServer interface {
+	// Only the group owner can manage the custom roles of a group
+	CreateGroupRole(context.Context, *CreateGroupRoleReq) (*CreateGroupRoleResp, error)
+	UpdateGroupRole(context.Context, *UpdateGroupRoleReq) (*UpdateGroupRoleResp, error)
+	// Fails while members still hold the role
+	DeleteGroupRole(context.Context, *DeleteGroupRoleReq) (*DeleteGroupRoleResp, error)
+	GetGroupRoles(context.Context, *GetGroupRolesReq) (*GetGroupRolesResp, error)
+	// Bypassing approval requires the invite permission
+	CreateGroupInviteLink(context.Context, *CreateGroupInviteLinkReq) (*CreateGroupInviteLinkResp, error)
+	// Allowed to the creator of the link and members with the invite permission
+	RevokeGroupInviteLink(context.Context, *RevokeGroupInviteLinkReq) (*RevokeGroupInviteLinkResp, error)
+	// Members with the invite permission get all links of the group, other members their own links
+	GetGroupInviteLinks(context.Context, *GetGroupInviteLinksReq) (*GetGroupInviteLinksResp, error)
+	// Requires the editInfo permission, members are told with a group info set notification
+	SetGroupMsgPolicy(context.Context, *SetGroupMsgPolicyReq) (*SetGroupMsgPolicyResp, error)
+	GetGroupMsgPolicy(context.Context, *GetGroupMsgPolicyReq) (*GetGroupMsgPolicyResp, error)
+	// Requires the approveJoin permission, an empty question list removes the questionnaire
+	SetGroupJoinQuestionnaire(context.Context, *SetGroupJoinQuestionnaireReq) (*SetGroupJoinQuestionnaireResp, error)
+	GetGroupJoinQuestionnaire(context.Context, *GetGroupJoinQuestionnaireReq) (*GetGroupJoinQuestionnaireResp, error)
+	// JoinGroup with the answers to the questionnaire of the group or an invite link, the operator is the applicant
+	ApplyJoinGroup(context.Context, *ApplyJoinGroupReq) (*ApplyJoinGroupResp, error)
+	// Requires the approveJoin permission
+	GetGroupRequestAnswers(context.Context, *GetGroupRequestAnswersReq) (*GetGroupRequestAnswersResp, error)
+	// Only the app manager, called by the cron task
+	ExpireGroupRequests(context.Context, *ExpireGroupRequestsReq) (*ExpireGroupRequestsResp, error)
+	// Requires the approveJoin permission
+	GetGroupRequestAudits(context.Context, *GetGroupRequestAuditsReq) (*GetGroupRequestAuditsResp, error)
+	// Requires the editInfo permission
+	SetGroupDirectorySetting(context.Context, *SetGroupDirectorySettingReq) (*SetGroupDirectorySettingResp, error)
+	GetGroupDirectorySetting(context.Context, *GetGroupDirectorySettingReq) (*GetGroupDirectorySettingResp, error)
+	GetGroupDirectoryCategories(context.Context, *GetGroupDirectoryCategoriesReq) (*GetGroupDirectoryCategoriesResp, error)
+	// Searches the listed groups, open to every user
+	SearchGroupDirectory(context.Context, *SearchGroupDirectoryReq) (*SearchGroupDirectoryResp, error)
+	// Only the app manager, called by the cron task to refresh the member counts and activity used for sorting
+	RefreshGroupDirectory(context.Context, *RefreshGroupDirectoryReq) (*RefreshGroupDirectoryResp, error)
+	// The operator becomes the owner of the community
+	CreateCommunity(context.Context, *CreateCommunityReq) (*CreateCommunityResp, error)
+	// Requires a community admin
+	SetCommunityInfo(context.Context, *SetCommunityInfoReq) (*SetCommunityInfoResp, error)
+	GetCommunitiesInfo(context.Context, *GetCommunitiesInfoReq) (*GetCommunitiesInfoResp, error)
+	// Only the owner, the channels become standalone groups again
+	DismissCommunity(context.Context, *DismissCommunityReq) (*DismissCommunityResp, error)
+	// Adds a group owned by the operator to the community or changes whether it is public, requires a community admin.
+	// Members of the group join the community
+	SetCommunityChannel(context.Context, *SetCommunityChannelReq) (*SetCommunityChannelResp, error)
+	// Requires a community admin, the group becomes standalone again
+	RemoveCommunityChannel(context.Context, *RemoveCommunityChannelReq) (*RemoveCommunityChannelResp, error)
+	// Private channels are only listed to their members and the community admins
+	GetCommunityChannels(context.Context, *GetCommunityChannelsReq) (*GetCommunityChannelsResp, error)
+	// Joins the community and its public channels, fails for invite only communities
+	JoinCommunity(context.Context, *JoinCommunityReq) (*JoinCommunityResp, error)
+	// Requires a community admin, the users join the public channels
+	InviteToCommunity(context.Context, *InviteToCommunityReq) (*InviteToCommunityResp, error)
+	// Joins a public channel of a community the operator is a member of
+	JoinCommunityChannel(context.Context, *JoinCommunityChannelReq) (*JoinCommunityChannelResp, error)
+	// Quits the community and all its channels, the owner cannot quit
+	QuitCommunity(context.Context, *QuitCommunityReq) (*QuitCommunityResp, error)
+	// Requires a community admin, the users are removed from all channels
+	KickCommunityMember(context.Context, *KickCommunityMemberReq) (*KickCommunityMemberResp, error)
+	// Only the owner, sets members to admins or ordinary members
+	SetCommunityMemberRole(context.Context, *SetCommunityMemberRoleReq) (*SetCommunityMemberRoleResp, error)
+	GetCommunityMembers(context.Context, *GetCommunityMembersReq) (*GetCommunityMembersResp, error)
+	GetJoinedCommunities(context.Context, *GetJoinedCommunitiesReq) (*GetJoinedCommunitiesResp, error)
+	// Requires a community admin, the announcement is sent to every channel
+	SendCommunityAnnouncement(context.Context, *SendCommunityAnnouncementReq) (*SendCommunityAnnouncementResp, error)
+	GetGroupCommunity(context.Context, *GetGroupCommunityReq) (*GetGroupCommunityResp, error)
+	// Returns a not found error for users outside the community
+	GetCommunityMember(context.Context, *GetCommunityMemberReq) (*GetCommunityMemberResp, error)
+	// The users among userIDs who are members of the community
+	FindCommunityMemberIDs(context.Context, *FindCommunityMemberIDsReq) (*FindCommunityMemberIDsResp, error)
+	// The other members of the groups of a user, by the number of groups they share with the user
+	GetSharedGroupMembers(context.Context, *GetSharedGroupMembersReq) (*GetSharedGroupMembersResp, error)
+	// Admin only, remove a deleted user from all groups and communities and delete their join requests.
+	// Groups and communities they own are transferred to an admin, or to the earliest member when there is no admin.
+	PurgeUserGroups(context.Context, *PurgeUserGroupsReq) (*PurgeUserGroupsResp, error)
+}
+
+// UnimplementedGroupExtServer should be embedded to have forward compatible implementations.
+type UnimplementedGroupExtServer struct {
+}
+
+func (UnimplementedGroupExtServer) CreateGroupRole(context.Context, *CreateGroupRoleReq) (*CreateGroupRoleResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGroupRole not implemented")
+}
+func (UnimplementedGroupExtServer) UpdateGroupRole(context.Context, *UpdateGroupRoleReq) (*UpdateGroupRoleResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGroupRole not implemented")
+}
+func (UnimplementedGroupExtServer) DeleteGroupRole(context.Context, *DeleteGroupRoleReq) (*DeleteGroupRoleResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteGroupRole not implemented")
+}
+func (UnimplementedGroupExtServer) GetGroupRoles(context.Context, *GetGroupRolesReq) (*GetGroupRolesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGroupRoles not implemented")
+}
+func (UnimplementedGroupExtServer) CreateGroupInviteLink(context.Context, *CreateGroupInviteLinkReq) (*CreateGroupInviteLinkResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGroupInviteLink not implemented")
+}
+func (UnimplementedGroupExtServer) RevokeGroupInviteLink(context.Context, *RevokeGroupInviteLinkReq) (*RevokeGroupInviteLinkResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeGroupInviteLink not implemented")
+}
+func (UnimplementedGroupExtServer) GetGroupInviteLinks(context.Context, *GetGroupInviteLinksReq) (*GetGroupInviteLinksResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGroupInviteLinks not implemented")
+}
+func (UnimplementedGroupExtServer) SetGroupMsgPolicy(context.Context, *SetGroupMsgPolicyReq) (*SetGroupMsgPolicyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetGroupMsgPolicy not implemented")
+}
+func (UnimplementedGroupExtServer) GetGroupMsgPolicy(context.Context, *GetGroupMsgPolicyReq) (*GetGroupMsgPolicyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGroupMsgPolicy not implemented")
+}
+func (UnimplementedGroupExtServer) SetGroupJoinQuestionnaire(context.Context, *SetGroupJoinQuestionnaireReq) (*SetGroupJoinQuestionnaireResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetGroupJoinQuestionnaire not implemented")
+}
+func (UnimplementedGroupExtServer) GetGroupJoinQuestionnaire(context.Context, *GetGroupJoinQuestionnaireReq) (*GetGroupJoinQuestionnaireResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGroupJoinQuestionnaire not implemented")
+}
+func (UnimplementedGroupExtServer) ApplyJoinGroup(context.Context, *ApplyJoinGroupReq) (*ApplyJoinGroupResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyJoinGroup not implemented")
+}
+func (UnimplementedGroupExtServer) GetGroupRequestAnswers(context.Context, *GetGroupRequestAnswersReq) (*GetGroupRequestAnswersResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGroupRequestAnswers not implemented")
+}
+func (UnimplementedGroupExtServer) ExpireGroupRequests(context.Context, *ExpireGroupRequestsReq) (*ExpireGroupRequestsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExpireGroupRequests not implemented")
+}
+func (UnimplementedGroupExtServer) GetGroupRequestAudits(context.Context, *GetGroupRequestAuditsReq) (*GetGroupRequestAuditsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGroupRequestAudits not implemented")
+}
+func (UnimplementedGroupExtServer) SetGroupDirectorySetting(context.Context, *SetGroupDirectorySettingReq) (*SetGroupDirectorySettingResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetGroupDirectorySetting not implemented")
+}
+func (UnimplementedGroupExtServer) GetGroupDirectorySetting(context.Context, *GetGroupDirectorySettingReq) (*GetGroupDirectorySettingResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGroupDirectorySetting not implemented")
+}
+func (UnimplementedGroupExtServer) GetGroupDirectoryCategories(context.Context, *GetGroupDirectoryCategoriesReq) (*GetGroupDirectoryCategoriesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGroupDirectoryCategories not implemented")
+}
+func (UnimplementedGroupExtServer) SearchGroupDirectory(context.Context, *SearchGroupDirectoryReq) (*SearchGroupDirectoryResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchGroupDirectory not implemented")
+}
+func (UnimplementedGroupExtServer) RefreshGroupDirectory(context.Context, *RefreshGroupDirectoryReq) (*RefreshGroupDirectoryResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshGroupDirectory not implemented")
+}
+func (UnimplementedGroupExtServer) CreateCommunity(context.Context, *CreateCommunityReq) (*CreateCommunityResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCommunity not implemented")
+}
+func (UnimplementedGroupExtServer) SetCommunityInfo(context.Context, *SetCommunityInfoReq) (*SetCommunityInfoResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCommunityInfo not implemented")
+}
+func (UnimplementedGroupExtServer) GetCommunitiesInfo(context.Context, *GetCommunitiesInfoReq) (*GetCommunitiesInfoResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCommunitiesInfo not implemented")
+}
+func (UnimplementedGroupExtServer) DismissCommunity(context.Context, *DismissCommunityReq) (*DismissCommunityResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DismissCommunity not implemented")
+}
+func (UnimplementedGroupExtServer) SetCommunityChannel(context.Context, *SetCommunityChannelReq) (*SetCommunityChannelResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCommunityChannel not implemented")
+}
+func (UnimplementedGroupExtServer) RemoveCommunityChannel(context.Context, *RemoveCommunityChannelReq) (*RemoveCommunityChannelResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveCommunityChannel not implemented")
+}
+func (UnimplementedGroupExtServer) GetCommunityChannels(context.Context, *GetCommunityChannelsReq) (*GetCommunityChannelsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCommunityChannels not implemented")
+}
+func (UnimplementedGroupExtServer) JoinCommunity(context.Context, *JoinCommunityReq) (*JoinCommunityResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JoinCommunity not implemented")
+}
+func (UnimplementedGroupExtServer) InviteToCommunity(context.Context, *InviteToCommunityReq) (*InviteToCommunityResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InviteToCommunity not implemented")
+}
+func (UnimplementedGroupExtServer) JoinCommunityChannel(context.Context, *JoinCommunityChannelReq) (*JoinCommunityChannelResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JoinCommunityChannel not implemented")
+}
+func (UnimplementedGroupExtServer) QuitCommunity(context.Context, *QuitCommunityReq) (*QuitCommunityResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuitCommunity not implemented")
+}
+func (UnimplementedGroupExtServer) KickCommunityMember(context.Context, *KickCommunityMemberReq) (*KickCommunityMemberResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KickCommunityMember not implemented")
+}
+func (UnimplementedGroupExtServer) SetCommunityMemberRole(context.Context, *SetCommunityMemberRoleReq) (*SetCommunityMemberRoleResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCommunityMemberRole not implemented")
+}
+func (UnimplementedGroupExtServer) GetCommunityMembers(context.Context, *GetCommunityMembersReq) (*GetCommunityMembersResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCommunityMembers not implemented")
+}
+func (UnimplementedGroupExtServer) GetJoinedCommunities(context.Context, *GetJoinedCommunitiesReq) (*GetJoinedCommunitiesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJoinedCommunities not implemented")
+}
+func (UnimplementedGroupExtServer) SendCommunityAnnouncement(context.Context, *SendCommunityAnnouncementReq) (*SendCommunityAnnouncementResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendCommunityAnnouncement not implemented")
+}
+func (UnimplementedGroupExtServer) GetGroupCommunity(context.Context, *GetGroupCommunityReq) (*GetGroupCommunityResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGroupCommunity not implemented")
+}
+func (UnimplementedGroupExtServer) GetCommunityMember(context.Context, *GetCommunityMemberReq) (*GetCommunityMemberResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCommunityMember not implemented")
+}
+func (UnimplementedGroupExtServer) FindCommunityMemberIDs(context.Context, *FindCommunityMemberIDsReq) (*FindCommunityMemberIDsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindCommunityMemberIDs not implemented")
+}
+func (UnimplementedGroupExtServer) GetSharedGroupMembers(context.Context, *GetSharedGroupMembersReq) (*GetSharedGroupMembersResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSharedGroupMembers not implemented")
+}
+func (UnimplementedGroupExtServer) PurgeUserGroups(context.Context, *PurgeUserGroupsReq) (*PurgeUserGroupsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeUserGroups not implemented")
+}
+
+// UnsafeGroupExtServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GroupExtServer will
+// result in compilation errors.
+type UnsafeGroupExtServer interface {
+	mustEmbedUnimplementedGroupExtServer()
+}
+
+func RegisterGroupExtServer(s grpc.ServiceRegistrar, srv GroupExtServer) {
+	s.RegisterService(&GroupExt_ServiceDesc, srv)
+}
+
+func _GroupExt_CreateGroupRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGroupRoleReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupExtServer).CreateGroupRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupExt_CreateGroupRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupExtServer).CreateGroupRole(ctx, req.(*CreateGroupRoleReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupExt_UpdateGroupRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateGroupRoleReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupExtServer).UpdateGroupRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupExt_UpdateGroupRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupExtServer).UpdateGroupRole(ctx, req.(*UpdateGroupRoleReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupExt_DeleteGroupRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteGroupRoleReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupExtServer).DeleteGroupRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupExt_DeleteGroupRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupExtServer).DeleteGroupRole(ctx, req.(*DeleteGroupRoleReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupExt_GetGroupRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGroupRolesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupExtServer).GetGroupRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupExt_GetGroupRoles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupExtServer).GetGroupRoles(ctx, req.(*GetGroupRolesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupExt_CreateGroupInviteLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGroupInviteLinkReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupExtServer).CreateGroupInviteLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupExt_CreateGroupInviteLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupExtServer).CreateGroupInviteLink(ctx, req.(*CreateGroupInviteLinkReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupExt_RevokeGroupInviteLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeGroupInviteLinkReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupExtServer).RevokeGroupInviteLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupExt_RevokeGroupInviteLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupExtServer).RevokeGroupInviteLink(ctx, req.(*RevokeGroupInviteLinkReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupExt_GetGroupInviteLinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGroupInviteLinksReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupExtServer).GetGroupInviteLinks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupExt_GetGroupInviteLinks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupExtServer).GetGroupInviteLinks(ctx, req.(*GetGroupInviteLinksReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupExt_SetGroupMsgPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGroupMsgPolicyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupExtServer).SetGroupMsgPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupExt_SetGroupMsgPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupExtServer).SetGroupMsgPolicy(ctx, req.(*SetGroupMsgPolicyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupExt_GetGroupMsgPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGroupMsgPolicyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupExtServer).GetGroupMsgPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupExt_GetGroupMsgPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupExtServer).GetGroupMsgPolicy(ctx, req.(*GetGroupMsgPolicyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupExt_SetGroupJoinQuestionnaire_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGroupJoinQuestionnaireReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupExtServer).SetGroupJoinQuestionnaire(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupExt_SetGroupJoinQuestionnaire_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupExtServer).SetGroupJoinQuestionnaire(ctx, req.(*SetGroupJoinQuestionnaireReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupExt_GetGroupJoinQuestionnaire_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGroupJoinQuestionnaireReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupExtServer).GetGroupJoinQuestionnaire(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupExt_GetGroupJoinQuestionnaire_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupExtServer).GetGroupJoinQuestionnaire(ctx, req.(*GetGroupJoinQuestionnaireReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupExt_ApplyJoinGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyJoinGroupReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupExtServer).ApplyJoinGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupExt_ApplyJoinGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupExtServer).ApplyJoinGroup(ctx, req.(*ApplyJoinGroupReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupExt_GetGroupRequestAnswers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGroupRequestAnswersReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupExtServer).GetGroupRequestAnswers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupExt_GetGroupRequestAnswers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupExtServer).GetGroupRequestAnswers(ctx, req.(*GetGroupRequestAnswersReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupExt_ExpireGroupRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExpireGroupRequestsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupExtServer).ExpireGroupRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupExt_ExpireGroupRequests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupExtServer).ExpireGroupRequests(ctx, req.(*ExpireGroupRequestsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupExt_GetGroupRequestAudits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGroupRequestAuditsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupExtServer).GetGroupRequestAudits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupExt_GetGroupRequestAudits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupExtServer).GetGroupRequestAudits(ctx, req.(*GetGroupRequestAuditsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupExt_SetGroupDirectorySetting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGroupDirectorySettingReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupExtServer).SetGroupDirectorySetting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupExt_SetGroupDirectorySetting_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupExtServer).SetGroupDirectorySetting(ctx, req.(*SetGroupDirectorySettingReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupExt_GetGroupDirectorySetting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGroupDirectorySettingReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupExtServer).GetGroupDirectorySetting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupExt_GetGroupDirectorySetting_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupExtServer).GetGroupDirectorySetting(ctx, req.(*GetGroupDirectorySettingReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupExt_GetGroupDirectoryCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGroupDirectoryCategoriesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupExtServer).GetGroupDirectoryCategories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupExt_GetGroupDirectoryCategories_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupExtServer).GetGroupDirectoryCategories(ctx, req.(*GetGroupDirectoryCategoriesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupExt_SearchGroupDirectory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchGroupDirectoryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupExtServer).SearchGroupDirectory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupExt_SearchGroupDirectory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupExtServer).SearchGroupDirectory(ctx, req.(*SearchGroupDirectoryReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupExt_RefreshGroupDirectory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshGroupDirectoryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupExtServer).RefreshGroupDirectory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupExt_RefreshGroupDirectory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupExtServer).RefreshGroupDirectory(ctx, req.(*RefreshGroupDirectoryReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupExt_CreateCommunity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCommunityReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupExtServer).CreateCommunity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupExt_CreateCommunity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupExtServer).CreateCommunity(ctx, req.(*CreateCommunityReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupExt_SetCommunityInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCommunityInfoReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupExtServer).SetCommunityInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupExt_SetCommunityInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupExtServer).SetCommunityInfo(ctx, req.(*SetCommunityInfoReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupExt_GetCommunitiesInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCommunitiesInfoReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupExtServer).GetCommunitiesInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupExt_GetCommunitiesInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupExtServer).GetCommunitiesInfo(ctx, req.(*GetCommunitiesInfoReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupExt_DismissCommunity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DismissCommunityReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupExtServer).DismissCommunity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupExt_DismissCommunity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupExtServer).DismissCommunity(ctx, req.(*DismissCommunityReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupExt_SetCommunityChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCommunityChannelReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupExtServer).SetCommunityChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupExt_SetCommunityChannel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupExtServer).SetCommunityChannel(ctx, req.(*SetCommunityChannelReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupExt_RemoveCommunityChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveCommunityChannelReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupExtServer).RemoveCommunityChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupExt_RemoveCommunityChannel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupExtServer).RemoveCommunityChannel(ctx, req.(*RemoveCommunityChannelReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupExt_GetCommunityChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCommunityChannelsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupExtServer).GetCommunityChannels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupExt_GetCommunityChannels_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupExtServer).GetCommunityChannels(ctx, req.(*GetCommunityChannelsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupExt_JoinCommunity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JoinCommunityReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupExtServer).JoinCommunity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupExt_JoinCommunity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupExtServer).JoinCommunity(ctx, req.(*JoinCommunityReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupExt_InviteToCommunity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InviteToCommunityReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupExtServer).InviteToCommunity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupExt_InviteToCommunity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupExtServer).InviteToCommunity(ctx, req.(*InviteToCommunityReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupExt_JoinCommunityChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JoinCommunityChannelReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupExtServer).JoinCommunityChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupExt_JoinCommunityChannel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupExtServer).JoinCommunityChannel(ctx, req.(*JoinCommunityChannelReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupExt_QuitCommunity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuitCommunityReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupExtServer).QuitCommunity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupExt_QuitCommunity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupExtServer).QuitCommunity(ctx, req.(*QuitCommunityReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupExt_KickCommunityMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KickCommunityMemberReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupExtServer).KickCommunityMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupExt_KickCommunityMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupExtServer).KickCommunityMember(ctx, req.(*KickCommunityMemberReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupExt_SetCommunityMemberRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCommunityMemberRoleReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupExtServer).SetCommunityMemberRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupExt_SetCommunityMemberRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupExtServer).SetCommunityMemberRole(ctx, req.(*SetCommunityMemberRoleReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupExt_GetCommunityMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCommunityMembersReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupExtServer).GetCommunityMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupExt_GetCommunityMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupExtServer).GetCommunityMembers(ctx, req.(*GetCommunityMembersReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupExt_GetJoinedCommunities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJoinedCommunitiesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupExtServer).GetJoinedCommunities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupExt_GetJoinedCommunities_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupExtServer).GetJoinedCommunities(ctx, req.(*GetJoinedCommunitiesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupExt_SendCommunityAnnouncement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendCommunityAnnouncementReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupExtServer).SendCommunityAnnouncement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupExt_SendCommunityAnnouncement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupExtServer).SendCommunityAnnouncement(ctx, req.(*SendCommunityAnnouncementReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupExt_GetGroupCommunity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGroupCommunityReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupExtServer).GetGroupCommunity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupExt_GetGroupCommunity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupExtServer).GetGroupCommunity(ctx, req.(*GetGroupCommunityReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupExt_GetCommunityMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCommunityMemberReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupExtServer).GetCommunityMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupExt_GetCommunityMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupExtServer).GetCommunityMember(ctx, req.(*GetCommunityMemberReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupExt_FindCommunityMemberIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindCommunityMemberIDsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupExtServer).FindCommunityMemberIDs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupExt_FindCommunityMemberIDs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupExtServer).FindCommunityMemberIDs(ctx, req.(*FindCommunityMemberIDsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupExt_GetSharedGroupMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSharedGroupMembersReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupExtServer).GetSharedGroupMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupExt_GetSharedGroupMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupExtServer).GetSharedGroupMembers(ctx, req.(*GetSharedGroupMembersReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupExt_PurgeUserGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeUserGroupsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupExtServer).PurgeUserGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupExt_PurgeUserGroups_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupExtServer).PurgeUserGroups(ctx, req.(*PurgeUserGroupsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// GroupExt_ServiceDesc is the grpc.ServiceDesc for GroupExt service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var GroupExt_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "openim.groupext.GroupExt",
+	HandlerType: (*GroupExtServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "createGroupRole",
+			Handler:    _GroupExt_CreateGroupRole_Handler,
+		},
+		{
+			MethodName: "updateGroupRole",
+			Handler:    _GroupExt_UpdateGroupRole_Handler,
+		},
+		{
+			MethodName: "deleteGroupRole",
+			Handler:    _GroupExt_DeleteGroupRole_Handler,
+		},
+		{
+			MethodName: "getGroupRoles",
+			Handler:    _GroupExt_GetGroupRoles_Handler,
+		},
+		{
+			MethodName: "createGroupInviteLink",
+			Handler:    _GroupExt_CreateGroupInviteLink_Handler,
+		},
+		{
+			MethodName: "revokeGroupInviteLink",
+			Handler:    _GroupExt_RevokeGroupInviteLink_Handler,
+		},
+		{
+			MethodName: "getGroupInviteLinks",
+			Handler:    _GroupExt_GetGroupInviteLinks_Handler,
+		},
+		{
+			MethodName: "setGroupMsgPolicy",
+			Handler:    _GroupExt_SetGroupMsgPolicy_Handler,
+		},
+		{
+			MethodName: "getGroupMsgPolicy",
+			Handler:    _GroupExt_GetGroupMsgPolicy_Handler,
+		},
+		{
+			MethodName: "setGroupJoinQuestionnaire",
+			Handler:    _GroupExt_SetGroupJoinQuestionnaire_Handler,
+		},
+		{
+			MethodName: "getGroupJoinQuestionnaire",
+			Handler:    _GroupExt_GetGroupJoinQuestionnaire_Handler,
+		},
+		{
+			MethodName: "applyJoinGroup",
+			Handler:    _GroupExt_ApplyJoinGroup_Handler,
+		},
+		{
+			MethodName: "getGroupRequestAnswers",
+			Handler:    _GroupExt_GetGroupRequestAnswers_Handler,
+		},
+		{
+			MethodName: "expireGroupRequests",
+			Handler:    _GroupExt_ExpireGroupRequests_Handler,
+		},
+		{
+			MethodName: "getGroupRequestAudits",
+			Handler:    _GroupExt_GetGroupRequestAudits_Handler,
+		},
+		{
+			MethodName: "setGroupDirectorySetting",
+			Handler:    _GroupExt_SetGroupDirectorySetting_Handler,
+		},
+		{
+			MethodName: "getGroupDirectorySetting",
+			Handler:    _GroupExt_GetGroupDirectorySetting_Handler,
+		},
+		{
+			MethodName: "getGroupDirectoryCategories",
+			Handler:    _GroupExt_GetGroupDirectoryCategories_Handler,
+		},
+		{
+			MethodName: "searchGroupDirectory",
+			Handler:    _GroupExt_SearchGroupDirectory_Handler,
+		},
+		{
+			MethodName: "refreshGroupDirectory",
+			Handler:    _GroupExt_RefreshGroupDirectory_Handler,
+		},
+		{
+			MethodName: "createCommunity",
+			Handler:    _GroupExt_CreateCommunity_Handler,
+		},
+		{
+			MethodName: "setCommunityInfo",
+			Handler:    _GroupExt_SetCommunityInfo_Handler,
+		},
+		{
+			MethodName: "getCommunitiesInfo",
+			Handler:    _GroupExt_GetCommunitiesInfo_Handler,
+		},
+		{
+			MethodName: "dismissCommunity",
+			Handler:    _GroupExt_DismissCommunity_Handler,
+		},
+		{
+			MethodName: "setCommunityChannel",
+			Handler:    _GroupExt_SetCommunityChannel_Handler,
+		},
+		{
+			MethodName: "removeCommunityChannel",
+			Handler:    _GroupExt_RemoveCommunityChannel_Handler,
+		},
+		{
+			MethodName: "getCommunityChannels",
+			Handler:    _GroupExt_GetCommunityChannels_Handler,
+		},
+		{
+			MethodName: "joinCommunity",
+			Handler:    _GroupExt_JoinCommunity_Handler,
+		},
+		{
+			MethodName: "inviteToCommunity",
+			Handler:    _GroupExt_InviteToCommunity_Handler,
+		},
+		{
+			MethodName: "joinCommunityChannel",
+			Handler:    _GroupExt_JoinCommunityChannel_Handler,
+		},
+		{
+			MethodName: "quitCommunity",
+			Handler:    _GroupExt_QuitCommunity_Handler,
+		},
+		{
+			MethodName: "kickCommunityMember",
+			Handler:    _GroupExt_KickCommunityMember_Handler,
+		},
+		{
+			MethodName: "setCommunityMemberRole",
+			Handler:    _GroupExt_SetCommunityMemberRole_Handler,
+		},
+		{
+			MethodName: "getCommunityMembers",
+			Handler:    _GroupExt_GetCommunityMembers_Handler,
+		},
+		{
+			MethodName: "getJoinedCommunities",
+			Handler:    _GroupExt_GetJoinedCommunities_Handler,
+		},
+		{
+			MethodName: "sendCommunityAnnouncement",
+			Handler:    _GroupExt_SendCommunityAnnouncement_Handler,
+		},
+		{
+			MethodName: "getGroupCommunity",
+			Handler:    _GroupExt_GetGroupCommunity_Handler,
+		},
+		{
+			MethodName: "getCommunityMember",
+			Handler:    _GroupExt_GetCommunityMember_Handler,
+		},
+		{
+			MethodName: "findCommunityMemberIDs",
+			Handler:    _GroupExt_FindCommunityMemberIDs_Handler,
+		},
+		{
+			MethodName: "getSharedGroupMembers",
+			Handler:    _GroupExt_GetSharedGroupMembers_Handler,
+		},
+		{
+			MethodName: "purgeUserGroups",
+			Handler:    _GroupExt_PurgeUserGroups_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "groupext/groupext.proto",
+}
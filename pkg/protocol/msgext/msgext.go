@@ -0,0 +1,215 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msgext
+
+import (
+	"errors"
+
+	"github.com/openimsdk/protocol/constant"
+)
+
+const (
+	BroadcastModeAll    = 1
+	BroadcastModeUsers  = 2
+	BroadcastModeFilter = 3
+)
+
+const (
+	// MsgPinChangedNotification is sent to the conversation when a message is pinned or unpinned.
+	MsgPinChangedNotification = constant.DeleteMsgsNotification + 1
+	// PollVotedNotification carries the new tallies of a poll after a vote, a retraction or its closing.
+	PollVotedNotification = MsgPinChangedNotification + 1
+)
+
+// Poll is the content type of poll messages, whose content is a json encoded PollInfo.
+const Poll = constant.ReactionMessageDeleter + 1
+
+func (x *SubmitBroadcastReq) Check() error {
+	if x.MsgData == nil {
+		return errors.New("msgData is empty")
+	}
+	switch x.Mode {
+	case BroadcastModeAll:
+	case BroadcastModeUsers:
+		if len(x.RecvIDs) == 0 {
+			return errors.New("recvIDs is empty")
+		}
+	case BroadcastModeFilter:
+		if x.FilterUserID == "" && x.FilterNickname == "" {
+			return errors.New("filter is empty")
+		}
+	default:
+		return errors.New("mode is invalid")
+	}
+	return nil
+}
+
+func (x *GetBroadcastReq) Check() error {
+	if x.JobID == "" {
+		return errors.New("jobID is empty")
+	}
+	return nil
+}
+
+func (x *GetBroadcastsReq) Check() error {
+	if x.Pagination == nil {
+		return errors.New("pagination is empty")
+	}
+	return nil
+}
+
+func (x *GetBroadcastFailedIDsReq) Check() error {
+	if x.JobID == "" {
+		return errors.New("jobID is empty")
+	}
+	if x.Pagination == nil {
+		return errors.New("pagination is empty")
+	}
+	return nil
+}
+
+func (x *CancelBroadcastReq) Check() error {
+	if x.JobID == "" {
+		return errors.New("jobID is empty")
+	}
+	return nil
+}
+
+func (x *PinMsgReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	if x.ConversationID == "" {
+		return errors.New("conversationID is empty")
+	}
+	if x.Seq <= 0 {
+		return errors.New("seq is invalid")
+	}
+	return nil
+}
+
+func (x *UnpinMsgReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	if x.ConversationID == "" {
+		return errors.New("conversationID is empty")
+	}
+	if x.Seq <= 0 {
+		return errors.New("seq is invalid")
+	}
+	return nil
+}
+
+func (x *GetPinnedMsgsReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	if x.ConversationID == "" {
+		return errors.New("conversationID is empty")
+	}
+	return nil
+}
+
+func (x *CreatePollReq) Check() error {
+	if x.MsgData == nil {
+		return errors.New("msgData is empty")
+	}
+	if x.Question == "" {
+		return errors.New("question is empty")
+	}
+	if len(x.Options) < 2 {
+		return errors.New("options must contain at least two options")
+	}
+	for _, option := range x.Options {
+		if option == "" {
+			return errors.New("option is empty")
+		}
+	}
+	if x.CloseTime < 0 {
+		return errors.New("closeTime is invalid")
+	}
+	return nil
+}
+
+func (x *VotePollReq) Check() error {
+	if x.PollID == "" {
+		return errors.New("pollID is empty")
+	}
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	if len(x.OptionIDs) == 0 {
+		return errors.New("optionIDs is empty")
+	}
+	return nil
+}
+
+func (x *RetractPollVoteReq) Check() error {
+	if x.PollID == "" {
+		return errors.New("pollID is empty")
+	}
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	return nil
+}
+
+func (x *GetPollReq) Check() error {
+	if x.PollID == "" {
+		return errors.New("pollID is empty")
+	}
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	return nil
+}
+
+func (x *GetPollVotersReq) Check() error {
+	if x.PollID == "" {
+		return errors.New("pollID is empty")
+	}
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	if x.OptionID == "" {
+		return errors.New("optionID is empty")
+	}
+	if x.Pagination == nil {
+		return errors.New("pagination is empty")
+	}
+	return nil
+}
+
+func (x *GetConversationsUnreadCountReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	return nil
+}
+
+func (x *GetUsersUnreadCountReq) Check() error {
+	if len(x.UserIDs) == 0 {
+		return errors.New("userIDs is empty")
+	}
+	return nil
+}
+
+func (x *AnonymizeUserMsgsReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	return nil
+}
@@ -0,0 +1,733 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: msgext/msgext.proto
+
+package msgext
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	MsgExt_SubmitBroadcast_FullMethodName             = "/openim.msgext.MsgExt/submitBroadcast"
+	MsgExt_GetBroadcast_FullMethodName                = "/openim.msgext.MsgExt/getBroadcast"
+	MsgExt_GetBroadcasts_FullMethodName               = "/openim.msgext.MsgExt/getBroadcasts"
+	MsgExt_GetBroadcastFailedIDs_FullMethodName       = "/openim.msgext.MsgExt/getBroadcastFailedIDs"
+	MsgExt_CancelBroadcast_FullMethodName             = "/openim.msgext.MsgExt/cancelBroadcast"
+	MsgExt_PinMsg_FullMethodName                      = "/openim.msgext.MsgExt/pinMsg"
+	MsgExt_UnpinMsg_FullMethodName                    = "/openim.msgext.MsgExt/unpinMsg"
+	MsgExt_GetPinnedMsgs_FullMethodName               = "/openim.msgext.MsgExt/getPinnedMsgs"
+	MsgExt_CreatePoll_FullMethodName                  = "/openim.msgext.MsgExt/createPoll"
+	MsgExt_VotePoll_FullMethodName                    = "/openim.msgext.MsgExt/votePoll"
+	MsgExt_RetractPollVote_FullMethodName             = "/openim.msgext.MsgExt/retractPollVote"
+	MsgExt_GetPoll_FullMethodName                     = "/openim.msgext.MsgExt/getPoll"
+	MsgExt_GetPollVoters_FullMethodName               = "/openim.msgext.MsgExt/getPollVoters"
+	MsgExt_CloseExpiredPolls_FullMethodName           = "/openim.msgext.MsgExt/closeExpiredPolls"
+	MsgExt_GetConversationsUnreadCount_FullMethodName = "/openim.msgext.MsgExt/getConversationsUnreadCount"
+	MsgExt_GetUsersUnreadCount_FullMethodName         = "/openim.msgext.MsgExt/getUsersUnreadCount"
+	MsgExt_AnonymizeUserMsgs_FullMethodName           = "/openim.msgext.MsgExt/anonymizeUserMsgs"
+)
+
+// MsgExtClient is the client API for MsgExt service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type MsgExtClient interface {
+	// Submit a message to be sent to many users by a background job
+	SubmitBroadcast(ctx context.Context, in *SubmitBroadcastReq, opts ...grpc.CallOption) (*SubmitBroadcastResp, error)
+	GetBroadcast(ctx context.Context, in *GetBroadcastReq, opts ...grpc.CallOption) (*GetBroadcastResp, error)
+	GetBroadcasts(ctx context.Context, in *GetBroadcastsReq, opts ...grpc.CallOption) (*GetBroadcastsResp, error)
+	// Users the message could not be sent to
+	GetBroadcastFailedIDs(ctx context.Context, in *GetBroadcastFailedIDsReq, opts ...grpc.CallOption) (*GetBroadcastFailedIDsResp, error)
+	CancelBroadcast(ctx context.Context, in *CancelBroadcastReq, opts ...grpc.CallOption) (*CancelBroadcastResp, error)
+	// Group members with the pin permission or either party of a single chat can pin and unpin messages
+	PinMsg(ctx context.Context, in *PinMsgReq, opts ...grpc.CallOption) (*PinMsgResp, error)
+	UnpinMsg(ctx context.Context, in *UnpinMsgReq, opts ...grpc.CallOption) (*UnpinMsgResp, error)
+	// Pins of revoked or deleted messages are left out
+	GetPinnedMsgs(ctx context.Context, in *GetPinnedMsgsReq, opts ...grpc.CallOption) (*GetPinnedMsgsResp, error)
+	// Store a poll and send it to the conversation as a poll message
+	CreatePoll(ctx context.Context, in *CreatePollReq, opts ...grpc.CallOption) (*CreatePollResp, error)
+	VotePoll(ctx context.Context, in *VotePollReq, opts ...grpc.CallOption) (*VotePollResp, error)
+	RetractPollVote(ctx context.Context, in *RetractPollVoteReq, opts ...grpc.CallOption) (*RetractPollVoteResp, error)
+	GetPoll(ctx context.Context, in *GetPollReq, opts ...grpc.CallOption) (*GetPollResp, error)
+	// Voters of an option of a poll that is not anonymous
+	GetPollVoters(ctx context.Context, in *GetPollVotersReq, opts ...grpc.CallOption) (*GetPollVotersResp, error)
+	// Close polls past their close time, called by the cron task
+	CloseExpiredPolls(ctx context.Context, in *CloseExpiredPollsReq, opts ...grpc.CallOption) (*CloseExpiredPollsResp, error)
+	// Unread counts excluding messages sent by the user, not counted as unread or deleted by the user
+	GetConversationsUnreadCount(ctx context.Context, in *GetConversationsUnreadCountReq, opts ...grpc.CallOption) (*GetConversationsUnreadCountResp, error)
+	// Badges of offline pushes, called by the push service
+	GetUsersUnreadCount(ctx context.Context, in *GetUsersUnreadCountReq, opts ...grpc.CallOption) (*GetUsersUnreadCountResp, error)
+	// Admin only, clear the sender nickname and face URL of the messages the user sent in their conversations
+	AnonymizeUserMsgs(ctx context.Context, in *AnonymizeUserMsgsReq, opts ...grpc.CallOption) (*AnonymizeUserMsgsResp, error)
+}
+
+type msgExtClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMsgExtClient(cc grpc.ClientConnInterface) MsgExtClient {
+	return &msgExtClient{cc}
+}
+
+func (c *msgExtClient) SubmitBroadcast(ctx context.Context, in *SubmitBroadcastReq, opts ...grpc.CallOption) (*SubmitBroadcastResp, error) {
+	out := new(SubmitBroadcastResp)
+	err := c.cc.Invoke(ctx, MsgExt_SubmitBroadcast_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) GetBroadcast(ctx context.Context, in *GetBroadcastReq, opts ...grpc.CallOption) (*GetBroadcastResp, error) {
+	out := new(GetBroadcastResp)
+	err := c.cc.Invoke(ctx, MsgExt_GetBroadcast_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) GetBroadcasts(ctx context.Context, in *GetBroadcastsReq, opts ...grpc.CallOption) (*GetBroadcastsResp, error) {
+	out := new(GetBroadcastsResp)
+	err := c.cc.Invoke(ctx, MsgExt_GetBroadcasts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) GetBroadcastFailedIDs(ctx context.Context, in *GetBroadcastFailedIDsReq, opts ...grpc.CallOption) (*GetBroadcastFailedIDsResp, error) {
+	out := new(GetBroadcastFailedIDsResp)
+	err := c.cc.Invoke(ctx, MsgExt_GetBroadcastFailedIDs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) CancelBroadcast(ctx context.Context, in *CancelBroadcastReq, opts ...grpc.CallOption) (*CancelBroadcastResp, error) {
+	out := new(CancelBroadcastResp)
+	err := c.cc.Invoke(ctx, MsgExt_CancelBroadcast_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) PinMsg(ctx context.Context, in *PinMsgReq, opts ...grpc.CallOption) (*PinMsgResp, error) {
+	out := new(PinMsgResp)
+	err := c.cc.Invoke(ctx, MsgExt_PinMsg_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) UnpinMsg(ctx context.Context, in *UnpinMsgReq, opts ...grpc.CallOption) (*UnpinMsgResp, error) {
+	out := new(UnpinMsgResp)
+	err := c.cc.Invoke(ctx, MsgExt_UnpinMsg_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) GetPinnedMsgs(ctx context.Context, in *GetPinnedMsgsReq, opts ...grpc.CallOption) (*GetPinnedMsgsResp, error) {
+	out := new(GetPinnedMsgsResp)
+	err := c.cc.Invoke(ctx, MsgExt_GetPinnedMsgs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) CreatePoll(ctx context.Context, in *CreatePollReq, opts ...grpc.CallOption) (*CreatePollResp, error) {
+	out := new(CreatePollResp)
+	err := c.cc.Invoke(ctx, MsgExt_CreatePoll_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) VotePoll(ctx context.Context, in *VotePollReq, opts ...grpc.CallOption) (*VotePollResp, error) {
+	out := new(VotePollResp)
+	err := c.cc.Invoke(ctx, MsgExt_VotePoll_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) RetractPollVote(ctx context.Context, in *RetractPollVoteReq, opts ...grpc.CallOption) (*RetractPollVoteResp, error) {
+	out := new(RetractPollVoteResp)
+	err := c.cc.Invoke(ctx, MsgExt_RetractPollVote_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) GetPoll(ctx context.Context, in *GetPollReq, opts ...grpc.CallOption) (*GetPollResp, error) {
+	out := new(GetPollResp)
+	err := c.cc.Invoke(ctx, MsgExt_GetPoll_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) GetPollVoters(ctx context.Context, in *GetPollVotersReq, opts ...grpc.CallOption) (*GetPollVotersResp, error) {
+	out := new(GetPollVotersResp)
+	err := c.cc.Invoke(ctx, MsgExt_GetPollVoters_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) CloseExpiredPolls(ctx context.Context, in *CloseExpiredPollsReq, opts ...grpc.CallOption) (*CloseExpiredPollsResp, error) {
+	out := new(CloseExpiredPollsResp)
+	err := c.cc.Invoke(ctx, MsgExt_CloseExpiredPolls_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) GetConversationsUnreadCount(ctx context.Context, in *GetConversationsUnreadCountReq, opts ...grpc.CallOption) (*GetConversationsUnreadCountResp, error) {
+	out := new(GetConversationsUnreadCountResp)
+	err := c.cc.Invoke(ctx, MsgExt_GetConversationsUnreadCount_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) GetUsersUnreadCount(ctx context.Context, in *GetUsersUnreadCountReq, opts ...grpc.CallOption) (*GetUsersUnreadCountResp, error) {
+	out := new(GetUsersUnreadCountResp)
+	err := c.cc.Invoke(ctx, MsgExt_GetUsersUnreadCount_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) AnonymizeUserMsgs(ctx context.Context, in *AnonymizeUserMsgsReq, opts ...grpc.CallOption) (*AnonymizeUserMsgsResp, error) {
+	out := new(AnonymizeUserMsgsResp)
+	err := c.cc.Invoke(ctx, MsgExt_AnonymizeUserMsgs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgExtServer is the server API for MsgExt service.
+// All implementations should embed UnimplementedMsgExtServer
+// for forward compatibility
+type MsgExtServer interface {
+	// Submit a message to be sent to many users by a background job
+	SubmitBroadcast(context.Context, *SubmitBroadcastReq) (*SubmitBroadcastResp, error)
+	GetBroadcast(context.Context, *GetBroadcastReq) (*GetBroadcastResp, error)
+	GetBroadcasts(context.Context, *GetBroadcastsReq) (*GetBroadcastsResp, error)
+	// Users the message could not be sent to
+	GetBroadcastFailedIDs(context.Context, *GetBroadcastFailedIDsReq) (*GetBroadcastFailedIDsResp, error)
+	CancelBroadcast(context.Context, *CancelBroadcastReq) (*CancelBroadcastResp, error)
+	// Group members with the pin permission or either party of a single chat can pin and unpin messages
+	PinMsg(context.Context, *PinMsgReq) (*PinMsgResp, error)
+	UnpinMsg(context.Context, *UnpinMsgReq) (*UnpinMsgResp, error)
+	// Pins of revoked or deleted messages are left out
+	GetPinnedMsgs(context.Context, *GetPinnedMsgsReq) (*GetPinnedMsgsResp, error)
+	// Store a poll and send it to the conversation as a poll message
+	CreatePoll(context.Context, *CreatePollReq) (*CreatePollResp, error)
+	VotePoll(context.Context, *VotePollReq) (*VotePollResp, error)
+	RetractPollVote(context.Context, *RetractPollVoteReq) (*RetractPollVoteResp, error)
+	GetPoll(context.Context, *GetPollReq) (*GetPollResp, error)
+	// Voters of an option of a poll that is not anonymous
+	GetPollVoters(context.Context, *GetPollVotersReq) (*GetPollVotersResp, error)
+	// Close polls past their close time, called by the cron task
+	CloseExpiredPolls(context.Context, *CloseExpiredPollsReq) (*CloseExpiredPollsResp, error)
+	// Unread counts excluding messages sent by the user, not counted as unread or deleted by the user
+	GetConversationsUnreadCount(context.Context, *GetConversationsUnreadCountReq) (*GetConversationsUnreadCountResp, error)
+	// Badges of offline pushes, called by the push service
+	GetUsersUnreadCount(context.Context, *GetUsersUnreadCountReq) (*GetUsersUnreadCountResp, error)
+	// Admin only, clear the sender nickname and face URL of the messages the user sent in their conversations
+	AnonymizeUserMsgs(context.Context, *AnonymizeUserMsgsReq) (*AnonymizeUserMsgsResp, error)
+}
+
+// UnimplementedMsgExtServer should be embedded to have forward compatible implementations.
+type UnimplementedMsgExtServer struct {
+}
+
+func (UnimplementedMsgExtServer) SubmitBroadcast(context.Context, *SubmitBroadcastReq) (*SubmitBroadcastResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitBroadcast not implemented")
+}
+func (UnimplementedMsgExtServer) GetBroadcast(context.Context, *GetBroadcastReq) (*GetBroadcastResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBroadcast not implemented")
+}
+func (UnimplementedMsgExtServer) GetBroadcasts(context.Context, *GetBroadcastsReq) (*GetBroadcastsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBroadcasts not implemented")
+}
+func (UnimplementedMsgExtServer) GetBroadcastFailedIDs(context.Context, *GetBroadcastFailedIDsReq) (*GetBroadcastFailedIDsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBroadcastFailedIDs not implemented")
+}
+func (UnimplementedMsgExtServer) CancelBroadcast(context.Context, *CancelBroadcastReq) (*CancelBroadcastResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelBroadcast not implemented")
+}
+func (UnimplementedMsgExtServer) PinMsg(context.Context, *PinMsgReq) (*PinMsgResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PinMsg not implemented")
+}
+func (UnimplementedMsgExtServer) UnpinMsg(context.Context, *UnpinMsgReq) (*UnpinMsgResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnpinMsg not implemented")
+}
+func (UnimplementedMsgExtServer) GetPinnedMsgs(context.Context, *GetPinnedMsgsReq) (*GetPinnedMsgsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPinnedMsgs not implemented")
+}
+func (UnimplementedMsgExtServer) CreatePoll(context.Context, *CreatePollReq) (*CreatePollResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePoll not implemented")
+}
+func (UnimplementedMsgExtServer) VotePoll(context.Context, *VotePollReq) (*VotePollResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VotePoll not implemented")
+}
+func (UnimplementedMsgExtServer) RetractPollVote(context.Context, *RetractPollVoteReq) (*RetractPollVoteResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetractPollVote not implemented")
+}
+func (UnimplementedMsgExtServer) GetPoll(context.Context, *GetPollReq) (*GetPollResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPoll not implemented")
+}
+func (UnimplementedMsgExtServer) GetPollVoters(context.Context, *GetPollVotersReq) (*GetPollVotersResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPollVoters not implemented")
+}
+func (UnimplementedMsgExtServer) CloseExpiredPolls(context.Context, *CloseExpiredPollsReq) (*CloseExpiredPollsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseExpiredPolls not implemented")
+}
+func (UnimplementedMsgExtServer) GetConversationsUnreadCount(context.Context, *GetConversationsUnreadCountReq) (*GetConversationsUnreadCountResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConversationsUnreadCount not implemented")
+}
+func (UnimplementedMsgExtServer) GetUsersUnreadCount(context.Context, *GetUsersUnreadCountReq) (*GetUsersUnreadCountResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUsersUnreadCount not implemented")
+}
+func (UnimplementedMsgExtServer) AnonymizeUserMsgs(context.Context, *AnonymizeUserMsgsReq) (*AnonymizeUserMsgsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AnonymizeUserMsgs not implemented")
+}
+
+// UnsafeMsgExtServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MsgExtServer will
+// result in compilation errors.
+type UnsafeMsgExtServer interface {
+	mustEmbedUnimplementedMsgExtServer()
+}
+
+func RegisterMsgExtServer(s grpc.ServiceRegistrar, srv MsgExtServer) {
+	s.RegisterService(&MsgExt_ServiceDesc, srv)
+}
+
+func _MsgExt_SubmitBroadcast_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitBroadcastReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).SubmitBroadcast(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgExt_SubmitBroadcast_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).SubmitBroadcast(ctx, req.(*SubmitBroadcastReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_GetBroadcast_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBroadcastReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).GetBroadcast(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgExt_GetBroadcast_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).GetBroadcast(ctx, req.(*GetBroadcastReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_GetBroadcasts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBroadcastsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).GetBroadcasts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgExt_GetBroadcasts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).GetBroadcasts(ctx, req.(*GetBroadcastsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_GetBroadcastFailedIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBroadcastFailedIDsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).GetBroadcastFailedIDs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgExt_GetBroadcastFailedIDs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).GetBroadcastFailedIDs(ctx, req.(*GetBroadcastFailedIDsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_CancelBroadcast_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelBroadcastReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).CancelBroadcast(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgExt_CancelBroadcast_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).CancelBroadcast(ctx, req.(*CancelBroadcastReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_PinMsg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PinMsgReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).PinMsg(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgExt_PinMsg_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).PinMsg(ctx, req.(*PinMsgReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_UnpinMsg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnpinMsgReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).UnpinMsg(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgExt_UnpinMsg_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).UnpinMsg(ctx, req.(*UnpinMsgReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_GetPinnedMsgs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPinnedMsgsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).GetPinnedMsgs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgExt_GetPinnedMsgs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).GetPinnedMsgs(ctx, req.(*GetPinnedMsgsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_CreatePoll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePollReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).CreatePoll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgExt_CreatePoll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).CreatePoll(ctx, req.(*CreatePollReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_VotePoll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VotePollReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).VotePoll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgExt_VotePoll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).VotePoll(ctx, req.(*VotePollReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_RetractPollVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetractPollVoteReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).RetractPollVote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgExt_RetractPollVote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).RetractPollVote(ctx, req.(*RetractPollVoteReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_GetPoll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPollReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).GetPoll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgExt_GetPoll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).GetPoll(ctx, req.(*GetPollReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_GetPollVoters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPollVotersReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).GetPollVoters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgExt_GetPollVoters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).GetPollVoters(ctx, req.(*GetPollVotersReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_CloseExpiredPolls_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloseExpiredPollsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).CloseExpiredPolls(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgExt_CloseExpiredPolls_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).CloseExpiredPolls(ctx, req.(*CloseExpiredPollsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_GetConversationsUnreadCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConversationsUnreadCountReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).GetConversationsUnreadCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgExt_GetConversationsUnreadCount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).GetConversationsUnreadCount(ctx, req.(*GetConversationsUnreadCountReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_GetUsersUnreadCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUsersUnreadCountReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).GetUsersUnreadCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgExt_GetUsersUnreadCount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).GetUsersUnreadCount(ctx, req.(*GetUsersUnreadCountReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_AnonymizeUserMsgs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnonymizeUserMsgsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).AnonymizeUserMsgs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgExt_AnonymizeUserMsgs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).AnonymizeUserMsgs(ctx, req.(*AnonymizeUserMsgsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// MsgExt_ServiceDesc is the grpc.ServiceDesc for MsgExt service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var MsgExt_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "openim.msgext.MsgExt",
+	HandlerType: (*MsgExtServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "submitBroadcast",
+			Handler:    _MsgExt_SubmitBroadcast_Handler,
+		},
+		{
+			MethodName: "getBroadcast",
+			Handler:    _MsgExt_GetBroadcast_Handler,
+		},
+		{
+			MethodName: "getBroadcasts",
+			Handler:    _MsgExt_GetBroadcasts_Handler,
+		},
+		{
+			MethodName: "getBroadcastFailedIDs",
+			Handler:    _MsgExt_GetBroadcastFailedIDs_Handler,
+		},
+		{
+			MethodName: "cancelBroadcast",
+			Handler:    _MsgExt_CancelBroadcast_Handler,
+		},
+		{
+			MethodName: "pinMsg",
+			Handler:    _MsgExt_PinMsg_Handler,
+		},
+		{
+			MethodName: "unpinMsg",
+			Handler:    _MsgExt_UnpinMsg_Handler,
+		},
+		{
+			MethodName: "getPinnedMsgs",
+			Handler:    _MsgExt_GetPinnedMsgs_Handler,
+		},
+		{
+			MethodName: "createPoll",
+			Handler:    _MsgExt_CreatePoll_Handler,
+		},
+		{
+			MethodName: "votePoll",
+			Handler:    _MsgExt_VotePoll_Handler,
+		},
+		{
+			MethodName: "retractPollVote",
+			Handler:    _MsgExt_RetractPollVote_Handler,
+		},
+		{
+			MethodName: "getPoll",
+			Handler:    _MsgExt_GetPoll_Handler,
+		},
+		{
+			MethodName: "getPollVoters",
+			Handler:    _MsgExt_GetPollVoters_Handler,
+		},
+		{
+			MethodName: "closeExpiredPolls",
+			Handler:    _MsgExt_CloseExpiredPolls_Handler,
+		},
+		{
+			MethodName: "getConversationsUnreadCount",
+			Handler:    _MsgExt_GetConversationsUnreadCount_Handler,
+		},
+		{
+			MethodName: "getUsersUnreadCount",
+			Handler:    _MsgExt_GetUsersUnreadCount_Handler,
+		},
+		{
+			MethodName: "anonymizeUserMsgs",
+			Handler:    _MsgExt_AnonymizeUserMsgs_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "msgext/msgext.proto",
+}
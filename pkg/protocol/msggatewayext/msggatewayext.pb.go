@@ -0,0 +1,243 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.33.0
+// 	protoc        (unknown)
+// source: msggatewayext/msggatewayext.proto
+
+package msggatewayext
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type KickConnOfflineReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID     string   `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	PlatformID int32    `protobuf:"varint,2,opt,name=platformID,proto3" json:"platformID"`
+	ConnIDs    []string `protobuf:"bytes,3,rep,name=connIDs,proto3" json:"connIDs"`
+}
+
+func (x *KickConnOfflineReq) Reset() {
+	*x = KickConnOfflineReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msggatewayext_msggatewayext_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KickConnOfflineReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KickConnOfflineReq) ProtoMessage() {}
+
+func (x *KickConnOfflineReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msggatewayext_msggatewayext_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KickConnOfflineReq.ProtoReflect.Descriptor instead.
+func (*KickConnOfflineReq) Descriptor() ([]byte, []int) {
+	return file_msggatewayext_msggatewayext_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *KickConnOfflineReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *KickConnOfflineReq) GetPlatformID() int32 {
+	if x != nil {
+		return x.PlatformID
+	}
+	return 0
+}
+
+func (x *KickConnOfflineReq) GetConnIDs() []string {
+	if x != nil {
+		return x.ConnIDs
+	}
+	return nil
+}
+
+type KickConnOfflineResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *KickConnOfflineResp) Reset() {
+	*x = KickConnOfflineResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msggatewayext_msggatewayext_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KickConnOfflineResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KickConnOfflineResp) ProtoMessage() {}
+
+func (x *KickConnOfflineResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msggatewayext_msggatewayext_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KickConnOfflineResp.ProtoReflect.Descriptor instead.
+func (*KickConnOfflineResp) Descriptor() ([]byte, []int) {
+	return file_msggatewayext_msggatewayext_proto_rawDescGZIP(), []int{1}
+}
+
+var File_msggatewayext_msggatewayext_proto protoreflect.FileDescriptor
+
+var file_msggatewayext_msggatewayext_proto_rawDesc = []byte{
+	0x0a, 0x21, 0x6d, 0x73, 0x67, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x65, 0x78, 0x74, 0x2f,
+	0x6d, 0x73, 0x67, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x65, 0x78, 0x74, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x14, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x67,
+	0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x65, 0x78, 0x74, 0x22, 0x66, 0x0a, 0x12, 0x6b, 0x69, 0x63,
+	0x6b, 0x43, 0x6f, 0x6e, 0x6e, 0x4f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x12,
+	0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x74, 0x66,
+	0x6f, 0x72, 0x6d, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x70, 0x6c, 0x61,
+	0x74, 0x66, 0x6f, 0x72, 0x6d, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x6e, 0x49,
+	0x44, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x6e, 0x49, 0x44,
+	0x73, 0x22, 0x15, 0x0a, 0x13, 0x6b, 0x69, 0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x6e, 0x4f, 0x66, 0x66,
+	0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x32, 0x77, 0x0a, 0x0d, 0x4d, 0x73, 0x67, 0x47,
+	0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x45, 0x78, 0x74, 0x12, 0x66, 0x0a, 0x0f, 0x6b, 0x69, 0x63,
+	0x6b, 0x43, 0x6f, 0x6e, 0x6e, 0x4f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x28, 0x2e, 0x6f,
+	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
+	0x65, 0x78, 0x74, 0x2e, 0x6b, 0x69, 0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x6e, 0x4f, 0x66, 0x66, 0x6c,
+	0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x29, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
+	0x6d, 0x73, 0x67, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x65, 0x78, 0x74, 0x2e, 0x6b, 0x69,
+	0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x6e, 0x4f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x42, 0x43, 0x5a, 0x41, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x73, 0x64, 0x6b, 0x2f, 0x6f, 0x70, 0x65, 0x6e, 0x2d, 0x69,
+	0x6d, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x76, 0x33, 0x2f, 0x70, 0x6b, 0x67, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x6d, 0x73, 0x67, 0x67, 0x61, 0x74, 0x65,
+	0x77, 0x61, 0x79, 0x65, 0x78, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_msggatewayext_msggatewayext_proto_rawDescOnce sync.Once
+	file_msggatewayext_msggatewayext_proto_rawDescData = file_msggatewayext_msggatewayext_proto_rawDesc
+)
+
+func file_msggatewayext_msggatewayext_proto_rawDescGZIP() []byte {
+	file_msggatewayext_msggatewayext_proto_rawDescOnce.Do(func() {
+		file_msggatewayext_msggatewayext_proto_rawDescData = protoimpl.X.CompressGZIP(file_msggatewayext_msggatewayext_proto_rawDescData)
+	})
+	return file_msggatewayext_msggatewayext_proto_rawDescData
+}
+
+var file_msggatewayext_msggatewayext_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_msggatewayext_msggatewayext_proto_goTypes = []interface{}{
+	(*KickConnOfflineReq)(nil),  // 0: openim.msggatewayext.kickConnOfflineReq
+	(*KickConnOfflineResp)(nil), // 1: openim.msggatewayext.kickConnOfflineResp
+}
+var file_msggatewayext_msggatewayext_proto_depIdxs = []int32{
+	0, // 0: openim.msggatewayext.MsgGatewayExt.kickConnOffline:input_type -> openim.msggatewayext.kickConnOfflineReq
+	1, // 1: openim.msggatewayext.MsgGatewayExt.kickConnOffline:output_type -> openim.msggatewayext.kickConnOfflineResp
+	1, // [1:2] is the sub-list for method output_type
+	0, // [0:1] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_msggatewayext_msggatewayext_proto_init() }
+func file_msggatewayext_msggatewayext_proto_init() {
+	if File_msggatewayext_msggatewayext_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_msggatewayext_msggatewayext_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KickConnOfflineReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msggatewayext_msggatewayext_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KickConnOfflineResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_msggatewayext_msggatewayext_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_msggatewayext_msggatewayext_proto_goTypes,
+		DependencyIndexes: file_msggatewayext_msggatewayext_proto_depIdxs,
+		MessageInfos:      file_msggatewayext_msggatewayext_proto_msgTypes,
+	}.Build()
+	File_msggatewayext_msggatewayext_proto = out.File
+	file_msggatewayext_msggatewayext_proto_rawDesc = nil
+	file_msggatewayext_msggatewayext_proto_goTypes = nil
+	file_msggatewayext_msggatewayext_proto_depIdxs = nil
+}
@@ -0,0 +1,233 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.33.0
+// 	protoc        (unknown)
+// source: thirdext/thirdext.proto
+
+package thirdext
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type PurgeUserObjectsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+}
+
+func (x *PurgeUserObjectsReq) Reset() {
+	*x = PurgeUserObjectsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_thirdext_thirdext_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PurgeUserObjectsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeUserObjectsReq) ProtoMessage() {}
+
+func (x *PurgeUserObjectsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_thirdext_thirdext_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeUserObjectsReq.ProtoReflect.Descriptor instead.
+func (*PurgeUserObjectsReq) Descriptor() ([]byte, []int) {
+	return file_thirdext_thirdext_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PurgeUserObjectsReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+type PurgeUserObjectsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// objects deleted
+	Count int64 `protobuf:"varint,1,opt,name=count,proto3" json:"count"`
+}
+
+func (x *PurgeUserObjectsResp) Reset() {
+	*x = PurgeUserObjectsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_thirdext_thirdext_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PurgeUserObjectsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeUserObjectsResp) ProtoMessage() {}
+
+func (x *PurgeUserObjectsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_thirdext_thirdext_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeUserObjectsResp.ProtoReflect.Descriptor instead.
+func (*PurgeUserObjectsResp) Descriptor() ([]byte, []int) {
+	return file_thirdext_thirdext_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PurgeUserObjectsResp) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+var File_thirdext_thirdext_proto protoreflect.FileDescriptor
+
+var file_thirdext_thirdext_proto_rawDesc = []byte{
+	0x0a, 0x17, 0x74, 0x68, 0x69, 0x72, 0x64, 0x65, 0x78, 0x74, 0x2f, 0x74, 0x68, 0x69, 0x72, 0x64,
+	0x65, 0x78, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0f, 0x6f, 0x70, 0x65, 0x6e, 0x69,
+	0x6d, 0x2e, 0x74, 0x68, 0x69, 0x72, 0x64, 0x65, 0x78, 0x74, 0x22, 0x2d, 0x0a, 0x13, 0x70, 0x75,
+	0x72, 0x67, 0x65, 0x55, 0x73, 0x65, 0x72, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x2c, 0x0a, 0x14, 0x70, 0x75, 0x72,
+	0x67, 0x65, 0x55, 0x73, 0x65, 0x72, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x32, 0x6b, 0x0a, 0x08, 0x74, 0x68, 0x69, 0x72, 0x64,
+	0x45, 0x78, 0x74, 0x12, 0x5f, 0x0a, 0x10, 0x70, 0x75, 0x72, 0x67, 0x65, 0x55, 0x73, 0x65, 0x72,
+	0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x24, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d,
+	0x2e, 0x74, 0x68, 0x69, 0x72, 0x64, 0x65, 0x78, 0x74, 0x2e, 0x70, 0x75, 0x72, 0x67, 0x65, 0x55,
+	0x73, 0x65, 0x72, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x25, 0x2e,
+	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x74, 0x68, 0x69, 0x72, 0x64, 0x65, 0x78, 0x74, 0x2e,
+	0x70, 0x75, 0x72, 0x67, 0x65, 0x55, 0x73, 0x65, 0x72, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x42, 0x3e, 0x5a, 0x3c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x73, 0x64, 0x6b, 0x2f, 0x6f, 0x70, 0x65,
+	0x6e, 0x2d, 0x69, 0x6d, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x76, 0x33, 0x2f, 0x70,
+	0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x74, 0x68, 0x69, 0x72,
+	0x64, 0x65, 0x78, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_thirdext_thirdext_proto_rawDescOnce sync.Once
+	file_thirdext_thirdext_proto_rawDescData = file_thirdext_thirdext_proto_rawDesc
+)
+
+func file_thirdext_thirdext_proto_rawDescGZIP() []byte {
+	file_thirdext_thirdext_proto_rawDescOnce.Do(func() {
+		file_thirdext_thirdext_proto_rawDescData = protoimpl.X.CompressGZIP(file_thirdext_thirdext_proto_rawDescData)
+	})
+	return file_thirdext_thirdext_proto_rawDescData
+}
+
+var file_thirdext_thirdext_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_thirdext_thirdext_proto_goTypes = []interface{}{
+	(*PurgeUserObjectsReq)(nil),  // 0: openim.thirdext.purgeUserObjectsReq
+	(*PurgeUserObjectsResp)(nil), // 1: openim.thirdext.purgeUserObjectsResp
+}
+var file_thirdext_thirdext_proto_depIdxs = []int32{
+	0, // 0: openim.thirdext.thirdExt.purgeUserObjects:input_type -> openim.thirdext.purgeUserObjectsReq
+	1, // 1: openim.thirdext.thirdExt.purgeUserObjects:output_type -> openim.thirdext.purgeUserObjectsResp
+	1, // [1:2] is the sub-list for method output_type
+	0, // [0:1] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_thirdext_thirdext_proto_init() }
+func file_thirdext_thirdext_proto_init() {
+	if File_thirdext_thirdext_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_thirdext_thirdext_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PurgeUserObjectsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_thirdext_thirdext_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PurgeUserObjectsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_thirdext_thirdext_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_thirdext_thirdext_proto_goTypes,
+		DependencyIndexes: file_thirdext_thirdext_proto_depIdxs,
+		MessageInfos:      file_thirdext_thirdext_proto_msgTypes,
+	}.Build()
+	File_thirdext_thirdext_proto = out.File
+	file_thirdext_thirdext_proto_rawDesc = nil
+	file_thirdext_thirdext_proto_goTypes = nil
+	file_thirdext_thirdext_proto_depIdxs = nil
+}
@@ -0,0 +1,123 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: thirdext/thirdext.proto
+
+package thirdext
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	ThirdExt_PurgeUserObjects_FullMethodName = "/openim.thirdext.thirdExt/purgeUserObjects"
+)
+
+// ThirdExtClient is the client API for ThirdExt service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ThirdExtClient interface {
+	// Admin only, deletes the objects uploaded by a deleted user. The stored file is removed when no other object refers to it.
+	PurgeUserObjects(ctx context.Context, in *PurgeUserObjectsReq, opts ...grpc.CallOption) (*PurgeUserObjectsResp, error)
+}
+
+type thirdExtClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewThirdExtClient(cc grpc.ClientConnInterface) ThirdExtClient {
+	return &thirdExtClient{cc}
+}
+
+func (c *thirdExtClient) PurgeUserObjects(ctx context.Context, in *PurgeUserObjectsReq, opts ...grpc.CallOption) (*PurgeUserObjectsResp, error) {
+	out := new(PurgeUserObjectsResp)
+	err := c.cc.Invoke(ctx, ThirdExt_PurgeUserObjects_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ThirdExtServer is the server API for ThirdExt service.
+// All implementations should embed UnimplementedThirdExtServer
+// for forward compatibility
+type ThirdExtServer interface {
+	// Admin only, deletes the objects uploaded by a deleted user. The stored file is removed when no other object refers to it.
+	PurgeUserObjects(context.Context, *PurgeUserObjectsReq) (*PurgeUserObjectsResp, error)
+}
+
+// UnimplementedThirdExtServer should be embedded to have forward compatible implementations.
+type UnimplementedThirdExtServer struct {
+}
+
+func (UnimplementedThirdExtServer) PurgeUserObjects(context.Context, *PurgeUserObjectsReq) (*PurgeUserObjectsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeUserObjects not implemented")
+}
+
+// UnsafeThirdExtServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ThirdExtServer will
+// result in compilation errors.
+type UnsafeThirdExtServer interface {
+	mustEmbedUnimplementedThirdExtServer()
+}
+
+func RegisterThirdExtServer(s grpc.ServiceRegistrar, srv ThirdExtServer) {
+	s.RegisterService(&ThirdExt_ServiceDesc, srv)
+}
+
+func _ThirdExt_PurgeUserObjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeUserObjectsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThirdExtServer).PurgeUserObjects(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThirdExt_PurgeUserObjects_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThirdExtServer).PurgeUserObjects(ctx, req.(*PurgeUserObjectsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ThirdExt_ServiceDesc is the grpc.ServiceDesc for ThirdExt service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ThirdExt_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "openim.thirdext.thirdExt",
+	HandlerType: (*ThirdExtServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "purgeUserObjects",
+			Handler:    _ThirdExt_PurgeUserObjects_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "thirdext/thirdext.proto",
+}
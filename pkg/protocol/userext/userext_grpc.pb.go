@@ -0,0 +1,390 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: userext/userext.proto
+
+package userext
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	UserExt_RequestAccountDeletion_FullMethodName = "/openim.userext.userExt/requestAccountDeletion"
+	UserExt_CancelAccountDeletion_FullMethodName  = "/openim.userext.userExt/cancelAccountDeletion"
+	UserExt_GetAccountDeletion_FullMethodName     = "/openim.userext.userExt/getAccountDeletion"
+	UserExt_PurgeDeletedUsers_FullMethodName      = "/openim.userext.userExt/purgeDeletedUsers"
+	UserExt_CheckAccountActive_FullMethodName     = "/openim.userext.userExt/checkAccountActive"
+	UserExt_RequestDataExport_FullMethodName      = "/openim.userext.userExt/requestDataExport"
+	UserExt_GetDataExports_FullMethodName         = "/openim.userext.userExt/getDataExports"
+	UserExt_ProcessDataExports_FullMethodName     = "/openim.userext.userExt/processDataExports"
+)
+
+// UserExtClient is the client API for UserExt service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type UserExtClient interface {
+	// Schedule the deletion of an account after the grace period, the user or an admin can cancel it until then
+	RequestAccountDeletion(ctx context.Context, in *RequestAccountDeletionReq, opts ...grpc.CallOption) (*RequestAccountDeletionResp, error)
+	CancelAccountDeletion(ctx context.Context, in *CancelAccountDeletionReq, opts ...grpc.CallOption) (*CancelAccountDeletionResp, error)
+	GetAccountDeletion(ctx context.Context, in *GetAccountDeletionReq, opts ...grpc.CallOption) (*GetAccountDeletionResp, error)
+	// Admin only, purges a batch of accounts whose grace period has ended
+	PurgeDeletedUsers(ctx context.Context, in *PurgeDeletedUsersReq, opts ...grpc.CallOption) (*PurgeDeletedUsersResp, error)
+	// Called before tokens are issued, fails for unknown users and with AccountDeleted for purged accounts
+	CheckAccountActive(ctx context.Context, in *CheckAccountActiveReq, opts ...grpc.CallOption) (*CheckAccountActiveResp, error)
+	// Queue an archive of the profile, contacts, groups and messages of the user
+	RequestDataExport(ctx context.Context, in *RequestDataExportReq, opts ...grpc.CallOption) (*RequestDataExportResp, error)
+	GetDataExports(ctx context.Context, in *GetDataExportsReq, opts ...grpc.CallOption) (*GetDataExportsResp, error)
+	// Admin only, builds and uploads a batch of queued archives
+	ProcessDataExports(ctx context.Context, in *ProcessDataExportsReq, opts ...grpc.CallOption) (*ProcessDataExportsResp, error)
+}
+
+type userExtClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewUserExtClient(cc grpc.ClientConnInterface) UserExtClient {
+	return &userExtClient{cc}
+}
+
+func (c *userExtClient) RequestAccountDeletion(ctx context.Context, in *RequestAccountDeletionReq, opts ...grpc.CallOption) (*RequestAccountDeletionResp, error) {
+	out := new(RequestAccountDeletionResp)
+	err := c.cc.Invoke(ctx, UserExt_RequestAccountDeletion_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userExtClient) CancelAccountDeletion(ctx context.Context, in *CancelAccountDeletionReq, opts ...grpc.CallOption) (*CancelAccountDeletionResp, error) {
+	out := new(CancelAccountDeletionResp)
+	err := c.cc.Invoke(ctx, UserExt_CancelAccountDeletion_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userExtClient) GetAccountDeletion(ctx context.Context, in *GetAccountDeletionReq, opts ...grpc.CallOption) (*GetAccountDeletionResp, error) {
+	out := new(GetAccountDeletionResp)
+	err := c.cc.Invoke(ctx, UserExt_GetAccountDeletion_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userExtClient) PurgeDeletedUsers(ctx context.Context, in *PurgeDeletedUsersReq, opts ...grpc.CallOption) (*PurgeDeletedUsersResp, error) {
+	out := new(PurgeDeletedUsersResp)
+	err := c.cc.Invoke(ctx, UserExt_PurgeDeletedUsers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userExtClient) CheckAccountActive(ctx context.Context, in *CheckAccountActiveReq, opts ...grpc.CallOption) (*CheckAccountActiveResp, error) {
+	out := new(CheckAccountActiveResp)
+	err := c.cc.Invoke(ctx, UserExt_CheckAccountActive_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userExtClient) RequestDataExport(ctx context.Context, in *RequestDataExportReq, opts ...grpc.CallOption) (*RequestDataExportResp, error) {
+	out := new(RequestDataExportResp)
+	err := c.cc.Invoke(ctx, UserExt_RequestDataExport_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userExtClient) GetDataExports(ctx context.Context, in *GetDataExportsReq, opts ...grpc.CallOption) (*GetDataExportsResp, error) {
+	out := new(GetDataExportsResp)
+	err := c.cc.Invoke(ctx, UserExt_GetDataExports_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userExtClient) ProcessDataExports(ctx context.Context, in *ProcessDataExportsReq, opts ...grpc.CallOption) (*ProcessDataExportsResp, error) {
+	out := new(ProcessDataExportsResp)
+	err := c.cc.Invoke(ctx, UserExt_ProcessDataExports_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// UserExtServer is the server API for UserExt service.
+// All implementations should embed UnimplementedUserExtServer
+// for forward compatibility
+type UserExtServer interface {
+	// Schedule the deletion of an account after the grace period, the user or an admin can cancel it until then
+	RequestAccountDeletion(context.Context, *RequestAccountDeletionReq) (*RequestAccountDeletionResp, error)
+	CancelAccountDeletion(context.Context, *CancelAccountDeletionReq) (*CancelAccountDeletionResp, error)
+	GetAccountDeletion(context.Context, *GetAccountDeletionReq) (*GetAccountDeletionResp, error)
+	// Admin only, purges a batch of accounts whose grace period has ended
+	PurgeDeletedUsers(context.Context, *PurgeDeletedUsersReq) (*PurgeDeletedUsersResp, error)
+	// Called before tokens are issued, fails for unknown users and with AccountDeleted for purged accounts
+	CheckAccountActive(context.Context, *CheckAccountActiveReq) (*CheckAccountActiveResp, error)
+	// Queue an archive of the profile, contacts, groups and messages of the user
+	RequestDataExport(context.Context, *RequestDataExportReq) (*RequestDataExportResp, error)
+	GetDataExports(context.Context, *GetDataExportsReq) (*GetDataExportsResp, error)
+	// Admin only, builds and uploads a batch of queued archives
+	ProcessDataExports(context.Context, *ProcessDataExportsReq) (*ProcessDataExportsResp, error)
+}
+
+// UnimplementedUserExtServer should be embedded to have forward compatible implementations.
+type UnimplementedUserExtServer struct {
+}
+
+func (UnimplementedUserExtServer) RequestAccountDeletion(context.Context, *RequestAccountDeletionReq) (*RequestAccountDeletionResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestAccountDeletion not implemented")
+}
+func (UnimplementedUserExtServer) CancelAccountDeletion(context.Context, *CancelAccountDeletionReq) (*CancelAccountDeletionResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelAccountDeletion not implemented")
+}
+func (UnimplementedUserExtServer) GetAccountDeletion(context.Context, *GetAccountDeletionReq) (*GetAccountDeletionResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccountDeletion not implemented")
+}
+func (UnimplementedUserExtServer) PurgeDeletedUsers(context.Context, *PurgeDeletedUsersReq) (*PurgeDeletedUsersResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeDeletedUsers not implemented")
+}
+func (UnimplementedUserExtServer) CheckAccountActive(context.Context, *CheckAccountActiveReq) (*CheckAccountActiveResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckAccountActive not implemented")
+}
+func (UnimplementedUserExtServer) RequestDataExport(context.Context, *RequestDataExportReq) (*RequestDataExportResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestDataExport not implemented")
+}
+func (UnimplementedUserExtServer) GetDataExports(context.Context, *GetDataExportsReq) (*GetDataExportsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDataExports not implemented")
+}
+func (UnimplementedUserExtServer) ProcessDataExports(context.Context, *ProcessDataExportsReq) (*ProcessDataExportsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProcessDataExports not implemented")
+}
+
+// UnsafeUserExtServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to UserExtServer will
+// result in compilation errors.
+type UnsafeUserExtServer interface {
+	mustEmbedUnimplementedUserExtServer()
+}
+
+func RegisterUserExtServer(s grpc.ServiceRegistrar, srv UserExtServer) {
+	s.RegisterService(&UserExt_ServiceDesc, srv)
+}
+
+func _UserExt_RequestAccountDeletion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestAccountDeletionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserExtServer).RequestAccountDeletion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserExt_RequestAccountDeletion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserExtServer).RequestAccountDeletion(ctx, req.(*RequestAccountDeletionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserExt_CancelAccountDeletion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelAccountDeletionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserExtServer).CancelAccountDeletion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserExt_CancelAccountDeletion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserExtServer).CancelAccountDeletion(ctx, req.(*CancelAccountDeletionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserExt_GetAccountDeletion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountDeletionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserExtServer).GetAccountDeletion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserExt_GetAccountDeletion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserExtServer).GetAccountDeletion(ctx, req.(*GetAccountDeletionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserExt_PurgeDeletedUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeDeletedUsersReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserExtServer).PurgeDeletedUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserExt_PurgeDeletedUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserExtServer).PurgeDeletedUsers(ctx, req.(*PurgeDeletedUsersReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserExt_CheckAccountActive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckAccountActiveReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserExtServer).CheckAccountActive(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserExt_CheckAccountActive_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserExtServer).CheckAccountActive(ctx, req.(*CheckAccountActiveReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserExt_RequestDataExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestDataExportReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserExtServer).RequestDataExport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserExt_RequestDataExport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserExtServer).RequestDataExport(ctx, req.(*RequestDataExportReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserExt_GetDataExports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDataExportsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserExtServer).GetDataExports(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserExt_GetDataExports_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserExtServer).GetDataExports(ctx, req.(*GetDataExportsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserExt_ProcessDataExports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProcessDataExportsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserExtServer).ProcessDataExports(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserExt_ProcessDataExports_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserExtServer).ProcessDataExports(ctx, req.(*ProcessDataExportsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// UserExt_ServiceDesc is the grpc.ServiceDesc for UserExt service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var UserExt_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "openim.userext.userExt",
+	HandlerType: (*UserExtServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "requestAccountDeletion",
+			Handler:    _UserExt_RequestAccountDeletion_Handler,
+		},
+		{
+			MethodName: "cancelAccountDeletion",
+			Handler:    _UserExt_CancelAccountDeletion_Handler,
+		},
+		{
+			MethodName: "getAccountDeletion",
+			Handler:    _UserExt_GetAccountDeletion_Handler,
+		},
+		{
+			MethodName: "purgeDeletedUsers",
+			Handler:    _UserExt_PurgeDeletedUsers_Handler,
+		},
+		{
+			MethodName: "checkAccountActive",
+			Handler:    _UserExt_CheckAccountActive_Handler,
+		},
+		{
+			MethodName: "requestDataExport",
+			Handler:    _UserExt_RequestDataExport_Handler,
+		},
+		{
+			MethodName: "getDataExports",
+			Handler:    _UserExt_GetDataExports_Handler,
+		},
+		{
+			MethodName: "processDataExports",
+			Handler:    _UserExt_ProcessDataExports_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "userext/userext.proto",
+}
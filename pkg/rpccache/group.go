@@ -18,8 +18,10 @@ import (
 	"context"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache/cachekey"
 
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
 	"github.com/openimsdk/open-im-server/v3/pkg/localcache"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/groupext"
 	"github.com/openimsdk/open-im-server/v3/pkg/rpcclient"
 	"github.com/openimsdk/protocol/sdkws"
 	"github.com/openimsdk/tools/errs"
@@ -96,6 +98,79 @@ func (g *GroupLocalCache) GetGroupInfo(ctx context.Context, groupID string) (val
 	}))
 }
 
+func (g *GroupLocalCache) GetGroupRoles(ctx context.Context, groupID string) (val []*groupext.GroupRole, err error) {
+	log.ZDebug(ctx, "GroupLocalCache GetGroupRoles req", "groupID", groupID)
+	defer func() {
+		if err == nil {
+			log.ZDebug(ctx, "GroupLocalCache GetGroupRoles return", "value", val)
+		} else {
+			log.ZError(ctx, "GroupLocalCache GetGroupRoles return", err)
+		}
+	}()
+	return localcache.AnyValue[[]*groupext.GroupRole](g.local.Get(ctx, cachekey.GetGroupRolesKey(groupID), func(ctx context.Context) (any, error) {
+		log.ZDebug(ctx, "GroupLocalCache GetGroupRoles rpc", "groupID", groupID)
+		return g.client.GetGroupRoles(ctx, groupID)
+	}))
+}
+
+func (g *GroupLocalCache) GetGroupMsgPolicy(ctx context.Context, groupID string) (val *groupext.GroupMsgPolicy, err error) {
+	log.ZDebug(ctx, "GroupLocalCache GetGroupMsgPolicy req", "groupID", groupID)
+	defer func() {
+		if err == nil {
+			log.ZDebug(ctx, "GroupLocalCache GetGroupMsgPolicy return", "value", val)
+		} else {
+			log.ZError(ctx, "GroupLocalCache GetGroupMsgPolicy return", err)
+		}
+	}()
+	return localcache.AnyValue[*groupext.GroupMsgPolicy](g.local.Get(ctx, cachekey.GetGroupMsgPolicyKey(groupID), func(ctx context.Context) (any, error) {
+		log.ZDebug(ctx, "GroupLocalCache GetGroupMsgPolicy rpc", "groupID", groupID)
+		return g.client.GetGroupMsgPolicy(ctx, groupID)
+	}))
+}
+
+func (g *GroupLocalCache) GetGroupCommunity(ctx context.Context, groupID string) (val *groupext.GetGroupCommunityResp, err error) {
+	log.ZDebug(ctx, "GroupLocalCache GetGroupCommunity req", "groupID", groupID)
+	defer func() {
+		if err == nil {
+			log.ZDebug(ctx, "GroupLocalCache GetGroupCommunity return", "value", val)
+		} else {
+			log.ZError(ctx, "GroupLocalCache GetGroupCommunity return", err)
+		}
+	}()
+	return localcache.AnyValue[*groupext.GetGroupCommunityResp](g.local.Get(ctx, cachekey.GetGroupCommunityKey(groupID), func(ctx context.Context) (any, error) {
+		log.ZDebug(ctx, "GroupLocalCache GetGroupCommunity rpc", "groupID", groupID)
+		return g.client.GetGroupCommunity(ctx, groupID)
+	}))
+}
+
+func (g *GroupLocalCache) GetCommunityMember(ctx context.Context, communityID, userID string) (val *groupext.CommunityMember, err error) {
+	log.ZDebug(ctx, "GroupLocalCache GetCommunityMember req", "communityID", communityID, "userID", userID)
+	defer func() {
+		if err == nil {
+			log.ZDebug(ctx, "GroupLocalCache GetCommunityMember return", "value", val)
+		} else {
+			log.ZError(ctx, "GroupLocalCache GetCommunityMember return", err)
+		}
+	}()
+	return localcache.AnyValue[*groupext.CommunityMember](g.local.Get(ctx, cachekey.GetCommunityMemberKey(communityID, userID), func(ctx context.Context) (any, error) {
+		log.ZDebug(ctx, "GroupLocalCache GetCommunityMember rpc", "communityID", communityID, "userID", userID)
+		return g.client.GetCommunityMember(ctx, communityID, userID)
+	}))
+}
+
+// HasGroupPermission reports whether the member holds permission, looking up custom roles only when the member has one.
+func (g *GroupLocalCache) HasGroupPermission(ctx context.Context, groupID string, roleLevel int32, permission string) (bool, error) {
+	var roles []*groupext.GroupRole
+	if authverify.IsCustomGroupRoleLevel(roleLevel) {
+		var err error
+		roles, err = g.GetGroupRoles(ctx, groupID)
+		if err != nil {
+			return false, err
+		}
+	}
+	return authverify.HasGroupPermission(roleLevel, roles, permission), nil
+}
+
 func (g *GroupLocalCache) GetGroupMemberIDs(ctx context.Context, groupID string) ([]string, error) {
 	res, err := g.getGroupMemberIDs(ctx, groupID)
 	if err != nil {
@@ -16,6 +16,7 @@ package rpcclient
 
 import (
 	"context"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/authext"
 	"github.com/openimsdk/protocol/auth"
 	pbAuth "github.com/openimsdk/protocol/auth"
 	"github.com/openimsdk/tools/discovery"
@@ -29,13 +30,14 @@ func NewAuth(discov discovery.SvcDiscoveryRegistry, rpcRegisterName string) *Aut
 		program.ExitWithError(err)
 	}
 	client := auth.NewAuthClient(conn)
-	return &Auth{discov: discov, conn: conn, Client: client}
+	return &Auth{discov: discov, conn: conn, Client: client, ExtClient: authext.NewAuthExtClient(conn)}
 }
 
 type Auth struct {
-	conn   grpc.ClientConnInterface
-	Client auth.AuthClient
-	discov discovery.SvcDiscoveryRegistry
+	conn      grpc.ClientConnInterface
+	Client    auth.AuthClient
+	ExtClient authext.AuthExtClient
+	discov    discovery.SvcDiscoveryRegistry
 }
 
 func (a *Auth) ParseToken(ctx context.Context, token string) (*pbAuth.ParseTokenResp, error) {
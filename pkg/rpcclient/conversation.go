@@ -18,6 +18,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/conversationext"
 	pbconversation "github.com/openimsdk/protocol/conversation"
 	"github.com/openimsdk/tools/discovery"
 	"github.com/openimsdk/tools/errs"
@@ -26,9 +27,10 @@ import (
 )
 
 type Conversation struct {
-	Client pbconversation.ConversationClient
-	conn   grpc.ClientConnInterface
-	discov discovery.SvcDiscoveryRegistry
+	Client    pbconversation.ConversationClient
+	ExtClient conversationext.ConversationExtClient
+	conn      grpc.ClientConnInterface
+	discov    discovery.SvcDiscoveryRegistry
 }
 
 func NewConversation(discov discovery.SvcDiscoveryRegistry, rpcRegisterName string) *Conversation {
@@ -37,7 +39,7 @@ func NewConversation(discov discovery.SvcDiscoveryRegistry, rpcRegisterName stri
 		program.ExitWithError(err)
 	}
 	client := pbconversation.NewConversationClient(conn)
-	return &Conversation{discov: discov, conn: conn, Client: client}
+	return &Conversation{discov: discov, conn: conn, Client: client, ExtClient: conversationext.NewConversationExtClient(conn)}
 }
 
 type ConversationRpcClient Conversation
@@ -125,6 +127,33 @@ func (c *ConversationRpcClient) GetConversationOfflinePushUserIDs(ctx context.Co
 	return resp.UserIDs, nil
 }
 
+// GetOfflinePushUserIDs also applies the do not disturb settings of the users, mentioned users may be let through.
+func (c *ConversationRpcClient) GetOfflinePushUserIDs(ctx context.Context, conversationID string, userIDs []string, mentionedUserIDs []string) ([]string, error) {
+	resp, err := c.ExtClient.GetOfflinePushUserIDs(ctx, &conversationext.GetOfflinePushUserIDsReq{
+		ConversationID:   conversationID,
+		UserIDs:          userIDs,
+		MentionedUserIDs: mentionedUserIDs,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.UserIDs, nil
+}
+
+// GetDoNotDisturbPushUserIDs only applies the do not disturb settings of the users.
+func (c *ConversationRpcClient) GetDoNotDisturbPushUserIDs(ctx context.Context, conversationID string, userIDs []string, mentionedUserIDs []string) ([]string, error) {
+	resp, err := c.ExtClient.GetOfflinePushUserIDs(ctx, &conversationext.GetOfflinePushUserIDsReq{
+		ConversationID:   conversationID,
+		UserIDs:          userIDs,
+		MentionedUserIDs: mentionedUserIDs,
+		DoNotDisturbOnly: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.UserIDs, nil
+}
+
 func (c *ConversationRpcClient) GetConversations(ctx context.Context, ownerUserID string, conversationIDs []string) ([]*pbconversation.Conversation, error) {
 	if len(conversationIDs) == 0 {
 		return nil, nil
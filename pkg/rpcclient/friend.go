@@ -17,6 +17,7 @@ package rpcclient
 import (
 	"context"
 
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/friendext"
 	"github.com/openimsdk/protocol/friend"
 	sdkws "github.com/openimsdk/protocol/sdkws"
 	"github.com/openimsdk/tools/discovery"
@@ -25,9 +26,10 @@ import (
 )
 
 type Friend struct {
-	conn   grpc.ClientConnInterface
-	Client friend.FriendClient
-	discov discovery.SvcDiscoveryRegistry
+	conn      grpc.ClientConnInterface
+	Client    friend.FriendClient
+	ExtClient friendext.FriendExtClient
+	discov    discovery.SvcDiscoveryRegistry
 }
 
 func NewFriend(discov discovery.SvcDiscoveryRegistry, rpcRegisterName string) *Friend {
@@ -36,7 +38,7 @@ func NewFriend(discov discovery.SvcDiscoveryRegistry, rpcRegisterName string) *F
 		program.ExitWithError(err)
 	}
 	client := friend.NewFriendClient(conn)
-	return &Friend{discov: discov, conn: conn, Client: client}
+	return &Friend{discov: discov, conn: conn, Client: client, ExtClient: friendext.NewFriendExtClient(conn)}
 }
 
 type FriendRpcClient Friend
@@ -19,6 +19,7 @@ import (
 	"strings"
 
 	"github.com/openimsdk/open-im-server/v3/pkg/common/servererrs"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/groupext"
 	"github.com/openimsdk/protocol/constant"
 	"github.com/openimsdk/protocol/group"
 	"github.com/openimsdk/protocol/sdkws"
@@ -28,8 +29,9 @@ import (
 )
 
 type Group struct {
-	Client group.GroupClient
-	discov discovery.SvcDiscoveryRegistry
+	Client    group.GroupClient
+	ExtClient groupext.GroupExtClient
+	discov    discovery.SvcDiscoveryRegistry
 }
 
 func NewGroup(discov discovery.SvcDiscoveryRegistry, rpcRegisterName string) *Group {
@@ -38,7 +40,7 @@ func NewGroup(discov discovery.SvcDiscoveryRegistry, rpcRegisterName string) *Gr
 		program.ExitWithError(err)
 	}
 	client := group.NewGroupClient(conn)
-	return &Group{discov: discov, Client: client}
+	return &Group{discov: discov, Client: client, ExtClient: groupext.NewGroupExtClient(conn)}
 }
 
 type GroupRpcClient Group
@@ -189,6 +191,71 @@ func (g *GroupRpcClient) GetGroupMemberCache(ctx context.Context, groupID string
 	return resp.Member, nil
 }
 
+func (g *GroupRpcClient) GetGroupRoles(ctx context.Context, groupID string) ([]*groupext.GroupRole, error) {
+	resp, err := g.ExtClient.GetGroupRoles(ctx, &groupext.GetGroupRolesReq{
+		GroupID: groupID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Roles, nil
+}
+
+func (g *GroupRpcClient) GetGroupMsgPolicy(ctx context.Context, groupID string) (*groupext.GroupMsgPolicy, error) {
+	resp, err := g.ExtClient.GetGroupMsgPolicy(ctx, &groupext.GetGroupMsgPolicyReq{
+		GroupID: groupID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Policy, nil
+}
+
+func (g *GroupRpcClient) GetGroupCommunity(ctx context.Context, groupID string) (*groupext.GetGroupCommunityResp, error) {
+	return g.ExtClient.GetGroupCommunity(ctx, &groupext.GetGroupCommunityReq{
+		GroupID: groupID,
+	})
+}
+
+func (g *GroupRpcClient) GetCommunityMember(ctx context.Context, communityID, userID string) (*groupext.CommunityMember, error) {
+	resp, err := g.ExtClient.GetCommunityMember(ctx, &groupext.GetCommunityMemberReq{
+		CommunityID: communityID,
+		UserID:      userID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Member, nil
+}
+
+// GetSharedGroupMembers maps the other members of the groups of the user to the number of groups they share.
+func (g *GroupRpcClient) GetSharedGroupMembers(ctx context.Context, userID string, maxGroupMembers int64, limit int64) (map[string]int64, error) {
+	resp, err := g.ExtClient.GetSharedGroupMembers(ctx, &groupext.GetSharedGroupMembersReq{
+		UserID:          userID,
+		MaxGroupMembers: maxGroupMembers,
+		Limit:           limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	res := make(map[string]int64, len(resp.Members))
+	for _, member := range resp.Members {
+		res[member.UserID] = member.SharedGroups
+	}
+	return res, nil
+}
+
+func (g *GroupRpcClient) FindCommunityMemberIDs(ctx context.Context, communityID string, userIDs []string) ([]string, error) {
+	resp, err := g.ExtClient.FindCommunityMemberIDs(ctx, &groupext.FindCommunityMemberIDsReq{
+		CommunityID: communityID,
+		UserIDs:     userIDs,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.UserIDs, nil
+}
+
 func (g *GroupRpcClient) DismissGroup(ctx context.Context, groupID string) error {
 	_, err := g.Client.DismissGroup(ctx, &group.DismissGroupReq{
 		GroupID:      groupID,
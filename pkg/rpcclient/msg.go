@@ -18,6 +18,10 @@ import (
 	"context"
 	"encoding/json"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/conversationext"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/friendext"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/groupext"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/msgext"
 	"github.com/openimsdk/protocol/constant"
 	"github.com/openimsdk/protocol/msg"
 	"github.com/openimsdk/protocol/sdkws"
@@ -57,6 +61,8 @@ func newContentTypeConf(conf *config.Notification) map[int32]config.Notification
 		constant.GroupMemberSetToOrdinaryUserNotification: conf.GroupMemberSetToOrdinary,
 		constant.GroupInfoSetAnnouncementNotification:     conf.GroupInfoSetAnnouncement,
 		constant.GroupInfoSetNameNotification:             conf.GroupInfoSetName,
+		groupext.CommunityAnnouncementNotification:        conf.CommunityAnnouncement,
+		groupext.GroupMsgPolicySetNotification:            conf.GroupMsgPolicySet,
 		// user
 		constant.UserInfoUpdatedNotification:  conf.UserInfoUpdated,
 		constant.UserStatusChangeNotification: conf.UserStatusChanged,
@@ -71,14 +77,20 @@ func newContentTypeConf(conf *config.Notification) map[int32]config.Notification
 		constant.BlackDeletedNotification:              conf.BlackDeleted,
 		constant.FriendInfoUpdatedNotification:         conf.FriendInfoUpdated,
 		constant.FriendsInfoUpdateNotification:         conf.FriendInfoUpdated, // use the same FriendInfoUpdated
+		friendext.FriendGroupChangedNotification:       conf.FriendGroupChanged,
 		// conversation
-		constant.ConversationChangeNotification:      conf.ConversationChanged,
-		constant.ConversationUnreadNotification:      conf.ConversationChanged,
-		constant.ConversationPrivateChatNotification: conf.ConversationSetPrivate,
+		constant.ConversationChangeNotification:        conf.ConversationChanged,
+		constant.ConversationUnreadNotification:        conf.ConversationChanged,
+		constant.ConversationPrivateChatNotification:   conf.ConversationSetPrivate,
+		conversationext.ConversationDraftNotification:  conf.ConversationDraftChanged,
+		conversationext.ConversationFolderNotification: conf.ConversationFolderChanged,
+		conversationext.DoNotDisturbNotification:       conf.DoNotDisturbChanged,
 		// msg
-		constant.MsgRevokeNotification:  {IsSendMsg: false, ReliabilityLevel: constant.ReliableNotificationNoMsg},
-		constant.HasReadReceipt:         {IsSendMsg: false, ReliabilityLevel: constant.ReliableNotificationNoMsg},
-		constant.DeleteMsgsNotification: {IsSendMsg: false, ReliabilityLevel: constant.ReliableNotificationNoMsg},
+		constant.MsgRevokeNotification:   {IsSendMsg: false, ReliabilityLevel: constant.ReliableNotificationNoMsg},
+		constant.HasReadReceipt:          {IsSendMsg: false, ReliabilityLevel: constant.ReliableNotificationNoMsg},
+		constant.DeleteMsgsNotification:  {IsSendMsg: false, ReliabilityLevel: constant.ReliableNotificationNoMsg},
+		msgext.MsgPinChangedNotification: {IsSendMsg: false, ReliabilityLevel: constant.ReliableNotificationNoMsg},
+		msgext.PollVotedNotification:     {IsSendMsg: false, ReliabilityLevel: constant.ReliableNotificationNoMsg},
 	}
 }
 
@@ -105,6 +117,8 @@ func newSessionTypeConf() map[int32]int32 {
 		constant.GroupMemberSetToOrdinaryUserNotification: constant.ReadGroupChatType,
 		constant.GroupInfoSetAnnouncementNotification:     constant.ReadGroupChatType,
 		constant.GroupInfoSetNameNotification:             constant.ReadGroupChatType,
+		groupext.CommunityAnnouncementNotification:        constant.ReadGroupChatType,
+		groupext.GroupMsgPolicySetNotification:            constant.ReadGroupChatType,
 		// user
 		constant.UserInfoUpdatedNotification:  constant.SingleChatType,
 		constant.UserStatusChangeNotification: constant.SingleChatType,
@@ -119,19 +133,24 @@ func newSessionTypeConf() map[int32]int32 {
 		constant.BlackDeletedNotification:              constant.SingleChatType,
 		constant.FriendInfoUpdatedNotification:         constant.SingleChatType,
 		constant.FriendsInfoUpdateNotification:         constant.SingleChatType,
+		friendext.FriendGroupChangedNotification:       constant.SingleChatType,
 		// conversation
-		constant.ConversationChangeNotification:      constant.SingleChatType,
-		constant.ConversationUnreadNotification:      constant.SingleChatType,
-		constant.ConversationPrivateChatNotification: constant.SingleChatType,
+		constant.ConversationChangeNotification:        constant.SingleChatType,
+		constant.ConversationUnreadNotification:        constant.SingleChatType,
+		constant.ConversationPrivateChatNotification:   constant.SingleChatType,
+		conversationext.ConversationDraftNotification:  constant.SingleChatType,
+		conversationext.ConversationFolderNotification: constant.SingleChatType,
+		conversationext.DoNotDisturbNotification:       constant.SingleChatType,
 		// delete
 		constant.DeleteMsgsNotification: constant.SingleChatType,
 	}
 }
 
 type Message struct {
-	conn   grpc.ClientConnInterface
-	Client msg.MsgClient
-	discov discovery.SvcDiscoveryRegistry
+	conn      grpc.ClientConnInterface
+	Client    msg.MsgClient
+	ExtClient msgext.MsgExtClient
+	discov    discovery.SvcDiscoveryRegistry
 }
 
 func NewMessage(discov discovery.SvcDiscoveryRegistry, rpcRegisterName string) *Message {
@@ -140,7 +159,7 @@ func NewMessage(discov discovery.SvcDiscoveryRegistry, rpcRegisterName string) *
 		program.ExitWithError(err)
 	}
 	client := msg.NewMsgClient(conn)
-	return &Message{discov: discov, conn: conn, Client: client}
+	return &Message{discov: discov, conn: conn, Client: client, ExtClient: msgext.NewMsgExtClient(conn)}
 }
 
 type MessageRpcClient Message
@@ -185,6 +204,33 @@ func (m *MessageRpcClient) GetHasReadSeqs(ctx context.Context, userID string, co
 	return resp.MaxSeqs, err
 }
 
+func (m *MessageRpcClient) GetConversationsUnreadCount(ctx context.Context, userID string, conversationIDs []string) (map[string]int64, error) {
+	resp, err := m.ExtClient.GetConversationsUnreadCount(ctx, &msgext.GetConversationsUnreadCountReq{
+		UserID:          userID,
+		ConversationIDs: conversationIDs,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.UnreadCounts, nil
+}
+
+func (m *MessageRpcClient) GetUsersUnreadCount(ctx context.Context, userIDs []string) (map[string]int64, error) {
+	resp, err := m.ExtClient.GetUsersUnreadCount(ctx, &msgext.GetUsersUnreadCountReq{UserIDs: userIDs})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Totals, nil
+}
+
+func (m *MessageRpcClient) GetPoll(ctx context.Context, userID string, pollID string) (*msgext.PollInfo, error) {
+	resp, err := m.ExtClient.GetPoll(ctx, &msgext.GetPollReq{PollID: pollID, UserID: userID})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Poll, nil
+}
+
 func (m *MessageRpcClient) GetMsgByConversationIDs(ctx context.Context, docIDs []string, seqs map[string]int64) (map[string]*sdkws.MsgData, error) {
 	resp, err := m.Client.GetMsgByConversationIDs(ctx, &msg.GetMsgByConversationIDsReq{
 		ConversationIDs: docIDs,
@@ -0,0 +1,68 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package notificationtest receives the notifications sent through a rpcclient.NotificationSender in tests.
+package notificationtest
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/rpcclient"
+	"github.com/openimsdk/protocol/msg"
+	"github.com/openimsdk/protocol/sdkws"
+)
+
+// Recorder receives the notifications of the sender it was created with.
+type Recorder struct {
+	sent chan *sdkws.MsgData
+}
+
+// NewRecorder returns a notification sender whose notifications are received by the returned recorder.
+func NewRecorder() (*rpcclient.NotificationSender, *Recorder) {
+	r := &Recorder{sent: make(chan *sdkws.MsgData, 10)}
+	sendMsg := func(ctx context.Context, req *msg.SendMsgReq) (*msg.SendMsgResp, error) {
+		r.sent <- req.MsgData
+		return &msg.SendMsgResp{}, nil
+	}
+	return rpcclient.NewNotificationSender(&config.Notification{}, rpcclient.WithLocalSendMsg(sendMsg)), r
+}
+
+// Receive waits for the next notification and fails the test unless it has the content type.
+// The tips of the notification are decoded into tips when it is not nil.
+func (r *Recorder) Receive(t testing.TB, contentType int32, tips any) *sdkws.MsgData {
+	t.Helper()
+	select {
+	case data := <-r.sent:
+		if data.ContentType != contentType {
+			t.Fatalf("notification content type %d, expected %d", data.ContentType, contentType)
+		}
+		if tips != nil {
+			var elem sdkws.NotificationElem
+			if err := json.Unmarshal(data.Content, &elem); err != nil {
+				t.Fatal(err)
+			}
+			if err := json.Unmarshal([]byte(elem.Detail), tips); err != nil {
+				t.Fatal(err)
+			}
+		}
+		return data
+	case <-time.After(time.Second):
+		t.Fatalf("no notification of content type %d sent", contentType)
+		return nil
+	}
+}
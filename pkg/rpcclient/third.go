@@ -15,10 +15,18 @@
 package rpcclient
 
 import (
+	"bytes"
 	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"net/http"
+	"net/url"
+	"strings"
 
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/thirdext"
 	"github.com/openimsdk/protocol/third"
 	"github.com/openimsdk/tools/discovery"
+	"github.com/openimsdk/tools/errs"
 	"github.com/openimsdk/tools/system/program"
 	"google.golang.org/grpc"
 )
@@ -26,6 +34,7 @@ import (
 type Third struct {
 	conn       grpc.ClientConnInterface
 	Client     third.ThirdClient
+	ExtClient  thirdext.ThirdExtClient
 	discov     discovery.SvcDiscoveryRegistry
 	GrafanaUrl string
 }
@@ -39,5 +48,100 @@ func NewThird(discov discovery.SvcDiscoveryRegistry, rpcRegisterName, grafanaUrl
 	if err != nil {
 		program.ExitWithError(err)
 	}
-	return &Third{discov: discov, Client: client, conn: conn, GrafanaUrl: grafanaUrl}
+	return &Third{discov: discov, Client: client, ExtClient: thirdext.NewThirdExtClient(conn), conn: conn, GrafanaUrl: grafanaUrl}
+}
+
+// UploadObject uploads data as the object name the same way the clients do, through a multipart upload of the third service.
+func (t *Third) UploadObject(ctx context.Context, name string, contentType string, cause string, data []byte) error {
+	partSize, err := t.Client.PartSize(ctx, &third.PartSizeReq{Size: int64(len(data))})
+	if err != nil {
+		return err
+	}
+	if partSize.Size <= 0 {
+		return errs.New("invalid part size", "size", partSize.Size).Wrap()
+	}
+	var (
+		parts      [][]byte
+		partHashes []string
+	)
+	for start := int64(0); start < int64(len(data)); start += partSize.Size {
+		part := data[start:min(start+partSize.Size, int64(len(data)))]
+		sum := md5.Sum(part)
+		parts = append(parts, part)
+		partHashes = append(partHashes, hex.EncodeToString(sum[:]))
+	}
+	sum := md5.Sum([]byte(strings.Join(partHashes, ",")))
+	initiate, err := t.Client.InitiateMultipartUpload(ctx, &third.InitiateMultipartUploadReq{
+		Hash:        hex.EncodeToString(sum[:]),
+		Size:        int64(len(data)),
+		PartSize:    partSize.Size,
+		MaxParts:    -1,
+		Cause:       cause,
+		Name:        name,
+		ContentType: contentType,
+	})
+	if err != nil {
+		return err
+	}
+	if initiate.Upload == nil {
+		// the same content was uploaded before
+		return nil
+	}
+	sign := initiate.Upload.Sign
+	if sign == nil || len(sign.Parts) != len(parts) {
+		return errs.New("unexpected upload sign", "parts", len(parts)).Wrap()
+	}
+	for _, signPart := range sign.Parts {
+		if signPart.PartNumber < 1 || int(signPart.PartNumber) > len(parts) {
+			return errs.New("invalid part number", "partNumber", signPart.PartNumber).Wrap()
+		}
+		if err := putPart(ctx, sign, signPart, parts[signPart.PartNumber-1]); err != nil {
+			return err
+		}
+	}
+	_, err = t.Client.CompleteMultipartUpload(ctx, &third.CompleteMultipartUploadReq{
+		UploadID:    initiate.Upload.UploadID,
+		Parts:       partHashes,
+		Name:        name,
+		ContentType: contentType,
+		Cause:       cause,
+	})
+	return err
+}
+
+func putPart(ctx context.Context, sign *third.AuthSignParts, part *third.SignPart, data []byte) error {
+	rawURL := part.Url
+	if rawURL == "" {
+		rawURL = sign.Url
+	}
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return errs.WrapMsg(err, "invalid part url", "url", rawURL)
+	}
+	query := u.Query()
+	for _, values := range [][]*third.KeyValues{sign.Query, part.Query} {
+		for _, kv := range values {
+			query[kv.Key] = kv.Values
+		}
+	}
+	u.RawQuery = query.Encode()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, u.String(), bytes.NewReader(data))
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	for _, values := range [][]*third.KeyValues{sign.Header, part.Header} {
+		for _, kv := range values {
+			req.Header[kv.Key] = kv.Values
+		}
+	}
+	req.ContentLength = int64(len(data))
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return errs.WrapMsg(err, "put part failed", "partNumber", part.PartNumber)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		return errs.New("put part failed", "partNumber", part.PartNumber, "status", resp.Status).Wrap()
+	}
+	return nil
 }
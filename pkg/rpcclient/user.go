@@ -20,6 +20,7 @@ import (
 
 	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/servererrs"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/userext"
 	"github.com/openimsdk/protocol/sdkws"
 	"github.com/openimsdk/protocol/user"
 	"github.com/openimsdk/tools/discovery"
@@ -32,6 +33,7 @@ import (
 type User struct {
 	conn                  grpc.ClientConnInterface
 	Client                user.UserClient
+	ExtClient             userext.UserExtClient
 	Discov                discovery.SvcDiscoveryRegistry
 	MessageGateWayRpcName string
 	imAdminUserID         []string
@@ -45,7 +47,7 @@ func NewUser(discov discovery.SvcDiscoveryRegistry, rpcRegisterName, messageGate
 		program.ExitWithError(err)
 	}
 	client := user.NewUserClient(conn)
-	return &User{Discov: discov, Client: client,
+	return &User{Discov: discov, Client: client, ExtClient: userext.NewUserExtClient(conn),
 		conn:                  conn,
 		MessageGateWayRpcName: messageGateWayRpcName,
 		imAdminUserID:         imAdminUserID}
@@ -94,6 +96,12 @@ func (u *UserRpcClient) GetUserInfo(ctx context.Context, userID string) (*sdkws.
 	return users[0], nil
 }
 
+// CheckAccountActive fails for unknown users and with servererrs.ErrAccountDeleted for purged accounts.
+func (u *UserRpcClient) CheckAccountActive(ctx context.Context, userID string) error {
+	_, err := u.ExtClient.CheckAccountActive(ctx, &userext.CheckAccountActiveReq{UserID: userID})
+	return err
+}
+
 // GetUsersInfoMap retrieves a map of user information indexed by their user IDs.
 func (u *UserRpcClient) GetUsersInfoMap(ctx context.Context, userIDs []string) (map[string]*sdkws.UserInfo, error) {
 	users, err := u.GetUsersInfo(ctx, userIDs)
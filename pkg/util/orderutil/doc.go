@@ -0,0 +1 @@
+package orderutil // import "github.com/openimsdk/open-im-server/v3/pkg/util/orderutil"
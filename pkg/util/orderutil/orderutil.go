@@ -0,0 +1,56 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package orderutil
+
+// Next returns the order listing a new item after all the items.
+func Next[T any](items []T, order func(T) int32) int32 {
+	var next int32
+	for _, item := range items {
+		if o := order(item); o >= next {
+			next = o + 1
+		}
+	}
+	return next
+}
+
+// Sort lists the items of ids first in the given order and the other items after them in their current order,
+// items must be sorted by ascending order. It returns the new orders of the items whose order changed,
+// k: id, v: order, or the first of ids that is not an item.
+func Sort[T any](items []T, id func(T) string, order func(T) int32, ids []string) (map[string]int32, string) {
+	current := make(map[string]int32, len(items))
+	for _, item := range items {
+		current[id(item)] = order(item)
+	}
+	orders := make(map[string]int32, len(items))
+	for _, i := range ids {
+		if _, ok := current[i]; !ok {
+			return nil, i
+		}
+		if _, ok := orders[i]; !ok {
+			orders[i] = int32(len(orders))
+		}
+	}
+	for _, item := range items {
+		if _, ok := orders[id(item)]; !ok {
+			orders[id(item)] = int32(len(orders))
+		}
+	}
+	for i, o := range orders {
+		if current[i] == o {
+			delete(orders, i)
+		}
+	}
+	return orders, ""
+}
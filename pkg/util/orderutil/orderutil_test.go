@@ -0,0 +1,59 @@
+// Copyright © 2024 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package orderutil
+
+import (
+	"reflect"
+	"testing"
+)
+
+type item struct {
+	id    string
+	order int32
+}
+
+func itemID(i item) string { return i.id }
+
+func itemOrder(i item) int32 { return i.order }
+
+func TestNext(t *testing.T) {
+	if next := Next(nil, itemOrder); next != 0 {
+		t.Errorf("next order %d without items, expected 0", next)
+	}
+	items := []item{{"a", 0}, {"b", 3}, {"c", 1}}
+	if next := Next(items, itemOrder); next != 4 {
+		t.Errorf("next order %d, expected 4", next)
+	}
+}
+
+func TestSort(t *testing.T) {
+	items := []item{{"a", 0}, {"b", 1}, {"c", 2}, {"d", 3}}
+	orders, missing := Sort(items, itemID, itemOrder, []string{"c", "a", "c"})
+	if missing != "" {
+		t.Fatalf("missing %s", missing)
+	}
+	// c, a, b, d
+	expected := map[string]int32{"c": 0, "a": 1, "b": 2}
+	if !reflect.DeepEqual(orders, expected) {
+		t.Errorf("orders %v, expected %v", orders, expected)
+	}
+	orders, _ = Sort(items, itemID, itemOrder, []string{"a", "b"})
+	if len(orders) != 0 {
+		t.Errorf("orders %v of an unchanged sort, expected none", orders)
+	}
+	if _, missing = Sort(items, itemID, itemOrder, []string{"a", "e"}); missing != "e" {
+		t.Errorf("missing %q, expected e", missing)
+	}
+}